@@ -40,6 +40,7 @@ func main() {
 	}
 
 	obsidian.AttachHandlers(srv.EchoServer, handlers.GetHandlers())
+	obsidian.AttachHandlers(srv.EchoServer, handlers.GetDynamicAuthorizationHandlers(handlers.NewGwDynamicAuthorizationClient()))
 
 	builder_protos.RegisterMconfigBuilderServer(srv.ProtectedGrpcServer, builder_servicers.NewBuilderServicer())
 
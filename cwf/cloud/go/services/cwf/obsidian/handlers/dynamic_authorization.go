@@ -0,0 +1,125 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/cwf/cloud/go/serdes"
+	cwfModels "magma/cwf/cloud/go/services/cwf/obsidian/models"
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
+	directorydTypes "magma/orc8r/cloud/go/services/directoryd/types"
+	"magma/orc8r/cloud/go/services/obsidian"
+)
+
+const (
+	SubscriberDisconnectPath  = BaseSubscriberPath + obsidian.UrlSep + "disconnect"
+	SubscriberReauthorizePath = BaseSubscriberPath + obsidian.UrlSep + "reauthorize"
+)
+
+// GetDynamicAuthorizationHandlers returns the handlers sending Disconnect-Request & CoA-Request
+// for a subscriber's active Wi-Fi session through the gateway serving the subscriber
+func GetDynamicAuthorizationHandlers(client GwDynamicAuthorizationClient) []obsidian.Handler {
+	return []obsidian.Handler{
+		{Path: SubscriberDisconnectPath, Methods: obsidian.POST, HandlerFunc: getDisconnectHandlerFunc(client)},
+		{Path: SubscriberReauthorizePath, Methods: obsidian.POST, HandlerFunc: getReauthorizeHandlerFunc(client)},
+	}
+}
+
+func getDisconnectHandlerFunc(client GwDynamicAuthorizationClient) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		hwID, gatewayID, nerr := getSubscriberGateway(c)
+		if nerr != nil {
+			return nerr
+		}
+		req := &fegprotos.DynamicAuthorizationRequest{Imsi: c.Param("subscriber_id")}
+		res, err := client.Disconnect(c.Request().Context(), hwID, req)
+		if err != nil {
+			return dynamicAuthorizationError("Disconnect", gatewayID, err)
+		}
+		return c.JSON(http.StatusOK, newDynamicAuthorizationResult(gatewayID, res))
+	}
+}
+
+func getReauthorizeHandlerFunc(client GwDynamicAuthorizationClient) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		payload := &cwfModels.CwfReauthorizationRequest{}
+		if err := c.Bind(payload); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		hwID, gatewayID, nerr := getSubscriberGateway(c)
+		if nerr != nil {
+			return nerr
+		}
+		req := &fegprotos.DynamicAuthorizationRequest{
+			Imsi:           c.Param("subscriber_id"),
+			SessionTimeout: payload.SessionTimeout,
+		}
+		res, err := client.Reauthorize(c.Request().Context(), hwID, req)
+		if err != nil {
+			return dynamicAuthorizationError("CoA", gatewayID, err)
+		}
+		return c.JSON(http.StatusOK, newDynamicAuthorizationResult(gatewayID, res))
+	}
+}
+
+// getSubscriberGateway returns the hardware & logical IDs of the gateway which last
+// reported the subscriber's directory record
+func getSubscriberGateway(c echo.Context) (string, string, *echo.HTTPError) {
+	networkID, directoryState, nerr := getSubscriberDirectoryState(c)
+	if nerr != nil {
+		return "", "", nerr
+	}
+	record, ok := directoryState.ReportedState.(*directorydTypes.DirectoryRecord)
+	if !ok {
+		return "", "", echo.NewHTTPError(http.StatusInternalServerError, "Could not convert retrieved state to DirectoryRecord")
+	}
+	hwID, err := record.GetCurrentLocation()
+	if err != nil {
+		return "", "", echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("subscriber's gateway is unknown: %v", err))
+	}
+	gateway, err := configurator.LoadEntityForPhysicalID(c.Request().Context(), hwID, configurator.EntityLoadCriteria{}, serdes.Entity)
+	if err != nil || gateway.NetworkID != networkID {
+		return "", "", echo.NewHTTPError(
+			http.StatusNotFound, fmt.Sprintf("gateway with hardware ID %s is not found in network %s", hwID, networkID))
+	}
+	return hwID, gateway.Key, nil
+}
+
+func dynamicAuthorizationError(request string, gatewayID string, err error) *echo.HTTPError {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	}
+	return echo.NewHTTPError(code, fmt.Sprintf("failed to send %s request through gateway %s: %v", request, gatewayID, err))
+}
+
+func newDynamicAuthorizationResult(gatewayID string, res *fegprotos.DynamicAuthorizationAnswer) *cwfModels.CwfDynamicAuthorizationResult {
+	return &cwfModels.CwfDynamicAuthorizationResult{
+		Result:     res.GetResult().String(),
+		ErrorCause: res.GetErrorCause(),
+		GatewayID:  gatewayID,
+		SessionID:  res.GetSessionId(),
+		MacAddr:    res.GetMacAddr(),
+	}
+}
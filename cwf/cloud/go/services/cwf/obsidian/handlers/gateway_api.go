@@ -0,0 +1,67 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+)
+
+// GwDynamicAuthorizationClient sends RADIUS Dynamic Authorization (RFC 5176) requests
+// for subscriber sessions through the AAA server of the gateway serving the session
+type GwDynamicAuthorizationClient interface {
+	Disconnect(ctx context.Context, hwID string, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error)
+
+	Reauthorize(ctx context.Context, hwID string, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error)
+}
+
+type gwDynamicAuthorizationClientImpl struct{}
+
+func NewGwDynamicAuthorizationClient() GwDynamicAuthorizationClient {
+	return gwDynamicAuthorizationClientImpl{}
+}
+
+// getGwDynamicAuthorizationClient gets a GRPC client to the AAA server running on the gateway with the given hardware ID
+func getGwDynamicAuthorizationClient(hwID string) (fegprotos.DynamicAuthorizationGatewayServiceClient, context.Context, error) {
+	conn, gatewayCtx, err := gateway_registry.GetGatewayConnection(gateway_registry.GwAAAService, hwID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gateway %s AAA server client initialization error: %w", hwID, err)
+	}
+	return fegprotos.NewDynamicAuthorizationGatewayServiceClient(conn), gatewayCtx, nil
+}
+
+// Disconnect sends Disconnect-Request for the subscriber's session through the specified gateway
+func (gwDynamicAuthorizationClientImpl) Disconnect(
+	_ context.Context, hwID string, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error) {
+
+	client, gatewayCtx, err := getGwDynamicAuthorizationClient(hwID)
+	if err != nil {
+		return nil, err
+	}
+	return client.Disconnect(gatewayCtx, req)
+}
+
+// Reauthorize sends CoA-Request for the subscriber's session through the specified gateway
+func (gwDynamicAuthorizationClientImpl) Reauthorize(
+	_ context.Context, hwID string, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error) {
+
+	client, gatewayCtx, err := getGwDynamicAuthorizationClient(hwID)
+	if err != nil {
+		return nil, err
+	}
+	return client.Reauthorize(gatewayCtx, req)
+}
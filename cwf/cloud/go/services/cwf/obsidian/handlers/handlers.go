@@ -35,6 +35,7 @@ import (
 	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
 	orc8rModels "magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
 	"magma/orc8r/cloud/go/services/state"
+	state_types "magma/orc8r/cloud/go/services/state/types"
 	"magma/orc8r/cloud/go/storage"
 	"magma/orc8r/lib/go/merrors"
 )
@@ -231,34 +232,44 @@ func makeCwfGateways(
 }
 
 func getSubscriberDirectoryHandler(c echo.Context) error {
-	networkID, nerr := obsidian.GetNetworkId(c)
+	_, directoryState, nerr := getSubscriberDirectoryState(c)
 	if nerr != nil {
 		return nerr
 	}
+	cwfRecord, err := convertDirectoryRecordToSubscriberRecord(directoryState.ReportedState)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, cwfRecord)
+}
+
+// getSubscriberDirectoryState returns the network ID & the directory record state of the
+// subscriber of the request, the network must be a CWF network
+func getSubscriberDirectoryState(c echo.Context) (string, state_types.State, *echo.HTTPError) {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return "", state_types.State{}, nerr
+	}
 
 	reqCtx := c.Request().Context()
 	configuratorNetwork, err := configurator.LoadNetwork(reqCtx, networkID, false, false, serdes.Network)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		return "", state_types.State{}, echo.NewHTTPError(http.StatusNotFound, err.Error())
 	}
 	if configuratorNetwork.Type != cwf.CwfNetworkType {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("NetworkID %s is not a CWF network", networkID))
+		return "", state_types.State{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("NetworkID %s is not a CWF network", networkID))
 	}
 	subscriberID := c.Param("subscriber_id")
 	if subscriberID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "SubscriberID cannot be empty")
+		return "", state_types.State{}, echo.NewHTTPError(http.StatusBadRequest, "SubscriberID cannot be empty")
 	}
 	directoryState, err := state.GetState(reqCtx, networkID, orc8r.DirectoryRecordType, subscriberID, serdes.State)
 	if err == merrors.ErrNotFound {
-		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		return "", state_types.State{}, echo.NewHTTPError(http.StatusNotFound, err.Error())
 	} else if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return "", state_types.State{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-	cwfRecord, err := convertDirectoryRecordToSubscriberRecord(directoryState.ReportedState)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-	return c.JSON(http.StatusOK, cwfRecord)
+	return networkID, directoryState, nil
 }
 
 func convertDirectoryRecordToSubscriberRecord(iRecord interface{}) (*cwfModels.CwfSubscriberDirectoryRecord, error) {
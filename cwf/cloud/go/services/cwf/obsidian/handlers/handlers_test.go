@@ -22,12 +22,15 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"magma/cwf/cloud/go/cwf"
 	"magma/cwf/cloud/go/serdes"
 	"magma/cwf/cloud/go/services/cwf/obsidian/handlers"
 	models2 "magma/cwf/cloud/go/services/cwf/obsidian/models"
 	"magma/feg/cloud/go/feg"
+	fegprotos "magma/feg/cloud/go/protos"
 	models3 "magma/feg/cloud/go/services/feg/obsidian/models"
 	"magma/orc8r/cloud/go/clock"
 	models5 "magma/orc8r/cloud/go/models"
@@ -826,6 +829,127 @@ func TestCwfHaPairs(t *testing.T) {
 }
 
 // n1, n3 are cwf networks, n2, n5 are not
+func TestCwfDynamicAuthorization(t *testing.T) {
+	test_init.StartTestService(t)
+	stateTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+
+	e := echo.New()
+	client := &mockDynamicAuthorizationClient{}
+	obsidianHandlers := handlers.GetDynamicAuthorizationHandlers(client)
+	disconnect := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/cwf/:network_id/subscribers/:subscriber_id/disconnect", obsidian.POST).HandlerFunc
+	reauthorize := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/cwf/:network_id/subscribers/:subscriber_id/reauthorize", obsidian.POST).HandlerFunc
+	seedCwfNetworks(t)
+	seedCwfTier(t, "n1")
+	seedCwfGateway(t, "g1", "hw1")
+
+	// Subscriber without a directory record
+	tc := tests.Test{
+		Method:                 "POST",
+		URL:                    "/magma/v1/cwf/n1/subscribers/IMSI123456/disconnect",
+		ParamNames:             []string{"network_id", "subscriber_id"},
+		ParamValues:            []string{"n1", "IMSI123456"},
+		Handler:                disconnect,
+		ExpectedStatus:         404,
+		ExpectedErrorSubstring: "Not found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	ctx := test_utils.GetContextWithCertificate(t, "hw1")
+	reportSubscriberDirectoryRecord(t, ctx, "IMSI123456", &directorydTypes.DirectoryRecord{
+		LocationHistory: []string{"hw1"},
+		Identifiers:     map[string]interface{}{"mac_addr": "aa:aa:aa:aa:aa:aa"},
+	})
+
+	// Not a CWF network
+	tc.URL = "/magma/v1/cwf/n2/subscribers/IMSI123456/disconnect"
+	tc.ParamValues = []string{"n2", "IMSI123456"}
+	tc.ExpectedStatus = 400
+	tc.ExpectedErrorSubstring = ""
+	tc.ExpectedError = "NetworkID n2 is not a CWF network"
+	tests.RunUnitTest(t, e, tc)
+
+	// Disconnect ACK
+	client.answer = &fegprotos.DynamicAuthorizationAnswer{
+		Result:    fegprotos.DynamicAuthorizationAnswer_ACK,
+		SessionId: "acct1",
+		MacAddr:   "aa:aa:aa:aa:aa:aa",
+	}
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/cwf/n1/subscribers/IMSI123456/disconnect",
+		ParamNames:     []string{"network_id", "subscriber_id"},
+		ParamValues:    []string{"n1", "IMSI123456"},
+		Handler:        disconnect,
+		ExpectedStatus: 200,
+		ExpectedResult: &models2.CwfDynamicAuthorizationResult{
+			Result:    models2.CwfDynamicAuthorizationResultResultACK,
+			GatewayID: "g1",
+			SessionID: "acct1",
+			MacAddr:   "aa:aa:aa:aa:aa:aa",
+		},
+	}
+	tests.RunUnitTest(t, e, tc)
+	assert.Equal(t, "hw1", client.hwID)
+	assert.Equal(t, &fegprotos.DynamicAuthorizationRequest{Imsi: "IMSI123456"}, client.request)
+
+	// CoA NAK
+	client.answer = &fegprotos.DynamicAuthorizationAnswer{
+		Result:     fegprotos.DynamicAuthorizationAnswer_NAK,
+		ErrorCause: 503,
+		SessionId:  "acct1",
+	}
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/cwf/n1/subscribers/IMSI123456/reauthorize",
+		Payload:        &models2.CwfReauthorizationRequest{SessionTimeout: 3600},
+		ParamNames:     []string{"network_id", "subscriber_id"},
+		ParamValues:    []string{"n1", "IMSI123456"},
+		Handler:        reauthorize,
+		ExpectedStatus: 200,
+		ExpectedResult: &models2.CwfDynamicAuthorizationResult{
+			Result:     models2.CwfDynamicAuthorizationResultResultNAK,
+			ErrorCause: 503,
+			GatewayID:  "g1",
+			SessionID:  "acct1",
+		},
+	}
+	tests.RunUnitTest(t, e, tc)
+	assert.Equal(t, &fegprotos.DynamicAuthorizationRequest{Imsi: "IMSI123456", SessionTimeout: 3600}, client.request)
+
+	// No active session on the gateway
+	client.err = status.Error(codes.NotFound, "Session for IMSI: 123456 is not found")
+	tc = tests.Test{
+		Method:                 "POST",
+		URL:                    "/magma/v1/cwf/n1/subscribers/IMSI123456/reauthorize",
+		ParamNames:             []string{"network_id", "subscriber_id"},
+		ParamValues:            []string{"n1", "IMSI123456"},
+		Handler:                reauthorize,
+		ExpectedStatus:         404,
+		ExpectedErrorSubstring: "failed to send CoA request through gateway g1",
+	}
+	tests.RunUnitTest(t, e, tc)
+}
+
+type mockDynamicAuthorizationClient struct {
+	hwID    string
+	request *fegprotos.DynamicAuthorizationRequest
+	answer  *fegprotos.DynamicAuthorizationAnswer
+	err     error
+}
+
+func (m *mockDynamicAuthorizationClient) Disconnect(
+	_ context.Context, hwID string, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error) {
+	m.hwID, m.request = hwID, req
+	return m.answer, m.err
+}
+
+func (m *mockDynamicAuthorizationClient) Reauthorize(
+	_ context.Context, hwID string, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error) {
+	m.hwID, m.request = hwID, req
+	return m.answer, m.err
+}
+
 func seedCwfNetworks(t *testing.T) {
 	fegNetworkID := "n5"
 	_, err := configurator.CreateNetworks(context.Background(), []configurator.Network{
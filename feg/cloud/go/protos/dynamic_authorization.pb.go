@@ -0,0 +1,475 @@
+//
+//Copyright 2021 The Magma Authors.
+//
+//This source code is licensed under the BSD-style license found in the
+//LICENSE file in the root directory of this source tree.
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.10.0
+// source: feg/protos/dynamic_authorization.proto
+
+package protos
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DynamicAuthorizationAnswer_Result int32
+
+const (
+	DynamicAuthorizationAnswer_NAK DynamicAuthorizationAnswer_Result = 0
+	DynamicAuthorizationAnswer_ACK DynamicAuthorizationAnswer_Result = 1
+)
+
+// Enum value maps for DynamicAuthorizationAnswer_Result.
+var (
+	DynamicAuthorizationAnswer_Result_name = map[int32]string{
+		0: "NAK",
+		1: "ACK",
+	}
+	DynamicAuthorizationAnswer_Result_value = map[string]int32{
+		"NAK": 0,
+		"ACK": 1,
+	}
+)
+
+func (x DynamicAuthorizationAnswer_Result) Enum() *DynamicAuthorizationAnswer_Result {
+	p := new(DynamicAuthorizationAnswer_Result)
+	*p = x
+	return p
+}
+
+func (x DynamicAuthorizationAnswer_Result) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DynamicAuthorizationAnswer_Result) Descriptor() protoreflect.EnumDescriptor {
+	return file_feg_protos_dynamic_authorization_proto_enumTypes[0].Descriptor()
+}
+
+func (DynamicAuthorizationAnswer_Result) Type() protoreflect.EnumType {
+	return &file_feg_protos_dynamic_authorization_proto_enumTypes[0]
+}
+
+func (x DynamicAuthorizationAnswer_Result) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DynamicAuthorizationAnswer_Result.Descriptor instead.
+func (DynamicAuthorizationAnswer_Result) EnumDescriptor() ([]byte, []int) {
+	return file_feg_protos_dynamic_authorization_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type DynamicAuthorizationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriber identifier
+	Imsi string `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// Optional Acct-Session-Id of the targeted session, if set - it must match the active session
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Optional Session-Timeout (seconds) to include into CoA-Request, 0 - not included
+	SessionTimeout uint32 `protobuf:"varint,3,opt,name=session_timeout,json=sessionTimeout,proto3" json:"session_timeout,omitempty"`
+}
+
+func (x *DynamicAuthorizationRequest) Reset() {
+	*x = DynamicAuthorizationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_dynamic_authorization_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicAuthorizationRequest) ProtoMessage() {}
+
+func (x *DynamicAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_dynamic_authorization_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*DynamicAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_dynamic_authorization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DynamicAuthorizationRequest) GetImsi() string {
+	if x != nil {
+		return x.Imsi
+	}
+	return ""
+}
+
+func (x *DynamicAuthorizationRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DynamicAuthorizationRequest) GetSessionTimeout() uint32 {
+	if x != nil {
+		return x.SessionTimeout
+	}
+	return 0
+}
+
+type DynamicAuthorizationAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// NAS response: Disconnect-ACK/CoA-ACK or Disconnect-NAK/CoA-NAK
+	Result DynamicAuthorizationAnswer_Result `protobuf:"varint,1,opt,name=result,proto3,enum=magma.feg.DynamicAuthorizationAnswer_Result" json:"result,omitempty"`
+	// Error-Cause (RFC 5176 Section 3.5) of the NAK, 0 if not present
+	ErrorCause uint32 `protobuf:"varint,2,opt,name=error_cause,json=errorCause,proto3" json:"error_cause,omitempty"`
+	// Acct-Session-Id of the targeted session
+	SessionId string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// MAC address of the subscriber's device (Calling-Station-Id)
+	MacAddr string `protobuf:"bytes,4,opt,name=mac_addr,json=macAddr,proto3" json:"mac_addr,omitempty"`
+}
+
+func (x *DynamicAuthorizationAnswer) Reset() {
+	*x = DynamicAuthorizationAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_dynamic_authorization_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicAuthorizationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicAuthorizationAnswer) ProtoMessage() {}
+
+func (x *DynamicAuthorizationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_dynamic_authorization_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicAuthorizationAnswer.ProtoReflect.Descriptor instead.
+func (*DynamicAuthorizationAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_dynamic_authorization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DynamicAuthorizationAnswer) GetResult() DynamicAuthorizationAnswer_Result {
+	if x != nil {
+		return x.Result
+	}
+	return DynamicAuthorizationAnswer_NAK
+}
+
+func (x *DynamicAuthorizationAnswer) GetErrorCause() uint32 {
+	if x != nil {
+		return x.ErrorCause
+	}
+	return 0
+}
+
+func (x *DynamicAuthorizationAnswer) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DynamicAuthorizationAnswer) GetMacAddr() string {
+	if x != nil {
+		return x.MacAddr
+	}
+	return ""
+}
+
+var File_feg_protos_dynamic_authorization_proto protoreflect.FileDescriptor
+
+var file_feg_protos_dynamic_authorization_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x66, 0x65, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x22, 0x79, 0x0a, 0x1b, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xd9,
+	0x01, 0x0a, 0x1a, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x44, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x75,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x61, 0x75, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0x1a,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x41, 0x4b, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x32, 0xe3, 0x01, 0x0a, 0x22, 0x44,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12,
+	0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12,
+	0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00,
+	0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_feg_protos_dynamic_authorization_proto_rawDescOnce sync.Once
+	file_feg_protos_dynamic_authorization_proto_rawDescData = file_feg_protos_dynamic_authorization_proto_rawDesc
+)
+
+func file_feg_protos_dynamic_authorization_proto_rawDescGZIP() []byte {
+	file_feg_protos_dynamic_authorization_proto_rawDescOnce.Do(func() {
+		file_feg_protos_dynamic_authorization_proto_rawDescData = protoimpl.X.CompressGZIP(file_feg_protos_dynamic_authorization_proto_rawDescData)
+	})
+	return file_feg_protos_dynamic_authorization_proto_rawDescData
+}
+
+var file_feg_protos_dynamic_authorization_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_feg_protos_dynamic_authorization_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_feg_protos_dynamic_authorization_proto_goTypes = []interface{}{
+	(DynamicAuthorizationAnswer_Result)(0), // 0: magma.feg.DynamicAuthorizationAnswer.Result
+	(*DynamicAuthorizationRequest)(nil),    // 1: magma.feg.DynamicAuthorizationRequest
+	(*DynamicAuthorizationAnswer)(nil),     // 2: magma.feg.DynamicAuthorizationAnswer
+}
+var file_feg_protos_dynamic_authorization_proto_depIdxs = []int32{
+	0, // 0: magma.feg.DynamicAuthorizationAnswer.result:type_name -> magma.feg.DynamicAuthorizationAnswer.Result
+	1, // 1: magma.feg.DynamicAuthorizationGatewayService.Disconnect:input_type -> magma.feg.DynamicAuthorizationRequest
+	1, // 2: magma.feg.DynamicAuthorizationGatewayService.Reauthorize:input_type -> magma.feg.DynamicAuthorizationRequest
+	2, // 3: magma.feg.DynamicAuthorizationGatewayService.Disconnect:output_type -> magma.feg.DynamicAuthorizationAnswer
+	2, // 4: magma.feg.DynamicAuthorizationGatewayService.Reauthorize:output_type -> magma.feg.DynamicAuthorizationAnswer
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_feg_protos_dynamic_authorization_proto_init() }
+func file_feg_protos_dynamic_authorization_proto_init() {
+	if File_feg_protos_dynamic_authorization_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_feg_protos_dynamic_authorization_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynamicAuthorizationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_dynamic_authorization_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynamicAuthorizationAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_feg_protos_dynamic_authorization_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_feg_protos_dynamic_authorization_proto_goTypes,
+		DependencyIndexes: file_feg_protos_dynamic_authorization_proto_depIdxs,
+		EnumInfos:         file_feg_protos_dynamic_authorization_proto_enumTypes,
+		MessageInfos:      file_feg_protos_dynamic_authorization_proto_msgTypes,
+	}.Build()
+	File_feg_protos_dynamic_authorization_proto = out.File
+	file_feg_protos_dynamic_authorization_proto_rawDesc = nil
+	file_feg_protos_dynamic_authorization_proto_goTypes = nil
+	file_feg_protos_dynamic_authorization_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DynamicAuthorizationGatewayServiceClient is the client API for DynamicAuthorizationGatewayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DynamicAuthorizationGatewayServiceClient interface {
+	// Disconnect sends Disconnect-Request for the subscriber's active session
+	Disconnect(ctx context.Context, in *DynamicAuthorizationRequest, opts ...grpc.CallOption) (*DynamicAuthorizationAnswer, error)
+	// Reauthorize sends CoA-Request for the subscriber's active session
+	Reauthorize(ctx context.Context, in *DynamicAuthorizationRequest, opts ...grpc.CallOption) (*DynamicAuthorizationAnswer, error)
+}
+
+type dynamicAuthorizationGatewayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDynamicAuthorizationGatewayServiceClient(cc grpc.ClientConnInterface) DynamicAuthorizationGatewayServiceClient {
+	return &dynamicAuthorizationGatewayServiceClient{cc}
+}
+
+func (c *dynamicAuthorizationGatewayServiceClient) Disconnect(ctx context.Context, in *DynamicAuthorizationRequest, opts ...grpc.CallOption) (*DynamicAuthorizationAnswer, error) {
+	out := new(DynamicAuthorizationAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.DynamicAuthorizationGatewayService/Disconnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dynamicAuthorizationGatewayServiceClient) Reauthorize(ctx context.Context, in *DynamicAuthorizationRequest, opts ...grpc.CallOption) (*DynamicAuthorizationAnswer, error) {
+	out := new(DynamicAuthorizationAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.DynamicAuthorizationGatewayService/Reauthorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DynamicAuthorizationGatewayServiceServer is the server API for DynamicAuthorizationGatewayService service.
+type DynamicAuthorizationGatewayServiceServer interface {
+	// Disconnect sends Disconnect-Request for the subscriber's active session
+	Disconnect(context.Context, *DynamicAuthorizationRequest) (*DynamicAuthorizationAnswer, error)
+	// Reauthorize sends CoA-Request for the subscriber's active session
+	Reauthorize(context.Context, *DynamicAuthorizationRequest) (*DynamicAuthorizationAnswer, error)
+}
+
+// UnimplementedDynamicAuthorizationGatewayServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDynamicAuthorizationGatewayServiceServer struct {
+}
+
+func (*UnimplementedDynamicAuthorizationGatewayServiceServer) Disconnect(context.Context, *DynamicAuthorizationRequest) (*DynamicAuthorizationAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (*UnimplementedDynamicAuthorizationGatewayServiceServer) Reauthorize(context.Context, *DynamicAuthorizationRequest) (*DynamicAuthorizationAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reauthorize not implemented")
+}
+
+func RegisterDynamicAuthorizationGatewayServiceServer(s *grpc.Server, srv DynamicAuthorizationGatewayServiceServer) {
+	s.RegisterService(&_DynamicAuthorizationGatewayService_serviceDesc, srv)
+}
+
+func _DynamicAuthorizationGatewayService_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamicAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DynamicAuthorizationGatewayServiceServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.DynamicAuthorizationGatewayService/Disconnect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DynamicAuthorizationGatewayServiceServer).Disconnect(ctx, req.(*DynamicAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DynamicAuthorizationGatewayService_Reauthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamicAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DynamicAuthorizationGatewayServiceServer).Reauthorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.DynamicAuthorizationGatewayService/Reauthorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DynamicAuthorizationGatewayServiceServer).Reauthorize(ctx, req.(*DynamicAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DynamicAuthorizationGatewayService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.feg.DynamicAuthorizationGatewayService",
+	HandlerType: (*DynamicAuthorizationGatewayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Disconnect",
+			Handler:    _DynamicAuthorizationGatewayService_Disconnect_Handler,
+		},
+		{
+			MethodName: "Reauthorize",
+			Handler:    _DynamicAuthorizationGatewayService_Reauthorize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feg/protos/dynamic_authorization.proto",
+}
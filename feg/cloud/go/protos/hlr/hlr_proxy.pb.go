@@ -167,7 +167,7 @@ type AuthInfoReq struct {
 	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
 	// Number of umts vectors to request in response
 	NumRequestedUmtsVectors uint32 `protobuf:"varint,2,opt,name=num_requested_umts_vectors,json=numRequestedUmtsVectors,proto3" json:"num_requested_umts_vectors,omitempty"`
-	// ResyncInfo containing RAND and AUTS in the case of a resync attach
+	//ResyncInfo containing RAND and AUTS in the case of a resync attach
 	ResyncInfo *AuthInfoReq_ResyncInfo `protobuf:"bytes,3,opt,name=resync_info,json=resyncInfo,proto3" json:"resync_info,omitempty"`
 }
 
@@ -282,6 +282,131 @@ func (x *AuthInfoAns) GetUmtsVectors() []*AuthInfoAns_UMTSVector {
 	return nil
 }
 
+// Update GPRS Location Request (MAP 29.002 section 8.1.7)
+type UpdateGprsLocationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// ISDN number of the serving node, overrides the configured SGSN number if set
+	SgsnNumber string `protobuf:"bytes,2,opt,name=sgsn_number,json=sgsnNumber,proto3" json:"sgsn_number,omitempty"`
+	// IP address of the serving node, overrides the configured SGSN address if set
+	SgsnAddress []byte `protobuf:"bytes,3,opt,name=sgsn_address,json=sgsnAddress,proto3" json:"sgsn_address,omitempty"`
+}
+
+func (x *UpdateGprsLocationReq) Reset() {
+	*x = UpdateGprsLocationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGprsLocationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGprsLocationReq) ProtoMessage() {}
+
+func (x *UpdateGprsLocationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGprsLocationReq.ProtoReflect.Descriptor instead.
+func (*UpdateGprsLocationReq) Descriptor() ([]byte, []int) {
+	return file_feg_protos_hlr_hlr_proxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateGprsLocationReq) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UpdateGprsLocationReq) GetSgsnNumber() string {
+	if x != nil {
+		return x.SgsnNumber
+	}
+	return ""
+}
+
+func (x *UpdateGprsLocationReq) GetSgsnAddress() []byte {
+	if x != nil {
+		return x.SgsnAddress
+	}
+	return nil
+}
+
+// Update GPRS Location Answer (MAP 29.002 section 8.1.7)
+type UpdateGprsLocationAns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EPC error code on failure
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.hlr.ErrorCode" json:"error_code,omitempty"`
+	// ISDN number of the HLR serving the subscriber
+	HlrNumber string `protobuf:"bytes,2,opt,name=hlr_number,json=hlrNumber,proto3" json:"hlr_number,omitempty"`
+}
+
+func (x *UpdateGprsLocationAns) Reset() {
+	*x = UpdateGprsLocationAns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGprsLocationAns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGprsLocationAns) ProtoMessage() {}
+
+func (x *UpdateGprsLocationAns) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGprsLocationAns.ProtoReflect.Descriptor instead.
+func (*UpdateGprsLocationAns) Descriptor() ([]byte, []int) {
+	return file_feg_protos_hlr_hlr_proxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateGprsLocationAns) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_SUCCESS
+}
+
+func (x *UpdateGprsLocationAns) GetHlrNumber() string {
+	if x != nil {
+		return x.HlrNumber
+	}
+	return ""
+}
+
 type AuthInfoReq_ResyncInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -294,7 +419,7 @@ type AuthInfoReq_ResyncInfo struct {
 func (x *AuthInfoReq_ResyncInfo) Reset() {
 	*x = AuthInfoReq_ResyncInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[2]
+		mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -307,7 +432,7 @@ func (x *AuthInfoReq_ResyncInfo) String() string {
 func (*AuthInfoReq_ResyncInfo) ProtoMessage() {}
 
 func (x *AuthInfoReq_ResyncInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[2]
+	mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +478,7 @@ type AuthInfoAns_UMTSVector struct {
 func (x *AuthInfoAns_UMTSVector) Reset() {
 	*x = AuthInfoAns_UMTSVector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[3]
+		mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -366,7 +491,7 @@ func (x *AuthInfoAns_UMTSVector) String() string {
 func (*AuthInfoAns_UMTSVector) ProtoMessage() {}
 
 func (x *AuthInfoAns_UMTSVector) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[3]
+	mi := &file_feg_protos_hlr_hlr_proxy_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,25 +581,46 @@ var file_feg_protos_hlr_hlr_proxy_proto_rawDesc = []byte{
 	0x04, 0x78, 0x72, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0c, 0x52, 0x02, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0c, 0x52, 0x02, 0x69, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6e, 0x2a, 0xd0, 0x01, 0x0a, 0x09, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45,
-	0x53, 0x53, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54,
-	0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41,
-	0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
-	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x54, 0x48,
-	0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a,
-	0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49,
-	0x42, 0x45, 0x52, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x50, 0x41, 0x54, 0x48,
-	0x5f, 0x54, 0x4f, 0x5f, 0x48, 0x4c, 0x52, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x4f, 0x5f,
-	0x48, 0x4c, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x53, 0x50,
-	0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x07, 0x32, 0x50, 0x0a, 0x08,
-	0x48, 0x6c, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x44, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x68, 0x6c, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x68, 0x6c, 0x72,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x6e, 0x73, 0x22, 0x00, 0x42, 0x1f,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6e, 0x22, 0x78, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x70, 0x72, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x67, 0x73, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x67, 0x73, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x67, 0x73, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x67, 0x73, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x70, 0x72,
+	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x68, 0x6c, 0x72,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6c, 0x72, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6c, 0x72, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x2a, 0xd0, 0x01, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12,
+	0x15, 0x0a, 0x11, 0x55, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e,
+	0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41,
+	0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x52, 0x10, 0x04,
+	0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x54, 0x4f, 0x5f, 0x48,
+	0x4c, 0x52, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x4f, 0x5f, 0x48, 0x4c, 0x52, 0x5f, 0x49,
+	0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x06,
+	0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x46, 0x52, 0x4f, 0x4d,
+	0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x07, 0x32, 0xb4, 0x01, 0x0a, 0x08, 0x48, 0x6c, 0x72, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x44, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x68, 0x6c, 0x72,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x68, 0x6c, 0x72, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x70, 0x72, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x68, 0x6c, 0x72,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x70, 0x72, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x68, 0x6c, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x70, 0x72,
+	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x22, 0x00, 0x42, 0x1f,
 	0x5a, 0x1d, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
 	0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x68, 0x6c, 0x72, 0x62,
 	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -493,26 +639,31 @@ func file_feg_protos_hlr_hlr_proxy_proto_rawDescGZIP() []byte {
 }
 
 var file_feg_protos_hlr_hlr_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_feg_protos_hlr_hlr_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_feg_protos_hlr_hlr_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_feg_protos_hlr_hlr_proxy_proto_goTypes = []interface{}{
 	(ErrorCode)(0),                  // 0: magma.feg.hlr.ErrorCode
 	(AuthInfoReq_ResyncInfo_Len)(0), // 1: magma.feg.hlr.AuthInfoReq.ResyncInfo.Len
 	(*AuthInfoReq)(nil),             // 2: magma.feg.hlr.AuthInfoReq
 	(*AuthInfoAns)(nil),             // 3: magma.feg.hlr.AuthInfoAns
-	(*AuthInfoReq_ResyncInfo)(nil),  // 4: magma.feg.hlr.AuthInfoReq.ResyncInfo
-	(*AuthInfoAns_UMTSVector)(nil),  // 5: magma.feg.hlr.AuthInfoAns.UMTSVector
+	(*UpdateGprsLocationReq)(nil),   // 4: magma.feg.hlr.UpdateGprsLocationReq
+	(*UpdateGprsLocationAns)(nil),   // 5: magma.feg.hlr.UpdateGprsLocationAns
+	(*AuthInfoReq_ResyncInfo)(nil),  // 6: magma.feg.hlr.AuthInfoReq.ResyncInfo
+	(*AuthInfoAns_UMTSVector)(nil),  // 7: magma.feg.hlr.AuthInfoAns.UMTSVector
 }
 var file_feg_protos_hlr_hlr_proxy_proto_depIdxs = []int32{
-	4, // 0: magma.feg.hlr.AuthInfoReq.resync_info:type_name -> magma.feg.hlr.AuthInfoReq.ResyncInfo
+	6, // 0: magma.feg.hlr.AuthInfoReq.resync_info:type_name -> magma.feg.hlr.AuthInfoReq.ResyncInfo
 	0, // 1: magma.feg.hlr.AuthInfoAns.error_code:type_name -> magma.feg.hlr.ErrorCode
-	5, // 2: magma.feg.hlr.AuthInfoAns.umts_vectors:type_name -> magma.feg.hlr.AuthInfoAns.UMTSVector
-	2, // 3: magma.feg.hlr.HlrProxy.AuthInfo:input_type -> magma.feg.hlr.AuthInfoReq
-	3, // 4: magma.feg.hlr.HlrProxy.AuthInfo:output_type -> magma.feg.hlr.AuthInfoAns
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	7, // 2: magma.feg.hlr.AuthInfoAns.umts_vectors:type_name -> magma.feg.hlr.AuthInfoAns.UMTSVector
+	0, // 3: magma.feg.hlr.UpdateGprsLocationAns.error_code:type_name -> magma.feg.hlr.ErrorCode
+	2, // 4: magma.feg.hlr.HlrProxy.AuthInfo:input_type -> magma.feg.hlr.AuthInfoReq
+	4, // 5: magma.feg.hlr.HlrProxy.UpdateGprsLocation:input_type -> magma.feg.hlr.UpdateGprsLocationReq
+	3, // 6: magma.feg.hlr.HlrProxy.AuthInfo:output_type -> magma.feg.hlr.AuthInfoAns
+	5, // 7: magma.feg.hlr.HlrProxy.UpdateGprsLocation:output_type -> magma.feg.hlr.UpdateGprsLocationAns
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_feg_protos_hlr_hlr_proxy_proto_init() }
@@ -546,7 +697,7 @@ func file_feg_protos_hlr_hlr_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_hlr_hlr_proxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthInfoReq_ResyncInfo); i {
+			switch v := v.(*UpdateGprsLocationReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -558,6 +709,30 @@ func file_feg_protos_hlr_hlr_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_hlr_hlr_proxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGprsLocationAns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_hlr_hlr_proxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthInfoReq_ResyncInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_hlr_hlr_proxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthInfoAns_UMTSVector); i {
 			case 0:
 				return &v.state
@@ -576,7 +751,7 @@ func file_feg_protos_hlr_hlr_proxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feg_protos_hlr_hlr_proxy_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -604,6 +779,7 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type HlrProxyClient interface {
 	AuthInfo(ctx context.Context, in *AuthInfoReq, opts ...grpc.CallOption) (*AuthInfoAns, error)
+	UpdateGprsLocation(ctx context.Context, in *UpdateGprsLocationReq, opts ...grpc.CallOption) (*UpdateGprsLocationAns, error)
 }
 
 type hlrProxyClient struct {
@@ -623,9 +799,19 @@ func (c *hlrProxyClient) AuthInfo(ctx context.Context, in *AuthInfoReq, opts ...
 	return out, nil
 }
 
+func (c *hlrProxyClient) UpdateGprsLocation(ctx context.Context, in *UpdateGprsLocationReq, opts ...grpc.CallOption) (*UpdateGprsLocationAns, error) {
+	out := new(UpdateGprsLocationAns)
+	err := c.cc.Invoke(ctx, "/magma.feg.hlr.HlrProxy/UpdateGprsLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HlrProxyServer is the server API for HlrProxy service.
 type HlrProxyServer interface {
 	AuthInfo(context.Context, *AuthInfoReq) (*AuthInfoAns, error)
+	UpdateGprsLocation(context.Context, *UpdateGprsLocationReq) (*UpdateGprsLocationAns, error)
 }
 
 // UnimplementedHlrProxyServer can be embedded to have forward compatible implementations.
@@ -635,6 +821,9 @@ type UnimplementedHlrProxyServer struct {
 func (*UnimplementedHlrProxyServer) AuthInfo(context.Context, *AuthInfoReq) (*AuthInfoAns, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthInfo not implemented")
 }
+func (*UnimplementedHlrProxyServer) UpdateGprsLocation(context.Context, *UpdateGprsLocationReq) (*UpdateGprsLocationAns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGprsLocation not implemented")
+}
 
 func RegisterHlrProxyServer(s *grpc.Server, srv HlrProxyServer) {
 	s.RegisterService(&_HlrProxy_serviceDesc, srv)
@@ -658,6 +847,24 @@ func _HlrProxy_AuthInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HlrProxy_UpdateGprsLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGprsLocationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HlrProxyServer).UpdateGprsLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.hlr.HlrProxy/UpdateGprsLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HlrProxyServer).UpdateGprsLocation(ctx, req.(*UpdateGprsLocationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HlrProxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.hlr.HlrProxy",
 	HandlerType: (*HlrProxyServer)(nil),
@@ -666,6 +873,10 @@ var _HlrProxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AuthInfo",
 			Handler:    _HlrProxy_AuthInfo_Handler,
 		},
+		{
+			MethodName: "UpdateGprsLocation",
+			Handler:    _HlrProxy_UpdateGprsLocation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/hlr/hlr_proxy.proto",
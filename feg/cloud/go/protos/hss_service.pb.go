@@ -46,44 +46,57 @@ var file_feg_protos_hss_service_proto_rawDesc = []byte{
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6c, 0x74, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
 	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xaf, 0x03, 0x0a, 0x0f, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
-	0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
-	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x1b,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x19,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x11,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x53, 0x65, 0x74, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x14, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44,
-	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66,
-	0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x66, 0x65, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
+	0x73, 0x36, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32,
+	0xca, 0x04, 0x0a, 0x0f, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49,
+	0x44, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1a, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x53, 0x65, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x14, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x49, 0x44, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f,
+	0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var file_feg_protos_hss_service_proto_goTypes = []interface{}{
-	(*protos.SubscriberData)(nil),   // 0: magma.lte.SubscriberData
-	(*protos.SubscriberID)(nil),     // 1: magma.lte.SubscriberID
-	(*protos.SubscriberUpdate)(nil), // 2: magma.lte.SubscriberUpdate
-	(*protos1.Void)(nil),            // 3: magma.orc8r.Void
-	(*protos.SubscriberIDSet)(nil),  // 4: magma.lte.SubscriberIDSet
+	(*protos.SubscriberData)(nil),       // 0: magma.lte.SubscriberData
+	(*protos.SubscriberID)(nil),         // 1: magma.lte.SubscriberID
+	(*protos.SubscriberUpdate)(nil),     // 2: magma.lte.SubscriberUpdate
+	(*protos1.Void)(nil),                // 3: magma.orc8r.Void
+	(*DeleteSubscriberDataRequest)(nil), // 4: magma.feg.DeleteSubscriberDataRequest
+	(*protos.SubscriberIDSet)(nil),      // 5: magma.lte.SubscriberIDSet
 }
 var file_feg_protos_hss_service_proto_depIdxs = []int32{
 	0, // 0: magma.feg.HSSConfigurator.AddSubscriber:input_type -> magma.lte.SubscriberData
@@ -92,14 +105,18 @@ var file_feg_protos_hss_service_proto_depIdxs = []int32{
 	1, // 3: magma.feg.HSSConfigurator.GetSubscriberData:input_type -> magma.lte.SubscriberID
 	3, // 4: magma.feg.HSSConfigurator.ListSubscribers:input_type -> magma.orc8r.Void
 	1, // 5: magma.feg.HSSConfigurator.DeregisterSubscriber:input_type -> magma.lte.SubscriberID
-	3, // 6: magma.feg.HSSConfigurator.AddSubscriber:output_type -> magma.orc8r.Void
-	3, // 7: magma.feg.HSSConfigurator.DeleteSubscriber:output_type -> magma.orc8r.Void
-	3, // 8: magma.feg.HSSConfigurator.UpdateSubscriber:output_type -> magma.orc8r.Void
-	0, // 9: magma.feg.HSSConfigurator.GetSubscriberData:output_type -> magma.lte.SubscriberData
-	4, // 10: magma.feg.HSSConfigurator.ListSubscribers:output_type -> magma.lte.SubscriberIDSet
-	3, // 11: magma.feg.HSSConfigurator.DeregisterSubscriber:output_type -> magma.orc8r.Void
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
+	1, // 6: magma.feg.HSSConfigurator.InsertSubscriberData:input_type -> magma.lte.SubscriberID
+	4, // 7: magma.feg.HSSConfigurator.DeleteSubscriberData:input_type -> magma.feg.DeleteSubscriberDataRequest
+	3, // 8: magma.feg.HSSConfigurator.AddSubscriber:output_type -> magma.orc8r.Void
+	3, // 9: magma.feg.HSSConfigurator.DeleteSubscriber:output_type -> magma.orc8r.Void
+	3, // 10: magma.feg.HSSConfigurator.UpdateSubscriber:output_type -> magma.orc8r.Void
+	0, // 11: magma.feg.HSSConfigurator.GetSubscriberData:output_type -> magma.lte.SubscriberData
+	5, // 12: magma.feg.HSSConfigurator.ListSubscribers:output_type -> magma.lte.SubscriberIDSet
+	3, // 13: magma.feg.HSSConfigurator.DeregisterSubscriber:output_type -> magma.orc8r.Void
+	3, // 14: magma.feg.HSSConfigurator.InsertSubscriberData:output_type -> magma.orc8r.Void
+	3, // 15: magma.feg.HSSConfigurator.DeleteSubscriberData:output_type -> magma.orc8r.Void
+	8, // [8:16] is the sub-list for method output_type
+	0, // [0:8] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -110,6 +127,7 @@ func file_feg_protos_hss_service_proto_init() {
 	if File_feg_protos_hss_service_proto != nil {
 		return
 	}
+	file_feg_protos_s6a_proxy_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -143,20 +161,33 @@ const _ = grpc.SupportPackageIsVersion6
 type HSSConfiguratorClient interface {
 	// Adds a new subscriber to the store.
 	// Throws ALREADY_EXISTS if the subscriber already exists.
+	//
 	AddSubscriber(ctx context.Context, in *protos.SubscriberData, opts ...grpc.CallOption) (*protos1.Void, error)
 	// Deletes an existing subscriber.
 	// If the subscriber is not already present, this request is ignored.
+	//
 	DeleteSubscriber(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error)
 	// Updates an existing subscriber.
 	// Throws NOT_FOUND if the subscriber is missing.
+	//
 	UpdateSubscriber(ctx context.Context, in *protos.SubscriberUpdate, opts ...grpc.CallOption) (*protos1.Void, error)
 	// Returns the SubscriberData for a subscriber.
 	// Throws NOT_FOUND if the subscriber is missing.
+	//
 	GetSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos.SubscriberData, error)
 	// List the subscribers in the store.
+	//
 	ListSubscribers(ctx context.Context, in *protos1.Void, opts ...grpc.CallOption) (*protos.SubscriberIDSet, error)
 	// De-register an authenticated subscriber
 	DeregisterSubscriber(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Push the subscriber's profile to the serving MME (S6a IDR)
+	// Throws NOT_FOUND if the subscriber is missing.
+	//
+	InsertSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Withdraw subscription data from the serving MME (S6a DSR)
+	// Throws NOT_FOUND if the subscriber is missing.
+	//
+	DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*protos1.Void, error)
 }
 
 type hSSConfiguratorClient struct {
@@ -221,24 +252,55 @@ func (c *hSSConfiguratorClient) DeregisterSubscriber(ctx context.Context, in *pr
 	return out, nil
 }
 
+func (c *hSSConfiguratorClient) InsertSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.HSSConfigurator/InsertSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hSSConfiguratorClient) DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.HSSConfigurator/DeleteSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HSSConfiguratorServer is the server API for HSSConfigurator service.
 type HSSConfiguratorServer interface {
 	// Adds a new subscriber to the store.
 	// Throws ALREADY_EXISTS if the subscriber already exists.
+	//
 	AddSubscriber(context.Context, *protos.SubscriberData) (*protos1.Void, error)
 	// Deletes an existing subscriber.
 	// If the subscriber is not already present, this request is ignored.
+	//
 	DeleteSubscriber(context.Context, *protos.SubscriberID) (*protos1.Void, error)
 	// Updates an existing subscriber.
 	// Throws NOT_FOUND if the subscriber is missing.
+	//
 	UpdateSubscriber(context.Context, *protos.SubscriberUpdate) (*protos1.Void, error)
 	// Returns the SubscriberData for a subscriber.
 	// Throws NOT_FOUND if the subscriber is missing.
+	//
 	GetSubscriberData(context.Context, *protos.SubscriberID) (*protos.SubscriberData, error)
 	// List the subscribers in the store.
+	//
 	ListSubscribers(context.Context, *protos1.Void) (*protos.SubscriberIDSet, error)
 	// De-register an authenticated subscriber
 	DeregisterSubscriber(context.Context, *protos.SubscriberID) (*protos1.Void, error)
+	// Push the subscriber's profile to the serving MME (S6a IDR)
+	// Throws NOT_FOUND if the subscriber is missing.
+	//
+	InsertSubscriberData(context.Context, *protos.SubscriberID) (*protos1.Void, error)
+	// Withdraw subscription data from the serving MME (S6a DSR)
+	// Throws NOT_FOUND if the subscriber is missing.
+	//
+	DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*protos1.Void, error)
 }
 
 // UnimplementedHSSConfiguratorServer can be embedded to have forward compatible implementations.
@@ -263,6 +325,12 @@ func (*UnimplementedHSSConfiguratorServer) ListSubscribers(context.Context, *pro
 func (*UnimplementedHSSConfiguratorServer) DeregisterSubscriber(context.Context, *protos.SubscriberID) (*protos1.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeregisterSubscriber not implemented")
 }
+func (*UnimplementedHSSConfiguratorServer) InsertSubscriberData(context.Context, *protos.SubscriberID) (*protos1.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertSubscriberData not implemented")
+}
+func (*UnimplementedHSSConfiguratorServer) DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*protos1.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscriberData not implemented")
+}
 
 func RegisterHSSConfiguratorServer(s *grpc.Server, srv HSSConfiguratorServer) {
 	s.RegisterService(&_HSSConfigurator_serviceDesc, srv)
@@ -376,6 +444,42 @@ func _HSSConfigurator_DeregisterSubscriber_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HSSConfigurator_InsertSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.SubscriberID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HSSConfiguratorServer).InsertSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.HSSConfigurator/InsertSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HSSConfiguratorServer).InsertSubscriberData(ctx, req.(*protos.SubscriberID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HSSConfigurator_DeleteSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HSSConfiguratorServer).DeleteSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.HSSConfigurator/DeleteSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HSSConfiguratorServer).DeleteSubscriberData(ctx, req.(*DeleteSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HSSConfigurator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.HSSConfigurator",
 	HandlerType: (*HSSConfiguratorServer)(nil),
@@ -404,6 +508,14 @@ var _HSSConfigurator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeregisterSubscriber",
 			Handler:    _HSSConfigurator_DeregisterSubscriber_Handler,
 		},
+		{
+			MethodName: "InsertSubscriberData",
+			Handler:    _HSSConfigurator_InsertSubscriberData_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriberData",
+			Handler:    _HSSConfigurator_DeleteSubscriberData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/hss_service.proto",
@@ -90,20 +90,23 @@ type DiamClientConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol          string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"` // tcp/sctp/...
-	Address           string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`   // server's host:port
-	Retransmits       uint32 `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
-	WatchdogInterval  uint32 `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
-	RetryCount        uint32 `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
-	LocalAddress      string `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"` // client's local address to bind socket to IP:port OR :port
-	ProductName       string `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Realm             string `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`                                                      // diameter realm
-	Host              string `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`                                                        // diameter host
-	DestRealm         string `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`                            // server diameter realm
-	DestHost          string `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`                               // server diameter host
-	DisableDestHost   bool   `protobuf:"varint,12,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`       // don't include dest_host AVP in diameter requests
-	OverwriteDestHost bool   `protobuf:"varint,13,opt,name=overwrite_dest_host,json=overwriteDestHost,proto3" json:"overwrite_dest_host,omitempty"` // overwrite dest_host AVP in diameter requests even if the message includes it
-	RequestTimeout    uint32 `protobuf:"varint,14,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`            // timeout to wait before ignore response
+	Protocol          string              `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"` // tcp/sctp/...
+	Address           string              `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`   // server's host:port
+	Retransmits       uint32              `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	WatchdogInterval  uint32              `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
+	RetryCount        uint32              `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LocalAddress      string              `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"` // client's local address to bind socket to IP:port OR :port
+	ProductName       string              `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Realm             string              `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`                                                      // diameter realm
+	Host              string              `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`                                                        // diameter host
+	DestRealm         string              `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`                            // server diameter realm
+	DestHost          string              `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`                               // server diameter host
+	DisableDestHost   bool                `protobuf:"varint,12,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`       // don't include dest_host AVP in diameter requests
+	OverwriteDestHost bool                `protobuf:"varint,13,opt,name=overwrite_dest_host,json=overwriteDestHost,proto3" json:"overwrite_dest_host,omitempty"` // overwrite dest_host AVP in diameter requests even if the message includes it
+	RequestTimeout    uint32              `protobuf:"varint,14,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`            // timeout to wait before ignore response
+	Priority          uint32              `protobuf:"varint,15,opt,name=priority,proto3" json:"priority,omitempty"`                                              // peer priority, lower value is preferred
+	Weight            uint32              `protobuf:"varint,16,opt,name=weight,proto3" json:"weight,omitempty"`                                                  // relative share of requests among peers of equal priority
+	Peers             []*DiamClientConfig `protobuf:"bytes,17,rep,name=peers,proto3" json:"peers,omitempty"`                                                     // alternate servers used for failover & load balancing
 }
 
 func (x *DiamClientConfig) Reset() {
@@ -236,6 +239,27 @@ func (x *DiamClientConfig) GetRequestTimeout() uint32 {
 	return 0
 }
 
+func (x *DiamClientConfig) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *DiamClientConfig) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *DiamClientConfig) GetPeers() []*DiamClientConfig {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
 type DiamServerConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -732,6 +756,8 @@ type SwxConfig struct {
 	HlrPlmnIds []string `protobuf:"bytes,9,rep,name=hlr_plmn_ids,json=hlrPlmnIds,proto3" json:"hlr_plmn_ids,omitempty"`
 	// Server where SWx points to (can be one or more than one)
 	Servers []*DiamClientConfig `protobuf:"bytes,10,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Persistence of cached authentication vectors
+	CachePersistence *SwxCachePersistence `protobuf:"bytes,11,opt,name=cache_persistence,json=cachePersistence,proto3" json:"cache_persistence,omitempty"`
 }
 
 func (x *SwxConfig) Reset() {
@@ -836,6 +862,88 @@ func (x *SwxConfig) GetServers() []*DiamClientConfig {
 	return nil
 }
 
+func (x *SwxConfig) GetCachePersistence() *SwxCachePersistence {
+	if x != nil {
+		return x.CachePersistence
+	}
+	return nil
+}
+
+type SwxCachePersistence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// none (default), redis or file
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// vectors file of the file backend
+	FilePath string `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	// redis hash of the redis backend
+	RedisHash string `protobuf:"bytes,3,opt,name=redis_hash,json=redisHash,proto3" json:"redis_hash,omitempty"`
+	// load persisted vectors into the cache on startup
+	WarmUp bool `protobuf:"varint,4,opt,name=warm_up,json=warmUp,proto3" json:"warm_up,omitempty"`
+}
+
+func (x *SwxCachePersistence) Reset() {
+	*x = SwxCachePersistence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwxCachePersistence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwxCachePersistence) ProtoMessage() {}
+
+func (x *SwxCachePersistence) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwxCachePersistence.ProtoReflect.Descriptor instead.
+func (*SwxCachePersistence) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SwxCachePersistence) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *SwxCachePersistence) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *SwxCachePersistence) GetRedisHash() string {
+	if x != nil {
+		return x.RedisHash
+	}
+	return ""
+}
+
+func (x *SwxCachePersistence) GetWarmUp() bool {
+	if x != nil {
+		return x.WarmUp
+	}
+	return false
+}
+
 type EapAkaConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -851,7 +959,7 @@ type EapAkaConfig struct {
 func (x *EapAkaConfig) Reset() {
 	*x = EapAkaConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[8]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -864,7 +972,7 @@ func (x *EapAkaConfig) String() string {
 func (*EapAkaConfig) ProtoMessage() {}
 
 func (x *EapAkaConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[8]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +985,7 @@ func (x *EapAkaConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EapAkaConfig.ProtoReflect.Descriptor instead.
 func (*EapAkaConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{8}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EapAkaConfig) GetLogLevel() protos.LogLevel {
@@ -932,7 +1040,7 @@ type EapProviderTimeouts struct {
 func (x *EapProviderTimeouts) Reset() {
 	*x = EapProviderTimeouts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[9]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -945,7 +1053,7 @@ func (x *EapProviderTimeouts) String() string {
 func (*EapProviderTimeouts) ProtoMessage() {}
 
 func (x *EapProviderTimeouts) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[9]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1066,7 @@ func (x *EapProviderTimeouts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EapProviderTimeouts.ProtoReflect.Descriptor instead.
 func (*EapProviderTimeouts) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{9}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EapProviderTimeouts) GetChallengeMs() uint32 {
@@ -1004,7 +1112,7 @@ type EapSimConfig struct {
 func (x *EapSimConfig) Reset() {
 	*x = EapSimConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[10]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1017,7 +1125,7 @@ func (x *EapSimConfig) String() string {
 func (*EapSimConfig) ProtoMessage() {}
 
 func (x *EapSimConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[10]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1138,7 @@ func (x *EapSimConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EapSimConfig.ProtoReflect.Descriptor instead.
 func (*EapSimConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{10}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EapSimConfig) GetLogLevel() protos.LogLevel {
@@ -1068,6 +1176,87 @@ func (x *EapSimConfig) GetMncLen() int32 {
 	return 0
 }
 
+type EapAkaPrimeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogLevel protos.LogLevel      `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=magma.orc8r.LogLevel" json:"log_level,omitempty"`
+	Timeout  *EapProviderTimeouts `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	PlmnIds  []string             `protobuf:"bytes,3,rep,name=PlmnIds,proto3" json:"PlmnIds,omitempty"`
+	// access network name used for CK'/IK' derivation & AT_KDF_INPUT
+	NetworkName string `protobuf:"bytes,4,opt,name=NetworkName,proto3" json:"NetworkName,omitempty"`
+	// derive CK'/IK' locally from CK/IK returned by HSS
+	DeriveCkIkPrime bool `protobuf:"varint,5,opt,name=DeriveCkIkPrime,proto3" json:"DeriveCkIkPrime,omitempty"`
+}
+
+func (x *EapAkaPrimeConfig) Reset() {
+	*x = EapAkaPrimeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EapAkaPrimeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapAkaPrimeConfig) ProtoMessage() {}
+
+func (x *EapAkaPrimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapAkaPrimeConfig.ProtoReflect.Descriptor instead.
+func (*EapAkaPrimeConfig) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EapAkaPrimeConfig) GetLogLevel() protos.LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return protos.LogLevel(0)
+}
+
+func (x *EapAkaPrimeConfig) GetTimeout() *EapProviderTimeouts {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *EapAkaPrimeConfig) GetPlmnIds() []string {
+	if x != nil {
+		return x.PlmnIds
+	}
+	return nil
+}
+
+func (x *EapAkaPrimeConfig) GetNetworkName() string {
+	if x != nil {
+		return x.NetworkName
+	}
+	return ""
+}
+
+func (x *EapAkaPrimeConfig) GetDeriveCkIkPrime() bool {
+	if x != nil {
+		return x.DeriveCkIkPrime
+	}
+	return false
+}
+
 type AAAConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1085,12 +1274,18 @@ type AAAConfig struct {
 	RadiusConfig        *RadiusConfig `protobuf:"bytes,6,opt,name=RadiusConfig,proto3" json:"RadiusConfig,omitempty"`
 	// Enable accounting reporting to the module's orc8r service
 	AcctReportingEnabled bool `protobuf:"varint,7,opt,name=AcctReportingEnabled,proto3" json:"AcctReportingEnabled,omitempty"`
+	// EAP-TLS & EAP-TTLS providers configuration
+	EapTlsConfig *EapTlsConfig `protobuf:"bytes,8,opt,name=EapTlsConfig,proto3" json:"EapTlsConfig,omitempty"`
+	// Session table backend configuration
+	SessionStore *AAASessionStore `protobuf:"bytes,9,opt,name=SessionStore,proto3" json:"SessionStore,omitempty"`
+	// Accounting records (CDR) configuration
+	AcctRecords *AAAAcctRecords `protobuf:"bytes,10,opt,name=AcctRecords,proto3" json:"AcctRecords,omitempty"`
 }
 
 func (x *AAAConfig) Reset() {
 	*x = AAAConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1103,7 +1298,7 @@ func (x *AAAConfig) String() string {
 func (*AAAConfig) ProtoMessage() {}
 
 func (x *AAAConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1311,7 @@ func (x *AAAConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AAAConfig.ProtoReflect.Descriptor instead.
 func (*AAAConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{11}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AAAConfig) GetLogLevel() protos.LogLevel {
@@ -1128,44 +1323,335 @@ func (x *AAAConfig) GetLogLevel() protos.LogLevel {
 
 func (x *AAAConfig) GetIdleSessionTimeoutMs() uint32 {
 	if x != nil {
-		return x.IdleSessionTimeoutMs
+		return x.IdleSessionTimeoutMs
+	}
+	return 0
+}
+
+func (x *AAAConfig) GetAccountingEnabled() bool {
+	if x != nil {
+		return x.AccountingEnabled
+	}
+	return false
+}
+
+func (x *AAAConfig) GetCreateSessionOnAuth() bool {
+	if x != nil {
+		return x.CreateSessionOnAuth
+	}
+	return false
+}
+
+func (x *AAAConfig) GetEventLoggingEnabled() bool {
+	if x != nil {
+		return x.EventLoggingEnabled
+	}
+	return false
+}
+
+func (x *AAAConfig) GetRadiusConfig() *RadiusConfig {
+	if x != nil {
+		return x.RadiusConfig
+	}
+	return nil
+}
+
+func (x *AAAConfig) GetAcctReportingEnabled() bool {
+	if x != nil {
+		return x.AcctReportingEnabled
+	}
+	return false
+}
+
+func (x *AAAConfig) GetEapTlsConfig() *EapTlsConfig {
+	if x != nil {
+		return x.EapTlsConfig
+	}
+	return nil
+}
+
+func (x *AAAConfig) GetSessionStore() *AAASessionStore {
+	if x != nil {
+		return x.SessionStore
+	}
+	return nil
+}
+
+func (x *AAAConfig) GetAcctRecords() *AAAAcctRecords {
+	if x != nil {
+		return x.AcctRecords
+	}
+	return nil
+}
+
+type AAASessionStore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Session table backend: memory (default) or redis
+	Backend string `protobuf:"bytes,1,opt,name=Backend,proto3" json:"Backend,omitempty"`
+	// Redis hash of the redis backend, empty - use default (aaa_sessions)
+	RedisHash string `protobuf:"bytes,2,opt,name=RedisHash,proto3" json:"RedisHash,omitempty"`
+}
+
+func (x *AAASessionStore) Reset() {
+	*x = AAASessionStore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AAASessionStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AAASessionStore) ProtoMessage() {}
+
+func (x *AAASessionStore) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AAASessionStore.ProtoReflect.Descriptor instead.
+func (*AAASessionStore) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AAASessionStore) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *AAASessionStore) GetRedisHash() string {
+	if x != nil {
+		return x.RedisHash
+	}
+	return ""
+}
+
+type AAAAcctRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Record file format: csv, json or 3gpp, empty - record files are disabled
+	Format string `protobuf:"bytes,1,opt,name=Format,proto3" json:"Format,omitempty"`
+	// Directory of the record files, empty - use default (/var/opt/magma/aaa_records)
+	Directory string `protobuf:"bytes,2,opt,name=Directory,proto3" json:"Directory,omitempty"`
+	// Rotate the record file when it exceeds the size, 0 - use default (10240KB)
+	MaxFileSizeKb uint32 `protobuf:"varint,3,opt,name=MaxFileSizeKb,proto3" json:"MaxFileSizeKb,omitempty"`
+	// Rotate the record file when it is older than the interval, 0 - use default (3600 sec)
+	RotationIntervalSec uint32 `protobuf:"varint,4,opt,name=RotationIntervalSec,proto3" json:"RotationIntervalSec,omitempty"`
+	// Number of rotated record files to keep, 0 - keep all
+	MaxFiles uint32 `protobuf:"varint,5,opt,name=MaxFiles,proto3" json:"MaxFiles,omitempty"`
+	// Forward the records to the cloud base_acct service
+	ForwardToCloud bool `protobuf:"varint,6,opt,name=ForwardToCloud,proto3" json:"ForwardToCloud,omitempty"`
+}
+
+func (x *AAAAcctRecords) Reset() {
+	*x = AAAAcctRecords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AAAAcctRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AAAAcctRecords) ProtoMessage() {}
+
+func (x *AAAAcctRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AAAAcctRecords.ProtoReflect.Descriptor instead.
+func (*AAAAcctRecords) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AAAAcctRecords) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *AAAAcctRecords) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *AAAAcctRecords) GetMaxFileSizeKb() uint32 {
+	if x != nil {
+		return x.MaxFileSizeKb
+	}
+	return 0
+}
+
+func (x *AAAAcctRecords) GetRotationIntervalSec() uint32 {
+	if x != nil {
+		return x.RotationIntervalSec
+	}
+	return 0
+}
+
+func (x *AAAAcctRecords) GetMaxFiles() uint32 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *AAAAcctRecords) GetForwardToCloud() bool {
+	if x != nil {
+		return x.ForwardToCloud
+	}
+	return false
+}
+
+type EapTlsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM encoded CA certificate(s) file used to verify peer certificates
+	CaCertFile string `protobuf:"bytes,1,opt,name=CaCertFile,proto3" json:"CaCertFile,omitempty"`
+	// PEM encoded server certificate chain file
+	ServerCertFile string `protobuf:"bytes,2,opt,name=ServerCertFile,proto3" json:"ServerCertFile,omitempty"`
+	// PEM encoded server private key file
+	ServerKeyFile string `protobuf:"bytes,3,opt,name=ServerKeyFile,proto3" json:"ServerKeyFile,omitempty"`
+	// Max size of TLS data in a single EAP-TLS/TTLS fragment, 0 - use default (1024)
+	FragmentSize uint32 `protobuf:"varint,4,opt,name=FragmentSize,proto3" json:"FragmentSize,omitempty"`
+	// Enable TLS session resumption (session tickets)
+	SessionResumptionEnabled bool `protobuf:"varint,5,opt,name=SessionResumptionEnabled,proto3" json:"SessionResumptionEnabled,omitempty"`
+	// Require peer certificate for EAP-TTLS (EAP-TLS always requires it)
+	TtlsRequireClientCert bool `protobuf:"varint,6,opt,name=TtlsRequireClientCert,proto3" json:"TtlsRequireClientCert,omitempty"`
+	// Allowed EAP-TTLS inner authentication methods: PAP, MSCHAPV2. Empty - allow all
+	TtlsInnerMethods []string `protobuf:"bytes,7,rep,name=TtlsInnerMethods,proto3" json:"TtlsInnerMethods,omitempty"`
+	// EAP-TTLS inner method credential store type: file
+	CredentialStore string `protobuf:"bytes,8,opt,name=CredentialStore,proto3" json:"CredentialStore,omitempty"`
+	// Credential store file path (for file based store)
+	CredentialsFile string `protobuf:"bytes,9,opt,name=CredentialsFile,proto3" json:"CredentialsFile,omitempty"`
+}
+
+func (x *EapTlsConfig) Reset() {
+	*x = EapTlsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EapTlsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapTlsConfig) ProtoMessage() {}
+
+func (x *EapTlsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapTlsConfig.ProtoReflect.Descriptor instead.
+func (*EapTlsConfig) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EapTlsConfig) GetCaCertFile() string {
+	if x != nil {
+		return x.CaCertFile
+	}
+	return ""
+}
+
+func (x *EapTlsConfig) GetServerCertFile() string {
+	if x != nil {
+		return x.ServerCertFile
+	}
+	return ""
+}
+
+func (x *EapTlsConfig) GetServerKeyFile() string {
+	if x != nil {
+		return x.ServerKeyFile
+	}
+	return ""
+}
+
+func (x *EapTlsConfig) GetFragmentSize() uint32 {
+	if x != nil {
+		return x.FragmentSize
 	}
 	return 0
 }
 
-func (x *AAAConfig) GetAccountingEnabled() bool {
+func (x *EapTlsConfig) GetSessionResumptionEnabled() bool {
 	if x != nil {
-		return x.AccountingEnabled
+		return x.SessionResumptionEnabled
 	}
 	return false
 }
 
-func (x *AAAConfig) GetCreateSessionOnAuth() bool {
+func (x *EapTlsConfig) GetTtlsRequireClientCert() bool {
 	if x != nil {
-		return x.CreateSessionOnAuth
+		return x.TtlsRequireClientCert
 	}
 	return false
 }
 
-func (x *AAAConfig) GetEventLoggingEnabled() bool {
+func (x *EapTlsConfig) GetTtlsInnerMethods() []string {
 	if x != nil {
-		return x.EventLoggingEnabled
+		return x.TtlsInnerMethods
 	}
-	return false
+	return nil
 }
 
-func (x *AAAConfig) GetRadiusConfig() *RadiusConfig {
+func (x *EapTlsConfig) GetCredentialStore() string {
 	if x != nil {
-		return x.RadiusConfig
+		return x.CredentialStore
 	}
-	return nil
+	return ""
 }
 
-func (x *AAAConfig) GetAcctReportingEnabled() bool {
+func (x *EapTlsConfig) GetCredentialsFile() string {
 	if x != nil {
-		return x.AcctReportingEnabled
+		return x.CredentialsFile
 	}
-	return false
+	return ""
 }
 
 type RadiusConfig struct {
@@ -1188,7 +1674,7 @@ type RadiusConfig struct {
 func (x *RadiusConfig) Reset() {
 	*x = RadiusConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1201,7 +1687,7 @@ func (x *RadiusConfig) String() string {
 func (*RadiusConfig) ProtoMessage() {}
 
 func (x *RadiusConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1700,7 @@ func (x *RadiusConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RadiusConfig.ProtoReflect.Descriptor instead.
 func (*RadiusConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{12}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RadiusConfig) GetSecret() []byte {
@@ -1267,7 +1753,7 @@ type GatewayHealthConfig struct {
 func (x *GatewayHealthConfig) Reset() {
 	*x = GatewayHealthConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1280,7 +1766,7 @@ func (x *GatewayHealthConfig) String() string {
 func (*GatewayHealthConfig) ProtoMessage() {}
 
 func (x *GatewayHealthConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1779,7 @@ func (x *GatewayHealthConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayHealthConfig.ProtoReflect.Descriptor instead.
 func (*GatewayHealthConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{13}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GatewayHealthConfig) GetRequiredServices() []string {
@@ -1352,7 +1838,7 @@ type HSSConfig struct {
 func (x *HSSConfig) Reset() {
 	*x = HSSConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1365,7 +1851,7 @@ func (x *HSSConfig) String() string {
 func (*HSSConfig) ProtoMessage() {}
 
 func (x *HSSConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1864,7 @@ func (x *HSSConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HSSConfig.ProtoReflect.Descriptor instead.
 func (*HSSConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{14}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *HSSConfig) GetServer() *DiamServerConfig {
@@ -1441,7 +1927,7 @@ type RadiusdConfig struct {
 func (x *RadiusdConfig) Reset() {
 	*x = RadiusdConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1454,7 +1940,7 @@ func (x *RadiusdConfig) String() string {
 func (*RadiusdConfig) ProtoMessage() {}
 
 func (x *RadiusdConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1467,7 +1953,7 @@ func (x *RadiusdConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RadiusdConfig.ProtoReflect.Descriptor instead.
 func (*RadiusdConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{15}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RadiusdConfig) GetRadiusMetricsPort() uint32 {
@@ -1510,7 +1996,7 @@ type SCTPClientConfig struct {
 func (x *SCTPClientConfig) Reset() {
 	*x = SCTPClientConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1523,7 +2009,7 @@ func (x *SCTPClientConfig) String() string {
 func (*SCTPClientConfig) ProtoMessage() {}
 
 func (x *SCTPClientConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +2022,7 @@ func (x *SCTPClientConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SCTPClientConfig.ProtoReflect.Descriptor instead.
 func (*SCTPClientConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{16}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SCTPClientConfig) GetServerAddress() string {
@@ -1565,7 +2051,7 @@ type CsfbConfig struct {
 func (x *CsfbConfig) Reset() {
 	*x = CsfbConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1578,7 +2064,7 @@ func (x *CsfbConfig) String() string {
 func (*CsfbConfig) ProtoMessage() {}
 
 func (x *CsfbConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1591,7 +2077,7 @@ func (x *CsfbConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CsfbConfig.ProtoReflect.Descriptor instead.
 func (*CsfbConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{17}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CsfbConfig) GetLogLevel() protos.LogLevel {
@@ -1619,7 +2105,7 @@ type EnvoyControllerConfig struct {
 func (x *EnvoyControllerConfig) Reset() {
 	*x = EnvoyControllerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1632,7 +2118,7 @@ func (x *EnvoyControllerConfig) String() string {
 func (*EnvoyControllerConfig) ProtoMessage() {}
 
 func (x *EnvoyControllerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,7 +2131,7 @@ func (x *EnvoyControllerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvoyControllerConfig.ProtoReflect.Descriptor instead.
 func (*EnvoyControllerConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{18}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *EnvoyControllerConfig) GetLogLevel() protos.LogLevel {
@@ -1673,7 +2159,7 @@ type S8Config struct {
 func (x *S8Config) Reset() {
 	*x = S8Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +2172,7 @@ func (x *S8Config) String() string {
 func (*S8Config) ProtoMessage() {}
 
 func (x *S8Config) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +2185,7 @@ func (x *S8Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S8Config.ProtoReflect.Descriptor instead.
 func (*S8Config) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{19}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *S8Config) GetLogLevel() protos.LogLevel {
@@ -1762,7 +2248,7 @@ type SbiServerConfig struct {
 func (x *SbiServerConfig) Reset() {
 	*x = SbiServerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1775,7 +2261,7 @@ func (x *SbiServerConfig) String() string {
 func (*SbiServerConfig) ProtoMessage() {}
 
 func (x *SbiServerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,7 +2274,7 @@ func (x *SbiServerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SbiServerConfig.ProtoReflect.Descriptor instead.
 func (*SbiServerConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{20}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SbiServerConfig) GetApiRoot() string {
@@ -1833,7 +2319,7 @@ type N7ClientConfig struct {
 func (x *N7ClientConfig) Reset() {
 	*x = N7ClientConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1846,7 +2332,7 @@ func (x *N7ClientConfig) String() string {
 func (*N7ClientConfig) ProtoMessage() {}
 
 func (x *N7ClientConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,7 +2345,7 @@ func (x *N7ClientConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7ClientConfig.ProtoReflect.Descriptor instead.
 func (*N7ClientConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{21}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *N7ClientConfig) GetLocalAddr() string {
@@ -1892,7 +2378,7 @@ type N7Config struct {
 func (x *N7Config) Reset() {
 	*x = N7Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1905,7 +2391,7 @@ func (x *N7Config) String() string {
 func (*N7Config) ProtoMessage() {}
 
 func (x *N7Config) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2404,7 @@ func (x *N7Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7Config.ProtoReflect.Descriptor instead.
 func (*N7Config) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{22}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *N7Config) GetDisableN7() bool {
@@ -1942,6 +2428,72 @@ func (x *N7Config) GetClient() *N7ClientConfig {
 	return nil
 }
 
+type N40Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disables N40 interface
+	DisableN40 bool `protobuf:"varint,1,opt,name=disable_n40,json=disableN40,proto3" json:"disable_n40,omitempty"`
+	// CHF configuration
+	Server *SbiServerConfig `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	// N40 consumer config for handling notifications
+	Client *N7ClientConfig `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+}
+
+func (x *N40Config) Reset() {
+	*x = N40Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *N40Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*N40Config) ProtoMessage() {}
+
+func (x *N40Config) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use N40Config.ProtoReflect.Descriptor instead.
+func (*N40Config) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *N40Config) GetDisableN40() bool {
+	if x != nil {
+		return x.DisableN40
+	}
+	return false
+}
+
+func (x *N40Config) GetServer() *SbiServerConfig {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *N40Config) GetClient() *N7ClientConfig {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
 type N7N40ProxyConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1955,12 +2507,14 @@ type N7N40ProxyConfig struct {
 	RequestFailureThreshold float32 `protobuf:"fixed32,3,opt,name=request_failure_threshold,json=requestFailureThreshold,proto3" json:"request_failure_threshold,omitempty"`
 	// Minimum number of requests necessary to consider a metrics snapshot valid
 	MinimumRequestThreshold uint32 `protobuf:"varint,4,opt,name=minimum_request_threshold,json=minimumRequestThreshold,proto3" json:"minimum_request_threshold,omitempty"`
+	// N40 Interface configuration
+	N40Config *N40Config `protobuf:"bytes,5,opt,name=n40_config,json=n40Config,proto3" json:"n40_config,omitempty"`
 }
 
 func (x *N7N40ProxyConfig) Reset() {
 	*x = N7N40ProxyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1973,7 +2527,7 @@ func (x *N7N40ProxyConfig) String() string {
 func (*N7N40ProxyConfig) ProtoMessage() {}
 
 func (x *N7N40ProxyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1986,7 +2540,7 @@ func (x *N7N40ProxyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7N40ProxyConfig.ProtoReflect.Descriptor instead.
 func (*N7N40ProxyConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{23}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *N7N40ProxyConfig) GetLogLevel() protos.LogLevel {
@@ -2017,6 +2571,13 @@ func (x *N7N40ProxyConfig) GetMinimumRequestThreshold() uint32 {
 	return 0
 }
 
+func (x *N7N40ProxyConfig) GetN40Config() *N40Config {
+	if x != nil {
+		return x.N40Config
+	}
+	return nil
+}
+
 type EapAkaConfig_Timeouts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2031,7 +2592,7 @@ type EapAkaConfig_Timeouts struct {
 func (x *EapAkaConfig_Timeouts) Reset() {
 	*x = EapAkaConfig_Timeouts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2044,7 +2605,7 @@ func (x *EapAkaConfig_Timeouts) String() string {
 func (*EapAkaConfig_Timeouts) ProtoMessage() {}
 
 func (x *EapAkaConfig_Timeouts) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2618,7 @@ func (x *EapAkaConfig_Timeouts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EapAkaConfig_Timeouts.ProtoReflect.Descriptor instead.
 func (*EapAkaConfig_Timeouts) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{8, 0}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *EapAkaConfig_Timeouts) GetChallengeMs() uint32 {
@@ -2102,7 +2663,7 @@ type HSSConfig_SubscriptionProfile struct {
 func (x *HSSConfig_SubscriptionProfile) Reset() {
 	*x = HSSConfig_SubscriptionProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2115,7 +2676,7 @@ func (x *HSSConfig_SubscriptionProfile) String() string {
 func (*HSSConfig_SubscriptionProfile) ProtoMessage() {}
 
 func (x *HSSConfig_SubscriptionProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,7 +2689,7 @@ func (x *HSSConfig_SubscriptionProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HSSConfig_SubscriptionProfile.ProtoReflect.Descriptor instead.
 func (*HSSConfig_SubscriptionProfile) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{14, 0}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *HSSConfig_SubscriptionProfile) GetMaxUlBitRate() uint64 {
@@ -2152,7 +2713,7 @@ var file_feg_protos_mconfig_mconfigs_proto_rawDesc = []byte{
 	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x1a, 0x19, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb, 0x03,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x04,
 	0x0a, 0x10, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18,
@@ -2183,366 +2744,472 @@ var file_feg_protos_mconfig_mconfigs_proto_rawDesc = []byte{
 	0x11, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x44, 0x65, 0x73, 0x74, 0x48, 0x6f,
 	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69,
 	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x10,
-	0x44, 0x69, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x74,
-	0x5f, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x8a, 0x02, 0x0a, 0x09, 0x53, 0x36, 0x61, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
-	0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a,
-	0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c,
-	0x6d, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50, 0x6c, 0x6d,
-	0x6e, 0x49, 0x64, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
-	0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x6e, 0x5f, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x6e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x6e, 0x5f, 0x6f, 0x76,
-	0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
-	0x70, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x1f, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1d, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68,
-	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x22, 0x8b, 0x02, 0x0a, 0x08, 0x47, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x76, 0x65,
-	0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x41, 0x70, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x41, 0x70, 0x6e, 0x12, 0x39, 0x0a,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44,
-	0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x47, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x47, 0x78, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
-	0x6c, 0x5f, 0x61, 0x70, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x22, 0xc9, 0x02, 0x0a, 0x08, 0x47, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37,
-	0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x35, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44,
 	0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x5f,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x79, 0x49,
-	0x6e, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69,
-	0x74, 0x65, 0x41, 0x70, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f, 0x76, 0x65,
-	0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x41, 0x70, 0x6e, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x47,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x47, 0x79, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x61, 0x70,
-	0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x76, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x92, 0x02,
-	0x0a, 0x12, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x02, 0x67, 0x78, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x02, 0x67,
-	0x78, 0x12, 0x27, 0x0a, 0x02, 0x67, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x79,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x02, 0x67, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
-	0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
-	0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x22, 0x8c, 0x04, 0x0a, 0x09, 0x53, 0x77, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x61, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x22, 0x8a, 0x02, 0x0a, 0x09, 0x53, 0x36, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
 	0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x31, 0x0a,
-	0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x28, 0x0a, 0x0f, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x54, 0x54, 0x4c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
-	0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
-	0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6f,
-	0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x17,
-	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64,
-	0x65, 0x72, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x61, 0x6c, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6c, 0x72, 0x5f, 0x70, 0x6c, 0x6d, 0x6e,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6c, 0x72, 0x50,
-	0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x73, 0x22, 0x83, 0x03, 0x0a, 0x0c, 0x45, 0x61, 0x70, 0x41, 0x6b, 0x61, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
-	0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x41, 0x6b, 0x61, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x07, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6e, 0x63, 0x4c,
-	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e,
-	0x1a, 0xb4, 0x01, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x12,
-	0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
-	0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x45, 0x61, 0x70, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d,
-	0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x12, 0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x45, 0x61,
-	0x70, 0x53, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f,
-	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3c,
-	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x45, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50,
-	0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x12, 0x16,
-	0x0a, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x41, 0x41, 0x41, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x49, 0x64, 0x6c, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x49, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x11,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x41, 0x75, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x13,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3f,
-	0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x32, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x41,
-	0x63, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x44, 0x41, 0x45, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x44, 0x41, 0x45, 0x41, 0x64, 0x64, 0x72, 0x22, 0xb0, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a,
-	0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12,
-	0x38, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x19, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x19, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x73, 0x22, 0xb4, 0x04, 0x0a, 0x09,
-	0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x3a, 0x0a,
+	0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x22,
+	0x9c, 0x01, 0x0a, 0x0e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x41, 0x70, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x6e, 0x4f, 0x76, 0x65,
+	0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x1f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1d, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x8b,
+	0x02, 0x0a, 0x08, 0x47, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x06, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x41, 0x70, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f, 0x76, 0x65, 0x72,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x41, 0x70, 0x6e, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x47,
+	0x78, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x6e,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xc9, 0x02, 0x0a,
+	0x08, 0x47, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6f,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x4f, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61,
-	0x6d, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x41, 0x6d, 0x66, 0x12, 0x4c, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x12, 0x5c, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x75,
-	0x62, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x11, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x1a,
-	0x63, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6c,
-	0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x6d, 0x61, 0x78, 0x55, 0x6c, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a,
-	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x6c, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6c, 0x42, 0x69, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x1a, 0x6c, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x0d, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x64, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
-	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x10, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x79, 0x0a, 0x0a, 0x43, 0x73, 0x66, 0x62, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x22, 0x4b, 0x0a, 0x15, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f,
-	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xac,
-	0x02, 0x0a, 0x08, 0x53, 0x38, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c,
+	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x41, 0x70, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x41, 0x70, 0x6e, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x79, 0x12, 0x49, 0x0a,
+	0x11, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x6e, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x41, 0x70, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x02, 0x67, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x47, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x02, 0x67, 0x78, 0x12, 0x27, 0x0a, 0x02,
+	0x67, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x02, 0x67, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xdd, 0x04,
+	0x0a, 0x09, 0x53, 0x77, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c,
 	0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
 	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67,
 	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x67, 0x77, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x70, 0x6e, 0x5f, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x61, 0x70, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x14, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x54, 0x4c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
 	0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
 	0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x8b, 0x01,
-	0x0a, 0x0f, 0x53, 0x62, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x57, 0x0a, 0x0e, 0x4e,
-	0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x0f,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x70, 0x69,
-	0x52, 0x6f, 0x6f, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x08, 0x4e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x37, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x37,
-	0x12, 0x36, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x53, 0x62, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22,
-	0xf4, 0x01, 0x0a, 0x10, 0x4e, 0x37, 0x4e, 0x34, 0x30, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x09, 0x6e, 0x37, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x6e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a,
-	0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69,
-	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d,
-	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x2a, 0x3a, 0x0a, 0x0c, 0x47, 0x79, 0x49, 0x6e, 0x69, 0x74,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53,
-	0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59,
-	0x10, 0x02, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
-	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a,
+	0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x72, 0x69, 0x76,
+	0x65, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12,
+	0x20, 0x0a, 0x0c, 0x68, 0x6c, 0x72, 0x5f, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6c, 0x72, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x11,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x77, 0x78, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x84, 0x01,
+	0x0a, 0x13, 0x53, 0x77, 0x78, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x64, 0x69, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x64, 0x69, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x77,
+	0x61, 0x72, 0x6d, 0x5f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x61,
+	0x72, 0x6d, 0x55, 0x70, 0x22, 0x83, 0x03, 0x0a, 0x0c, 0x45, 0x61, 0x70, 0x41, 0x6b, 0x61, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x41, 0x6b,
+	0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c, 0x6d,
+	0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50, 0x6c, 0x6d, 0x6e,
+	0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4d,
+	0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4d, 0x6e, 0x63,
+	0x4c, 0x65, 0x6e, 0x1a, 0xb4, 0x01, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x4d, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x45,
+	0x61, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x22, 0xca, 0x01, 0x0a,
+	0x0c, 0x45, 0x61, 0x70, 0x53, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a,
+	0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x53, 0x36, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36,
+	0x61, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x22, 0xeb, 0x01, 0x0a, 0x11, 0x45, 0x61,
+	0x70, 0x41, 0x6b, 0x61, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x43, 0x6b, 0x49, 0x6b, 0x50, 0x72, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x43, 0x6b,
+	0x49, 0x6b, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x22, 0xc0, 0x04, 0x0a, 0x09, 0x41, 0x41, 0x41, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x49, 0x64, 0x6c,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x49, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x41, 0x75,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x30, 0x0a,
+	0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x3f, 0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x32, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x41, 0x63, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x45, 0x61, 0x70, 0x54, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x54, 0x6c,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x45, 0x61, 0x70, 0x54, 0x6c, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x41, 0x41, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41,
+	0x41, 0x41, 0x41, 0x63, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x0b, 0x41,
+	0x63, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x0f, 0x41, 0x41,
+	0x41, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x64, 0x69, 0x73,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x64, 0x69,
+	0x73, 0x48, 0x61, 0x73, 0x68, 0x22, 0xe2, 0x01, 0x0a, 0x0e, 0x41, 0x41, 0x41, 0x41, 0x63, 0x63,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24,
+	0x0a, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x4b, 0x62, 0x12, 0x30, 0x0a, 0x13, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x13, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x22, 0x92, 0x03, 0x0a, 0x0c, 0x45,
+	0x61, 0x70, 0x54, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43,
+	0x61, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a,
+	0x18, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x18, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x54, 0x74, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x54, 0x74, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x2a, 0x0a, 0x10, 0x54, 0x74, 0x6c, 0x73, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x54, 0x74, 0x6c, 0x73, 0x49,
+	0x6e, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x22,
+	0x92, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x41, 0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x41, 0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x41,
+	0x45, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x41, 0x45,
+	0x41, 0x64, 0x64, 0x72, 0x22, 0xb0, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x11,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x65, 0x63, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x73, 0x22, 0xb4, 0x04, 0x0a, 0x09, 0x48, 0x53, 0x53, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0b, 0x6c, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6f, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x70, 0x12, 0x20,
+	0x0a, 0x0c, 0x6c, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61, 0x6d, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6d, 0x66,
+	0x12, 0x4c, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x5c,
+	0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x63, 0x0a, 0x13, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6c, 0x5f, 0x62, 0x69, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x55, 0x6c, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x6c, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6c, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x1a, 0x6c, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1,
+	0x01, 0x0a, 0x0d, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x6f,
+	0x73, 0x74, 0x22, 0x5e, 0x0a, 0x10, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x79, 0x0a, 0x0a, 0x43, 0x73, 0x66, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
+	0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x4b, 0x0a,
+	0x15, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xac, 0x02, 0x0a, 0x08, 0x53,
+	0x38, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x70, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x70, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x75, 0x66, 0x66, 0x69,
+	0x78, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a,
+	0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x53, 0x62,
+	0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x70, 0x69, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x57, 0x0a, 0x0e, 0x4e, 0x37, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x70, 0x69, 0x52, 0x6f, 0x6f, 0x74,
+	0x22, 0x98, 0x01, 0x0a, 0x08, 0x4e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x37, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x37, 0x12, 0x36, 0x0a, 0x06,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x62, 0x69,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x09,
+	0x4e, 0x34, 0x30, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x34, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x34, 0x30, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x62, 0x69, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x10, 0x4e, 0x37,
+	0x4e, 0x34, 0x30, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32,
+	0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x34, 0x0a, 0x09, 0x6e, 0x37, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
+	0x6e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x37, 0x0a, 0x0a, 0x6e, 0x34, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x34, 0x30, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09,
+	0x6e, 0x34, 0x30, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2a, 0x3a, 0x0a, 0x0c, 0x47, 0x79, 0x49,
+	0x6e, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x52, 0x5f, 0x53,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x52, 0x5f,
+	0x4b, 0x45, 0x59, 0x10, 0x02, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66,
+	0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2f, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -2558,7 +3225,7 @@ func file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP() []byte {
 }
 
 var file_feg_protos_mconfig_mconfigs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_feg_protos_mconfig_mconfigs_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_feg_protos_mconfig_mconfigs_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_feg_protos_mconfig_mconfigs_proto_goTypes = []interface{}{
 	(GyInitMethod)(0),                     // 0: magma.mconfig.GyInitMethod
 	(*DiamClientConfig)(nil),              // 1: magma.mconfig.DiamClientConfig
@@ -2569,66 +3236,82 @@ var file_feg_protos_mconfig_mconfigs_proto_goTypes = []interface{}{
 	(*GyConfig)(nil),                      // 6: magma.mconfig.GyConfig
 	(*SessionProxyConfig)(nil),            // 7: magma.mconfig.SessionProxyConfig
 	(*SwxConfig)(nil),                     // 8: magma.mconfig.SwxConfig
-	(*EapAkaConfig)(nil),                  // 9: magma.mconfig.EapAkaConfig
-	(*EapProviderTimeouts)(nil),           // 10: magma.mconfig.EapProviderTimeouts
-	(*EapSimConfig)(nil),                  // 11: magma.mconfig.EapSimConfig
-	(*AAAConfig)(nil),                     // 12: magma.mconfig.AAAConfig
-	(*RadiusConfig)(nil),                  // 13: magma.mconfig.RadiusConfig
-	(*GatewayHealthConfig)(nil),           // 14: magma.mconfig.GatewayHealthConfig
-	(*HSSConfig)(nil),                     // 15: magma.mconfig.HSSConfig
-	(*RadiusdConfig)(nil),                 // 16: magma.mconfig.RadiusdConfig
-	(*SCTPClientConfig)(nil),              // 17: magma.mconfig.SCTPClientConfig
-	(*CsfbConfig)(nil),                    // 18: magma.mconfig.CsfbConfig
-	(*EnvoyControllerConfig)(nil),         // 19: magma.mconfig.EnvoyControllerConfig
-	(*S8Config)(nil),                      // 20: magma.mconfig.S8Config
-	(*SbiServerConfig)(nil),               // 21: magma.mconfig.SbiServerConfig
-	(*N7ClientConfig)(nil),                // 22: magma.mconfig.N7ClientConfig
-	(*N7Config)(nil),                      // 23: magma.mconfig.N7Config
-	(*N7N40ProxyConfig)(nil),              // 24: magma.mconfig.N7N40ProxyConfig
-	(*EapAkaConfig_Timeouts)(nil),         // 25: magma.mconfig.EapAkaConfig.Timeouts
-	(*HSSConfig_SubscriptionProfile)(nil), // 26: magma.mconfig.HSSConfig.SubscriptionProfile
-	nil,                                   // 27: magma.mconfig.HSSConfig.SubProfilesEntry
-	(protos.LogLevel)(0),                  // 28: magma.orc8r.LogLevel
+	(*SwxCachePersistence)(nil),           // 9: magma.mconfig.SwxCachePersistence
+	(*EapAkaConfig)(nil),                  // 10: magma.mconfig.EapAkaConfig
+	(*EapProviderTimeouts)(nil),           // 11: magma.mconfig.EapProviderTimeouts
+	(*EapSimConfig)(nil),                  // 12: magma.mconfig.EapSimConfig
+	(*EapAkaPrimeConfig)(nil),             // 13: magma.mconfig.EapAkaPrimeConfig
+	(*AAAConfig)(nil),                     // 14: magma.mconfig.AAAConfig
+	(*AAASessionStore)(nil),               // 15: magma.mconfig.AAASessionStore
+	(*AAAAcctRecords)(nil),                // 16: magma.mconfig.AAAAcctRecords
+	(*EapTlsConfig)(nil),                  // 17: magma.mconfig.EapTlsConfig
+	(*RadiusConfig)(nil),                  // 18: magma.mconfig.RadiusConfig
+	(*GatewayHealthConfig)(nil),           // 19: magma.mconfig.GatewayHealthConfig
+	(*HSSConfig)(nil),                     // 20: magma.mconfig.HSSConfig
+	(*RadiusdConfig)(nil),                 // 21: magma.mconfig.RadiusdConfig
+	(*SCTPClientConfig)(nil),              // 22: magma.mconfig.SCTPClientConfig
+	(*CsfbConfig)(nil),                    // 23: magma.mconfig.CsfbConfig
+	(*EnvoyControllerConfig)(nil),         // 24: magma.mconfig.EnvoyControllerConfig
+	(*S8Config)(nil),                      // 25: magma.mconfig.S8Config
+	(*SbiServerConfig)(nil),               // 26: magma.mconfig.SbiServerConfig
+	(*N7ClientConfig)(nil),                // 27: magma.mconfig.N7ClientConfig
+	(*N7Config)(nil),                      // 28: magma.mconfig.N7Config
+	(*N40Config)(nil),                     // 29: magma.mconfig.N40Config
+	(*N7N40ProxyConfig)(nil),              // 30: magma.mconfig.N7N40ProxyConfig
+	(*EapAkaConfig_Timeouts)(nil),         // 31: magma.mconfig.EapAkaConfig.Timeouts
+	(*HSSConfig_SubscriptionProfile)(nil), // 32: magma.mconfig.HSSConfig.SubscriptionProfile
+	nil,                                   // 33: magma.mconfig.HSSConfig.SubProfilesEntry
+	(protos.LogLevel)(0),                  // 34: magma.orc8r.LogLevel
 }
 var file_feg_protos_mconfig_mconfigs_proto_depIdxs = []int32{
-	28, // 0: magma.mconfig.S6aConfig.log_level:type_name -> magma.orc8r.LogLevel
-	1,  // 1: magma.mconfig.S6aConfig.server:type_name -> magma.mconfig.DiamClientConfig
-	1,  // 2: magma.mconfig.GxConfig.server:type_name -> magma.mconfig.DiamClientConfig
-	1,  // 3: magma.mconfig.GxConfig.servers:type_name -> magma.mconfig.DiamClientConfig
-	4,  // 4: magma.mconfig.GxConfig.virtual_apn_rules:type_name -> magma.mconfig.VirtualApnRule
-	1,  // 5: magma.mconfig.GyConfig.server:type_name -> magma.mconfig.DiamClientConfig
-	0,  // 6: magma.mconfig.GyConfig.init_method:type_name -> magma.mconfig.GyInitMethod
-	1,  // 7: magma.mconfig.GyConfig.servers:type_name -> magma.mconfig.DiamClientConfig
-	4,  // 8: magma.mconfig.GyConfig.virtual_apn_rules:type_name -> magma.mconfig.VirtualApnRule
-	28, // 9: magma.mconfig.SessionProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
-	5,  // 10: magma.mconfig.SessionProxyConfig.gx:type_name -> magma.mconfig.GxConfig
-	6,  // 11: magma.mconfig.SessionProxyConfig.gy:type_name -> magma.mconfig.GyConfig
-	28, // 12: magma.mconfig.SwxConfig.log_level:type_name -> magma.orc8r.LogLevel
-	1,  // 13: magma.mconfig.SwxConfig.server:type_name -> magma.mconfig.DiamClientConfig
-	1,  // 14: magma.mconfig.SwxConfig.servers:type_name -> magma.mconfig.DiamClientConfig
-	28, // 15: magma.mconfig.EapAkaConfig.log_level:type_name -> magma.orc8r.LogLevel
-	25, // 16: magma.mconfig.EapAkaConfig.timeout:type_name -> magma.mconfig.EapAkaConfig.Timeouts
-	28, // 17: magma.mconfig.EapSimConfig.log_level:type_name -> magma.orc8r.LogLevel
-	10, // 18: magma.mconfig.EapSimConfig.timeout:type_name -> magma.mconfig.EapProviderTimeouts
-	28, // 19: magma.mconfig.AAAConfig.log_level:type_name -> magma.orc8r.LogLevel
-	13, // 20: magma.mconfig.AAAConfig.RadiusConfig:type_name -> magma.mconfig.RadiusConfig
-	2,  // 21: magma.mconfig.HSSConfig.server:type_name -> magma.mconfig.DiamServerConfig
-	27, // 22: magma.mconfig.HSSConfig.sub_profiles:type_name -> magma.mconfig.HSSConfig.SubProfilesEntry
-	26, // 23: magma.mconfig.HSSConfig.default_sub_profile:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
-	28, // 24: magma.mconfig.CsfbConfig.log_level:type_name -> magma.orc8r.LogLevel
-	17, // 25: magma.mconfig.CsfbConfig.client:type_name -> magma.mconfig.SCTPClientConfig
-	28, // 26: magma.mconfig.EnvoyControllerConfig.log_level:type_name -> magma.orc8r.LogLevel
-	28, // 27: magma.mconfig.S8Config.log_level:type_name -> magma.orc8r.LogLevel
-	21, // 28: magma.mconfig.N7Config.server:type_name -> magma.mconfig.SbiServerConfig
-	22, // 29: magma.mconfig.N7Config.client:type_name -> magma.mconfig.N7ClientConfig
-	28, // 30: magma.mconfig.N7N40ProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
-	23, // 31: magma.mconfig.N7N40ProxyConfig.n7_config:type_name -> magma.mconfig.N7Config
-	26, // 32: magma.mconfig.HSSConfig.SubProfilesEntry.value:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	1,  // 0: magma.mconfig.DiamClientConfig.peers:type_name -> magma.mconfig.DiamClientConfig
+	34, // 1: magma.mconfig.S6aConfig.log_level:type_name -> magma.orc8r.LogLevel
+	1,  // 2: magma.mconfig.S6aConfig.server:type_name -> magma.mconfig.DiamClientConfig
+	1,  // 3: magma.mconfig.GxConfig.server:type_name -> magma.mconfig.DiamClientConfig
+	1,  // 4: magma.mconfig.GxConfig.servers:type_name -> magma.mconfig.DiamClientConfig
+	4,  // 5: magma.mconfig.GxConfig.virtual_apn_rules:type_name -> magma.mconfig.VirtualApnRule
+	1,  // 6: magma.mconfig.GyConfig.server:type_name -> magma.mconfig.DiamClientConfig
+	0,  // 7: magma.mconfig.GyConfig.init_method:type_name -> magma.mconfig.GyInitMethod
+	1,  // 8: magma.mconfig.GyConfig.servers:type_name -> magma.mconfig.DiamClientConfig
+	4,  // 9: magma.mconfig.GyConfig.virtual_apn_rules:type_name -> magma.mconfig.VirtualApnRule
+	34, // 10: magma.mconfig.SessionProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
+	5,  // 11: magma.mconfig.SessionProxyConfig.gx:type_name -> magma.mconfig.GxConfig
+	6,  // 12: magma.mconfig.SessionProxyConfig.gy:type_name -> magma.mconfig.GyConfig
+	34, // 13: magma.mconfig.SwxConfig.log_level:type_name -> magma.orc8r.LogLevel
+	1,  // 14: magma.mconfig.SwxConfig.server:type_name -> magma.mconfig.DiamClientConfig
+	1,  // 15: magma.mconfig.SwxConfig.servers:type_name -> magma.mconfig.DiamClientConfig
+	9,  // 16: magma.mconfig.SwxConfig.cache_persistence:type_name -> magma.mconfig.SwxCachePersistence
+	34, // 17: magma.mconfig.EapAkaConfig.log_level:type_name -> magma.orc8r.LogLevel
+	31, // 18: magma.mconfig.EapAkaConfig.timeout:type_name -> magma.mconfig.EapAkaConfig.Timeouts
+	34, // 19: magma.mconfig.EapSimConfig.log_level:type_name -> magma.orc8r.LogLevel
+	11, // 20: magma.mconfig.EapSimConfig.timeout:type_name -> magma.mconfig.EapProviderTimeouts
+	34, // 21: magma.mconfig.EapAkaPrimeConfig.log_level:type_name -> magma.orc8r.LogLevel
+	11, // 22: magma.mconfig.EapAkaPrimeConfig.timeout:type_name -> magma.mconfig.EapProviderTimeouts
+	34, // 23: magma.mconfig.AAAConfig.log_level:type_name -> magma.orc8r.LogLevel
+	18, // 24: magma.mconfig.AAAConfig.RadiusConfig:type_name -> magma.mconfig.RadiusConfig
+	17, // 25: magma.mconfig.AAAConfig.EapTlsConfig:type_name -> magma.mconfig.EapTlsConfig
+	15, // 26: magma.mconfig.AAAConfig.SessionStore:type_name -> magma.mconfig.AAASessionStore
+	16, // 27: magma.mconfig.AAAConfig.AcctRecords:type_name -> magma.mconfig.AAAAcctRecords
+	2,  // 28: magma.mconfig.HSSConfig.server:type_name -> magma.mconfig.DiamServerConfig
+	33, // 29: magma.mconfig.HSSConfig.sub_profiles:type_name -> magma.mconfig.HSSConfig.SubProfilesEntry
+	32, // 30: magma.mconfig.HSSConfig.default_sub_profile:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
+	34, // 31: magma.mconfig.CsfbConfig.log_level:type_name -> magma.orc8r.LogLevel
+	22, // 32: magma.mconfig.CsfbConfig.client:type_name -> magma.mconfig.SCTPClientConfig
+	34, // 33: magma.mconfig.EnvoyControllerConfig.log_level:type_name -> magma.orc8r.LogLevel
+	34, // 34: magma.mconfig.S8Config.log_level:type_name -> magma.orc8r.LogLevel
+	26, // 35: magma.mconfig.N7Config.server:type_name -> magma.mconfig.SbiServerConfig
+	27, // 36: magma.mconfig.N7Config.client:type_name -> magma.mconfig.N7ClientConfig
+	26, // 37: magma.mconfig.N40Config.server:type_name -> magma.mconfig.SbiServerConfig
+	27, // 38: magma.mconfig.N40Config.client:type_name -> magma.mconfig.N7ClientConfig
+	34, // 39: magma.mconfig.N7N40ProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
+	28, // 40: magma.mconfig.N7N40ProxyConfig.n7_config:type_name -> magma.mconfig.N7Config
+	29, // 41: magma.mconfig.N7N40ProxyConfig.n40_config:type_name -> magma.mconfig.N40Config
+	32, // 42: magma.mconfig.HSSConfig.SubProfilesEntry.value:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_feg_protos_mconfig_mconfigs_proto_init() }
@@ -2734,7 +3417,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EapAkaConfig); i {
+			switch v := v.(*SwxCachePersistence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2746,7 +3429,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EapProviderTimeouts); i {
+			switch v := v.(*EapAkaConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2758,7 +3441,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EapSimConfig); i {
+			switch v := v.(*EapProviderTimeouts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2770,7 +3453,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AAAConfig); i {
+			switch v := v.(*EapSimConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2782,7 +3465,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RadiusConfig); i {
+			switch v := v.(*EapAkaPrimeConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2794,7 +3477,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayHealthConfig); i {
+			switch v := v.(*AAAConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2806,7 +3489,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HSSConfig); i {
+			switch v := v.(*AAASessionStore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2818,7 +3501,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RadiusdConfig); i {
+			switch v := v.(*AAAAcctRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2830,7 +3513,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SCTPClientConfig); i {
+			switch v := v.(*EapTlsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2842,7 +3525,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CsfbConfig); i {
+			switch v := v.(*RadiusConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2854,7 +3537,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvoyControllerConfig); i {
+			switch v := v.(*GatewayHealthConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2866,7 +3549,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S8Config); i {
+			switch v := v.(*HSSConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2878,7 +3561,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SbiServerConfig); i {
+			switch v := v.(*RadiusdConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2890,7 +3573,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7ClientConfig); i {
+			switch v := v.(*SCTPClientConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2902,7 +3585,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7Config); i {
+			switch v := v.(*CsfbConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2914,7 +3597,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7N40ProxyConfig); i {
+			switch v := v.(*EnvoyControllerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2926,7 +3609,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EapAkaConfig_Timeouts); i {
+			switch v := v.(*S8Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2938,6 +3621,78 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SbiServerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*N7ClientConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*N7Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*N40Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*N7N40ProxyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EapAkaConfig_Timeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HSSConfig_SubscriptionProfile); i {
 			case 0:
 				return &v.state
@@ -2956,7 +3711,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feg_protos_mconfig_mconfigs_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   27,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -473,7 +473,7 @@ func (x N7Expectation_RequestType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use N7Expectation_RequestType.Descriptor instead.
 func (N7Expectation_RequestType) EnumDescriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{45, 0}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{46, 0}
 }
 
 type Reply struct {
@@ -485,7 +485,6 @@ type Reply struct {
 	// reply delaying time in sec
 	ReplyDelay int32 `protobuf:"varint,2,opt,name=reply_delay,json=replyDelay,proto3" json:"reply_delay,omitempty"`
 	// Types that are assignable to SgsMessage:
-	//
 	//	*Reply_AlertRequest
 	//	*Reply_DownlinkUnitdata
 	//	*Reply_EpsDetachAck
@@ -501,7 +500,6 @@ type Reply struct {
 	//	*Reply_Status
 	SgsMessage isReply_SgsMessage `protobuf_oneof:"sgs_message"`
 	// Types that are assignable to GxgyMessage:
-	//
 	//	*Reply_CreateSessionResponse
 	//	*Reply_UpdateSessionResponse
 	//	*Reply_SessionTerminateResponse
@@ -790,7 +788,6 @@ type ExpectedRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to SgsMessage:
-	//
 	//	*ExpectedRequest_AlertAck
 	//	*ExpectedRequest_AlertReject
 	//	*ExpectedRequest_EpsDetachIndication
@@ -807,7 +804,6 @@ type ExpectedRequest struct {
 	//	*ExpectedRequest_Status
 	SgsMessage isExpectedRequest_SgsMessage `protobuf_oneof:"sgs_message"`
 	// Types that are assignable to GxgyMessage:
-	//
 	//	*ExpectedRequest_CreateSessionRequest
 	//	*ExpectedRequest_UpdateSessionRequest
 	//	*ExpectedRequest_SessionTerminateRequest
@@ -1313,6 +1309,9 @@ type OCSConfig struct {
 	FinalUnitIndication *FinalUnitIndication `protobuf:"bytes,5,opt,name=final_unit_indication,json=finalUnitIndication,proto3" json:"final_unit_indication,omitempty"`
 	// defines what field will OCS check. Only Total and TX are implemented
 	GrantTypeProcedure OCSConfig_GrantType `protobuf:"varint,6,opt,name=grant_type_procedure,json=grantTypeProcedure,proto3,enum=magma.feg.OCSConfig_GrantType" json:"grant_type_procedure,omitempty"`
+	// Tariff-Time-Change, Quota-Holding-Time, Quota-Consumption-Time & Trigger
+	// to be sent in every quota grant (not sent if unset)
+	QuotaTimers *QuotaTimers `protobuf:"bytes,7,opt,name=quota_timers,json=quotaTimers,proto3" json:"quota_timers,omitempty"`
 }
 
 func (x *OCSConfig) Reset() {
@@ -1389,6 +1388,13 @@ func (x *OCSConfig) GetGrantTypeProcedure() OCSConfig_GrantType {
 	return OCSConfig_TotalOnly
 }
 
+func (x *OCSConfig) GetQuotaTimers() *QuotaTimers {
+	if x != nil {
+		return x.QuotaTimers
+	}
+	return nil
+}
+
 type CreditInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1673,6 +1679,8 @@ type AccountRules struct {
 	StaticRuleNames        []string          `protobuf:"bytes,2,rep,name=static_rule_names,json=staticRuleNames,proto3" json:"static_rule_names,omitempty"`
 	StaticRuleBaseNames    []string          `protobuf:"bytes,3,rep,name=static_rule_base_names,json=staticRuleBaseNames,proto3" json:"static_rule_base_names,omitempty"`
 	DynamicRuleDefinitions []*RuleDefinition `protobuf:"bytes,4,rep,name=dynamic_rule_definitions,json=dynamicRuleDefinitions,proto3" json:"dynamic_rule_definitions,omitempty"`
+	// Event-Trigger values the PCRF subscribes to in the CCA-I
+	EventTriggers []uint32 `protobuf:"varint,5,rep,packed,name=event_triggers,json=eventTriggers,proto3" json:"event_triggers,omitempty"`
 }
 
 func (x *AccountRules) Reset() {
@@ -1735,6 +1743,13 @@ func (x *AccountRules) GetDynamicRuleDefinitions() []*RuleDefinition {
 	return nil
 }
 
+func (x *AccountRules) GetEventTriggers() []uint32 {
+	if x != nil {
+		return x.EventTriggers
+	}
+	return nil
+}
+
 type RuleDefinition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1747,6 +1762,8 @@ type RuleDefinition struct {
 	FlowDescriptions    []string                    `protobuf:"bytes,5,rep,name=flow_descriptions,json=flowDescriptions,proto3" json:"flow_descriptions,omitempty"`
 	RedirectInformation *protos.RedirectInformation `protobuf:"bytes,7,opt,name=redirect_information,json=redirectInformation,proto3" json:"redirect_information,omitempty"`
 	QosInformation      *protos.FlowQos             `protobuf:"bytes,8,opt,name=qos_information,json=qosInformation,proto3" json:"qos_information,omitempty"`
+	// TDF-Application-Identifier of the application to detect
+	TdfApplicationIdentifier string `protobuf:"bytes,9,opt,name=tdf_application_identifier,json=tdfApplicationIdentifier,proto3" json:"tdf_application_identifier,omitempty"`
 }
 
 func (x *RuleDefinition) Reset() {
@@ -1830,6 +1847,13 @@ func (x *RuleDefinition) GetQosInformation() *protos.FlowQos {
 	return nil
 }
 
+func (x *RuleDefinition) GetTdfApplicationIdentifier() string {
+	if x != nil {
+		return x.TdfApplicationIdentifier
+	}
+	return ""
+}
+
 type UsageMonitorConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2766,6 +2790,7 @@ type QuotaGrant struct {
 	ResultCode          uint32               `protobuf:"varint,4,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
 	IsFinalCredit       bool                 `protobuf:"varint,11,opt,name=is_final_credit,json=isFinalCredit,proto3" json:"is_final_credit,omitempty"`
 	FinalUnitIndication *FinalUnitIndication `protobuf:"bytes,12,opt,name=final_unit_indication,json=finalUnitIndication,proto3" json:"final_unit_indication,omitempty"`
+	QuotaTimers         *QuotaTimers         `protobuf:"bytes,13,opt,name=quota_timers,json=quotaTimers,proto3" json:"quota_timers,omitempty"`
 }
 
 func (x *QuotaGrant) Reset() {
@@ -2842,6 +2867,88 @@ func (x *QuotaGrant) GetFinalUnitIndication() *FinalUnitIndication {
 	return nil
 }
 
+func (x *QuotaGrant) GetQuotaTimers() *QuotaTimers {
+	if x != nil {
+		return x.QuotaTimers
+	}
+	return nil
+}
+
+type QuotaTimers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AVP: 451
+	TariffTimeChange *timestamp.Timestamp `protobuf:"bytes,1,opt,name=tariff_time_change,json=tariffTimeChange,proto3" json:"tariff_time_change,omitempty"`
+	// AVP: 871
+	QuotaHoldingTime uint32 `protobuf:"varint,2,opt,name=quota_holding_time,json=quotaHoldingTime,proto3" json:"quota_holding_time,omitempty"`
+	// AVP: 881
+	QuotaConsumptionTime uint32 `protobuf:"varint,3,opt,name=quota_consumption_time,json=quotaConsumptionTime,proto3" json:"quota_consumption_time,omitempty"`
+	// AVP: 1264, Trigger AVP is sent only if trigger_types is not empty
+	TriggerTypes []uint32 `protobuf:"varint,4,rep,packed,name=trigger_types,json=triggerTypes,proto3" json:"trigger_types,omitempty"`
+}
+
+func (x *QuotaTimers) Reset() {
+	*x = QuotaTimers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mock_core_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaTimers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaTimers) ProtoMessage() {}
+
+func (x *QuotaTimers) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mock_core_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaTimers.ProtoReflect.Descriptor instead.
+func (*QuotaTimers) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QuotaTimers) GetTariffTimeChange() *timestamp.Timestamp {
+	if x != nil {
+		return x.TariffTimeChange
+	}
+	return nil
+}
+
+func (x *QuotaTimers) GetQuotaHoldingTime() uint32 {
+	if x != nil {
+		return x.QuotaHoldingTime
+	}
+	return 0
+}
+
+func (x *QuotaTimers) GetQuotaConsumptionTime() uint32 {
+	if x != nil {
+		return x.QuotaConsumptionTime
+	}
+	return 0
+}
+
+func (x *QuotaTimers) GetTriggerTypes() []uint32 {
+	if x != nil {
+		return x.TriggerTypes
+	}
+	return nil
+}
+
 // AVP: 1067
 type UsageMonitoringInformation struct {
 	state         protoimpl.MessageState
@@ -2856,7 +2963,7 @@ type UsageMonitoringInformation struct {
 func (x *UsageMonitoringInformation) Reset() {
 	*x = UsageMonitoringInformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[29]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2869,7 +2976,7 @@ func (x *UsageMonitoringInformation) String() string {
 func (*UsageMonitoringInformation) ProtoMessage() {}
 
 func (x *UsageMonitoringInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[29]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2882,7 +2989,7 @@ func (x *UsageMonitoringInformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageMonitoringInformation.ProtoReflect.Descriptor instead.
 func (*UsageMonitoringInformation) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{29}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UsageMonitoringInformation) GetMonitoringKey() []byte {
@@ -2919,7 +3026,7 @@ type MultipleServicesCreditControl struct {
 func (x *MultipleServicesCreditControl) Reset() {
 	*x = MultipleServicesCreditControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[30]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2932,7 +3039,7 @@ func (x *MultipleServicesCreditControl) String() string {
 func (*MultipleServicesCreditControl) ProtoMessage() {}
 
 func (x *MultipleServicesCreditControl) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[30]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2945,7 +3052,7 @@ func (x *MultipleServicesCreditControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultipleServicesCreditControl.ProtoReflect.Descriptor instead.
 func (*MultipleServicesCreditControl) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{30}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *MultipleServicesCreditControl) GetRatingGroup() uint32 {
@@ -2983,7 +3090,7 @@ type QosInfo struct {
 func (x *QosInfo) Reset() {
 	*x = QosInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[31]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2996,7 +3103,7 @@ func (x *QosInfo) String() string {
 func (*QosInfo) ProtoMessage() {}
 
 func (x *QosInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[31]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,7 +3116,7 @@ func (x *QosInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QosInfo.ProtoReflect.Descriptor instead.
 func (*QosInfo) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{31}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QosInfo) GetApnAggMaxBitRateUl() uint32 {
@@ -3041,7 +3148,7 @@ type RuleInstalls struct {
 func (x *RuleInstalls) Reset() {
 	*x = RuleInstalls{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[32]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3054,7 +3161,7 @@ func (x *RuleInstalls) String() string {
 func (*RuleInstalls) ProtoMessage() {}
 
 func (x *RuleInstalls) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[32]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3067,7 +3174,7 @@ func (x *RuleInstalls) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleInstalls.ProtoReflect.Descriptor instead.
 func (*RuleInstalls) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{32}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RuleInstalls) GetRuleNames() []string {
@@ -3117,7 +3224,7 @@ type RuleRemovals struct {
 func (x *RuleRemovals) Reset() {
 	*x = RuleRemovals{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[33]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3130,7 +3237,7 @@ func (x *RuleRemovals) String() string {
 func (*RuleRemovals) ProtoMessage() {}
 
 func (x *RuleRemovals) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[33]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3143,7 +3250,7 @@ func (x *RuleRemovals) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleRemovals.ProtoReflect.Descriptor instead.
 func (*RuleRemovals) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{33}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RuleRemovals) GetRuleNames() []string {
@@ -3173,7 +3280,7 @@ type Octets struct {
 func (x *Octets) Reset() {
 	*x = Octets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[34]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3186,7 +3293,7 @@ func (x *Octets) String() string {
 func (*Octets) ProtoMessage() {}
 
 func (x *Octets) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[34]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3199,7 +3306,7 @@ func (x *Octets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Octets.ProtoReflect.Descriptor instead.
 func (*Octets) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{34}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Octets) GetTotalOctets() uint64 {
@@ -3237,7 +3344,7 @@ type PolicyReAuthTarget struct {
 func (x *PolicyReAuthTarget) Reset() {
 	*x = PolicyReAuthTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[35]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3250,7 +3357,7 @@ func (x *PolicyReAuthTarget) String() string {
 func (*PolicyReAuthTarget) ProtoMessage() {}
 
 func (x *PolicyReAuthTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[35]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3263,7 +3370,7 @@ func (x *PolicyReAuthTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyReAuthTarget.ProtoReflect.Descriptor instead.
 func (*PolicyReAuthTarget) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{35}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *PolicyReAuthTarget) GetImsi() string {
@@ -3307,7 +3414,7 @@ type PolicyReAuthAnswer struct {
 func (x *PolicyReAuthAnswer) Reset() {
 	*x = PolicyReAuthAnswer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[36]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3320,7 +3427,7 @@ func (x *PolicyReAuthAnswer) String() string {
 func (*PolicyReAuthAnswer) ProtoMessage() {}
 
 func (x *PolicyReAuthAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[36]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3333,7 +3440,7 @@ func (x *PolicyReAuthAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyReAuthAnswer.ProtoReflect.Descriptor instead.
 func (*PolicyReAuthAnswer) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{36}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PolicyReAuthAnswer) GetSessionId() string {
@@ -3368,7 +3475,7 @@ type AbortSessionRequest struct {
 func (x *AbortSessionRequest) Reset() {
 	*x = AbortSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[37]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3381,7 +3488,7 @@ func (x *AbortSessionRequest) String() string {
 func (*AbortSessionRequest) ProtoMessage() {}
 
 func (x *AbortSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[37]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3394,7 +3501,7 @@ func (x *AbortSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortSessionRequest.ProtoReflect.Descriptor instead.
 func (*AbortSessionRequest) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{37}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AbortSessionRequest) GetImsi() string {
@@ -3417,7 +3524,7 @@ type AbortSessionAnswer struct {
 func (x *AbortSessionAnswer) Reset() {
 	*x = AbortSessionAnswer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[38]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3430,7 +3537,7 @@ func (x *AbortSessionAnswer) String() string {
 func (*AbortSessionAnswer) ProtoMessage() {}
 
 func (x *AbortSessionAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[38]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3443,7 +3550,7 @@ func (x *AbortSessionAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortSessionAnswer.ProtoReflect.Descriptor instead.
 func (*AbortSessionAnswer) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{38}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AbortSessionAnswer) GetSessionId() string {
@@ -3479,7 +3586,7 @@ type PCFConfigs struct {
 func (x *PCFConfigs) Reset() {
 	*x = PCFConfigs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[39]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3492,7 +3599,7 @@ func (x *PCFConfigs) String() string {
 func (*PCFConfigs) ProtoMessage() {}
 
 func (x *PCFConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[39]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3505,7 +3612,7 @@ func (x *PCFConfigs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PCFConfigs.ProtoReflect.Descriptor instead.
 func (*PCFConfigs) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{39}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *PCFConfigs) GetUseMockDriver() bool {
@@ -3532,7 +3639,7 @@ type PolicyDecision struct {
 func (x *PolicyDecision) Reset() {
 	*x = PolicyDecision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[40]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3545,7 +3652,7 @@ func (x *PolicyDecision) String() string {
 func (*PolicyDecision) ProtoMessage() {}
 
 func (x *PolicyDecision) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[40]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3558,7 +3665,7 @@ func (x *PolicyDecision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyDecision.ProtoReflect.Descriptor instead.
 func (*PolicyDecision) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{40}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *PolicyDecision) GetImsi() string {
@@ -3596,7 +3703,7 @@ type UpdateNotificationAnswer struct {
 func (x *UpdateNotificationAnswer) Reset() {
 	*x = UpdateNotificationAnswer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[41]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3609,7 +3716,7 @@ func (x *UpdateNotificationAnswer) String() string {
 func (*UpdateNotificationAnswer) ProtoMessage() {}
 
 func (x *UpdateNotificationAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[41]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,7 +3729,7 @@ func (x *UpdateNotificationAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNotificationAnswer.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationAnswer) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{41}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateNotificationAnswer) GetStatusCode() uint32 {
@@ -3656,7 +3763,7 @@ type TerminateNotification struct {
 func (x *TerminateNotification) Reset() {
 	*x = TerminateNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[42]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3669,7 +3776,7 @@ func (x *TerminateNotification) String() string {
 func (*TerminateNotification) ProtoMessage() {}
 
 func (x *TerminateNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[42]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3682,7 +3789,7 @@ func (x *TerminateNotification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TerminateNotification.ProtoReflect.Descriptor instead.
 func (*TerminateNotification) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{42}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *TerminateNotification) GetImsi() string {
@@ -3718,7 +3825,7 @@ type TerminateNotificationAnswer struct {
 func (x *TerminateNotificationAnswer) Reset() {
 	*x = TerminateNotificationAnswer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[43]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3731,7 +3838,7 @@ func (x *TerminateNotificationAnswer) String() string {
 func (*TerminateNotificationAnswer) ProtoMessage() {}
 
 func (x *TerminateNotificationAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[43]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3744,7 +3851,7 @@ func (x *TerminateNotificationAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TerminateNotificationAnswer.ProtoReflect.Descriptor instead.
 func (*TerminateNotificationAnswer) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{43}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *TerminateNotificationAnswer) GetStatusCode() uint32 {
@@ -3767,7 +3874,7 @@ type N7Expectations struct {
 func (x *N7Expectations) Reset() {
 	*x = N7Expectations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[44]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3780,7 +3887,7 @@ func (x *N7Expectations) String() string {
 func (*N7Expectations) ProtoMessage() {}
 
 func (x *N7Expectations) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[44]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3793,7 +3900,7 @@ func (x *N7Expectations) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7Expectations.ProtoReflect.Descriptor instead.
 func (*N7Expectations) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{44}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *N7Expectations) GetExpectations() []*N7Expectation {
@@ -3830,7 +3937,7 @@ type N7Expectation struct {
 func (x *N7Expectation) Reset() {
 	*x = N7Expectation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[45]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3843,7 +3950,7 @@ func (x *N7Expectation) String() string {
 func (*N7Expectation) ProtoMessage() {}
 
 func (x *N7Expectation) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[45]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3856,7 +3963,7 @@ func (x *N7Expectation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7Expectation.ProtoReflect.Descriptor instead.
 func (*N7Expectation) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{45}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *N7Expectation) GetRequestType() N7Expectation_RequestType {
@@ -3892,7 +3999,7 @@ type N7ExpectationResult struct {
 func (x *N7ExpectationResult) Reset() {
 	*x = N7ExpectationResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mock_core_proto_msgTypes[46]
+		mi := &file_feg_protos_mock_core_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3905,7 +4012,7 @@ func (x *N7ExpectationResult) String() string {
 func (*N7ExpectationResult) ProtoMessage() {}
 
 func (x *N7ExpectationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mock_core_proto_msgTypes[46]
+	mi := &file_feg_protos_mock_core_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3918,7 +4025,7 @@ func (x *N7ExpectationResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7ExpectationResult.ProtoReflect.Descriptor instead.
 func (*N7ExpectationResult) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{46}
+	return file_feg_protos_mock_core_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *N7ExpectationResult) GetResults() []*ExpectationResult {
@@ -4156,7 +4263,7 @@ var file_feg_protos_mock_core_proto_rawDesc = []byte{
 	0x55, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
 	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
 	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x22, 0x89, 0x03, 0x0a, 0x09, 0x4f, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x73, 0x22, 0xc4, 0x03, 0x0a, 0x09, 0x4f, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
 	0x3b, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x63, 0x74,
 	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
 	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x52, 0x0e, 0x6d, 0x61,
@@ -4178,84 +4285,94 @@ var file_feg_protos_mock_core_proto_rawDesc = []byte{
 	0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4f, 0x43,
 	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70,
 	0x65, 0x52, 0x12, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x64, 0x75, 0x72, 0x65, 0x22, 0x26, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x78, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x22, 0xcc, 0x01,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67,
-	0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
-	0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x3b,
-	0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x1f, 0x0a, 0x08, 0x55,
-	0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x01, 0x22, 0xc7, 0x01, 0x0a,
-	0x0b, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x5b, 0x0a, 0x11,
-	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x5b, 0x0a, 0x16, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d,
-	0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x56, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a,
-	0x0b, 0x50, 0x43, 0x52, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x75, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f,
-	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x18, 0x64, 0x79,
-	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xd4, 0x02, 0x0a, 0x0e, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6c, 0x6f,
-	0x77, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6c, 0x6f, 0x77, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0f, 0x71, 0x6f, 0x73,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x46,
-	0x6c, 0x6f, 0x77, 0x51, 0x6f, 0x73, 0x52, 0x0e, 0x71, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7c, 0x0a, 0x19, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d,
+	0x65, 0x64, 0x75, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x54, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73,
+	0x22, 0x26, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
+	0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x54, 0x78, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x22, 0xcc, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x29,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4f, 0x63, 0x74, 0x65, 0x74,
+	0x73, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x75, 0x6e,
+	0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x1f, 0x0a, 0x08, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x5b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x5b, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x22, 0x56, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x0b, 0x50, 0x43, 0x52, 0x46,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x5f, 0x6d,
+	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x75, 0x73, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22,
+	0xff, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x6d, 0x73, 0x69, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x16, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x73, 0x22, 0x92, 0x03, 0x0a, 0x0e, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6c, 0x6f, 0x77, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0f, 0x71,
+	0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
+	0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x51, 0x6f, 0x73, 0x52, 0x0e, 0x71, 0x6f, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1a, 0x74, 0x64, 0x66, 0x5f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x74, 0x64,
+	0x66, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x19, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d,
 	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x4b, 0x0a, 0x15, 0x75, 0x73, 0x61, 0x67, 0x65,
@@ -4445,7 +4562,7 @@ var file_feg_protos_mock_core_proto_rawDesc = []byte{
 	0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x47, 0x72,
 	0x61, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x66, 0x61, 0x69,
 	0x6c, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b,
-	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0xf1, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74,
 	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
 	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x61,
 	0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x14, 0x67, 0x72, 0x61,
@@ -4465,327 +4582,344 @@ var file_feg_protos_mock_core_proto_rawDesc = []byte{
 	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69,
 	0x74, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x66, 0x69, 0x6e,
 	0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xb5, 0x01, 0x0a, 0x1a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x10, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x6d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a,
-	0x06, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73,
-	0x52, 0x06, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x1d, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3d, 0x0a,
-	0x11, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x6e,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x52, 0x0f, 0x75, 0x73, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x73, 0x0a,
-	0x07, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x0a, 0x17, 0x61, 0x70, 0x6e, 0x5f,
-	0x61, 0x67, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x70, 0x6e, 0x41, 0x67,
-	0x67, 0x4d, 0x61, 0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6c, 0x12, 0x33, 0x0a,
-	0x17, 0x61, 0x70, 0x6e, 0x5f, 0x61, 0x67, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x74,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
-	0x61, 0x70, 0x6e, 0x41, 0x67, 0x67, 0x4d, 0x61, 0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65,
-	0x44, 0x6c, 0x22, 0xa9, 0x02, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x75, 0x6c,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x75,
-	0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0f, 0x72, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x64, 0x65,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x55,
-	0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a,
-	0x0f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x06, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x63, 0x74, 0x65,
-	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6f, 0x63, 0x74, 0x65,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4f,
-	0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
-	0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x12, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x3f, 0x0a, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x6f,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f,
-	0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x0e, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x54, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x5b, 0x0a, 0x16, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x14, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
-	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x51, 0x0a,
-	0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x1a, 0x3e, 0x0a, 0x10, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x29, 0x0a, 0x13, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x22, 0x79, 0x0a, 0x12, 0x41,
-	0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x50, 0x43, 0x46, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x63, 0x6b,
-	0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75,
-	0x73, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x0e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d,
-	0x73, 0x69, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x64, 0x75, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x18, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x15, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x39, 0x0a, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x0b,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0b,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x74,
+	0x61, 0x72, 0x69, 0x66, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x10, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x68,
+	0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x14, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xb5,
+	0x01, 0x0a, 0x1a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x10, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x06, 0x6f,
+	0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x52, 0x06,
+	0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x1d, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3d, 0x0a, 0x11, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x73, 0x0a, 0x07, 0x51,
+	0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x0a, 0x17, 0x61, 0x70, 0x6e, 0x5f, 0x61, 0x67,
+	0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x70, 0x6e, 0x41, 0x67, 0x67, 0x4d,
+	0x61, 0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6c, 0x12, 0x33, 0x0a, 0x17, 0x61,
+	0x70, 0x6e, 0x5f, 0x61, 0x67, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x64, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x70,
+	0x6e, 0x41, 0x67, 0x67, 0x4d, 0x61, 0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x44, 0x6c,
+	0x22, 0xa9, 0x02, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72,
+	0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43,
+	0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x64, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x0c,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x06, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4f, 0x63, 0x74,
+	0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6f, 0x63,
+	0x74, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x12, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
 	0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
-	0x6d, 0x73, 0x69, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x64, 0x75,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x61, 0x75, 0x73, 0x65, 0x22, 0x3e,
-	0x0a, 0x1b, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xdb,
-	0x01, 0x0a, 0x0e, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x66, 0x65, 0x67, 0x2e, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x64, 0x0a, 0x1b, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19, 0x75, 0x6e, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x65, 0x68,
-	0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xd1, 0x01, 0x0a,
-	0x0d, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47,
-	0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x0b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
-	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x02,
-	0x22, 0x7e, 0x0a, 0x13, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
-	0x2f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x42, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x2a, 0x3c, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x10, 0x02, 0x2a, 0x32,
-	0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x10, 0x01, 0x2a, 0x47, 0x0a, 0x0d, 0x43, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x45,
-	0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x56, 0x0a, 0x19, 0x55,
+	0x6d, 0x73, 0x69, 0x12, 0x3f, 0x0a, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x0e, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x6f,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x5b, 0x0a, 0x16, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x3e,
+	0x0a, 0x10, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29,
+	0x0a, 0x13, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x22, 0x79, 0x0a, 0x12, 0x41, 0x62, 0x6f,
+	0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x50, 0x43, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x63, 0x6b, 0x5f, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65,
+	0x4d, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x0e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x15, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73,
+	0x69, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x64, 0x75, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x61, 0x75, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x1b,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xdb, 0x01, 0x0a,
+	0x0e, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3c, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65,
+	0x67, 0x2e, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a,
+	0x1b, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55,
 	0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x54,
-	0x49, 0x4e, 0x55, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x4e, 0x53, 0x57, 0x45,
-	0x52, 0x10, 0x01, 0x2a, 0x66, 0x0a, 0x0e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x75, 0x73,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x6e, 0x73,
-	0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x6e,
-	0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x10, 0x02, 0x32, 0x8c, 0x01, 0x0a, 0x14,
-	0x4d, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
-	0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x05, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
-	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0xf7, 0x04, 0x0a, 0x07, 0x4d,
-	0x6f, 0x63, 0x6b, 0x4f, 0x43, 0x53, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4f, 0x43, 0x53,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4f, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x11,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x0d, 0x4e,
+	0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0c,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4e,
+	0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0x7e,
+	0x0a, 0x13, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2a, 0x3c,
+	0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x10, 0x02, 0x2a, 0x32, 0x0a, 0x0f,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x10, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10, 0x01,
+	0x2a, 0x47, 0x0a, 0x0d, 0x43, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0c, 0x0a, 0x08, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x45, 0x52, 0x4d,
+	0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x56, 0x0a, 0x19, 0x55, 0x6e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e,
+	0x55, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12,
+	0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52, 0x10,
+	0x01, 0x2a, 0x66, 0x0a, 0x0e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x75, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x75,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x10, 0x02, 0x32, 0x8c, 0x01, 0x0a, 0x14, 0x4d, 0x6f,
+	0x63, 0x6b, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
+	0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0xf7, 0x04, 0x0a, 0x07, 0x4d, 0x6f, 0x63,
+	0x6b, 0x4f, 0x43, 0x53, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4f, 0x43, 0x53, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x4f, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x15,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
+	0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x06, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x45,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x20,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x79, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x00, 0x32, 0x85, 0x05, 0x0a, 0x08, 0x4d, 0x6f, 0x63, 0x6b, 0x50, 0x43, 0x52, 0x46, 0x12,
+	0x3d, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x43, 0x52, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x43,
+	0x52, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x08, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x11, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x06, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1d, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c,
+	0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x78, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x12, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
+	0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x47, 0x78, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x32, 0xcd, 0x04, 0x0a, 0x07, 0x4d,
+	0x6f, 0x63, 0x6b, 0x50, 0x43, 0x46, 0x12, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x43, 0x46,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x50, 0x43, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x11,
 	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x12, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
-	0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12,
-	0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x06, 0x52, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x68,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43,
-	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
-	0x65, 0x67, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
-	0x65, 0x67, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x72,
-	0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e,
+	0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x11, 0x2e,
 	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x79, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x00, 0x32, 0x85, 0x05, 0x0a, 0x08, 0x4d, 0x6f, 0x63, 0x6b, 0x50, 0x43, 0x52,
-	0x46, 0x12, 0x3d, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x43, 0x52, 0x46, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
-	0x50, 0x43, 0x52, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
-	0x12, 0x3d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
-	0x38, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
-	0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10, 0x53, 0x65, 0x74,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
-	0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a,
-	0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x06, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1d,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x1d, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4f,
-	0x0a, 0x0c, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12,
-	0x4e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47,
-	0x78, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x78,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65,
+	0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x14, 0x53, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x11, 0x53,
+	0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4e, 0x37, 0x45, 0x78,
 	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67,
 	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x49, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
-	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x78, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x32, 0xcd, 0x04, 0x0a,
-	0x07, 0x4d, 0x6f, 0x63, 0x6b, 0x50, 0x43, 0x46, 0x12, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50,
-	0x43, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x43, 0x46, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x44, 0x1a,
-	0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x11,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x14, 0x53, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x19, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
-	0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x5f, 0x0a,
-	0x11, 0x53, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x41,
-	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4e, 0x37,
-	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f,
-	0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4e, 0x37, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4801,7 +4935,7 @@ func file_feg_protos_mock_core_proto_rawDescGZIP() []byte {
 }
 
 var file_feg_protos_mock_core_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_feg_protos_mock_core_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_feg_protos_mock_core_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_feg_protos_mock_core_proto_goTypes = []interface{}{
 	(FinalUnitAction)(0),                    // 0: magma.feg.FinalUnitAction
 	(MonitoringLevel)(0),                    // 1: magma.feg.MonitoringLevel
@@ -4841,223 +4975,227 @@ var file_feg_protos_mock_core_proto_goTypes = []interface{}{
 	(*GyCreditControlRequest)(nil),          // 35: magma.feg.GyCreditControlRequest
 	(*GyCreditControlAnswer)(nil),           // 36: magma.feg.GyCreditControlAnswer
 	(*QuotaGrant)(nil),                      // 37: magma.feg.QuotaGrant
-	(*UsageMonitoringInformation)(nil),      // 38: magma.feg.UsageMonitoringInformation
-	(*MultipleServicesCreditControl)(nil),   // 39: magma.feg.MultipleServicesCreditControl
-	(*QosInfo)(nil),                         // 40: magma.feg.QosInfo
-	(*RuleInstalls)(nil),                    // 41: magma.feg.RuleInstalls
-	(*RuleRemovals)(nil),                    // 42: magma.feg.RuleRemovals
-	(*Octets)(nil),                          // 43: magma.feg.Octets
-	(*PolicyReAuthTarget)(nil),              // 44: magma.feg.PolicyReAuthTarget
-	(*PolicyReAuthAnswer)(nil),              // 45: magma.feg.PolicyReAuthAnswer
-	(*AbortSessionRequest)(nil),             // 46: magma.feg.AbortSessionRequest
-	(*AbortSessionAnswer)(nil),              // 47: magma.feg.AbortSessionAnswer
-	(*PCFConfigs)(nil),                      // 48: magma.feg.PCFConfigs
-	(*PolicyDecision)(nil),                  // 49: magma.feg.PolicyDecision
-	(*UpdateNotificationAnswer)(nil),        // 50: magma.feg.UpdateNotificationAnswer
-	(*TerminateNotification)(nil),           // 51: magma.feg.TerminateNotification
-	(*TerminateNotificationAnswer)(nil),     // 52: magma.feg.TerminateNotificationAnswer
-	(*N7Expectations)(nil),                  // 53: magma.feg.N7Expectations
-	(*N7Expectation)(nil),                   // 54: magma.feg.N7Expectation
-	(*N7ExpectationResult)(nil),             // 55: magma.feg.N7ExpectationResult
-	nil,                                     // 56: magma.feg.CreditInfos.CreditInformationEntry
-	nil,                                     // 57: magma.feg.PolicyReAuthAnswer.FailedRulesEntry
-	(*AlertRequest)(nil),                    // 58: magma.feg.AlertRequest
-	(*DownlinkUnitdata)(nil),                // 59: magma.feg.DownlinkUnitdata
-	(*EPSDetachAck)(nil),                    // 60: magma.feg.EPSDetachAck
-	(*IMSIDetachAck)(nil),                   // 61: magma.feg.IMSIDetachAck
-	(*LocationUpdateAccept)(nil),            // 62: magma.feg.LocationUpdateAccept
-	(*LocationUpdateReject)(nil),            // 63: magma.feg.LocationUpdateReject
-	(*MMInformationRequest)(nil),            // 64: magma.feg.MMInformationRequest
-	(*PagingRequest)(nil),                   // 65: magma.feg.PagingRequest
-	(*ReleaseRequest)(nil),                  // 66: magma.feg.ReleaseRequest
-	(*ServiceAbortRequest)(nil),             // 67: magma.feg.ServiceAbortRequest
-	(*ResetAck)(nil),                        // 68: magma.feg.ResetAck
-	(*ResetIndication)(nil),                 // 69: magma.feg.ResetIndication
-	(*Status)(nil),                          // 70: magma.feg.Status
-	(*protos.CreateSessionResponse)(nil),    // 71: magma.lte.CreateSessionResponse
-	(*protos.UpdateSessionResponse)(nil),    // 72: magma.lte.UpdateSessionResponse
-	(*protos.SessionTerminateResponse)(nil), // 73: magma.lte.SessionTerminateResponse
-	(*AlertAck)(nil),                        // 74: magma.feg.AlertAck
-	(*AlertReject)(nil),                     // 75: magma.feg.AlertReject
-	(*EPSDetachIndication)(nil),             // 76: magma.feg.EPSDetachIndication
-	(*IMSIDetachIndication)(nil),            // 77: magma.feg.IMSIDetachIndication
-	(*LocationUpdateRequest)(nil),           // 78: magma.feg.LocationUpdateRequest
-	(*PagingReject)(nil),                    // 79: magma.feg.PagingReject
-	(*ServiceRequest)(nil),                  // 80: magma.feg.ServiceRequest
-	(*TMSIReallocationComplete)(nil),        // 81: magma.feg.TMSIReallocationComplete
-	(*UEActivityIndication)(nil),            // 82: magma.feg.UEActivityIndication
-	(*UEUnreachable)(nil),                   // 83: magma.feg.UEUnreachable
-	(*UplinkUnitdata)(nil),                  // 84: magma.feg.UplinkUnitdata
-	(*protos.CreateSessionRequest)(nil),     // 85: magma.lte.CreateSessionRequest
-	(*protos.UpdateSessionRequest)(nil),     // 86: magma.lte.UpdateSessionRequest
-	(*protos.SessionTerminateRequest)(nil),  // 87: magma.lte.SessionTerminateRequest
-	(*protos.RedirectInformation)(nil),      // 88: magma.lte.RedirectInformation
-	(*protos.FlowQos)(nil),                  // 89: magma.lte.FlowQos
-	(*wrappers.Int32Value)(nil),             // 90: google.protobuf.Int32Value
-	(*timestamp.Timestamp)(nil),             // 91: google.protobuf.Timestamp
-	(*protos1.Void)(nil),                    // 92: magma.orc8r.Void
-	(*protos.SubscriberID)(nil),             // 93: magma.lte.SubscriberID
+	(*QuotaTimers)(nil),                     // 38: magma.feg.QuotaTimers
+	(*UsageMonitoringInformation)(nil),      // 39: magma.feg.UsageMonitoringInformation
+	(*MultipleServicesCreditControl)(nil),   // 40: magma.feg.MultipleServicesCreditControl
+	(*QosInfo)(nil),                         // 41: magma.feg.QosInfo
+	(*RuleInstalls)(nil),                    // 42: magma.feg.RuleInstalls
+	(*RuleRemovals)(nil),                    // 43: magma.feg.RuleRemovals
+	(*Octets)(nil),                          // 44: magma.feg.Octets
+	(*PolicyReAuthTarget)(nil),              // 45: magma.feg.PolicyReAuthTarget
+	(*PolicyReAuthAnswer)(nil),              // 46: magma.feg.PolicyReAuthAnswer
+	(*AbortSessionRequest)(nil),             // 47: magma.feg.AbortSessionRequest
+	(*AbortSessionAnswer)(nil),              // 48: magma.feg.AbortSessionAnswer
+	(*PCFConfigs)(nil),                      // 49: magma.feg.PCFConfigs
+	(*PolicyDecision)(nil),                  // 50: magma.feg.PolicyDecision
+	(*UpdateNotificationAnswer)(nil),        // 51: magma.feg.UpdateNotificationAnswer
+	(*TerminateNotification)(nil),           // 52: magma.feg.TerminateNotification
+	(*TerminateNotificationAnswer)(nil),     // 53: magma.feg.TerminateNotificationAnswer
+	(*N7Expectations)(nil),                  // 54: magma.feg.N7Expectations
+	(*N7Expectation)(nil),                   // 55: magma.feg.N7Expectation
+	(*N7ExpectationResult)(nil),             // 56: magma.feg.N7ExpectationResult
+	nil,                                     // 57: magma.feg.CreditInfos.CreditInformationEntry
+	nil,                                     // 58: magma.feg.PolicyReAuthAnswer.FailedRulesEntry
+	(*AlertRequest)(nil),                    // 59: magma.feg.AlertRequest
+	(*DownlinkUnitdata)(nil),                // 60: magma.feg.DownlinkUnitdata
+	(*EPSDetachAck)(nil),                    // 61: magma.feg.EPSDetachAck
+	(*IMSIDetachAck)(nil),                   // 62: magma.feg.IMSIDetachAck
+	(*LocationUpdateAccept)(nil),            // 63: magma.feg.LocationUpdateAccept
+	(*LocationUpdateReject)(nil),            // 64: magma.feg.LocationUpdateReject
+	(*MMInformationRequest)(nil),            // 65: magma.feg.MMInformationRequest
+	(*PagingRequest)(nil),                   // 66: magma.feg.PagingRequest
+	(*ReleaseRequest)(nil),                  // 67: magma.feg.ReleaseRequest
+	(*ServiceAbortRequest)(nil),             // 68: magma.feg.ServiceAbortRequest
+	(*ResetAck)(nil),                        // 69: magma.feg.ResetAck
+	(*ResetIndication)(nil),                 // 70: magma.feg.ResetIndication
+	(*Status)(nil),                          // 71: magma.feg.Status
+	(*protos.CreateSessionResponse)(nil),    // 72: magma.lte.CreateSessionResponse
+	(*protos.UpdateSessionResponse)(nil),    // 73: magma.lte.UpdateSessionResponse
+	(*protos.SessionTerminateResponse)(nil), // 74: magma.lte.SessionTerminateResponse
+	(*AlertAck)(nil),                        // 75: magma.feg.AlertAck
+	(*AlertReject)(nil),                     // 76: magma.feg.AlertReject
+	(*EPSDetachIndication)(nil),             // 77: magma.feg.EPSDetachIndication
+	(*IMSIDetachIndication)(nil),            // 78: magma.feg.IMSIDetachIndication
+	(*LocationUpdateRequest)(nil),           // 79: magma.feg.LocationUpdateRequest
+	(*PagingReject)(nil),                    // 80: magma.feg.PagingReject
+	(*ServiceRequest)(nil),                  // 81: magma.feg.ServiceRequest
+	(*TMSIReallocationComplete)(nil),        // 82: magma.feg.TMSIReallocationComplete
+	(*UEActivityIndication)(nil),            // 83: magma.feg.UEActivityIndication
+	(*UEUnreachable)(nil),                   // 84: magma.feg.UEUnreachable
+	(*UplinkUnitdata)(nil),                  // 85: magma.feg.UplinkUnitdata
+	(*protos.CreateSessionRequest)(nil),     // 86: magma.lte.CreateSessionRequest
+	(*protos.UpdateSessionRequest)(nil),     // 87: magma.lte.UpdateSessionRequest
+	(*protos.SessionTerminateRequest)(nil),  // 88: magma.lte.SessionTerminateRequest
+	(*protos.RedirectInformation)(nil),      // 89: magma.lte.RedirectInformation
+	(*protos.FlowQos)(nil),                  // 90: magma.lte.FlowQos
+	(*wrappers.Int32Value)(nil),             // 91: google.protobuf.Int32Value
+	(*timestamp.Timestamp)(nil),             // 92: google.protobuf.Timestamp
+	(*protos1.Void)(nil),                    // 93: magma.orc8r.Void
+	(*protos.SubscriberID)(nil),             // 94: magma.lte.SubscriberID
 }
 var file_feg_protos_mock_core_proto_depIdxs = []int32{
 	5,   // 0: magma.feg.Reply.server_behavior:type_name -> magma.feg.Reply.ServerBehavior
-	58,  // 1: magma.feg.Reply.alert_request:type_name -> magma.feg.AlertRequest
-	59,  // 2: magma.feg.Reply.downlink_unitdata:type_name -> magma.feg.DownlinkUnitdata
-	60,  // 3: magma.feg.Reply.eps_detach_ack:type_name -> magma.feg.EPSDetachAck
-	61,  // 4: magma.feg.Reply.imsi_detach_ack:type_name -> magma.feg.IMSIDetachAck
-	62,  // 5: magma.feg.Reply.location_update_accept:type_name -> magma.feg.LocationUpdateAccept
-	63,  // 6: magma.feg.Reply.location_update_reject:type_name -> magma.feg.LocationUpdateReject
-	64,  // 7: magma.feg.Reply.mm_information_request:type_name -> magma.feg.MMInformationRequest
-	65,  // 8: magma.feg.Reply.paging_request:type_name -> magma.feg.PagingRequest
-	66,  // 9: magma.feg.Reply.release_request:type_name -> magma.feg.ReleaseRequest
-	67,  // 10: magma.feg.Reply.service_abort_request:type_name -> magma.feg.ServiceAbortRequest
-	68,  // 11: magma.feg.Reply.reset_ack:type_name -> magma.feg.ResetAck
-	69,  // 12: magma.feg.Reply.reset_indication:type_name -> magma.feg.ResetIndication
-	70,  // 13: magma.feg.Reply.status:type_name -> magma.feg.Status
-	71,  // 14: magma.feg.Reply.create_session_response:type_name -> magma.lte.CreateSessionResponse
-	72,  // 15: magma.feg.Reply.update_session_response:type_name -> magma.lte.UpdateSessionResponse
-	73,  // 16: magma.feg.Reply.session_terminate_response:type_name -> magma.lte.SessionTerminateResponse
-	74,  // 17: magma.feg.ExpectedRequest.alert_ack:type_name -> magma.feg.AlertAck
-	75,  // 18: magma.feg.ExpectedRequest.alert_reject:type_name -> magma.feg.AlertReject
-	76,  // 19: magma.feg.ExpectedRequest.eps_detach_indication:type_name -> magma.feg.EPSDetachIndication
-	77,  // 20: magma.feg.ExpectedRequest.imsi_detach_indication:type_name -> magma.feg.IMSIDetachIndication
-	78,  // 21: magma.feg.ExpectedRequest.location_update_request:type_name -> magma.feg.LocationUpdateRequest
-	79,  // 22: magma.feg.ExpectedRequest.paging_reject:type_name -> magma.feg.PagingReject
-	80,  // 23: magma.feg.ExpectedRequest.service_request:type_name -> magma.feg.ServiceRequest
-	81,  // 24: magma.feg.ExpectedRequest.tmsi_reallocation_complete:type_name -> magma.feg.TMSIReallocationComplete
-	82,  // 25: magma.feg.ExpectedRequest.ue_activity_indication:type_name -> magma.feg.UEActivityIndication
-	83,  // 26: magma.feg.ExpectedRequest.ue_unreachable:type_name -> magma.feg.UEUnreachable
-	84,  // 27: magma.feg.ExpectedRequest.uplink_unitdata:type_name -> magma.feg.UplinkUnitdata
-	68,  // 28: magma.feg.ExpectedRequest.reset_ack:type_name -> magma.feg.ResetAck
-	69,  // 29: magma.feg.ExpectedRequest.reset_indication:type_name -> magma.feg.ResetIndication
-	70,  // 30: magma.feg.ExpectedRequest.status:type_name -> magma.feg.Status
-	85,  // 31: magma.feg.ExpectedRequest.create_session_request:type_name -> magma.lte.CreateSessionRequest
-	86,  // 32: magma.feg.ExpectedRequest.update_session_request:type_name -> magma.lte.UpdateSessionRequest
-	87,  // 33: magma.feg.ExpectedRequest.session_terminate_request:type_name -> magma.lte.SessionTerminateRequest
+	59,  // 1: magma.feg.Reply.alert_request:type_name -> magma.feg.AlertRequest
+	60,  // 2: magma.feg.Reply.downlink_unitdata:type_name -> magma.feg.DownlinkUnitdata
+	61,  // 3: magma.feg.Reply.eps_detach_ack:type_name -> magma.feg.EPSDetachAck
+	62,  // 4: magma.feg.Reply.imsi_detach_ack:type_name -> magma.feg.IMSIDetachAck
+	63,  // 5: magma.feg.Reply.location_update_accept:type_name -> magma.feg.LocationUpdateAccept
+	64,  // 6: magma.feg.Reply.location_update_reject:type_name -> magma.feg.LocationUpdateReject
+	65,  // 7: magma.feg.Reply.mm_information_request:type_name -> magma.feg.MMInformationRequest
+	66,  // 8: magma.feg.Reply.paging_request:type_name -> magma.feg.PagingRequest
+	67,  // 9: magma.feg.Reply.release_request:type_name -> magma.feg.ReleaseRequest
+	68,  // 10: magma.feg.Reply.service_abort_request:type_name -> magma.feg.ServiceAbortRequest
+	69,  // 11: magma.feg.Reply.reset_ack:type_name -> magma.feg.ResetAck
+	70,  // 12: magma.feg.Reply.reset_indication:type_name -> magma.feg.ResetIndication
+	71,  // 13: magma.feg.Reply.status:type_name -> magma.feg.Status
+	72,  // 14: magma.feg.Reply.create_session_response:type_name -> magma.lte.CreateSessionResponse
+	73,  // 15: magma.feg.Reply.update_session_response:type_name -> magma.lte.UpdateSessionResponse
+	74,  // 16: magma.feg.Reply.session_terminate_response:type_name -> magma.lte.SessionTerminateResponse
+	75,  // 17: magma.feg.ExpectedRequest.alert_ack:type_name -> magma.feg.AlertAck
+	76,  // 18: magma.feg.ExpectedRequest.alert_reject:type_name -> magma.feg.AlertReject
+	77,  // 19: magma.feg.ExpectedRequest.eps_detach_indication:type_name -> magma.feg.EPSDetachIndication
+	78,  // 20: magma.feg.ExpectedRequest.imsi_detach_indication:type_name -> magma.feg.IMSIDetachIndication
+	79,  // 21: magma.feg.ExpectedRequest.location_update_request:type_name -> magma.feg.LocationUpdateRequest
+	80,  // 22: magma.feg.ExpectedRequest.paging_reject:type_name -> magma.feg.PagingReject
+	81,  // 23: magma.feg.ExpectedRequest.service_request:type_name -> magma.feg.ServiceRequest
+	82,  // 24: magma.feg.ExpectedRequest.tmsi_reallocation_complete:type_name -> magma.feg.TMSIReallocationComplete
+	83,  // 25: magma.feg.ExpectedRequest.ue_activity_indication:type_name -> magma.feg.UEActivityIndication
+	84,  // 26: magma.feg.ExpectedRequest.ue_unreachable:type_name -> magma.feg.UEUnreachable
+	85,  // 27: magma.feg.ExpectedRequest.uplink_unitdata:type_name -> magma.feg.UplinkUnitdata
+	69,  // 28: magma.feg.ExpectedRequest.reset_ack:type_name -> magma.feg.ResetAck
+	70,  // 29: magma.feg.ExpectedRequest.reset_indication:type_name -> magma.feg.ResetIndication
+	71,  // 30: magma.feg.ExpectedRequest.status:type_name -> magma.feg.Status
+	86,  // 31: magma.feg.ExpectedRequest.create_session_request:type_name -> magma.lte.CreateSessionRequest
+	87,  // 32: magma.feg.ExpectedRequest.update_session_request:type_name -> magma.lte.UpdateSessionRequest
+	88,  // 33: magma.feg.ExpectedRequest.session_terminate_request:type_name -> magma.lte.SessionTerminateRequest
 	10,  // 34: magma.feg.RequestReply.request:type_name -> magma.feg.ExpectedRequest
 	9,   // 35: magma.feg.RequestReply.reply:type_name -> magma.feg.Reply
 	11,  // 36: magma.feg.ServerConfiguration.request_reply:type_name -> magma.feg.RequestReply
 	13,  // 37: magma.feg.FinalUnitIndication.redirect_server:type_name -> magma.feg.RedirectServer
 	0,   // 38: magma.feg.FinalUnitIndication.final_unit_action:type_name -> magma.feg.FinalUnitAction
-	43,  // 39: magma.feg.OCSConfig.max_usage_octets:type_name -> magma.feg.Octets
+	44,  // 39: magma.feg.OCSConfig.max_usage_octets:type_name -> magma.feg.Octets
 	14,  // 40: magma.feg.OCSConfig.final_unit_indication:type_name -> magma.feg.FinalUnitIndication
 	6,   // 41: magma.feg.OCSConfig.grant_type_procedure:type_name -> magma.feg.OCSConfig.GrantType
-	43,  // 42: magma.feg.CreditInfo.volume:type_name -> magma.feg.Octets
-	7,   // 43: magma.feg.CreditInfo.unit_type:type_name -> magma.feg.CreditInfo.UnitType
-	56,  // 44: magma.feg.CreditInfos.creditInformation:type_name -> magma.feg.CreditInfos.CreditInformationEntry
-	22,  // 45: magma.feg.AccountRules.dynamic_rule_definitions:type_name -> magma.feg.RuleDefinition
-	88,  // 46: magma.feg.RuleDefinition.redirect_information:type_name -> magma.lte.RedirectInformation
-	89,  // 47: magma.feg.RuleDefinition.qos_information:type_name -> magma.lte.FlowQos
-	24,  // 48: magma.feg.UsageMonitorConfiguration.usage_monitor_credits:type_name -> magma.feg.UsageMonitor
-	38,  // 49: magma.feg.UsageMonitor.monitor_info_per_request:type_name -> magma.feg.UsageMonitoringInformation
-	43,  // 50: magma.feg.UsageMonitor.total_quota:type_name -> magma.feg.Octets
-	29,  // 51: magma.feg.GxCreditControlExpectations.expectations:type_name -> magma.feg.GxCreditControlExpectation
-	3,   // 52: magma.feg.GxCreditControlExpectations.unexpected_request_behavior:type_name -> magma.feg.UnexpectedRequestBehavior
-	31,  // 53: magma.feg.GxCreditControlExpectations.gx_default_cca:type_name -> magma.feg.GxCreditControlAnswer
-	43,  // 54: magma.feg.GxCreditControlExpectations.total_expected_usage:type_name -> magma.feg.Octets
-	28,  // 55: magma.feg.GxCreditControlResult.results:type_name -> magma.feg.ExpectationResult
-	27,  // 56: magma.feg.GxCreditControlResult.errors:type_name -> magma.feg.ErrorByIndex
-	30,  // 57: magma.feg.GxCreditControlExpectation.expected_request:type_name -> magma.feg.GxCreditControlRequest
-	31,  // 58: magma.feg.GxCreditControlExpectation.answer:type_name -> magma.feg.GxCreditControlAnswer
-	2,   // 59: magma.feg.GxCreditControlRequest.request_type:type_name -> magma.feg.CCRequestType
-	90,  // 60: magma.feg.GxCreditControlRequest.request_number:type_name -> google.protobuf.Int32Value
-	38,  // 61: magma.feg.GxCreditControlRequest.usage_monitoring_reports:type_name -> magma.feg.UsageMonitoringInformation
-	90,  // 62: magma.feg.GxCreditControlRequest.event_trigger:type_name -> google.protobuf.Int32Value
-	38,  // 63: magma.feg.GxCreditControlAnswer.usage_monitoring_infos:type_name -> magma.feg.UsageMonitoringInformation
-	41,  // 64: magma.feg.GxCreditControlAnswer.rule_installs:type_name -> magma.feg.RuleInstalls
-	42,  // 65: magma.feg.GxCreditControlAnswer.rule_removals:type_name -> magma.feg.RuleRemovals
-	91,  // 66: magma.feg.GxCreditControlAnswer.revalidation_time:type_name -> google.protobuf.Timestamp
-	34,  // 67: magma.feg.GyCreditControlExpectations.expectations:type_name -> magma.feg.GyCreditControlExpectation
-	3,   // 68: magma.feg.GyCreditControlExpectations.unexpected_request_behavior:type_name -> magma.feg.UnexpectedRequestBehavior
-	36,  // 69: magma.feg.GyCreditControlExpectations.gy_default_cca:type_name -> magma.feg.GyCreditControlAnswer
-	28,  // 70: magma.feg.GyCreditControlResult.results:type_name -> magma.feg.ExpectationResult
-	27,  // 71: magma.feg.GyCreditControlResult.errors:type_name -> magma.feg.ErrorByIndex
-	35,  // 72: magma.feg.GyCreditControlExpectation.expected_request:type_name -> magma.feg.GyCreditControlRequest
-	36,  // 73: magma.feg.GyCreditControlExpectation.answer:type_name -> magma.feg.GyCreditControlAnswer
-	2,   // 74: magma.feg.GyCreditControlRequest.request_type:type_name -> magma.feg.CCRequestType
-	90,  // 75: magma.feg.GyCreditControlRequest.request_number:type_name -> google.protobuf.Int32Value
-	40,  // 76: magma.feg.GyCreditControlRequest.qos_info:type_name -> magma.feg.QosInfo
-	39,  // 77: magma.feg.GyCreditControlRequest.mscc:type_name -> magma.feg.MultipleServicesCreditControl
-	2,   // 78: magma.feg.GyCreditControlAnswer.request_type:type_name -> magma.feg.CCRequestType
-	37,  // 79: magma.feg.GyCreditControlAnswer.quota_grants:type_name -> magma.feg.QuotaGrant
-	43,  // 80: magma.feg.QuotaGrant.granted_service_unit:type_name -> magma.feg.Octets
-	14,  // 81: magma.feg.QuotaGrant.final_unit_indication:type_name -> magma.feg.FinalUnitIndication
-	1,   // 82: magma.feg.UsageMonitoringInformation.monitoring_level:type_name -> magma.feg.MonitoringLevel
-	43,  // 83: magma.feg.UsageMonitoringInformation.octets:type_name -> magma.feg.Octets
-	43,  // 84: magma.feg.MultipleServicesCreditControl.used_service_unit:type_name -> magma.feg.Octets
-	22,  // 85: magma.feg.RuleInstalls.rule_definitions:type_name -> magma.feg.RuleDefinition
-	91,  // 86: magma.feg.RuleInstalls.activation_time:type_name -> google.protobuf.Timestamp
-	91,  // 87: magma.feg.RuleInstalls.deactivation_time:type_name -> google.protobuf.Timestamp
-	42,  // 88: magma.feg.PolicyReAuthTarget.rules_to_remove:type_name -> magma.feg.RuleRemovals
-	41,  // 89: magma.feg.PolicyReAuthTarget.rules_to_install:type_name -> magma.feg.RuleInstalls
-	38,  // 90: magma.feg.PolicyReAuthTarget.usage_monitoring_infos:type_name -> magma.feg.UsageMonitoringInformation
-	57,  // 91: magma.feg.PolicyReAuthAnswer.failed_rules:type_name -> magma.feg.PolicyReAuthAnswer.FailedRulesEntry
-	54,  // 92: magma.feg.N7Expectations.expectations:type_name -> magma.feg.N7Expectation
-	3,   // 93: magma.feg.N7Expectations.unexpected_request_behavior:type_name -> magma.feg.UnexpectedRequestBehavior
-	8,   // 94: magma.feg.N7Expectation.request_type:type_name -> magma.feg.N7Expectation.RequestType
-	28,  // 95: magma.feg.N7ExpectationResult.results:type_name -> magma.feg.ExpectationResult
-	27,  // 96: magma.feg.N7ExpectationResult.errors:type_name -> magma.feg.ErrorByIndex
-	16,  // 97: magma.feg.CreditInfos.CreditInformationEntry.value:type_name -> magma.feg.CreditInfo
-	12,  // 98: magma.feg.MockCoreConfigurator.ConfigServer:input_type -> magma.feg.ServerConfiguration
-	92,  // 99: magma.feg.MockCoreConfigurator.Reset:input_type -> magma.orc8r.Void
-	15,  // 100: magma.feg.MockOCS.SetOCSSettings:input_type -> magma.feg.OCSConfig
-	16,  // 101: magma.feg.MockOCS.SetCredit:input_type -> magma.feg.CreditInfo
-	93,  // 102: magma.feg.MockOCS.CreateAccount:input_type -> magma.lte.SubscriberID
-	92,  // 103: magma.feg.MockOCS.ClearSubscribers:input_type -> magma.orc8r.Void
-	18,  // 104: magma.feg.MockOCS.ReAuth:input_type -> magma.feg.ChargingReAuthTarget
-	93,  // 105: magma.feg.MockOCS.GetCredits:input_type -> magma.lte.SubscriberID
-	46,  // 106: magma.feg.MockOCS.AbortSession:input_type -> magma.feg.AbortSessionRequest
-	32,  // 107: magma.feg.MockOCS.SetExpectations:input_type -> magma.feg.GyCreditControlExpectations
-	92,  // 108: magma.feg.MockOCS.AssertExpectations:input_type -> magma.orc8r.Void
-	20,  // 109: magma.feg.MockPCRF.SetPCRFConfigs:input_type -> magma.feg.PCRFConfigs
-	93,  // 110: magma.feg.MockPCRF.CreateAccount:input_type -> magma.lte.SubscriberID
-	21,  // 111: magma.feg.MockPCRF.SetRules:input_type -> magma.feg.AccountRules
-	23,  // 112: magma.feg.MockPCRF.SetUsageMonitors:input_type -> magma.feg.UsageMonitorConfiguration
-	92,  // 113: magma.feg.MockPCRF.ClearSubscribers:input_type -> magma.orc8r.Void
-	44,  // 114: magma.feg.MockPCRF.ReAuth:input_type -> magma.feg.PolicyReAuthTarget
-	46,  // 115: magma.feg.MockPCRF.AbortSession:input_type -> magma.feg.AbortSessionRequest
-	25,  // 116: magma.feg.MockPCRF.SetExpectations:input_type -> magma.feg.GxCreditControlExpectations
-	92,  // 117: magma.feg.MockPCRF.AssertExpectations:input_type -> magma.orc8r.Void
-	48,  // 118: magma.feg.MockPCF.SetPCFConfigs:input_type -> magma.feg.PCFConfigs
-	93,  // 119: magma.feg.MockPCF.CreateAccount:input_type -> magma.lte.SubscriberID
-	49,  // 120: magma.feg.MockPCF.SetAccountRules:input_type -> magma.feg.PolicyDecision
-	92,  // 121: magma.feg.MockPCF.ClearSubscribers:input_type -> magma.orc8r.Void
-	49,  // 122: magma.feg.MockPCF.SmPolicyUpdateNotify:input_type -> magma.feg.PolicyDecision
-	51,  // 123: magma.feg.MockPCF.SmPolicyTerminate:input_type -> magma.feg.TerminateNotification
-	53,  // 124: magma.feg.MockPCF.SetExpectations:input_type -> magma.feg.N7Expectations
-	92,  // 125: magma.feg.MockPCF.AssertExpectations:input_type -> magma.orc8r.Void
-	92,  // 126: magma.feg.MockCoreConfigurator.ConfigServer:output_type -> magma.orc8r.Void
-	92,  // 127: magma.feg.MockCoreConfigurator.Reset:output_type -> magma.orc8r.Void
-	92,  // 128: magma.feg.MockOCS.SetOCSSettings:output_type -> magma.orc8r.Void
-	92,  // 129: magma.feg.MockOCS.SetCredit:output_type -> magma.orc8r.Void
-	92,  // 130: magma.feg.MockOCS.CreateAccount:output_type -> magma.orc8r.Void
-	92,  // 131: magma.feg.MockOCS.ClearSubscribers:output_type -> magma.orc8r.Void
-	19,  // 132: magma.feg.MockOCS.ReAuth:output_type -> magma.feg.ChargingReAuthAnswer
-	17,  // 133: magma.feg.MockOCS.GetCredits:output_type -> magma.feg.CreditInfos
-	47,  // 134: magma.feg.MockOCS.AbortSession:output_type -> magma.feg.AbortSessionAnswer
-	92,  // 135: magma.feg.MockOCS.SetExpectations:output_type -> magma.orc8r.Void
-	33,  // 136: magma.feg.MockOCS.AssertExpectations:output_type -> magma.feg.GyCreditControlResult
-	92,  // 137: magma.feg.MockPCRF.SetPCRFConfigs:output_type -> magma.orc8r.Void
-	92,  // 138: magma.feg.MockPCRF.CreateAccount:output_type -> magma.orc8r.Void
-	92,  // 139: magma.feg.MockPCRF.SetRules:output_type -> magma.orc8r.Void
-	92,  // 140: magma.feg.MockPCRF.SetUsageMonitors:output_type -> magma.orc8r.Void
-	92,  // 141: magma.feg.MockPCRF.ClearSubscribers:output_type -> magma.orc8r.Void
-	45,  // 142: magma.feg.MockPCRF.ReAuth:output_type -> magma.feg.PolicyReAuthAnswer
-	47,  // 143: magma.feg.MockPCRF.AbortSession:output_type -> magma.feg.AbortSessionAnswer
-	92,  // 144: magma.feg.MockPCRF.SetExpectations:output_type -> magma.orc8r.Void
-	26,  // 145: magma.feg.MockPCRF.AssertExpectations:output_type -> magma.feg.GxCreditControlResult
-	92,  // 146: magma.feg.MockPCF.SetPCFConfigs:output_type -> magma.orc8r.Void
-	92,  // 147: magma.feg.MockPCF.CreateAccount:output_type -> magma.orc8r.Void
-	92,  // 148: magma.feg.MockPCF.SetAccountRules:output_type -> magma.orc8r.Void
-	92,  // 149: magma.feg.MockPCF.ClearSubscribers:output_type -> magma.orc8r.Void
-	50,  // 150: magma.feg.MockPCF.SmPolicyUpdateNotify:output_type -> magma.feg.UpdateNotificationAnswer
-	52,  // 151: magma.feg.MockPCF.SmPolicyTerminate:output_type -> magma.feg.TerminateNotificationAnswer
-	92,  // 152: magma.feg.MockPCF.SetExpectations:output_type -> magma.orc8r.Void
-	55,  // 153: magma.feg.MockPCF.AssertExpectations:output_type -> magma.feg.N7ExpectationResult
-	126, // [126:154] is the sub-list for method output_type
-	98,  // [98:126] is the sub-list for method input_type
-	98,  // [98:98] is the sub-list for extension type_name
-	98,  // [98:98] is the sub-list for extension extendee
-	0,   // [0:98] is the sub-list for field type_name
+	38,  // 42: magma.feg.OCSConfig.quota_timers:type_name -> magma.feg.QuotaTimers
+	44,  // 43: magma.feg.CreditInfo.volume:type_name -> magma.feg.Octets
+	7,   // 44: magma.feg.CreditInfo.unit_type:type_name -> magma.feg.CreditInfo.UnitType
+	57,  // 45: magma.feg.CreditInfos.creditInformation:type_name -> magma.feg.CreditInfos.CreditInformationEntry
+	22,  // 46: magma.feg.AccountRules.dynamic_rule_definitions:type_name -> magma.feg.RuleDefinition
+	89,  // 47: magma.feg.RuleDefinition.redirect_information:type_name -> magma.lte.RedirectInformation
+	90,  // 48: magma.feg.RuleDefinition.qos_information:type_name -> magma.lte.FlowQos
+	24,  // 49: magma.feg.UsageMonitorConfiguration.usage_monitor_credits:type_name -> magma.feg.UsageMonitor
+	39,  // 50: magma.feg.UsageMonitor.monitor_info_per_request:type_name -> magma.feg.UsageMonitoringInformation
+	44,  // 51: magma.feg.UsageMonitor.total_quota:type_name -> magma.feg.Octets
+	29,  // 52: magma.feg.GxCreditControlExpectations.expectations:type_name -> magma.feg.GxCreditControlExpectation
+	3,   // 53: magma.feg.GxCreditControlExpectations.unexpected_request_behavior:type_name -> magma.feg.UnexpectedRequestBehavior
+	31,  // 54: magma.feg.GxCreditControlExpectations.gx_default_cca:type_name -> magma.feg.GxCreditControlAnswer
+	44,  // 55: magma.feg.GxCreditControlExpectations.total_expected_usage:type_name -> magma.feg.Octets
+	28,  // 56: magma.feg.GxCreditControlResult.results:type_name -> magma.feg.ExpectationResult
+	27,  // 57: magma.feg.GxCreditControlResult.errors:type_name -> magma.feg.ErrorByIndex
+	30,  // 58: magma.feg.GxCreditControlExpectation.expected_request:type_name -> magma.feg.GxCreditControlRequest
+	31,  // 59: magma.feg.GxCreditControlExpectation.answer:type_name -> magma.feg.GxCreditControlAnswer
+	2,   // 60: magma.feg.GxCreditControlRequest.request_type:type_name -> magma.feg.CCRequestType
+	91,  // 61: magma.feg.GxCreditControlRequest.request_number:type_name -> google.protobuf.Int32Value
+	39,  // 62: magma.feg.GxCreditControlRequest.usage_monitoring_reports:type_name -> magma.feg.UsageMonitoringInformation
+	91,  // 63: magma.feg.GxCreditControlRequest.event_trigger:type_name -> google.protobuf.Int32Value
+	39,  // 64: magma.feg.GxCreditControlAnswer.usage_monitoring_infos:type_name -> magma.feg.UsageMonitoringInformation
+	42,  // 65: magma.feg.GxCreditControlAnswer.rule_installs:type_name -> magma.feg.RuleInstalls
+	43,  // 66: magma.feg.GxCreditControlAnswer.rule_removals:type_name -> magma.feg.RuleRemovals
+	92,  // 67: magma.feg.GxCreditControlAnswer.revalidation_time:type_name -> google.protobuf.Timestamp
+	34,  // 68: magma.feg.GyCreditControlExpectations.expectations:type_name -> magma.feg.GyCreditControlExpectation
+	3,   // 69: magma.feg.GyCreditControlExpectations.unexpected_request_behavior:type_name -> magma.feg.UnexpectedRequestBehavior
+	36,  // 70: magma.feg.GyCreditControlExpectations.gy_default_cca:type_name -> magma.feg.GyCreditControlAnswer
+	28,  // 71: magma.feg.GyCreditControlResult.results:type_name -> magma.feg.ExpectationResult
+	27,  // 72: magma.feg.GyCreditControlResult.errors:type_name -> magma.feg.ErrorByIndex
+	35,  // 73: magma.feg.GyCreditControlExpectation.expected_request:type_name -> magma.feg.GyCreditControlRequest
+	36,  // 74: magma.feg.GyCreditControlExpectation.answer:type_name -> magma.feg.GyCreditControlAnswer
+	2,   // 75: magma.feg.GyCreditControlRequest.request_type:type_name -> magma.feg.CCRequestType
+	91,  // 76: magma.feg.GyCreditControlRequest.request_number:type_name -> google.protobuf.Int32Value
+	41,  // 77: magma.feg.GyCreditControlRequest.qos_info:type_name -> magma.feg.QosInfo
+	40,  // 78: magma.feg.GyCreditControlRequest.mscc:type_name -> magma.feg.MultipleServicesCreditControl
+	2,   // 79: magma.feg.GyCreditControlAnswer.request_type:type_name -> magma.feg.CCRequestType
+	37,  // 80: magma.feg.GyCreditControlAnswer.quota_grants:type_name -> magma.feg.QuotaGrant
+	44,  // 81: magma.feg.QuotaGrant.granted_service_unit:type_name -> magma.feg.Octets
+	14,  // 82: magma.feg.QuotaGrant.final_unit_indication:type_name -> magma.feg.FinalUnitIndication
+	38,  // 83: magma.feg.QuotaGrant.quota_timers:type_name -> magma.feg.QuotaTimers
+	92,  // 84: magma.feg.QuotaTimers.tariff_time_change:type_name -> google.protobuf.Timestamp
+	1,   // 85: magma.feg.UsageMonitoringInformation.monitoring_level:type_name -> magma.feg.MonitoringLevel
+	44,  // 86: magma.feg.UsageMonitoringInformation.octets:type_name -> magma.feg.Octets
+	44,  // 87: magma.feg.MultipleServicesCreditControl.used_service_unit:type_name -> magma.feg.Octets
+	22,  // 88: magma.feg.RuleInstalls.rule_definitions:type_name -> magma.feg.RuleDefinition
+	92,  // 89: magma.feg.RuleInstalls.activation_time:type_name -> google.protobuf.Timestamp
+	92,  // 90: magma.feg.RuleInstalls.deactivation_time:type_name -> google.protobuf.Timestamp
+	43,  // 91: magma.feg.PolicyReAuthTarget.rules_to_remove:type_name -> magma.feg.RuleRemovals
+	42,  // 92: magma.feg.PolicyReAuthTarget.rules_to_install:type_name -> magma.feg.RuleInstalls
+	39,  // 93: magma.feg.PolicyReAuthTarget.usage_monitoring_infos:type_name -> magma.feg.UsageMonitoringInformation
+	58,  // 94: magma.feg.PolicyReAuthAnswer.failed_rules:type_name -> magma.feg.PolicyReAuthAnswer.FailedRulesEntry
+	55,  // 95: magma.feg.N7Expectations.expectations:type_name -> magma.feg.N7Expectation
+	3,   // 96: magma.feg.N7Expectations.unexpected_request_behavior:type_name -> magma.feg.UnexpectedRequestBehavior
+	8,   // 97: magma.feg.N7Expectation.request_type:type_name -> magma.feg.N7Expectation.RequestType
+	28,  // 98: magma.feg.N7ExpectationResult.results:type_name -> magma.feg.ExpectationResult
+	27,  // 99: magma.feg.N7ExpectationResult.errors:type_name -> magma.feg.ErrorByIndex
+	16,  // 100: magma.feg.CreditInfos.CreditInformationEntry.value:type_name -> magma.feg.CreditInfo
+	12,  // 101: magma.feg.MockCoreConfigurator.ConfigServer:input_type -> magma.feg.ServerConfiguration
+	93,  // 102: magma.feg.MockCoreConfigurator.Reset:input_type -> magma.orc8r.Void
+	15,  // 103: magma.feg.MockOCS.SetOCSSettings:input_type -> magma.feg.OCSConfig
+	16,  // 104: magma.feg.MockOCS.SetCredit:input_type -> magma.feg.CreditInfo
+	94,  // 105: magma.feg.MockOCS.CreateAccount:input_type -> magma.lte.SubscriberID
+	93,  // 106: magma.feg.MockOCS.ClearSubscribers:input_type -> magma.orc8r.Void
+	18,  // 107: magma.feg.MockOCS.ReAuth:input_type -> magma.feg.ChargingReAuthTarget
+	94,  // 108: magma.feg.MockOCS.GetCredits:input_type -> magma.lte.SubscriberID
+	47,  // 109: magma.feg.MockOCS.AbortSession:input_type -> magma.feg.AbortSessionRequest
+	32,  // 110: magma.feg.MockOCS.SetExpectations:input_type -> magma.feg.GyCreditControlExpectations
+	93,  // 111: magma.feg.MockOCS.AssertExpectations:input_type -> magma.orc8r.Void
+	20,  // 112: magma.feg.MockPCRF.SetPCRFConfigs:input_type -> magma.feg.PCRFConfigs
+	94,  // 113: magma.feg.MockPCRF.CreateAccount:input_type -> magma.lte.SubscriberID
+	21,  // 114: magma.feg.MockPCRF.SetRules:input_type -> magma.feg.AccountRules
+	23,  // 115: magma.feg.MockPCRF.SetUsageMonitors:input_type -> magma.feg.UsageMonitorConfiguration
+	93,  // 116: magma.feg.MockPCRF.ClearSubscribers:input_type -> magma.orc8r.Void
+	45,  // 117: magma.feg.MockPCRF.ReAuth:input_type -> magma.feg.PolicyReAuthTarget
+	47,  // 118: magma.feg.MockPCRF.AbortSession:input_type -> magma.feg.AbortSessionRequest
+	25,  // 119: magma.feg.MockPCRF.SetExpectations:input_type -> magma.feg.GxCreditControlExpectations
+	93,  // 120: magma.feg.MockPCRF.AssertExpectations:input_type -> magma.orc8r.Void
+	49,  // 121: magma.feg.MockPCF.SetPCFConfigs:input_type -> magma.feg.PCFConfigs
+	94,  // 122: magma.feg.MockPCF.CreateAccount:input_type -> magma.lte.SubscriberID
+	50,  // 123: magma.feg.MockPCF.SetAccountRules:input_type -> magma.feg.PolicyDecision
+	93,  // 124: magma.feg.MockPCF.ClearSubscribers:input_type -> magma.orc8r.Void
+	50,  // 125: magma.feg.MockPCF.SmPolicyUpdateNotify:input_type -> magma.feg.PolicyDecision
+	52,  // 126: magma.feg.MockPCF.SmPolicyTerminate:input_type -> magma.feg.TerminateNotification
+	54,  // 127: magma.feg.MockPCF.SetExpectations:input_type -> magma.feg.N7Expectations
+	93,  // 128: magma.feg.MockPCF.AssertExpectations:input_type -> magma.orc8r.Void
+	93,  // 129: magma.feg.MockCoreConfigurator.ConfigServer:output_type -> magma.orc8r.Void
+	93,  // 130: magma.feg.MockCoreConfigurator.Reset:output_type -> magma.orc8r.Void
+	93,  // 131: magma.feg.MockOCS.SetOCSSettings:output_type -> magma.orc8r.Void
+	93,  // 132: magma.feg.MockOCS.SetCredit:output_type -> magma.orc8r.Void
+	93,  // 133: magma.feg.MockOCS.CreateAccount:output_type -> magma.orc8r.Void
+	93,  // 134: magma.feg.MockOCS.ClearSubscribers:output_type -> magma.orc8r.Void
+	19,  // 135: magma.feg.MockOCS.ReAuth:output_type -> magma.feg.ChargingReAuthAnswer
+	17,  // 136: magma.feg.MockOCS.GetCredits:output_type -> magma.feg.CreditInfos
+	48,  // 137: magma.feg.MockOCS.AbortSession:output_type -> magma.feg.AbortSessionAnswer
+	93,  // 138: magma.feg.MockOCS.SetExpectations:output_type -> magma.orc8r.Void
+	33,  // 139: magma.feg.MockOCS.AssertExpectations:output_type -> magma.feg.GyCreditControlResult
+	93,  // 140: magma.feg.MockPCRF.SetPCRFConfigs:output_type -> magma.orc8r.Void
+	93,  // 141: magma.feg.MockPCRF.CreateAccount:output_type -> magma.orc8r.Void
+	93,  // 142: magma.feg.MockPCRF.SetRules:output_type -> magma.orc8r.Void
+	93,  // 143: magma.feg.MockPCRF.SetUsageMonitors:output_type -> magma.orc8r.Void
+	93,  // 144: magma.feg.MockPCRF.ClearSubscribers:output_type -> magma.orc8r.Void
+	46,  // 145: magma.feg.MockPCRF.ReAuth:output_type -> magma.feg.PolicyReAuthAnswer
+	48,  // 146: magma.feg.MockPCRF.AbortSession:output_type -> magma.feg.AbortSessionAnswer
+	93,  // 147: magma.feg.MockPCRF.SetExpectations:output_type -> magma.orc8r.Void
+	26,  // 148: magma.feg.MockPCRF.AssertExpectations:output_type -> magma.feg.GxCreditControlResult
+	93,  // 149: magma.feg.MockPCF.SetPCFConfigs:output_type -> magma.orc8r.Void
+	93,  // 150: magma.feg.MockPCF.CreateAccount:output_type -> magma.orc8r.Void
+	93,  // 151: magma.feg.MockPCF.SetAccountRules:output_type -> magma.orc8r.Void
+	93,  // 152: magma.feg.MockPCF.ClearSubscribers:output_type -> magma.orc8r.Void
+	51,  // 153: magma.feg.MockPCF.SmPolicyUpdateNotify:output_type -> magma.feg.UpdateNotificationAnswer
+	53,  // 154: magma.feg.MockPCF.SmPolicyTerminate:output_type -> magma.feg.TerminateNotificationAnswer
+	93,  // 155: magma.feg.MockPCF.SetExpectations:output_type -> magma.orc8r.Void
+	56,  // 156: magma.feg.MockPCF.AssertExpectations:output_type -> magma.feg.N7ExpectationResult
+	129, // [129:157] is the sub-list for method output_type
+	101, // [101:129] is the sub-list for method input_type
+	101, // [101:101] is the sub-list for extension type_name
+	101, // [101:101] is the sub-list for extension extendee
+	0,   // [0:101] is the sub-list for field type_name
 }
 
 func init() { file_feg_protos_mock_core_proto_init() }
@@ -5416,7 +5554,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsageMonitoringInformation); i {
+			switch v := v.(*QuotaTimers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5428,7 +5566,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultipleServicesCreditControl); i {
+			switch v := v.(*UsageMonitoringInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5440,7 +5578,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QosInfo); i {
+			switch v := v.(*MultipleServicesCreditControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5452,7 +5590,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuleInstalls); i {
+			switch v := v.(*QosInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5464,7 +5602,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuleRemovals); i {
+			switch v := v.(*RuleInstalls); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5476,7 +5614,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Octets); i {
+			switch v := v.(*RuleRemovals); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5488,7 +5626,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyReAuthTarget); i {
+			switch v := v.(*Octets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5500,7 +5638,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyReAuthAnswer); i {
+			switch v := v.(*PolicyReAuthTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5512,7 +5650,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AbortSessionRequest); i {
+			switch v := v.(*PolicyReAuthAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5524,7 +5662,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AbortSessionAnswer); i {
+			switch v := v.(*AbortSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5536,7 +5674,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PCFConfigs); i {
+			switch v := v.(*AbortSessionAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5548,7 +5686,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyDecision); i {
+			switch v := v.(*PCFConfigs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5560,7 +5698,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateNotificationAnswer); i {
+			switch v := v.(*PolicyDecision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5572,7 +5710,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TerminateNotification); i {
+			switch v := v.(*UpdateNotificationAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5584,7 +5722,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TerminateNotificationAnswer); i {
+			switch v := v.(*TerminateNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5596,7 +5734,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7Expectations); i {
+			switch v := v.(*TerminateNotificationAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5608,7 +5746,7 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7Expectation); i {
+			switch v := v.(*N7Expectations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5620,6 +5758,18 @@ func file_feg_protos_mock_core_proto_init() {
 			}
 		}
 		file_feg_protos_mock_core_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*N7Expectation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mock_core_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*N7ExpectationResult); i {
 			case 0:
 				return &v.state
@@ -5675,7 +5825,7 @@ func file_feg_protos_mock_core_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feg_protos_mock_core_proto_rawDesc,
 			NumEnums:      9,
-			NumMessages:   49,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
@@ -1098,6 +1098,460 @@ func (x *ResetAnswer) GetErrorCode() ErrorCode {
 	return ErrorCode_UNDEFINED
 }
 
+// Insert Subscriber Data Request (Section 7.2.9)
+type InsertSubscriberDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Subscription-Data AVP content, see UpdateLocationAnswer for field details
+	Msisdn           []byte                                         `protobuf:"bytes,2,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	DefaultContextId uint32                                         `protobuf:"varint,3,opt,name=default_context_id,json=defaultContextId,proto3" json:"default_context_id,omitempty"`
+	TotalAmbr        *UpdateLocationAnswer_AggregatedMaximumBitrate `protobuf:"bytes,4,opt,name=total_ambr,json=totalAmbr,proto3" json:"total_ambr,omitempty"`
+	// Indicates to wipe other stored APNs
+	AllApnsIncluded                bool                                     `protobuf:"varint,5,opt,name=all_apns_included,json=allApnsIncluded,proto3" json:"all_apns_included,omitempty"`
+	Apn                            []*UpdateLocationAnswer_APNConfiguration `protobuf:"bytes,6,rep,name=apn,proto3" json:"apn,omitempty"`
+	DefaultChargingCharacteristics string                                   `protobuf:"bytes,7,opt,name=default_charging_characteristics,json=defaultChargingCharacteristics,proto3" json:"default_charging_characteristics,omitempty"`
+	NetworkAccessMode              UpdateLocationAnswer_NetworkAccessMode   `protobuf:"varint,8,opt,name=network_access_mode,json=networkAccessMode,proto3,enum=magma.feg.UpdateLocationAnswer_NetworkAccessMode" json:"network_access_mode,omitempty"`
+	RegionalSubscriptionZoneCode   [][]byte                                 `protobuf:"bytes,9,rep,name=regional_subscription_zone_code,json=regionalSubscriptionZoneCode,proto3" json:"regional_subscription_zone_code,omitempty"`
+}
+
+func (x *InsertSubscriberDataRequest) Reset() {
+	*x = InsertSubscriberDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertSubscriberDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertSubscriberDataRequest) ProtoMessage() {}
+
+func (x *InsertSubscriberDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertSubscriberDataRequest.ProtoReflect.Descriptor instead.
+func (*InsertSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InsertSubscriberDataRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *InsertSubscriberDataRequest) GetMsisdn() []byte {
+	if x != nil {
+		return x.Msisdn
+	}
+	return nil
+}
+
+func (x *InsertSubscriberDataRequest) GetDefaultContextId() uint32 {
+	if x != nil {
+		return x.DefaultContextId
+	}
+	return 0
+}
+
+func (x *InsertSubscriberDataRequest) GetTotalAmbr() *UpdateLocationAnswer_AggregatedMaximumBitrate {
+	if x != nil {
+		return x.TotalAmbr
+	}
+	return nil
+}
+
+func (x *InsertSubscriberDataRequest) GetAllApnsIncluded() bool {
+	if x != nil {
+		return x.AllApnsIncluded
+	}
+	return false
+}
+
+func (x *InsertSubscriberDataRequest) GetApn() []*UpdateLocationAnswer_APNConfiguration {
+	if x != nil {
+		return x.Apn
+	}
+	return nil
+}
+
+func (x *InsertSubscriberDataRequest) GetDefaultChargingCharacteristics() string {
+	if x != nil {
+		return x.DefaultChargingCharacteristics
+	}
+	return ""
+}
+
+func (x *InsertSubscriberDataRequest) GetNetworkAccessMode() UpdateLocationAnswer_NetworkAccessMode {
+	if x != nil {
+		return x.NetworkAccessMode
+	}
+	return UpdateLocationAnswer_PACKET_AND_CIRCUIT
+}
+
+func (x *InsertSubscriberDataRequest) GetRegionalSubscriptionZoneCode() [][]byte {
+	if x != nil {
+		return x.RegionalSubscriptionZoneCode
+	}
+	return nil
+}
+
+// Insert Subscriber Data Answer (Section 7.2.10)
+type InsertSubscriberDataAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EPC error code on failure
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+}
+
+func (x *InsertSubscriberDataAnswer) Reset() {
+	*x = InsertSubscriberDataAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertSubscriberDataAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertSubscriberDataAnswer) ProtoMessage() {}
+
+func (x *InsertSubscriberDataAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertSubscriberDataAnswer.ProtoReflect.Descriptor instead.
+func (*InsertSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InsertSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+// Delete Subscriber Data Request (Section 7.2.11)
+type DeleteSubscriberDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Selective unrolling of DSR-Flags 29.272 Table 7.3.26/1
+	RegionalSubscriptionWithdrawal              bool `protobuf:"varint,2,opt,name=regional_subscription_withdrawal,json=regionalSubscriptionWithdrawal,proto3" json:"regional_subscription_withdrawal,omitempty"`                                          // bit 0
+	CompleteApnConfigurationProfileWithdrawal   bool `protobuf:"varint,3,opt,name=complete_apn_configuration_profile_withdrawal,json=completeApnConfigurationProfileWithdrawal,proto3" json:"complete_apn_configuration_profile_withdrawal,omitempty"`     // bit 1
+	SubscribedChargingCharacteristicsWithdrawal bool `protobuf:"varint,4,opt,name=subscribed_charging_characteristics_withdrawal,json=subscribedChargingCharacteristicsWithdrawal,proto3" json:"subscribed_charging_characteristics_withdrawal,omitempty"` // bit 2
+	PdnSubscriptionContextsWithdrawal           bool `protobuf:"varint,5,opt,name=pdn_subscription_contexts_withdrawal,json=pdnSubscriptionContextsWithdrawal,proto3" json:"pdn_subscription_contexts_withdrawal,omitempty"`                               // bit 3
+	// Identifiers of the withdrawn APN configurations
+	// (only with pdn_subscription_contexts_withdrawal)
+	ContextId []uint32 `protobuf:"varint,6,rep,packed,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+}
+
+func (x *DeleteSubscriberDataRequest) Reset() {
+	*x = DeleteSubscriberDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriberDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriberDataRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriberDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriberDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteSubscriberDataRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *DeleteSubscriberDataRequest) GetRegionalSubscriptionWithdrawal() bool {
+	if x != nil {
+		return x.RegionalSubscriptionWithdrawal
+	}
+	return false
+}
+
+func (x *DeleteSubscriberDataRequest) GetCompleteApnConfigurationProfileWithdrawal() bool {
+	if x != nil {
+		return x.CompleteApnConfigurationProfileWithdrawal
+	}
+	return false
+}
+
+func (x *DeleteSubscriberDataRequest) GetSubscribedChargingCharacteristicsWithdrawal() bool {
+	if x != nil {
+		return x.SubscribedChargingCharacteristicsWithdrawal
+	}
+	return false
+}
+
+func (x *DeleteSubscriberDataRequest) GetPdnSubscriptionContextsWithdrawal() bool {
+	if x != nil {
+		return x.PdnSubscriptionContextsWithdrawal
+	}
+	return false
+}
+
+func (x *DeleteSubscriberDataRequest) GetContextId() []uint32 {
+	if x != nil {
+		return x.ContextId
+	}
+	return nil
+}
+
+// Delete Subscriber Data Answer (Section 7.2.12)
+type DeleteSubscriberDataAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EPC error code on failure
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+}
+
+func (x *DeleteSubscriberDataAnswer) Reset() {
+	*x = DeleteSubscriberDataAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriberDataAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriberDataAnswer) ProtoMessage() {}
+
+func (x *DeleteSubscriberDataAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriberDataAnswer.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+// Notify Request (Section 7.2.17)
+type NotifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// PDN GW identity (MIP6-Agent-Info) allocated for the APN
+	PgwAddress string `protobuf:"bytes,2,opt,name=pgw_address,json=pgwAddress,proto3" json:"pgw_address,omitempty"`
+	// APN configuration the PDN GW was allocated for
+	ContextId        uint32 `protobuf:"varint,3,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	ServiceSelection string `protobuf:"bytes,4,opt,name=service_selection,json=serviceSelection,proto3" json:"service_selection,omitempty"`
+	// Selective unrolling of NOR-Flags 29.272 Table 7.3.49/1
+	UeReachableFromMme             bool `protobuf:"varint,5,opt,name=ue_reachable_from_mme,json=ueReachableFromMme,proto3" json:"ue_reachable_from_mme,omitempty"`                                         // bit 3
+	ReadyForSmFromMme              bool `protobuf:"varint,6,opt,name=ready_for_sm_from_mme,json=readyForSmFromMme,proto3" json:"ready_for_sm_from_mme,omitempty"`                                          // bit 6
+	RemovalOfMmeRegistrationForSms bool `protobuf:"varint,7,opt,name=removal_of_mme_registration_for_sms,json=removalOfMmeRegistrationForSms,proto3" json:"removal_of_mme_registration_for_sms,omitempty"` // bit 9
+}
+
+func (x *NotifyRequest) Reset() {
+	*x = NotifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRequest) ProtoMessage() {}
+
+func (x *NotifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRequest.ProtoReflect.Descriptor instead.
+func (*NotifyRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NotifyRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *NotifyRequest) GetPgwAddress() string {
+	if x != nil {
+		return x.PgwAddress
+	}
+	return ""
+}
+
+func (x *NotifyRequest) GetContextId() uint32 {
+	if x != nil {
+		return x.ContextId
+	}
+	return 0
+}
+
+func (x *NotifyRequest) GetServiceSelection() string {
+	if x != nil {
+		return x.ServiceSelection
+	}
+	return ""
+}
+
+func (x *NotifyRequest) GetUeReachableFromMme() bool {
+	if x != nil {
+		return x.UeReachableFromMme
+	}
+	return false
+}
+
+func (x *NotifyRequest) GetReadyForSmFromMme() bool {
+	if x != nil {
+		return x.ReadyForSmFromMme
+	}
+	return false
+}
+
+func (x *NotifyRequest) GetRemovalOfMmeRegistrationForSms() bool {
+	if x != nil {
+		return x.RemovalOfMmeRegistrationForSms
+	}
+	return false
+}
+
+// Notify Answer (Section 7.2.18)
+type NotifyAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EPC error code on failure
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+}
+
+func (x *NotifyAnswer) Reset() {
+	*x = NotifyAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyAnswer) ProtoMessage() {}
+
+func (x *NotifyAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyAnswer.ProtoReflect.Descriptor instead.
+func (*NotifyAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NotifyAnswer) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
 // Feature ID list (3GPP TS 29.229 Table 7.1.1)
 type FeatureListId2 struct {
 	state         protoimpl.MessageState
@@ -1111,7 +1565,7 @@ type FeatureListId2 struct {
 func (x *FeatureListId2) Reset() {
 	*x = FeatureListId2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[10]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1124,7 +1578,7 @@ func (x *FeatureListId2) String() string {
 func (*FeatureListId2) ProtoMessage() {}
 
 func (x *FeatureListId2) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[10]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1591,7 @@ func (x *FeatureListId2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatureListId2.ProtoReflect.Descriptor instead.
 func (*FeatureListId2) Descriptor() ([]byte, []int) {
-	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{10}
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *FeatureListId2) GetNrAsSecondaryRat() bool {
@@ -1160,7 +1614,7 @@ type FeatureListId1 struct {
 func (x *FeatureListId1) Reset() {
 	*x = FeatureListId1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[11]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1173,7 +1627,7 @@ func (x *FeatureListId1) String() string {
 func (*FeatureListId1) ProtoMessage() {}
 
 func (x *FeatureListId1) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[11]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,7 +1640,7 @@ func (x *FeatureListId1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatureListId1.ProtoReflect.Descriptor instead.
 func (*FeatureListId1) Descriptor() ([]byte, []int) {
-	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{11}
+	return file_feg_protos_s6a_proxy_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *FeatureListId1) GetRegionalSubscription() bool {
@@ -1212,7 +1666,7 @@ type AuthenticationInformationAnswer_EUTRANVector struct {
 func (x *AuthenticationInformationAnswer_EUTRANVector) Reset() {
 	*x = AuthenticationInformationAnswer_EUTRANVector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[12]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1225,7 +1679,7 @@ func (x *AuthenticationInformationAnswer_EUTRANVector) String() string {
 func (*AuthenticationInformationAnswer_EUTRANVector) ProtoMessage() {}
 
 func (x *AuthenticationInformationAnswer_EUTRANVector) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[12]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,7 +1739,7 @@ type AuthenticationInformationAnswer_UTRANVector struct {
 func (x *AuthenticationInformationAnswer_UTRANVector) Reset() {
 	*x = AuthenticationInformationAnswer_UTRANVector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[13]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1298,7 +1752,7 @@ func (x *AuthenticationInformationAnswer_UTRANVector) String() string {
 func (*AuthenticationInformationAnswer_UTRANVector) ProtoMessage() {}
 
 func (x *AuthenticationInformationAnswer_UTRANVector) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[13]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +1817,7 @@ type AuthenticationInformationAnswer_GERANVector struct {
 func (x *AuthenticationInformationAnswer_GERANVector) Reset() {
 	*x = AuthenticationInformationAnswer_GERANVector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[14]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1376,7 +1830,7 @@ func (x *AuthenticationInformationAnswer_GERANVector) String() string {
 func (*AuthenticationInformationAnswer_GERANVector) ProtoMessage() {}
 
 func (x *AuthenticationInformationAnswer_GERANVector) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[14]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,7 +1892,7 @@ type UpdateLocationAnswer_APNConfiguration struct {
 func (x *UpdateLocationAnswer_APNConfiguration) Reset() {
 	*x = UpdateLocationAnswer_APNConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[15]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1451,7 +1905,7 @@ func (x *UpdateLocationAnswer_APNConfiguration) String() string {
 func (*UpdateLocationAnswer_APNConfiguration) ProtoMessage() {}
 
 func (x *UpdateLocationAnswer_APNConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[15]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +1993,7 @@ type UpdateLocationAnswer_AggregatedMaximumBitrate struct {
 func (x *UpdateLocationAnswer_AggregatedMaximumBitrate) Reset() {
 	*x = UpdateLocationAnswer_AggregatedMaximumBitrate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[16]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1552,7 +2006,7 @@ func (x *UpdateLocationAnswer_AggregatedMaximumBitrate) String() string {
 func (*UpdateLocationAnswer_AggregatedMaximumBitrate) ProtoMessage() {}
 
 func (x *UpdateLocationAnswer_AggregatedMaximumBitrate) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[16]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,7 +2058,7 @@ type UpdateLocationAnswer_APNConfiguration_QoSProfile struct {
 func (x *UpdateLocationAnswer_APNConfiguration_QoSProfile) Reset() {
 	*x = UpdateLocationAnswer_APNConfiguration_QoSProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[17]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1617,7 +2071,7 @@ func (x *UpdateLocationAnswer_APNConfiguration_QoSProfile) String() string {
 func (*UpdateLocationAnswer_APNConfiguration_QoSProfile) ProtoMessage() {}
 
 func (x *UpdateLocationAnswer_APNConfiguration_QoSProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[17]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +2130,7 @@ type UpdateLocationAnswer_APNConfiguration_APNResource struct {
 func (x *UpdateLocationAnswer_APNConfiguration_APNResource) Reset() {
 	*x = UpdateLocationAnswer_APNConfiguration_APNResource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[18]
+		mi := &file_feg_protos_s6a_proxy_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1689,7 +2143,7 @@ func (x *UpdateLocationAnswer_APNConfiguration_APNResource) String() string {
 func (*UpdateLocationAnswer_APNConfiguration_APNResource) ProtoMessage() {}
 
 func (x *UpdateLocationAnswer_APNConfiguration_APNResource) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[18]
+	mi := &file_feg_protos_s6a_proxy_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,85 +2452,202 @@ var file_feg_protos_s6a_proxy_proto_rawDesc = []byte{
 	0x12, 0x33, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
 	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x3f, 0x0a, 0x0e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x32, 0x12, 0x2d, 0x0a, 0x13, 0x6e, 0x72, 0x5f, 0x61, 0x73,
-	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x72, 0x41, 0x73, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x61, 0x72, 0x79, 0x52, 0x61, 0x74, 0x22, 0x45, 0x0a, 0x0e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x31, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xf0, 0x04,
-	0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55,
-	0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x10, 0x4d, 0x55,
-	0x4c, 0x54, 0x49, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0xe9,
-	0x07, 0x12, 0x0c, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xd1, 0x0f, 0x12,
-	0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
-	0x53, 0x53, 0x10, 0xd2, 0x0f, 0x12, 0x18, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44,
-	0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0xb9, 0x17, 0x12,
-	0x16, 0x0a, 0x11, 0x55, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c,
-	0x49, 0x56, 0x45, 0x52, 0x10, 0xba, 0x17, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x4c, 0x4d,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0xbb, 0x17, 0x12, 0x0d,
-	0x0a, 0x08, 0x54, 0x4f, 0x4f, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbc, 0x17, 0x12, 0x12, 0x0a,
-	0x0d, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0xbd,
-	0x17, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4e,
-	0x44, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xbe, 0x17, 0x12, 0x1c, 0x0a, 0x17, 0x41,
-	0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50,
-	0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0xbf, 0x17, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x48, 0x44, 0x52, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x10, 0xc0, 0x17,
-	0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x56, 0x50, 0x5f,
-	0x42, 0x49, 0x54, 0x53, 0x10, 0xc1, 0x17, 0x12, 0x11, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0xc2, 0x17, 0x12, 0x1c, 0x0a, 0x17, 0x41, 0x55,
-	0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a,
-	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0xa1, 0x1f, 0x12, 0x11, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x5f,
-	0x4f, 0x46, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0xa2, 0x1f, 0x12, 0x12, 0x0a, 0x0d, 0x45,
-	0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0xa3, 0x1f, 0x12,
-	0x1b, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x8b, 0x27, 0x12, 0x11, 0x0a, 0x0c,
-	0x55, 0x53, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x89, 0x27, 0x12,
-	0x17, 0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49,
-	0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x8a, 0x27, 0x12, 0x1d, 0x0a, 0x18, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x50, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0xac, 0x2a, 0x12, 0x14, 0x0a, 0x0f, 0x52, 0x41, 0x54, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0xad, 0x2a, 0x12, 0x18, 0x0a,
-	0x13, 0x52, 0x4f, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c,
-	0x4f, 0x57, 0x45, 0x44, 0x10, 0x8c, 0x27, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x51, 0x55, 0x49, 0x50,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xae, 0x2a, 0x12,
-	0x19, 0x0a, 0x14, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
-	0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0xaf, 0x2a, 0x12, 0x24, 0x0a, 0x1f, 0x41, 0x55,
-	0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x54,
-	0x41, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xd5, 0x20,
-	0x32, 0x9b, 0x02, 0x0a, 0x08, 0x53, 0x36, 0x61, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x76, 0x0a,
-	0x19, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07,
-	0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x45, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x66, 0x65, 0x67, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50,
-	0x75, 0x72, 0x67, 0x65, 0x55, 0x45, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x32, 0xa6,
-	0x01, 0x0a, 0x11, 0x53, 0x36, 0x61, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
-	0x65, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbd, 0x04, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42,
+	0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x62,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x70, 0x6e, 0x73, 0x5f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c,
+	0x6c, 0x41, 0x70, 0x6e, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x42, 0x0a,
+	0x03, 0x61, 0x70, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x41, 0x50, 0x4e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x70,
+	0x6e, 0x12, 0x48, 0x0a, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x61, 0x0a, 0x13, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x11, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x45,
+	0x0a, 0x1f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x1c, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5a, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x51, 0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbb, 0x03, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x20, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x1e, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12,
+	0x60, 0x0a, 0x2d, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x70, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x29, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x12, 0x63, 0x0a, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x2b, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x4f, 0x0a, 0x24, 0x70, 0x64, 0x6e, 0x5f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x21, 0x70, 0x64, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xcb, 0x02, 0x0a, 0x0d, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x67, 0x77, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x65, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x6d, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x61, 0x64, 0x79, 0x46, 0x6f,
+	0x72, 0x53, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x6d, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c,
+	0x4f, 0x66, 0x4d, 0x6d, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x6f, 0x72, 0x53, 0x6d, 0x73, 0x22, 0x43, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x3f, 0x0a, 0x0e,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x32, 0x12, 0x2d,
+	0x0a, 0x13, 0x6e, 0x72, 0x5f, 0x61, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x5f, 0x72, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x72, 0x41,
+	0x73, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x52, 0x61, 0x74, 0x22, 0x45, 0x0a,
+	0x0e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x31, 0x12,
+	0x33, 0x0a, 0x15, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xf0, 0x04, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x10, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0xe9, 0x07, 0x12, 0x0c, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0xd1, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45,
+	0x44, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xd2, 0x0f, 0x12, 0x18, 0x0a, 0x13,
+	0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0xb9, 0x17, 0x12, 0x16, 0x0a, 0x11, 0x55, 0x4e, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0xba, 0x17, 0x12, 0x15,
+	0x0a, 0x10, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x45, 0x44, 0x10, 0xbb, 0x17, 0x12, 0x0d, 0x0a, 0x08, 0x54, 0x4f, 0x4f, 0x5f, 0x42, 0x55, 0x53,
+	0x59, 0x10, 0xbc, 0x17, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x44, 0x45, 0x54,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0xbd, 0x17, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x45, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0xbe, 0x17, 0x12, 0x1c, 0x0a, 0x17, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0xbf, 0x17,
+	0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x48, 0x44, 0x52, 0x5f,
+	0x42, 0x49, 0x54, 0x53, 0x10, 0xc0, 0x17, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x56, 0x50, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x10, 0xc1, 0x17, 0x12, 0x11,
+	0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0xc2,
+	0x17, 0x12, 0x1c, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0xa1, 0x1f, 0x12,
+	0x11, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10,
+	0xa2, 0x1f, 0x12, 0x12, 0x0a, 0x0d, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c,
+	0x4f, 0x53, 0x54, 0x10, 0xa3, 0x1f, 0x12, 0x1b, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52,
+	0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x8b, 0x27, 0x12, 0x11, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x89, 0x27, 0x12, 0x17, 0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x8a, 0x27, 0x12,
+	0x1d, 0x0a, 0x18, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x50, 0x53, 0x5f, 0x53,
+	0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xac, 0x2a, 0x12, 0x14,
+	0x0a, 0x0f, 0x52, 0x41, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
+	0x44, 0x10, 0xad, 0x2a, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x4f, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x8c, 0x27, 0x12, 0x16,
+	0x0a, 0x11, 0x45, 0x51, 0x55, 0x49, 0x50, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0xae, 0x2a, 0x12, 0x19, 0x0a, 0x14, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0xaf,
+	0x2a, 0x12, 0x24, 0x0a, 0x1f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0xd5, 0x20, 0x32, 0xda, 0x02, 0x0a, 0x08, 0x53, 0x36, 0x61, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x76, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x45, 0x12, 0x19,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x55, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x45, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12,
+	0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x22, 0x00, 0x32, 0xf8, 0x02, 0x0a, 0x11, 0x53, 0x36, 0x61, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x67, 0x0a,
+	0x14, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65,
+	0x67, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x42,
+	0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2092,7 +2663,7 @@ func file_feg_protos_s6a_proxy_proto_rawDescGZIP() []byte {
 }
 
 var file_feg_protos_s6a_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_feg_protos_s6a_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_feg_protos_s6a_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_feg_protos_s6a_proxy_proto_goTypes = []interface{}{
 	(ErrorCode)(0), // 0: magma.feg.ErrorCode
 	(UpdateLocationAnswer_NetworkAccessMode)(0),                         // 1: magma.feg.UpdateLocationAnswer.NetworkAccessMode
@@ -2109,54 +2680,72 @@ var file_feg_protos_s6a_proxy_proto_goTypes = []interface{}{
 	(*PurgeUEAnswer)(nil),                                               // 12: magma.feg.PurgeUEAnswer
 	(*ResetRequest)(nil),                                                // 13: magma.feg.ResetRequest
 	(*ResetAnswer)(nil),                                                 // 14: magma.feg.ResetAnswer
-	(*FeatureListId2)(nil),                                              // 15: magma.feg.FeatureListId2
-	(*FeatureListId1)(nil),                                              // 16: magma.feg.FeatureListId1
-	(*AuthenticationInformationAnswer_EUTRANVector)(nil),                // 17: magma.feg.AuthenticationInformationAnswer.EUTRANVector
-	(*AuthenticationInformationAnswer_UTRANVector)(nil),                 // 18: magma.feg.AuthenticationInformationAnswer.UTRANVector
-	(*AuthenticationInformationAnswer_GERANVector)(nil),                 // 19: magma.feg.AuthenticationInformationAnswer.GERANVector
-	(*UpdateLocationAnswer_APNConfiguration)(nil),                       // 20: magma.feg.UpdateLocationAnswer.APNConfiguration
-	(*UpdateLocationAnswer_AggregatedMaximumBitrate)(nil),               // 21: magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate
-	(*UpdateLocationAnswer_APNConfiguration_QoSProfile)(nil),            // 22: magma.feg.UpdateLocationAnswer.APNConfiguration.QoSProfile
-	(*UpdateLocationAnswer_APNConfiguration_APNResource)(nil),           // 23: magma.feg.UpdateLocationAnswer.APNConfiguration.APNResource
+	(*InsertSubscriberDataRequest)(nil),                                 // 15: magma.feg.InsertSubscriberDataRequest
+	(*InsertSubscriberDataAnswer)(nil),                                  // 16: magma.feg.InsertSubscriberDataAnswer
+	(*DeleteSubscriberDataRequest)(nil),                                 // 17: magma.feg.DeleteSubscriberDataRequest
+	(*DeleteSubscriberDataAnswer)(nil),                                  // 18: magma.feg.DeleteSubscriberDataAnswer
+	(*NotifyRequest)(nil),                                               // 19: magma.feg.NotifyRequest
+	(*NotifyAnswer)(nil),                                                // 20: magma.feg.NotifyAnswer
+	(*FeatureListId2)(nil),                                              // 21: magma.feg.FeatureListId2
+	(*FeatureListId1)(nil),                                              // 22: magma.feg.FeatureListId1
+	(*AuthenticationInformationAnswer_EUTRANVector)(nil),                // 23: magma.feg.AuthenticationInformationAnswer.EUTRANVector
+	(*AuthenticationInformationAnswer_UTRANVector)(nil),                 // 24: magma.feg.AuthenticationInformationAnswer.UTRANVector
+	(*AuthenticationInformationAnswer_GERANVector)(nil),                 // 25: magma.feg.AuthenticationInformationAnswer.GERANVector
+	(*UpdateLocationAnswer_APNConfiguration)(nil),                       // 26: magma.feg.UpdateLocationAnswer.APNConfiguration
+	(*UpdateLocationAnswer_AggregatedMaximumBitrate)(nil),               // 27: magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate
+	(*UpdateLocationAnswer_APNConfiguration_QoSProfile)(nil),            // 28: magma.feg.UpdateLocationAnswer.APNConfiguration.QoSProfile
+	(*UpdateLocationAnswer_APNConfiguration_APNResource)(nil),           // 29: magma.feg.UpdateLocationAnswer.APNConfiguration.APNResource
 }
 var file_feg_protos_s6a_proxy_proto_depIdxs = []int32{
-	15, // 0: magma.feg.AuthenticationInformationRequest.feature_list_id_2:type_name -> magma.feg.FeatureListId2
+	21, // 0: magma.feg.AuthenticationInformationRequest.feature_list_id_2:type_name -> magma.feg.FeatureListId2
 	0,  // 1: magma.feg.AuthenticationInformationAnswer.error_code:type_name -> magma.feg.ErrorCode
-	17, // 2: magma.feg.AuthenticationInformationAnswer.eutran_vectors:type_name -> magma.feg.AuthenticationInformationAnswer.EUTRANVector
-	18, // 3: magma.feg.AuthenticationInformationAnswer.utran_vectors:type_name -> magma.feg.AuthenticationInformationAnswer.UTRANVector
-	19, // 4: magma.feg.AuthenticationInformationAnswer.geran_vectors:type_name -> magma.feg.AuthenticationInformationAnswer.GERANVector
-	15, // 5: magma.feg.UpdateLocationRequest.feature_list_id_2:type_name -> magma.feg.FeatureListId2
-	16, // 6: magma.feg.UpdateLocationRequest.feature_list_id_1:type_name -> magma.feg.FeatureListId1
+	23, // 2: magma.feg.AuthenticationInformationAnswer.eutran_vectors:type_name -> magma.feg.AuthenticationInformationAnswer.EUTRANVector
+	24, // 3: magma.feg.AuthenticationInformationAnswer.utran_vectors:type_name -> magma.feg.AuthenticationInformationAnswer.UTRANVector
+	25, // 4: magma.feg.AuthenticationInformationAnswer.geran_vectors:type_name -> magma.feg.AuthenticationInformationAnswer.GERANVector
+	21, // 5: magma.feg.UpdateLocationRequest.feature_list_id_2:type_name -> magma.feg.FeatureListId2
+	22, // 6: magma.feg.UpdateLocationRequest.feature_list_id_1:type_name -> magma.feg.FeatureListId1
 	0,  // 7: magma.feg.UpdateLocationAnswer.error_code:type_name -> magma.feg.ErrorCode
-	21, // 8: magma.feg.UpdateLocationAnswer.total_ambr:type_name -> magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate
-	20, // 9: magma.feg.UpdateLocationAnswer.apn:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration
+	27, // 8: magma.feg.UpdateLocationAnswer.total_ambr:type_name -> magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate
+	26, // 9: magma.feg.UpdateLocationAnswer.apn:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration
 	1,  // 10: magma.feg.UpdateLocationAnswer.network_access_mode:type_name -> magma.feg.UpdateLocationAnswer.NetworkAccessMode
-	15, // 11: magma.feg.UpdateLocationAnswer.feature_list_id_2:type_name -> magma.feg.FeatureListId2
-	16, // 12: magma.feg.UpdateLocationAnswer.feature_list_id_1:type_name -> magma.feg.FeatureListId1
+	21, // 11: magma.feg.UpdateLocationAnswer.feature_list_id_2:type_name -> magma.feg.FeatureListId2
+	22, // 12: magma.feg.UpdateLocationAnswer.feature_list_id_1:type_name -> magma.feg.FeatureListId1
 	4,  // 13: magma.feg.CancelLocationRequest.cancellation_type:type_name -> magma.feg.CancelLocationRequest.CancellationType
 	0,  // 14: magma.feg.CancelLocationAnswer.error_code:type_name -> magma.feg.ErrorCode
 	0,  // 15: magma.feg.PurgeUEAnswer.error_code:type_name -> magma.feg.ErrorCode
 	0,  // 16: magma.feg.ResetAnswer.error_code:type_name -> magma.feg.ErrorCode
-	22, // 17: magma.feg.UpdateLocationAnswer.APNConfiguration.qos_profile:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration.QoSProfile
-	21, // 18: magma.feg.UpdateLocationAnswer.APNConfiguration.ambr:type_name -> magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate
-	2,  // 19: magma.feg.UpdateLocationAnswer.APNConfiguration.pdn:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration.PDNType
-	23, // 20: magma.feg.UpdateLocationAnswer.APNConfiguration.resource:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration.APNResource
-	3,  // 21: magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate.unit:type_name -> magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate.BitrateUnitsAMBR
-	5,  // 22: magma.feg.S6aProxy.AuthenticationInformation:input_type -> magma.feg.AuthenticationInformationRequest
-	7,  // 23: magma.feg.S6aProxy.UpdateLocation:input_type -> magma.feg.UpdateLocationRequest
-	11, // 24: magma.feg.S6aProxy.PurgeUE:input_type -> magma.feg.PurgeUERequest
-	9,  // 25: magma.feg.S6aGatewayService.CancelLocation:input_type -> magma.feg.CancelLocationRequest
-	13, // 26: magma.feg.S6aGatewayService.Reset:input_type -> magma.feg.ResetRequest
-	6,  // 27: magma.feg.S6aProxy.AuthenticationInformation:output_type -> magma.feg.AuthenticationInformationAnswer
-	8,  // 28: magma.feg.S6aProxy.UpdateLocation:output_type -> magma.feg.UpdateLocationAnswer
-	12, // 29: magma.feg.S6aProxy.PurgeUE:output_type -> magma.feg.PurgeUEAnswer
-	10, // 30: magma.feg.S6aGatewayService.CancelLocation:output_type -> magma.feg.CancelLocationAnswer
-	14, // 31: magma.feg.S6aGatewayService.Reset:output_type -> magma.feg.ResetAnswer
-	27, // [27:32] is the sub-list for method output_type
-	22, // [22:27] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	27, // 17: magma.feg.InsertSubscriberDataRequest.total_ambr:type_name -> magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate
+	26, // 18: magma.feg.InsertSubscriberDataRequest.apn:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration
+	1,  // 19: magma.feg.InsertSubscriberDataRequest.network_access_mode:type_name -> magma.feg.UpdateLocationAnswer.NetworkAccessMode
+	0,  // 20: magma.feg.InsertSubscriberDataAnswer.error_code:type_name -> magma.feg.ErrorCode
+	0,  // 21: magma.feg.DeleteSubscriberDataAnswer.error_code:type_name -> magma.feg.ErrorCode
+	0,  // 22: magma.feg.NotifyAnswer.error_code:type_name -> magma.feg.ErrorCode
+	28, // 23: magma.feg.UpdateLocationAnswer.APNConfiguration.qos_profile:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration.QoSProfile
+	27, // 24: magma.feg.UpdateLocationAnswer.APNConfiguration.ambr:type_name -> magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate
+	2,  // 25: magma.feg.UpdateLocationAnswer.APNConfiguration.pdn:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration.PDNType
+	29, // 26: magma.feg.UpdateLocationAnswer.APNConfiguration.resource:type_name -> magma.feg.UpdateLocationAnswer.APNConfiguration.APNResource
+	3,  // 27: magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate.unit:type_name -> magma.feg.UpdateLocationAnswer.AggregatedMaximumBitrate.BitrateUnitsAMBR
+	5,  // 28: magma.feg.S6aProxy.AuthenticationInformation:input_type -> magma.feg.AuthenticationInformationRequest
+	7,  // 29: magma.feg.S6aProxy.UpdateLocation:input_type -> magma.feg.UpdateLocationRequest
+	11, // 30: magma.feg.S6aProxy.PurgeUE:input_type -> magma.feg.PurgeUERequest
+	19, // 31: magma.feg.S6aProxy.Notify:input_type -> magma.feg.NotifyRequest
+	9,  // 32: magma.feg.S6aGatewayService.CancelLocation:input_type -> magma.feg.CancelLocationRequest
+	13, // 33: magma.feg.S6aGatewayService.Reset:input_type -> magma.feg.ResetRequest
+	15, // 34: magma.feg.S6aGatewayService.InsertSubscriberData:input_type -> magma.feg.InsertSubscriberDataRequest
+	17, // 35: magma.feg.S6aGatewayService.DeleteSubscriberData:input_type -> magma.feg.DeleteSubscriberDataRequest
+	6,  // 36: magma.feg.S6aProxy.AuthenticationInformation:output_type -> magma.feg.AuthenticationInformationAnswer
+	8,  // 37: magma.feg.S6aProxy.UpdateLocation:output_type -> magma.feg.UpdateLocationAnswer
+	12, // 38: magma.feg.S6aProxy.PurgeUE:output_type -> magma.feg.PurgeUEAnswer
+	20, // 39: magma.feg.S6aProxy.Notify:output_type -> magma.feg.NotifyAnswer
+	10, // 40: magma.feg.S6aGatewayService.CancelLocation:output_type -> magma.feg.CancelLocationAnswer
+	14, // 41: magma.feg.S6aGatewayService.Reset:output_type -> magma.feg.ResetAnswer
+	16, // 42: magma.feg.S6aGatewayService.InsertSubscriberData:output_type -> magma.feg.InsertSubscriberDataAnswer
+	18, // 43: magma.feg.S6aGatewayService.DeleteSubscriberData:output_type -> magma.feg.DeleteSubscriberDataAnswer
+	36, // [36:44] is the sub-list for method output_type
+	28, // [28:36] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_feg_protos_s6a_proxy_proto_init() }
@@ -2286,7 +2875,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeatureListId2); i {
+			switch v := v.(*InsertSubscriberDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2298,7 +2887,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeatureListId1); i {
+			switch v := v.(*InsertSubscriberDataAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2310,7 +2899,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticationInformationAnswer_EUTRANVector); i {
+			switch v := v.(*DeleteSubscriberDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2322,7 +2911,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticationInformationAnswer_UTRANVector); i {
+			switch v := v.(*DeleteSubscriberDataAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2334,7 +2923,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticationInformationAnswer_GERANVector); i {
+			switch v := v.(*NotifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2346,7 +2935,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLocationAnswer_APNConfiguration); i {
+			switch v := v.(*NotifyAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2358,7 +2947,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLocationAnswer_AggregatedMaximumBitrate); i {
+			switch v := v.(*FeatureListId2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2370,7 +2959,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLocationAnswer_APNConfiguration_QoSProfile); i {
+			switch v := v.(*FeatureListId1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2382,6 +2971,78 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s6a_proxy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationInformationAnswer_EUTRANVector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s6a_proxy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationInformationAnswer_UTRANVector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s6a_proxy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationInformationAnswer_GERANVector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s6a_proxy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLocationAnswer_APNConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s6a_proxy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLocationAnswer_AggregatedMaximumBitrate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s6a_proxy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLocationAnswer_APNConfiguration_QoSProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s6a_proxy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateLocationAnswer_APNConfiguration_APNResource); i {
 			case 0:
 				return &v.state
@@ -2400,7 +3061,7 @@ func file_feg_protos_s6a_proxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feg_protos_s6a_proxy_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   19,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -2433,6 +3094,8 @@ type S6AProxyClient interface {
 	UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*UpdateLocationAnswer, error)
 	// Purge-UE (Code 321)
 	PurgeUE(ctx context.Context, in *PurgeUERequest, opts ...grpc.CallOption) (*PurgeUEAnswer, error)
+	// Notify (Code 323)
+	Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyAnswer, error)
 }
 
 type s6AProxyClient struct {
@@ -2470,6 +3133,15 @@ func (c *s6AProxyClient) PurgeUE(ctx context.Context, in *PurgeUERequest, opts .
 	return out, nil
 }
 
+func (c *s6AProxyClient) Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyAnswer, error) {
+	out := new(NotifyAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aProxy/Notify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S6AProxyServer is the server API for S6AProxy service.
 type S6AProxyServer interface {
 	// Authentication-Information (Code 318)
@@ -2478,6 +3150,8 @@ type S6AProxyServer interface {
 	UpdateLocation(context.Context, *UpdateLocationRequest) (*UpdateLocationAnswer, error)
 	// Purge-UE (Code 321)
 	PurgeUE(context.Context, *PurgeUERequest) (*PurgeUEAnswer, error)
+	// Notify (Code 323)
+	Notify(context.Context, *NotifyRequest) (*NotifyAnswer, error)
 }
 
 // UnimplementedS6AProxyServer can be embedded to have forward compatible implementations.
@@ -2493,6 +3167,9 @@ func (*UnimplementedS6AProxyServer) UpdateLocation(context.Context, *UpdateLocat
 func (*UnimplementedS6AProxyServer) PurgeUE(context.Context, *PurgeUERequest) (*PurgeUEAnswer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeUE not implemented")
 }
+func (*UnimplementedS6AProxyServer) Notify(context.Context, *NotifyRequest) (*NotifyAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
+}
 
 func RegisterS6AProxyServer(s *grpc.Server, srv S6AProxyServer) {
 	s.RegisterService(&_S6AProxy_serviceDesc, srv)
@@ -2552,6 +3229,24 @@ func _S6AProxy_PurgeUE_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S6AProxy_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AProxyServer).Notify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aProxy/Notify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AProxyServer).Notify(ctx, req.(*NotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S6AProxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S6aProxy",
 	HandlerType: (*S6AProxyServer)(nil),
@@ -2568,6 +3263,10 @@ var _S6AProxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeUE",
 			Handler:    _S6AProxy_PurgeUE_Handler,
 		},
+		{
+			MethodName: "Notify",
+			Handler:    _S6AProxy_Notify_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s6a_proxy.proto",
@@ -2581,6 +3280,10 @@ type S6AGatewayServiceClient interface {
 	CancelLocation(ctx context.Context, in *CancelLocationRequest, opts ...grpc.CallOption) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error)
 }
 
 type s6AGatewayServiceClient struct {
@@ -2609,12 +3312,34 @@ func (c *s6AGatewayServiceClient) Reset(ctx context.Context, in *ResetRequest, o
 	return out, nil
 }
 
+func (c *s6AGatewayServiceClient) InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error) {
+	out := new(InsertSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/InsertSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *s6AGatewayServiceClient) DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error) {
+	out := new(DeleteSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/DeleteSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S6AGatewayServiceServer is the server API for S6AGatewayService service.
 type S6AGatewayServiceServer interface {
 	// Cancel-Location (Code 317)
 	CancelLocation(context.Context, *CancelLocationRequest) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(context.Context, *ResetRequest) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(context.Context, *InsertSubscriberDataRequest) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*DeleteSubscriberDataAnswer, error)
 }
 
 // UnimplementedS6AGatewayServiceServer can be embedded to have forward compatible implementations.
@@ -2627,6 +3352,12 @@ func (*UnimplementedS6AGatewayServiceServer) CancelLocation(context.Context, *Ca
 func (*UnimplementedS6AGatewayServiceServer) Reset(context.Context, *ResetRequest) (*ResetAnswer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
 }
+func (*UnimplementedS6AGatewayServiceServer) InsertSubscriberData(context.Context, *InsertSubscriberDataRequest) (*InsertSubscriberDataAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertSubscriberData not implemented")
+}
+func (*UnimplementedS6AGatewayServiceServer) DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*DeleteSubscriberDataAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscriberData not implemented")
+}
 
 func RegisterS6AGatewayServiceServer(s *grpc.Server, srv S6AGatewayServiceServer) {
 	s.RegisterService(&_S6AGatewayService_serviceDesc, srv)
@@ -2668,6 +3399,42 @@ func _S6AGatewayService_Reset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S6AGatewayService_InsertSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/InsertSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, req.(*InsertSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _S6AGatewayService_DeleteSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/DeleteSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, req.(*DeleteSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S6aGatewayService",
 	HandlerType: (*S6AGatewayServiceServer)(nil),
@@ -2680,6 +3447,14 @@ var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _S6AGatewayService_Reset_Handler,
 		},
+		{
+			MethodName: "InsertSubscriberData",
+			Handler:    _S6AGatewayService_InsertSubscriberData_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriberData",
+			Handler:    _S6AGatewayService_DeleteSubscriberData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s6a_proxy.proto",
@@ -22,6 +22,9 @@ type AaaServer struct {
 	// Example: true
 	AccountingEnabled bool `json:"accounting_enabled,omitempty"`
 
+	// acct records
+	AcctRecords *AaaAcctRecords `json:"acct_records,omitempty"`
+
 	// acct reporting enabled
 	AcctReportingEnabled bool `json:"acct_reporting_enabled,omitempty"`
 
@@ -29,6 +32,9 @@ type AaaServer struct {
 	// Example: true
 	CreateSessionOnAuth bool `json:"create_session_on_auth,omitempty"`
 
+	// eap TLS config
+	EapTLSConfig *EapTLSConfig `json:"eap_tls_config,omitempty"`
+
 	// event logging enabled
 	EventLoggingEnabled bool `json:"event_logging_enabled,omitempty"`
 
@@ -38,22 +44,75 @@ type AaaServer struct {
 
 	// radius config
 	RadiusConfig *RadiusConfig `json:"radius_config,omitempty"`
+
+	// session store
+	SessionStore *AaaSessionStore `json:"session_store,omitempty"`
 }
 
 // Validate validates this aaa server
 func (m *AaaServer) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAcctRecords(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEapTLSConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRadiusConfig(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSessionStore(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *AaaServer) validateAcctRecords(formats strfmt.Registry) error {
+	if swag.IsZero(m.AcctRecords) { // not required
+		return nil
+	}
+
+	if m.AcctRecords != nil {
+		if err := m.AcctRecords.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("acct_records")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("acct_records")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *AaaServer) validateEapTLSConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.EapTLSConfig) { // not required
+		return nil
+	}
+
+	if m.EapTLSConfig != nil {
+		if err := m.EapTLSConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("eap_tls_config")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("eap_tls_config")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *AaaServer) validateRadiusConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.RadiusConfig) { // not required
 		return nil
@@ -73,20 +132,83 @@ func (m *AaaServer) validateRadiusConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *AaaServer) validateSessionStore(formats strfmt.Registry) error {
+	if swag.IsZero(m.SessionStore) { // not required
+		return nil
+	}
+
+	if m.SessionStore != nil {
+		if err := m.SessionStore.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("session_store")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("session_store")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this aaa server based on the context it is used
 func (m *AaaServer) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAcctRecords(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateEapTLSConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateRadiusConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSessionStore(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *AaaServer) contextValidateAcctRecords(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AcctRecords != nil {
+		if err := m.AcctRecords.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("acct_records")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("acct_records")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *AaaServer) contextValidateEapTLSConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.EapTLSConfig != nil {
+		if err := m.EapTLSConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("eap_tls_config")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("eap_tls_config")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *AaaServer) contextValidateRadiusConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.RadiusConfig != nil {
@@ -103,6 +225,22 @@ func (m *AaaServer) contextValidateRadiusConfig(ctx context.Context, formats str
 	return nil
 }
 
+func (m *AaaServer) contextValidateSessionStore(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SessionStore != nil {
+		if err := m.SessionStore.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("session_store")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("session_store")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *AaaServer) MarshalBinary() ([]byte, error) {
 	if m == nil {
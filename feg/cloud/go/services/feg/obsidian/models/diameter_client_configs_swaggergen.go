@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -50,6 +51,12 @@ type DiameterClientConfigs struct {
 	// Example: false
 	OverwriteDestHost bool `json:"overwrite_dest_host,omitempty"`
 
+	// Alternate servers used for failover and load balancing
+	Peers []*DiameterClientConfigs `json:"peers"`
+
+	// Peer priority, lower value is preferred
+	Priority uint32 `json:"priority,omitempty"`
+
 	// product name
 	// Min Length: 1
 	ProductName string `json:"product_name,omitempty"`
@@ -74,6 +81,9 @@ type DiameterClientConfigs struct {
 
 	// watchdog interval
 	WatchdogInterval uint32 `json:"watchdog_interval,omitempty"`
+
+	// Relative share of requests among peers of equal priority
+	Weight uint32 `json:"weight,omitempty"`
 }
 
 // Validate validates this diameter client configs
@@ -92,6 +102,10 @@ func (m *DiameterClientConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePeers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProductName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -146,6 +160,32 @@ func (m *DiameterClientConfigs) validateLocalAddress(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *DiameterClientConfigs) validatePeers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Peers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Peers); i++ {
+		if swag.IsZero(m.Peers[i]) { // not required
+			continue
+		}
+
+		if m.Peers[i] != nil {
+			if err := m.Peers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("peers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("peers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DiameterClientConfigs) validateProductName(formats strfmt.Registry) error {
 	if swag.IsZero(m.ProductName) { // not required
 		return nil
@@ -224,8 +264,37 @@ func (m *DiameterClientConfigs) validateRealm(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this diameter client configs based on context it is used
+// ContextValidate validate this diameter client configs based on the context it is used
 func (m *DiameterClientConfigs) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePeers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DiameterClientConfigs) contextValidatePeers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Peers); i++ {
+
+		if m.Peers[i] != nil {
+			if err := m.Peers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("peers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("peers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
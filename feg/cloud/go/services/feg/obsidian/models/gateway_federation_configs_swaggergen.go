@@ -30,6 +30,9 @@ type GatewayFederationConfigs struct {
 	// Required: true
 	EapAka *EapAka `json:"eap_aka"`
 
+	// eap aka prime
+	EapAkaPrime *EapAkaPrime `json:"eap_aka_prime,omitempty"`
+
 	// eap sim
 	EapSim *EapSim `json:"eap_sim,omitempty"`
 
@@ -87,6 +90,10 @@ func (m *GatewayFederationConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateEapAkaPrime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateEapSim(formats); err != nil {
 		res = append(res, err)
 	}
@@ -196,6 +203,25 @@ func (m *GatewayFederationConfigs) validateEapAka(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *GatewayFederationConfigs) validateEapAkaPrime(formats strfmt.Registry) error {
+	if swag.IsZero(m.EapAkaPrime) { // not required
+		return nil
+	}
+
+	if m.EapAkaPrime != nil {
+		if err := m.EapAkaPrime.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("eap_aka_prime")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("eap_aka_prime")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *GatewayFederationConfigs) validateEapSim(formats strfmt.Registry) error {
 	if swag.IsZero(m.EapSim) { // not required
 		return nil
@@ -424,6 +450,10 @@ func (m *GatewayFederationConfigs) ContextValidate(ctx context.Context, formats
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateEapAkaPrime(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateEapSim(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -522,6 +552,22 @@ func (m *GatewayFederationConfigs) contextValidateEapAka(ctx context.Context, fo
 	return nil
 }
 
+func (m *GatewayFederationConfigs) contextValidateEapAkaPrime(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.EapAkaPrime != nil {
+		if err := m.EapAkaPrime.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("eap_aka_prime")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("eap_aka_prime")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *GatewayFederationConfigs) contextValidateEapSim(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.EapSim != nil {
@@ -24,6 +24,9 @@ type Swx struct {
 	// Example: 10800
 	CacheTTLSeconds uint32 `json:"cache_TTL_seconds,omitempty"`
 
+	// cache persistence
+	CachePersistence *SwxCachePersistence `json:"cache_persistence,omitempty"`
+
 	// derive unregister realm
 	// Example: false
 	DeriveUnregisterRealm bool `json:"derive_unregister_realm,omitempty"`
@@ -50,6 +53,10 @@ type Swx struct {
 func (m *Swx) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCachePersistence(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHlrPlmnIds(formats); err != nil {
 		res = append(res, err)
 	}
@@ -68,6 +75,25 @@ func (m *Swx) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Swx) validateCachePersistence(formats strfmt.Registry) error {
+	if swag.IsZero(m.CachePersistence) { // not required
+		return nil
+	}
+
+	if m.CachePersistence != nil {
+		if err := m.CachePersistence.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cache_persistence")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cache_persistence")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Swx) validateHlrPlmnIds(formats strfmt.Registry) error {
 	if swag.IsZero(m.HlrPlmnIds) { // not required
 		return nil
@@ -141,6 +167,10 @@ func (m *Swx) validateServers(formats strfmt.Registry) error {
 func (m *Swx) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateCachePersistence(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateServer(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -155,6 +185,22 @@ func (m *Swx) ContextValidate(ctx context.Context, formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *Swx) contextValidateCachePersistence(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CachePersistence != nil {
+		if err := m.CachePersistence.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cache_persistence")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cache_persistence")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Swx) contextValidateServer(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Server != nil {
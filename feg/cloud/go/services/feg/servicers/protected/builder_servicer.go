@@ -70,6 +70,7 @@ func (s *builderServicer) Build(ctx context.Context, request *builder_protos.Bui
 	swxc := gwConfig.Swx
 	eapAka := gwConfig.EapAka
 	eapSim := gwConfig.EapSim
+	eapAkaPrime := gwConfig.EapAkaPrime
 	aaa := gwConfig.AaaServer
 	csfb := gwConfig.Csfb
 	healthc := protos.SafeInit(healthConfig).(*models.Health)
@@ -152,6 +153,12 @@ func (s *builderServicer) Build(ctx context.Context, request *builder_protos.Bui
 		vals["eap_sim"] = mc
 	}
 
+	if eapAkaPrime != nil {
+		mc := &feg_mconfig.EapAkaPrimeConfig{LogLevel: protos.LogLevel_INFO}
+		protos.FillIn(eapAkaPrime, mc)
+		vals["eap_aka_prime"] = mc
+	}
+
 	if aaa != nil {
 		mc := &feg_mconfig.AAAConfig{LogLevel: protos.LogLevel_INFO}
 		protos.FillIn(aaa, mc)
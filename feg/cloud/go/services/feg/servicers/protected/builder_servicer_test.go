@@ -217,6 +217,26 @@ func TestBuilder_Build(t *testing.T) {
 			IdleSessionTimeoutMs: 21600000,
 			AccountingEnabled:    false,
 			CreateSessionOnAuth:  false,
+			EapTlsConfig: &feg_mconfig.EapTlsConfig{
+				CaCertFile:               "/var/opt/magma/certs/eap_ca.pem",
+				ServerCertFile:           "/var/opt/magma/certs/eap_server.pem",
+				ServerKeyFile:            "/var/opt/magma/certs/eap_server.key",
+				FragmentSize:             1024,
+				SessionResumptionEnabled: true,
+				TtlsInnerMethods:         []string{"PAP", "MSCHAPV2"},
+			},
+			SessionStore: &feg_mconfig.AAASessionStore{
+				Backend:   "redis",
+				RedisHash: "aaa_sessions",
+			},
+			AcctRecords: &feg_mconfig.AAAAcctRecords{
+				Format:              "csv",
+				Directory:           "/var/opt/magma/aaa_records",
+				MaxFileSizeKb:       10240,
+				RotationIntervalSec: 3600,
+				MaxFiles:            168,
+				ForwardToCloud:      true,
+			},
 		},
 		"health": &feg_mconfig.GatewayHealthConfig{
 			RequiredServices:          []string{"SWX_PROXY", "SESSION_PROXY"},
@@ -407,6 +427,26 @@ var defaultConfig = &models.NetworkFederationConfigs{
 		IdleSessionTimeoutMs: 21600000,
 		AccountingEnabled:    false,
 		CreateSessionOnAuth:  false,
+		EapTLSConfig: &models.EapTLSConfig{
+			CaCertFile:               "/var/opt/magma/certs/eap_ca.pem",
+			ServerCertFile:           "/var/opt/magma/certs/eap_server.pem",
+			ServerKeyFile:            "/var/opt/magma/certs/eap_server.key",
+			FragmentSize:             1024,
+			SessionResumptionEnabled: true,
+			TtlsInnerMethods:         []string{"PAP", "MSCHAPV2"},
+		},
+		SessionStore: &models.AaaSessionStore{
+			Backend:   models.AaaSessionStoreBackendRedis,
+			RedisHash: "aaa_sessions",
+		},
+		AcctRecords: &models.AaaAcctRecords{
+			Format:              models.AaaAcctRecordsFormatCsv,
+			Directory:           "/var/opt/magma/aaa_records",
+			MaxFileSizeKb:       10240,
+			RotationIntervalSec: 3600,
+			MaxFiles:            168,
+			ForwardToCloud:      true,
+		},
 	},
 	ServedNetworkIds: []string{},
 	Health: &models.Health{
@@ -60,6 +60,18 @@ func (s *RelayRouter) PurgeUE(ctx context.Context, r *protos.PurgeUERequest) (*p
 	return client.PurgeUE(ctx, r)
 }
 
+// Notify sends NOR (Code 323) over diameter connection,
+// waits (blocks) for NOA & returns its RPC representation
+func (s *RelayRouter) Notify(ctx context.Context, r *protos.NotifyRequest) (*protos.NotifyAnswer, error) {
+
+	client, ctx, cancel, err := s.getS6aClient(ctx, r.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return client.Notify(ctx, r)
+}
+
 func (s *RelayRouter) getS6aClient(
 	c context.Context, imsi string) (protos.S6AProxyClient, context.Context, context.CancelFunc, error) {
 
@@ -36,6 +36,20 @@ func (srv *testFegProxyServer) CancelLocation(
 	return srv.CancelLocationUnverified(ctx, req)
 }
 
+func (srv *testFegProxyServer) InsertSubscriberData(
+	ctx context.Context,
+	req *protos.InsertSubscriberDataRequest,
+) (*protos.InsertSubscriberDataAnswer, error) {
+	return srv.InsertSubscriberDataUnverified(ctx, req)
+}
+
+func (srv *testFegProxyServer) DeleteSubscriberData(
+	ctx context.Context,
+	req *protos.DeleteSubscriberDataRequest,
+) (*protos.DeleteSubscriberDataAnswer, error) {
+	return srv.DeleteSubscriberDataUnverified(ctx, req)
+}
+
 func StartTestService(t *testing.T) {
 	srv, lis, _ := test_utils.NewTestService(t, feg.ModuleName, feg_relay.ServiceName)
 	protos.RegisterS6AGatewayServiceServer(srv.GrpcServer, &testFegProxyServer{})
@@ -0,0 +1,430 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diameter
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/golang/glog"
+)
+
+// Diameter capture is enabled for a process if CaptureFileEnv is set to the capture file path
+const (
+	CaptureFileEnv     = "DIAMETER_CAPTURE_FILE"
+	CaptureMaxSizeEnv  = "DIAMETER_CAPTURE_MAX_SIZE_MB"
+	CaptureMaxFilesEnv = "DIAMETER_CAPTURE_MAX_FILES"
+
+	DefaultCaptureMaxSizeMB = 10
+	DefaultCaptureMaxFiles  = 5
+
+	// RedactedPrefix prefixes pseudonyms which replace values of identity AVPs in captured messages
+	RedactedPrefix = "redacted-"
+
+	captureAnswerTimeout = time.Second * 30
+)
+
+// pseudonymizedAVPs are subscriber identities, their values are replaced by pseudonyms, so captured
+// messages of the same subscriber or session can still be correlated
+var pseudonymizedAVPs = map[uint32]bool{
+	avp.SessionID:              true,
+	avp.UserName:               true,
+	avp.SubscriptionIDData:     true,
+	avp.MSISDN:                 true,
+	avp.CallingStationID:       true,
+	avp.UserEquipmentInfoValue: true,
+}
+
+// zeroedAVPs are authentication secrets & subscriber location data, their values are zeroed
+var zeroedAVPs = map[uint32]bool{
+	avp.RAND:                 true,
+	avp.XRES:                 true,
+	avp.AUTN:                 true,
+	avp.KASME:                true,
+	avp.Kc:                   true,
+	avp.SRES:                 true,
+	avp.SIPAuthenticate:      true,
+	avp.SIPAuthorization:     true,
+	avp.ConfidentialityKey:   true,
+	avp.IntegrityKey:         true,
+	avp.UserPassword:         true,
+	avp.FramedIPAddress:      true,
+	avp.FramedIPv6Prefix:     true,
+	avp.TGPPUserLocationInfo: true,
+}
+
+// CaptureRecord is a captured diameter request with its answer, capture files store one JSON encoded
+// record per line
+type CaptureRecord struct {
+	Peer          string           `json:"peer"`
+	SessionID     string           `json:"session_id,omitempty"`
+	ApplicationID uint32           `json:"application_id"`
+	CommandCode   uint32           `json:"command_code"`
+	Request       *CapturedMessage `json:"request"`
+	Answer        *CapturedMessage `json:"answer,omitempty"` // nil if the request was not answered
+}
+
+// CapturedMessage is a redacted diameter message
+type CapturedMessage struct {
+	Time    time.Time `json:"time"`
+	Decoded string    `json:"decoded"` // human readable form of the message
+	Raw     []byte    `json:"raw"`     // wire encoding of the message
+}
+
+// Message decodes the captured message
+func (cm *CapturedMessage) Message() (*diam.Message, error) {
+	if cm == nil {
+		return nil, fmt.Errorf("nil captured message")
+	}
+	return diam.ReadMessage(bytes.NewReader(cm.Raw), dict.Default)
+}
+
+// Capture records request/answer pairs of diameter clients to a rotating capture file
+type Capture struct {
+	out       *rotatingFile
+	salt      []byte
+	pending   map[uint32]*CaptureRecord // keyed by Hop-by-Hop ID of the captured request
+	lastPrune time.Time
+	mutex     sync.Mutex
+}
+
+var (
+	captureOnce   sync.Once
+	activeCapture *Capture
+	captureMutex  sync.RWMutex
+)
+
+// StartCapture starts capturing of diameter requests & answers of all clients of the process to the
+// given file. The file is rotated when it reaches maxSize bytes, at most maxFiles rotated files are kept
+func StartCapture(path string, maxSize int64, maxFiles int) (*Capture, error) {
+	out, err := newRotatingFile(path, maxSize, maxFiles)
+	if err != nil {
+		return nil, err
+	}
+	c := &Capture{
+		out:       out,
+		salt:      make([]byte, 16),
+		pending:   map[uint32]*CaptureRecord{},
+		lastPrune: time.Now(),
+	}
+	if _, err = rand.Read(c.salt); err != nil {
+		out.Close()
+		return nil, err
+	}
+	captureMutex.Lock()
+	prev := activeCapture
+	activeCapture = c
+	captureMutex.Unlock()
+	if prev != nil {
+		prev.Close()
+	}
+	glog.Infof("diameter capture to %s is enabled", path)
+	return c, nil
+}
+
+// StopCapture stops the active diameter capture if any
+func StopCapture() {
+	captureMutex.Lock()
+	c := activeCapture
+	activeCapture = nil
+	captureMutex.Unlock()
+	if c != nil {
+		c.Close()
+	}
+}
+
+// Close writes all unanswered requests & closes the capture file
+func (c *Capture) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for id, rec := range c.pending {
+		c.write(rec)
+		delete(c.pending, id)
+	}
+	return c.out.Close()
+}
+
+// getCapture returns the active capture or nil if capture is disabled, on the first call
+// the capture is started if it's enabled by CaptureFileEnv
+func getCapture() *Capture {
+	captureOnce.Do(func() {
+		path := os.Getenv(CaptureFileEnv)
+		if len(path) == 0 {
+			return
+		}
+		maxSizeMB, err := strconv.Atoi(os.Getenv(CaptureMaxSizeEnv))
+		if err != nil || maxSizeMB <= 0 {
+			maxSizeMB = DefaultCaptureMaxSizeMB
+		}
+		maxFiles, err := strconv.Atoi(os.Getenv(CaptureMaxFilesEnv))
+		if err != nil || maxFiles <= 0 {
+			maxFiles = DefaultCaptureMaxFiles
+		}
+		if _, err = StartCapture(path, int64(maxSizeMB)<<20, maxFiles); err != nil {
+			glog.Errorf("failed to start diameter capture to %s: %v", path, err)
+		}
+	})
+	captureMutex.RLock()
+	defer captureMutex.RUnlock()
+	return activeCapture
+}
+
+// captureRequest records the request sent to the given peer if capture is enabled
+func captureRequest(message *diam.Message, peer string) {
+	if c := getCapture(); c != nil {
+		c.RequestSent(message, peer)
+	}
+}
+
+// captureAnswer records the answer of a captured request if capture is enabled
+func captureAnswer(message *diam.Message) {
+	if c := getCapture(); c != nil {
+		c.AnswerReceived(message)
+	}
+}
+
+// RequestSent records the request, the request is written to the capture file with its answer or
+// when its answer times out
+func (c *Capture) RequestSent(message *diam.Message, peer string) {
+	captured, sessionID, err := c.capture(message)
+	if err != nil {
+		glog.Errorf("failed to capture diameter request: %v", err)
+		return
+	}
+	rec := &CaptureRecord{
+		Peer:          peer,
+		SessionID:     sessionID,
+		ApplicationID: message.Header.ApplicationID,
+		CommandCode:   message.Header.CommandCode,
+		Request:       captured,
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := time.Now()
+	if now.Sub(c.lastPrune) > captureAnswerTimeout {
+		for id, pending := range c.pending {
+			if now.Sub(pending.Request.Time) > captureAnswerTimeout {
+				c.write(pending)
+				delete(c.pending, id)
+			}
+		}
+		c.lastPrune = now
+	}
+	if prev, ok := c.pending[message.Header.HopByHopID]; ok {
+		c.write(prev) // retransmission of an unanswered request or Hop-by-Hop ID reuse
+	}
+	c.pending[message.Header.HopByHopID] = rec
+}
+
+// AnswerReceived writes the request matching the answer together with the answer to the capture file,
+// answers without captured requests are ignored
+func (c *Capture) AnswerReceived(message *diam.Message) {
+	c.mutex.Lock()
+	rec, ok := c.pending[message.Header.HopByHopID]
+	if ok {
+		delete(c.pending, message.Header.HopByHopID)
+	}
+	c.mutex.Unlock()
+	if !ok {
+		return
+	}
+	captured, _, err := c.capture(message)
+	if err != nil {
+		glog.Errorf("failed to capture diameter answer: %v", err)
+		return
+	}
+	rec.Answer = captured
+	c.mutex.Lock()
+	c.write(rec)
+	c.mutex.Unlock()
+}
+
+// write must be called with the capture mutex held
+func (c *Capture) write(rec *CaptureRecord) {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		glog.Errorf("failed to marshal diameter capture record: %v", err)
+		return
+	}
+	if _, err = c.out.Write(append(b, '\n')); err != nil {
+		glog.Errorf("failed to write diameter capture record: %v", err)
+	}
+}
+
+// capture returns the redacted copy of the message & its redacted Session-Id
+func (c *Capture) capture(message *diam.Message) (*CapturedMessage, string, error) {
+	raw, err := message.Serialize()
+	if err != nil {
+		return nil, "", err
+	}
+	m, err := diam.ReadMessage(bytes.NewReader(raw), message.Dictionary())
+	if err != nil {
+		return nil, "", err
+	}
+	c.redact(m.AVP)
+	m.Header.MessageLength = uint32(m.Len())
+	if raw, err = m.Serialize(); err != nil {
+		return nil, "", err
+	}
+	var sessionID string
+	if sid, err := m.FindAVP(avp.SessionID, 0); err == nil && sid != nil {
+		sessionID = fmt.Sprint(sid.Data)
+		if s, ok := sid.Data.(datatype.UTF8String); ok {
+			sessionID = string(s)
+		}
+	}
+	return &CapturedMessage{Time: time.Now(), Decoded: m.String(), Raw: raw}, sessionID, nil
+}
+
+// redact replaces values of sensitive AVPs with pseudonyms or zeros
+func (c *Capture) redact(avps []*diam.AVP) {
+	for _, a := range avps {
+		switch data := a.Data.(type) {
+		case *diam.GroupedAVP:
+			c.redact(data.AVP)
+		case datatype.UTF8String:
+			if pseudonymizedAVPs[a.Code] {
+				a.Data = datatype.UTF8String(c.pseudonym(string(data)))
+			} else if zeroedAVPs[a.Code] {
+				a.Data = datatype.UTF8String(make([]byte, len(data)))
+			}
+		case datatype.OctetString:
+			if pseudonymizedAVPs[a.Code] {
+				a.Data = datatype.OctetString(c.pseudonym(string(data)))
+			} else if zeroedAVPs[a.Code] {
+				a.Data = datatype.OctetString(make([]byte, len(data)))
+			}
+		case datatype.DiameterIdentity:
+			if pseudonymizedAVPs[a.Code] {
+				a.Data = datatype.DiameterIdentity(c.pseudonym(string(data)))
+			}
+		case datatype.Address:
+			if zeroedAVPs[a.Code] {
+				a.Data = datatype.Address(make([]byte, len(data)))
+			}
+		}
+		a.Length = a.Len()
+	}
+}
+
+func (c *Capture) pseudonym(value string) string {
+	h := sha256.New()
+	h.Write(c.salt)
+	h.Write([]byte(value))
+	return RedactedPrefix + hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// ReadCaptureFile returns all records of the given capture file
+func ReadCaptureFile(path string) ([]*CaptureRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var records []*CaptureRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		rec := &CaptureRecord{}
+		if err = json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return nil, fmt.Errorf("invalid capture record at %s:%d: %v", path, line, err)
+		}
+		records = append(records, rec)
+	}
+	return records, scanner.Err()
+}
+
+// rotatingFile is a file writer which renames the file to <path>.1 when it reaches its maximum size,
+// older rotated files are renamed to <path>.2 ... <path>.<maxFiles>
+type rotatingFile struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxFiles int) (*rotatingFile, error) {
+	rf := &rotatingFile{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	return rf, rf.open()
+}
+
+func (rf *rotatingFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	rf.file, rf.size = f, fi.Size()
+	return nil
+}
+
+func (rf *rotatingFile) Write(b []byte) (int, error) {
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+	if rf.size > 0 && rf.size+int64(len(b)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(b)
+	rf.size += int64(n)
+	return n, err
+}
+
+func (rf *rotatingFile) rotate() error {
+	rf.file.Close()
+	rf.file = nil
+	for i := rf.maxFiles - 1; i > 0; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", rf.path, i), fmt.Sprintf("%s.%d", rf.path, i+1))
+	}
+	if rf.maxFiles > 0 {
+		if err := os.Rename(rf.path, rf.path+".1"); err != nil {
+			return err
+		}
+	} else {
+		os.Remove(rf.path)
+	}
+	return rf.open()
+}
+
+func (rf *rotatingFile) Close() error {
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diameter
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCaptureIMSI      = "001010000000001"
+	testCaptureSessionID = "magma-fedgw;123;456;IMSI001010000000001"
+)
+
+func newCaptureTestCCR(hopByHopID uint32) *diam.Message {
+	m := diam.NewMessage(diam.CreditControl, diam.RequestFlag, diam.CHARGING_CONTROL_APP_ID, hopByHopID, 1, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(testCaptureSessionID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("magma-fedgw.magma.com"))
+	m.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(1))
+	m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(1)),
+			diam.NewAVP(avp.SubscriptionIDData, avp.Mbit, 0, datatype.UTF8String(testCaptureIMSI)),
+		},
+	})
+	m.NewAVP(avp.FramedIPAddress, avp.Mbit, 0, datatype.OctetString([]byte{192, 168, 128, 12}))
+	return m
+}
+
+func TestCaptureRedactedPairs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture.json")
+	c, err := StartCapture(path, 1<<20, 2)
+	require.NoError(t, err)
+
+	req := newCaptureTestCCR(100)
+	captureRequest(req, "127.0.0.1:3868")
+	captureRequest(newCaptureTestCCR(101), "127.0.0.1:3868") // never answered
+	ans := req.Answer(diam.Success)
+	ans.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(testCaptureSessionID))
+	captureAnswer(ans)
+	captureAnswer(req.Answer(diam.Success)) // answer of already captured request is ignored
+	StopCapture()
+	assert.Nil(t, getCapture())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), testCaptureIMSI)
+
+	records, err := ReadCaptureFile(path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	rec := records[0]
+	assert.Equal(t, "127.0.0.1:3868", rec.Peer)
+	assert.Equal(t, uint32(diam.CreditControl), rec.CommandCode)
+	assert.Equal(t, uint32(diam.CHARGING_CONTROL_APP_ID), rec.ApplicationID)
+	assert.True(t, strings.HasPrefix(rec.SessionID, RedactedPrefix))
+	assert.Equal(t, c.pseudonym(testCaptureSessionID), rec.SessionID)
+	require.NotNil(t, rec.Answer)
+
+	m, err := rec.Request.Message()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(100), m.Header.HopByHopID)
+	subID, err := m.FindAVP(avp.SubscriptionIDData, 0)
+	require.NoError(t, err)
+	assert.Equal(t, datatype.UTF8String(c.pseudonym(testCaptureIMSI)), subID.Data)
+	ip, err := m.FindAVP(avp.FramedIPAddress, 0)
+	require.NoError(t, err)
+	assert.Equal(t, datatype.OctetString([]byte{0, 0, 0, 0}), ip.Data)
+	host, err := m.FindAVP(avp.OriginHost, 0)
+	require.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("magma-fedgw.magma.com"), host.Data)
+
+	a, err := rec.Answer.Message()
+	require.NoError(t, err)
+	sid, err := a.FindAVP(avp.SessionID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, datatype.UTF8String(rec.SessionID), sid.Data)
+
+	assert.Equal(t, uint32(101), mustMessage(t, records[1].Request).Header.HopByHopID)
+	assert.Nil(t, records[1].Answer)
+}
+
+func TestCaptureFileRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture.json")
+	rf, err := newRotatingFile(path, 100, 2)
+	require.NoError(t, err)
+	line := append(bytes.Repeat([]byte{'x'}, 59), '\n')
+	for i := 0; i < 5; i++ {
+		_, err = rf.Write(line)
+		require.NoError(t, err)
+	}
+	require.NoError(t, rf.Close())
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		b, err := os.ReadFile(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, line, b, name)
+	}
+	_, err = os.Stat(fmt.Sprintf("%s.3", path))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func mustMessage(t *testing.T, cm *CapturedMessage) *diam.Message {
+	m, err := cm.Message()
+	require.NoError(t, err)
+	return m
+}
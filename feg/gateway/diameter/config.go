@@ -20,6 +20,7 @@ import (
 	"net"
 	"strings"
 
+	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/utils"
 )
 
@@ -70,6 +71,9 @@ type DiameterServerConfig struct {
 	DestRealm         string
 	DisableDestHost   bool
 	OverwriteDestHost bool
+	Priority          uint32                  // peer priority, lower value is preferred
+	Weight            uint32                  // relative share of requests among peers of equal priority
+	Peers             []*DiameterServerConfig // alternate servers, together with this server they form a PeerTable
 }
 
 // DiameterClientConfig holds information for connecting with a diameter server
@@ -102,6 +106,11 @@ func (cfg *DiameterServerConfig) Validate() error {
 	if err != nil {
 		return fmt.Errorf("Invalid Diameter Address (%s://%s): %v", cfg.Protocol, cfg.Addr, err)
 	}
+	for i, peer := range cfg.Peers {
+		if err = peer.Validate(); err != nil {
+			return fmt.Errorf("Invalid Diameter Peer #%d: %v", i, err)
+		}
+	}
 	return nil
 }
 
@@ -141,6 +150,36 @@ func (srcCfg *DiameterClientConfig) FillInDefaults() *DiameterClientConfig {
 	return &cfg
 }
 
+// GetPeerConfigs converts peers of the given managed diameter client config into
+// DiameterServerConfigs. Peers with empty addresses are skipped and peers without
+// a protocol inherit the protocol of the parent config
+func GetPeerConfigs(cfg *mconfig.DiamClientConfig) []*DiameterServerConfig {
+	var peers []*DiameterServerConfig
+	for _, peer := range cfg.GetPeers() {
+		if len(peer.GetAddress()) == 0 {
+			continue
+		}
+		protocol := peer.GetProtocol()
+		if len(protocol) == 0 {
+			protocol = cfg.GetProtocol()
+		}
+		peers = append(peers, &DiameterServerConfig{
+			DiameterServerConnConfig: DiameterServerConnConfig{
+				Addr:      peer.GetAddress(),
+				Protocol:  protocol,
+				LocalAddr: peer.GetLocalAddress(),
+			},
+			DestHost:          peer.GetDestHost(),
+			DestRealm:         peer.GetDestRealm(),
+			DisableDestHost:   peer.GetDisableDestHost(),
+			OverwriteDestHost: peer.GetOverwriteDestHost(),
+			Priority:          peer.GetPriority(),
+			Weight:            peer.GetWeight(),
+		})
+	}
+	return peers
+}
+
 // GetValueUint64 returns value of the flagValue if it exists, or defaultValue if not
 func GetValueUint64(flagName string, defaultValue uint64) uint64 {
 	return utils.GetValueUint64(flagName, defaultValue)
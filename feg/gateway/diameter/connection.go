@@ -25,6 +25,7 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/avp"
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
 	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smparser"
 	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
 	"github.com/golang/glog"
 	"github.com/ishidawataru/sctp"
@@ -35,9 +36,14 @@ type messageTypeEnum uint8
 const (
 	requestMessage messageTypeEnum = 1
 	answerMessage  messageTypeEnum = 2
+	relayMessage   messageTypeEnum = 3
 
 	connectionRecoveryInterval = time.Second
 	connectionRecoveryattempts = 6
+	connectionDialTimeout      = time.Second * 5
+
+	defaultWatchdogInterval   = time.Second * 5
+	defaultRetransmitInterval = time.Second
 )
 
 // Connection is representing a diameter connection that you can
@@ -49,14 +55,25 @@ type Connection struct {
 	client   *sm.Client
 	disabled bool
 	mutex    sync.Mutex
+	// closeHandler is notified when an established connection is lost
+	closeHandler func(*Connection)
 }
 
 var disabledErr = errors.New("connection disabled")
 
-func newConnection(client *sm.Client, server *DiameterServerConfig) *Connection {
+var (
+	// handshakeLocks serializes handshakes of connections sharing the same sm.Client,
+	// sm.Client registers a single CEA handler for all its handshakes
+	handshakeLocks sync.Map // *sm.Client -> *sync.Mutex
+	// watchdogAnswers maps diameter connections to channels of their watchdogs
+	watchdogAnswers sync.Map // diam.Conn -> chan struct{}
+)
+
+func newConnection(client *sm.Client, server *DiameterServerConfig, closeHandler func(*Connection)) *Connection {
 	conn := &Connection{
-		server: server,
-		client: client,
+		server:       server,
+		client:       client,
+		closeHandler: closeHandler,
 	}
 	go func() { // init connection in a goroutine, it can block for long time
 		_, _, err := conn.getDiamConnection() // attempt to establish connection at start
@@ -81,6 +98,12 @@ func (c *Connection) SendRequestToServer(message *diam.Message, retryCount uint,
 	return c.sendMessageWithRetries(message, requestMessage, retryCount, server)
 }
 
+// RelayRequest sends a request received from another diameter peer as is, without adding or
+// modifying its destination AVPs
+func (c *Connection) RelayRequest(message *diam.Message, retryCount uint) error {
+	return c.sendMessageWithRetries(message, relayMessage, retryCount, nil)
+}
+
 func (c *Connection) sendMessageWithRetries(
 	message *diam.Message, messageType messageTypeEnum, retryCount uint, server *DiameterServerConfig) error {
 
@@ -157,7 +180,14 @@ func (c *Connection) getDiamConnection() (diam.Conn, *smpeer.Metadata, error) {
 				"Invalid " + c.server.Protocol + " local address '" + c.server.LocalAddr + "':" + err.Error())
 		}
 	}
-	conn, err := c.client.DialExt(c.server.Protocol, c.server.Addr, 0, localAddr)
+	// sm.Client's watchdog cannot tell apart DWAs of multiple connections sharing the client,
+	// dial with a copy of the client with disabled watchdog & run a watchdog per connection instead
+	client := *c.client
+	client.EnableWatchdog = false
+	lock, _ := handshakeLocks.LoadOrStore(c.client, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	conn, err := client.DialExt(c.server.Protocol, c.server.Addr, connectionDialTimeout, localAddr)
+	lock.(*sync.Mutex).Unlock()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,26 +199,113 @@ func (c *Connection) getDiamConnection() (diam.Conn, *smpeer.Metadata, error) {
 	c.conn, c.metadata = conn, metadata
 	if cn, ok := conn.(diam.CloseNotifier); ok && cn != nil {
 		go c.connCloseNotify(cn.CloseNotify(), conn)
+		if c.client.EnableWatchdog {
+			dwac := make(chan struct{}, 1)
+			watchdogAnswers.Store(conn, dwac)
+			c.client.Handler.HandleFunc("DWA", handleWatchdogAnswer)
+			go c.watchdog(conn, cn.CloseNotify(), dwac)
+		}
 	} else {
 		glog.Errorf("new diam conn (%T) %s -> %s is not CloseNotifier", conn, localAddr, c.server.Addr)
 	}
 	return conn, metadata, nil
 }
 
+// watchdog sends Device-Watchdog-Requests on the connection every WatchdogInterval of the client.
+// If none of MaxRetransmits + 1 DWRs gets answered or DWR cannot be sent, the connection is destroyed
+// & recovered. The connection is destroyed here rather than by the close notifier since
+// diam.Conn does not notify of closures which happen before its next read
+func (c *Connection) watchdog(conn diam.Conn, disconnect <-chan struct{}, dwac chan struct{}) {
+	defer watchdogAnswers.Delete(conn)
+	interval, retransmitInterval := c.client.WatchdogInterval, c.client.RetransmitInterval
+	if interval == 0 {
+		interval = defaultWatchdogInterval
+	}
+	if retransmitInterval == 0 {
+		retransmitInterval = defaultRetransmitInterval
+	}
+	for {
+		select {
+		case <-disconnect:
+			return
+		case <-time.After(interval):
+		}
+		answered := false
+		m := c.newWatchdogRequest()
+		for i := uint(0); i <= c.client.MaxRetransmits && !answered; i++ {
+			if _, err := m.WriteToStream(conn, c.client.WatchdogStream); err != nil {
+				glog.V(1).Infof("failed to send DWR on %s connection: %v", connAddrStr(conn), err)
+				break
+			}
+			select {
+			case <-dwac:
+				answered = true
+			case <-disconnect:
+				return
+			case <-time.After(retransmitInterval):
+			}
+		}
+		if !answered {
+			// destroyConnection returns false if the connection was already closed by connection management
+			if c.destroyConnection(conn) {
+				glog.Errorf("diameter watchdog failure on %s connection", connAddrStr(conn))
+				c.recoverConnection()
+			}
+			return
+		}
+	}
+}
+
+func (c *Connection) newWatchdogRequest() *diam.Message {
+	m := diam.NewRequest(diam.DeviceWatchdog, 0, c.client.Dict)
+	settings := c.client.Handler.Settings()
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
+	if settings.OriginStateID != 0 {
+		m.NewAVP(avp.OriginStateID, avp.Mbit, 0, settings.OriginStateID)
+	}
+	return m
+}
+
+// handleWatchdogAnswer notifies the watchdog of the connection on which a successful DWA is received
+func handleWatchdogAnswer(conn diam.Conn, m *diam.Message) {
+	dwac, ok := watchdogAnswers.Load(conn)
+	if !ok {
+		return
+	}
+	dwa := new(smparser.DWA)
+	if err := dwa.Parse(m); err != nil {
+		glog.Errorf("invalid DWA from %s: %v", connAddrStr(conn), err)
+		return
+	}
+	if dwa.ResultCode != diam.Success {
+		return
+	}
+	select {
+	case dwac.(chan struct{}) <- struct{}{}:
+	default:
+	}
+}
+
 func (c *Connection) connCloseNotify(cnc <-chan struct{}, conn diam.Conn) {
 	<-cnc // wait for close notifier
 	glog.V(1).Infof("notified of %s connection closure", connAddrStr(conn))
 	if c != nil && conn != nil && c.destroyConnection(conn) {
 		// if connection was closed not by connection management functions, recover it
-		retryWaitTime := connectionRecoveryInterval
-		for i := 0; i < connectionRecoveryattempts; i++ {
-			_, _, err := c.getDiamConnection()
-			if err == nil || err == disabledErr {
-				break
-			}
-			time.Sleep(retryWaitTime)
-			retryWaitTime *= 2
+		c.recoverConnection()
+	}
+}
+
+// recoverConnection attempts to reestablish the connection with increasing intervals
+func (c *Connection) recoverConnection() {
+	retryWaitTime := connectionRecoveryInterval
+	for i := 0; i < connectionRecoveryattempts; i++ {
+		_, _, err := c.getDiamConnection()
+		if err == nil || err == disabledErr {
+			break
 		}
+		time.Sleep(retryWaitTime)
+		retryWaitTime *= 2
 	}
 }
 
@@ -208,6 +325,9 @@ func (c *Connection) destroyConnection(conn diam.Conn) bool {
 		c.metadata = nil
 	}
 	c.mutex.Unlock()
+	if match && c.closeHandler != nil {
+		go c.closeHandler(c)
+	}
 
 	if glog.V(2) {
 		if match {
@@ -221,6 +341,13 @@ func (c *Connection) destroyConnection(conn diam.Conn) bool {
 	return match
 }
 
+// state returns true and the peer metadata if the connection is currently established
+func (c *Connection) state() (bool, *smpeer.Metadata) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.conn != nil && !c.disabled, c.metadata
+}
+
 // cleanupConnection is similar to destroyConnection, but it closes & cleans up connection unconditionally
 func (c *Connection) cleanupConnection(disabled bool) {
 	c.mutex.Lock()
@@ -27,9 +27,10 @@ import (
 // ConnectionManager holds a map of connections keyed by the server ip/protocol
 // pair
 type ConnectionManager struct {
-	connMap  map[DiameterServerConnConfig]*Connection // map of DiameterServerConfig -> *lockedConnection
-	disabled bool                                     // true is new connection creation is disabled
-	rwl      sync.RWMutex
+	connMap       map[DiameterServerConnConfig]*Connection // map of DiameterServerConfig -> *lockedConnection
+	disabled      bool                                     // true is new connection creation is disabled
+	closeHandlers []func(*Connection)                      // handlers notified of lost connections
+	rwl           sync.RWMutex
 }
 
 func NewConnectionManager() *ConnectionManager {
@@ -60,7 +61,7 @@ func (cm *ConnectionManager) GetConnection(client *sm.Client, server *DiameterSe
 	if ok && conn != nil { // check again, another thread may have added a connection between RUnlock() & Lock()
 		return conn, nil
 	}
-	conn = newConnection(client, server)
+	conn = newConnection(client, server, cm.notifyClosed)
 	cm.connMap[server.DiameterServerConnConfig] = conn
 	glog.V(2).Infof("ConnectionManager: created connection for %+v", server)
 
@@ -149,6 +150,27 @@ func (cm *ConnectionManager) FindConnection(server *DiameterServerConfig) *Conne
 	return nil
 }
 
+// AddCloseHandler registers a handler to be notified when any of the manager's established
+// connections is lost (write failure, watchdog failure or closure by the peer).
+// Connections closed by the manager itself (Disable/Cleanup) are not reported
+func (cm *ConnectionManager) AddCloseHandler(handler func(*Connection)) {
+	if cm == nil || handler == nil {
+		return
+	}
+	cm.rwl.Lock()
+	defer cm.rwl.Unlock()
+	cm.closeHandlers = append(cm.closeHandlers, handler)
+}
+
+func (cm *ConnectionManager) notifyClosed(conn *Connection) {
+	cm.rwl.RLock()
+	handlers := cm.closeHandlers
+	cm.rwl.RUnlock()
+	for _, handler := range handlers {
+		handler(conn)
+	}
+}
+
 func (cm *ConnectionManager) cleanupConnections() {
 	glog.V(2).Info("ConnectionManager: removing all existing connections")
 	for _, c := range cm.connMap {
@@ -19,6 +19,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fiorix/go-diameter/v4/diam"
@@ -51,6 +52,8 @@ type Client struct {
 	requestTracker *RequestTracker
 	cfg            *DiameterClientConfig
 	originStateID  uint32
+	peerTables     map[DiameterServerConnConfig]*PeerTable // peer tables keyed by their primary server
+	peersMutex     sync.Mutex
 }
 
 // String stringifies diameter client configuration
@@ -175,6 +178,7 @@ func NewClient(clientCfg *DiameterClientConfig, localAddresses ...string) *Clien
 		requestTracker: NewRequestTracker(),
 		cfg:            clientCfg,
 		originStateID:  originStateID,
+		peerTables:     map[DiameterServerConnConfig]*PeerTable{},
 	}
 	glog.V(1).Infof("new diam client::\n\t%s", client.String())
 
@@ -240,13 +244,38 @@ func (client *Client) BeginConnection(server *DiameterServerConfig) error {
 		glog.Error(err)
 		return err
 	}
-	_, err := client.connMan.GetConnection(client.smClient, server)
+	err := client.getPeerTable(server).Connect()
 	if err != nil {
 		glog.Error(err)
 	}
 	return err
 }
 
+// getPeerTable returns the peer table of the given server and its peers, creating it if needed
+func (client *Client) getPeerTable(server *DiameterServerConfig) *PeerTable {
+	client.peersMutex.Lock()
+	defer client.peersMutex.Unlock()
+	pt, ok := client.peerTables[server.DiameterServerConnConfig]
+	if !ok {
+		var requestTimeout time.Duration
+		if client.cfg != nil {
+			requestTimeout = time.Second * time.Duration(client.cfg.RequestTimeout)
+		}
+		pt = NewPeerTable(client.smClient, client.connMan, requestTimeout, server)
+		client.peerTables[server.DiameterServerConnConfig] = pt
+	}
+	return pt
+}
+
+// answerReceived stops retransmission tracking of the request matching the answer
+func (client *Client) answerReceived(message *diam.Message) {
+	client.peersMutex.Lock()
+	defer client.peersMutex.Unlock()
+	for _, pt := range client.peerTables {
+		pt.AnswerReceived(message)
+	}
+}
+
 func (client *Client) Retries() uint {
 	if client != nil && client.cfg != nil {
 		return client.cfg.RetryCount
@@ -276,7 +305,8 @@ func (client *Client) DisableConnectionCreation(period time.Duration) {
 // SendRequest sends a diameter request message to the given server and sends
 // back the answer on the given channel. A key is required to identify the
 // corresponding answer. Additionally, SendRequest will add the OriginHost/Realm
-// AVPs to the message because they are mandatory for all requests
+// AVPs to the message because they are mandatory for all requests.
+// If the server has Peers, the request is routed via the server's PeerTable
 // Input:
 //   - server  -- cfg containing info on what server to send to
 //   - done    -- channel to send the answer to when received
@@ -291,13 +321,10 @@ func (client *Client) SendRequest(
 	key interface{},
 ) error {
 	client.requestTracker.RegisterRequest(key, done)
-	conn, err := client.connMan.GetConnection(client.smClient, server)
-	if err == nil {
-		m := client.AddOriginAVPsToMessage(message)
-		err = conn.SendRequestToServer(m, client.cfg.RetryCount, server)
-		if err != nil {
-			client.requestTracker.DeregisterRequest(key)
-		}
+	m := client.AddOriginAVPsToMessage(message)
+	err := client.getPeerTable(server).SendRequest(m, client.cfg.RetryCount)
+	if err != nil {
+		client.requestTracker.DeregisterRequest(key)
 	}
 	return err
 }
@@ -339,6 +366,7 @@ func (client *Client) RegisterAnswerHandlerForAppID(command uint32, appID uint32
 			glog.Error("nil diameter message")
 			return
 		}
+		client.answerReceived(m)
 		answerKey := handler(m)
 		if answerKey.Key == nil {
 			glog.Errorf("nil Key found in received diameter message:\n%s\n", m.String())
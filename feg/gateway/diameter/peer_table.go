@@ -0,0 +1,438 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diameter
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/golang/glog"
+)
+
+const (
+	// peerRecheckInterval is the time a failed & disconnected peer is skipped for before
+	// it becomes eligible for new requests again
+	peerRecheckInterval = time.Second * 30
+	defaultPeerWeight   = 1
+)
+
+// PeerTable distributes requests of a diameter application over a set of servers (peers).
+// For every request a peer is selected by:
+//   - Destination-Realm: only peers serving the realm of the request are eligible
+//   - health: peers which lost their connection are skipped until they recover
+//   - priority: peers with the lowest Priority value are preferred
+//   - weight: requests are spread over peers of equal priority proportionally to their Weight
+//
+// Peer health follows the state of the peer's connection. The sm.Client watchdog closes connections
+// which stop answering Device-Watchdog-Requests, so a DWR failure takes the peer out of rotation.
+// Requests in flight on a peer which goes down are retransmitted to an alternate peer with
+// the T (retransmitted) flag set.
+type PeerTable struct {
+	client         *sm.Client
+	connMan        *ConnectionManager
+	peers          []*peer
+	inFlight       map[uint32]*inFlightRequest // keyed by Hop-by-Hop ID
+	requestTimeout time.Duration
+	lastPrune      time.Time
+	mutex          sync.Mutex
+}
+
+type peer struct {
+	server   *DiameterServerConfig
+	failedAt time.Time // zero if the peer did not fail since it was last used successfully
+}
+
+// PeerStatus describes the current state of a peer table's peer
+type PeerStatus struct {
+	Server     *DiameterServerConfig
+	Connected  bool
+	Failed     bool   // the peer is skipped for new requests after a recent failure
+	OriginHost string // Origin-Host of the peer's CEA, empty if the peer never connected
+}
+
+type inFlightRequest struct {
+	message    *diam.Message
+	peer       *peer
+	retryCount uint
+	relay      bool
+	expires    time.Time
+}
+
+// NewPeerTable creates a peer table for the given server and all its Peers. The table uses connections
+// of the given connection manager and is notified by it of lost connections.
+// requestTimeout defines how long sent requests are kept for retransmission, if 0 -
+// DefaultRequestTimeoutSeconds is used
+func NewPeerTable(
+	client *sm.Client, connMan *ConnectionManager, requestTimeout time.Duration, server *DiameterServerConfig) *PeerTable {
+
+	if requestTimeout == 0 {
+		requestTimeout = time.Second * DefaultRequestTimeoutSeconds
+	}
+	pt := &PeerTable{
+		client:         client,
+		connMan:        connMan,
+		inFlight:       map[uint32]*inFlightRequest{},
+		requestTimeout: requestTimeout,
+		lastPrune:      time.Now(),
+	}
+	if server != nil {
+		pt.peers = append(pt.peers, &peer{server: server})
+		for _, p := range server.Peers {
+			if p != nil {
+				pt.peers = append(pt.peers, &peer{server: p})
+			}
+		}
+	}
+	if len(pt.peers) > 1 {
+		connMan.AddCloseHandler(pt.handleConnectionClosed)
+	}
+	return pt
+}
+
+// Connect initiates connections to all peers of the table
+func (pt *PeerTable) Connect() error {
+	var err error
+	for _, p := range pt.peers {
+		if _, e := pt.connMan.GetConnection(pt.client, p.server); e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// SendRequest selects a peer for the request and sends it. If sending to the selected peer fails,
+// the request is sent to the next eligible peer with the T flag set.
+// For tables with multiple peers, successfully sent requests are kept for retransmission until
+// AnswerReceived is called for them or the table's request timeout expires
+func (pt *PeerTable) SendRequest(message *diam.Message, retryCount uint) error {
+	if pt == nil || len(pt.peers) == 0 {
+		return errors.New("empty diameter peer table")
+	}
+	return pt.send(message, retryCount, false, nil)
+}
+
+// RelayRequest is the same as SendRequest, but it sends the request as is, destination AVPs of relayed
+// requests are set by their originator and are not modified. Relayed requests are routed by the caller,
+// so peers are not filtered by the request's Destination-Realm
+func (pt *PeerTable) RelayRequest(message *diam.Message, retryCount uint) error {
+	if pt == nil || len(pt.peers) == 0 {
+		return errors.New("empty diameter peer table")
+	}
+	return pt.send(message, retryCount, true, nil)
+}
+
+// AnswerReceived stops tracking of the request matching the given answer, if diameter capture is enabled
+// the answer is captured with its request
+func (pt *PeerTable) AnswerReceived(message *diam.Message) {
+	if pt == nil || message == nil {
+		return
+	}
+	captureAnswer(message)
+	if len(pt.peers) < 2 {
+		return
+	}
+	pt.mutex.Lock()
+	delete(pt.inFlight, message.Header.HopByHopID)
+	pt.mutex.Unlock()
+}
+
+// HandleAnswers returns a diameter handler which stops tracking of answered requests before
+// passing the answers to the given handler
+func (pt *PeerTable) HandleAnswers(handler diam.Handler) diam.Handler {
+	return diam.HandlerFunc(func(c diam.Conn, m *diam.Message) {
+		pt.AnswerReceived(m)
+		handler.ServeDIAM(c, m)
+	})
+}
+
+// Status returns current states of all peers of the table
+func (pt *PeerTable) Status() []PeerStatus {
+	if pt == nil {
+		return nil
+	}
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+	now := time.Now()
+	res := make([]PeerStatus, 0, len(pt.peers))
+	for _, p := range pt.peers {
+		connected, metadata := pt.peerState(p)
+		status := PeerStatus{
+			Server:    p.server,
+			Connected: connected,
+			Failed:    !connected && !p.failedAt.IsZero() && now.Sub(p.failedAt) <= peerRecheckInterval,
+		}
+		if metadata != nil {
+			status.OriginHost = string(metadata.OriginHost)
+		}
+		res = append(res, status)
+	}
+	return res
+}
+
+func (pt *PeerTable) send(message *diam.Message, retryCount uint, relay bool, failedPeer *peer) error {
+	var realm string
+	if !relay {
+		realm = requestRealm(message)
+	}
+	tried := map[*peer]bool{}
+	if failedPeer != nil {
+		tried[failedPeer] = true
+	}
+	var lastErr error
+	for {
+		p := pt.selectPeer(realm, tried)
+		if p == nil {
+			if lastErr == nil {
+				lastErr = fmt.Errorf("no available diameter peer for realm '%s'", realm)
+			}
+			return lastErr
+		}
+		tried[p] = true
+		conn, err := pt.connMan.GetConnection(pt.client, p.server)
+		if err != nil {
+			return err // connection manager is disabled, don't fail over
+		}
+		if relay {
+			err = conn.RelayRequest(message, retryCount)
+		} else {
+			err = pt.sendToPeer(conn, message, retryCount, p)
+		}
+		if err == nil {
+			captureRequest(message, p.server.Addr)
+			pt.sent(message, p, retryCount, relay)
+			return nil
+		}
+		if err == disabledErr {
+			return err
+		}
+		glog.Warningf("failed to send diameter request to peer %s: %v", p.server.Addr, err)
+		pt.peerFailed(p)
+		lastErr = err
+		message.Header.CommandFlags |= diam.RetransmittedFlag
+	}
+}
+
+// sendToPeer sends the request with destination AVPs of the given peer
+func (pt *PeerTable) sendToPeer(conn *Connection, message *diam.Message, retryCount uint, p *peer) error {
+	server := p.server
+	if message.Header.CommandFlags&diam.RetransmittedFlag != 0 {
+		// Destination-Host of the previous peer must be replaced or removed
+		if server.DisableDestHost {
+			removeAVP(message, avp.DestinationHost)
+		} else {
+			srv := *server
+			srv.OverwriteDestHost = true
+			server = &srv
+		}
+	}
+	return conn.SendRequestToServer(message, retryCount, server)
+}
+
+// selectPeer returns an eligible peer of the highest priority (lowest Priority value), peers of
+// equal priority are chosen randomly proportionally to their weights
+func (pt *PeerTable) selectPeer(realm string, exclude map[*peer]bool) *peer {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	now := time.Now()
+	var healthy, failed []*peer
+	for _, p := range pt.peers {
+		if exclude[p] {
+			continue
+		}
+		connected, metadata := pt.peerState(p)
+		if !p.servesRealm(realm, metadata) {
+			continue
+		}
+		if connected || p.failedAt.IsZero() || now.Sub(p.failedAt) > peerRecheckInterval {
+			healthy = append(healthy, p)
+		} else {
+			failed = append(failed, p)
+		}
+	}
+	if len(healthy) == 0 {
+		// all peers are down, try the failed ones anyway
+		healthy = failed
+	}
+	return pickPeer(healthy)
+}
+
+func pickPeer(peers []*peer) *peer {
+	var (
+		candidates  []*peer
+		totalWeight uint64
+	)
+	for _, p := range peers {
+		if len(candidates) > 0 && p.server.Priority > candidates[0].server.Priority {
+			continue
+		}
+		if len(candidates) > 0 && p.server.Priority < candidates[0].server.Priority {
+			candidates, totalWeight = candidates[:0], 0
+		}
+		candidates = append(candidates, p)
+		totalWeight += uint64(p.weight())
+	}
+	if len(candidates) <= 1 {
+		if len(candidates) == 1 {
+			return candidates[0]
+		}
+		return nil
+	}
+	r := uint64(rand.Int63n(int64(totalWeight)))
+	for _, p := range candidates {
+		w := uint64(p.weight())
+		if r < w {
+			return p
+		}
+		r -= w
+	}
+	return candidates[len(candidates)-1]
+}
+
+func (pt *PeerTable) peerState(p *peer) (bool, *smpeer.Metadata) {
+	conn := pt.connMan.FindConnection(p.server)
+	if conn == nil {
+		return false, nil
+	}
+	return conn.state()
+}
+
+func (pt *PeerTable) peerFailed(p *peer) {
+	pt.mutex.Lock()
+	p.failedAt = time.Now()
+	pt.mutex.Unlock()
+}
+
+func (pt *PeerTable) sent(message *diam.Message, p *peer, retryCount uint, relay bool) {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+	p.failedAt = time.Time{}
+	if len(pt.peers) < 2 {
+		return // nowhere to retransmit to
+	}
+	now := time.Now()
+	if now.Sub(pt.lastPrune) > pt.requestTimeout {
+		for id, req := range pt.inFlight {
+			if now.After(req.expires) {
+				delete(pt.inFlight, id)
+			}
+		}
+		pt.lastPrune = now
+	}
+	pt.inFlight[message.Header.HopByHopID] = &inFlightRequest{
+		message:    message,
+		peer:       p,
+		retryCount: retryCount,
+		relay:      relay,
+		expires:    now.Add(pt.requestTimeout),
+	}
+}
+
+// handleConnectionClosed marks the peer of the lost connection as failed and retransmits
+// all its unanswered requests to alternate peers
+func (pt *PeerTable) handleConnectionClosed(conn *Connection) {
+	if conn == nil || conn.server == nil {
+		return
+	}
+	var (
+		failedPeer *peer
+		requests   []*inFlightRequest
+	)
+	now := time.Now()
+	pt.mutex.Lock()
+	for _, p := range pt.peers {
+		if p.server.DiameterServerConnConfig == conn.server.DiameterServerConnConfig {
+			failedPeer = p
+			break
+		}
+	}
+	if failedPeer != nil {
+		failedPeer.failedAt = now
+		for id, req := range pt.inFlight {
+			if req.peer == failedPeer {
+				delete(pt.inFlight, id)
+				if now.Before(req.expires) {
+					requests = append(requests, req)
+				}
+			}
+		}
+	}
+	pt.mutex.Unlock()
+
+	if failedPeer == nil {
+		return
+	}
+	glog.Warningf("diameter peer %s is down, retransmitting %d in flight requests",
+		failedPeer.server.Addr, len(requests))
+	for _, req := range requests {
+		req.message.Header.CommandFlags |= diam.RetransmittedFlag
+		if err := pt.send(req.message, req.retryCount, req.relay, failedPeer); err != nil {
+			glog.Errorf("failed to retransmit diameter request %d: %v", req.message.Header.HopByHopID, err)
+		}
+	}
+}
+
+func (p *peer) weight() uint32 {
+	if p.server.Weight == 0 {
+		return defaultPeerWeight
+	}
+	return p.server.Weight
+}
+
+// servesRealm returns true if the peer's realm matches the given realm, peer's realm is either
+// configured DestRealm or Origin-Realm learned from the peer's CEA
+func (p *peer) servesRealm(realm string, metadata *smpeer.Metadata) bool {
+	if len(realm) == 0 {
+		return true
+	}
+	peerRealm := p.server.DestRealm
+	if len(peerRealm) == 0 && metadata != nil {
+		peerRealm = string(metadata.OriginRealm)
+	}
+	return len(peerRealm) == 0 || strings.EqualFold(peerRealm, realm)
+}
+
+// requestRealm returns Destination-Realm of the request if present
+func requestRealm(message *diam.Message) string {
+	realmAVP, err := message.FindAVP(avp.DestinationRealm, 0)
+	if err != nil || realmAVP == nil {
+		return ""
+	}
+	if realm, ok := realmAVP.Data.(datatype.DiameterIdentity); ok {
+		return string(realm)
+	}
+	return ""
+}
+
+// removeAVP removes all top level AVPs with the given code from the message
+func removeAVP(message *diam.Message, code uint32) {
+	avps := message.AVP[:0]
+	for _, a := range message.AVP {
+		if a.Code == code {
+			message.Header.MessageLength -= uint32(a.Len())
+			continue
+		}
+		avps = append(avps, a)
+	}
+	message.AVP = avps
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diameter
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type peerTestServer struct {
+	server   *DiameterServerConfig
+	listener net.Listener
+	received chan *diam.Message
+	conns    chan diam.Conn
+}
+
+// startPeerTestServer starts a diameter server which reports all received CCRs to its received
+// channel and, if dropRequests is set, closes the connection on which a CCR is received
+func startPeerTestServer(t *testing.T, realm string, priority, weight uint32, dropRequests bool) *peerTestServer {
+	mux := sm.New(&sm.Settings{
+		OriginHost:  datatype.DiameterIdentity("server." + realm),
+		OriginRealm: datatype.DiameterIdentity(realm),
+		VendorID:    datatype.Unsigned32(Vendor3GPP),
+		ProductName: "peer table test",
+	})
+	l, err := diam.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ts := &peerTestServer{
+		server: &DiameterServerConfig{
+			DiameterServerConnConfig: DiameterServerConnConfig{Addr: l.Addr().String(), Protocol: "tcp"},
+			Priority:                 priority,
+			Weight:                   weight,
+		},
+		listener: l,
+		received: make(chan *diam.Message, 10),
+		conns:    make(chan diam.Conn, 10),
+	}
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true},
+		diam.HandlerFunc(func(c diam.Conn, m *diam.Message) {
+			ts.conns <- c
+			ts.received <- m
+			if dropRequests {
+				time.Sleep(time.Millisecond * 50)
+				c.Close()
+			}
+		}))
+	go (&diam.Server{Network: "tcp", Handler: mux}).Serve(l)
+	return ts
+}
+
+func (ts *peerTestServer) stop() {
+	ts.listener.Close()
+	for {
+		select {
+		case c := <-ts.conns:
+			c.Close()
+		default:
+			return
+		}
+	}
+}
+
+func newPeerTestClient() *sm.Client {
+	return &sm.Client{
+		Dict: dict.Default,
+		Handler: sm.New(&sm.Settings{
+			OriginHost:  "test.magma.com",
+			OriginRealm: "magma.com",
+			VendorID:    datatype.Unsigned32(Vendor3GPP),
+			ProductName: "peer table test",
+		}),
+		MaxRetransmits:     1,
+		RetransmitInterval: time.Millisecond * 200,
+		EnableWatchdog:     true,
+		WatchdogInterval:   time.Millisecond * 200,
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID)),
+		},
+	}
+}
+
+func newPeerTestRequest() *diam.Message {
+	m := diam.NewRequest(diam.CreditControl, diam.CHARGING_CONTROL_APP_ID, nil)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("test.magma.com"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+	return m
+}
+
+func receivePeerTestRequest(t *testing.T, ts *peerTestServer) *diam.Message {
+	select {
+	case m := <-ts.received:
+		return m
+	case <-time.After(time.Second * 3):
+		t.Fatalf("timed out waiting for request on %s", ts.server.Addr)
+	}
+	return nil
+}
+
+func assertNoPeerTestRequest(t *testing.T, ts *peerTestServer) {
+	select {
+	case m := <-ts.received:
+		t.Fatalf("unexpected request on %s: %s", ts.server.Addr, m)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func waitForPeerState(t *testing.T, pt *PeerTable, server *DiameterServerConfig, connected bool) {
+	for i := 0; i < 100; i++ {
+		conn := pt.connMan.FindConnection(server)
+		if conn != nil {
+			if c, _ := conn.state(); c == connected {
+				return
+			}
+		}
+		time.Sleep(time.Millisecond * 30)
+	}
+	t.Fatalf("peer %s did not reach connected == %t state", server.Addr, connected)
+}
+
+func TestPeerTableFailover(t *testing.T) {
+	primary := startPeerTestServer(t, "ocs.com", 0, 1, false)
+	secondary := startPeerTestServer(t, "ocs.com", 1, 1, false)
+	defer secondary.stop()
+
+	server := primary.server
+	server.Peers = []*DiameterServerConfig{secondary.server}
+	pt := NewPeerTable(newPeerTestClient(), NewConnectionManager(), time.Second, server)
+	assert.NoError(t, pt.Connect())
+	waitForPeerState(t, pt, primary.server, true)
+	waitForPeerState(t, pt, secondary.server, true)
+
+	// all requests go to the higher priority peer
+	for i := 0; i < 3; i++ {
+		require.NoError(t, pt.SendRequest(newPeerTestRequest(), 0))
+		m := receivePeerTestRequest(t, primary)
+		assert.Zero(t, m.Header.CommandFlags&diam.RetransmittedFlag)
+		host, err := m.FindAVP(avp.DestinationHost, 0)
+		require.NoError(t, err)
+		assert.Equal(t, datatype.DiameterIdentity("server.ocs.com"), host.Data)
+	}
+	assertNoPeerTestRequest(t, secondary)
+
+	// primary goes down, requests fail over to the secondary
+	primary.stop()
+	waitForPeerState(t, pt, primary.server, false)
+	require.NoError(t, pt.SendRequest(newPeerTestRequest(), 0))
+	receivePeerTestRequest(t, secondary)
+	assertNoPeerTestRequest(t, primary)
+
+	// all peers are down
+	secondary.stop()
+	waitForPeerState(t, pt, secondary.server, false)
+	assert.Error(t, pt.SendRequest(newPeerTestRequest(), 0))
+}
+
+func TestPeerTableRetransmitInFlight(t *testing.T) {
+	primary := startPeerTestServer(t, "ocs.com", 0, 1, true)
+	defer primary.stop()
+	secondary := startPeerTestServer(t, "ocs.com", 1, 1, false)
+	defer secondary.stop()
+
+	server := primary.server
+	server.Peers = []*DiameterServerConfig{secondary.server}
+	pt := NewPeerTable(newPeerTestClient(), NewConnectionManager(), time.Second*5, server)
+	assert.NoError(t, pt.Connect())
+	waitForPeerState(t, pt, primary.server, true)
+	waitForPeerState(t, pt, secondary.server, true)
+
+	req := newPeerTestRequest()
+	require.NoError(t, pt.SendRequest(req, 0))
+	m := receivePeerTestRequest(t, primary)
+	assert.Zero(t, m.Header.CommandFlags&diam.RetransmittedFlag)
+
+	// primary drops the connection without answering, the request is retransmitted to the secondary
+	m = receivePeerTestRequest(t, secondary)
+	assert.Equal(t, uint8(diam.RetransmittedFlag), m.Header.CommandFlags&diam.RetransmittedFlag)
+	assert.Equal(t, req.Header.HopByHopID, m.Header.HopByHopID)
+	assert.Equal(t, req.Header.EndToEndID, m.Header.EndToEndID)
+
+	// answered requests are not retransmitted
+	pt.AnswerReceived(m)
+	pt.mutex.Lock()
+	assert.Empty(t, pt.inFlight)
+	pt.mutex.Unlock()
+}
+
+func TestPeerTableSelection(t *testing.T) {
+	newPeer := func(addr, realm string, priority, weight uint32) *peer {
+		return &peer{server: &DiameterServerConfig{
+			DiameterServerConnConfig: DiameterServerConnConfig{Addr: addr, Protocol: "tcp"},
+			DestRealm:                realm,
+			Priority:                 priority,
+			Weight:                   weight,
+		}}
+	}
+	var (
+		p1 = newPeer("p1:3868", "ocs1.com", 1, 1)
+		p2 = newPeer("p2:3868", "ocs1.com", 1, 3)
+		p3 = newPeer("p3:3868", "ocs2.com", 2, 1)
+		p4 = newPeer("p4:3868", "", 3, 1)
+	)
+	assert.Nil(t, pickPeer(nil))
+	assert.Equal(t, p3, pickPeer([]*peer{p4, p3}))
+
+	counts := map[*peer]int{}
+	for i := 0; i < 4000; i++ {
+		counts[pickPeer([]*peer{p4, p3, p1, p2})]++
+	}
+	assert.Zero(t, counts[p3]+counts[p4])
+	assert.InDelta(t, 1000, counts[p1], 200)
+	assert.InDelta(t, 3000, counts[p2], 200)
+
+	pt := &PeerTable{connMan: NewConnectionManager(), peers: []*peer{p1, p2, p3, p4}}
+	assert.Equal(t, p3, pt.selectPeer("OCS2.com", nil))
+	assert.Equal(t, p4, pt.selectPeer("ocs3.com", nil))
+	assert.Equal(t, p4, pt.selectPeer("ocs1.com", map[*peer]bool{p1: true, p2: true}))
+
+	// failed peers are skipped while alternatives are available
+	p1.failedAt, p2.failedAt = time.Now(), time.Now()
+	assert.Equal(t, p3, pt.selectPeer("", nil))
+	assert.Contains(t, []*peer{p1, p2}, pt.selectPeer("ocs1.com", map[*peer]bool{p4: true}))
+	p1.failedAt = time.Now().Add(-peerRecheckInterval * 2)
+	assert.Equal(t, p1, pt.selectPeer("", nil))
+
+	// realm learned from CEA
+	assert.True(t, p4.servesRealm("ocs4.com", nil))
+	p4.server.DestRealm = ""
+	assert.False(t, p4.servesRealm("ocs4.com", &smpeer.Metadata{OriginRealm: "ocs5.com"}))
+	assert.True(t, p4.servesRealm("ocs5.com", &smpeer.Metadata{OriginRealm: "ocs5.com"}))
+}
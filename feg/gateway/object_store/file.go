@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// FileMap is an ObjectMap that keeps serialized objects in memory and
+// persists them into a local JSON file. With a positive flush interval the
+// file is rewritten periodically when the map changed, otherwise every
+// modification is written through to the file.
+type FileMap struct {
+	mu            sync.Mutex
+	path          string
+	flushInterval time.Duration
+	data          map[string]string
+	dirty         bool
+	serializer    Serializer
+	deserializer  Deserializer
+	done          chan struct{}
+	closeOnce     sync.Once
+}
+
+// NewFileMap creates a new file map backed by the file at path, loading
+// the file's content if it already exists
+func NewFileMap(
+	path string,
+	serializer Serializer,
+	deserializer Deserializer,
+	flushInterval time.Duration,
+) (*FileMap, error) {
+	fm := &FileMap{
+		path:          path,
+		flushInterval: flushInterval,
+		data:          map[string]string{},
+		serializer:    serializer,
+		deserializer:  deserializer,
+		done:          make(chan struct{}),
+	}
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(content) > 0 {
+		if err = json.Unmarshal(content, &fm.data); err != nil {
+			return nil, fmt.Errorf("invalid object store file %s: %v", path, err)
+		}
+	}
+	if flushInterval > 0 {
+		go fm.flushLoop(flushInterval)
+	}
+	return fm, nil
+}
+
+// Set sets an object in the map
+func (fm *FileMap) Set(key string, object interface{}) error {
+	str, err := fm.serializer(object)
+	if err != nil {
+		return err
+	}
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	fm.data[key] = str
+	return fm.changed()
+}
+
+// Get retrieves an object from the map
+func (fm *FileMap) Get(key string) (interface{}, error) {
+	fm.mu.Lock()
+	val, ok := fm.data[key]
+	fm.mu.Unlock()
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return fm.deserializer(val)
+}
+
+// Delete removes an object from the map
+func (fm *FileMap) Delete(key string) error {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	if _, ok := fm.data[key]; !ok {
+		return nil
+	}
+	delete(fm.data, key)
+	return fm.changed()
+}
+
+// GetAll returns all objects in the map
+func (fm *FileMap) GetAll() (map[string]interface{}, error) {
+	fm.mu.Lock()
+	snapshot := make(map[string]string, len(fm.data))
+	for key, val := range fm.data {
+		snapshot[key] = val
+	}
+	fm.mu.Unlock()
+
+	returnVals := make(map[string]interface{}, len(snapshot))
+	for key, val := range snapshot {
+		obj, err := fm.deserializer(val)
+		if err != nil {
+			glog.Errorf("Unable to parse key %s because: %s", key, err.Error())
+		} else {
+			returnVals[key] = obj
+		}
+	}
+	return returnVals, nil
+}
+
+// DeleteAll removes all objects from the map
+func (fm *FileMap) DeleteAll() error {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	fm.data = map[string]string{}
+	return fm.changed()
+}
+
+// Flush writes the map into its file if it was modified since the last write
+func (fm *FileMap) Flush() error {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	if !fm.dirty {
+		return nil
+	}
+	return fm.write()
+}
+
+// Close stops periodic flushing and writes out all pending modifications
+func (fm *FileMap) Close() error {
+	fm.closeOnce.Do(func() { close(fm.done) })
+	return fm.Flush()
+}
+
+func (fm *FileMap) flushLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-fm.done:
+			return
+		case <-ticker.C:
+			if err := fm.Flush(); err != nil {
+				glog.Errorf("failed to flush object store file %s: %v", fm.path, err)
+			}
+		}
+	}
+}
+
+// changed marks the map as modified and writes it out right away when there
+// is no periodic flush, must be called with fm.mu held
+func (fm *FileMap) changed() error {
+	fm.dirty = true
+	if fm.flushInterval > 0 {
+		return nil
+	}
+	return fm.write()
+}
+
+// write atomically replaces the map's file with the current content,
+// must be called with fm.mu held
+func (fm *FileMap) write() error {
+	content, err := json.Marshal(fm.data)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(fm.path)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(fm.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), fm.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	fm.dirty = false
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_store_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/object_store"
+)
+
+func TestFileMap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "objects.json")
+	fileMap, err := object_store.NewFileMap(path, getSerializer(), getDeserializer(), 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, fileMap.Set("1", &testObject{foo: "first"}))
+	assert.NoError(t, fileMap.Set("2", &testObject{foo: "second"}))
+	assert.NoError(t, fileMap.Set("3", &testObject{foo: "last"}))
+	assert.NoError(t, fileMap.Delete("2"))
+	assert.NoError(t, fileMap.Delete("4"))
+
+	objRaw, err := fileMap.Get("1")
+	assert.NoError(t, err)
+	obj, ok := objRaw.(*testObject)
+	assert.True(t, ok)
+	assert.Equal(t, "first", obj.foo)
+	_, err = fileMap.Get("2")
+	assert.Equal(t, object_store.ErrNotFound, err)
+
+	// Every modification is written through, a new map sees the same content
+	reloaded, err := object_store.NewFileMap(path, getSerializer(), getDeserializer(), 0)
+	assert.NoError(t, err)
+	allVals, err := reloaded.GetAll()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(allVals))
+	assert.Equal(t, "last", allVals["3"].(*testObject).foo)
+
+	assert.NoError(t, reloaded.DeleteAll())
+	reloaded, err = object_store.NewFileMap(path, getSerializer(), getDeserializer(), 0)
+	assert.NoError(t, err)
+	allVals, err = reloaded.GetAll()
+	assert.NoError(t, err)
+	assert.Empty(t, allVals)
+}
+
+func TestFileMapPeriodicFlush(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "objects.json")
+	fileMap, err := object_store.NewFileMap(path, getSerializer(), getDeserializer(), time.Hour)
+	assert.NoError(t, err)
+	assert.NoError(t, fileMap.Set("1", &testObject{foo: "first"}))
+
+	// Nothing is written until the map is flushed
+	reloaded, err := object_store.NewFileMap(path, getSerializer(), getDeserializer(), 0)
+	assert.NoError(t, err)
+	allVals, err := reloaded.GetAll()
+	assert.NoError(t, err)
+	assert.Empty(t, allVals)
+
+	assert.NoError(t, fileMap.Close())
+	reloaded, err = object_store.NewFileMap(path, getSerializer(), getDeserializer(), 0)
+	assert.NoError(t, err)
+	allVals, err = reloaded.GetAll()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(allVals))
+	assert.Equal(t, "first", allVals["1"].(*testObject).foo)
+}
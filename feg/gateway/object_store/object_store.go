@@ -14,9 +14,15 @@ limitations under the License.
 package object_store
 
 import (
+	"errors"
+
+	"github.com/go-redis/redis"
 	"github.com/golang/glog"
 )
 
+// ErrNotFound is returned by ObjectMap Get if there is no object for the key
+var ErrNotFound = errors.New("object not found")
+
 // ObjectMap is an interface for getting objects from an arbitrary data store
 type ObjectMap interface {
 	Set(key string, object interface{}) error
@@ -68,6 +74,9 @@ func (rm *RedisMap) Set(key string, object interface{}) error {
 func (rm *RedisMap) Get(key string) (interface{}, error) {
 	val, err := rm.client.HGet(rm.hash, key)
 	if err != nil {
+		if err == redis.Nil {
+			return nil, ErrNotFound
+		}
 		return nil, err
 	}
 	return rm.deserializer(val)
@@ -29,6 +29,7 @@ const (
 	CONTROL_PROXY    = "CONTROL_PROXY"
 	S6A_PROXY        = "S6A_PROXY"
 	S8_PROXY         = "S8_PROXY"
+	DRA              = "DRA"
 	SESSION_PROXY    = "SESSION_PROXY"
 	SWX_PROXY        = "SWX_PROXY"
 	HLR_PROXY        = "HLR_PROXY"
@@ -41,6 +42,7 @@ const (
 	EAP              = "EAP"
 	EAP_SIM          = "EAP_SIM"
 	EAP_AKA          = "EAP_AKA"
+	EAP_AKA_PRIME    = "EAP_AKA_PRIME"
 	RADIUSD          = "RADIUSD"
 	RADIUS           = "RADIUS"
 	REDIS            = "REDIS"
@@ -96,6 +98,7 @@ func init() {
 	addLocalService(AAA_SERVER, 9109)
 	addLocalService(EAP_SIM, 9118)
 	addLocalService(EAP_AKA, 9123)
+	addLocalService(EAP_AKA_PRIME, 9125)
 	addLocalService(SWX_PROXY, 9110)
 	addLocalService(RADIUSD, 9115)
 	addLocalService(HLR_PROXY, 9116)
@@ -16,6 +16,9 @@ package main
 
 import (
 	"flag"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -46,9 +49,6 @@ var (
 func main() {
 	flag.Parse() // for glog
 
-	// Create a shared Session Table
-	sessions := store.NewMemorySessionTable()
-
 	// Create the EAP AKA Provider service
 	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.AAA_SERVER)
 	if err != nil {
@@ -63,11 +63,26 @@ func main() {
 	aaaConfigs.AcctReportingEnabled = utils.GetBoolValueOrEnv(
 		AccountingReportingEnabledFlag, AccountingReportingEnabledEnv, aaaConfigs.AcctReportingEnabled)
 
+	// Create a shared Session Table
+	sessions, err := store.NewSessionTable(aaaConfigs.GetSessionStore())
+	if err != nil {
+		glog.Errorf("Error creating AAA session store, using in-memory sessions: %v", err)
+		sessions = store.NewMemorySessionTable()
+	}
+
 	acct, _ := servicers.NewAccountingService(sessions, proto.Clone(aaaConfigs).(*mconfig.AAAConfig))
 	protos.RegisterAccountingServer(srv.GrpcServer, acct)
 	lteprotos.RegisterAbortSessionResponderServer(srv.GrpcServer, acct)
 	fegprotos.RegisterSwxGatewayServiceServer(srv.GrpcServer, acct)
 	fegprotos.RegisterS6AGatewayServiceServer(srv.GrpcServer, acct)
+	fegprotos.RegisterDynamicAuthorizationGatewayServiceServer(srv.GrpcServer, acct)
+
+	// Take over sessions persisted by previous AAA server instances
+	if restorer, ok := sessions.(store.Restorer); ok {
+		if _, _, err = restorer.Restore(acct.TimeoutSessionNotifier()); err != nil {
+			glog.Errorf("Error restoring AAA sessions: %v", err)
+		}
+	}
 
 	auth, _ := servicers.NewEapAuthenticator(sessions, aaaConfigs, acct)
 	protos.RegisterAuthenticatorServer(srv.GrpcServer, auth)
@@ -75,9 +90,22 @@ func main() {
 	// Starts built in radius server if built with this option
 	startBuiltInRadius(aaaConfigs, auth, acct)
 
+	// Stop serving on SIGTERM, accounting records are flushed once in-flight requests are done
+	sigtermChannel := make(chan os.Signal, 1)
+	signal.Notify(sigtermChannel, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigtermChannel
+		glog.Info("Received SIGTERM, stopping AAA service")
+		srv.GrpcServer.GracefulStop()
+		srv.ProtectedGrpcServer.GracefulStop()
+	}()
+
 	glog.Infof("Starting AAA Service v%s.", Version)
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error running AAA service: %s", err)
 	}
+	if err = acct.Close(); err != nil {
+		glog.Errorf("Error closing accounting records: %v", err)
+	}
 }
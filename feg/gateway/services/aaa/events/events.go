@@ -68,6 +68,7 @@ const (
 	SessionTimeout          SessionTerminationReason = "session_timeout"
 	AbortSession            SessionTerminationReason = "abort_session"
 	RegistrationTermination SessionTerminationReason = "registration_termination"
+	OperatorDisconnect      SessionTerminationReason = "operator_disconnect"
 )
 
 func LogAuthenticationSuccessEvent(ctx *protos.Context) {
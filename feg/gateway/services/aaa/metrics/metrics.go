@@ -106,12 +106,44 @@ var (
 		},
 		[]string{"apn", "imsi", "msisdn"},
 	)
+
+	// Replicated session store
+	SessionStoreFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "session_store_failures",
+			Help: "Shared session store operation failures, partitioned by operation (set, get, delete, restore)",
+		},
+		[]string{"operation"},
+	)
+	SessionsAdopted = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "sessions_adopted",
+			Help: "Sessions loaded from the shared session store, created by another or previous AAA instance",
+		},
+	)
+
+	// Accounting records
+	AcctRecords = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "accounting_records",
+			Help: "Accounting records written, partitioned by sink (file, cloud) & record type (START, INTERIM, STOP)",
+		},
+		[]string{"sink", "type"},
+	)
+	AcctRecordFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "accounting_record_failures",
+			Help: "Accounting records which failed to be written, partitioned by sink (file, cloud)",
+		},
+		[]string{"sink"},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(Auth, Sessions, SessionStart,
 		SessionStop, CreateSessionLatency, OctetsIn, OctetsOut,
-		SessionTimeouts, AcctStop, SessionTerminate, EndSession)
+		SessionTimeouts, AcctStop, SessionTerminate, EndSession,
+		SessionStoreFailures, SessionsAdopted, AcctRecords, AcctRecordFailures)
 }
 
 const imsiPrefix = "IMSI"
@@ -14,8 +14,31 @@ limitations under the License.
 // package dae implements Radius Dynamic Authorization Extensions API (https://tools.ietf.org/html/rfc5176)
 package dae
 
-import "magma/feg/gateway/services/aaa/protos"
+import (
+	"errors"
+
+	"magma/feg/gateway/services/aaa/protos"
+)
+
+// ErrSessionTimeoutUnsupported is returned by ChangeAuthorization of DAE implementations which cannot
+// send a new Session-Timeout to the NAS
+var ErrSessionTimeoutUnsupported = errors.New("session timeout change is not supported by the DAE")
+
+// Result is the NAS answer to a DAE request
+type Result struct {
+	// Ack is true for Disconnect-ACK & CoA-ACK, false for Disconnect-NAK & CoA-NAK
+	Ack bool
+	// ErrorCause is the Error-Cause attribute of the answer, 0 if not present
+	ErrorCause uint32
+}
 
 type DAE interface {
+	// Disconnect is DAE's Disconnect Messages equivalent, the NAS answer is not verified
 	Disconnect(aaaCtx *protos.Context) error
+	// DisconnectSession sends Disconnect-Request for the session & returns the NAS answer
+	DisconnectSession(aaaCtx *protos.Context) (*Result, error)
+	// ChangeAuthorization sends CoA-Request for the session & returns the NAS answer,
+	// a non zero sessionTimeout is sent as the new Session-Timeout of the session or
+	// ErrSessionTimeoutUnsupported is returned if the implementation cannot send it
+	ChangeAuthorization(aaaCtx *protos.Context, sessionTimeout uint32) (*Result, error)
 }
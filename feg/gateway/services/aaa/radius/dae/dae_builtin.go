@@ -17,11 +17,14 @@ package dae
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/golang/glog"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 	"layeh.com/radius/rfc2866"
+	"layeh.com/radius/rfc3576"
 
 	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/services/aaa/protos"
@@ -47,20 +50,63 @@ func (s daeServerCfg) Disconnect(aaaCtx *protos.Context) error {
 		glog.V(1).Info("empty DAE server address")
 		return nil
 	}
-	rr := radius.Request{
-		Packet: &radius.Packet{
-			Code:       radius.CodeDisconnectRequest,
-			Identifier: 0,
-			Secret:     s.RadiusConfig.GetSecret(),
-		},
-	}
-	rr.Add(rfc2866.AcctSessionID_Type, radius.Attribute(aaaCtx.GetSessionId()))
-	rr.Add(rfc2865.CallingStationID_Type, radius.Attribute(aaaCtx.GetMacAddr()))
-	resp, err := radius.Exchange(context.Background(), rr.Packet, s.RadiusConfig.GetDAEAddr())
-	if err != nil {
-		glog.Errorf("failed radius DAE request to %s: %v", s.RadiusConfig.GetDAEAddr(), err)
-	} else {
+	resp, err := s.exchange(s.newRequest(radius.CodeDisconnectRequest, aaaCtx))
+	if err == nil {
 		glog.V(2).Infof("DAE Disconnect Response Code %s", resp.Code)
 	}
 	return err
 }
+
+// DisconnectSession sends Disconnect-Request for the session & returns the NAS answer
+func (s daeServerCfg) DisconnectSession(aaaCtx *protos.Context) (*Result, error) {
+	if len(s.RadiusConfig.GetDAEAddr()) == 0 {
+		return nil, errors.New("DAE server address is not configured")
+	}
+	resp, err := s.exchange(s.newRequest(radius.CodeDisconnectRequest, aaaCtx))
+	if err != nil {
+		return nil, err
+	}
+	return newResult(resp, radius.CodeDisconnectACK, radius.CodeDisconnectNAK)
+}
+
+// ChangeAuthorization sends CoA-Request for the session & returns the NAS answer
+func (s daeServerCfg) ChangeAuthorization(aaaCtx *protos.Context, sessionTimeout uint32) (*Result, error) {
+	if len(s.RadiusConfig.GetDAEAddr()) == 0 {
+		return nil, errors.New("DAE server address is not configured")
+	}
+	req := s.newRequest(radius.CodeCoARequest, aaaCtx)
+	if sessionTimeout > 0 {
+		rfc2865.SessionTimeout_Set(req, rfc2865.SessionTimeout(sessionTimeout))
+	}
+	resp, err := s.exchange(req)
+	if err != nil {
+		return nil, err
+	}
+	return newResult(resp, radius.CodeCoAACK, radius.CodeCoANAK)
+}
+
+func (s daeServerCfg) newRequest(code radius.Code, aaaCtx *protos.Context) *radius.Packet {
+	p := radius.New(code, s.RadiusConfig.GetSecret())
+	p.Add(rfc2866.AcctSessionID_Type, radius.Attribute(aaaCtx.GetSessionId()))
+	p.Add(rfc2865.CallingStationID_Type, radius.Attribute(aaaCtx.GetMacAddr()))
+	return p
+}
+
+func (s daeServerCfg) exchange(req *radius.Packet) (*radius.Packet, error) {
+	resp, err := radius.Exchange(context.Background(), req, s.RadiusConfig.GetDAEAddr())
+	if err != nil {
+		glog.Errorf("failed radius DAE %s to %s: %v", req.Code, s.RadiusConfig.GetDAEAddr(), err)
+	}
+	return resp, err
+}
+
+func newResult(resp *radius.Packet, ack, nak radius.Code) (*Result, error) {
+	switch resp.Code {
+	case ack:
+		return &Result{Ack: true}, nil
+	case nak:
+		return &Result{ErrorCause: uint32(rfc3576.ErrorCause_Get(resp))}, nil
+	default:
+		return nil, fmt.Errorf("unexpected DAE response code %s, expected %s or %s", resp.Code, ack, nak)
+	}
+}
@@ -39,3 +39,36 @@ func (extDAEServer) Disconnect(aaaCtx *protos.Context) error {
 	}
 	return err
 }
+
+// DisconnectSession sends Disconnect-Request for the session via the radius server & returns the NAS answer
+func (extDAEServer) DisconnectSession(aaaCtx *protos.Context) (*Result, error) {
+	conn, err := registry.GetConnection(registry.RADIUS)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := protos.NewAuthorizationClient(conn).Disconnect(
+		context.Background(), &protos.DisconnectRequest{Ctx: aaaCtx})
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Ack: resp.GetCoaResponseType() == protos.CoaResponse_ACK}, nil
+}
+
+// ChangeAuthorization sends CoA-Request for the session via the radius server & returns the NAS answer,
+// the radius server CoA modules are responsible for the request's authorization attributes, so a new
+// session timeout cannot be passed to the NAS & ErrSessionTimeoutUnsupported is returned for it
+func (extDAEServer) ChangeAuthorization(aaaCtx *protos.Context, sessionTimeout uint32) (*Result, error) {
+	if sessionTimeout != 0 {
+		return nil, ErrSessionTimeoutUnsupported
+	}
+	conn, err := registry.GetConnection(registry.RADIUS)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := protos.NewAuthorizationClient(conn).Change(
+		context.Background(), &protos.ChangeRequest{Ctx: aaaCtx})
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Ack: resp.GetCoaResponseType() == protos.CoaResponse_ACK}, nil
+}
@@ -0,0 +1,29 @@
+// Copyright 2021 The Magma Authors.
+//
+// This source code is licensed under the BSD-style license found in the
+// LICENSE file in the root directory of this source tree.
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//go:build !with_builtin_radius
+// +build !with_builtin_radius
+
+package dae
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/services/aaa/protos"
+)
+
+func TestExternalChangeAuthorizationSessionTimeout(t *testing.T) {
+	res, err := NewDAEServicer(nil).ChangeAuthorization(&protos.Context{Imsi: "123456789012345"}, 3600)
+	assert.Equal(t, ErrSessionTimeoutUnsupported, err)
+	assert.Nil(t, res)
+}
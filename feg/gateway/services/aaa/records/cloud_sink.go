@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/golang/glog"
+
+	fegpb "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/aaa/base_acct"
+	"magma/feg/gateway/services/aaa/metrics"
+)
+
+// DefaultAsyncQueueSize is the default number of records an async sink buffers
+const DefaultAsyncQueueSize = 1024
+
+// BaseAcctClient is the subset of the base_acct client API used to forward records
+type BaseAcctClient interface {
+	Start(*fegpb.AcctSession) (*fegpb.AcctSessionResp, error)
+	Update(*fegpb.AcctUpdateReq) (*fegpb.AcctSessionResp, error)
+	Stop(*fegpb.AcctUpdateReq) (*fegpb.AcctStopResp, error)
+}
+
+type baseAcctClient struct{}
+
+func (baseAcctClient) Start(s *fegpb.AcctSession) (*fegpb.AcctSessionResp, error) {
+	return base_acct.Start(s)
+}
+
+func (baseAcctClient) Update(r *fegpb.AcctUpdateReq) (*fegpb.AcctSessionResp, error) {
+	return base_acct.Update(r)
+}
+
+func (baseAcctClient) Stop(r *fegpb.AcctUpdateReq) (*fegpb.AcctStopResp, error) {
+	return base_acct.Stop(r)
+}
+
+// cloudSink forwards records to the cloud base_acct service
+type cloudSink struct {
+	client BaseAcctClient
+}
+
+// NewCloudSink returns a sink forwarding records to the cloud base_acct service
+func NewCloudSink() Sink {
+	return NewCloudSinkWithClient(baseAcctClient{})
+}
+
+// NewCloudSinkWithClient returns a sink forwarding records to the given base_acct client
+func NewCloudSinkWithClient(client BaseAcctClient) Sink {
+	return &cloudSink{client: client}
+}
+
+func (s *cloudSink) Write(r *Record) error {
+	session := &fegpb.AcctSession{
+		User:       &fegpb.AcctSession_IMSI{IMSI: r.IMSI},
+		SessionId:  r.SessionID,
+		ServingApn: r.APN,
+	}
+	// base_acct volumes are from the network point of view: octets in - sent to the user
+	update := &fegpb.AcctUpdateReq{
+		Session:     session,
+		OctetsIn:    r.OutputOctets,
+		OctetsOut:   r.InputOctets,
+		SessionTime: uint32(durationSec(r.Duration)),
+	}
+	var err error
+	switch r.Type {
+	case Start:
+		_, err = s.client.Start(session)
+	case Interim:
+		_, err = s.client.Update(update)
+	case Stop:
+		_, err = s.client.Stop(update)
+	default:
+		err = errors.New("unknown record type: " + string(r.Type))
+	}
+	return err
+}
+
+func (s *cloudSink) Close() error {
+	return nil
+}
+
+// asyncSink writes records to the wrapped sink from a background routine, records are dropped when its queue
+// is full so a slow sink does not delay the accounting RPCs
+type asyncSink struct {
+	name  string
+	sink  Sink
+	queue chan *Record
+	done  chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewAsyncSink returns a sink writing records to the named sink s asynchronously
+func NewAsyncSink(name string, s Sink, queueSize int) Sink {
+	if queueSize <= 0 {
+		queueSize = DefaultAsyncQueueSize
+	}
+	as := &asyncSink{name: name, sink: s, queue: make(chan *Record, queueSize), done: make(chan struct{})}
+	go as.run()
+	return as
+}
+
+func (s *asyncSink) Write(r *Record) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return errors.New("async record sink is closed")
+	}
+	select {
+	case s.queue <- r:
+		return nil
+	default:
+		return errors.New("async record sink queue is full")
+	}
+}
+
+// Close waits for the queued records to be written & closes the wrapped sink
+func (s *asyncSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return s.sink.Close()
+}
+
+func (s *asyncSink) run() {
+	defer close(s.done)
+	for r := range s.queue {
+		if err := s.sink.Write(r); err != nil {
+			glog.Errorf("failed to write %s accounting record of session %s to %s sink: %v",
+				r.Type, r.SessionID, s.name, err)
+			metrics.AcctRecordFailures.WithLabelValues(s.name).Inc()
+		}
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	DefaultDirectory        = "/var/opt/magma/aaa_records"
+	DefaultMaxFileSize      = 10 * 1024 * 1024
+	DefaultRotationInterval = time.Hour
+
+	filePrefix = "aaa_records"
+	// openSuffix is appended to the name of the file being written, collectors should only pick up rotated files
+	openSuffix = ".open"
+	timeLayout = "20060102T150405"
+)
+
+// FileSinkConfig configures the rotating record files
+type FileSinkConfig struct {
+	Format           string
+	Directory        string        // default: DefaultDirectory
+	MaxFileSize      int64         // rotate the file when it exceeds the size, default: DefaultMaxFileSize
+	RotationInterval time.Duration // rotate the file when it's older than the interval, default: DefaultRotationInterval
+	MaxFiles         int           // number of rotated files to keep, 0 - keep all
+}
+
+// fileSink writes records into rotating files, the current file has the openSuffix which is removed on rotation
+type fileSink struct {
+	cfg       FileSinkConfig
+	formatter formatter
+
+	mu       sync.Mutex
+	file     *os.File
+	path     string
+	size     int64
+	opened   time.Time
+	sequence int
+	done     chan struct{}
+}
+
+// NewFileSink returns a sink writing records in the configured format into rotating files
+func NewFileSink(cfg FileSinkConfig) (Sink, error) {
+	f, err := newFormatter(cfg.Format)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Directory) == 0 {
+		cfg.Directory = DefaultDirectory
+	}
+	if cfg.MaxFileSize <= 0 {
+		cfg.MaxFileSize = DefaultMaxFileSize
+	}
+	if cfg.RotationInterval <= 0 {
+		cfg.RotationInterval = DefaultRotationInterval
+	}
+	if err = os.MkdirAll(cfg.Directory, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create accounting records directory: %v", err)
+	}
+	s := &fileSink{cfg: cfg, formatter: f, done: make(chan struct{})}
+	s.closeStale()
+	go s.rotateExpired()
+	return s, nil
+}
+
+func (s *fileSink) Write(r *Record) error {
+	b, err := s.formatter.format(r)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.done:
+		return errors.New("accounting records file sink is closed")
+	default:
+	}
+	if s.file != nil && s.size+int64(len(b)) > s.cfg.MaxFileSize && s.size > int64(len(s.formatter.header())) {
+		s.rotate()
+	}
+	if s.file == nil {
+		if err = s.open(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(b)
+	s.size += int64(n)
+	return err
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.done:
+		return nil
+	default:
+		close(s.done)
+	}
+	return s.rotate()
+}
+
+// rotateExpired periodically rotates the current file once it's older than the rotation interval
+func (s *fileSink) rotateExpired() {
+	ticker := time.NewTicker(s.checkInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			if s.file != nil && time.Since(s.opened) >= s.cfg.RotationInterval {
+				s.rotate()
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *fileSink) checkInterval() time.Duration {
+	interval := s.cfg.RotationInterval / 10
+	if interval > time.Minute {
+		return time.Minute
+	}
+	if interval < 10*time.Millisecond {
+		return 10 * time.Millisecond
+	}
+	return interval
+}
+
+// open creates a new current file, must be called with the sink lock held
+func (s *fileSink) open() error {
+	var (
+		f    *os.File
+		path string
+		err  error
+	)
+	s.opened = time.Now()
+	// the sequence keeps names of files opened within the same second unique & ordered
+	for retries := 0; retries < 10; retries++ {
+		s.sequence++
+		name := fmt.Sprintf(
+			"%s_%s_%04d.%s", filePrefix, s.opened.UTC().Format(timeLayout), s.sequence, s.formatter.extension())
+		path = filepath.Join(s.cfg.Directory, name) + openSuffix
+		if _, statErr := os.Stat(filepath.Join(s.cfg.Directory, name)); statErr == nil {
+			err = os.ErrExist
+			continue
+		}
+		f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if !os.IsExist(err) {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create accounting records file: %v", err)
+	}
+	s.file, s.path, s.size = f, path, 0
+	if header := s.formatter.header(); len(header) > 0 {
+		n, err := f.Write(header)
+		s.size += int64(n)
+		if err != nil {
+			return fmt.Errorf("failed to write accounting records file header: %v", err)
+		}
+	}
+	return nil
+}
+
+// rotate closes the current file & makes it available to collectors, must be called with the sink lock held
+func (s *fileSink) rotate() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	if renameErr := os.Rename(s.path, strings.TrimSuffix(s.path, openSuffix)); renameErr != nil && err == nil {
+		err = renameErr
+	}
+	if err != nil {
+		glog.Errorf("failed to rotate accounting records file %s: %v", s.path, err)
+	}
+	s.file, s.path, s.size = nil, "", 0
+	s.removeOld()
+	return err
+}
+
+// closeStale rotates files left open by a previous instance
+func (s *fileSink) closeStale() {
+	stale, _ := filepath.Glob(filepath.Join(s.cfg.Directory, filePrefix+"_*"+openSuffix))
+	for _, path := range stale {
+		if err := os.Rename(path, strings.TrimSuffix(path, openSuffix)); err != nil {
+			glog.Errorf("failed to close stale accounting records file %s: %v", path, err)
+		}
+	}
+	s.removeOld()
+}
+
+// removeOld removes the oldest rotated files exceeding MaxFiles
+func (s *fileSink) removeOld() {
+	if s.cfg.MaxFiles <= 0 {
+		return
+	}
+	files, err := s.rotatedFiles()
+	if err != nil {
+		glog.Errorf("failed to list accounting records files: %v", err)
+		return
+	}
+	for len(files) > s.cfg.MaxFiles {
+		if err = os.Remove(files[0]); err != nil {
+			glog.Errorf("failed to remove accounting records file %s: %v", files[0], err)
+		}
+		files = files[1:]
+	}
+}
+
+// rotatedFiles returns rotated files of the sink's format, oldest first
+func (s *fileSink) rotatedFiles() ([]string, error) {
+	entries, err := ioutil.ReadDir(s.cfg.Directory)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	suffix := "." + s.formatter.extension()
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), filePrefix+"_") && strings.HasSuffix(e.Name(), suffix) {
+			files = append(files, filepath.Join(s.cfg.Directory, e.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// formatter serializes records into one of the supported file formats
+type formatter interface {
+	// header returns the data to be written at the beginning of every record file, nil if none
+	header() []byte
+	format(r *Record) ([]byte, error)
+	extension() string
+}
+
+func newFormatter(format string) (formatter, error) {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return csvFormatter{}, nil
+	case FormatJSON:
+		return jsonFormatter{}, nil
+	case Format3GPP:
+		return tgppFormatter{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported accounting record format '%s', expected: %s, %s or %s",
+			format, FormatCSV, FormatJSON, Format3GPP)
+	}
+}
+
+var csvColumns = []string{
+	"record_type", "sequence", "time", "session_id", "acct_session_id", "imsi", "msisdn", "mac_addr", "ip_addr",
+	"apn", "start_time", "duration_sec", "input_octets", "output_octets", "input_packets", "output_packets",
+	"terminate_cause",
+}
+
+// csvFormatter writes a record per line with the csvColumns header line
+type csvFormatter struct{}
+
+func (csvFormatter) header() []byte {
+	b, _ := csvLine(csvColumns)
+	return b
+}
+
+func (csvFormatter) format(r *Record) ([]byte, error) {
+	return csvLine([]string{
+		string(r.Type),
+		strconv.FormatUint(uint64(r.Sequence), 10),
+		formatTime(r.Time),
+		r.SessionID,
+		r.AcctSessionID,
+		r.IMSI,
+		r.MSISDN,
+		r.MacAddr,
+		r.IPAddr,
+		r.APN,
+		formatTime(r.StartTime),
+		strconv.FormatInt(durationSec(r.Duration), 10),
+		strconv.FormatUint(r.InputOctets, 10),
+		strconv.FormatUint(r.OutputOctets, 10),
+		strconv.FormatUint(uint64(r.InputPackets), 10),
+		strconv.FormatUint(uint64(r.OutputPackets), 10),
+		r.TerminateCause,
+	})
+}
+
+func (csvFormatter) extension() string { return FormatCSV }
+
+func csvLine(fields []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(fields); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// jsonRecord is the JSON representation of a record
+type jsonRecord struct {
+	RecordType     Type   `json:"record_type"`
+	Sequence       uint32 `json:"sequence"`
+	Time           string `json:"time"`
+	SessionID      string `json:"session_id"`
+	AcctSessionID  string `json:"acct_session_id,omitempty"`
+	IMSI           string `json:"imsi"`
+	MSISDN         string `json:"msisdn,omitempty"`
+	MacAddr        string `json:"mac_addr,omitempty"`
+	IPAddr         string `json:"ip_addr,omitempty"`
+	APN            string `json:"apn,omitempty"`
+	StartTime      string `json:"start_time,omitempty"`
+	DurationSec    int64  `json:"duration_sec"`
+	InputOctets    uint64 `json:"input_octets"`
+	OutputOctets   uint64 `json:"output_octets"`
+	InputPackets   uint32 `json:"input_packets"`
+	OutputPackets  uint32 `json:"output_packets"`
+	TerminateCause string `json:"terminate_cause,omitempty"`
+}
+
+// jsonFormatter writes a JSON object per line
+type jsonFormatter struct{}
+
+func (jsonFormatter) header() []byte { return nil }
+
+func (jsonFormatter) format(r *Record) ([]byte, error) {
+	b, err := json.Marshal(jsonRecord{
+		RecordType:     r.Type,
+		Sequence:       r.Sequence,
+		Time:           formatTime(r.Time),
+		SessionID:      r.SessionID,
+		AcctSessionID:  r.AcctSessionID,
+		IMSI:           r.IMSI,
+		MSISDN:         r.MSISDN,
+		MacAddr:        r.MacAddr,
+		IPAddr:         r.IPAddr,
+		APN:            r.APN,
+		StartTime:      formatTime(r.StartTime),
+		DurationSec:    durationSec(r.Duration),
+		InputOctets:    r.InputOctets,
+		OutputOctets:   r.OutputOctets,
+		InputPackets:   r.InputPackets,
+		OutputPackets:  r.OutputPackets,
+		TerminateCause: r.TerminateCause,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func (jsonFormatter) extension() string { return FormatJSON }
+
+// 3GPP TS 32.298 TimeStamp: YYMMDDhhmmssShhmm
+const tgppTimeLayout = "060102150405-0700"
+
+// 3GPP TS 32.298 CauseForRecClosing values
+const (
+	tgppNormalRelease          = 0
+	tgppAbnormalRelease        = 4
+	tgppTimeLimit              = 17
+	tgppManagementIntervention = 20
+)
+
+// tgppFormatter writes a record per line of semicolon separated name=value pairs named after the
+// 3GPP TS 32.298 PS domain CDR fields
+type tgppFormatter struct{}
+
+func (tgppFormatter) header() []byte { return nil }
+
+func (tgppFormatter) format(r *Record) ([]byte, error) {
+	fields := []string{
+		"recordType=" + tgppRecordType(r),
+		"servedIMSI=" + r.IMSI,
+		"servedMSISDN=" + r.MSISDN,
+		"servedMACAddress=" + r.MacAddr,
+		"servedPDPPDNAddress=" + r.IPAddr,
+		"accessPointNameNI=" + r.APN,
+		"chargingID=" + r.SessionID,
+		"recordSequenceNumber=" + strconv.FormatUint(uint64(r.Sequence), 10),
+		"recordOpeningTime=" + tgppTime(r.StartTime),
+		"timeOfReport=" + tgppTime(r.Time),
+		"duration=" + strconv.FormatInt(durationSec(r.Duration), 10),
+		"datavolumeUplink=" + strconv.FormatUint(r.InputOctets, 10),
+		"datavolumeDownlink=" + strconv.FormatUint(r.OutputOctets, 10),
+		"rATType=WLAN",
+	}
+	if r.Type == Stop {
+		fields = append(fields, "causeForRecClosing="+strconv.Itoa(tgppCauseForRecClosing(r.TerminateCause)))
+	}
+	return []byte(strings.Join(fields, ";") + "\n"), nil
+}
+
+func (tgppFormatter) extension() string { return "cdr" }
+
+func tgppRecordType(r *Record) string {
+	if r.Type == Stop {
+		return "finalRecord"
+	}
+	return "partialRecord"
+}
+
+func tgppCauseForRecClosing(terminateCause string) int {
+	switch terminateCause {
+	case "", "UNDEFINED", "USER_REQUEST", "NAS_REQUEST", "HOST_REQUEST":
+		return tgppNormalRelease
+	case "IDLE_TIMEOUT", "SESSION_TIMEOUT":
+		return tgppTimeLimit
+	case "ADMIN_RESET", "ADMIN_REBOOT":
+		return tgppManagementIntervention
+	default:
+		return tgppAbnormalRelease
+	}
+}
+
+func tgppTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(tgppTimeLayout)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func durationSec(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
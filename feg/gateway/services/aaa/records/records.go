@@ -0,0 +1,266 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package records implements AAA accounting records (CDRs) & their sinks
+package records
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/metrics"
+	"magma/feg/gateway/services/aaa/protos"
+)
+
+// Type of an accounting record, corresponds to the RADIUS Acct-Status-Type
+type Type string
+
+const (
+	Start   Type = "START"
+	Interim Type = "INTERIM"
+	Stop    Type = "STOP"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+	Format3GPP = "3gpp"
+
+	SinkFile  = "file"
+	SinkCloud = "cloud"
+)
+
+// Record is a single accounting record of an AAA session. Volumes are cumulative for the session &
+// reported from the user's point of view: input - uplink, received from the user, output - downlink
+type Record struct {
+	Type           Type
+	Sequence       uint32    // record sequence number within the session, starts with 0
+	Time           time.Time // record creation time
+	SessionID      string
+	AcctSessionID  string
+	IMSI           string
+	MSISDN         string
+	MacAddr        string
+	IPAddr         string
+	APN            string
+	StartTime      time.Time // session creation time, zero if unknown
+	Duration       time.Duration
+	InputOctets    uint64
+	OutputOctets   uint64
+	InputPackets   uint32
+	OutputPackets  uint32
+	TerminateCause string // RADIUS Acct-Terminate-Cause of Stop records
+}
+
+// Sink is a destination of accounting records
+type Sink interface {
+	Write(r *Record) error
+	Close() error
+}
+
+// usage is the last known state of a session's records
+type usage struct {
+	sequence                    uint32
+	inputOctets, outputOctets   uint64
+	inputPackets, outputPackets uint32
+}
+
+// Recorder creates Start, Interim & Stop records of AAA sessions & writes them to its sinks.
+// All methods of a nil Recorder are no-ops
+type Recorder struct {
+	sinks map[string]Sink
+
+	mu    sync.Mutex
+	usage map[string]*usage // keyed by session ID
+}
+
+// NewRecorder returns a recorder for the AAA accounting records configuration or nil if records are disabled
+func NewRecorder(cfg *mconfig.AAAConfig) (*Recorder, error) {
+	recordsCfg := cfg.GetAcctRecords()
+	sinks := map[string]Sink{}
+	if len(recordsCfg.GetFormat()) > 0 {
+		fs, err := NewFileSink(FileSinkConfig{
+			Format:           recordsCfg.GetFormat(),
+			Directory:        recordsCfg.GetDirectory(),
+			MaxFileSize:      int64(recordsCfg.GetMaxFileSizeKb()) * 1024,
+			RotationInterval: time.Duration(recordsCfg.GetRotationIntervalSec()) * time.Second,
+			MaxFiles:         int(recordsCfg.GetMaxFiles()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		sinks[SinkFile] = fs
+	}
+	if recordsCfg.GetForwardToCloud() {
+		if cfg.GetAcctReportingEnabled() {
+			// sessions are already reported to base_acct by the accounting service
+			glog.Warning("AAA accounting records are not forwarded to the cloud, accounting reporting is enabled")
+		} else {
+			sinks[SinkCloud] = NewAsyncSink(SinkCloud, NewCloudSink(), DefaultAsyncQueueSize)
+		}
+	}
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return NewRecorderWithSinks(sinks), nil
+}
+
+// NewRecorderWithSinks returns a recorder writing to the given named sinks
+func NewRecorderWithSinks(sinks map[string]Sink) *Recorder {
+	return &Recorder{sinks: sinks, usage: map[string]*usage{}}
+}
+
+// Start records the start of the session
+func (r *Recorder) Start(ctx *protos.Context) {
+	if r == nil || ctx == nil {
+		return
+	}
+	r.mu.Lock()
+	r.usage[ctx.GetSessionId()] = &usage{}
+	r.mu.Unlock()
+	r.write(newRecord(Start, 0, ctx))
+}
+
+// Interim records the session's usage reported by an Accounting Interim-Update
+func (r *Recorder) Interim(ctx *protos.Context, req *protos.UpdateRequest) {
+	if r == nil || ctx == nil {
+		return
+	}
+	u := r.update(ctx.GetSessionId(), false, func(u *usage) {
+		u.inputOctets = octets(req.GetGigawordsIn(), req.GetOctetsIn())
+		u.outputOctets = octets(req.GetGigawordsOut(), req.GetOctetsOut())
+		u.inputPackets = req.GetPacketsIn()
+		u.outputPackets = req.GetPacketsOut()
+	})
+	rec := newRecord(Interim, u.sequence, ctx)
+	u.fill(rec)
+	r.write(rec)
+}
+
+// Stop records the end of the session reported by an Accounting Stop
+func (r *Recorder) Stop(ctx *protos.Context, req *protos.StopRequest) {
+	if r == nil || ctx == nil {
+		return
+	}
+	u := r.update(ctx.GetSessionId(), true, func(u *usage) {
+		u.inputOctets = octets(req.GetGigawordsIn(), req.GetOctetsIn())
+		u.outputOctets = octets(req.GetGigawordsOut(), req.GetOctetsOut())
+	})
+	rec := newRecord(Stop, u.sequence, ctx)
+	u.fill(rec)
+	rec.TerminateCause = req.GetCause().String()
+	r.write(rec)
+}
+
+// Terminate records the end of a session which was ended without an Accounting Stop (timed out or terminated by
+// the network), volumes of the record are the last reported by the session's Interim-Updates
+func (r *Recorder) Terminate(ctx *protos.Context, cause protos.StopRequestTerminateCause) {
+	if r == nil || ctx == nil {
+		return
+	}
+	u := r.update(ctx.GetSessionId(), true, nil)
+	rec := newRecord(Stop, u.sequence, ctx)
+	u.fill(rec)
+	rec.TerminateCause = cause.String()
+	r.write(rec)
+}
+
+// Close closes all recorder's sinks
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	var errs []string
+	for name, s := range r.sinks {
+		if err := s.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("accounting record sinks close errors: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// update increments the session's record sequence, applies the reported usage & returns a copy of the result,
+// the session's usage is removed if it's the last record of the session
+func (r *Recorder) update(sid string, last bool, apply func(u *usage)) usage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.usage[sid]
+	if !ok {
+		// session started before the recorder (AAA server restart or session take over)
+		u = &usage{}
+		r.usage[sid] = u
+	}
+	u.sequence++
+	if apply != nil {
+		apply(u)
+	}
+	if last {
+		delete(r.usage, sid)
+	}
+	return *u
+}
+
+func (r *Recorder) write(rec *Record) {
+	for name, s := range r.sinks {
+		if err := s.Write(rec); err != nil {
+			glog.Errorf("failed to write %s accounting record of session %s to %s sink: %v",
+				rec.Type, rec.SessionID, name, err)
+			metrics.AcctRecordFailures.WithLabelValues(name).Inc()
+			continue
+		}
+		metrics.AcctRecords.WithLabelValues(name, string(rec.Type)).Inc()
+	}
+}
+
+func (u usage) fill(rec *Record) {
+	rec.InputOctets = u.inputOctets
+	rec.OutputOctets = u.outputOctets
+	rec.InputPackets = u.inputPackets
+	rec.OutputPackets = u.outputPackets
+}
+
+func newRecord(typ Type, sequence uint32, ctx *protos.Context) *Record {
+	now := time.Now()
+	rec := &Record{
+		Type:          typ,
+		Sequence:      sequence,
+		Time:          now,
+		SessionID:     ctx.GetSessionId(),
+		AcctSessionID: ctx.GetAcctSessionId(),
+		IMSI:          ctx.GetImsi(),
+		MSISDN:        ctx.GetMsisdn(),
+		MacAddr:       ctx.GetMacAddr(),
+		IPAddr:        ctx.GetIpAddr(),
+		APN:           ctx.GetApn(),
+	}
+	if created := ctx.GetCreatedTimeMs(); created > 0 {
+		rec.StartTime = time.Unix(0, int64(created)*int64(time.Millisecond))
+		if rec.Duration = now.Sub(rec.StartTime); rec.Duration < 0 {
+			rec.Duration = 0
+		}
+	}
+	return rec
+}
+
+// octets combines RADIUS Acct-Input/Output-Gigawords & Octets, see: https://datatracker.ietf.org/doc/html/rfc2869#section-5.1
+func octets(gigawords, octets uint32) uint64 {
+	return (uint64(gigawords) << 32) + uint64(octets)
+}
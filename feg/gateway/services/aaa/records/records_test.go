@@ -0,0 +1,344 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fegpb "magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/protos"
+)
+
+const (
+	testIMSI = "123456789012345"
+	testSID  = "sessionid0001"
+)
+
+type memorySink struct {
+	mu      sync.Mutex
+	records []*Record
+	err     error
+}
+
+func (s *memorySink) Write(r *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *memorySink) Close() error { return nil }
+
+func (s *memorySink) get() []*Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Record{}, s.records...)
+}
+
+func testContext() *protos.Context {
+	return &protos.Context{
+		SessionId:     testSID,
+		Imsi:          testIMSI,
+		Msisdn:        "5100001234",
+		Apn:           "magma.ipv4",
+		MacAddr:       "0A:00:27:00:00:03",
+		IpAddr:        "192.168.128.12",
+		AcctSessionId: "IMSI123456789012345-1234",
+		CreatedTimeMs: uint64(time.Now().Add(-time.Minute).UnixNano() / int64(time.Millisecond)),
+	}
+}
+
+func testRecord(typ Type) *Record {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	return &Record{
+		Type:           typ,
+		Sequence:       2,
+		Time:           start.Add(90 * time.Second),
+		SessionID:      testSID,
+		IMSI:           testIMSI,
+		MSISDN:         "5100001234",
+		MacAddr:        "0A:00:27:00:00:03",
+		APN:            "magma.ipv4",
+		StartTime:      start,
+		Duration:       90 * time.Second,
+		InputOctets:    1 << 33,
+		OutputOctets:   2048,
+		TerminateCause: "IDLE_TIMEOUT",
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	sink := &memorySink{}
+	rec := NewRecorderWithSinks(map[string]Sink{"memory": sink})
+	ctx := testContext()
+
+	rec.Start(ctx)
+	rec.Interim(ctx, &protos.UpdateRequest{OctetsIn: 100, OctetsOut: 200, GigawordsIn: 1, PacketsIn: 3, PacketsOut: 4})
+	rec.Stop(ctx, &protos.StopRequest{Cause: protos.StopRequest_USER_REQUEST, OctetsIn: 150, OctetsOut: 300})
+
+	records := sink.get()
+	require.Len(t, records, 3)
+	assert.Equal(t, []Type{Start, Interim, Stop}, []Type{records[0].Type, records[1].Type, records[2].Type})
+	for i, r := range records {
+		assert.Equal(t, uint32(i), r.Sequence)
+		assert.Equal(t, testIMSI, r.IMSI)
+		assert.Equal(t, "0A:00:27:00:00:03", r.MacAddr)
+		assert.Equal(t, "magma.ipv4", r.APN)
+		assert.True(t, r.Duration >= time.Minute)
+	}
+	assert.Equal(t, uint64(1<<32+100), records[1].InputOctets)
+	assert.Equal(t, uint64(200), records[1].OutputOctets)
+	assert.Equal(t, uint64(150), records[2].InputOctets)
+	assert.Equal(t, uint64(300), records[2].OutputOctets)
+	// Stop carries no packet counters, the last reported are kept
+	assert.Equal(t, uint32(3), records[2].InputPackets)
+	assert.Equal(t, "USER_REQUEST", records[2].TerminateCause)
+	assert.Empty(t, rec.usage)
+
+	// Timed out session reports the last Interim-Update volumes
+	rec.Start(ctx)
+	rec.Interim(ctx, &protos.UpdateRequest{OctetsIn: 10, OctetsOut: 20})
+	rec.Terminate(ctx, protos.StopRequest_IDLE_TIMEOUT)
+	records = sink.get()
+	require.Len(t, records, 6)
+	assert.Equal(t, Stop, records[5].Type)
+	assert.Equal(t, uint32(2), records[5].Sequence)
+	assert.Equal(t, uint64(10), records[5].InputOctets)
+	assert.Equal(t, uint64(20), records[5].OutputOctets)
+	assert.Equal(t, "IDLE_TIMEOUT", records[5].TerminateCause)
+	assert.Empty(t, rec.usage)
+
+	// Failing sinks don't affect the others
+	failing := &memorySink{err: errors.New("failed")}
+	rec = NewRecorderWithSinks(map[string]Sink{"failing": failing, "memory": sink})
+	rec.Start(ctx)
+	assert.Len(t, sink.get(), 7)
+
+	// nil recorder is a no-op
+	var disabled *Recorder
+	disabled.Start(ctx)
+	disabled.Stop(ctx, &protos.StopRequest{})
+	assert.NoError(t, disabled.Close())
+}
+
+func TestNewRecorder(t *testing.T) {
+	rec, err := NewRecorder(&mconfig.AAAConfig{})
+	assert.NoError(t, err)
+	assert.Nil(t, rec)
+
+	_, err = NewRecorder(&mconfig.AAAConfig{AcctRecords: &mconfig.AAAAcctRecords{Format: "xml"}})
+	assert.Error(t, err)
+
+	rec, err = NewRecorder(&mconfig.AAAConfig{
+		AcctReportingEnabled: true,
+		AcctRecords:          &mconfig.AAAAcctRecords{Format: FormatJSON, Directory: t.TempDir(), ForwardToCloud: true},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rec)
+	assert.Contains(t, rec.sinks, SinkFile)
+	assert.NotContains(t, rec.sinks, SinkCloud)
+	assert.NoError(t, rec.Close())
+}
+
+func TestFormats(t *testing.T) {
+	r := testRecord(Stop)
+
+	// CSV
+	f, err := newFormatter("CSV")
+	require.NoError(t, err)
+	b, err := f.format(r)
+	require.NoError(t, err)
+	lines, err := csv.NewReader(strings.NewReader(string(f.header()) + string(b))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, csvColumns, lines[0])
+	assert.Equal(t, []string{
+		"STOP", "2", "2021-06-01T10:01:30Z", testSID, "", testIMSI, "5100001234", "0A:00:27:00:00:03", "",
+		"magma.ipv4", "2021-06-01T10:00:00Z", "90", "8589934592", "2048", "0", "0", "IDLE_TIMEOUT",
+	}, lines[1])
+
+	// JSON
+	f, err = newFormatter(FormatJSON)
+	require.NoError(t, err)
+	assert.Nil(t, f.header())
+	b, err = f.format(r)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(string(b), "}\n"))
+	var jr jsonRecord
+	require.NoError(t, json.Unmarshal(b, &jr))
+	assert.Equal(t, Stop, jr.RecordType)
+	assert.Equal(t, testIMSI, jr.IMSI)
+	assert.Equal(t, int64(90), jr.DurationSec)
+	assert.Equal(t, uint64(1<<33), jr.InputOctets)
+	assert.Empty(t, jr.IPAddr)
+
+	// 3GPP
+	f, err = newFormatter(Format3GPP)
+	require.NoError(t, err)
+	b, err = f.format(r)
+	require.NoError(t, err)
+	assert.Equal(t, "recordType=finalRecord;servedIMSI=123456789012345;servedMSISDN=5100001234;"+
+		"servedMACAddress=0A:00:27:00:00:03;servedPDPPDNAddress=;accessPointNameNI=magma.ipv4;"+
+		"chargingID=sessionid0001;recordSequenceNumber=2;recordOpeningTime=210601100000+0000;"+
+		"timeOfReport=210601100130+0000;duration=90;datavolumeUplink=8589934592;datavolumeDownlink=2048;"+
+		"rATType=WLAN;causeForRecClosing=17\n", string(b))
+	b, err = f.format(testRecord(Interim))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(b), "recordType=partialRecord;"))
+	assert.NotContains(t, string(b), "causeForRecClosing")
+
+	_, err = newFormatter("asn1")
+	assert.Error(t, err)
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	dir := t.TempDir()
+	line, _ := csvFormatter{}.format(testRecord(Interim))
+	header := csvFormatter{}.header()
+	s, err := NewFileSink(FileSinkConfig{
+		Format:      FormatCSV,
+		Directory:   dir,
+		MaxFileSize: int64(len(header) + 2*len(line)),
+		MaxFiles:    2,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 7; i++ {
+		require.NoError(t, s.Write(testRecord(Interim)))
+	}
+	// 3 full files were rotated, the oldest one was removed
+	rotated, open := listFiles(t, dir)
+	assert.Len(t, rotated, 2)
+	require.Len(t, open, 1)
+	for _, name := range rotated {
+		assert.Equal(t, 3, countLines(t, filepath.Join(dir, name)))
+		// records contain subscriber identities & must not be readable by other users
+		info, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+	assert.Equal(t, 2, countLines(t, filepath.Join(dir, open[0])))
+
+	require.NoError(t, s.Close())
+	rotated, open = listFiles(t, dir)
+	assert.Len(t, rotated, 2)
+	assert.Empty(t, open)
+	assert.Error(t, s.Write(testRecord(Interim)))
+}
+
+func TestFileSinkRotationInterval(t *testing.T) {
+	dir := t.TempDir()
+	// a file left open by a previous instance
+	stale := filepath.Join(dir, "aaa_records_20210601T100000_0001.json"+openSuffix)
+	require.NoError(t, ioutil.WriteFile(stale, []byte("{}\n"), 0644))
+
+	s, err := NewFileSink(FileSinkConfig{Format: FormatJSON, Directory: dir, RotationInterval: 50 * time.Millisecond})
+	require.NoError(t, err)
+	defer s.Close()
+	rotated, open := listFiles(t, dir)
+	assert.Equal(t, []string{"aaa_records_20210601T100000_0001.json"}, rotated)
+	assert.Empty(t, open)
+
+	require.NoError(t, s.Write(testRecord(Start)))
+	_, open = listFiles(t, dir)
+	assert.Len(t, open, 1)
+	assert.Eventually(t, func() bool {
+		rotated, open := listFiles(t, dir)
+		return len(rotated) == 2 && len(open) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+type fakeBaseAcct struct {
+	mu      sync.Mutex
+	starts  []*fegpb.AcctSession
+	updates []*fegpb.AcctUpdateReq
+	stops   []*fegpb.AcctUpdateReq
+}
+
+func (c *fakeBaseAcct) Start(s *fegpb.AcctSession) (*fegpb.AcctSessionResp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.starts = append(c.starts, s)
+	return &fegpb.AcctSessionResp{}, nil
+}
+
+func (c *fakeBaseAcct) Update(r *fegpb.AcctUpdateReq) (*fegpb.AcctSessionResp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.updates = append(c.updates, r)
+	return &fegpb.AcctSessionResp{}, nil
+}
+
+func (c *fakeBaseAcct) Stop(r *fegpb.AcctUpdateReq) (*fegpb.AcctStopResp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stops = append(c.stops, r)
+	return &fegpb.AcctStopResp{}, nil
+}
+
+func TestCloudSink(t *testing.T) {
+	client := &fakeBaseAcct{}
+	s := NewAsyncSink(SinkCloud, NewCloudSinkWithClient(client), 10)
+	for _, typ := range []Type{Start, Interim, Interim, Stop} {
+		require.NoError(t, s.Write(testRecord(typ)))
+	}
+	// Close flushes the queued records
+	require.NoError(t, s.Close())
+	assert.Error(t, s.Write(testRecord(Stop)))
+
+	require.Len(t, client.starts, 1)
+	assert.Equal(t, testIMSI, client.starts[0].GetIMSI())
+	assert.Equal(t, "magma.ipv4", client.starts[0].GetServingApn())
+	assert.Len(t, client.updates, 2)
+	require.Len(t, client.stops, 1)
+	// base_acct volumes are reported from the network point of view
+	assert.Equal(t, uint64(2048), client.stops[0].GetOctetsIn())
+	assert.Equal(t, uint64(1<<33), client.stops[0].GetOctetsOut())
+	assert.Equal(t, uint32(90), client.stops[0].GetSessionTime())
+}
+
+func listFiles(t *testing.T, dir string) (rotated, open []string) {
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), openSuffix) {
+			open = append(open, e.Name())
+		} else {
+			rotated = append(rotated, e.Name())
+		}
+	}
+	return rotated, open
+}
+
+func countLines(t *testing.T, path string) int {
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.Count(string(b), "\n")
+}
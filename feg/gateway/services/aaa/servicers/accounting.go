@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -34,6 +35,7 @@ import (
 	"magma/feg/gateway/services/aaa/pipelined"
 	"magma/feg/gateway/services/aaa/protos"
 	"magma/feg/gateway/services/aaa/radius/dae"
+	"magma/feg/gateway/services/aaa/records"
 	"magma/feg/gateway/services/aaa/session_manager"
 	"magma/gateway/directoryd"
 	lte_protos "magma/lte/cloud/go/protos"
@@ -45,6 +47,7 @@ type accountingService struct {
 	config      *mconfig.AAAConfig
 	sessionTout time.Duration // Idle Session Timeout
 	dae         dae.DAE
+	records     *records.Recorder // Accounting records (CDRs), nil if disabled
 }
 
 const (
@@ -62,6 +65,12 @@ func NewAccountingService(sessions aaa.SessionTable, cfg *mconfig.AAAConfig) (*a
 	if cfg != nil && cfg.GetAcctReportingEnabled() {
 		base_acct.StartBaseAccountingHeartbeat()
 	}
+	rec, err := records.NewRecorder(cfg)
+	if err != nil {
+		glog.Errorf("Error creating accounting records recorder, records are disabled: %v", err)
+	} else {
+		srv.records = rec
+	}
 	return srv, nil
 }
 
@@ -90,6 +99,9 @@ func (srv *accountingService) Start(ctx context.Context, aaaCtx *protos.Context)
 	} else {
 		srv.sessions.SetTimeout(sid, srv.sessionTout, srv.timeoutSessionNotifier)
 	}
+	if err == nil {
+		srv.records.Start(sessionCtx(s))
+	}
 	return &protos.AcctResp{}, err
 }
 
@@ -114,6 +126,7 @@ func (srv *accountingService) InterimUpdate(_ context.Context, ur *protos.Update
 	octetsOut := ur.GetOctetsOut()
 	metrics.OctetsIn.WithLabelValues(apn, imsi, msisdn).Add(float64(octetsIn))
 	metrics.OctetsOut.WithLabelValues(apn, imsi, msisdn).Add(float64(octetsOut))
+	srv.records.Interim(sessionCtx(s), ur)
 
 	if srv.config.GetAcctReportingEnabled() {
 		_, err = base_acct.Update(&fegpb.AcctUpdateReq{
@@ -150,6 +163,7 @@ func (srv *accountingService) Stop(_ context.Context, req *protos.StopRequest) (
 	}
 
 	s.Lock()
+	recordCtx := proto.Clone(s.GetCtx()).(*protos.Context)
 	sessionImsi := s.GetCtx().GetImsi()
 	apn := s.GetCtx().GetApn()
 	msisdn := s.GetCtx().GetMsisdn()
@@ -178,6 +192,7 @@ func (srv *accountingService) Stop(_ context.Context, req *protos.StopRequest) (
 		directoryd.DeleteRecord(deleteRequest)
 	}
 	metrics.AcctStop.WithLabelValues(apn, imsi, msisdn).Inc()
+	srv.records.Stop(recordCtx, req)
 
 	if err != nil && srv.config.GetEventLoggingEnabled() {
 		events.LogSessionTerminationFailedEvent(req.GetCtx(), events.AccountingStop, err.Error())
@@ -252,6 +267,7 @@ func (srv *accountingService) TerminateSession(
 	s.Unlock()
 
 	metrics.SessionTerminate.WithLabelValues(apn, metrics.DecorateIMSI(imsi), msisdn).Inc()
+	srv.records.Terminate(sctx, protos.StopRequest_ADMIN_RESET)
 
 	if !strings.HasPrefix(imsi, imsiPrefix) {
 		imsi = imsiPrefix + imsi
@@ -279,6 +295,7 @@ func (srv *accountingService) EndTimedOutSession(aaaCtx *protos.Context) error {
 	}
 	var err, radErr error
 	glog.V(1).Infof("AAA session timeout for SID: %s, IMSI: %s", aaaCtx.SessionId, aaaCtx.Imsi)
+	srv.records.Terminate(aaaCtx, protos.StopRequest_IDLE_TIMEOUT)
 	if srv.config.GetAccountingEnabled() {
 		req := &lte_protos.LocalEndSessionRequest{
 			Sid: makeSID(aaaCtx.GetImsi()),
@@ -407,6 +424,19 @@ func activateSessionOnSessionManager(subscriberId *lte_protos.SubscriberID) (*lt
 	return session_manager.UpdateTunnelIds(req)
 }
 
+// Close flushes & closes the service's accounting record sinks, it should be called on shutdown
+func (srv *accountingService) Close() error {
+	if srv == nil {
+		return nil
+	}
+	return srv.records.Close()
+}
+
+// TimeoutSessionNotifier returns the notifier used by the service to end timed out sessions
+func (srv *accountingService) TimeoutSessionNotifier() aaa.TimeoutNotifier {
+	return srv.timeoutSessionNotifier
+}
+
 func (srv *accountingService) timeoutSessionNotifier(s aaa.Session) error {
 	if srv != nil && s != nil {
 		return srv.EndTimedOutSession(s.GetCtx())
@@ -414,6 +444,13 @@ func (srv *accountingService) timeoutSessionNotifier(s aaa.Session) error {
 	return nil
 }
 
+// sessionCtx returns a copy of the session's context
+func sessionCtx(s aaa.Session) *protos.Context {
+	s.Lock()
+	defer s.Unlock()
+	return proto.Clone(s.GetCtx()).(*protos.Context)
+}
+
 func makeSID(imsi string) *lte_protos.SubscriberID {
 	if !strings.HasPrefix(imsi, imsiPrefix) {
 		imsi = imsiPrefix + imsi
@@ -15,6 +15,9 @@ package servicers_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -62,6 +65,44 @@ func TestAccountingStartStop(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAccountingRecords(t *testing.T) {
+	mock_pipelined.NewRunningPipelined(t)
+	mock_sessiond.NewRunningSessionManager(t)
+
+	aaaCtx := getAAAcontext(SESSIONID1, IMSI1)
+	sessionTable := createSessionTableWithAuthenticatedUE(t, aaaCtx)
+	aaaConfig := getAAAConfig()
+	aaaConfig.AcctRecords = &mconfig.AAAAcctRecords{Format: "csv", Directory: t.TempDir()}
+	accService, err := servicers.NewAccountingService(sessionTable, aaaConfig)
+	assert.NoError(t, err)
+
+	_, err = accService.Start(context.Background(), aaaCtx)
+	assert.NoError(t, err)
+	_, err = accService.InterimUpdate(context.Background(), &protos.UpdateRequest{
+		OctetsIn: 1000, OctetsOut: 2000, Ctx: aaaCtx})
+	assert.NoError(t, err)
+	_, err = accService.Stop(context.Background(), &protos.StopRequest{
+		Cause: protos.StopRequest_USER_REQUEST, OctetsIn: 1500, OctetsOut: 3000, Ctx: aaaCtx})
+	assert.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(aaaConfig.AcctRecords.Directory, "aaa_records_*.csv.open"))
+	assert.NoError(t, err)
+	if assert.Len(t, files, 1) {
+		b, err := os.ReadFile(files[0])
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+		if assert.Len(t, lines, 4) {
+			assert.True(t, strings.HasPrefix(lines[1], "START,0,"))
+			assert.Contains(t, lines[1], IMSI1)
+			assert.Contains(t, lines[1], "12-34-AB-CD-EF-FF")
+			assert.True(t, strings.HasPrefix(lines[2], "INTERIM,1,"))
+			assert.Contains(t, lines[2], ",1000,2000,")
+			assert.True(t, strings.HasPrefix(lines[3], "STOP,2,"))
+			assert.True(t, strings.HasSuffix(lines[3], ",1500,3000,0,0,USER_REQUEST"))
+		}
+	}
+}
+
 func TestAccountingCreate(t *testing.T) {
 	mockPipelined := mock_pipelined.NewRunningPipelined(t)
 	mock_sessiond.NewRunningSessionManager(t)
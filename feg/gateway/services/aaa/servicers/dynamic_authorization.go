@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/aaa/events"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/aaa/radius/dae"
+)
+
+// Disconnect is a method of DynamicAuthorizationGatewayService, it sends Disconnect-Request for the
+// subscriber's active session to the NAS. The session is not removed here, on Disconnect-ACK the NAS
+// stops the session & sends Accounting-Stop which ends the session the same way as any other Stop
+func (srv *accountingService) Disconnect(
+	_ context.Context, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error) {
+
+	sctx, err := srv.findDynamicAuthorizationSession(req)
+	if err != nil {
+		return &fegprotos.DynamicAuthorizationAnswer{}, err
+	}
+	result, err := srv.dae.DisconnectSession(sctx)
+	if err != nil {
+		if srv.config.GetEventLoggingEnabled() {
+			events.LogSessionTerminationFailedEvent(sctx, events.OperatorDisconnect, err.Error())
+		}
+		return &fegprotos.DynamicAuthorizationAnswer{}, Errorf(
+			codes.Unavailable, "Disconnect-Request failure for IMSI: %s: %v", sctx.GetImsi(), err)
+	}
+	if srv.config.GetEventLoggingEnabled() {
+		if result.Ack {
+			events.LogSessionTerminationSucceededEvent(sctx, events.OperatorDisconnect)
+		} else {
+			events.LogSessionTerminationFailedEvent(sctx, events.OperatorDisconnect, "Disconnect-NAK")
+		}
+	}
+	return newDynamicAuthorizationAnswer(sctx, result), nil
+}
+
+// Reauthorize is a method of DynamicAuthorizationGatewayService, it sends CoA-Request for the
+// subscriber's active session to the NAS
+func (srv *accountingService) Reauthorize(
+	_ context.Context, req *fegprotos.DynamicAuthorizationRequest) (*fegprotos.DynamicAuthorizationAnswer, error) {
+
+	sctx, err := srv.findDynamicAuthorizationSession(req)
+	if err != nil {
+		return &fegprotos.DynamicAuthorizationAnswer{}, err
+	}
+	result, err := srv.dae.ChangeAuthorization(sctx, req.GetSessionTimeout())
+	if err == dae.ErrSessionTimeoutUnsupported {
+		return &fegprotos.DynamicAuthorizationAnswer{}, Errorf(
+			codes.InvalidArgument, "CoA-Request for IMSI: %s: %v", sctx.GetImsi(), err)
+	}
+	if err != nil {
+		return &fegprotos.DynamicAuthorizationAnswer{}, Errorf(
+			codes.Unavailable, "CoA-Request failure for IMSI: %s: %v", sctx.GetImsi(), err)
+	}
+	return newDynamicAuthorizationAnswer(sctx, result), nil
+}
+
+// findDynamicAuthorizationSession returns a copy of the context of the session targeted by the request
+func (srv *accountingService) findDynamicAuthorizationSession(
+	req *fegprotos.DynamicAuthorizationRequest) (*protos.Context, error) {
+
+	if req == nil {
+		return nil, Errorf(codes.InvalidArgument, "Nil Dynamic Authorization Request")
+	}
+	imsi := strings.TrimPrefix(req.GetImsi(), ImsiPrefix)
+	if len(imsi) < MinIMSILen || len(imsi) > MaxIMSILen {
+		return nil, Errorf(codes.InvalidArgument, "Invalid IMSI: %s", req.GetImsi())
+	}
+	sid := srv.sessions.FindSession(imsi)
+	if len(sid) == 0 {
+		return nil, Errorf(codes.NotFound, "Session for IMSI: %s is not found", imsi)
+	}
+	s := srv.sessions.GetSession(sid)
+	if s == nil {
+		return nil, Errorf(codes.NotFound, "Session for RadSID: %s and IMSI: %s is not found", sid, imsi)
+	}
+	s.Lock()
+	sctx := proto.Clone(s.GetCtx()).(*protos.Context)
+	s.Unlock()
+
+	if len(req.GetSessionId()) > 0 &&
+		len(sctx.GetAcctSessionId()) > 0 &&
+		req.GetSessionId() != sctx.GetAcctSessionId() {
+
+		return nil, Errorf(codes.FailedPrecondition,
+			"Accounting Session ID Mismatch for RadSID %s and IMSI: %s. Requested: %s, recorded: %s",
+			sid, imsi, req.GetSessionId(), sctx.GetAcctSessionId())
+	}
+	glog.V(1).Infof("dynamic authorization request for IMSI: %s, RadSID: %s", imsi, sid)
+	return sctx, nil
+}
+
+func newDynamicAuthorizationAnswer(sctx *protos.Context, result *dae.Result) *fegprotos.DynamicAuthorizationAnswer {
+	res := &fegprotos.DynamicAuthorizationAnswer{
+		Result:     fegprotos.DynamicAuthorizationAnswer_NAK,
+		ErrorCause: result.ErrorCause,
+		SessionId:  sctx.GetAcctSessionId(),
+		MacAddr:    sctx.GetMacAddr(),
+	}
+	if result.Ack {
+		res.Result = fegprotos.DynamicAuthorizationAnswer_ACK
+	}
+	return res
+}
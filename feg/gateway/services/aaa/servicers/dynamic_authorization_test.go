@@ -0,0 +1,108 @@
+//go:build with_builtin_radius
+// +build with_builtin_radius
+
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+	"layeh.com/radius/rfc3576"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/servicers"
+)
+
+const daeSecret = "123456"
+
+// startNAS starts a test NAS which ACKs Disconnect-Requests & CoA-Requests with Session-Timeout
+// and NAKs CoA-Requests without it
+func startNAS(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	srv := &radius.PacketServer{
+		SecretSource: radius.StaticSecretSource([]byte(daeSecret)),
+		Handler: radius.HandlerFunc(func(w radius.ResponseWriter, r *radius.Request) {
+			assert.Equal(t, SESSIONID1, rfc2866.AcctSessionID_GetString(r.Packet))
+			switch r.Code {
+			case radius.CodeDisconnectRequest:
+				w.Write(r.Response(radius.CodeDisconnectACK))
+			case radius.CodeCoARequest:
+				if rfc2865.SessionTimeout_Get(r.Packet) > 0 {
+					w.Write(r.Response(radius.CodeCoAACK))
+					return
+				}
+				resp := r.Response(radius.CodeCoANAK)
+				rfc3576.ErrorCause_Set(resp, rfc3576.ErrorCause_Value_UnsupportedService)
+				w.Write(resp)
+			}
+		}),
+	}
+	go srv.Serve(conn)
+	t.Cleanup(func() { srv.Shutdown(context.Background()) })
+	return conn.LocalAddr().String()
+}
+
+func TestDynamicAuthorization(t *testing.T) {
+	aaaCtx := getAAAcontext(SESSIONID1, IMSI1)
+	aaaCtx.AcctSessionId = "acct0001"
+	sessionTable := createSessionTableWithAuthenticatedUE(t, aaaCtx)
+	aaaConfig := getAAAConfig()
+	aaaConfig.RadiusConfig = &mconfig.RadiusConfig{DAEAddr: startNAS(t), Secret: []byte(daeSecret)}
+	accService, err := servicers.NewAccountingService(sessionTable, aaaConfig)
+	assert.NoError(t, err)
+
+	res, err := accService.Disconnect(context.Background(), &fegprotos.DynamicAuthorizationRequest{Imsi: imsiPrefix + IMSI1})
+	assert.NoError(t, err)
+	assert.Equal(t, fegprotos.DynamicAuthorizationAnswer_ACK, res.GetResult())
+	assert.Equal(t, "acct0001", res.GetSessionId())
+	assert.Equal(t, aaaCtx.GetMacAddr(), res.GetMacAddr())
+	// the session is removed by the NAS Accounting-Stop, not by Disconnect
+	assert.NotEmpty(t, sessionTable.FindSession(IMSI1))
+
+	res, err = accService.Reauthorize(context.Background(), &fegprotos.DynamicAuthorizationRequest{Imsi: IMSI1})
+	assert.NoError(t, err)
+	assert.Equal(t, fegprotos.DynamicAuthorizationAnswer_NAK, res.GetResult())
+	assert.Equal(t, uint32(rfc3576.ErrorCause_Value_UnsupportedService), res.GetErrorCause())
+
+	res, err = accService.Reauthorize(
+		context.Background(), &fegprotos.DynamicAuthorizationRequest{Imsi: IMSI1, SessionTimeout: 3600})
+	assert.NoError(t, err)
+	assert.Equal(t, fegprotos.DynamicAuthorizationAnswer_ACK, res.GetResult())
+	assert.Zero(t, res.GetErrorCause())
+
+	_, err = accService.Disconnect(context.Background(), &fegprotos.DynamicAuthorizationRequest{Imsi: "123"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = accService.Disconnect(context.Background(), &fegprotos.DynamicAuthorizationRequest{Imsi: "001010000000001"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = accService.Reauthorize(
+		context.Background(), &fegprotos.DynamicAuthorizationRequest{Imsi: IMSI1, SessionId: "acct9999"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	aaaConfig.RadiusConfig = &mconfig.RadiusConfig{Secret: []byte(daeSecret)}
+	accService, err = servicers.NewAccountingService(sessionTable, aaaConfig)
+	assert.NoError(t, err)
+	_, err = accService.Disconnect(context.Background(), &fegprotos.DynamicAuthorizationRequest{Imsi: IMSI1})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -264,6 +264,54 @@ func (srv *accountingService) Reset(_ context.Context, req *fegprotos.ResetReque
 	return res, nil
 }
 
+// InsertSubscriberData fulfills S6a's IDR, AAA doesn't keep S6a subscription data,
+// so the update is only acknowledged for subscribers with an active session
+func (srv *accountingService) InsertSubscriberData(
+	_ context.Context, req *fegprotos.InsertSubscriberDataRequest) (*fegprotos.InsertSubscriberDataAnswer, error) {
+
+	res := &fegprotos.InsertSubscriberDataAnswer{}
+	if req == nil {
+		return res, Errorf(codes.InvalidArgument, "Nil IDR Request")
+	}
+	imsi := req.GetUserName()
+	if len(imsi) < MinIMSILen {
+		return res, Errorf(codes.InvalidArgument, "Invalid IDR IMSI: %s", imsi)
+	}
+	if len(srv.sessions.FindSession(strings.TrimPrefix(imsi, ImsiPrefix))) == 0 {
+		glog.Errorf("radius session for S6a IDR IMSI: %s is not found", imsi)
+		res.ErrorCode = fegprotos.ErrorCode_USER_UNKNOWN
+		return res, nil
+	}
+	res.ErrorCode = fegprotos.ErrorCode_SUCCESS
+	return res, nil
+}
+
+// DeleteSubscriberData fulfills S6a's DSR, withdrawal of the complete APN configuration
+// profile disconnects UE from AAA, other withdrawals are only acknowledged
+func (srv *accountingService) DeleteSubscriberData(
+	_ context.Context, req *fegprotos.DeleteSubscriberDataRequest) (*fegprotos.DeleteSubscriberDataAnswer, error) {
+
+	res := &fegprotos.DeleteSubscriberDataAnswer{}
+	if req == nil {
+		return res, Errorf(codes.InvalidArgument, "Nil DSR Request")
+	}
+	imsi := req.GetUserName()
+	if len(imsi) < MinIMSILen {
+		return res, Errorf(codes.InvalidArgument, "Invalid DSR IMSI: %s", imsi)
+	}
+	if req.GetCompleteApnConfigurationProfileWithdrawal() {
+		res.ErrorCode = srv.s6aDisconnectUser(imsi)
+		return res, nil
+	}
+	if len(srv.sessions.FindSession(strings.TrimPrefix(imsi, ImsiPrefix))) == 0 {
+		glog.Errorf("radius session for S6a DSR IMSI: %s is not found", imsi)
+		res.ErrorCode = fegprotos.ErrorCode_USER_UNKNOWN
+		return res, nil
+	}
+	res.ErrorCode = fegprotos.ErrorCode_SUCCESS
+	return res, nil
+}
+
 func (srv *accountingService) s6aDisconnectUser(imsi string) fegprotos.ErrorCode {
 	imsi = strings.TrimPrefix(imsi, ImsiPrefix)
 	sid := srv.sessions.FindSession(imsi)
@@ -34,6 +34,7 @@ type memSession struct {
 	*protos.Context
 	imsi            string
 	cleanupTimerCtx unsafe.Pointer // *cleanupTimerCtx
+	expiresAt       int64          // session timeout deadline, Unix nanoseconds
 	mu              sync.Mutex
 }
 
@@ -78,11 +79,32 @@ func (s *memSession) StopTimeout() bool {
 	return false
 }
 
+// ExpiresAt returns the session's timeout deadline
+func (s *memSession) ExpiresAt() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&s.expiresAt))
+}
+
+// timeoutAction is returned by a timeout hook to tell the table how to handle a fired session timeout
+type timeoutAction int
+
+const (
+	// timeoutExpire - remove the session & call its timeout notifier
+	timeoutExpire timeoutAction = iota
+	// timeoutExtend - the session was extended (by another table instance), re-arm its timeout
+	timeoutExtend
+	// timeoutDrop - the session was removed elsewhere, remove it without calling its timeout notifier
+	timeoutDrop
+)
+
+// timeoutHook is called when a session timeout fires, before the session is removed from the table
+type timeoutHook func(sid string, s *memSession) (action timeoutAction, extension time.Duration)
+
 // SessionTable - synchronized map of authenticated sessions
 type memSessionTable struct {
-	sm   map[string]*memSession
-	sids map[string]string // Session IDs by IMSI: SID[IMSI]
-	rwl  sync.RWMutex      // R/W lock synchronizing maps access
+	sm        map[string]*memSession
+	sids      map[string]string // Session IDs by IMSI: SID[IMSI]
+	rwl       sync.RWMutex      // R/W lock synchronizing maps access
+	onTimeout timeoutHook       // optional timeout hook
 }
 
 // NewSessionTable - returns a new initialized session table
@@ -252,6 +274,7 @@ type cleanupTimerCtx struct {
 
 func setTimeoutUnsafe(st *memSessionTable, sid string, tout time.Duration, s *memSession, notifier aaa.TimeoutNotifier) {
 	var ctx = &cleanupTimerCtx{owner: st, sidKey: sid, s: s, notifyRoutine: notifier}
+	atomic.StoreInt64(&s.expiresAt, time.Now().Add(tout).UnixNano())
 	newTimer := time.AfterFunc(tout, func() { cleanupTimer(ctx) })
 	atomic.StorePointer(&ctx.sessionTimerPtr, unsafe.Pointer(newTimer))
 	atomic.StorePointer(&s.cleanupTimerCtx, unsafe.Pointer(ctx))
@@ -261,6 +284,21 @@ func cleanupTimer(ctx *cleanupTimerCtx) {
 	if ctx != nil && ctx.s != nil && ctx.owner != nil {
 		var deleted bool
 
+		action := timeoutExpire
+		if ctx.owner.onTimeout != nil {
+			var extension time.Duration
+			if action, extension = ctx.owner.onTimeout(ctx.sidKey, ctx.s); action == timeoutExtend {
+				ctx.owner.rwl.Lock()
+				if ms, ok := ctx.owner.sm[ctx.sidKey]; ok && ms == ctx.s &&
+					atomic.LoadPointer(&ms.cleanupTimerCtx) == unsafe.Pointer(ctx) {
+					glog.V(1).Infof("session '%s' timeout was extended by %f seconds", ctx.sidKey, extension.Seconds())
+					setTimeoutUnsafe(ctx.owner, ctx.sidKey, extension, ms, ctx.notifyRoutine)
+				}
+				ctx.owner.rwl.Unlock()
+				return
+			}
+		}
+
 		ctx.owner.rwl.Lock()
 		if ctx.owner.sm != nil {
 			if ms, ok := ctx.owner.sm[ctx.sidKey]; ok && ms == ctx.s {
@@ -275,7 +313,11 @@ func cleanupTimer(ctx *cleanupTimerCtx) {
 		}
 		ctx.owner.rwl.Unlock()
 
-		if deleted {
+		if deleted && action == timeoutDrop {
+			s := ctx.s
+			glog.Infof("Dropped session '%s' for IMSI: %s, it was removed by another instance", ctx.sidKey, s.GetImsi())
+			updateSessionMetricsForRemovedSession(s.GetApn(), s.GetImsi(), s.GetSessionId(), s.GetMsisdn())
+		} else if deleted {
 			var notifyResult error
 			s := ctx.s
 			if ctx.notifyRoutine != nil {
@@ -0,0 +1,453 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/object_store"
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/metrics"
+	"magma/feg/gateway/services/aaa/protos"
+)
+
+const (
+	// BackendMemory - sessions are kept in the AAA server process memory
+	BackendMemory = "memory"
+	// BackendRedis - sessions are replicated into Redis & can be taken over by another AAA server instance
+	BackendRedis = "redis"
+	// DefaultRedisHash - Redis hash of the redis session store
+	DefaultRedisHash = "aaa_sessions"
+
+	expiryHashSuffix = "_expiry"
+	imsiHashSuffix   = "_imsi"
+
+	// expiryTolerance - min difference between the shared & local session deadlines to consider the session
+	// extended by another instance
+	expiryTolerance = time.Second
+)
+
+// Restorer is implemented by session tables backed by a persistent store
+type Restorer interface {
+	// Restore loads persisted sessions into the table & expires sessions which timed out while no AAA server
+	// instance owned them, notifier will be called for the expired & restored sessions on timeout
+	Restore(notifier aaa.TimeoutNotifier) (restored, expired int, err error)
+}
+
+// NewSessionTable returns session table for the given store configuration, the in-memory table is used by default
+func NewSessionTable(cfg *mconfig.AAASessionStore) (aaa.SessionTable, error) {
+	switch strings.ToLower(cfg.GetBackend()) {
+	case "", BackendMemory:
+		return NewMemorySessionTable(), nil
+	case BackendRedis:
+		client, err := object_store.NewRedisClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create AAA session store Redis client: %v", err)
+		}
+		hash := cfg.GetRedisHash()
+		if len(hash) == 0 {
+			hash = DefaultRedisHash
+		}
+		return NewReplicatedSessionTable(client, hash), nil
+	default:
+		return nil, fmt.Errorf("unknown AAA session store backend: %s", cfg.GetBackend())
+	}
+}
+
+// replicatedSessionTable is a session table which keeps its sessions in memory & replicates them into a shared
+// Redis store. Sessions missing from memory are looked up in the shared store & adopted with their remaining
+// timeouts, so sessions survive AAA server restarts & fail overs.
+// Session locks are local to an instance, the table is designed for deployments where a session is served by
+// a single AAA server instance at a time (active/standby or sticky load balancing)
+type replicatedSessionTable struct {
+	local    *memSessionTable
+	ctxs     object_store.ObjectMap // Session contexts: CTX[SID]
+	expiries object_store.ObjectMap // Session timeout deadlines: Deadline[SID]
+	sids     object_store.ObjectMap // Session IDs by IMSI: SID[IMSI]
+
+	adoptMu    sync.Mutex
+	notifierMu sync.RWMutex
+	notifier   aaa.TimeoutNotifier // timeout notifier for sessions adopted from the shared store
+}
+
+// replicatedSession writes its context into the shared store on Unlock
+type replicatedSession struct {
+	*memSession
+	table *replicatedSessionTable
+}
+
+// Unlock - saves the Session's context into the shared store & unlocks the Session's mutex, the context of
+// a session which was removed or replaced while locked is not saved
+func (s *replicatedSession) Unlock() {
+	if s != nil && s.memSession != nil {
+		if pc := s.GetCtx(); pc != nil {
+			s.table.storeActiveCtx(strings.TrimSpace(pc.GetSessionId()), s.memSession, pc)
+		}
+		s.memSession.Unlock()
+	}
+}
+
+// NewReplicatedSessionTable returns a new session table replicated into the Redis hash
+func NewReplicatedSessionTable(client object_store.RedisClient, hash string) aaa.SessionTable {
+	st := &replicatedSessionTable{
+		local:    &memSessionTable{sm: map[string]*memSession{}, sids: map[string]string{}},
+		ctxs:     object_store.NewRedisMap(client, hash, serializeCtx, deserializeCtx),
+		expiries: object_store.NewRedisMap(client, hash+expiryHashSuffix, serializeDeadline, deserializeDeadline),
+		sids:     object_store.NewRedisMap(client, hash+imsiHashSuffix, serializeSid, deserializeSid),
+	}
+	st.local.onTimeout = st.checkTimeout
+	return st
+}
+
+// AddSession - adds a new session to the table & the shared store & returns the newly created session pointer.
+// If a session with the same ID already is in the table or the shared store - returns
+// "Session with SID: XYZ already exist" as well as the existing session.
+func (st *replicatedSessionTable) AddSession(
+	pc *protos.Context, tout time.Duration, notifier aaa.TimeoutNotifier, overwrite ...bool) (aaa.Session, error) {
+
+	if st == nil {
+		return nil, fmt.Errorf("Nil SessionTable")
+	}
+	if pc == nil {
+		return nil, fmt.Errorf("Nil Session Context")
+	}
+	st.setNotifier(notifier)
+	sid := strings.TrimSpace(pc.GetSessionId())
+	if len(sid) > 0 && !(len(overwrite) > 0 && overwrite[0]) {
+		if s := st.GetSession(sid); s != nil {
+			return s, fmt.Errorf("Session with SID: %s already exist", sid)
+		}
+	}
+	snapshot := proto.Clone(pc).(*protos.Context)
+	s, err := st.local.AddSession(pc, tout, notifier, overwrite...)
+	if err != nil {
+		return st.wrap(s), err
+	}
+	ms := s.(*memSession)
+	imsi := pc.GetImsi()
+	if len(imsi) > 0 {
+		if oldSid, err := st.sids.Get(imsi); err == nil && oldSid.(string) != sid {
+			st.deleteStored(oldSid.(string), "")
+		}
+	}
+	st.storeCtx(sid, snapshot)
+	st.storeExpiry(sid, ms.ExpiresAt())
+	if len(imsi) > 0 {
+		if err = st.sids.Set(imsi, sid); err != nil {
+			storeFailure("set", imsi, err)
+		}
+	}
+	return st.wrap(ms), nil
+}
+
+// GetSession returns session corresponding to the given sid or nil if not found in the table & the shared store
+func (st *replicatedSessionTable) GetSession(sid string) aaa.Session {
+	if st == nil {
+		return nil
+	}
+	if s := st.local.GetSession(sid); s != nil {
+		return st.wrap(s)
+	}
+	s, _ := st.adopt(sid)
+	return s
+}
+
+// FindSession returns session ID corresponding to the given IMSI (empty string if not found)
+func (st *replicatedSessionTable) FindSession(imsi string) string {
+	if st == nil {
+		return ""
+	}
+	if sid := st.local.FindSession(imsi); len(sid) > 0 {
+		return sid
+	}
+	if len(imsi) == 0 {
+		return ""
+	}
+	sid, err := st.sids.Get(imsi)
+	if err != nil {
+		if err != object_store.ErrNotFound {
+			storeFailure("get", imsi, err)
+		}
+		return ""
+	}
+	return sid.(string)
+}
+
+// GetSessionByImsi returns session corresponding to the given IMSI or nil if not found
+func (st *replicatedSessionTable) GetSessionByImsi(imsi string) aaa.Session {
+	if st == nil {
+		return nil
+	}
+	if s := st.local.GetSessionByImsi(imsi); s != nil {
+		return st.wrap(s)
+	}
+	if sid := st.FindSession(imsi); len(sid) > 0 {
+		return st.GetSession(sid)
+	}
+	return nil
+}
+
+// RemoveSession - removes the session with the given SID from the table & the shared store and returns it
+func (st *replicatedSessionTable) RemoveSession(sid string) aaa.Session {
+	if st == nil {
+		return nil
+	}
+	if s := st.local.RemoveSession(sid); s != nil {
+		st.deleteStored(sid, s.(*memSession).imsi)
+		return s
+	}
+	pc, _, err := st.load(sid)
+	if err != nil {
+		return nil
+	}
+	st.deleteStored(sid, pc.GetImsi())
+	return &memSession{Context: pc, imsi: pc.GetImsi()}
+}
+
+// SetTimeout - [Re]sets the session's cleanup timeout to fire after tout duration & saves the new deadline
+// into the shared store
+func (st *replicatedSessionTable) SetTimeout(sid string, tout time.Duration, notifier aaa.TimeoutNotifier) bool {
+	if st == nil || tout <= 0 || len(sid) == 0 {
+		return false
+	}
+	st.setNotifier(notifier)
+	if st.local.GetSession(sid) == nil {
+		if s, _ := st.adopt(sid); s == nil {
+			return false
+		}
+	}
+	if !st.local.SetTimeout(sid, tout, notifier) {
+		return false
+	}
+	if s := st.local.GetSession(sid); s != nil {
+		st.storeExpiry(sid, s.(*memSession).ExpiresAt())
+	}
+	return true
+}
+
+// Restore implements Restorer interface
+func (st *replicatedSessionTable) Restore(notifier aaa.TimeoutNotifier) (restored, expired int, err error) {
+	st.setNotifier(notifier)
+	all, err := st.ctxs.GetAll()
+	if err != nil {
+		storeFailure("restore", st.ctxs, err)
+		return 0, 0, err
+	}
+	for sid := range all {
+		if st.local.GetSession(sid) != nil {
+			continue
+		}
+		s, isExpired := st.adopt(sid)
+		if s != nil {
+			restored++
+		} else if isExpired {
+			expired++
+		}
+	}
+	glog.Infof("restored %d AAA sessions, expired %d sessions", restored, expired)
+	return restored, expired, nil
+}
+
+// adopt loads the session from the shared store into the local table,
+// it returns nil session & expired flag set if the session is timed out
+func (st *replicatedSessionTable) adopt(sid string) (s aaa.Session, expired bool) {
+	if len(sid) == 0 {
+		return nil, false
+	}
+	st.adoptMu.Lock()
+	defer st.adoptMu.Unlock()
+	if s := st.local.GetSession(sid); s != nil { // adopted by a concurrent call
+		return st.wrap(s), false
+	}
+	pc, deadline, err := st.load(sid)
+	if err != nil {
+		return nil, false
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		st.deleteStored(sid, pc.GetImsi())
+		glog.Infof("session '%s' for IMSI: %s timed out %s ago while not owned by an instance",
+			sid, pc.GetImsi(), -remaining)
+		if notifier := st.getNotifier(); notifier != nil {
+			go notifier(&memSession{Context: pc, imsi: pc.GetImsi()})
+		}
+		metrics.SessionTimeouts.WithLabelValues(pc.GetApn(), metrics.DecorateIMSI(pc.GetImsi()), pc.GetMsisdn()).Inc()
+		return nil, true
+	}
+	ls, err := st.local.AddSession(pc, remaining, st.getNotifier(), true)
+	if err != nil {
+		glog.Errorf("failed to adopt session '%s': %v", sid, err)
+		return nil, false
+	}
+	metrics.SessionsAdopted.Inc()
+	glog.V(1).Infof("adopted session '%s' for IMSI: %s with remaining timeout: %s", sid, pc.GetImsi(), remaining)
+	return st.wrap(ls), false
+}
+
+// checkTimeout is the local table timeout hook, it extends sessions extended by another instance & drops
+// sessions removed by another instance
+func (st *replicatedSessionTable) checkTimeout(sid string, s *memSession) (timeoutAction, time.Duration) {
+	if _, err := st.ctxs.Get(sid); err == object_store.ErrNotFound {
+		return timeoutDrop, 0
+	} else if err != nil {
+		storeFailure("get", sid, err)
+	}
+	deadline, err := st.expiries.Get(sid)
+	if err == nil {
+		if remaining := time.Until(deadline.(time.Time)); remaining > expiryTolerance {
+			return timeoutExtend, remaining
+		}
+	} else if err != object_store.ErrNotFound {
+		storeFailure("get", sid, err)
+	}
+	st.deleteStored(sid, s.imsi)
+	return timeoutExpire, 0
+}
+
+func (st *replicatedSessionTable) load(sid string) (*protos.Context, time.Time, error) {
+	obj, err := st.ctxs.Get(sid)
+	if err != nil {
+		if err != object_store.ErrNotFound {
+			storeFailure("get", sid, err)
+		}
+		return nil, time.Time{}, err
+	}
+	deadline := time.Now().Add(aaa.DefaultSessionTimeout)
+	if d, err := st.expiries.Get(sid); err == nil {
+		deadline = d.(time.Time)
+	} else if err != object_store.ErrNotFound {
+		storeFailure("get", sid, err)
+	}
+	return obj.(*protos.Context), deadline, nil
+}
+
+func (st *replicatedSessionTable) storeCtx(sid string, pc *protos.Context) {
+	if err := st.ctxs.Set(sid, pc); err != nil {
+		storeFailure("set", sid, err)
+	}
+}
+
+// storeActiveCtx saves the context only if the session is still in the table, the table lock is held while
+// storing so a concurrent RemoveSession cannot delete the stored context before it's written
+func (st *replicatedSessionTable) storeActiveCtx(sid string, ms *memSession, pc *protos.Context) {
+	st.local.rwl.RLock()
+	defer st.local.rwl.RUnlock()
+	if st.local.sm[sid] == ms {
+		st.storeCtx(sid, pc)
+	}
+}
+
+func (st *replicatedSessionTable) storeExpiry(sid string, deadline time.Time) {
+	if err := st.expiries.Set(sid, deadline); err != nil {
+		storeFailure("set", sid, err)
+	}
+}
+
+// deleteStored removes the session from the shared store, the IMSI index is removed only if it points to the sid
+func (st *replicatedSessionTable) deleteStored(sid, imsi string) {
+	if err := st.ctxs.Delete(sid); err != nil {
+		storeFailure("delete", sid, err)
+	}
+	if err := st.expiries.Delete(sid); err != nil {
+		storeFailure("delete", sid, err)
+	}
+	if len(imsi) > 0 {
+		if current, err := st.sids.Get(imsi); err == nil && current.(string) == sid {
+			if err = st.sids.Delete(imsi); err != nil {
+				storeFailure("delete", imsi, err)
+			}
+		}
+	}
+}
+
+func (st *replicatedSessionTable) setNotifier(notifier aaa.TimeoutNotifier) {
+	if notifier != nil {
+		st.notifierMu.Lock()
+		st.notifier = notifier
+		st.notifierMu.Unlock()
+	}
+}
+
+func (st *replicatedSessionTable) getNotifier() aaa.TimeoutNotifier {
+	st.notifierMu.RLock()
+	defer st.notifierMu.RUnlock()
+	return st.notifier
+}
+
+func (st *replicatedSessionTable) wrap(s aaa.Session) aaa.Session {
+	if ms, ok := s.(*memSession); ok && ms != nil {
+		return &replicatedSession{memSession: ms, table: st}
+	}
+	return nil
+}
+
+func storeFailure(op string, key interface{}, err error) {
+	metrics.SessionStoreFailures.WithLabelValues(op).Inc()
+	glog.Errorf("AAA session store %s error for '%v': %v", op, key, err)
+}
+
+func serializeCtx(object interface{}) (string, error) {
+	pc, ok := object.(*protos.Context)
+	if !ok {
+		return "", fmt.Errorf("invalid AAA session context type: %T", object)
+	}
+	b, err := proto.Marshal(pc)
+	return base64.StdEncoding.EncodeToString(b), err
+}
+
+func deserializeCtx(serialized string) (interface{}, error) {
+	b, err := base64.StdEncoding.DecodeString(serialized)
+	if err != nil {
+		return nil, err
+	}
+	pc := &protos.Context{}
+	return pc, proto.Unmarshal(b, pc)
+}
+
+func serializeDeadline(object interface{}) (string, error) {
+	deadline, ok := object.(time.Time)
+	if !ok {
+		return "", fmt.Errorf("invalid AAA session deadline type: %T", object)
+	}
+	return strconv.FormatInt(deadline.UnixNano(), 10), nil
+}
+
+func deserializeDeadline(serialized string) (interface{}, error) {
+	ns, err := strconv.ParseInt(serialized, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return time.Unix(0, ns), nil
+}
+
+func serializeSid(object interface{}) (string, error) {
+	sid, ok := object.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid AAA session ID type: %T", object)
+	}
+	return sid, nil
+}
+
+func deserializeSid(serialized string) (interface{}, error) {
+	return serialized, nil
+}
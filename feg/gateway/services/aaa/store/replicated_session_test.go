@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/aaa/store"
+)
+
+// sharedRedis emulates a Redis instance shared by multiple AAA servers
+type sharedRedis struct {
+	sync.Mutex
+	hashes map[string]map[string]string
+}
+
+func newSharedRedis() *sharedRedis {
+	return &sharedRedis{hashes: map[string]map[string]string{}}
+}
+
+func (r *sharedRedis) HSet(hash string, field string, value string) error {
+	r.Lock()
+	defer r.Unlock()
+	h, ok := r.hashes[hash]
+	if !ok {
+		h = map[string]string{}
+		r.hashes[hash] = h
+	}
+	h[field] = value
+	return nil
+}
+
+func (r *sharedRedis) HGet(hash string, field string) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+	if value, ok := r.hashes[hash][field]; ok {
+		return value, nil
+	}
+	return "", redis.Nil
+}
+
+func (r *sharedRedis) HGetAll(hash string) (map[string]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	res := map[string]string{}
+	for k, v := range r.hashes[hash] {
+		res[k] = v
+	}
+	return res, nil
+}
+
+func (r *sharedRedis) HDel(hash string, field string) error {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.hashes[hash], field)
+	return nil
+}
+
+type timeoutRecorder struct {
+	sync.Mutex
+	sids []string
+}
+
+func (rec *timeoutRecorder) notify(s aaa.Session) error {
+	rec.Lock()
+	defer rec.Unlock()
+	rec.sids = append(rec.sids, s.GetCtx().GetSessionId())
+	return nil
+}
+
+func (rec *timeoutRecorder) get() []string {
+	rec.Lock()
+	defer rec.Unlock()
+	return append([]string{}, rec.sids...)
+}
+
+func TestReplicatedSessionTableFailover(t *testing.T) {
+	rdb := newSharedRedis()
+	primary := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	standby := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	rec := &timeoutRecorder{}
+
+	sid := aaa.CreateSessionId()
+	s, err := primary.AddSession(&protos.Context{SessionId: sid, Imsi: sharedImsi}, time.Minute, rec.notify)
+	assert.NoError(t, err)
+	s.Lock()
+	s.GetCtx().Apn = "magma.ipv4"
+	s.Unlock()
+
+	// a session which exists in the shared store must not be overwritten
+	_, err = standby.AddSession(&protos.Context{SessionId: sid, Imsi: sharedImsi}, time.Minute, rec.notify)
+	assert.Error(t, err)
+
+	assert.Equal(t, sid, standby.FindSession(sharedImsi))
+	s = standby.GetSessionByImsi(sharedImsi)
+	assert.NotNil(t, s)
+	assert.Equal(t, "magma.ipv4", s.GetCtx().GetApn())
+
+	s = standby.RemoveSession(sid)
+	assert.NotNil(t, s)
+	assert.Equal(t, sid, s.GetCtx().GetSessionId())
+	assert.Nil(t, standby.GetSession(sid))
+	assert.Empty(t, standby.FindSession(sharedImsi))
+	// the primary's copy is dropped on timeout without notification since the session was removed elsewhere
+	assert.True(t, primary.SetTimeout(sid, time.Millisecond*10, rec.notify))
+	time.Sleep(time.Millisecond * 100)
+	assert.Nil(t, primary.GetSession(sid))
+	assert.Empty(t, rec.get())
+}
+
+func TestReplicatedSessionTableRemoveWhileLocked(t *testing.T) {
+	rdb := newSharedRedis()
+	st := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+
+	sid := aaa.CreateSessionId()
+	s, err := st.AddSession(&protos.Context{SessionId: sid, Imsi: sharedImsi}, time.Minute, nil)
+	assert.NoError(t, err)
+	s.Lock()
+	s.GetCtx().Apn = "magma.ipv4"
+	assert.NotNil(t, st.RemoveSession(sid))
+	s.Unlock()
+
+	// the holder's Unlock must not bring the removed session back into the shared store
+	fields, _ := rdb.HGetAll(store.DefaultRedisHash)
+	assert.Empty(t, fields)
+	restarted := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	restored, expired, err := restarted.(store.Restorer).Restore(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, restored)
+	assert.Equal(t, 0, expired)
+	assert.Nil(t, restarted.GetSession(sid))
+}
+
+func TestReplicatedSessionTableTimeouts(t *testing.T) {
+	rdb := newSharedRedis()
+	primary := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	standby := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	rec := &timeoutRecorder{}
+
+	sid := aaa.CreateSessionId()
+	_, err := primary.AddSession(&protos.Context{SessionId: sid, Imsi: sharedImsi}, time.Millisecond*300, rec.notify)
+	assert.NoError(t, err)
+
+	// standby extends the session, primary's timer must not expire it
+	assert.True(t, standby.SetTimeout(sid, time.Second*3, rec.notify))
+	time.Sleep(time.Millisecond * 500)
+	assert.NotNil(t, primary.GetSession(sid))
+	assert.Empty(t, rec.get())
+
+	// the session is restored with the remaining timeout
+	restarted := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	restored, expired, err := restarted.(store.Restorer).Restore(rec.notify)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, restored)
+	assert.Equal(t, 0, expired)
+	assert.Equal(t, sid, restarted.FindSession(sharedImsi))
+
+	assert.True(t, restarted.SetTimeout(sid, time.Millisecond*50, rec.notify))
+	time.Sleep(time.Millisecond * 200)
+	assert.Nil(t, restarted.GetSession(sid))
+	assert.Contains(t, rec.get(), sid)
+}
+
+func TestReplicatedSessionTableRestoreExpired(t *testing.T) {
+	rdb := newSharedRedis()
+	st := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	rec := &timeoutRecorder{}
+
+	sid := aaa.CreateSessionId()
+	_, err := st.AddSession(&protos.Context{SessionId: sid, Imsi: sharedImsi}, time.Millisecond*100, nil)
+	assert.NoError(t, err)
+
+	// emulate the owner going down before the session timed out
+	snapshot := map[string]map[string]string{}
+	for _, hash := range []string{store.DefaultRedisHash, store.DefaultRedisHash + "_expiry"} {
+		snapshot[hash], _ = rdb.HGetAll(hash)
+	}
+	time.Sleep(time.Millisecond * 300)
+	for hash, fields := range snapshot {
+		for k, v := range fields {
+			rdb.HSet(hash, k, v)
+		}
+	}
+
+	restarted := store.NewReplicatedSessionTable(rdb, store.DefaultRedisHash)
+	restored, expired, err := restarted.(store.Restorer).Restore(rec.notify)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, restored)
+	assert.Equal(t, 1, expired)
+	assert.Nil(t, restarted.GetSession(sid))
+	assert.Eventually(t, func() bool { return len(rec.get()) == 1 }, time.Second, time.Millisecond*10)
+}
+
+func TestNewSessionTable(t *testing.T) {
+	st, err := store.NewSessionTable(nil)
+	assert.NoError(t, err)
+	_, isRestorer := st.(store.Restorer)
+	assert.False(t, isRestorer)
+
+	_, err = store.NewSessionTable(&mconfig.AAASessionStore{Backend: "cassandra"})
+	assert.Error(t, err)
+}
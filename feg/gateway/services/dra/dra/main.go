@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Magma's standalone Diameter Routing Agent (DRA) terminates diameter connections of FeG services &
+// network peers and relays diameter messages between them
+package main
+
+import (
+	"flag"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/dra/servicers"
+	"magma/orc8r/lib/go/service"
+)
+
+func init() {
+	flag.Parse()
+}
+
+func main() {
+	// Create the service
+	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.DRA)
+	if err != nil {
+		glog.Fatalf("Error creating DRA service: %s", err)
+	}
+
+	dra, err := servicers.NewDra(servicers.GetDraConfig())
+	if err != nil {
+		glog.Fatalf("Failed to create DRA: %v", err)
+	}
+	if err = dra.Start(); err != nil {
+		glog.Fatalf("Failed to start DRA: %v", err)
+	}
+	defer dra.Stop()
+
+	// Run the service
+	err = srv.Run()
+	if err != nil {
+		glog.Fatalf("Error running service: %s", err)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines DRA prometheus metrics, the metrics are exported via service303
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus counters are monotonically increasing
+// Counters reset to zero on service restart
+var (
+	RelayedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dra_relayed_requests_total",
+			Help: "Total number of diameter requests relayed by the DRA",
+		},
+		[]string{"application_id"},
+	)
+	RelayedAnswers = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "dra_relayed_answers_total",
+		Help: "Total number of diameter answers relayed back to request originators",
+	})
+	UnroutableRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dra_unroutable_requests_total",
+			Help: "Total number of diameter requests without a matching route or an available peer",
+		},
+		[]string{"application_id"},
+	)
+	LoopedRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "dra_looped_requests_total",
+		Help: "Total number of diameter requests rejected due to a detected routing loop",
+	})
+	UnmatchedAnswers = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "dra_unmatched_answers_total",
+		Help: "Total number of diameter answers without a matching relayed request",
+	})
+	PeerUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dra_peer_up",
+			Help: "1 if the connection to the upstream diameter peer is established, 0 otherwise",
+		},
+		[]string{"peer", "address"},
+	)
+	PeerFailed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dra_peer_failed",
+			Help: "1 if the upstream diameter peer is taken out of rotation after a recent failure, 0 otherwise",
+		},
+		[]string{"peer", "address"},
+	)
+	DownstreamPeers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "dra_downstream_peers",
+		Help: "Number of diameter peers currently connected to the DRA",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RelayedRequests, RelayedAnswers, UnroutableRequests, LoopedRequests,
+		UnmatchedAnswers, PeerUp, PeerFailed, DownstreamPeers)
+}
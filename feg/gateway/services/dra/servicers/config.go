@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/orc8r/lib/go/service/config"
+)
+
+const (
+	DraServiceName     = "dra"
+	DefaultDraDiamHost = "dra.magma.com"
+	DefaultDraRealm    = "magma.com"
+)
+
+// DraConfig is the structure of the DRA service YAML configuration (dra.yml)
+type DraConfig struct {
+	Host             string           `yaml:"host"`
+	Realm            string           `yaml:"realm"`
+	ProductName      string           `yaml:"product_name"`
+	Listeners        []ListenerConfig `yaml:"listeners"`
+	WatchdogInterval uint             `yaml:"watchdog_interval"`
+	Retransmits      uint             `yaml:"retransmits"`
+	RetryCount       uint             `yaml:"retry_count"`
+	RequestTimeout   uint             `yaml:"request_timeout"`
+	Peers            []PeerConfig     `yaml:"peers"`
+	Routes           []RouteConfig    `yaml:"routes"`
+}
+
+// ListenerConfig defines a local address the DRA accepts diameter connections on
+type ListenerConfig struct {
+	Protocol string `yaml:"protocol"`
+	Address  string `yaml:"address"`
+}
+
+// PeerConfig defines an upstream diameter peer the DRA connects to
+type PeerConfig struct {
+	Name         string `yaml:"name"`
+	Protocol     string `yaml:"protocol"`
+	Address      string `yaml:"address"`
+	LocalAddress string `yaml:"local_address"`
+	Priority     uint32 `yaml:"priority"`
+	Weight       uint32 `yaml:"weight"`
+}
+
+// RouteConfig is a routing table entry. A request matches the route if its Application-Id,
+// Destination-Realm and Destination-Host match all non empty route parameters, the request is
+// relayed to one of the route's peers. If multiple routes match, the most specific one is used:
+// Destination-Host match takes precedence over Destination-Realm, which takes precedence over
+// Application-Id
+type RouteConfig struct {
+	ApplicationID uint32   `yaml:"application_id"` // 0 - any application
+	DestRealm     string   `yaml:"dest_realm"`     // empty - any realm
+	DestHost      string   `yaml:"dest_host"`      // empty - any host
+	Peers         []string `yaml:"peers"`
+}
+
+// GetDraConfig returns the DRA configuration loaded from dra.yml,
+// missing identity parameters are set to their defaults. The DRA is opt-in: with no listeners
+// configured it doesn't accept diameter connections
+func GetDraConfig() *DraConfig {
+	cfg := &DraConfig{}
+	_, _, err := config.GetStructuredServiceConfig("", DraServiceName, cfg)
+	if err != nil {
+		glog.Errorf("%s configs load error: %v", DraServiceName, err)
+	}
+	if len(cfg.Host) == 0 {
+		cfg.Host = DefaultDraDiamHost
+	}
+	if len(cfg.Realm) == 0 {
+		cfg.Realm = DefaultDraRealm
+	}
+	if len(cfg.ProductName) == 0 {
+		cfg.ProductName = diameter.DiamProductName
+	}
+	return cfg
+}
+
+// ValidateDraConfig verifies that all routes refer to configured peers
+func ValidateDraConfig(cfg *DraConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("nil DRA config")
+	}
+	peers := map[string]bool{}
+	for _, p := range cfg.Peers {
+		if len(p.Name) == 0 || len(p.Address) == 0 {
+			return fmt.Errorf("invalid DRA peer '%s' with address '%s'", p.Name, p.Address)
+		}
+		if peers[p.Name] {
+			return fmt.Errorf("duplicate DRA peer name '%s'", p.Name)
+		}
+		peers[p.Name] = true
+	}
+	for i, r := range cfg.Routes {
+		if len(r.Peers) == 0 {
+			return fmt.Errorf("DRA route #%d has no peers", i)
+		}
+		for _, name := range r.Peers {
+			if !peers[name] {
+				return fmt.Errorf("DRA route #%d refers to unknown peer '%s'", i, name)
+			}
+		}
+	}
+	return nil
+}
+
+// serverConfig returns the diameter connection config of the peer
+func (p *PeerConfig) serverConfig() *diameter.DiameterServerConfig {
+	protocol := strings.ToLower(p.Protocol)
+	if len(protocol) == 0 {
+		protocol = "tcp"
+	}
+	return &diameter.DiameterServerConfig{
+		DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr:      p.Address,
+			Protocol:  protocol,
+			LocalAddr: p.LocalAddress,
+		},
+		Priority: p.Priority,
+		Weight:   p.Weight,
+	}
+}
@@ -0,0 +1,467 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicers implements the standalone Diameter Routing Agent (DRA) service. The DRA terminates
+// diameter connections of its peers and relays requests between them according to a configurable
+// routing table
+package servicers
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/dra/metrics"
+)
+
+const (
+	// relayAppID is the Application-Id of the diameter relay application (RFC 6733 section 2.4)
+	relayAppID = 0xffffffff
+
+	peerStatusInterval = time.Second * 10
+)
+
+// Dra is a diameter relay agent. Requests received from any peer are relayed to:
+//   - a connected peer with Origin-Host equal to the request's Destination-Host, or
+//   - one of the peers of the most specific matching route of the routing table.
+//
+// Hop-by-Hop IDs of relayed requests are replaced by DRA generated IDs, answers are matched to
+// relayed requests by their Hop-by-Hop ID and sent back to the request originators with the
+// original Hop-by-Hop ID
+type Dra struct {
+	config     *DraConfig
+	mux        *sm.StateMachine // state machine of connections accepted by the DRA
+	smClient   *sm.Client       // client of upstream peer connections, uses its own state machine
+	connMan    *diameter.ConnectionManager
+	routes     *routingTable
+	peerNames  map[diameter.DiameterServerConnConfig]string
+	downstream map[string]diam.Conn       // connected peers keyed by lower case Origin-Host
+	pending    map[uint32]*pendingRequest // relayed requests keyed by DRA generated Hop-by-Hop ID
+	lastPrune  time.Time
+	hopByHopID uint32
+	listeners  []net.Listener
+	mutex      sync.Mutex
+}
+
+type pendingRequest struct {
+	conn       diam.Conn           // connection the request was received on
+	hopByHopID uint32              // original Hop-by-Hop ID of the request
+	peers      *diameter.PeerTable // peer table the request was relayed with, nil for downstream peers
+	expires    time.Time
+}
+
+// NewDra creates a new DRA with the given configuration, Start must be called to start
+// accepting diameter connections
+func NewDra(config *DraConfig) (*Dra, error) {
+	if err := ValidateDraConfig(config); err != nil {
+		return nil, err
+	}
+	if config.RequestTimeout == 0 {
+		config.RequestTimeout = diameter.DefaultRequestTimeoutSeconds
+	}
+	if config.WatchdogInterval == 0 {
+		config.WatchdogInterval = diameter.DefaultWatchdogIntervalSeconds
+	}
+	settings := &sm.Settings{
+		OriginHost:       datatype.DiameterIdentity(config.Host),
+		OriginRealm:      datatype.DiameterIdentity(config.Realm),
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      datatype.UTF8String(config.ProductName),
+		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+		FirmwareRevision: 1,
+	}
+	// sm.Client handshake disables CER handling of its state machine, so accepted & upstream connections
+	// cannot share the same state machine
+	settingsCopy := *settings
+	mux, clientMux := sm.New(settings), sm.New(&settingsCopy)
+	d := &Dra{
+		config:     config,
+		mux:        mux,
+		connMan:    diameter.NewConnectionManager(),
+		routes:     &routingTable{},
+		peerNames:  map[diameter.DiameterServerConnConfig]string{},
+		downstream: map[string]diam.Conn{},
+		pending:    map[uint32]*pendingRequest{},
+		lastPrune:  time.Now(),
+		hopByHopID: rand.Uint32(),
+	}
+	d.smClient = &sm.Client{
+		Dict:               dict.Default,
+		Handler:            clientMux,
+		MaxRetransmits:     config.Retransmits,
+		RetransmitInterval: time.Second,
+		EnableWatchdog:     true,
+		WatchdogInterval:   time.Second * time.Duration(config.WatchdogInterval),
+		SupportedVendorID: []*diam.AVP{
+			diam.NewAVP(avp.SupportedVendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	}
+	d.addApplicationIDs()
+
+	peers := map[string]*PeerConfig{}
+	for i := range config.Peers {
+		p := &config.Peers[i]
+		peers[p.Name] = p
+	}
+	requestTimeout := time.Second * time.Duration(config.RequestTimeout)
+	for _, rc := range config.Routes {
+		var server *diameter.DiameterServerConfig
+		for _, name := range rc.Peers {
+			peerServer := peers[name].serverConfig()
+			d.peerNames[peerServer.DiameterServerConnConfig] = name
+			if server == nil {
+				server = peerServer
+			} else {
+				server.Peers = append(server.Peers, peerServer)
+			}
+		}
+		d.routes.routes = append(d.routes.routes, &route{
+			RouteConfig: rc,
+			peers:       diameter.NewPeerTable(d.smClient, d.connMan, requestTimeout, server),
+		})
+	}
+	mux.HandleFunc("ALL", d.handleMessage)
+	clientMux.HandleFunc("ALL", d.handleMessage)
+	go d.handleErrors(mux.ErrorReports())
+	go d.handleErrors(clientMux.ErrorReports())
+	return d, nil
+}
+
+// Start starts listening on all configured addresses & connects to all upstream peers
+func (d *Dra) Start() error {
+	if len(d.config.Listeners) == 0 {
+		glog.Info("DRA has no listeners configured, diameter connections will not be accepted")
+	}
+	for _, lc := range d.config.Listeners {
+		protocol := strings.ToLower(lc.Protocol)
+		if len(protocol) == 0 {
+			protocol = "tcp"
+		}
+		l, err := diam.Listen(protocol, lc.Address)
+		if err != nil {
+			d.Stop()
+			return fmt.Errorf("failed to listen on %s %s: %v", protocol, lc.Address, err)
+		}
+		glog.Infof("DRA is listening on %s %s", protocol, l.Addr())
+		d.mutex.Lock()
+		d.listeners = append(d.listeners, l)
+		d.mutex.Unlock()
+		go func() {
+			err := (&diam.Server{Network: protocol, Handler: d.mux, Dict: dict.Default}).Serve(l)
+			glog.Infof("DRA %s listener %s stopped: %v", protocol, l.Addr(), err)
+		}()
+	}
+	go d.trackDownstreamPeers()
+	for _, r := range d.routes.routes {
+		if err := r.peers.Connect(); err != nil {
+			glog.Errorf("DRA failed to connect to peers of route %+v: %v", r.RouteConfig, err)
+		}
+	}
+	go d.monitorPeers()
+	return nil
+}
+
+// Stop closes all DRA listeners and upstream connections
+func (d *Dra) Stop() {
+	d.mutex.Lock()
+	listeners := d.listeners
+	d.listeners = nil
+	d.mutex.Unlock()
+	for _, l := range listeners {
+		l.Close()
+	}
+	d.connMan.CleanupAllConnections()
+}
+
+// Addrs returns addresses of all active DRA listeners
+func (d *Dra) Addrs() []net.Addr {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	res := make([]net.Addr, 0, len(d.listeners))
+	for _, l := range d.listeners {
+		res = append(res, l.Addr())
+	}
+	return res
+}
+
+// handleMessage is the DRA's catch all handler for all non base protocol messages
+func (d *Dra) handleMessage(c diam.Conn, m *diam.Message) {
+	if m == nil {
+		return
+	}
+	if m.Header.CommandFlags&diam.RequestFlag == 0 {
+		d.relayAnswer(m)
+		return
+	}
+	d.relayRequest(c, m)
+}
+
+// relayRequest relays the request received on the given connection to the peer selected by
+// Destination-Host or the routing table and replies with an error answer if the request cannot
+// be relayed
+func (d *Dra) relayRequest(c diam.Conn, m *diam.Message) {
+	appID := strconv.FormatUint(uint64(m.Header.ApplicationID), 10)
+	if d.isLooped(m) {
+		glog.Warningf("DRA routing loop detected for request: %s", m)
+		metrics.LoopedRequests.Inc()
+		d.sendErrorAnswer(c, m, diam.LoopDetected)
+		return
+	}
+	// identify the peer the request is received from
+	if meta, ok := smpeer.FromContext(c.Context()); ok {
+		m.NewAVP(avp.RouteRecord, avp.Mbit, 0, meta.OriginHost)
+	}
+	destRealm, destHost := identityAVP(m, avp.DestinationRealm), identityAVP(m, avp.DestinationHost)
+	originalID := m.Header.HopByHopID
+	hopByHopID := atomic.AddUint32(&d.hopByHopID, 1)
+	m.Header.HopByHopID = hopByHopID
+
+	var err error
+	if dc := d.findDownstream(destHost); dc != nil && dc != c {
+		d.addPending(hopByHopID, &pendingRequest{conn: c, hopByHopID: originalID})
+		_, err = m.WriteTo(dc)
+	} else if r := d.routes.lookup(m.Header.ApplicationID, destRealm, destHost); r != nil {
+		d.addPending(hopByHopID, &pendingRequest{conn: c, hopByHopID: originalID, peers: r.peers})
+		err = r.peers.RelayRequest(m, d.config.RetryCount)
+	} else {
+		err = fmt.Errorf("no route for Application-Id: %s, Destination-Realm: '%s', Destination-Host: '%s'",
+			appID, destRealm, destHost)
+	}
+	if err != nil {
+		glog.Errorf("DRA failed to relay request %d from %s: %v", originalID, c.RemoteAddr(), err)
+		metrics.UnroutableRequests.WithLabelValues(appID).Inc()
+		d.removePending(hopByHopID)
+		m.Header.HopByHopID = originalID
+		d.sendErrorAnswer(c, m, diam.UnableToDeliver)
+		return
+	}
+	metrics.RelayedRequests.WithLabelValues(appID).Inc()
+}
+
+// relayAnswer sends the answer back on the connection its request was received on
+func (d *Dra) relayAnswer(m *diam.Message) {
+	req := d.removePending(m.Header.HopByHopID)
+	if req == nil {
+		glog.Warningf("DRA received answer without matching request: %s", m)
+		metrics.UnmatchedAnswers.Inc()
+		return
+	}
+	req.peers.AnswerReceived(m)
+	m.Header.HopByHopID = req.hopByHopID
+	if _, err := m.WriteTo(req.conn); err != nil {
+		glog.Errorf("DRA failed to relay answer %d to %s: %v", req.hopByHopID, req.conn.RemoteAddr(), err)
+		return
+	}
+	metrics.RelayedAnswers.Inc()
+}
+
+// isLooped returns true if the request has already passed through the DRA (RFC 6733 section 6.1.3)
+func (d *Dra) isLooped(m *diam.Message) bool {
+	avps, err := m.FindAVPs(avp.RouteRecord, 0)
+	if err != nil {
+		return false
+	}
+	for _, a := range avps {
+		if id, ok := a.Data.(datatype.DiameterIdentity); ok && strings.EqualFold(string(id), d.config.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// sendErrorAnswer replies to the request with a protocol error answer originated by the DRA
+func (d *Dra) sendErrorAnswer(c diam.Conn, m *diam.Message, resultCode uint32) {
+	a := diam.NewMessage(
+		m.Header.CommandCode,
+		m.Header.CommandFlags&^diam.RequestFlag|diam.ErrorFlag,
+		m.Header.ApplicationID,
+		m.Header.HopByHopID,
+		m.Header.EndToEndID,
+		m.Dictionary())
+	if sid, err := m.FindAVP(avp.SessionID, 0); err == nil && sid != nil {
+		a.AddAVP(sid)
+	}
+	a.NewAVP(avp.ResultCode, avp.Mbit, 0, datatype.Unsigned32(resultCode))
+	a.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(d.config.Host))
+	a.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(d.config.Realm))
+	a.NewAVP(avp.ErrorReportingHost, 0, 0, datatype.DiameterIdentity(d.config.Host))
+	if _, err := a.WriteTo(c); err != nil {
+		glog.Errorf("DRA failed to send error answer to %s: %v", c.RemoteAddr(), err)
+	}
+}
+
+func (d *Dra) addPending(hopByHopID uint32, req *pendingRequest) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	timeout := time.Second * time.Duration(d.config.RequestTimeout)
+	now := time.Now()
+	if now.Sub(d.lastPrune) > timeout {
+		for id, p := range d.pending {
+			if now.After(p.expires) {
+				delete(d.pending, id)
+			}
+		}
+		d.lastPrune = now
+	}
+	req.expires = now.Add(timeout)
+	d.pending[hopByHopID] = req
+}
+
+func (d *Dra) removePending(hopByHopID uint32) *pendingRequest {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	req, ok := d.pending[hopByHopID]
+	if !ok {
+		return nil
+	}
+	delete(d.pending, hopByHopID)
+	return req
+}
+
+func (d *Dra) findDownstream(host string) diam.Conn {
+	if len(host) == 0 {
+		return nil
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.downstream[strings.ToLower(host)]
+}
+
+// trackDownstreamPeers keeps track of peers which passed the capabilities exchange with the DRA,
+// requests with Destination-Host of a connected peer are relayed directly to the peer
+func (d *Dra) trackDownstreamPeers() {
+	for c := range d.mux.HandshakeNotify() {
+		meta, ok := smpeer.FromContext(c.Context())
+		if !ok {
+			continue
+		}
+		host := strings.ToLower(string(meta.OriginHost))
+		glog.Infof("DRA peer %s connected from %s", meta.OriginHost, c.RemoteAddr())
+		d.mutex.Lock()
+		d.downstream[host] = c
+		metrics.DownstreamPeers.Set(float64(len(d.downstream)))
+		d.mutex.Unlock()
+
+		cn, ok := c.(diam.CloseNotifier)
+		if !ok {
+			continue
+		}
+		go func(c diam.Conn, closed <-chan struct{}, host string) {
+			<-closed
+			glog.Infof("DRA peer %s from %s disconnected", host, c.RemoteAddr())
+			d.mutex.Lock()
+			if d.downstream[host] == c {
+				delete(d.downstream, host)
+			}
+			metrics.DownstreamPeers.Set(float64(len(d.downstream)))
+			d.mutex.Unlock()
+		}(c, cn.CloseNotify(), host)
+	}
+}
+
+// monitorPeers periodically exports states of all upstream peers
+func (d *Dra) monitorPeers() {
+	for {
+		d.updatePeerMetrics()
+		time.Sleep(peerStatusInterval)
+	}
+}
+
+func (d *Dra) updatePeerMetrics() {
+	for _, r := range d.routes.routes {
+		for _, status := range r.peers.Status() {
+			name := d.peerNames[status.Server.DiameterServerConnConfig]
+			metrics.PeerUp.WithLabelValues(name, status.Server.Addr).Set(boolToFloat(status.Connected))
+			metrics.PeerFailed.WithLabelValues(name, status.Server.Addr).Set(boolToFloat(status.Failed))
+		}
+	}
+}
+
+func (d *Dra) handleErrors(ec <-chan *diam.ErrorReport) {
+	for err := range ec {
+		if err != nil {
+			glog.Errorf("DRA diameter error: %v", err)
+		}
+	}
+}
+
+// addApplicationIDs adds applications of all routes to the capabilities advertised to upstream peers,
+// all locally supported applications are advertised if there is a route for any application
+func (d *Dra) addApplicationIDs() {
+	apps := map[uint32]bool{}
+	anyApp := false
+	for _, r := range d.config.Routes {
+		if r.ApplicationID == 0 || r.ApplicationID == relayAppID {
+			anyApp = true
+		}
+		apps[r.ApplicationID] = true
+	}
+	for _, app := range sm.PrepareSupportedApps(dict.Default) {
+		if app.ID == 0 || !(anyApp || apps[app.ID]) {
+			continue
+		}
+		code := uint32(avp.AuthApplicationID)
+		if app.AppType == "acct" {
+			code = avp.AcctApplicationID
+		}
+		if app.Vendor == 0 {
+			appAVP := diam.NewAVP(code, avp.Mbit, 0, datatype.Unsigned32(app.ID))
+			if code == avp.AuthApplicationID {
+				d.smClient.AuthApplicationID = append(d.smClient.AuthApplicationID, appAVP)
+			} else {
+				d.smClient.AcctApplicationID = append(d.smClient.AcctApplicationID, appAVP)
+			}
+			continue
+		}
+		d.smClient.VendorSpecificApplicationID = append(d.smClient.VendorSpecificApplicationID,
+			diam.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(code, avp.Mbit, 0, datatype.Unsigned32(app.ID)),
+					diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(app.Vendor)),
+				},
+			}))
+	}
+}
+
+// identityAVP returns the value of the top level DiameterIdentity AVP with the given code
+func identityAVP(m *diam.Message, code uint32) string {
+	a, err := m.FindAVP(code, 0)
+	if err != nil || a == nil {
+		return ""
+	}
+	if id, ok := a.Data.(datatype.DiameterIdentity); ok {
+		return string(id)
+	}
+	return ""
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/diameter"
+)
+
+const (
+	testRealm = "operator.com"
+	testHost  = "dra.magma.com"
+)
+
+var ccrIdx = diam.CommandIndex{AppID: diam.CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true}
+var ccaIdx = diam.CommandIndex{AppID: diam.CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: false}
+
+// testPeer is a diameter peer which answers all received CCRs with DIAMETER_SUCCESS and
+// reports all received messages to its received channel
+type testPeer struct {
+	host     string
+	mux      *sm.StateMachine
+	received chan *diam.Message
+}
+
+func newTestPeer(host string) *testPeer {
+	p := &testPeer{
+		host: host,
+		mux: sm.New(&sm.Settings{
+			OriginHost:  datatype.DiameterIdentity(host),
+			OriginRealm: datatype.DiameterIdentity(testRealm),
+			VendorID:    datatype.Unsigned32(diameter.Vendor3GPP),
+			ProductName: "dra test",
+		}),
+		received: make(chan *diam.Message, 10),
+	}
+	p.mux.HandleIdx(ccrIdx, diam.HandlerFunc(func(c diam.Conn, m *diam.Message) {
+		p.received <- m
+		a := m.Answer(diam.Success)
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(host))
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(testRealm))
+		a.WriteTo(c)
+	}))
+	p.mux.HandleIdx(ccaIdx, diam.HandlerFunc(func(c diam.Conn, m *diam.Message) {
+		p.received <- m
+	}))
+	return p
+}
+
+// listen starts the peer's diameter server and returns its address
+func (p *testPeer) listen(t *testing.T) net.Listener {
+	l, err := diam.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go (&diam.Server{Network: "tcp", Handler: p.mux}).Serve(l)
+	return l
+}
+
+// dial connects the peer to the given address
+func (p *testPeer) dial(t *testing.T, addr string) diam.Conn {
+	client := &sm.Client{
+		Dict:    dict.Default,
+		Handler: p.mux,
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID)),
+		},
+	}
+	conn, err := client.DialNetwork("tcp", addr)
+	require.NoError(t, err)
+	return conn
+}
+
+func (p *testPeer) expectMessage(t *testing.T) *diam.Message {
+	select {
+	case m := <-p.received:
+		return m
+	case <-time.After(time.Second * 3):
+		require.FailNow(t, "timed out waiting for diameter message", p.host)
+	}
+	return nil
+}
+
+func newCCR(hopByHopID uint32, destHost string) *diam.Message {
+	m := diam.NewMessage(diam.CreditControl, diam.RequestFlag, diam.CHARGING_CONTROL_APP_ID, hopByHopID, 1, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("test-session"))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("pcef.magma.com"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(testRealm))
+	if len(destHost) > 0 {
+		m.NewAVP(avp.DestinationHost, avp.Mbit, 0, datatype.DiameterIdentity(destHost))
+	}
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID))
+	return m
+}
+
+func startTestDra(t *testing.T, routes []RouteConfig, peers ...PeerConfig) *Dra {
+	dra, err := NewDra(&DraConfig{
+		Host:        testHost,
+		Realm:       "magma.com",
+		ProductName: "dra test",
+		Listeners:   []ListenerConfig{{Protocol: "tcp", Address: "127.0.0.1:0"}},
+		Peers:       peers,
+		Routes:      routes,
+	})
+	require.NoError(t, err)
+	require.NoError(t, dra.Start())
+	return dra
+}
+
+func TestDraRelayToRoute(t *testing.T) {
+	ocs := newTestPeer("ocs." + testRealm)
+	l := ocs.listen(t)
+	defer l.Close()
+
+	dra := startTestDra(t,
+		[]RouteConfig{{ApplicationID: diam.CHARGING_CONTROL_APP_ID, Peers: []string{"ocs"}}},
+		PeerConfig{Name: "ocs", Protocol: "tcp", Address: l.Addr().String()})
+	defer dra.Stop()
+	draAddr := dra.Addrs()[0].String()
+
+	pcef := newTestPeer("pcef.magma.com")
+	conn := pcef.dial(t, draAddr)
+	defer conn.Close()
+
+	_, err := newCCR(1234, "").WriteTo(conn)
+	require.NoError(t, err)
+
+	// the request is relayed with a new Hop-by-Hop ID and a Route-Record of the originator
+	req := ocs.expectMessage(t)
+	assert.NotEqual(t, uint32(1234), req.Header.HopByHopID)
+	assert.Equal(t, testRealm, identityAVP(req, avp.DestinationRealm))
+	assert.Empty(t, identityAVP(req, avp.DestinationHost))
+	assert.Equal(t, "pcef.magma.com", identityAVP(req, avp.RouteRecord))
+
+	// the answer is relayed back with the original Hop-by-Hop ID
+	ans := pcef.expectMessage(t)
+	assert.Equal(t, uint32(1234), ans.Header.HopByHopID)
+	assert.Equal(t, "ocs."+testRealm, identityAVP(ans, avp.OriginHost))
+	assert.Empty(t, dra.pending)
+
+	dra.updatePeerMetrics()
+	status := dra.routes.routes[0].peers.Status()
+	require.Len(t, status, 1)
+	assert.True(t, status[0].Connected)
+	assert.Equal(t, "ocs."+testRealm, status[0].OriginHost)
+}
+
+func TestDraRelayToDestinationHost(t *testing.T) {
+	dra := startTestDra(t, nil)
+	defer dra.Stop()
+	draAddr := dra.Addrs()[0].String()
+
+	pcef := newTestPeer("pcef.magma.com")
+	pcefConn := pcef.dial(t, draAddr)
+	defer pcefConn.Close()
+	ocs := newTestPeer("ocs." + testRealm)
+	ocsConn := ocs.dial(t, draAddr)
+	defer ocsConn.Close()
+
+	require.Eventually(t, func() bool { return dra.findDownstream("OCS."+testRealm) != nil },
+		time.Second, time.Millisecond*10)
+
+	_, err := newCCR(42, "ocs."+testRealm).WriteTo(pcefConn)
+	require.NoError(t, err)
+	req := ocs.expectMessage(t)
+	assert.NotEqual(t, uint32(42), req.Header.HopByHopID)
+	ans := pcef.expectMessage(t)
+	assert.Equal(t, uint32(42), ans.Header.HopByHopID)
+	assert.Equal(t, "ocs."+testRealm, identityAVP(ans, avp.OriginHost))
+}
+
+func TestDraErrorAnswers(t *testing.T) {
+	dra := startTestDra(t, nil)
+	defer dra.Stop()
+
+	pcef := newTestPeer("pcef.magma.com")
+	conn := pcef.dial(t, dra.Addrs()[0].String())
+	defer conn.Close()
+
+	// no route
+	_, err := newCCR(7, "").WriteTo(conn)
+	require.NoError(t, err)
+	ans := pcef.expectMessage(t)
+	assert.Equal(t, uint32(7), ans.Header.HopByHopID)
+	assert.NotZero(t, ans.Header.CommandFlags&diam.ErrorFlag)
+	assertResultCode(t, ans, diam.UnableToDeliver)
+	assert.Equal(t, testHost, identityAVP(ans, avp.ErrorReportingHost))
+
+	// routing loop
+	req := newCCR(8, "")
+	req.NewAVP(avp.RouteRecord, avp.Mbit, 0, datatype.DiameterIdentity(testHost))
+	_, err = req.WriteTo(conn)
+	require.NoError(t, err)
+	ans = pcef.expectMessage(t)
+	assert.Equal(t, uint32(8), ans.Header.HopByHopID)
+	assertResultCode(t, ans, diam.LoopDetected)
+	assert.Empty(t, dra.pending)
+}
+
+func TestValidateDraConfig(t *testing.T) {
+	peers := []PeerConfig{{Name: "ocs", Address: "127.0.0.1:3868"}}
+	assert.NoError(t, ValidateDraConfig(&DraConfig{Peers: peers, Routes: []RouteConfig{{Peers: []string{"ocs"}}}}))
+	assert.Error(t, ValidateDraConfig(&DraConfig{Peers: peers, Routes: []RouteConfig{{Peers: []string{"pcrf"}}}}))
+	assert.Error(t, ValidateDraConfig(&DraConfig{Peers: peers, Routes: []RouteConfig{{}}}))
+	assert.Error(t, ValidateDraConfig(&DraConfig{Peers: append(peers, peers[0])}))
+}
+
+func assertResultCode(t *testing.T, m *diam.Message, expected uint32) {
+	rc, err := m.FindAVP(avp.ResultCode, 0)
+	require.NoError(t, err)
+	assert.Equal(t, datatype.Unsigned32(expected), rc.Data)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"strings"
+
+	"magma/feg/gateway/diameter"
+)
+
+// Route specificity weights, Destination-Host match is more specific than Destination-Realm match
+// which is more specific than Application-Id match
+const (
+	appMatchWeight   = 1
+	realmMatchWeight = 2
+	hostMatchWeight  = 4
+)
+
+// route is a routing table entry with the peer table of its peers
+type route struct {
+	RouteConfig
+	peers *diameter.PeerTable
+}
+
+// routingTable selects routes for relayed requests
+type routingTable struct {
+	routes []*route
+}
+
+// lookup returns the most specific route matching the given Application-Id, Destination-Realm &
+// Destination-Host or nil if there is no matching route. Among equally specific routes,
+// the first configured one is selected
+func (rt *routingTable) lookup(appID uint32, destRealm, destHost string) *route {
+	var (
+		best      *route
+		bestScore = -1
+	)
+	for _, r := range rt.routes {
+		score, ok := r.match(appID, destRealm, destHost)
+		if ok && score > bestScore {
+			best, bestScore = r, score
+		}
+	}
+	return best
+}
+
+// match returns true and the match specificity if the request parameters match the route
+func (r *route) match(appID uint32, destRealm, destHost string) (int, bool) {
+	score := 0
+	if r.ApplicationID != 0 {
+		if r.ApplicationID != appID {
+			return 0, false
+		}
+		score += appMatchWeight
+	}
+	if len(r.DestRealm) > 0 {
+		if !strings.EqualFold(r.DestRealm, destRealm) {
+			return 0, false
+		}
+		score += realmMatchWeight
+	}
+	if len(r.DestHost) > 0 {
+		if !strings.EqualFold(r.DestHost, destHost) {
+			return 0, false
+		}
+		score += hostMatchWeight
+	}
+	return score, true
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutingTableLookup(t *testing.T) {
+	rt := &routingTable{routes: []*route{
+		{RouteConfig: RouteConfig{Peers: []string{"default"}}},
+		{RouteConfig: RouteConfig{ApplicationID: 4, Peers: []string{"ocs"}}},
+		{RouteConfig: RouteConfig{ApplicationID: 4, DestRealm: "operator.com", Peers: []string{"ocs_realm"}}},
+		{RouteConfig: RouteConfig{DestRealm: "operator.com", Peers: []string{"realm"}}},
+		{RouteConfig: RouteConfig{DestHost: "ocs2.operator.com", Peers: []string{"ocs2"}}},
+		{RouteConfig: RouteConfig{ApplicationID: 4, Peers: []string{"ocs_duplicate"}}},
+	}}
+	lookup := func(appID uint32, realm, host string) string {
+		r := rt.lookup(appID, realm, host)
+		if r == nil {
+			return ""
+		}
+		return r.Peers[0]
+	}
+	assert.Equal(t, "default", lookup(16777238, "", ""))
+	assert.Equal(t, "ocs", lookup(4, "other.com", ""))
+	assert.Equal(t, "ocs_realm", lookup(4, "OPERATOR.com", "ocs1.operator.com"))
+	assert.Equal(t, "realm", lookup(16777238, "operator.com", ""))
+	assert.Equal(t, "ocs2", lookup(4, "operator.com", "ocs2.operator.com"))
+
+	rt.routes = rt.routes[1:]
+	assert.Equal(t, "", lookup(16777238, "other.com", ""))
+	assert.Equal(t, "ocs", lookup(4, "", ""))
+}
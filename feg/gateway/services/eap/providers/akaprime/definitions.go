@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// package akaprime implements EAP-AKA' provider (RFC 5448, RFC 9048)
+package akaprime
+
+import (
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+)
+
+const (
+	TYPE           = uint8(protos.EapType_AKAPrime)
+	MIN_PACKET_LEN = eap.EapSubtype
+
+	EapAkaPrimeServiceName = "eap_aka_prime"
+
+	// DefaultNetworkName - default Access Network Identity used for CK'/IK' derivation & AT_KDF_INPUT,
+	// see 3GPP TS 24.302, section 8.1.1
+	DefaultNetworkName = "WLAN"
+)
+
+const (
+	// AKA' specific Attributes, all other EAP-AKA' attributes are shared with EAP-AKA
+	AT_KDF_INPUT eap.AttrType = 23
+	AT_KDF       eap.AttrType = 24
+)
+
+const (
+	// KDF_AKA_PRIME - the only defined AKA' Key Derivation Function: CK'/IK' derivation & PRF' (HMAC-SHA-256)
+	KDF_AKA_PRIME uint16 = 1
+)
+
+const (
+	// Identity prefixes, see https://tools.ietf.org/html/rfc5448#section-3
+	PermanentIdPrefix = '6'
+	PseudonymIdPrefix = '7'
+	ReauthIdPrefix    = '8'
+)
+
+const (
+	K_ENCR_LEN = 16
+	K_AUT_LEN  = 32
+	K_RE_LEN   = 32
+	MSK_LEN    = 64
+	EMSK_LEN   = 64
+	MK_LEN     = K_ENCR_LEN + K_AUT_LEN + K_RE_LEN + MSK_LEN + EMSK_LEN
+
+	AT_KDF_ATTR_LEN = 4
+	SQN_XOR_AK_LEN  = 6
+
+	ckIkPrimeFC byte = 0x20 // FC value of CK'/IK' derivation, 3GPP TS 33.402 Annex A.2
+)
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akaprime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// HmacSha256 - SHA-256 based HMAC
+func HmacSha256(data, key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// MakeCKIKPrime derives CK' & IK' from CK, IK, the access network name & AUTN
+// (see: https://tools.ietf.org/html/rfc5448#section-3.3 & 3GPP TS 33.402 Annex A.2):
+//
+//	CK' | IK' = HMAC-SHA-256(CK | IK, FC | P0 | L0 | P1 | L1)
+//
+// where FC = 0x20, P0 - network name, P1 - SQN xor AK (the first 6 octets of AUTN)
+func MakeCKIKPrime(CK, IK, AUTN []byte, networkName string) (CKPrime, IKPrime []byte) {
+	l0 := len(networkName)
+	s := make([]byte, 0, 1+l0+2+SQN_XOR_AK_LEN+2)
+	s = append(s, ckIkPrimeFC)
+	s = append(s, networkName...)
+	s = append(s, byte(l0>>8), byte(l0))
+	s = append(s, AUTN[:SQN_XOR_AK_LEN]...)
+	s = append(s, 0, SQN_XOR_AK_LEN)
+
+	key := make([]byte, 0, len(CK)+len(IK))
+	key = append(append(key, CK...), IK...)
+	res := HmacSha256(s, key)
+	return res[:16], res[16:]
+}
+
+// PRFPrime implements PRF' (see: https://tools.ietf.org/html/rfc5448#section-3.4.1) and returns
+// length bytes of:
+//
+//	T1 = HMAC-SHA-256 (K, S | 0x01)
+//	T2 = HMAC-SHA-256 (K, T1 | S | 0x02)
+//	...
+func PRFPrime(key, s []byte, length int) []byte {
+	res := make([]byte, 0, length+sha256.Size)
+	var t []byte
+	for n := 1; len(res) < length; n++ {
+		h := hmac.New(sha256.New, key)
+		h.Write(t)
+		h.Write(s)
+		h.Write([]byte{byte(n)})
+		t = h.Sum(nil)
+		res = append(res, t...)
+	}
+	return res[:length]
+}
+
+// MakeAKAPrimeKeys returns generated K_encr, K_aut, K_re, MSK, EMSK keys for AKA' Authentication
+// (see: https://tools.ietf.org/html/rfc5448#section-3.3):
+//
+//	MK = PRF'(IK'|CK',"EAP-AKA'"|Identity)
+func MakeAKAPrimeKeys(identity, IKPrime, CKPrime []byte) (K_encr, K_aut, K_re, MSK, EMSK []byte) {
+	key := make([]byte, 0, len(IKPrime)+len(CKPrime))
+	key = append(append(key, IKPrime...), CKPrime...)
+	s := make([]byte, 0, len(mkLabel)+len(identity))
+	s = append(append(s, mkLabel...), identity...)
+	mk := PRFPrime(key, s, MK_LEN)
+
+	K_encr, mk = mk[:K_ENCR_LEN], mk[K_ENCR_LEN:]
+	K_aut, mk = mk[:K_AUT_LEN], mk[K_AUT_LEN:]
+	K_re, mk = mk[:K_RE_LEN], mk[K_RE_LEN:]
+	MSK, EMSK = mk[:MSK_LEN], mk[MSK_LEN:]
+	return
+}
+
+const mkLabel = "EAP-AKA'"
+
+// GenMac calculates AKA' MAC given data & K_aut: HMAC-SHA-256-128
+// (see: https://tools.ietf.org/html/rfc5448#section-3.4.1)
+func GenMac(data, K_aut []byte) []byte {
+	return HmacSha256(data, K_aut)[:aka.MAC_LEN]
+}
+
+// AppendMac appends AT_MAC attribute to eap packet, signs the packet & returns the new, signed packet
+// returns error if provided EAP Packet was malformed
+func AppendMac(p eap.Packet, K_aut []byte) (eap.Packet, error) {
+	p = p.Truncate()
+	atMacOffset := len(p) + aka.ATT_HDR_LEN
+	p, err := p.Append(eap.NewAttribute(aka.AT_MAC, append([]byte{0, 0}, make([]byte, aka.MAC_LEN)...)))
+	if err != nil {
+		return p, err
+	}
+	mac := GenMac(p, K_aut)
+	// Set AT_MAC
+	copy(p[atMacOffset:], mac)
+	return p, nil
+}
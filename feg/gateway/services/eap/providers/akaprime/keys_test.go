@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akaprime
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// RFC 5448, Appendix C test vectors
+type testVector struct {
+	identity, networkName,
+	ck, ik, autn,
+	ckPrime, ikPrime,
+	kEncr, kAut, kRe, msk, emsk string
+}
+
+var rfc5448Vectors = []testVector{
+	{ // Test Case 1
+		identity:    "0555444333222111",
+		networkName: "WLAN",
+		ck:          "5349fbe098649f948f5d2e973a81c00f",
+		ik:          "9744871ad32bf9bbd1dd5ce54e3e2e5a",
+		autn:        "bb52e91c747ac3ab2a5c23d15ee351d5",
+		ckPrime:     "0093962d0dd84aa5684b045c9edffa04",
+		ikPrime:     "ccfc230ca74fcc96c0a5d61164f5a76c",
+		kEncr:       "766fa0a6c317174b812d52fbcd11a179",
+		kAut:        "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea",
+		kRe:         "cf83aa8bc7e0aced892acc98e76a9b2095b558c7795c7094715cb3393aa7d17a",
+		msk: "67c42d9aa56c1b79e295e3459fc3d187d42be0bf818d3070e362c5e967a4d544" +
+			"e8ecfe19358ab3039aff03b7c930588c055babee58a02650b067ec4e9347c75a",
+		emsk: "f861703cd775590e16c7679ea3874ada866311de290764d760cf76df647ea01c" +
+			"313f69924bdd7650ca9bac141ea075c4ef9e8029c0e290cdbad5638b63bc23fb",
+	},
+	{ // Test Case 2
+		identity:    "0555444333222111",
+		networkName: "HRPD",
+		ck:          "5349fbe098649f948f5d2e973a81c00f",
+		ik:          "9744871ad32bf9bbd1dd5ce54e3e2e5a",
+		autn:        "bb52e91c747ac3ab2a5c23d15ee351d5",
+		ckPrime:     "3820f0277fa5f77732b1fb1d90c1a0da",
+		ikPrime:     "db94a0ab557ef6c9ab48619ca05b9a9f",
+		kEncr:       "05ad73ac915fce89ac77e1520d82187b",
+		kAut:        "5b4acaef62c6ebb8882b2f3d534c4b35277337a00184f20ff25d224c04be2afd",
+		kRe:         "3f90bf5c6e5ef325ff04eb5ef6539fa8cca8398194fbd00be425b3f40dba10ac",
+		msk: "87b321570117cd6c95ab6c436fb5073ff15cf85505d2bc5bb7355fc21ea8a757" +
+			"57e8f86a2b138002e05752913bb43b82f868a96117e91a2d95f526677d572900",
+		emsk: "c891d5f20f148a1007553e2dea555c9cb672e9675f4a66b4bafa027379f93aee" +
+			"539a5979d0a0042b9d2ae28bed3b17a31dc8ab75072b80bd0c1da612466e402c",
+	},
+}
+
+func unhex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	return b
+}
+
+func TestMakeCKIKPrime(t *testing.T) {
+	for _, v := range rfc5448Vectors {
+		ckPrime, ikPrime := MakeCKIKPrime(unhex(t, v.ck), unhex(t, v.ik), unhex(t, v.autn), v.networkName)
+		assert.Equal(t, v.ckPrime, hex.EncodeToString(ckPrime), "CK' for %s", v.networkName)
+		assert.Equal(t, v.ikPrime, hex.EncodeToString(ikPrime), "IK' for %s", v.networkName)
+	}
+}
+
+func TestMakeAKAPrimeKeys(t *testing.T) {
+	for _, v := range rfc5448Vectors {
+		kEncr, kAut, kRe, msk, emsk := MakeAKAPrimeKeys(
+			[]byte(v.identity), unhex(t, v.ikPrime), unhex(t, v.ckPrime))
+		assert.Equal(t, v.kEncr, hex.EncodeToString(kEncr))
+		assert.Equal(t, v.kAut, hex.EncodeToString(kAut))
+		assert.Equal(t, v.kRe, hex.EncodeToString(kRe))
+		assert.Equal(t, v.msk, hex.EncodeToString(msk))
+		assert.Equal(t, v.emsk, hex.EncodeToString(emsk))
+	}
+}
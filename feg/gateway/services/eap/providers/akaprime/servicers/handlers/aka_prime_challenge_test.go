@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+)
+
+var successEAP = eap.Packet{eap.SuccessCode, 2, 0, 4}
+
+// newTestChallengeResp creates EAP-Response/AKA'-Challenge with given attributes signed with RFC 5448 K_aut
+func newTestChallengeResp(t *testing.T, identifier uint8, attrs ...eap.Attribute) eap.Packet {
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{akaprime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	var err error
+	for _, a := range attrs {
+		p, err = p.Append(a)
+		assert.NoError(t, err)
+	}
+	p, err = akaprime.AppendMac(p, unhex(testKAut))
+	assert.NoError(t, err)
+	return p
+}
+
+func newTestAtRes(res []byte) eap.Attribute {
+	bitLen := len(res) * 8
+	return eap.NewAttribute(aka.AT_RES, append([]byte{byte(bitLen >> 8), byte(bitLen)}, res...))
+}
+
+func TestAkaPrimeChallengeResp(t *testing.T) {
+	for _, cfg := range []struct {
+		primeKeys bool
+		config    *mconfig.EapAkaPrimeConfig
+	}{
+		{primeKeys: true, config: nil},
+		{primeKeys: false, config: &mconfig.EapAkaPrimeConfig{DeriveCkIkPrime: true}},
+	} {
+		startTestSwxProxy(t, cfg.primeKeys)
+
+		akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(cfg.config)
+		eapCtx := &protos.Context{}
+		_, err := identityResponse(akaPrimeSrv, eapCtx, newTestIdentityResp(t, 1, testIdentity))
+		assert.NoError(t, err)
+
+		p, err := challengeResponse(akaPrimeSrv, eapCtx, newTestChallengeResp(t, 2, newTestAtRes(unhex(testRes))))
+		assert.NoError(t, err)
+		assert.Equal(t, successEAP, p)
+		assert.Equal(t, unhex(testMsk), eapCtx.Msk)
+		assert.Equal(t, testIdentity, eapCtx.Identity)
+	}
+}
+
+func TestAkaPrimeChallengeRespFailures(t *testing.T) {
+	startTestSwxProxy(t, true)
+	akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(nil)
+
+	// Invalid AT_RES
+	eapCtx := &protos.Context{}
+	_, err := identityResponse(akaPrimeSrv, eapCtx, newTestIdentityResp(t, 1, testIdentity))
+	assert.NoError(t, err)
+	p, err := challengeResponse(akaPrimeSrv, eapCtx,
+		newTestChallengeResp(t, 2, newTestAtRes([]byte{1, 2, 3, 4, 5, 6, 7, 8})))
+	assert.NoError(t, err)
+	expected, _ := akaprime.AppendMac(
+		akaprime.NewAKAPrimeNotificationReq(2, aka.NOTIFICATION_FAILURE_AUTH), unhex(testKAut))
+	assert.Equal(t, expected, p)
+	assert.Empty(t, eapCtx.Msk)
+
+	// Invalid AT_MAC
+	eapCtx = &protos.Context{}
+	_, err = identityResponse(akaPrimeSrv, eapCtx, newTestIdentityResp(t, 1, testIdentity))
+	assert.NoError(t, err)
+	resp := newTestChallengeResp(t, 2, newTestAtRes(unhex(testRes)))
+	resp[len(resp)-1] ^= 0xff
+	p, err = challengeResponse(akaPrimeSrv, eapCtx, resp)
+	assert.NoError(t, err)
+	assert.Equal(t, akaprime.NewAKAPrimeNotificationReq(2, aka.NOTIFICATION_FAILURE), p)
+	assert.Empty(t, eapCtx.Msk)
+
+	// Peer proposes different KDF
+	eapCtx = &protos.Context{}
+	_, err = identityResponse(akaPrimeSrv, eapCtx, newTestIdentityResp(t, 1, testIdentity))
+	assert.NoError(t, err)
+	p, err = challengeResponse(akaPrimeSrv, eapCtx,
+		newTestChallengeResp(t, 2, eap.NewAttribute(akaprime.AT_KDF, []byte{0, 2})))
+	assert.NoError(t, err)
+	assert.Equal(t, akaprime.NewAKAPrimeNotificationReq(2, aka.NOTIFICATION_FAILURE), p)
+	assert.Empty(t, eapCtx.Msk)
+}
+
+func TestAkaPrimeResync(t *testing.T) {
+	startTestSwxProxy(t, true)
+	akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(nil)
+
+	eapCtx := &protos.Context{}
+	_, err := identityResponse(akaPrimeSrv, eapCtx, newTestIdentityResp(t, 1, testIdentity))
+	assert.NoError(t, err)
+
+	p := eap.NewPacket(eap.ResponseCode, 2, []byte{akaprime.TYPE, byte(aka.SubtypeSynchronizationFailure), 0, 0})
+	p, err = p.Append(eap.NewAttribute(aka.AT_AUTS, make([]byte, 14)))
+	assert.NoError(t, err)
+	rp, err := resyncResponse(akaPrimeSrv, eapCtx, p)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.RequestCode), rp.Code())
+	assert.Equal(t, uint8(3), rp.Identifier())
+	assert.Equal(t, byte(aka.SubtypeChallenge), rp[eap.EapSubtype])
+
+	p, err = challengeResponse(akaPrimeSrv, eapCtx, newTestChallengeResp(t, 3, newTestAtRes(unhex(testRes))))
+	assert.NoError(t, err)
+	assert.Equal(t, eap.Packet{eap.SuccessCode, 3, 0, 4}, p)
+}
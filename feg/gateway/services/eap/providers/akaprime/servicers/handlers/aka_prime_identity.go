@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers provided AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeIdentity, identityResponse)
+}
+
+// identityResponse implements handler for EAP-Response/AKA'-Identity, see https://tools.ietf.org/html/rfc5448#section-3
+// and https://tools.ietf.org/html/rfc4187#section-9.2 for reference
+func identityResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var success bool
+	metrics.IdentityRequests.Inc()
+	defer func() {
+		if !success {
+			metrics.FailedIdentityRequests.Inc()
+		}
+	}()
+	identifier := req.Identifier()
+	if ctx == nil {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+		glog.Warningf("Missing Session ID for EAP: %x; Generated new SID: %s", req, ctx.SessionId)
+	}
+	scanner, err := eap.NewAttributeScanner(req)
+	if err != nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Aborted, err.Error())
+	}
+	var a eap.Attribute
+
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		// Find first valid AT_IDENTITY attribute to get UE IMSI
+		if a.Type() == aka.AT_IDENTITY {
+			identity, imsi, permanent, err := getIMSIIdentity(a)
+			if err == nil {
+				if !permanent {
+					glog.Warningf("AKA' AT_IDENTITY '%s' (IMSI: %s) is non-permanent type", identity, imsi)
+				}
+				if !s.CheckPlmnId(imsi) {
+					s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+					return akaprime.EapErrorResPacket(
+						identifier,
+						aka.NOTIFICATION_FAILURE,
+						codes.PermissionDenied,
+						"PLMN ID of IMSI: %s is not permitted", imsi)
+				}
+				ctx.Imsi = string(imsi)                  // set IMSI
+				uc := s.InitSession(ctx.SessionId, imsi) // we have Locked User Ctx after this call
+				state, t := uc.State()
+				if state > aka.StateCreated {
+					glog.Errorf(
+						"EAP AKA' IdentityResponse: Unexpected user state: %d,%s for IMSI: %s, CTX Identity: %s",
+						state, t, imsi, uc.Identity)
+				}
+				uc.Identity = identity
+				uc.SetState(aka.StateIdentity)
+				p, err := createChallengeRequest(s, uc, identifier, nil)
+				if success = err == nil; success {
+					// Update state
+					uc.SetState(aka.StateChallenge)
+					s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+				} else {
+					s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+				}
+				return p, err
+			}
+		}
+	}
+	s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+	if err != nil && err != io.EOF {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, err.Error())
+	}
+	return akaprime.EapErrorResPacket(
+		identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
+}
+
+// getIMSIIdentity returns full identity, IMSI & permanent identity flag from AT_IDENTITY attribute,
+// AKA' permanent identity prefix is stripped from the returned IMSI,
+// see https://tools.ietf.org/html/rfc4187#section-4.1.1.4 & https://tools.ietf.org/html/rfc5448#section-3
+func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, bool, error) {
+	if a.Type() != aka.AT_IDENTITY {
+		return "", "", false, fmt.Errorf("Unexpected Attr Type: %d, AT_IDENTITY expected", a.Type())
+	}
+	if a.Len() <= 4 {
+		return "", "", false, fmt.Errorf("AT_IDENTITY is too short: %d", a.Len())
+	}
+	val := a.Value()
+	actualLen2 := int(val[0])<<8 + int(val[1]) + 2
+	if actualLen2 > len(val) {
+		return "", "", false, fmt.Errorf(
+			"Corrupt AT_IDENTITY Attribute: actual len %d > data len %d", actualLen2-2, len(val))
+	}
+	fullIdentity := string(val[2:actualLen2])
+	imsi := fullIdentity
+	if atIdx := strings.Index(fullIdentity, "@"); atIdx > 0 {
+		imsi = fullIdentity[:atIdx]
+	}
+	permanent := len(imsi) > 0 && imsi[0] == akaprime.PermanentIdPrefix
+	if permanent {
+		imsi = imsi[1:]
+	}
+	return fullIdentity, aka.IMSI(imsi), permanent, aka.IMSI(imsi).Validate()
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cp "magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+// RFC 5448, Appendix C, Test Case 1 values
+const (
+	testIdentity = "0555444333222111"
+	testRand     = "81e92b6c0ee0e12ebceba8d92a99dfa5"
+	testAutn     = "bb52e91c747ac3ab2a5c23d15ee351d5"
+	testIk       = "9744871ad32bf9bbd1dd5ce54e3e2e5a"
+	testCk       = "5349fbe098649f948f5d2e973a81c00f"
+	testRes      = "28d7b0f2a2ec3de5"
+	testCkPrime  = "0093962d0dd84aa5684b045c9edffa04"
+	testIkPrime  = "ccfc230ca74fcc96c0a5d61164f5a76c"
+	testKAut     = "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea"
+	testMsk      = "67c42d9aa56c1b79e295e3459fc3d187d42be0bf818d3070e362c5e967a4d544" +
+		"e8ecfe19358ab3039aff03b7c930588c055babee58a02650b067ec4e9347c75a"
+)
+
+// testSwxProxy is a SwxProxyServer returning RFC 5448 Test Case 1 vector,
+// returns CK'/IK' if primeKeys is set and CK/IK otherwise
+type testSwxProxy struct {
+	primeKeys bool
+}
+
+// Authenticate returns RFC 5448 Test Case 1 Auth Vector
+func (s testSwxProxy) Authenticate(
+	ctx context.Context,
+	req *cp.AuthenticationRequest,
+) (*cp.AuthenticationAnswer, error) {
+	ck, ik := testCk, testIk
+	if s.primeKeys {
+		ck, ik = testCkPrime, testIkPrime
+	}
+	return &cp.AuthenticationAnswer{
+		UserName: req.GetUserName(),
+		SipAuthVectors: []*cp.AuthenticationAnswer_SIPAuthVector{
+			{
+				AuthenticationScheme: req.AuthenticationScheme,
+				RandAutn:             unhex(testRand + testAutn),
+				Xres:                 unhex(testRes),
+				ConfidentialityKey:   unhex(ck),
+				IntegrityKey:         unhex(ik),
+			},
+		},
+	}, nil
+}
+
+// Register returns empty RegistrationAnswer
+func (s testSwxProxy) Register(
+	ctx context.Context,
+	req *cp.RegistrationRequest,
+) (*cp.RegistrationAnswer, error) {
+	return &cp.RegistrationAnswer{}, nil
+}
+
+// Deregister returns empty RegistrationAnswer
+func (s testSwxProxy) Deregister(
+	ctx context.Context,
+	req *cp.RegistrationRequest,
+) (*cp.RegistrationAnswer, error) {
+	return &cp.RegistrationAnswer{}, nil
+}
+
+func unhex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func startTestSwxProxy(t *testing.T, primeKeys bool) {
+	os.Setenv("USE_REMOTE_SWX_PROXY", "false")
+	srv, lis, _ := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	cp.RegisterSwxProxyServer(srv.GrpcServer, testSwxProxy{primeKeys: primeKeys})
+	go srv.RunTest(lis, nil)
+}
+
+func newTestIdentityResp(t *testing.T, identifier uint8, identity string) eap.Packet {
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{akaprime.TYPE, byte(aka.SubtypeIdentity), 0, 0})
+	idLen := len(identity)
+	p, err := p.Append(eap.NewAttribute(aka.AT_IDENTITY, append([]byte{byte(idLen >> 8), byte(idLen)}, identity...)))
+	assert.NoError(t, err)
+	return p
+}
+
+func TestAkaPrimeIdentity(t *testing.T) {
+	startTestSwxProxy(t, false)
+
+	akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(&mconfig.EapAkaPrimeConfig{DeriveCkIkPrime: true})
+	eapCtx := &protos.Context{}
+	p, err := identityResponse(akaPrimeSrv, eapCtx, newTestIdentityResp(t, 1, testIdentity))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, eapCtx.SessionId)
+	assert.Equal(t, uint8(eap.RequestCode), p.Code())
+	assert.Equal(t, uint8(2), p.Identifier())
+	assert.Equal(t, akaprime.TYPE, p.Type())
+	assert.Equal(t, byte(aka.SubtypeChallenge), p[eap.EapSubtype])
+
+	scanner, err := eap.NewAttributeScanner(p)
+	assert.NoError(t, err)
+	expected := []struct {
+		typ eap.AttrType
+		val []byte
+	}{
+		{aka.AT_RAND, append([]byte{0, 0}, unhex(testRand)...)},
+		{aka.AT_AUTN, append([]byte{0, 0}, unhex(testAutn)...)},
+		{akaprime.AT_KDF_INPUT, []byte{0, 4, 'W', 'L', 'A', 'N'}},
+		{akaprime.AT_KDF, []byte{0, 1}},
+	}
+	for _, e := range expected {
+		attr, err := scanner.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, e.typ, attr.Type())
+		assert.Equal(t, e.val, attr.Value())
+	}
+	atMac, err := scanner.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, aka.AT_MAC, atMac.Type())
+
+	// Verify AT_MAC using RFC 5448 K_aut
+	ueMac := make([]byte, aka.MAC_LEN)
+	copy(ueMac, atMac.Value()[2:])
+	for i := 2; i < len(atMac.Value()); i++ {
+		atMac.Value()[i] = 0
+	}
+	assert.Equal(t, akaprime.GenMac(p, unhex(testKAut)), ueMac)
+}
+
+func TestAkaPrimeIdentityNetworkName(t *testing.T) {
+	startTestSwxProxy(t, true)
+
+	akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(&mconfig.EapAkaPrimeConfig{NetworkName: "HRPD"})
+	p, err := identityResponse(akaPrimeSrv, &protos.Context{}, newTestIdentityResp(t, 1, testIdentity))
+	assert.NoError(t, err)
+	scanner, err := eap.NewAttributeScanner(p)
+	assert.NoError(t, err)
+	var atKdfInput eap.Attribute
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		if a.Type() == akaprime.AT_KDF_INPUT {
+			atKdfInput = a
+		}
+	}
+	if assert.NotNil(t, atKdfInput) {
+		assert.Equal(t, []byte{0, 4, 'H', 'R', 'P', 'D'}, atKdfInput.Value())
+	}
+}
+
+func TestAkaPrimeIdentityErrors(t *testing.T) {
+	akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(&mconfig.EapAkaPrimeConfig{PlmnIds: []string{"00102"}})
+
+	// missing AT_IDENTITY
+	p := eap.NewPacket(eap.ResponseCode, 1, []byte{akaprime.TYPE, byte(aka.SubtypeIdentity), 0, 0})
+	p, _ = p.Append(eap.NewAttribute(aka.AT_RES, []byte{0, 0}))
+	rp, err := identityResponse(akaPrimeSrv, &protos.Context{}, p)
+	assert.NoError(t, err)
+	assert.Equal(t, akaprime.NewAKAPrimeNotificationReq(1, aka.NOTIFICATION_FAILURE), rp)
+
+	// PLMN ID is not permitted
+	rp, err = identityResponse(akaPrimeSrv, &protos.Context{}, newTestIdentityResp(t, 1, "6001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"))
+	assert.NoError(t, err)
+	assert.Equal(t, akaprime.NewAKAPrimeNotificationReq(1, aka.NOTIFICATION_FAILURE), rp)
+}
+
+func TestGetIMSIIdentity(t *testing.T) {
+	newAttr := func(identity string) eap.Attribute {
+		return eap.NewAttribute(aka.AT_IDENTITY, append([]byte{0, byte(len(identity))}, identity...))
+	}
+	fullId, imsi, permanent, err := getIMSIIdentity(newAttr("6001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"))
+	assert.NoError(t, err)
+	assert.Equal(t, "6001010000000055@wlan.mnc001.mcc001.3gppnetwork.org", fullId)
+	assert.Equal(t, aka.IMSI("001010000000055"), imsi)
+	assert.True(t, permanent)
+
+	_, imsi, permanent, err = getIMSIIdentity(newAttr("0001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"))
+	assert.NoError(t, err)
+	assert.Equal(t, aka.IMSI("0001010000000055"), imsi)
+	assert.False(t, permanent)
+
+	_, _, _, err = getIMSIIdentity(newAttr("7abcd@wlan"))
+	assert.Error(t, err)
+}
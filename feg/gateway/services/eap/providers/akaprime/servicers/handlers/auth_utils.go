@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	swx_protos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+	"magma/feg/gateway/services/swx_proxy"
+)
+
+type tgppAuthResult struct {
+	rand, autn, xres, ckPrime, ikPrime []byte
+	sid                                string
+	profile                            *swx_protos.AuthenticationAnswer_UserProfile
+}
+
+// getSwxVector fetches EAP-AKA' vector from HSS via SWx Proxy. HSS is expected to return CK'/IK' bound to the
+// service's network name sent as ANID for EAP-AKA' scheme (3GPP TS 29.273, 8.2.3.1), if the service is configured
+// to derive CK'/IK' locally, CK/IK returned by HSS will be used as the derivation input
+func getSwxVector(s *servicers.EapAkaPrimeSrv, imsi string, resyncInfo []byte) (*tgppAuthResult, error) {
+	metrics.SwxRequests.Inc()
+	swxStartTime := time.Now()
+
+	ans, err := swx_proxy.Authenticate(
+		&swx_protos.AuthenticationRequest{
+			UserName:              imsi,
+			SipNumAuthVectors:     1,
+			AuthenticationScheme:  swx_protos.AuthenticationScheme_EAP_AKA_PRIME,
+			ResyncInfo:            resyncInfo,
+			RetrieveUserProfile:   true,
+			AccessNetworkIdentity: s.NetworkName(),
+		})
+
+	metrics.SWxLatency.Observe(time.Since(swxStartTime).Seconds())
+
+	if err != nil {
+		metrics.SwxFailures.Inc()
+		errCode := codes.Internal
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			errCode = se.GRPCStatus().Code()
+		}
+		return nil, status.Errorf(errCode, "%v; IMSI: %s", err, imsi)
+	}
+	if ans == nil {
+		return nil, status.Error(codes.Internal, "Error: Nil SWx Response")
+	}
+	if len(ans.SipAuthVectors) == 0 {
+		return nil, status.Errorf(codes.Internal, "Error: Missing/empty SWx Auth Vector: %+v", ans)
+	}
+	av := ans.SipAuthVectors[0] // Use first vector for now
+	ra := av.GetRandAutn()
+	if len(ra) < aka.RandAutnLen {
+		return nil, status.Errorf(codes.Internal,
+			"Invalid SWx RandAutn len (%d, expected: %d) in Response: %+v", len(ra), aka.RandAutnLen, ans)
+	}
+	res := &tgppAuthResult{
+		rand:    ra[:aka.RAND_LEN],
+		autn:    ra[aka.RAND_LEN:aka.RandAutnLen],
+		xres:    av.GetXres(),
+		ckPrime: av.GetConfidentialityKey(),
+		ikPrime: av.GetIntegrityKey(),
+		sid:     ans.GetSessionId(),
+		profile: ans.GetUserProfile(),
+	}
+	if s.DeriveCkIkPrime() {
+		res.ckPrime, res.ikPrime = akaprime.MakeCKIKPrime(res.ckPrime, res.ikPrime, res.autn, s.NetworkName())
+	}
+	return res, nil
+}
+
+// newChallengeRequest creates a new, unsigned EAP-Request/AKA'-Challenge packet with
+// AT_RAND, AT_AUTN, AT_KDF_INPUT, AT_KDF & zeroed AT_MAC attributes, it returns the packet &
+// AT_MAC value offset within the packet
+func newChallengeRequest(identifier uint8, rand, autn []byte, networkName string) (eap.Packet, int, error) {
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{akaprime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	p, err := p.Append(eap.NewAttribute(aka.AT_RAND, append([]byte{0, 0}, rand...)))
+	if err != nil {
+		return p, 0, err
+	}
+	p, err = p.Append(eap.NewAttribute(aka.AT_AUTN, append([]byte{0, 0}, autn...)))
+	if err != nil {
+		return p, 0, err
+	}
+	// AT_KDF_INPUT: 2 bytes of actual network name length followed by the name, see RFC 5448, 3.1
+	nameLen := len(networkName)
+	p, err = p.Append(eap.NewAttribute(
+		akaprime.AT_KDF_INPUT, append([]byte{byte(nameLen >> 8), byte(nameLen)}, networkName...)))
+	if err != nil {
+		return p, 0, err
+	}
+	// AT_KDF: the only KDF we offer, see RFC 5448, 3.2
+	p, err = p.Append(eap.NewAttribute(
+		akaprime.AT_KDF, []byte{byte(akaprime.KDF_AKA_PRIME >> 8), byte(akaprime.KDF_AKA_PRIME)}))
+	if err != nil {
+		return p, 0, err
+	}
+	atMacOffset := len(p) + aka.ATT_HDR_LEN
+	p, err = p.Append(eap.NewAttribute(aka.AT_MAC, append([]byte{0, 0}, make([]byte, aka.MAC_LEN)...)))
+	return p, atMacOffset, err
+}
+
+func createChallengeRequest(
+	s *servicers.EapAkaPrimeSrv,
+	lockedCtx *servicers.UserCtx,
+	identifier uint8,
+	resyncInfo []byte) (eap.Packet, error) {
+
+	authRes, err := getSwxVector(s, string(lockedCtx.Imsi), resyncInfo)
+	if err != nil {
+		var (
+			code codes.Code
+			msg  string
+		)
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			code = se.GRPCStatus().Code()
+			msg = se.GRPCStatus().Message()
+		} else {
+			code = codes.Internal
+			msg = err.Error()
+		}
+		glog.Errorf("AKA' RPC [%s] %s", code, msg)
+		return akaprime.NewAKAPrimeNotificationReq(identifier, aka.NOTIFICATION_FAILURE), nil
+	}
+	identifier++
+
+	p, atMacOffset, err := newChallengeRequest(identifier, authRes.rand, authRes.autn, s.NetworkName())
+	if err != nil {
+		return akaprime.EapErrorResPacket(identifier-1, aka.NOTIFICATION_FAILURE, codes.Internal,
+			"Failed to create AKA' Challenge for IMSI %s: %v", lockedCtx.Imsi, err)
+	}
+	lockedCtx.Identifier = identifier
+	lockedCtx.Rand = authRes.rand
+	lockedCtx.Xres = authRes.xres
+	lockedCtx.AuthSessionId = authRes.sid
+	lockedCtx.Profile = authRes.profile
+
+	// Calculate AT_MAC
+	_, lockedCtx.K_aut, _, lockedCtx.MSK, _ = akaprime.MakeAKAPrimeKeys(
+		[]byte(lockedCtx.Identity), authRes.ikPrime, authRes.ckPrime)
+	mac := akaprime.GenMac(p, lockedCtx.K_aut)
+	// Set AT_MAC
+	copy(p[atMacOffset:], mac)
+	return p, nil
+}
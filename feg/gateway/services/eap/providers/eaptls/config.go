@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eaptls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	managed_configs "magma/gateway/mconfig"
+)
+
+// GetConfig returns EAP-TLS configuration from AAA server mconfig
+func GetConfig() (*mconfig.EapTlsConfig, error) {
+	aaaConfigs := &mconfig.AAAConfig{}
+	err := managed_configs.GetServiceConfigs(AAAServiceName, aaaConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s service configs: %v", AAAServiceName, err)
+	}
+	cfg := aaaConfigs.GetEapTlsConfig()
+	if cfg == nil {
+		return nil, errors.New("missing EAP-TLS configuration")
+	}
+	return cfg, nil
+}
+
+// NewTLSConfig returns TLS server configuration for the given EAP-TLS mconfig & client authentication policy
+// Only TLS 1.2 is negotiated: older versions are insecure & EAP-TLS 1.3 (RFC 9190) framing & key derivation are different
+func NewTLSConfig(cfg *mconfig.EapTlsConfig, clientAuth tls.ClientAuthType) (*tls.Config, error) {
+	if cfg == nil {
+		return nil, errors.New("nil EAP-TLS configuration")
+	}
+	if len(cfg.GetServerCertFile()) == 0 || len(cfg.GetServerKeyFile()) == 0 {
+		return nil, errors.New("EAP-TLS server certificate & key files must be configured")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.GetServerCertFile(), cfg.GetServerKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load EAP-TLS server certificate: %v", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates:           []tls.Certificate{cert},
+		ClientAuth:             clientAuth,
+		MinVersion:             tls.VersionTLS12,
+		MaxVersion:             tls.VersionTLS12,
+		SessionTicketsDisabled: !cfg.GetSessionResumptionEnabled(),
+	}
+	if len(cfg.GetCaCertFile()) > 0 {
+		caPem, err := os.ReadFile(cfg.GetCaCertFile())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read EAP-TLS CA certificate file: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("no valid certificates found in CA certificate file %s", cfg.GetCaCertFile())
+		}
+		tlsConfig.ClientCAs = pool
+	} else if clientAuth >= tls.VerifyClientCertIfGiven {
+		return nil, errors.New("CA certificate file must be configured to verify client certificates")
+	}
+	return tlsConfig, nil
+}
+
+// FragmentSize returns configured or default EAP-TLS fragment size
+func FragmentSize(cfg *mconfig.EapTlsConfig) int {
+	fs := int(cfg.GetFragmentSize())
+	if fs == 0 {
+		return DefaultFragmentSize
+	}
+	if fs < MinFragmentSize {
+		return MinFragmentSize
+	}
+	if fs > MaxFragmentSize {
+		return MaxFragmentSize
+	}
+	return fs
+}
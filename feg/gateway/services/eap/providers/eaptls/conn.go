@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eaptls
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// pipeConn is an in-memory net.Conn used to run crypto/tls over EAP. TLS data received from a peer is fed into
+// the connection by Engine.Step, data written by TLS is accumulated & returned by the Step as the next TLS flight.
+// pipeConn signals idle when TLS is blocked waiting for more peer data, which indicates that its current flight
+// is complete
+type pipeConn struct {
+	in     bytes.Buffer
+	out    bytes.Buffer
+	feed   chan []byte
+	idle   chan struct{}
+	quit   chan struct{}
+	closed int32
+}
+
+func newPipeConn() *pipeConn {
+	return &pipeConn{feed: make(chan []byte), idle: make(chan struct{}), quit: make(chan struct{})}
+}
+
+// Read implements net.Conn, it blocks until new data is fed from the EAP side
+func (c *pipeConn) Read(b []byte) (int, error) {
+	for c.in.Len() == 0 {
+		if atomic.LoadInt32(&c.closed) != 0 {
+			return 0, io.EOF
+		}
+		select {
+		case c.idle <- struct{}{}:
+		case <-c.quit:
+			atomic.StoreInt32(&c.closed, 1)
+			return 0, io.EOF
+		}
+		select {
+		case data := <-c.feed:
+			c.in.Write(data)
+		case <-c.quit:
+			atomic.StoreInt32(&c.closed, 1)
+			return 0, io.EOF
+		}
+	}
+	return c.in.Read(b)
+}
+
+// Write implements net.Conn, it appends data to the outgoing flight
+func (c *pipeConn) Write(b []byte) (int, error) {
+	if atomic.LoadInt32(&c.closed) != 0 {
+		return 0, errors.New("write on closed EAP-TLS connection")
+	}
+	return c.out.Write(b)
+}
+
+func (c *pipeConn) Close() error                       { return nil }
+func (c *pipeConn) LocalAddr() net.Addr                { return eapAddr{} }
+func (c *pipeConn) RemoteAddr() net.Addr               { return eapAddr{} }
+func (c *pipeConn) SetDeadline(t time.Time) error      { return nil }
+func (c *pipeConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *pipeConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type eapAddr struct{}
+
+func (eapAddr) Network() string { return "eap" }
+func (eapAddr) String() string  { return "eap" }
+
+// Engine drives a TLS connection over EAP one flight at a time. The TLS side (client or server) is executed by
+// a worker routine passed to NewEngine, the EAP side feeds peer's flights into the engine & receives TLS
+// flights to be sent to the peer
+type Engine struct {
+	conn       *pipeConn
+	run        func(net.Conn) error
+	done       chan error
+	started    bool
+	finished   bool
+	closed     bool
+	terminated bool
+	err        error
+}
+
+// NewEngine returns a new Engine for the given TLS worker function,
+// the worker is started on the first call to Step
+func NewEngine(run func(net.Conn) error) *Engine {
+	return &Engine{conn: newPipeConn(), run: run, done: make(chan error, 1)}
+}
+
+// Step feeds in data into TLS & waits for TLS to either complete the next outgoing flight or finish.
+// Step returns the outgoing flight, finished flag & the worker's error, if any.
+// Step is not thread safe, the caller must serialize calls to Step & Close
+func (e *Engine) Step(in []byte, timeout time.Duration) (out []byte, finished bool, err error) {
+	if e.finished {
+		return nil, true, e.err
+	}
+	if e.closed {
+		return nil, false, errors.New("EAP-TLS engine is closed")
+	}
+	if !e.started {
+		e.started = true
+		go func() { e.done <- e.run(e.conn) }()
+		if err = e.wait(timeout); err != nil || e.finished {
+			return e.flight(), e.finished, err
+		}
+	}
+	if len(in) > 0 {
+		e.conn.feed <- in
+		err = e.wait(timeout)
+	}
+	return e.flight(), e.finished, err
+}
+
+// Close terminates the worker if it's still running
+func (e *Engine) Close() {
+	if e.terminated {
+		return
+	}
+	e.closed, e.terminated = true, true
+	close(e.conn.quit)
+}
+
+// Finished returns true if the worker completed
+func (e *Engine) Finished() bool {
+	return e.finished
+}
+
+func (e *Engine) wait(timeout time.Duration) error {
+	select {
+	case <-e.conn.idle:
+		return nil
+	case e.err = <-e.done:
+		e.finished = true
+		return e.err
+	case <-time.After(timeout):
+		// the worker may still be running, the engine cannot be used anymore
+		e.closed = true
+		return errors.New("EAP-TLS processing timeout")
+	}
+}
+
+func (e *Engine) flight() []byte {
+	if e.closed && !e.finished {
+		return nil
+	}
+	if e.conn.out.Len() == 0 {
+		return nil
+	}
+	res := make([]byte, e.conn.out.Len())
+	copy(res, e.conn.out.Bytes())
+	e.conn.out.Reset()
+	return res
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eaptls implements EAP-TLS (RFC 5216) framing, fragmentation & TLS session handling shared by
+// EAP-TLS & EAP-TTLS providers
+package eaptls
+
+import (
+	"time"
+
+	"magma/feg/gateway/services/aaa/protos"
+)
+
+const (
+	TYPE = uint8(protos.EapType_TLS)
+
+	// AAAServiceName is the name of the service which mconfig carries EAP-TLS/TTLS configuration
+	AAAServiceName = "aaa_server"
+
+	// EAP-TLS Flags, see https://tools.ietf.org/html/rfc5216#section-3.1
+	FlagLengthIncluded uint8 = 0x80
+	FlagMoreFragments  uint8 = 0x40
+	FlagStart          uint8 = 0x20
+	FlagVersionMask    uint8 = 0x07
+
+	// EapTlsFlagsOffset is the offset of the Flags octet in EAP-TLS packet
+	EapTlsFlagsOffset = 5
+	// EapTlsHeaderLen is the length of EAP-TLS header: EAP header + Type + Flags
+	EapTlsHeaderLen = 6
+	// TlsMessageLengthLen is the length of optional TLS Message Length field
+	TlsMessageLengthLen = 4
+
+	DefaultFragmentSize = 1024
+	MinFragmentSize     = 64
+	MaxFragmentSize     = 4096
+	// MaxTlsMessageLen limits the size of reassembled TLS messages received from a peer
+	MaxTlsMessageLen = 64 * 1024
+
+	// MSKLen is the length of Master Session Key, see https://tools.ietf.org/html/rfc5216#section-2.3
+	MSKLen = 64
+	// KeyMaterialLen is the length of exported Key Material: MSK + EMSK
+	KeyMaterialLen = 128
+	// KeyMaterialLabel is the EAP-TLS Key Material PRF label
+	KeyMaterialLabel = "client EAP encryption"
+
+	DefaultSessionTimeout = time.Second * 30
+	// DefaultStepTimeout is the max time TLS processing of a single peer message may take
+	DefaultStepTimeout = time.Second * 5
+)
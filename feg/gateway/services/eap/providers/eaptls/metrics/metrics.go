@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics provides EAP-TLS & EAP-TTLS providers prometheus metrics
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Prometheus counters are monotonically increasing
+// Counters reset to zero on service restart
+// All EAP-TLS based method counters are partitioned by the method name (EAP-TLS, EAP-TTLS)
+var (
+	// Generic counters
+	Requests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_tls_requests_total",
+			Help: "Total number of EAP-TLS based method Handle requests",
+		},
+		[]string{"method"},
+	)
+	FailedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_tls_failed_requests_total",
+			Help: "Total number of failed EAP-TLS based method Handle requests",
+		},
+		[]string{"method"},
+	)
+	SessionTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_tls_session_timeouts_total",
+			Help: "Total number of EAP-TLS based method Session Timeouts",
+		},
+		[]string{"method"},
+	)
+	Fragments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_tls_fragments_total",
+			Help: "Total number of EAP-TLS fragments sent & received, partitioned by direction (in, out)",
+		},
+		[]string{"method", "direction"},
+	)
+
+	// TLS handshake metrics
+	Handshakes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_tls_handshakes_total",
+			Help: "Total number of completed TLS handshakes",
+		},
+		[]string{"method"},
+	)
+	HandshakeFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_tls_handshake_failures_total",
+			Help: "Total number of failed TLS handshakes",
+		},
+		[]string{"method"},
+	)
+	ResumedSessions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_tls_resumed_sessions_total",
+			Help: "Total number of TLS handshakes completed with session resumption",
+		},
+		[]string{"method"},
+	)
+
+	// EAP-TTLS inner (phase 2) authentication metrics
+	InnerAuthRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_ttls_inner_auth_requests_total",
+			Help: "Total number of EAP-TTLS inner authentications, partitioned by inner method (PAP, MSCHAPV2)",
+		},
+		[]string{"inner_method"},
+	)
+	InnerAuthFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eap_ttls_inner_auth_failures_total",
+			Help: "Total number of failed EAP-TTLS inner authentications, partitioned by inner method (PAP, MSCHAPV2)",
+		},
+		[]string{"inner_method"},
+	)
+
+	// Latencies
+	AuthLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name:       "eap_tls_auth_lat",
+			Help:       "Latency of EAP-TLS based Authentication round (seconds). Only calculated for completed authentications.",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		},
+		[]string{"method"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(Requests, FailedRequests, SessionTimeouts, Fragments,
+		Handshakes, HandshakeFailures, ResumedSessions,
+		InnerAuthRequests, InnerAuthFailures,
+		AuthLatency)
+}
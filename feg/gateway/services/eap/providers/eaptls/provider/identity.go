@@ -0,0 +1,39 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provider implements EAP-TLS provider
+package provider
+
+import (
+	"bytes"
+	"regexp"
+)
+
+// SIM based methods identities start with a single digit prefix followed by IMSI,
+// anonymous identities are expected to be handled by EAP-TTLS
+var (
+	simIdentityRe = regexp.MustCompile(`^\d{7,16}@`)
+	tlsIdentityRe = regexp.MustCompile(`^[^@\s]+@\w(?:\w|\.|-)*\w$`)
+)
+
+var anonymousUser = []byte("anonymous@")
+
+// WillHandleIdentity returns true if the provider 1) recognizes the given Identity and 2) can hendle authentication
+// for this type of identity.
+// EAP-TLS will handle non SIM based, non anonymous NAI identities (user@realm)
+func (p *providerImpl) WillHandleIdentity(identityData []byte) bool {
+	return len(identityData) > 2 &&
+		!simIdentityRe.Match(identityData) &&
+		!bytes.HasPrefix(identityData, anonymousUser) &&
+		tlsIdentityRe.Match(identityData)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provider implements EAP-TLS provider
+package provider
+
+import (
+	"crypto/tls"
+	"errors"
+	"sync"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers"
+	"magma/feg/gateway/services/eap/providers/eaptls"
+)
+
+// EAP-TLS Provider Implementation
+type providerImpl struct {
+	sync.RWMutex
+	*eaptls.Server
+	cfg *mconfig.EapTlsConfig
+}
+
+// New returns EAP-TLS provider, the provider gets its configuration from AAA server mconfig on first use
+func New() providers.Method {
+	return &providerImpl{}
+}
+
+// NewWithConfig returns EAP-TLS provider for the given configuration
+func NewWithConfig(cfg *mconfig.EapTlsConfig) providers.Method {
+	return &providerImpl{cfg: cfg}
+}
+
+// String returns EAP TLS Provider name/info
+func (*providerImpl) String() string {
+	return "EAP-TLS"
+}
+
+// EAPType returns EAP TLS Type - 13
+func (*providerImpl) EAPType() uint8 {
+	return eaptls.TYPE
+}
+
+// Handle handles passed EAP-TLS payload & returns corresponding result
+func (prov *providerImpl) Handle(msg *protos.Eap) (*protos.Eap, error) {
+	if msg == nil {
+		return nil, errors.New("Invalid EAP TLS Message")
+	}
+	prov.RLock()
+	if prov.Server == nil {
+		// server is not initialized, relock, recheck, create
+		prov.RUnlock()
+		prov.Lock()
+		if prov.Server == nil {
+			srv, err := prov.newServer()
+			if err != nil {
+				prov.Unlock()
+				return &protos.Eap{Payload: eap.Packet(msg.GetPayload()).Failure(), Ctx: msg.GetCtx()}, err
+			}
+			prov.Server = srv
+		}
+		prov.Unlock()
+		prov.RLock()
+	}
+	defer prov.RUnlock()
+	return prov.Server.Handle(msg)
+}
+
+func (prov *providerImpl) newServer() (*eaptls.Server, error) {
+	cfg := prov.cfg
+	if cfg == nil {
+		var err error
+		if cfg, err = eaptls.GetConfig(); err != nil {
+			return nil, err
+		}
+	}
+	tlsConfig, err := eaptls.NewTLSConfig(cfg, tls.RequireAndVerifyClientCert)
+	if err != nil {
+		return nil, err
+	}
+	return eaptls.NewServer(eaptls.Config{
+		Name:         prov.String(),
+		Type:         eaptls.TYPE,
+		TLS:          tlsConfig,
+		FragmentSize: eaptls.FragmentSize(cfg),
+		KeyLabel:     eaptls.KeyMaterialLabel,
+	})
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/eaptls"
+	"magma/feg/gateway/services/eap/providers/eaptls/test"
+)
+
+func TestEapTlsProvider(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	p := NewWithConfig(&mconfig.EapTlsConfig{
+		CaCertFile:               certs.CaCertFile,
+		ServerCertFile:           certs.ServerCertFile,
+		ServerKeyFile:            certs.ServerKeyFile,
+		SessionResumptionEnabled: true,
+	})
+	assert.Equal(t, eaptls.TYPE, p.EAPType())
+
+	peer := test.NewPeer(eaptls.TYPE, certs.ClientTLSConfig(true), eaptls.KeyMaterialLabel, nil)
+	resp, err := peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid1"}, "tls_user@magma.test")
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+	assert.Equal(t, peer.Msk, resp.GetCtx().GetMsk())
+
+	// Invalid configuration
+	p = NewWithConfig(&mconfig.EapTlsConfig{ServerCertFile: certs.ServerCertFile})
+	resp, err = p.Handle(&protos.Eap{
+		Payload: eap.NewPacket(eap.ResponseCode, 3, append([]byte{eap.MethodIdentity}, "tls_user@magma.test"...)),
+		Ctx:     &protos.Context{SessionId: "sid2"}})
+	assert.Error(t, err)
+	assert.Equal(t, []byte{eap.FailureCode, 3, 0, 4}, resp.GetPayload())
+}
+
+func TestEapTlsWillHandleIdentity(t *testing.T) {
+	p := New()
+	assert.True(t, p.WillHandleIdentity([]byte("user@magma.test")))
+	assert.True(t, p.WillHandleIdentity([]byte("host/laptop.corp@corp.example.com")))
+	assert.False(t, p.WillHandleIdentity([]byte("0001010000000001@wlan.mnc001.mcc001.3gppnetwork.org")))
+	assert.False(t, p.WillHandleIdentity([]byte("6001010000000001@wlan.mnc001.mcc001.3gppnetwork.org")))
+	assert.False(t, p.WillHandleIdentity([]byte("anonymous@magma.test")))
+	assert.False(t, p.WillHandleIdentity([]byte("user")))
+}
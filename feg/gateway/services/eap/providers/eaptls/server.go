@@ -0,0 +1,374 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eaptls
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/eaptls/metrics"
+)
+
+// Result holds the outcome of a completed EAP-TLS based authentication
+type Result struct {
+	// Identity is the authenticated identity, if set it overwrites the EAP context identity
+	Identity string
+	// Msk is the Master Session Key derived from the TLS session
+	Msk []byte
+	// Resumed is true if the TLS session was resumed
+	Resumed bool
+	// PeerCertificates are the certificates presented by the peer
+	PeerCertificates [][]byte
+}
+
+// Config defines EAP-TLS based method parameters
+type Config struct {
+	// Name is the method name used for logging & metrics
+	Name string
+	// Type is the EAP method type
+	Type uint8
+	// TLS is the server TLS configuration, sessions resumption is controlled by TLS.SessionTicketsDisabled
+	TLS *tls.Config
+	// FragmentSize is the max size of TLS data carried by a single EAP request
+	FragmentSize int
+	// KeyLabel is the PRF label used to export the MSK
+	KeyLabel string
+	// SessionTimeout is the max time between subsequent messages of an authentication exchange
+	SessionTimeout time.Duration
+	// Phase2, if set, is called after successful TLS handshake from the TLS worker routine to carry out
+	// inner authentication over the established TLS connection (EAP-TTLS).
+	// Data written to conn by Phase2 before successful return will be sent to the peer prior to EAP-Success
+	Phase2 func(conn *tls.Conn, res *Result) error
+}
+
+type sessionState int
+
+const (
+	stateStart sessionState = iota
+	stateHandshake
+	stateSuccessPending
+)
+
+type session struct {
+	sync.Mutex
+	id        string
+	state     sessionState
+	engine    *Engine
+	result    *Result
+	in        []byte // reassembled incoming TLS message
+	inLen     int    // total incoming TLS message length, if provided by the peer
+	out       []byte // outgoing TLS data not sent yet
+	outLen    int    // total length of the outgoing TLS message
+	timer     *time.Timer
+	startTime time.Time
+	closed    bool
+}
+
+// Server implements EAP-TLS framing, fragmentation & session management for EAP-TLS based methods
+type Server struct {
+	cfg      Config
+	rwl      sync.RWMutex
+	sessions map[string]*session
+}
+
+// NewServer returns a new Server for the given method configuration
+func NewServer(cfg Config) (*Server, error) {
+	if cfg.TLS == nil {
+		return nil, errors.New("nil TLS configuration")
+	}
+	if cfg.FragmentSize <= 0 {
+		cfg.FragmentSize = DefaultFragmentSize
+	}
+	if cfg.SessionTimeout <= 0 {
+		cfg.SessionTimeout = DefaultSessionTimeout
+	}
+	if len(cfg.KeyLabel) == 0 {
+		cfg.KeyLabel = KeyMaterialLabel
+	}
+	if len(cfg.Name) == 0 {
+		cfg.Name = protos.EapType(cfg.Type).String()
+	}
+	return &Server{cfg: cfg, sessions: map[string]*session{}}, nil
+}
+
+// Handle handles EAP-Response/Identity & EAP-TLS based method responses & returns corresponding EAP message
+func (s *Server) Handle(msg *protos.Eap) (*protos.Eap, error) {
+	metrics.Requests.WithLabelValues(s.cfg.Name).Inc()
+	resp, err := s.handle(msg)
+	if err != nil {
+		metrics.FailedRequests.WithLabelValues(s.cfg.Name).Inc()
+	}
+	return resp, err
+}
+
+func (s *Server) handle(msg *protos.Eap) (*protos.Eap, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("nil %s message", s.cfg.Name)
+	}
+	if msg.Ctx == nil {
+		msg.Ctx = &protos.Context{}
+	}
+	ctx := msg.Ctx
+	p := eap.Packet(msg.GetPayload())
+	if err := p.Validate(); err != nil {
+		if len(p) >= eap.EapHeaderLen && len(p) > p.Len() {
+			p = p.Truncate()
+		} else {
+			return failure(ctx, p, err)
+		}
+	}
+	switch p.Type() {
+	case eap.MethodIdentity:
+		return s.start(ctx, p)
+	case s.cfg.Type:
+		return s.continueSession(ctx, p)
+	default:
+		return failure(ctx, p, fmt.Errorf("unexpected EAP method type %d for %s", p.Type(), s.cfg.Name))
+	}
+}
+
+// start creates a new session & returns EAP-TLS Start request
+func (s *Server) start(ctx *protos.Context, p eap.Packet) (*protos.Eap, error) {
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+		glog.Warningf("Missing Session ID for %s; Generated new SID: %s", s.cfg.Name, ctx.SessionId)
+	}
+	sess := &session{id: ctx.SessionId, startTime: time.Now()}
+	sess.engine = NewEngine(s.serverWorker(sess))
+	s.rwl.Lock()
+	if old, ok := s.sessions[sess.id]; ok {
+		glog.Warningf("%s: overwriting existing session %s", s.cfg.Name, sess.id)
+		go old.close()
+	}
+	s.sessions[sess.id] = sess
+	sess.timer = time.AfterFunc(s.cfg.SessionTimeout, func() { s.timeoutSession(sess) })
+	s.rwl.Unlock()
+
+	return &protos.Eap{
+		Payload: eap.NewPacket(eap.RequestCode, p.Identifier()+1, []byte{s.cfg.Type, FlagStart}),
+		Ctx:     ctx}, nil
+}
+
+func (s *Server) continueSession(ctx *protos.Context, p eap.Packet) (*protos.Eap, error) {
+	s.rwl.RLock()
+	sess, ok := s.sessions[ctx.GetSessionId()]
+	s.rwl.RUnlock()
+	if !ok {
+		return failure(ctx, p, fmt.Errorf("%s: unknown session '%s'", s.cfg.Name, ctx.GetSessionId()))
+	}
+	sess.Lock()
+	defer sess.Unlock()
+	if sess.closed {
+		return failure(ctx, p, fmt.Errorf("%s: session '%s' is closed", s.cfg.Name, sess.id))
+	}
+	sess.timer.Reset(s.cfg.SessionTimeout)
+	resp, err := s.handleResponse(sess, ctx, p)
+	if err != nil || eap.Packet(resp.GetPayload()).Code() != eap.RequestCode {
+		s.removeSession(sess)
+	}
+	return resp, err
+}
+
+// handleResponse processes EAP-TLS response for the session, it must be called with the session locked
+func (s *Server) handleResponse(sess *session, ctx *protos.Context, p eap.Packet) (*protos.Eap, error) {
+	td := p.TypeData()
+	if len(td) < 1 {
+		return failure(ctx, p, fmt.Errorf("%s response is missing flags", s.cfg.Name))
+	}
+	flags, data := td[0], td[1:]
+	if flags&FlagLengthIncluded != 0 {
+		if len(data) < TlsMessageLengthLen {
+			return failure(ctx, p, fmt.Errorf("%s response is missing TLS Message Length", s.cfg.Name))
+		}
+		sess.inLen = int(binary.BigEndian.Uint32(data))
+		data = data[TlsMessageLengthLen:]
+		if sess.inLen > MaxTlsMessageLen {
+			return failure(ctx, p, fmt.Errorf("%s TLS message length %d exceeds %d", s.cfg.Name, sess.inLen, MaxTlsMessageLen))
+		}
+	}
+	identifier := p.Identifier()
+	// Outgoing fragments are pending, the peer must acknowledge each fragment with an empty response
+	if len(sess.out) > 0 {
+		if len(data) > 0 || flags&FlagMoreFragments != 0 {
+			return failure(ctx, p, fmt.Errorf("%s: expected fragment ACK, received %d bytes", s.cfg.Name, len(data)))
+		}
+		return s.nextFragment(sess, ctx, identifier), nil
+	}
+	if sess.state == stateSuccessPending {
+		if len(data) > 0 || flags&FlagMoreFragments != 0 {
+			return failure(ctx, p, fmt.Errorf("%s: expected final ACK, received %d bytes", s.cfg.Name, len(data)))
+		}
+		return s.success(sess, ctx, identifier), nil
+	}
+	if len(sess.in)+len(data) > MaxTlsMessageLen {
+		return failure(ctx, p, fmt.Errorf("%s TLS message exceeds %d bytes", s.cfg.Name, MaxTlsMessageLen))
+	}
+	sess.in = append(sess.in, data...)
+	if flags&FlagMoreFragments != 0 {
+		metrics.Fragments.WithLabelValues(s.cfg.Name, "in").Inc()
+		// ACK the fragment
+		return &protos.Eap{
+			Payload: eap.NewPacket(eap.RequestCode, identifier+1, []byte{s.cfg.Type, 0}),
+			Ctx:     ctx}, nil
+	}
+	record := sess.in
+	sess.in, sess.inLen = nil, 0
+	if len(record) == 0 {
+		return failure(ctx, p, fmt.Errorf("%s: unexpected empty response in state %d", s.cfg.Name, sess.state))
+	}
+	sess.state = stateHandshake
+	out, finished, err := sess.engine.Step(record, DefaultStepTimeout)
+	if err != nil {
+		return failure(ctx, p, fmt.Errorf("%s session '%s' failure: %v", s.cfg.Name, sess.id, err))
+	}
+	if finished {
+		if len(out) == 0 {
+			return s.success(sess, ctx, identifier), nil
+		}
+		sess.state = stateSuccessPending
+	}
+	if len(out) == 0 {
+		// Incomplete TLS flight from the peer, solicit more data
+		return &protos.Eap{
+			Payload: eap.NewPacket(eap.RequestCode, identifier+1, []byte{s.cfg.Type, 0}),
+			Ctx:     ctx}, nil
+	}
+	sess.out, sess.outLen = out, len(out)
+	return s.nextFragment(sess, ctx, identifier), nil
+}
+
+// nextFragment returns EAP-TLS request with the next fragment of pending outgoing TLS data
+func (s *Server) nextFragment(sess *session, ctx *protos.Context, identifier uint8) *protos.Eap {
+	var flags uint8
+	chunk := sess.out
+	first := len(sess.out) == sess.outLen
+	if len(chunk) > s.cfg.FragmentSize {
+		chunk = chunk[:s.cfg.FragmentSize]
+		flags |= FlagMoreFragments
+		if first {
+			flags |= FlagLengthIncluded
+		}
+		metrics.Fragments.WithLabelValues(s.cfg.Name, "out").Inc()
+	}
+	sess.out = sess.out[len(chunk):]
+	data := make([]byte, 2, len(chunk)+2+TlsMessageLengthLen)
+	data[0], data[1] = s.cfg.Type, flags
+	if flags&FlagLengthIncluded != 0 {
+		data = data[:2+TlsMessageLengthLen]
+		binary.BigEndian.PutUint32(data[2:], uint32(sess.outLen))
+	}
+	data = append(data, chunk...)
+	if len(sess.out) == 0 {
+		sess.out, sess.outLen = nil, 0
+	}
+	return &protos.Eap{Payload: eap.NewPacket(eap.RequestCode, identifier+1, data), Ctx: ctx}
+}
+
+// success returns EAP-Success & updates the context with session's keys & identity
+func (s *Server) success(sess *session, ctx *protos.Context, identifier uint8) *protos.Eap {
+	if res := sess.result; res != nil {
+		ctx.Msk = res.Msk
+		if len(res.Identity) > 0 {
+			ctx.Identity = res.Identity
+		}
+	}
+	metrics.AuthLatency.WithLabelValues(s.cfg.Name).Observe(time.Since(sess.startTime).Seconds())
+	return &protos.Eap{Payload: eap.NewPacket(eap.SuccessCode, identifier, nil), Ctx: ctx}
+}
+
+// serverWorker returns TLS worker routine for the session
+func (s *Server) serverWorker(sess *session) func(net.Conn) error {
+	return func(conn net.Conn) error {
+		tlsConn := tls.Server(conn, s.cfg.TLS)
+		if err := tlsConn.Handshake(); err != nil {
+			metrics.HandshakeFailures.WithLabelValues(s.cfg.Name).Inc()
+			return err
+		}
+		metrics.Handshakes.WithLabelValues(s.cfg.Name).Inc()
+		state := tlsConn.ConnectionState()
+		if state.DidResume {
+			metrics.ResumedSessions.WithLabelValues(s.cfg.Name).Inc()
+		}
+		keys, err := state.ExportKeyingMaterial(s.cfg.KeyLabel, nil, KeyMaterialLen)
+		if err != nil {
+			return fmt.Errorf("key material export error: %v", err)
+		}
+		res := &Result{Msk: keys[:MSKLen], Resumed: state.DidResume}
+		for _, c := range state.PeerCertificates {
+			res.PeerCertificates = append(res.PeerCertificates, c.Raw)
+		}
+		if len(state.PeerCertificates) > 0 {
+			res.Identity = state.PeerCertificates[0].Subject.CommonName
+		}
+		if s.cfg.Phase2 != nil {
+			if err = s.cfg.Phase2(tlsConn, res); err != nil {
+				return err
+			}
+		}
+		// result is consumed by the EAP side after the worker completes (engine's done channel synchronizes)
+		sess.result = res
+		return nil
+	}
+}
+
+func (s *Server) removeSession(sess *session) {
+	s.rwl.Lock()
+	if current, ok := s.sessions[sess.id]; ok && current == sess {
+		delete(s.sessions, sess.id)
+	}
+	s.rwl.Unlock()
+	sess.timer.Stop()
+	sess.closed = true
+	sess.engine.Close()
+}
+
+func (s *Server) timeoutSession(sess *session) {
+	sess.Lock()
+	defer sess.Unlock()
+	if sess.closed {
+		return
+	}
+	glog.V(1).Infof("%s session '%s' timed out", s.cfg.Name, sess.id)
+	metrics.SessionTimeouts.WithLabelValues(s.cfg.Name).Inc()
+	s.removeSession(sess)
+}
+
+// close closes an orphaned session
+func (sess *session) close() {
+	sess.Lock()
+	defer sess.Unlock()
+	if !sess.closed {
+		sess.closed = true
+		sess.timer.Stop()
+		sess.engine.Close()
+	}
+}
+
+// failure returns EAP-Failure message with the identifier of p & the error
+func failure(ctx *protos.Context, p eap.Packet, err error) (*protos.Eap, error) {
+	var identifier uint8
+	if len(p) > eap.EapMsgIdentifier {
+		identifier = p.Identifier()
+	}
+	return &protos.Eap{Payload: eap.NewPacket(eap.FailureCode, identifier, nil), Ctx: ctx}, err
+}
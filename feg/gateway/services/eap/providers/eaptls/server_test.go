@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eaptls_test
+
+import (
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/eaptls"
+	"magma/feg/gateway/services/eap/providers/eaptls/test"
+)
+
+func newTestServer(t *testing.T, certs *test.Certs, resumption bool, fragmentSize int) *eaptls.Server {
+	tlsConfig, err := eaptls.NewTLSConfig(&mconfig.EapTlsConfig{
+		CaCertFile:               certs.CaCertFile,
+		ServerCertFile:           certs.ServerCertFile,
+		ServerKeyFile:            certs.ServerKeyFile,
+		SessionResumptionEnabled: resumption,
+	}, tls.RequireAndVerifyClientCert)
+	assert.NoError(t, err)
+	srv, err := eaptls.NewServer(eaptls.Config{
+		Type:         eaptls.TYPE,
+		TLS:          tlsConfig,
+		FragmentSize: fragmentSize,
+	})
+	assert.NoError(t, err)
+	return srv
+}
+
+func TestEapTlsFragmentation(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	srv := newTestServer(t, certs, false, 128)
+	peer := test.NewPeer(eaptls.TYPE, certs.ClientTLSConfig(true), eaptls.KeyMaterialLabel, nil)
+	peer.FragmentSize = 100
+
+	ctx := &protos.Context{SessionId: "sid1"}
+	resp, err := peer.Authenticate(srv.Handle, ctx, "tls_user@magma.test")
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+	assert.Len(t, peer.Msk, eaptls.MSKLen)
+	assert.Equal(t, peer.Msk, resp.GetCtx().GetMsk())
+	assert.Equal(t, test.ClientName, resp.GetCtx().GetIdentity())
+	assert.Greater(t, peer.FragmentsReceived, 1)
+	assert.False(t, peer.Resumed)
+}
+
+func TestEapTlsResumption(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	peer := test.NewPeer(eaptls.TYPE, certs.ClientTLSConfig(true), eaptls.KeyMaterialLabel, nil)
+	// avoid fragmentation to compare full & abbreviated handshake rounds
+	peer.FragmentSize = eaptls.MaxFragmentSize
+
+	srv := newTestServer(t, certs, true, eaptls.MaxFragmentSize)
+	resp, err := peer.Authenticate(srv.Handle, &protos.Context{SessionId: "sid1"}, "tls_user@magma.test")
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+	assert.False(t, peer.Resumed)
+	fullRounds, fullMsk := peer.Rounds, peer.Msk
+
+	resp, err = peer.Authenticate(srv.Handle, &protos.Context{SessionId: "sid2"}, "tls_user@magma.test")
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+	assert.True(t, peer.Resumed)
+	assert.Less(t, peer.Rounds, fullRounds)
+	assert.Equal(t, peer.Msk, resp.GetCtx().GetMsk())
+	assert.NotEqual(t, fullMsk, peer.Msk)
+
+	// Resumption disabled
+	srv = newTestServer(t, certs, false, 0)
+	peer = test.NewPeer(eaptls.TYPE, certs.ClientTLSConfig(true), eaptls.KeyMaterialLabel, nil)
+	for _, sid := range []string{"sid3", "sid4"} {
+		resp, err = peer.Authenticate(srv.Handle, &protos.Context{SessionId: sid}, "tls_user@magma.test")
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+		assert.False(t, peer.Resumed)
+	}
+}
+
+func TestEapTlsNoClientCert(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	srv := newTestServer(t, certs, true, 0)
+	peer := test.NewPeer(eaptls.TYPE, certs.ClientTLSConfig(false), eaptls.KeyMaterialLabel, nil)
+
+	resp, err := peer.Authenticate(srv.Handle, &protos.Context{SessionId: "sid1"}, "tls_user@magma.test")
+	assert.Error(t, err)
+	assert.Equal(t, uint8(eap.FailureCode), eap.Packet(resp.GetPayload()).Code())
+	assert.Empty(t, resp.GetCtx().GetMsk())
+}
+
+func TestEapTlsSessionTimeout(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	tlsConfig, err := eaptls.NewTLSConfig(&mconfig.EapTlsConfig{
+		CaCertFile:     certs.CaCertFile,
+		ServerCertFile: certs.ServerCertFile,
+		ServerKeyFile:  certs.ServerKeyFile,
+	}, tls.RequireAndVerifyClientCert)
+	assert.NoError(t, err)
+	srv, err := eaptls.NewServer(eaptls.Config{
+		Type: eaptls.TYPE, TLS: tlsConfig, SessionTimeout: time.Millisecond * 100})
+	assert.NoError(t, err)
+
+	ctx := &protos.Context{SessionId: "sid1"}
+	resp, err := srv.Handle(&protos.Eap{
+		Payload: eap.NewPacket(eap.ResponseCode, 1, append([]byte{eap.MethodIdentity}, "tls_user@magma.test"...)),
+		Ctx:     ctx})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{eap.RequestCode, 2, 0, 6, eaptls.TYPE, eaptls.FlagStart}, resp.GetPayload())
+
+	peer := test.NewPeer(eaptls.TYPE, certs.ClientTLSConfig(true), eaptls.KeyMaterialLabel, nil)
+	clientHello, err := peer.Respond(resp.GetPayload())
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 300)
+	resp, err = srv.Handle(&protos.Eap{Payload: clientHello, Ctx: ctx})
+	assert.Error(t, err)
+	assert.Equal(t, []byte{eap.FailureCode, 2, 0, 4}, resp.GetPayload())
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	_, err = eaptls.NewTLSConfig(&mconfig.EapTlsConfig{}, tls.NoClientCert)
+	assert.Error(t, err)
+	_, err = eaptls.NewTLSConfig(&mconfig.EapTlsConfig{
+		ServerCertFile: certs.ServerCertFile,
+		ServerKeyFile:  certs.ServerKeyFile,
+	}, tls.RequireAndVerifyClientCert)
+	assert.Error(t, err)
+	cfg, err := eaptls.NewTLSConfig(&mconfig.EapTlsConfig{
+		ServerCertFile: certs.ServerCertFile,
+		ServerKeyFile:  certs.ServerKeyFile,
+	}, tls.NoClientCert)
+	assert.NoError(t, err)
+	assert.True(t, cfg.SessionTicketsDisabled)
+
+	assert.Equal(t, eaptls.DefaultFragmentSize, eaptls.FragmentSize(&mconfig.EapTlsConfig{}))
+	assert.Equal(t, eaptls.MinFragmentSize, eaptls.FragmentSize(&mconfig.EapTlsConfig{FragmentSize: 1}))
+	assert.Equal(t, 1400, eaptls.FragmentSize(&mconfig.EapTlsConfig{FragmentSize: 1400}))
+}
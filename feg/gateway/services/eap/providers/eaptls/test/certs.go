@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package test provides EAP-TLS based methods test peer & certificates
+package test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	ServerName = "aaa.magma.test"
+	ClientName = "user@magma.test"
+)
+
+// Certs holds test CA, server & client certificates
+type Certs struct {
+	CaCertFile     string
+	ServerCertFile string
+	ServerKeyFile  string
+	CaPool         *x509.CertPool
+	ClientCert     tls.Certificate
+}
+
+// GenerateCerts generates test CA, server & client certificates, CA & server cert & key are stored in dir
+func GenerateCerts(dir string) (*Certs, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Magma Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour * 24),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		return nil, err
+	}
+	serverDer, serverKey, err := issue(caCert, caKey, 2, ServerName, x509.ExtKeyUsageServerAuth)
+	if err != nil {
+		return nil, err
+	}
+	clientDer, clientKey, err := issue(caCert, caKey, 3, ClientName, x509.ExtKeyUsageClientAuth)
+	if err != nil {
+		return nil, err
+	}
+	serverKeyDer, err := x509.MarshalECPrivateKey(serverKey)
+	if err != nil {
+		return nil, err
+	}
+	certs := &Certs{
+		CaCertFile:     filepath.Join(dir, "ca.pem"),
+		ServerCertFile: filepath.Join(dir, "server.pem"),
+		ServerKeyFile:  filepath.Join(dir, "server.key"),
+		CaPool:         x509.NewCertPool(),
+		ClientCert:     tls.Certificate{Certificate: [][]byte{clientDer, caDer}, PrivateKey: clientKey},
+	}
+	certs.CaPool.AddCert(caCert)
+	if err = writePem(certs.CaCertFile, "CERTIFICATE", caDer); err != nil {
+		return nil, err
+	}
+	if err = writePem(certs.ServerCertFile, "CERTIFICATE", serverDer); err != nil {
+		return nil, err
+	}
+	if err = writePem(certs.ServerKeyFile, "EC PRIVATE KEY", serverKeyDer); err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+// ClientTLSConfig returns peer TLS configuration, if withCert is set, the client certificate will be presented
+func (c *Certs) ClientTLSConfig(withCert bool) *tls.Config {
+	cfg := &tls.Config{
+		RootCAs:            c.CaPool,
+		ServerName:         ServerName,
+		MaxVersion:         tls.VersionTLS12,
+		ClientSessionCache: tls.NewLRUClientSessionCache(4),
+	}
+	if withCert {
+		cfg.Certificates = []tls.Certificate{c.ClientCert}
+	}
+	return cfg
+}
+
+func issue(
+	ca *x509.Certificate, caKey *ecdsa.PrivateKey, serial int64, name string, usage x509.ExtKeyUsage,
+) ([]byte, *ecdsa.PrivateKey, error) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour * 24),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	if usage == x509.ExtKeyUsageServerAuth {
+		template.DNSNames = []string{name}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	return der, key, err
+}
+
+func writePem(path, typ string, der []byte) error {
+	return os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600)
+}
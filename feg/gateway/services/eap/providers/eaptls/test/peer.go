@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/eaptls"
+)
+
+// Peer is a test EAP-TLS based method peer (supplicant)
+type Peer struct {
+	Type         uint8
+	FragmentSize int
+	KeyLabel     string
+	// Msk is the Master Session Key derived by the peer
+	Msk []byte
+	// Resumed is set if the TLS session was resumed
+	Resumed bool
+	// Rounds is the number of EAP request/response exchanges of the last authentication
+	Rounds int
+	// FragmentsReceived is the number of fragmented requests received from the server
+	FragmentsReceived int
+
+	tlsConfig *tls.Config
+	phase2    func(*tls.Conn) error
+	engine    *eaptls.Engine
+	in        []byte
+	out       []byte
+	outLen    int
+}
+
+// NewPeer returns a new test peer for the method type, TLS configuration & optional phase 2 routine
+func NewPeer(typ uint8, tlsConfig *tls.Config, keyLabel string, phase2 func(*tls.Conn) error) *Peer {
+	return &Peer{
+		Type: typ, FragmentSize: eaptls.DefaultFragmentSize, KeyLabel: keyLabel, tlsConfig: tlsConfig, phase2: phase2}
+}
+
+// Authenticate runs EAP authentication with the given identity against the handler & returns the final
+// (Success or Failure) EAP message
+func (p *Peer) Authenticate(
+	handle func(*protos.Eap) (*protos.Eap, error), ctx *protos.Context, identity string) (*protos.Eap, error) {
+
+	p.Msk, p.Resumed, p.Rounds, p.FragmentsReceived = nil, false, 0, 0
+	defer p.close()
+
+	resp, err := handle(&protos.Eap{
+		Payload: eap.NewPacket(eap.ResponseCode, 1, append([]byte{eap.MethodIdentity}, identity...)),
+		Ctx:     ctx})
+	for p.Rounds = 1; err == nil && eap.Packet(resp.GetPayload()).Code() == eap.RequestCode; p.Rounds++ {
+		var next eap.Packet
+		next, err = p.Respond(resp.GetPayload())
+		if err != nil {
+			return resp, err
+		}
+		resp, err = handle(&protos.Eap{Payload: next, Ctx: resp.GetCtx()})
+	}
+	return resp, err
+}
+
+// Respond returns peer's response to the server's EAP request
+func (p *Peer) Respond(req eap.Packet) (eap.Packet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	td := req.TypeData()
+	if req.Type() != p.Type || len(td) < 1 {
+		return nil, fmt.Errorf("unexpected request type %d or length %d", req.Type(), len(td))
+	}
+	identifier, flags, data := req.Identifier(), td[0], td[1:]
+	if flags&eaptls.FlagLengthIncluded != 0 {
+		if len(data) < eaptls.TlsMessageLengthLen {
+			return nil, fmt.Errorf("missing TLS Message Length")
+		}
+		data = data[eaptls.TlsMessageLengthLen:]
+	}
+	if flags&eaptls.FlagStart != 0 {
+		p.newEngine()
+		out, _, err := p.engine.Step(nil, eaptls.DefaultStepTimeout)
+		if err != nil {
+			return nil, err
+		}
+		p.out, p.outLen = out, len(out)
+		return p.nextFragment(identifier), nil
+	}
+	if len(p.out) > 0 {
+		if len(data) > 0 {
+			return nil, fmt.Errorf("expected fragment ACK, received %d bytes", len(data))
+		}
+		return p.nextFragment(identifier), nil
+	}
+	p.in = append(p.in, data...)
+	if flags&eaptls.FlagMoreFragments != 0 {
+		p.FragmentsReceived++
+		return p.ack(identifier), nil
+	}
+	record := p.in
+	p.in = nil
+	if len(record) == 0 {
+		return p.ack(identifier), nil
+	}
+	out, _, err := p.engine.Step(record, eaptls.DefaultStepTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return p.ack(identifier), nil
+	}
+	p.out, p.outLen = out, len(out)
+	return p.nextFragment(identifier), nil
+}
+
+func (p *Peer) close() {
+	if p.engine != nil {
+		p.engine.Close()
+		p.engine = nil
+	}
+}
+
+func (p *Peer) newEngine() {
+	p.close()
+	p.in, p.out, p.outLen = nil, nil, 0
+	p.engine = eaptls.NewEngine(func(conn net.Conn) error {
+		c := tls.Client(conn, p.tlsConfig)
+		if err := c.Handshake(); err != nil {
+			return err
+		}
+		state := c.ConnectionState()
+		keys, err := state.ExportKeyingMaterial(p.KeyLabel, nil, eaptls.KeyMaterialLen)
+		if err != nil {
+			return err
+		}
+		p.Msk, p.Resumed = keys[:eaptls.MSKLen], state.DidResume
+		if p.phase2 != nil {
+			return p.phase2(c)
+		}
+		return nil
+	})
+}
+
+func (p *Peer) ack(identifier uint8) eap.Packet {
+	return eap.NewPacket(eap.ResponseCode, identifier, []byte{p.Type, 0})
+}
+
+func (p *Peer) nextFragment(identifier uint8) eap.Packet {
+	var flags uint8
+	chunk := p.out
+	if len(chunk) > p.FragmentSize {
+		chunk = chunk[:p.FragmentSize]
+		flags |= eaptls.FlagMoreFragments
+	}
+	data := []byte{p.Type, flags}
+	if len(p.out) == p.outLen && flags&eaptls.FlagMoreFragments != 0 {
+		data[1] |= eaptls.FlagLengthIncluded
+		data = binary.BigEndian.AppendUint32(data, uint32(p.outLen))
+	}
+	p.out = p.out[len(chunk):]
+	return eap.NewPacket(eap.ResponseCode, identifier, append(data, chunk...))
+}
@@ -16,10 +16,16 @@ package registry
 
 import (
 	aka_provider "magma/feg/gateway/services/eap/providers/aka/provider"
+	akaprime_provider "magma/feg/gateway/services/eap/providers/akaprime/provider"
+	tls_provider "magma/feg/gateway/services/eap/providers/eaptls/provider"
 	sim_provider "magma/feg/gateway/services/eap/providers/sim/provider"
+	ttls_provider "magma/feg/gateway/services/eap/providers/ttls/provider"
 )
 
 func init() {
 	Register(aka_provider.New())
 	Register(sim_provider.New())
+	Register(akaprime_provider.New())
+	Register(tls_provider.New())
+	Register(ttls_provider.New())
 }
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttls
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// AVP Flags, see https://tools.ietf.org/html/rfc5281#section-10.1
+const (
+	AvpFlagVendor    uint8 = 0x80
+	AvpFlagMandatory uint8 = 0x40
+
+	avpHeaderLen       = 8
+	avpVendorHeaderLen = 12
+)
+
+// AVP represents Diameter AVP carried within EAP-TTLS data
+type AVP struct {
+	Code     uint32
+	Flags    uint8
+	VendorId uint32
+	Data     []byte
+}
+
+// NewAVP returns a new mandatory AVP with the given code, vendor (0 - no vendor) & data
+func NewAVP(code, vendorId uint32, data []byte) AVP {
+	avp := AVP{Code: code, Flags: AvpFlagMandatory, VendorId: vendorId, Data: data}
+	if vendorId != 0 {
+		avp.Flags |= AvpFlagVendor
+	}
+	return avp
+}
+
+// Encode appends encoded & padded AVP to b & returns the result
+func (avp AVP) Encode(b []byte) []byte {
+	hl := avpHeaderLen
+	if avp.Flags&AvpFlagVendor != 0 {
+		hl = avpVendorHeaderLen
+	}
+	l := hl + len(avp.Data)
+	hdr := make([]byte, hl)
+	binary.BigEndian.PutUint32(hdr, avp.Code)
+	binary.BigEndian.PutUint32(hdr[4:], uint32(l))
+	hdr[4] = avp.Flags
+	if hl == avpVendorHeaderLen {
+		binary.BigEndian.PutUint32(hdr[8:], avp.VendorId)
+	}
+	b = append(append(b, hdr...), avp.Data...)
+	if pad := (4 - l%4) % 4; pad > 0 {
+		b = append(b, make([]byte, pad)...)
+	}
+	return b
+}
+
+// ParseAVPs parses sequence of padded AVPs
+func ParseAVPs(b []byte) ([]AVP, error) {
+	var res []AVP
+	for len(b) > 0 {
+		if len(b) < avpHeaderLen {
+			return nil, fmt.Errorf("AVP is too short: %d", len(b))
+		}
+		avp := AVP{Code: binary.BigEndian.Uint32(b), Flags: b[4]}
+		l := int(binary.BigEndian.Uint32(b[4:]) & 0xFFFFFF)
+		hl := avpHeaderLen
+		if avp.Flags&AvpFlagVendor != 0 {
+			hl = avpVendorHeaderLen
+		}
+		if l < hl || l > len(b) {
+			return nil, fmt.Errorf("invalid AVP %d length: %d, available: %d", avp.Code, l, len(b))
+		}
+		if hl == avpVendorHeaderLen {
+			avp.VendorId = binary.BigEndian.Uint32(b[8:])
+		}
+		avp.Data = b[hl:l]
+		res = append(res, avp)
+		if padded := l + (4-l%4)%4; padded < len(b) {
+			b = b[padded:]
+		} else {
+			b = nil
+		}
+	}
+	return res, nil
+}
+
+// Find returns data of the first AVP with the given code & vendor, found flag is false if there is no such AVP
+func Find(avps []AVP, code, vendorId uint32) ([]byte, bool) {
+	for _, avp := range avps {
+		if avp.Code == code && avp.VendorId == vendorId {
+			return avp.Data, true
+		}
+	}
+	return nil, false
+}
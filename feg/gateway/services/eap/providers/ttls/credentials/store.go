@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credentials defines pluggable user credential stores used by EAP-TTLS inner authentication methods
+package credentials
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"magma/feg/cloud/go/protos/mconfig"
+)
+
+// FileStoreName is the name of the built in file based credential store
+const FileStoreName = "file"
+
+// ErrUnknownUser is returned by a Store for users it has no credentials for
+var ErrUnknownUser = errors.New("unknown user")
+
+// Store is the interface for user credential stores
+type Store interface {
+	// Password returns clear text password of the user or ErrUnknownUser
+	Password(user string) (string, error)
+}
+
+// Factory creates a Store for the given EAP-TLS configuration
+type Factory func(cfg *mconfig.EapTlsConfig) (Store, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{
+		FileStoreName: func(cfg *mconfig.EapTlsConfig) (Store, error) {
+			return NewFileStore(cfg.GetCredentialsFile())
+		},
+	}
+)
+
+// Register registers a credential store factory with the given name, the store can then be selected by
+// CredentialStore configuration parameter. Register overwrites previously registered factory with the same name
+func Register(name string, f Factory) {
+	factoriesMu.Lock()
+	factories[strings.ToLower(name)] = f
+	factoriesMu.Unlock()
+}
+
+// New creates the credential store selected by the configuration, file store is used by default
+func New(cfg *mconfig.EapTlsConfig) (Store, error) {
+	name := strings.ToLower(cfg.GetCredentialStore())
+	if len(name) == 0 {
+		name = FileStoreName
+	}
+	factoriesMu.RLock()
+	f, ok := factories[name]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown credential store: '%s'", name)
+	}
+	return f(cfg)
+}
+
+// MapStore is an in-memory credential store
+type MapStore map[string]string
+
+// Password implements Store interface
+func (s MapStore) Password(user string) (string, error) {
+	if pwd, ok := s[user]; ok {
+		return pwd, nil
+	}
+	return "", ErrUnknownUser
+}
+
+// NewFileStore loads credentials from the given file & returns corresponding in-memory store.
+// Every non-empty line of the file which doesn't start with '#' is expected to have the following format:
+//
+//	username:password
+func NewFileStore(path string) (MapStore, error) {
+	if len(path) == 0 {
+		return nil, errors.New("credentials file is not configured")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	store := MapStore{}
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		idx := strings.Index(line, ":")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid credentials entry at %s:%d", path, lineNum)
+		}
+		store[line[:idx]] = line[idx+1:]
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
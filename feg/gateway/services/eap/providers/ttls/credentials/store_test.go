@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/eap/providers/ttls/credentials"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(path, []byte("# test users\nuser1:pass1\n\n user2:pa:ss2 \n"), 0600)
+	assert.NoError(t, err)
+
+	store, err := credentials.New(&mconfig.EapTlsConfig{CredentialsFile: path})
+	assert.NoError(t, err)
+	pwd, err := store.Password("user1")
+	assert.NoError(t, err)
+	assert.Equal(t, "pass1", pwd)
+	pwd, err = store.Password("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, "pa:ss2", pwd)
+	_, err = store.Password("user3")
+	assert.Equal(t, credentials.ErrUnknownUser, err)
+
+	err = os.WriteFile(path, []byte("user1\n"), 0600)
+	assert.NoError(t, err)
+	_, err = credentials.NewFileStore(path)
+	assert.Error(t, err)
+
+	_, err = credentials.New(&mconfig.EapTlsConfig{CredentialStore: "ldap"})
+	assert.Error(t, err)
+
+	credentials.Register("ldap", func(*mconfig.EapTlsConfig) (credentials.Store, error) {
+		return credentials.MapStore{"user4": "pass4"}, nil
+	})
+	store, err = credentials.New(&mconfig.EapTlsConfig{CredentialStore: "LDAP"})
+	assert.NoError(t, err)
+	pwd, err = store.Password("user4")
+	assert.NoError(t, err)
+	assert.Equal(t, "pass4", pwd)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ttls implements EAP-TTLSv0 (RFC 5281) inner authentication methods
+package ttls
+
+import "magma/feg/gateway/services/aaa/protos"
+
+const (
+	TYPE = uint8(protos.EapType_TTLS)
+
+	// KeyMaterialLabel is the EAP-TTLS Key Material PRF label, see https://tools.ietf.org/html/rfc5281#section-8
+	KeyMaterialLabel = "ttls keying material"
+	// ChallengeLabel is the EAP-TTLS implicit challenge PRF label, see https://tools.ietf.org/html/rfc5281#section-11.1
+	ChallengeLabel = "ttls challenge"
+
+	// Inner authentication methods
+	InnerMethodPAP      = "PAP"
+	InnerMethodMSCHAPV2 = "MSCHAPV2"
+
+	// RADIUS AVPs, see https://tools.ietf.org/html/rfc2865
+	AVP_USER_NAME     uint32 = 1
+	AVP_USER_PASSWORD uint32 = 2
+
+	// Microsoft Vendor Specific AVPs, see https://tools.ietf.org/html/rfc2548
+	VENDOR_MICROSOFT           uint32 = 311
+	AVP_MS_CHAP_ERROR          uint32 = 2
+	AVP_MS_CHAP_CHALLENGE      uint32 = 11
+	AVP_MS_CHAP2_RESPONSE      uint32 = 25
+	AVP_MS_CHAP2_SUCCESS       uint32 = 26
+	MS_CHAP_CHALLENGE_LEN             = 16
+	MS_CHAP2_RESPONSE_LEN             = 50
+	MS_CHAP2_AUTH_RESPONSE_LEN        = 42 // "S=" + 40 hex digits
+	MaxUserPasswordLen                = 128
+)
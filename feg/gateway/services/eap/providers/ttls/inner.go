@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttls
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+
+	"magma/feg/gateway/services/eap/providers/eaptls"
+	"magma/feg/gateway/services/eap/providers/eaptls/metrics"
+	"magma/feg/gateway/services/eap/providers/ttls/credentials"
+)
+
+const maxPhase2MessageLen = 4096
+
+// InnerAuthenticator implements EAP-TTLS phase 2 (inner) authentication
+type InnerAuthenticator struct {
+	store   credentials.Store
+	methods map[string]bool
+}
+
+// NewInnerAuthenticator returns inner authenticator for the credential store & allowed inner methods,
+// empty methods list allows all supported methods
+func NewInnerAuthenticator(store credentials.Store, methods []string) (*InnerAuthenticator, error) {
+	if store == nil {
+		return nil, errors.New("nil credential store")
+	}
+	if len(methods) == 0 {
+		methods = []string{InnerMethodPAP, InnerMethodMSCHAPV2}
+	}
+	a := &InnerAuthenticator{store: store, methods: map[string]bool{}}
+	for _, m := range methods {
+		m = strings.ToUpper(m)
+		if m != InnerMethodPAP && m != InnerMethodMSCHAPV2 {
+			return nil, fmt.Errorf("unsupported EAP-TTLS inner method: %s", m)
+		}
+		a.methods[m] = true
+	}
+	return a, nil
+}
+
+// Authenticate reads inner authentication AVPs from the TLS tunnel, verifies user credentials & writes
+// method specific success AVPs (if any) back to the tunnel. Authenticate conforms to eaptls.Config.Phase2
+func (a *InnerAuthenticator) Authenticate(conn *tls.Conn, res *eaptls.Result) error {
+	buf := make([]byte, maxPhase2MessageLen)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return fmt.Errorf("EAP-TTLS phase 2 read error: %v", err)
+	}
+	avps, err := ParseAVPs(buf[:n])
+	if err != nil {
+		return fmt.Errorf("EAP-TTLS phase 2 AVPs error: %v", err)
+	}
+	userName, found := Find(avps, AVP_USER_NAME, 0)
+	if !found || len(userName) == 0 {
+		return errors.New("EAP-TTLS phase 2: missing User-Name AVP")
+	}
+	var method string
+	if _, found = Find(avps, AVP_MS_CHAP2_RESPONSE, VENDOR_MICROSOFT); found {
+		method = InnerMethodMSCHAPV2
+	} else if _, found = Find(avps, AVP_USER_PASSWORD, 0); found {
+		method = InnerMethodPAP
+	} else {
+		return fmt.Errorf("EAP-TTLS phase 2: unsupported inner method for user '%s'", userName)
+	}
+	metrics.InnerAuthRequests.WithLabelValues(method).Inc()
+	if !a.methods[method] {
+		metrics.InnerAuthFailures.WithLabelValues(method).Inc()
+		return fmt.Errorf("EAP-TTLS phase 2: inner method %s is not allowed", method)
+	}
+	if method == InnerMethodMSCHAPV2 {
+		err = a.msChapV2(conn, string(userName), avps)
+	} else {
+		err = a.pap(string(userName), avps)
+	}
+	if err != nil {
+		metrics.InnerAuthFailures.WithLabelValues(method).Inc()
+		return fmt.Errorf("EAP-TTLS phase 2 %s authentication failed for user '%s': %v", method, userName, err)
+	}
+	res.Identity = string(userName)
+	return nil
+}
+
+// pap verifies PAP credentials, see https://tools.ietf.org/html/rfc5281#section-11.2.5
+func (a *InnerAuthenticator) pap(userName string, avps []AVP) error {
+	password, _ := Find(avps, AVP_USER_PASSWORD, 0)
+	if len(password) > MaxUserPasswordLen {
+		return fmt.Errorf("User-Password is too long: %d", len(password))
+	}
+	// the password is padded with nulls to a multiple of 16 octets
+	password = bytes.TrimRight(password, "\x00")
+	expected, err := a.store.Password(userName)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare([]byte(expected), password) != 1 {
+		return errors.New("invalid password")
+	}
+	return nil
+}
+
+// msChapV2 verifies MS-CHAP-V2 response & sends MS-CHAP2-Success to the peer,
+// see https://tools.ietf.org/html/rfc5281#section-11.2.4
+func (a *InnerAuthenticator) msChapV2(conn *tls.Conn, userName string, avps []AVP) error {
+	state := conn.ConnectionState()
+	challengeMaterial, err := state.ExportKeyingMaterial(ChallengeLabel, nil, MS_CHAP_CHALLENGE_LEN+1)
+	if err != nil {
+		return err
+	}
+	authChallenge, ident := challengeMaterial[:MS_CHAP_CHALLENGE_LEN], challengeMaterial[MS_CHAP_CHALLENGE_LEN]
+
+	challenge, found := Find(avps, AVP_MS_CHAP_CHALLENGE, VENDOR_MICROSOFT)
+	if !found || !bytes.Equal(challenge, authChallenge) {
+		return errors.New("invalid or missing MS-CHAP-Challenge")
+	}
+	response, _ := Find(avps, AVP_MS_CHAP2_RESPONSE, VENDOR_MICROSOFT)
+	if len(response) != MS_CHAP2_RESPONSE_LEN {
+		return fmt.Errorf("invalid MS-CHAP2-Response length: %d", len(response))
+	}
+	if response[0] != ident {
+		return fmt.Errorf("invalid MS-CHAP2-Response Ident: %d, expected: %d", response[0], ident)
+	}
+	// MS-CHAP2-Response: Ident(1), Flags(1), Peer-Challenge(16), Reserved(8), Response(24)
+	peerChallenge, ntResponse := response[2:18], response[26:50]
+	password, err := a.store.Password(userName)
+	if err != nil {
+		return err
+	}
+	expected := GenerateNTResponse(authChallenge, peerChallenge, userName, password)
+	if subtle.ConstantTimeCompare(expected, ntResponse) != 1 {
+		return errors.New("invalid NT-Response")
+	}
+	authResponse := GenerateAuthenticatorResponse(password, ntResponse, peerChallenge, authChallenge, userName)
+	success := append([]byte{ident}, authResponse...)
+	_, err = conn.Write(NewAVP(AVP_MS_CHAP2_SUCCESS, VENDOR_MICROSOFT, success).Encode(nil))
+	return err
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttls
+
+import (
+	"crypto/des"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/crypto/md4"
+)
+
+// MS-CHAPv2 routines, see https://tools.ietf.org/html/rfc2759#section-8
+
+var (
+	magic1 = []byte("Magic server to client signing constant")
+	magic2 = []byte("Pad to make it do more than one iteration")
+)
+
+// ChallengeHash returns 8 octets challenge hash, RFC 2759 8.2
+func ChallengeHash(peerChallenge, authenticatorChallenge []byte, userName string) []byte {
+	h := sha1.New()
+	h.Write(peerChallenge)
+	h.Write(authenticatorChallenge)
+	h.Write([]byte(stripDomain(userName)))
+	return h.Sum(nil)[:8]
+}
+
+// NtPasswordHash returns MD4 hash of UTF-16LE encoded password, RFC 2759 8.3
+func NtPasswordHash(password string) []byte {
+	encoded := utf16.Encode([]rune(password))
+	b := make([]byte, 0, len(encoded)*2)
+	for _, c := range encoded {
+		b = append(b, byte(c), byte(c>>8))
+	}
+	h := md4.New()
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// GenerateNTResponse returns 24 octets NT-Response, RFC 2759 8.1
+func GenerateNTResponse(authenticatorChallenge, peerChallenge []byte, userName, password string) []byte {
+	return ChallengeResponse(
+		ChallengeHash(peerChallenge, authenticatorChallenge, userName), NtPasswordHash(password))
+}
+
+// ChallengeResponse returns 24 octets response for the challenge & password hash, RFC 2759 8.5
+func ChallengeResponse(challenge, passwordHash []byte) []byte {
+	zHash := make([]byte, 21)
+	copy(zHash, passwordHash)
+	res := make([]byte, 24)
+	for i := 0; i < 3; i++ {
+		block, _ := des.NewCipher(desKey(zHash[i*7 : i*7+7])) // 8 bytes key cannot fail
+		block.Encrypt(res[i*8:], challenge)
+	}
+	return res
+}
+
+// GenerateAuthenticatorResponse returns 42 octets "S=" authenticator response, RFC 2759 8.7
+func GenerateAuthenticatorResponse(
+	password string, ntResponse, peerChallenge, authenticatorChallenge []byte, userName string) string {
+
+	h := md4.New()
+	h.Write(NtPasswordHash(password))
+	passwordHashHash := h.Sum(nil)
+
+	s := sha1.New()
+	s.Write(passwordHashHash)
+	s.Write(ntResponse)
+	s.Write(magic1)
+	digest := s.Sum(nil)
+
+	s = sha1.New()
+	s.Write(digest)
+	s.Write(ChallengeHash(peerChallenge, authenticatorChallenge, userName))
+	s.Write(magic2)
+	return "S=" + strings.ToUpper(hex.EncodeToString(s.Sum(nil)))
+}
+
+// desKey expands 7 octets key into 8 octets DES key, parity bits are ignored by DES
+func desKey(k []byte) []byte {
+	return []byte{
+		k[0] & 0xFE,
+		(k[0]<<7 | k[1]>>1) & 0xFE,
+		(k[1]<<6 | k[2]>>2) & 0xFE,
+		(k[2]<<5 | k[3]>>3) & 0xFE,
+		(k[3]<<4 | k[4]>>4) & 0xFE,
+		(k[4]<<3 | k[5]>>5) & 0xFE,
+		(k[5]<<2 | k[6]>>6) & 0xFE,
+		k[6] << 1,
+	}
+}
+
+// stripDomain removes Windows domain prefix from the user name, RFC 2759 8.2
+func stripDomain(userName string) string {
+	if idx := strings.LastIndex(userName, "\\"); idx >= 0 {
+		return userName[idx+1:]
+	}
+	return userName
+}
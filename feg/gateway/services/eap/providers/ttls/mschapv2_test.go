@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttls
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// RFC 2759 section 9.2 test vectors
+func TestMsChapV2(t *testing.T) {
+	const (
+		user     = "User"
+		password = "clientPass"
+	)
+	authChallenge, _ := hex.DecodeString("5B5D7C7D7B3F2F3E3C2C602132262628")
+	peerChallenge, _ := hex.DecodeString("21402324255E262A28295F2B3A337C7E")
+
+	assert.Equal(t, "d02e4386bce91226", hex.EncodeToString(ChallengeHash(peerChallenge, authChallenge, user)))
+	assert.Equal(t, "44ebba8d5312b8d611474411f56989ae", hex.EncodeToString(NtPasswordHash(password)))
+	ntResponse := GenerateNTResponse(authChallenge, peerChallenge, user, password)
+	assert.Equal(t, "82309ecd8d708b5ea08faa3981cd83544233114a3d85d6df", hex.EncodeToString(ntResponse))
+	assert.Equal(t,
+		"S=407A5589115FD0D6209F510FE9C04566932CDA56",
+		GenerateAuthenticatorResponse(password, ntResponse, peerChallenge, authChallenge, user))
+	// Windows domain must be ignored
+	assert.Equal(t, ntResponse, GenerateNTResponse(authChallenge, peerChallenge, "DOMAIN\\"+user, password))
+}
+
+func TestAVP(t *testing.T) {
+	b := NewAVP(AVP_USER_NAME, 0, []byte("user")).Encode(nil)
+	b = NewAVP(AVP_MS_CHAP_CHALLENGE, VENDOR_MICROSOFT, []byte{1, 2, 3}).Encode(b)
+	assert.Equal(t, []byte{
+		0, 0, 0, 1, 0x40, 0, 0, 12, 'u', 's', 'e', 'r',
+		0, 0, 0, 11, 0xC0, 0, 0, 15, 0, 0, 1, 55, 1, 2, 3, 0}, b)
+	avps, err := ParseAVPs(b)
+	assert.NoError(t, err)
+	assert.Len(t, avps, 2)
+	data, found := Find(avps, AVP_MS_CHAP_CHALLENGE, VENDOR_MICROSOFT)
+	assert.True(t, found)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+	_, found = Find(avps, AVP_MS_CHAP_CHALLENGE, 0)
+	assert.False(t, found)
+
+	_, err = ParseAVPs(b[:10])
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provider implements EAP-TTLS provider
+package provider
+
+import "regexp"
+
+// TTLS peers usually hide their real (inner) identity behind anonymous outer identity
+var anonymousRe = regexp.MustCompile(`^anonymous(?:@\w(?:\w|\.|-)*\w)?$`)
+
+// WillHandleIdentity returns true if the provider 1) recognizes the given Identity and 2) can hendle authentication
+// for this type of identity.
+// EAP-TTLS will handle anonymous identities ('anonymous' or 'anonymous@realm')
+func (p *providerImpl) WillHandleIdentity(identityData []byte) bool {
+	return anonymousRe.Match(identityData)
+}
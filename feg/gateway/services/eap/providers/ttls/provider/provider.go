@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provider implements EAP-TTLS provider
+package provider
+
+import (
+	"crypto/tls"
+	"errors"
+	"sync"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers"
+	"magma/feg/gateway/services/eap/providers/eaptls"
+	"magma/feg/gateway/services/eap/providers/ttls"
+	"magma/feg/gateway/services/eap/providers/ttls/credentials"
+)
+
+// EAP-TTLS Provider Implementation
+type providerImpl struct {
+	sync.RWMutex
+	*eaptls.Server
+	cfg   *mconfig.EapTlsConfig
+	store credentials.Store
+}
+
+// New returns EAP-TTLS provider, the provider gets its configuration from AAA server mconfig on first use
+func New() providers.Method {
+	return &providerImpl{}
+}
+
+// NewWithConfig returns EAP-TTLS provider for the given configuration & credential store,
+// if store is nil, the store is created based on the configuration
+func NewWithConfig(cfg *mconfig.EapTlsConfig, store credentials.Store) providers.Method {
+	return &providerImpl{cfg: cfg, store: store}
+}
+
+// String returns EAP TTLS Provider name/info
+func (*providerImpl) String() string {
+	return "EAP-TTLS"
+}
+
+// EAPType returns EAP TTLS Type - 21
+func (*providerImpl) EAPType() uint8 {
+	return ttls.TYPE
+}
+
+// Handle handles passed EAP-TTLS payload & returns corresponding result
+func (prov *providerImpl) Handle(msg *protos.Eap) (*protos.Eap, error) {
+	if msg == nil {
+		return nil, errors.New("Invalid EAP TTLS Message")
+	}
+	prov.RLock()
+	if prov.Server == nil {
+		// server is not initialized, relock, recheck, create
+		prov.RUnlock()
+		prov.Lock()
+		if prov.Server == nil {
+			srv, err := prov.newServer()
+			if err != nil {
+				prov.Unlock()
+				return &protos.Eap{Payload: eap.Packet(msg.GetPayload()).Failure(), Ctx: msg.GetCtx()}, err
+			}
+			prov.Server = srv
+		}
+		prov.Unlock()
+		prov.RLock()
+	}
+	defer prov.RUnlock()
+	return prov.Server.Handle(msg)
+}
+
+func (prov *providerImpl) newServer() (*eaptls.Server, error) {
+	cfg := prov.cfg
+	if cfg == nil {
+		var err error
+		if cfg, err = eaptls.GetConfig(); err != nil {
+			return nil, err
+		}
+	}
+	store := prov.store
+	if store == nil {
+		var err error
+		if store, err = credentials.New(cfg); err != nil {
+			return nil, err
+		}
+	}
+	inner, err := ttls.NewInnerAuthenticator(store, cfg.GetTtlsInnerMethods())
+	if err != nil {
+		return nil, err
+	}
+	clientAuth := tls.VerifyClientCertIfGiven
+	if cfg.GetTtlsRequireClientCert() {
+		clientAuth = tls.RequireAndVerifyClientCert
+	} else if len(cfg.GetCaCertFile()) == 0 {
+		clientAuth = tls.NoClientCert
+	}
+	tlsConfig, err := eaptls.NewTLSConfig(cfg, clientAuth)
+	if err != nil {
+		return nil, err
+	}
+	return eaptls.NewServer(eaptls.Config{
+		Name:         prov.String(),
+		Type:         ttls.TYPE,
+		TLS:          tlsConfig,
+		FragmentSize: eaptls.FragmentSize(cfg),
+		KeyLabel:     ttls.KeyMaterialLabel,
+		Phase2:       inner.Authenticate,
+	})
+}
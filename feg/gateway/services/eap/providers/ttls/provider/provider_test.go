@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/eaptls"
+	"magma/feg/gateway/services/eap/providers/eaptls/test"
+	"magma/feg/gateway/services/eap/providers/ttls"
+	"magma/feg/gateway/services/eap/providers/ttls/credentials"
+)
+
+const (
+	testUser     = "ttls_user"
+	testPassword = "ttls_password"
+	outerId      = "anonymous@magma.test"
+)
+
+var testStore = credentials.MapStore{testUser: testPassword}
+
+func papPhase2(user, password string) func(*tls.Conn) error {
+	return func(c *tls.Conn) error {
+		pwd := []byte(password)
+		if pad := len(pwd) % 16; pad > 0 {
+			pwd = append(pwd, make([]byte, 16-pad)...)
+		}
+		b := ttls.NewAVP(ttls.AVP_USER_NAME, 0, []byte(user)).Encode(nil)
+		_, err := c.Write(ttls.NewAVP(ttls.AVP_USER_PASSWORD, 0, pwd).Encode(b))
+		return err
+	}
+}
+
+func msChapV2Phase2(user, password string) func(*tls.Conn) error {
+	return func(c *tls.Conn) error {
+		state := c.ConnectionState()
+		material, err := state.ExportKeyingMaterial(ttls.ChallengeLabel, nil, ttls.MS_CHAP_CHALLENGE_LEN+1)
+		if err != nil {
+			return err
+		}
+		authChallenge, ident := material[:ttls.MS_CHAP_CHALLENGE_LEN], material[ttls.MS_CHAP_CHALLENGE_LEN]
+		peerChallenge := bytes.Repeat([]byte{0x21}, 16)
+		ntResponse := ttls.GenerateNTResponse(authChallenge, peerChallenge, user, password)
+		response := append(append(append([]byte{ident, 0}, peerChallenge...), make([]byte, 8)...), ntResponse...)
+
+		b := ttls.NewAVP(ttls.AVP_USER_NAME, 0, []byte(user)).Encode(nil)
+		b = ttls.NewAVP(ttls.AVP_MS_CHAP_CHALLENGE, ttls.VENDOR_MICROSOFT, authChallenge).Encode(b)
+		b = ttls.NewAVP(ttls.AVP_MS_CHAP2_RESPONSE, ttls.VENDOR_MICROSOFT, response).Encode(b)
+		if _, err = c.Write(b); err != nil {
+			return err
+		}
+		buf := make([]byte, 1024)
+		n, err := c.Read(buf)
+		if err != nil {
+			return err
+		}
+		avps, err := ttls.ParseAVPs(buf[:n])
+		if err != nil {
+			return err
+		}
+		success, found := ttls.Find(avps, ttls.AVP_MS_CHAP2_SUCCESS, ttls.VENDOR_MICROSOFT)
+		if !found {
+			return errors.New("missing MS-CHAP2-Success")
+		}
+		expected := ttls.GenerateAuthenticatorResponse(password, ntResponse, peerChallenge, authChallenge, user)
+		if !bytes.Equal(success, append([]byte{ident}, expected...)) {
+			return fmt.Errorf("invalid MS-CHAP2-Success: %s", success)
+		}
+		return nil
+	}
+}
+
+func newTestProvider(t *testing.T, certs *test.Certs, innerMethods ...string) *providerImpl {
+	return NewWithConfig(&mconfig.EapTlsConfig{
+		CaCertFile:               certs.CaCertFile,
+		ServerCertFile:           certs.ServerCertFile,
+		ServerKeyFile:            certs.ServerKeyFile,
+		SessionResumptionEnabled: true,
+		TtlsInnerMethods:         innerMethods,
+	}, testStore).(*providerImpl)
+}
+
+func TestTtlsPAP(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	p := newTestProvider(t, certs)
+	assert.Equal(t, ttls.TYPE, p.EAPType())
+
+	peer := test.NewPeer(ttls.TYPE, certs.ClientTLSConfig(false), ttls.KeyMaterialLabel, papPhase2(testUser, testPassword))
+	resp, err := peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid1"}, outerId)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+	assert.Len(t, resp.GetCtx().GetMsk(), eaptls.MSKLen)
+	assert.Equal(t, peer.Msk, resp.GetCtx().GetMsk())
+	assert.Equal(t, testUser, resp.GetCtx().GetIdentity())
+
+	// resumed session still requires inner authentication
+	resp, err = peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid2"}, outerId)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+	assert.True(t, peer.Resumed)
+
+	peer = test.NewPeer(ttls.TYPE, certs.ClientTLSConfig(false), ttls.KeyMaterialLabel, papPhase2(testUser, "bad"))
+	resp, err = peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid3"}, outerId)
+	assert.Error(t, err)
+	assert.Equal(t, uint8(eap.FailureCode), eap.Packet(resp.GetPayload()).Code())
+	assert.Empty(t, resp.GetCtx().GetMsk())
+
+	peer = test.NewPeer(ttls.TYPE, certs.ClientTLSConfig(false), ttls.KeyMaterialLabel, papPhase2("unknown", testPassword))
+	resp, err = peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid4"}, outerId)
+	assert.Error(t, err)
+	assert.Equal(t, uint8(eap.FailureCode), eap.Packet(resp.GetPayload()).Code())
+}
+
+func TestTtlsMSCHAPV2(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	p := newTestProvider(t, certs, ttls.InnerMethodMSCHAPV2)
+
+	peer := test.NewPeer(
+		ttls.TYPE, certs.ClientTLSConfig(true), ttls.KeyMaterialLabel, msChapV2Phase2(testUser, testPassword))
+	resp, err := peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid1"}, outerId)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+	assert.Equal(t, peer.Msk, resp.GetCtx().GetMsk())
+	assert.Equal(t, testUser, resp.GetCtx().GetIdentity())
+
+	peer = test.NewPeer(ttls.TYPE, certs.ClientTLSConfig(false), ttls.KeyMaterialLabel, msChapV2Phase2(testUser, "bad"))
+	resp, err = peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid2"}, outerId)
+	assert.Error(t, err)
+	assert.Equal(t, uint8(eap.FailureCode), eap.Packet(resp.GetPayload()).Code())
+
+	// PAP is not allowed
+	peer = test.NewPeer(ttls.TYPE, certs.ClientTLSConfig(false), ttls.KeyMaterialLabel, papPhase2(testUser, testPassword))
+	resp, err = peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid3"}, outerId)
+	assert.Error(t, err)
+	assert.Equal(t, uint8(eap.FailureCode), eap.Packet(resp.GetPayload()).Code())
+}
+
+func TestTtlsRequireClientCert(t *testing.T) {
+	certs, err := test.GenerateCerts(t.TempDir())
+	assert.NoError(t, err)
+	p := NewWithConfig(&mconfig.EapTlsConfig{
+		CaCertFile:            certs.CaCertFile,
+		ServerCertFile:        certs.ServerCertFile,
+		ServerKeyFile:         certs.ServerKeyFile,
+		TtlsRequireClientCert: true,
+	}, testStore)
+
+	peer := test.NewPeer(ttls.TYPE, certs.ClientTLSConfig(false), ttls.KeyMaterialLabel, papPhase2(testUser, testPassword))
+	resp, err := peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid1"}, outerId)
+	assert.Error(t, err)
+	assert.Equal(t, uint8(eap.FailureCode), eap.Packet(resp.GetPayload()).Code())
+
+	peer = test.NewPeer(ttls.TYPE, certs.ClientTLSConfig(true), ttls.KeyMaterialLabel, papPhase2(testUser, testPassword))
+	resp, err = peer.Authenticate(p.Handle, &protos.Context{SessionId: "sid2"}, outerId)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.SuccessCode), eap.Packet(resp.GetPayload()).Code())
+}
+
+func TestTtlsWillHandleIdentity(t *testing.T) {
+	p := New()
+	assert.True(t, p.WillHandleIdentity([]byte("anonymous@magma.test")))
+	assert.True(t, p.WillHandleIdentity([]byte("anonymous")))
+	assert.False(t, p.WillHandleIdentity([]byte("user@magma.test")))
+	assert.False(t, p.WillHandleIdentity([]byte("0001010000000001@wlan.mnc001.mcc001.3gppnetwork.org")))
+}
@@ -101,6 +101,30 @@ func Authenticate(ctx context.Context, req *protos.AuthenticationRequest) (*prot
 	return res, nil
 }
 
+// UpdateGprsLocation registers the user's SGSN with the HLR, empty SGSN number &
+// address are replaced by the HLR proxy's configured values
+func UpdateGprsLocation(ctx context.Context, userName, sgsnNumber string, sgsnAddress []byte) (string, error) {
+	cli, err := getHlrProxyClient()
+	if err != nil {
+		return "", err
+	}
+	hlrAns, err := cli.UpdateGprsLocation(ctx, &hlr.UpdateGprsLocationReq{
+		UserName:    userName,
+		SgsnNumber:  sgsnNumber,
+		SgsnAddress: sgsnAddress,
+	})
+	if err != nil {
+		log.Printf("HLR RPC Error: %v", err)
+		return "", err
+	}
+	if hlrAns.GetErrorCode() != hlr.ErrorCode_SUCCESS {
+		msg := fmt.Sprintf("HLR Error: %s for User: %s", hlrAns.GetErrorCode().String(), userName)
+		log.Print(msg)
+		return "", errors.New(msg)
+	}
+	return hlrAns.GetHlrNumber(), nil
+}
+
 // Register HLR equivalent of SWX register
 func Register(_ context.Context, req *protos.RegistrationRequest) (*protos.RegistrationAnswer, error) {
 	return &protos.RegistrationAnswer{SessionId: req.SessionId}, nil
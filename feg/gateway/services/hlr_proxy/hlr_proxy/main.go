@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Magma's HLR proxy service implements MAP SendAuthenticationInfo & UpdateGprsLocation
+// towards the HLR over TCAP/SCCP/M3UA for SIM based 2G/3G subscribers
+package main
+
+import (
+	"flag"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos/hlr"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/hlr_proxy/servicers"
+	"magma/orc8r/lib/go/service"
+)
+
+func init() {
+	flag.Parse()
+}
+
+func main() {
+	// Create the service
+	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.HLR_PROXY)
+	if err != nil {
+		glog.Fatalf("Error creating HLR Proxy service: %s", err)
+	}
+
+	proxy, err := servicers.NewHlrProxy(servicers.GetHlrProxyConfig())
+	if err != nil {
+		glog.Fatalf("Failed to create HLR Proxy: %v", err)
+	}
+	defer proxy.Close()
+	hlr.RegisterHlrProxyServer(srv.GrpcServer, proxy)
+
+	// Run the service
+	err = srv.Run()
+	if err != nil {
+		glog.Fatalf("Error running service: %s", err)
+	}
+}
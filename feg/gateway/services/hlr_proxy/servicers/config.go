@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/gsmmap"
+	"magma/feg/gateway/services/hlr_proxy/ss7/m3ua"
+	"magma/feg/gateway/services/hlr_proxy/ss7/sccp"
+	"magma/orc8r/lib/go/service/config"
+)
+
+const (
+	HlrProxyServiceName = "hlr_proxy"
+
+	DefaultM3uaNetwork      = "sctp"
+	DefaultNetworkIndicator = 2 // national network
+	DefaultLocalSSN         = sccp.SSNSGSN
+	DefaultRequestTimeout   = 10 // seconds
+	DefaultNumVectors       = 1
+)
+
+// HlrProxyConfig is the structure of the HLR proxy service YAML configuration (hlr_proxy.yml)
+type HlrProxyConfig struct {
+	M3ua           M3uaConfig `yaml:"m3ua"`
+	Sccp           SccpConfig `yaml:"sccp"`
+	Map            MapConfig  `yaml:"map"`
+	RequestTimeout uint       `yaml:"request_timeout"` // seconds
+}
+
+// M3uaConfig defines the M3UA association with the signaling gateway & MTP3 routing label
+type M3uaConfig struct {
+	Network           string `yaml:"network"`
+	Address           string `yaml:"address"`
+	LocalAddress      string `yaml:"local_address"`
+	RoutingContext    uint32 `yaml:"routing_context"`
+	NetworkAppearance uint32 `yaml:"network_appearance"`
+	TrafficMode       uint32 `yaml:"traffic_mode"`
+	OPC               uint32 `yaml:"opc"`
+	DPC               uint32 `yaml:"dpc"`
+	NetworkIndicator  uint8  `yaml:"network_indicator"`
+}
+
+// SccpConfig defines the SCCP calling & called party addresses, if a global title is empty
+// the corresponding address is routed on point code & SSN
+type SccpConfig struct {
+	LocalGT         string `yaml:"local_gt"`
+	LocalSSN        uint8  `yaml:"local_ssn"`
+	HlrGT           string `yaml:"hlr_gt"`
+	TranslationType uint8  `yaml:"translation_type"`
+}
+
+// MapConfig defines MAP operation parameters sent to the HLR
+type MapConfig struct {
+	SgsnNumber         string `yaml:"sgsn_number"`
+	SgsnAddress        string `yaml:"sgsn_address"`
+	RequestingNodeType string `yaml:"requesting_node_type"` // vlr, sgsn, wlan-aaa or empty - not sent
+	NumVectors         uint32 `yaml:"num_vectors"`
+}
+
+// GetHlrProxyConfig returns the HLR proxy configuration loaded from hlr_proxy.yml,
+// missing parameters are set to their defaults
+func GetHlrProxyConfig() *HlrProxyConfig {
+	cfg := &HlrProxyConfig{}
+	_, _, err := config.GetStructuredServiceConfig("", HlrProxyServiceName, cfg)
+	if err != nil {
+		glog.Errorf("%s configs load error: %v", HlrProxyServiceName, err)
+	}
+	if len(cfg.M3ua.Network) == 0 {
+		cfg.M3ua.Network = DefaultM3uaNetwork
+	}
+	if cfg.M3ua.NetworkIndicator == 0 {
+		cfg.M3ua.NetworkIndicator = DefaultNetworkIndicator
+	}
+	if cfg.Sccp.LocalSSN == 0 {
+		cfg.Sccp.LocalSSN = DefaultLocalSSN
+	}
+	if cfg.Map.NumVectors == 0 {
+		cfg.Map.NumVectors = DefaultNumVectors
+	}
+	if cfg.RequestTimeout == 0 {
+		cfg.RequestTimeout = DefaultRequestTimeout
+	}
+	return cfg
+}
+
+// ValidateHlrProxyConfig verifies that the M3UA peer, point codes & MAP parameters are valid
+func ValidateHlrProxyConfig(cfg *HlrProxyConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("nil HLR proxy config")
+	}
+	if len(cfg.M3ua.Address) == 0 {
+		return fmt.Errorf("missing M3UA peer address")
+	}
+	if cfg.M3ua.OPC == 0 || cfg.M3ua.DPC == 0 || cfg.M3ua.OPC > 0x3FFF || cfg.M3ua.DPC > 0x3FFF {
+		return fmt.Errorf("invalid OPC %d or DPC %d, expected 14 bit point codes", cfg.M3ua.OPC, cfg.M3ua.DPC)
+	}
+	if len(cfg.Map.SgsnAddress) > 0 && net.ParseIP(cfg.Map.SgsnAddress) == nil {
+		return fmt.Errorf("invalid SGSN address: %s", cfg.Map.SgsnAddress)
+	}
+	if _, err := cfg.requestingNodeType(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cfg *HlrProxyConfig) m3uaConfig() m3ua.Config {
+	return m3ua.Config{
+		Network:           cfg.M3ua.Network,
+		Address:           cfg.M3ua.Address,
+		LocalAddress:      cfg.M3ua.LocalAddress,
+		RoutingContext:    cfg.M3ua.RoutingContext,
+		NetworkAppearance: cfg.M3ua.NetworkAppearance,
+		TrafficMode:       cfg.M3ua.TrafficMode,
+	}
+}
+
+func (cfg *HlrProxyConfig) timeout() time.Duration {
+	return time.Duration(cfg.RequestTimeout) * time.Second
+}
+
+// callingAddress returns the local SCCP address, routed on global title if configured
+func (cfg *HlrProxyConfig) callingAddress() sccp.Address {
+	return cfg.sccpAddress(cfg.Sccp.LocalGT, uint16(cfg.M3ua.OPC), cfg.Sccp.LocalSSN)
+}
+
+// calledAddress returns the HLR's SCCP address, routed on global title if configured
+func (cfg *HlrProxyConfig) calledAddress() sccp.Address {
+	return cfg.sccpAddress(cfg.Sccp.HlrGT, uint16(cfg.M3ua.DPC), sccp.SSNHLR)
+}
+
+func (cfg *HlrProxyConfig) sccpAddress(gt string, pc uint16, ssn uint8) sccp.Address {
+	if len(gt) == 0 {
+		return sccp.Address{RouteOnSSN: true, PointCode: pc, SSN: ssn}
+	}
+	return sccp.Address{
+		SSN: ssn,
+		GlobalTitle: &sccp.GlobalTitle{
+			TranslationType: cfg.Sccp.TranslationType,
+			NumberingPlan:   sccp.NumberingPlanE164,
+			NatureOfAddress: sccp.NatureOfAddressInternational,
+			Digits:          gt,
+		},
+	}
+}
+
+func (cfg *HlrProxyConfig) requestingNodeType() (*gsmmap.RequestingNodeType, error) {
+	var nodeType gsmmap.RequestingNodeType
+	switch strings.ToLower(cfg.Map.RequestingNodeType) {
+	case "":
+		return nil, nil
+	case "vlr":
+		nodeType = gsmmap.NodeVLR
+	case "sgsn":
+		nodeType = gsmmap.NodeSGSN
+	case "wlan-aaa", "wlan-aaa-server":
+		nodeType = gsmmap.NodeWLANAAAServer
+	default:
+		return nil, fmt.Errorf("invalid requesting node type: %s", cfg.Map.RequestingNodeType)
+	}
+	return &nodeType, nil
+}
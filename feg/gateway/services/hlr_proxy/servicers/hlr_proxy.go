@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"net"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/cloud/go/protos/hlr"
+	"magma/feg/gateway/services/hlr_proxy/ss7/gsmmap"
+	"magma/feg/gateway/services/hlr_proxy/ss7/tcap"
+)
+
+// HlrProxy implements the HlrProxy gRPC service by running MAP dialogues with the HLR
+type HlrProxy struct {
+	cfg        *HlrProxyConfig
+	client     *mapClient
+	nodeType   *gsmmap.RequestingNodeType
+	sgsnNumber string
+	sgsnAddr   net.IP
+}
+
+// NewHlrProxy returns an HLR proxy with the given configuration, the M3UA association
+// with the signaling gateway is established on first request
+func NewHlrProxy(cfg *HlrProxyConfig) (*HlrProxy, error) {
+	if err := ValidateHlrProxyConfig(cfg); err != nil {
+		return nil, err
+	}
+	nodeType, _ := cfg.requestingNodeType()
+	proxy := &HlrProxy{
+		cfg:        cfg,
+		client:     newMapClient(cfg),
+		nodeType:   nodeType,
+		sgsnNumber: cfg.Map.SgsnNumber,
+	}
+	if len(cfg.Map.SgsnAddress) > 0 {
+		proxy.sgsnAddr = net.ParseIP(cfg.Map.SgsnAddress)
+	}
+	return proxy, nil
+}
+
+// Close closes the M3UA association with the signaling gateway
+func (s *HlrProxy) Close() {
+	s.client.close()
+}
+
+// AuthInfo sends MAP SendAuthenticationInfo to the HLR & returns the received UMTS vectors
+func (s *HlrProxy) AuthInfo(ctx context.Context, req *hlr.AuthInfoReq) (*hlr.AuthInfoAns, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil AuthInfoReq")
+	}
+	numVectors := req.GetNumRequestedUmtsVectors()
+	if numVectors == 0 {
+		numVectors = s.cfg.Map.NumVectors
+	}
+	sai := &gsmmap.SendAuthenticationInfoArg{
+		IMSI:                     req.GetUserName(),
+		NumberOfRequestedVectors: numVectors,
+		RequestingNodeType:       s.nodeType,
+	}
+	if rs := req.GetResyncInfo(); rs != nil {
+		sai.ResyncInfo = &gsmmap.ResyncInfo{RAND: rs.GetRand(), AUTS: rs.GetAutn()}
+	}
+	arg, err := sai.Encode()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid AuthInfoReq for %s: %v", req.GetUserName(), err)
+	}
+	results, err := s.client.dialogue(
+		ctx, gsmmap.InfoRetrievalContextV3, gsmmap.OpSendAuthenticationInfo, arg, nil)
+	if err != nil {
+		return &hlr.AuthInfoAns{ErrorCode: errorCode(req.GetUserName(), "SendAuthenticationInfo", err)}, nil
+	}
+	ans := &hlr.AuthInfoAns{ErrorCode: hlr.ErrorCode_SUCCESS}
+	var triplets int
+	for _, result := range results {
+		res, err := gsmmap.DecodeSendAuthenticationInfoRes(result)
+		if err != nil {
+			glog.Errorf("invalid SendAuthenticationInfo result for %s: %v", req.GetUserName(), err)
+			return &hlr.AuthInfoAns{ErrorCode: hlr.ErrorCode_UNABLE_TO_DELIVER}, nil
+		}
+		triplets += len(res.Triplets)
+		for _, q := range res.Quintuplets {
+			ans.UmtsVectors = append(ans.UmtsVectors, &hlr.AuthInfoAns_UMTSVector{
+				Rand: q.RAND, Xres: q.XRES, Ck: q.CK, Ik: q.IK, Autn: q.AUTN})
+		}
+	}
+	if len(ans.UmtsVectors) == 0 {
+		// EAP-AKA requires UMTS vectors, GSM triplets of SIM-only subscribers can't be used
+		glog.Errorf("no UMTS vectors received for %s, GSM triplets: %d", req.GetUserName(), triplets)
+		ans.ErrorCode = hlr.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE
+	}
+	return ans, nil
+}
+
+// UpdateGprsLocation sends MAP UpdateGprsLocation to the HLR, InsertSubscriberData
+// received within the dialogue is acknowledged
+func (s *HlrProxy) UpdateGprsLocation(
+	ctx context.Context, req *hlr.UpdateGprsLocationReq) (*hlr.UpdateGprsLocationAns, error) {
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil UpdateGprsLocationReq")
+	}
+	ugl := &gsmmap.UpdateGprsLocationArg{
+		IMSI:        req.GetUserName(),
+		SGSNNumber:  req.GetSgsnNumber(),
+		SGSNAddress: s.sgsnAddr,
+	}
+	if len(ugl.SGSNNumber) == 0 {
+		ugl.SGSNNumber = s.sgsnNumber
+	}
+	if len(req.GetSgsnAddress()) > 0 {
+		ugl.SGSNAddress = net.IP(req.GetSgsnAddress())
+	}
+	if len(ugl.SGSNNumber) == 0 || ugl.SGSNAddress == nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"missing SGSN number or address in UpdateGprsLocationReq for %s", req.GetUserName())
+	}
+	arg, err := ugl.Encode()
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "invalid UpdateGprsLocationReq for %s: %v", req.GetUserName(), err)
+	}
+	results, err := s.client.dialogue(
+		ctx, gsmmap.GprsLocationUpdateContextV3, gsmmap.OpUpdateGprsLocation, arg, insertSubscriberDataHandler)
+	if err != nil {
+		return &hlr.UpdateGprsLocationAns{ErrorCode: errorCode(req.GetUserName(), "UpdateGprsLocation", err)}, nil
+	}
+	ans := &hlr.UpdateGprsLocationAns{ErrorCode: hlr.ErrorCode_SUCCESS}
+	if len(results) > 0 {
+		res, err := gsmmap.DecodeUpdateGprsLocationRes(results[len(results)-1])
+		if err != nil {
+			glog.Errorf("invalid UpdateGprsLocation result for %s: %v", req.GetUserName(), err)
+			return &hlr.UpdateGprsLocationAns{ErrorCode: hlr.ErrorCode_UNABLE_TO_DELIVER}, nil
+		}
+		ans.HlrNumber = res.HLRNumber
+	}
+	return ans, nil
+}
+
+// insertSubscriberDataHandler acknowledges InsertSubscriberData, the subscription data itself is not used
+func insertSubscriberDataHandler(invoke *tcap.Component) *tcap.Component {
+	if invoke.OpCode != nil && *invoke.OpCode == gsmmap.OpInsertSubscriberData {
+		return tcap.NewReturnResultLast(invoke.InvokeID, gsmmap.OpInsertSubscriberData, nil)
+	}
+	glog.Warningf("rejecting unexpected invoke of operation %v", invoke.OpCode)
+	return &tcap.Component{
+		Type:        tcap.Reject,
+		InvokeID:    invoke.InvokeID,
+		ProblemType: tcap.ProblemTypeInvoke,
+		ProblemCode: tcap.InvokeProblemUnrecognizedOperation,
+	}
+}
+
+// errorCode logs a failed dialogue & returns its HLR proxy error code
+func errorCode(imsi, operation string, err error) hlr.ErrorCode {
+	glog.Errorf("MAP %s failed for %s: %v", operation, imsi, err)
+	if herr, ok := err.(*hlrError); ok {
+		return herr.code
+	}
+	return hlr.ErrorCode_UNABLE_TO_DELIVER
+}
+
+// make sure HlrProxy implements the whole service
+var _ hlr.HlrProxyServer = (*HlrProxy)(nil)
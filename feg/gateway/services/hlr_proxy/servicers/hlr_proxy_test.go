@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/cloud/go/protos/hlr"
+	"magma/feg/gateway/services/hlr_proxy/servicers"
+	"magma/feg/gateway/services/hlr_proxy/servicers/test"
+	"magma/feg/gateway/services/hlr_proxy/ss7/gsmmap"
+)
+
+const testIMSI = test.BaseIMSI + "00001"
+
+func newTestConfig(addr string) *servicers.HlrProxyConfig {
+	return &servicers.HlrProxyConfig{
+		M3ua: servicers.M3uaConfig{
+			Network:          "tcp",
+			Address:          addr,
+			RoutingContext:   1,
+			OPC:              1,
+			DPC:              2,
+			NetworkIndicator: 2,
+		},
+		Sccp: servicers.SccpConfig{
+			LocalGT:  "15555550000",
+			LocalSSN: 149,
+			HlrGT:    "15555550001",
+		},
+		Map: servicers.MapConfig{
+			SgsnNumber:         "15555550000",
+			SgsnAddress:        "10.0.0.1",
+			RequestingNodeType: "wlan-aaa",
+			NumVectors:         1,
+		},
+		RequestTimeout: 1,
+	}
+}
+
+func startTestProxy(t *testing.T) (*servicers.HlrProxy, *test.TestHlr) {
+	testHlr, err := test.StartTestHlr("127.0.0.1:0")
+	require.NoError(t, err)
+	proxy, err := servicers.NewHlrProxy(newTestConfig(testHlr.Addr()))
+	require.NoError(t, err)
+	return proxy, testHlr
+}
+
+func TestHlrProxy_AuthInfo(t *testing.T) {
+	proxy, testHlr := startTestProxy(t)
+	defer testHlr.Close()
+	defer proxy.Close()
+
+	ans, err := proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{UserName: testIMSI})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_SUCCESS, ans.GetErrorCode())
+	require.Len(t, ans.GetUmtsVectors(), 1)
+	v := ans.GetUmtsVectors()[0]
+	assert.Equal(t, test.DefaultRAND, v.GetRand())
+	assert.Equal(t, test.DefaultXRES, v.GetXres())
+	assert.Equal(t, test.DefaultCK, v.GetCk())
+	assert.Equal(t, test.DefaultIK, v.GetIk())
+	assert.Equal(t, test.DefaultAUTN, v.GetAutn())
+
+	sai := testHlr.LastSAI()
+	require.NotNil(t, sai)
+	assert.Equal(t, testIMSI, sai.IMSI)
+	assert.Equal(t, uint32(1), sai.NumberOfRequestedVectors)
+	require.NotNil(t, sai.RequestingNodeType)
+	assert.Equal(t, gsmmap.NodeWLANAAAServer, *sai.RequestingNodeType)
+	assert.Nil(t, sai.ResyncInfo)
+
+	// Segmented result, one vector per TC-RESULT-NL
+	ans, err = proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{UserName: testIMSI, NumRequestedUmtsVectors: 3})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_SUCCESS, ans.GetErrorCode())
+	assert.Len(t, ans.GetUmtsVectors(), 3)
+
+	// Re-synchronisation
+	rand := make([]byte, hlr.AuthInfoReq_ResyncInfo_RAND_LEN)
+	auts := make([]byte, 14)
+	rand[0], auts[0] = 0xAA, 0xBB
+	ans, err = proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{
+		UserName:   testIMSI,
+		ResyncInfo: &hlr.AuthInfoReq_ResyncInfo{Rand: rand, Autn: auts},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_SUCCESS, ans.GetErrorCode())
+	sai = testHlr.LastSAI()
+	require.NotNil(t, sai.ResyncInfo)
+	assert.Equal(t, rand, sai.ResyncInfo.RAND)
+	assert.Equal(t, auts, sai.ResyncInfo.AUTS)
+}
+
+func TestHlrProxy_AuthInfoErrors(t *testing.T) {
+	proxy, testHlr := startTestProxy(t)
+	defer testHlr.Close()
+	defer proxy.Close()
+
+	ans, err := proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{UserName: "001019999999999"})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_UNKNOWN_SUBSCRIBER, ans.GetErrorCode())
+	assert.Empty(t, ans.GetUmtsVectors())
+
+	_, err = proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{UserName: "imsi001010000000001"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	testHlr.SetSilent(true)
+	ans, err = proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{UserName: testIMSI})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_NO_RESP_FROM_PEER, ans.GetErrorCode())
+
+	// The association is kept after the timeout
+	testHlr.SetSilent(false)
+	ans, err = proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{UserName: testIMSI})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_SUCCESS, ans.GetErrorCode())
+}
+
+func TestHlrProxy_NoPathToHlr(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	lis.Close()
+
+	proxy, err := servicers.NewHlrProxy(newTestConfig(addr))
+	require.NoError(t, err)
+	defer proxy.Close()
+	ans, err := proxy.AuthInfo(context.Background(), &hlr.AuthInfoReq{UserName: testIMSI})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_NO_PATH_TO_HLR, ans.GetErrorCode())
+}
+
+func TestHlrProxy_UpdateGprsLocation(t *testing.T) {
+	proxy, testHlr := startTestProxy(t)
+	defer testHlr.Close()
+	defer proxy.Close()
+
+	ans, err := proxy.UpdateGprsLocation(context.Background(), &hlr.UpdateGprsLocationReq{UserName: testIMSI})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_SUCCESS, ans.GetErrorCode())
+	assert.Equal(t, test.HlrNumber, ans.GetHlrNumber())
+	assert.Equal(t, 1, testHlr.InsertSubscriberDataAcks())
+
+	ugl := testHlr.LastUGL()
+	require.NotNil(t, ugl)
+	assert.Equal(t, testIMSI, ugl.IMSI)
+	assert.Equal(t, "15555550000", ugl.SGSNNumber)
+	assert.True(t, net.ParseIP("10.0.0.1").Equal(ugl.SGSNAddress))
+
+	// Request parameters override the configured SGSN
+	ans, err = proxy.UpdateGprsLocation(context.Background(), &hlr.UpdateGprsLocationReq{
+		UserName:    testIMSI,
+		SgsnNumber:  "15555550002",
+		SgsnAddress: net.ParseIP("2001:db8::1"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_SUCCESS, ans.GetErrorCode())
+	ugl = testHlr.LastUGL()
+	assert.Equal(t, "15555550002", ugl.SGSNNumber)
+	assert.True(t, net.ParseIP("2001:db8::1").Equal(ugl.SGSNAddress))
+
+	ans, err = proxy.UpdateGprsLocation(context.Background(), &hlr.UpdateGprsLocationReq{UserName: "001019999999999"})
+	require.NoError(t, err)
+	assert.Equal(t, hlr.ErrorCode_UNKNOWN_SUBSCRIBER, ans.GetErrorCode())
+	assert.Equal(t, 2, testHlr.InsertSubscriberDataAcks())
+}
+
+func TestValidateHlrProxyConfig(t *testing.T) {
+	cfg := newTestConfig("127.0.0.1:2905")
+	assert.NoError(t, servicers.ValidateHlrProxyConfig(cfg))
+
+	cfg.M3ua.DPC = 0x4000
+	assert.Error(t, servicers.ValidateHlrProxyConfig(cfg))
+
+	cfg = newTestConfig("127.0.0.1:2905")
+	cfg.Map.RequestingNodeType = "mme"
+	assert.Error(t, servicers.ValidateHlrProxyConfig(cfg))
+
+	cfg = newTestConfig("")
+	assert.Error(t, servicers.ValidateHlrProxyConfig(cfg))
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos/hlr"
+	"magma/feg/gateway/services/hlr_proxy/ss7/ber"
+	"magma/feg/gateway/services/hlr_proxy/ss7/gsmmap"
+	"magma/feg/gateway/services/hlr_proxy/ss7/m3ua"
+	"magma/feg/gateway/services/hlr_proxy/ss7/sccp"
+	"magma/feg/gateway/services/hlr_proxy/ss7/tcap"
+)
+
+// invokeID is the invoke ID of the operation sent in TC-BEGIN, MAP dialogues
+// of the HLR proxy carry a single operation
+const invokeID = 1
+
+// hlrError is a failed MAP dialogue with the HLR & the HLR proxy error code it maps to
+type hlrError struct {
+	code hlr.ErrorCode
+	err  error
+}
+
+func (e *hlrError) Error() string {
+	return fmt.Sprintf("%s: %v", e.code, e.err)
+}
+
+// dialogueEvent is a TCAP message received within a dialogue or the dialogue's TC-BEGIN
+// returned by SCCP as undeliverable
+type dialogueEvent struct {
+	msg         *tcap.Message
+	peer        sccp.Address
+	returned    bool
+	returnCause uint8
+}
+
+// invokeHandler handles an operation invoked by the HLR within a dialogue (for example
+// InsertSubscriberData during UpdateGprsLocation), it returns the component to reply with
+type invokeHandler func(invoke *tcap.Component) *tcap.Component
+
+// mapClient runs MAP dialogues with the HLR over TCAP/SCCP/M3UA. The M3UA association is
+// established on first use & re-established after it's lost
+type mapClient struct {
+	cfg *HlrProxyConfig
+
+	connLock sync.Mutex
+	conn     *m3ua.Conn
+
+	dialoguesLock sync.Mutex
+	dialogues     map[uint32]chan *dialogueEvent
+	lastTID       uint32
+}
+
+func newMapClient(cfg *HlrProxyConfig) *mapClient {
+	return &mapClient{cfg: cfg, dialogues: map[uint32]chan *dialogueEvent{}}
+}
+
+// dialogue runs a MAP dialogue of the given application context: it sends TC-BEGIN with the
+// operation's invoke & collects the operation's results until the HLR ends the dialogue
+func (c *mapClient) dialogue(
+	ctx context.Context, acn []uint32, opCode int64, arg *ber.TLV, handler invokeHandler) ([]*ber.TLV, error) {
+
+	conn, err := c.getConn()
+	if err != nil {
+		return nil, &hlrError{code: hlr.ErrorCode_NO_PATH_TO_HLR, err: err}
+	}
+	tid, events := c.newDialogue()
+	defer c.endDialogue(tid)
+
+	localTID := make([]byte, 4)
+	binary.BigEndian.PutUint32(localTID, tid)
+	sls := uint8(tid & 0x0F)
+	peer := c.cfg.calledAddress()
+
+	begin := &tcap.Message{
+		Type:       tcap.Begin,
+		OTID:       localTID,
+		Dialogue:   tcap.NewAARQ(acn),
+		Components: []*tcap.Component{tcap.NewInvoke(invokeID, opCode, arg)},
+	}
+	if err = c.send(conn, peer, begin, sls); err != nil {
+		return nil, &hlrError{code: hlr.ErrorCode_UNABLE_TO_DELIVER, err: err}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.timeout())
+	defer cancel()
+
+	var (
+		remoteTID []byte
+		results   []*ber.TLV
+	)
+	for {
+		var ev *dialogueEvent
+		select {
+		case <-ctx.Done():
+			if remoteTID != nil {
+				// Release the HLR's transaction with a TC-U-ABORT
+				c.send(conn, peer, &tcap.Message{Type: tcap.Abort, DTID: remoteTID}, sls)
+			}
+			return nil, &hlrError{code: hlr.ErrorCode_NO_RESP_FROM_PEER, err: ctx.Err()}
+		case <-conn.Done():
+			return nil, &hlrError{code: hlr.ErrorCode_NO_HLR_IN_ACTIVE_STATE, err: conn.Err()}
+		case ev = <-events:
+		}
+		if ev.returned {
+			return nil, &hlrError{
+				code: hlr.ErrorCode_UNABLE_TO_DELIVER,
+				err:  fmt.Errorf("SCCP returned TC-BEGIN, return cause: %d", ev.returnCause)}
+		}
+		msg := ev.msg
+		if msg.Type == tcap.Abort {
+			return nil, abortError(msg)
+		}
+		if d := msg.Dialogue; d != nil && d.Type == tcap.AARE && d.Result != tcap.ResultAccepted {
+			return nil, &hlrError{
+				code: hlr.ErrorCode_UNABLE_TO_DELIVER,
+				err:  fmt.Errorf("application context %v rejected, diagnostic: %d", acn, d.Diagnostic)}
+		}
+		var (
+			replies             []*tcap.Component
+			resultLast, segment bool
+		)
+		for _, comp := range msg.Components {
+			switch comp.Type {
+			case tcap.ReturnResultNotLast, tcap.ReturnResultLast:
+				if comp.InvokeID != invokeID {
+					glog.Warningf("ignoring result of unknown invoke ID %d", comp.InvokeID)
+					continue
+				}
+				if comp.Parameter != nil {
+					results = append(results, comp.Parameter)
+				}
+				resultLast = resultLast || comp.Type == tcap.ReturnResultLast
+				segment = segment || comp.Type == tcap.ReturnResultNotLast
+			case tcap.ReturnError:
+				return nil, mapError(&gsmmap.Error{Code: comp.ErrorCode})
+			case tcap.Reject:
+				return nil, &hlrError{
+					code: hlr.ErrorCode_UNABLE_TO_DELIVER,
+					err:  fmt.Errorf("operation rejected, problem type: %d, code: %d", comp.ProblemType, comp.ProblemCode)}
+			case tcap.Invoke:
+				if handler == nil {
+					glog.Warningf("ignoring unexpected invoke of operation %v", comp.OpCode)
+					continue
+				}
+				if reply := handler(comp); reply != nil {
+					replies = append(replies, reply)
+				}
+			}
+		}
+		if msg.Type == tcap.End {
+			if !resultLast {
+				return nil, &hlrError{
+					code: hlr.ErrorCode_UNABLE_TO_DELIVER, err: fmt.Errorf("dialogue ended without result")}
+			}
+			return results, nil
+		}
+		// TC-CONTINUE, subsequent messages are sent to the HLR which answered
+		remoteTID, peer = msg.OTID, ev.peer
+		if resultLast {
+			if err = c.send(conn, peer, &tcap.Message{Type: tcap.End, DTID: remoteTID}, sls); err != nil {
+				glog.Errorf("failed to end MAP dialogue: %v", err)
+			}
+			return results, nil
+		}
+		// An empty TC-CONTINUE requests the next segment of a segmented result
+		if len(replies) > 0 || segment {
+			cont := &tcap.Message{Type: tcap.Continue, OTID: localTID, DTID: remoteTID, Components: replies}
+			if err = c.send(conn, peer, cont, sls); err != nil {
+				return nil, &hlrError{code: hlr.ErrorCode_UNABLE_TO_DELIVER, err: err}
+			}
+		}
+	}
+}
+
+// getConn returns the active M3UA association with the signaling gateway, the association
+// is (re)established if needed
+func (c *mapClient) getConn() (*m3ua.Conn, error) {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	if c.conn != nil {
+		select {
+		case <-c.conn.Done():
+			c.conn = nil
+		default:
+			return c.conn, nil
+		}
+	}
+	conn, err := m3ua.Dial(c.cfg.m3uaConfig())
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	go c.receive(conn)
+	return conn, nil
+}
+
+// close closes the M3UA association if it's established
+func (c *mapClient) close() {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+}
+
+func (c *mapClient) newDialogue() (uint32, chan *dialogueEvent) {
+	events := make(chan *dialogueEvent, 4)
+	c.dialoguesLock.Lock()
+	defer c.dialoguesLock.Unlock()
+	for {
+		c.lastTID++
+		if _, ok := c.dialogues[c.lastTID]; !ok && c.lastTID != 0 {
+			break
+		}
+	}
+	c.dialogues[c.lastTID] = events
+	return c.lastTID, events
+}
+
+func (c *mapClient) endDialogue(tid uint32) {
+	c.dialoguesLock.Lock()
+	delete(c.dialogues, tid)
+	c.dialoguesLock.Unlock()
+}
+
+func (c *mapClient) send(conn *m3ua.Conn, peer sccp.Address, msg *tcap.Message, sls uint8) error {
+	data, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	udt, err := sccp.NewUDT(peer, c.cfg.callingAddress(), data).Marshal()
+	if err != nil {
+		return err
+	}
+	return conn.WriteData(&m3ua.ProtocolData{
+		OPC:  c.cfg.M3ua.OPC,
+		DPC:  c.cfg.M3ua.DPC,
+		SI:   m3ua.ServiceIndicatorSCCP,
+		NI:   c.cfg.M3ua.NetworkIndicator,
+		SLS:  sls,
+		Data: udt,
+	})
+}
+
+// receive dispatches TCAP messages received on the association to their dialogues
+func (c *mapClient) receive(conn *m3ua.Conn) {
+	for {
+		var pd *m3ua.ProtocolData
+		select {
+		case <-conn.Done():
+			return
+		case pd = <-conn.Data():
+		}
+		if pd.SI != m3ua.ServiceIndicatorSCCP {
+			glog.Warningf("ignoring M3UA DATA with service indicator %d", pd.SI)
+			continue
+		}
+		msg, err := sccp.Unmarshal(pd.Data)
+		if err != nil {
+			glog.Errorf("invalid SCCP message from %d: %v", pd.OPC, err)
+			continue
+		}
+		tm, err := tcap.Unmarshal(msg.Data)
+		if err != nil {
+			glog.Errorf("invalid TCAP message from %d: %v", pd.OPC, err)
+			continue
+		}
+		// Returned messages are our own TC-BEGINs, others are addressed by the destination TID
+		ev := &dialogueEvent{msg: tm, peer: msg.Calling, returned: msg.IsService(), returnCause: msg.ReturnCause}
+		tid := tm.DTID
+		if ev.returned {
+			tid = tm.OTID
+		}
+		if len(tid) != 4 {
+			glog.Warningf("ignoring TCAP message type %d with unknown transaction ID %x", tm.Type, tid)
+			continue
+		}
+		c.dialoguesLock.Lock()
+		events, ok := c.dialogues[binary.BigEndian.Uint32(tid)]
+		c.dialoguesLock.Unlock()
+		if !ok {
+			glog.V(2).Infof("ignoring TCAP message type %d for ended transaction %x", tm.Type, tid)
+			continue
+		}
+		select {
+		case events <- ev:
+		default:
+			glog.Errorf("dropping TCAP message type %d for transaction %x: queue is full", tm.Type, tid)
+		}
+	}
+}
+
+// abortError maps a TC-ABORT to an HLR proxy error
+func abortError(msg *tcap.Message) error {
+	if msg.PAbortCause != nil {
+		return &hlrError{
+			code: hlr.ErrorCode_UNABLE_TO_DELIVER, err: fmt.Errorf("TCAP P-Abort, cause: %d", *msg.PAbortCause)}
+	}
+	if d := msg.Dialogue; d != nil && d.Type == tcap.AARE {
+		return &hlrError{
+			code: hlr.ErrorCode_UNABLE_TO_DELIVER,
+			err:  fmt.Errorf("dialogue refused, application context: %v, diagnostic: %d", d.ApplicationContext, d.Diagnostic)}
+	}
+	return &hlrError{code: hlr.ErrorCode_UNABLE_TO_DELIVER, err: fmt.Errorf("TCAP U-Abort")}
+}
+
+// mapError maps a MAP user error to an HLR proxy error
+func mapError(err *gsmmap.Error) error {
+	code := hlr.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE
+	switch err.Code {
+	case gsmmap.ErrUnknownSubscriber, gsmmap.ErrUnidentifiedSubscriber:
+		code = hlr.ErrorCode_UNKNOWN_SUBSCRIBER
+	case gsmmap.ErrRoamingNotAllowed, gsmmap.ErrIllegalSubscriber, gsmmap.ErrIllegalEquipment:
+		code = hlr.ErrorCode_AUTHENTICATION_REJECTED
+	}
+	return &hlrError{code: code, err: err}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package test provides an M3UA signaling gateway & HLR stub for HLR proxy tests
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/ber"
+	"magma/feg/gateway/services/hlr_proxy/ss7/gsmmap"
+	"magma/feg/gateway/services/hlr_proxy/ss7/m3ua"
+	"magma/feg/gateway/services/hlr_proxy/ss7/sccp"
+	"magma/feg/gateway/services/hlr_proxy/ss7/tcap"
+)
+
+const (
+	// BaseIMSI is the prefix of IMSIs known by the test HLR
+	BaseIMSI = "0010100000"
+	// HlrNumber is the HLR number returned in UpdateGprsLocation results
+	HlrNumber = "15555550100"
+)
+
+var (
+	DefaultRAND = bytes.Repeat([]byte{0x11}, 16)
+	DefaultXRES = bytes.Repeat([]byte{0x22}, 8)
+	DefaultCK   = bytes.Repeat([]byte{0x33}, 16)
+	DefaultIK   = bytes.Repeat([]byte{0x44}, 16)
+	DefaultAUTN = bytes.Repeat([]byte{0x55}, 16)
+)
+
+// transaction is an HLR side dialogue waiting for the next TC-CONTINUE of the ASP
+type transaction struct {
+	invokeID  int64
+	opCode    int64
+	remaining []gsmmap.AuthenticationQuintuplet
+}
+
+// TestHlr is an M3UA signaling gateway & HLR stub, it answers SendAuthenticationInfo
+// with quintuplets (segmented if multiple vectors are requested) & UpdateGprsLocation
+// after InsertSubscriberData is acknowledged
+type TestHlr struct {
+	listener net.Listener
+
+	lock         sync.Mutex
+	silent       bool
+	lastSAI      *gsmmap.SendAuthenticationInfoArg
+	lastUGL      *gsmmap.UpdateGprsLocationArg
+	isdAcks      int
+	transactions map[string]*transaction
+	lastTID      uint32
+}
+
+// StartTestHlr starts a TCP M3UA test HLR on the given address
+func StartTestHlr(addr string) (*TestHlr, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	h := &TestHlr{listener: lis, transactions: map[string]*transaction{}}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go h.serve(conn)
+		}
+	}()
+	return h, nil
+}
+
+// Addr returns the address the test HLR listens on
+func (h *TestHlr) Addr() string {
+	return h.listener.Addr().String()
+}
+
+// Close stops accepting new associations
+func (h *TestHlr) Close() {
+	h.listener.Close()
+}
+
+// SetSilent makes the test HLR ignore (silent == true) or answer MAP requests
+func (h *TestHlr) SetSilent(silent bool) {
+	h.lock.Lock()
+	h.silent = silent
+	h.lock.Unlock()
+}
+
+// LastSAI returns the argument of the last received SendAuthenticationInfo
+func (h *TestHlr) LastSAI() *gsmmap.SendAuthenticationInfoArg {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.lastSAI
+}
+
+// LastUGL returns the argument of the last received UpdateGprsLocation
+func (h *TestHlr) LastUGL() *gsmmap.UpdateGprsLocationArg {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.lastUGL
+}
+
+// InsertSubscriberDataAcks returns the number of acknowledged InsertSubscriberData invokes
+func (h *TestHlr) InsertSubscriberDataAcks() int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.isdAcks
+}
+
+func (h *TestHlr) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		m, err := m3ua.ReadMessage(conn)
+		if err != nil {
+			return
+		}
+		var reply []*m3ua.Message
+		switch {
+		case m.Class == m3ua.ClassASPSM && m.Type == m3ua.TypeASPUP:
+			reply = append(reply, m3ua.NewMessage(m3ua.ClassASPSM, m3ua.TypeASPUPAck))
+		case m.Class == m3ua.ClassASPTM && m.Type == m3ua.TypeASPAC:
+			reply = append(reply,
+				m3ua.NewMessage(m3ua.ClassASPTM, m3ua.TypeASPACAck, m.Params...),
+				// AS State Change: AS-ACTIVE
+				m3ua.NewMessage(m3ua.ClassMGMT, m3ua.TypeNTFY, m3ua.Uint32Param(m3ua.TagStatus, 0x00010003)))
+		case m.Class == m3ua.ClassASPSM && m.Type == m3ua.TypeASPDN:
+			conn.Write(m3ua.NewMessage(m3ua.ClassASPSM, m3ua.TypeASPDNAck).Marshal())
+			return
+		case m.Class == m3ua.ClassASPSM && m.Type == m3ua.TypeBEAT:
+			reply = append(reply, m3ua.NewMessage(m3ua.ClassASPSM, m3ua.TypeBEATAck, m.Params...))
+		case m.Class == m3ua.ClassTRANS && m.Type == m3ua.TypeDATA:
+			pd, err := m.ProtocolData()
+			if err != nil {
+				glog.Errorf("test HLR: invalid DATA: %v", err)
+				continue
+			}
+			answer, err := h.handleData(pd)
+			if err != nil {
+				glog.Errorf("test HLR: %v", err)
+				continue
+			}
+			if answer != nil {
+				rc, _ := m.Uint32(m3ua.TagRoutingContext)
+				reply = append(reply, m3ua.NewData(0, rc, answer))
+			}
+		}
+		for _, r := range reply {
+			if _, err = conn.Write(r.Marshal()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// handleData handles a received SCCP UDT & returns the protocol data of the answer if any
+func (h *TestHlr) handleData(pd *m3ua.ProtocolData) (*m3ua.ProtocolData, error) {
+	udt, err := sccp.Unmarshal(pd.Data)
+	if err != nil {
+		return nil, err
+	}
+	req, err := tcap.Unmarshal(udt.Data)
+	if err != nil {
+		return nil, err
+	}
+	h.lock.Lock()
+	silent := h.silent
+	h.lock.Unlock()
+	if silent {
+		return nil, nil
+	}
+	ans, err := h.handleTcap(req)
+	if err != nil || ans == nil {
+		return nil, err
+	}
+	data, err := ans.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	called := udt.Calling
+	calling := udt.Called
+	if calling.SSN == 0 {
+		calling.SSN = sccp.SSNHLR
+	}
+	b, err := sccp.NewUDT(called, calling, data).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &m3ua.ProtocolData{OPC: pd.DPC, DPC: pd.OPC, SI: pd.SI, NI: pd.NI, SLS: pd.SLS, Data: b}, nil
+}
+
+func (h *TestHlr) handleTcap(req *tcap.Message) (*tcap.Message, error) {
+	switch req.Type {
+	case tcap.Begin:
+		if len(req.Components) != 1 || req.Components[0].Type != tcap.Invoke || req.Dialogue == nil {
+			return nil, fmt.Errorf("unexpected TC-BEGIN")
+		}
+		return h.handleInvoke(req.OTID, req.Dialogue.ApplicationContext, req.Components[0])
+	case tcap.Continue:
+		return h.handleContinue(req)
+	case tcap.End, tcap.Abort:
+		h.lock.Lock()
+		delete(h.transactions, string(req.DTID))
+		h.lock.Unlock()
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unexpected TCAP message type: %d", req.Type)
+}
+
+func (h *TestHlr) handleInvoke(remoteTID []byte, acn []uint32, invoke *tcap.Component) (*tcap.Message, error) {
+	aare := tcap.NewAARE(acn, tcap.ResultAccepted, tcap.DiagnosticSourceUser, tcap.DiagnosticNull)
+	end := &tcap.Message{Type: tcap.End, DTID: remoteTID, Dialogue: aare}
+	opCode := *invoke.OpCode
+
+	var imsi string
+	switch opCode {
+	case gsmmap.OpSendAuthenticationInfo:
+		arg, err := gsmmap.DecodeSendAuthenticationInfoArg(invoke.Parameter)
+		if err != nil {
+			return nil, err
+		}
+		h.lock.Lock()
+		h.lastSAI = arg
+		h.lock.Unlock()
+		imsi = arg.IMSI
+		if strings.HasPrefix(imsi, BaseIMSI) {
+			vectors := make([]gsmmap.AuthenticationQuintuplet, arg.NumberOfRequestedVectors)
+			for i := range vectors {
+				vectors[i] = gsmmap.AuthenticationQuintuplet{
+					RAND: DefaultRAND, XRES: DefaultXRES, CK: DefaultCK, IK: DefaultIK, AUTN: DefaultAUTN}
+			}
+			if len(vectors) <= 1 {
+				res := &gsmmap.SendAuthenticationInfoRes{Quintuplets: vectors}
+				end.Components = []*tcap.Component{tcap.NewReturnResultLast(invoke.InvokeID, opCode, res.Encode())}
+				return end, nil
+			}
+			// Segment the result, one vector per TC-RESULT-NL
+			tr := &transaction{invokeID: invoke.InvokeID, opCode: opCode, remaining: vectors[1:]}
+			res := &gsmmap.SendAuthenticationInfoRes{Quintuplets: vectors[:1]}
+			return h.newContinue(remoteTID, tr, aare, &tcap.Component{
+				Type: tcap.ReturnResultNotLast, InvokeID: invoke.InvokeID, OpCode: &opCode, Parameter: res.Encode()}), nil
+		}
+	case gsmmap.OpUpdateGprsLocation:
+		arg, err := gsmmap.DecodeUpdateGprsLocationArg(invoke.Parameter)
+		if err != nil {
+			return nil, err
+		}
+		h.lock.Lock()
+		h.lastUGL = arg
+		h.lock.Unlock()
+		imsi = arg.IMSI
+		if strings.HasPrefix(imsi, BaseIMSI) {
+			imsiTBCD, _ := gsmmap.EncodeTBCD(imsi)
+			isd := ber.NewSequence(ber.NewPrimitive(ber.ClassContextSpecific, 0, imsiTBCD))
+			tr := &transaction{invokeID: invoke.InvokeID, opCode: opCode}
+			return h.newContinue(remoteTID, tr, aare, tcap.NewInvoke(1, gsmmap.OpInsertSubscriberData, isd)), nil
+		}
+	default:
+		end.Components = []*tcap.Component{{
+			Type:        tcap.Reject,
+			InvokeID:    invoke.InvokeID,
+			ProblemType: tcap.ProblemTypeInvoke,
+			ProblemCode: tcap.InvokeProblemUnrecognizedOperation,
+		}}
+		return end, nil
+	}
+	end.Components = []*tcap.Component{{
+		Type: tcap.ReturnError, InvokeID: invoke.InvokeID, ErrorCode: gsmmap.ErrUnknownSubscriber}}
+	return end, nil
+}
+
+// newContinue registers the transaction & returns the TC-CONTINUE starting it
+func (h *TestHlr) newContinue(
+	remoteTID []byte, tr *transaction, aare *tcap.Dialogue, comp *tcap.Component) *tcap.Message {
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.lastTID++
+	localTID := []byte{0xAB, 0, byte(h.lastTID >> 8), byte(h.lastTID)}
+	h.transactions[string(localTID)] = tr
+	return &tcap.Message{
+		Type: tcap.Continue, OTID: localTID, DTID: remoteTID, Dialogue: aare, Components: []*tcap.Component{comp}}
+}
+
+func (h *TestHlr) handleContinue(req *tcap.Message) (*tcap.Message, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	tr, ok := h.transactions[string(req.DTID)]
+	if !ok {
+		return nil, fmt.Errorf("unknown transaction %x", req.DTID)
+	}
+	next := &tcap.Message{Type: tcap.Continue, OTID: req.DTID, DTID: req.OTID}
+	switch tr.opCode {
+	case gsmmap.OpSendAuthenticationInfo:
+		res := &gsmmap.SendAuthenticationInfoRes{Quintuplets: tr.remaining[:1]}
+		tr.remaining = tr.remaining[1:]
+		if len(tr.remaining) > 0 {
+			next.Components = []*tcap.Component{{
+				Type: tcap.ReturnResultNotLast, InvokeID: tr.invokeID, OpCode: &tr.opCode, Parameter: res.Encode()}}
+			return next, nil
+		}
+		next.Components = []*tcap.Component{tcap.NewReturnResultLast(tr.invokeID, tr.opCode, res.Encode())}
+	case gsmmap.OpUpdateGprsLocation:
+		if len(req.Components) != 1 || req.Components[0].Type != tcap.ReturnResultLast {
+			return nil, fmt.Errorf("unexpected InsertSubscriberData response")
+		}
+		h.isdAcks++
+		res, err := (&gsmmap.UpdateGprsLocationRes{HLRNumber: HlrNumber}).Encode()
+		if err != nil {
+			return nil, err
+		}
+		next.Components = []*tcap.Component{tcap.NewReturnResultLast(tr.invokeID, tr.opCode, res)}
+	}
+	next.Type, next.OTID = tcap.End, nil
+	delete(h.transactions, string(req.DTID))
+	return next, nil
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ber implements the subset of ASN.1 Basic Encoding Rules (ITU-T X.690)
+// used by TCAP & MAP: tag-length-value encoding of primitive & constructed
+// elements with context specific, application & private tags.
+package ber
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Class is the class of a BER tag
+type Class uint8
+
+const (
+	ClassUniversal       Class = 0x00
+	ClassApplication     Class = 0x40
+	ClassContextSpecific Class = 0x80
+	ClassPrivate         Class = 0xC0
+
+	constructedBit = 0x20
+	classMask      = 0xC0
+	shortTagMask   = 0x1F
+	indefiniteLen  = 0x80
+)
+
+// Universal tag numbers
+const (
+	TagBoolean          = 1
+	TagInteger          = 2
+	TagBitString        = 3
+	TagOctetString      = 4
+	TagNull             = 5
+	TagObjectIdentifier = 6
+	TagExternal         = 8
+	TagEnumerated       = 10
+	TagSequence         = 16
+	TagSet              = 17
+)
+
+// TLV is a decoded or to be encoded BER element. Value holds the contents
+// octets of primitive elements, Children the elements of constructed ones
+type TLV struct {
+	Class       Class
+	Constructed bool
+	Tag         uint32
+	Value       []byte
+	Children    []*TLV
+}
+
+// NewPrimitive returns a primitive element with the given contents
+func NewPrimitive(class Class, tag uint32, value []byte) *TLV {
+	return &TLV{Class: class, Tag: tag, Value: value}
+}
+
+// NewConstructed returns a constructed element with the given children,
+// nil children are skipped to simplify encoding of optional elements
+func NewConstructed(class Class, tag uint32, children ...*TLV) *TLV {
+	t := &TLV{Class: class, Constructed: true, Tag: tag}
+	for _, c := range children {
+		if c != nil {
+			t.Children = append(t.Children, c)
+		}
+	}
+	return t
+}
+
+// NewSequence returns a universal SEQUENCE of the given children
+func NewSequence(children ...*TLV) *TLV {
+	return NewConstructed(ClassUniversal, TagSequence, children...)
+}
+
+// NewOctetString returns a universal OCTET STRING
+func NewOctetString(value []byte) *TLV {
+	return NewPrimitive(ClassUniversal, TagOctetString, value)
+}
+
+// NewInteger returns a universal INTEGER
+func NewInteger(value int64) *TLV {
+	return NewPrimitive(ClassUniversal, TagInteger, EncodeInteger(value))
+}
+
+// NewNull returns a NULL with the given class & tag
+func NewNull(class Class, tag uint32) *TLV {
+	return NewPrimitive(class, tag, []byte{})
+}
+
+// Is returns true if the element has the given class & tag
+func (t *TLV) Is(class Class, tag uint32) bool {
+	return t != nil && t.Class == class && t.Tag == tag
+}
+
+// Find returns the first child with the given class & tag or nil if not found
+func (t *TLV) Find(class Class, tag uint32) *TLV {
+	if t == nil {
+		return nil
+	}
+	for _, c := range t.Children {
+		if c.Is(class, tag) {
+			return c
+		}
+	}
+	return nil
+}
+
+// Int returns the primitive element's contents decoded as an INTEGER
+func (t *TLV) Int() (int64, error) {
+	if t == nil {
+		return 0, errors.New("missing INTEGER element")
+	}
+	return DecodeInteger(t.Value)
+}
+
+// String returns a short description of the element's identifier
+func (t *TLV) String() string {
+	return fmt.Sprintf("[class: 0x%02X, constructed: %t, tag: %d, len: %d]", uint8(t.Class), t.Constructed, t.Tag, len(t.Value))
+}
+
+// Marshal returns the BER encoding of the element using definite lengths
+func (t *TLV) Marshal() []byte {
+	value := t.Value
+	if t.Constructed {
+		value = nil
+		for _, c := range t.Children {
+			value = append(value, c.Marshal()...)
+		}
+	}
+	res := encodeIdentifier(t.Class, t.Constructed, t.Tag)
+	res = append(res, encodeLength(len(value))...)
+	return append(res, value...)
+}
+
+// Unmarshal decodes a single BER element from b, it returns the element and
+// the remaining bytes following it. Constructed elements are decoded recursively,
+// both definite & indefinite lengths are supported
+func Unmarshal(b []byte) (*TLV, []byte, error) {
+	if len(b) < 2 {
+		return nil, b, fmt.Errorf("BER element is too short: %d bytes", len(b))
+	}
+	t := &TLV{Class: Class(b[0] & classMask), Constructed: b[0]&constructedBit != 0}
+	offset := 1
+	if b[0]&shortTagMask != shortTagMask {
+		t.Tag = uint32(b[0] & shortTagMask)
+	} else {
+		for {
+			if offset >= len(b) {
+				return nil, b, errors.New("truncated BER tag")
+			}
+			if offset > 4 {
+				return nil, b, errors.New("BER tag is too long")
+			}
+			t.Tag = t.Tag<<7 | uint32(b[offset]&0x7F)
+			offset++
+			if b[offset-1]&0x80 == 0 {
+				break
+			}
+		}
+	}
+	if offset >= len(b) {
+		return nil, b, errors.New("missing BER length")
+	}
+	if b[offset] == indefiniteLen {
+		if !t.Constructed {
+			return nil, b, errors.New("indefinite length of a primitive BER element")
+		}
+		rest := b[offset+1:]
+		for {
+			if len(rest) >= 2 && rest[0] == 0 && rest[1] == 0 {
+				return t, rest[2:], nil
+			}
+			child, next, err := Unmarshal(rest)
+			if err != nil {
+				return nil, b, err
+			}
+			t.Children = append(t.Children, child)
+			rest = next
+		}
+	}
+	length, lenBytes, err := decodeLength(b[offset:])
+	if err != nil {
+		return nil, b, err
+	}
+	offset += lenBytes
+	if len(b)-offset < length {
+		return nil, b, fmt.Errorf("BER element length %d exceeds available %d bytes", length, len(b)-offset)
+	}
+	value := b[offset : offset+length]
+	if t.Constructed {
+		t.Children, err = UnmarshalAll(value)
+		if err != nil {
+			return nil, b, err
+		}
+	}
+	t.Value = value
+	return t, b[offset+length:], nil
+}
+
+// UnmarshalAll decodes all consecutive BER elements in b
+func UnmarshalAll(b []byte) ([]*TLV, error) {
+	var res []*TLV
+	for len(b) > 0 {
+		t, rest, err := Unmarshal(b)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+		b = rest
+	}
+	return res, nil
+}
+
+// EncodeInteger returns the minimal two's complement encoding of an INTEGER
+func EncodeInteger(value int64) []byte {
+	n := 1
+	for v := value; v > 127 || v < -128; v >>= 8 {
+		n++
+	}
+	res := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		res[i] = byte(value)
+		value >>= 8
+	}
+	return res
+}
+
+// DecodeInteger decodes a two's complement INTEGER
+func DecodeInteger(b []byte) (int64, error) {
+	if len(b) == 0 || len(b) > 8 {
+		return 0, fmt.Errorf("invalid INTEGER length: %d", len(b))
+	}
+	res := int64(int8(b[0]))
+	for _, o := range b[1:] {
+		res = res<<8 | int64(o)
+	}
+	return res, nil
+}
+
+// EncodeOID returns the contents octets of an OBJECT IDENTIFIER
+func EncodeOID(oid []uint32) ([]byte, error) {
+	if len(oid) < 2 || oid[0] > 2 || (oid[0] < 2 && oid[1] > 39) {
+		return nil, fmt.Errorf("invalid OID: %v", oid)
+	}
+	res := encodeBase128(oid[0]*40 + oid[1])
+	for _, arc := range oid[2:] {
+		res = append(res, encodeBase128(arc)...)
+	}
+	return res, nil
+}
+
+// DecodeOID decodes the contents octets of an OBJECT IDENTIFIER
+func DecodeOID(b []byte) ([]uint32, error) {
+	var arcs []uint32
+	var arc uint32
+	for i, o := range b {
+		if arc > 0x1FFFFFF {
+			return nil, errors.New("OID arc overflow")
+		}
+		arc = arc<<7 | uint32(o&0x7F)
+		if o&0x80 != 0 {
+			if i == len(b)-1 {
+				return nil, errors.New("truncated OID")
+			}
+			continue
+		}
+		if len(arcs) == 0 {
+			first := arc / 40
+			if first > 2 {
+				first = 2
+			}
+			arcs = append(arcs, first, arc-first*40)
+		} else {
+			arcs = append(arcs, arc)
+		}
+		arc = 0
+	}
+	if len(arcs) == 0 {
+		return nil, errors.New("empty OID")
+	}
+	return arcs, nil
+}
+
+func encodeBase128(v uint32) []byte {
+	res := []byte{byte(v & 0x7F)}
+	for v >>= 7; v > 0; v >>= 7 {
+		res = append([]byte{byte(v&0x7F) | 0x80}, res...)
+	}
+	return res
+}
+
+func encodeIdentifier(class Class, constructed bool, tag uint32) []byte {
+	first := byte(class)
+	if constructed {
+		first |= constructedBit
+	}
+	if tag < shortTagMask {
+		return []byte{first | byte(tag)}
+	}
+	return append([]byte{first | shortTagMask}, encodeBase128(tag)...)
+}
+
+func encodeLength(length int) []byte {
+	if length < 0x80 {
+		return []byte{byte(length)}
+	}
+	var res []byte
+	for l := length; l > 0; l >>= 8 {
+		res = append([]byte{byte(l)}, res...)
+	}
+	return append([]byte{0x80 | byte(len(res))}, res...)
+}
+
+func decodeLength(b []byte) (int, int, error) {
+	if b[0] < 0x80 {
+		return int(b[0]), 1, nil
+	}
+	n := int(b[0] & 0x7F)
+	if n > 4 || len(b) < n+1 {
+		return 0, 0, fmt.Errorf("invalid BER long form length of %d octets", n)
+	}
+	length := 0
+	for _, o := range b[1 : n+1] {
+		length = length<<8 | int(o)
+	}
+	return length, n + 1, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ber_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/ber"
+)
+
+func TestInteger(t *testing.T) {
+	for value, encoded := range map[int64]string{
+		0:      "00",
+		1:      "01",
+		127:    "7f",
+		128:    "0080",
+		256:    "0100",
+		-1:     "ff",
+		-128:   "80",
+		-129:   "ff7f",
+		65535:  "00ffff",
+		100000: "0186a0",
+	} {
+		assert.Equal(t, encoded, hex.EncodeToString(ber.EncodeInteger(value)), "value %d", value)
+		b, _ := hex.DecodeString(encoded)
+		decoded, err := ber.DecodeInteger(b)
+		assert.NoError(t, err)
+		assert.Equal(t, value, decoded)
+	}
+	_, err := ber.DecodeInteger(nil)
+	assert.Error(t, err)
+}
+
+func TestOID(t *testing.T) {
+	// id-as-dialogue & gprsLocationUpdateContext-v3
+	for encoded, oid := range map[string][]uint32{
+		"00118605010101": {0, 0, 17, 773, 1, 1, 1},
+		"04000001002003": {0, 4, 0, 0, 1, 0, 32, 3},
+	} {
+		b, err := ber.EncodeOID(oid)
+		assert.NoError(t, err)
+		assert.Equal(t, encoded, hex.EncodeToString(b))
+		decoded, err := ber.DecodeOID(b)
+		assert.NoError(t, err)
+		assert.Equal(t, oid, decoded)
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	seq := ber.NewSequence(
+		ber.NewInteger(5),
+		ber.NewPrimitive(ber.ClassContextSpecific, 0, []byte{0x01, 0x02}),
+		ber.NewConstructed(ber.ClassApplication, 11, ber.NewNull(ber.ClassUniversal, ber.TagNull)),
+		ber.NewPrimitive(ber.ClassContextSpecific, 40, []byte{0xff}))
+	b := seq.Marshal()
+	assert.Equal(t, "300f020105800201026b0205009f2801ff", hex.EncodeToString(b))
+
+	decoded, rest, err := ber.Unmarshal(append(b, 0x05, 0x00))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x05, 0x00}, rest)
+	assert.True(t, decoded.Is(ber.ClassUniversal, ber.TagSequence))
+	require.Len(t, decoded.Children, 4)
+	i, err := decoded.Children[0].Int()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), i)
+	assert.Equal(t, []byte{0x01, 0x02}, decoded.Find(ber.ClassContextSpecific, 0).Value)
+	assert.NotNil(t, decoded.Find(ber.ClassApplication, 11).Find(ber.ClassUniversal, ber.TagNull))
+	assert.Equal(t, []byte{0xff}, decoded.Find(ber.ClassContextSpecific, 40).Value)
+	assert.Nil(t, decoded.Find(ber.ClassContextSpecific, 1))
+}
+
+func TestLongLength(t *testing.T) {
+	value := make([]byte, 300)
+	b := ber.NewOctetString(value).Marshal()
+	assert.Equal(t, "0482012c", hex.EncodeToString(b[:4]))
+	decoded, rest, err := ber.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, value, decoded.Value)
+}
+
+func TestIndefiniteLength(t *testing.T) {
+	b, _ := hex.DecodeString("3080020101a18004010000000000")
+	decoded, rest, err := ber.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	require.Len(t, decoded.Children, 2)
+	assert.Equal(t, []byte{0x00}, decoded.Children[1].Find(ber.ClassUniversal, ber.TagOctetString).Value)
+	// Re-encoded with definite lengths
+	assert.Equal(t, "3008020101a103040100", hex.EncodeToString(decoded.Marshal()))
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, encoded := range []string{"", "04", "0405010203", "3080020101", "0484ffffffff"} {
+		b, _ := hex.DecodeString(encoded)
+		_, _, err := ber.Unmarshal(b)
+		assert.Error(t, err, encoded)
+	}
+}
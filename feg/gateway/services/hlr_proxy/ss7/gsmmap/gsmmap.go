@@ -0,0 +1,468 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gsmmap implements BER encoding of the 3GPP TS 29.002 MAP operations
+// used by the HLR proxy: SendAuthenticationInfo & UpdateGprsLocation
+package gsmmap
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/ber"
+)
+
+// Local operation codes (29.002 17.5)
+const (
+	OpUpdateGprsLocation     int64 = 23
+	OpInsertSubscriberData   int64 = 7
+	OpSendAuthenticationInfo int64 = 56
+)
+
+// Application context names (29.002 17.3.3)
+var (
+	InfoRetrievalContextV3      = []uint32{0, 4, 0, 0, 1, 0, 14, 3}
+	GprsLocationUpdateContextV3 = []uint32{0, 4, 0, 0, 1, 0, 32, 3}
+)
+
+// RequestingNodeType (29.002 17.7.4)
+type RequestingNodeType int64
+
+const (
+	NodeVLR           RequestingNodeType = 0
+	NodeSGSN          RequestingNodeType = 1
+	NodeWLANAAAServer RequestingNodeType = 5
+	NodeMME           RequestingNodeType = 16
+)
+
+// Used-RAT-Type (29.002 17.7.1)
+type UsedRATType int64
+
+const (
+	RATUTRAN  UsedRATType = 0
+	RATGERAN  UsedRATType = 1
+	RATGAN    UsedRATType = 2
+	RATEUTRAN UsedRATType = 4
+)
+
+// Local error codes (29.002 17.6.1)
+const (
+	ErrUnknownSubscriber      int64 = 1
+	ErrUnidentifiedSubscriber int64 = 5
+	ErrUnknownEquipment       int64 = 7
+	ErrRoamingNotAllowed      int64 = 8
+	ErrIllegalSubscriber      int64 = 9
+	ErrIllegalEquipment       int64 = 12
+	ErrFacilityNotSupported   int64 = 21
+	ErrSystemFailure          int64 = 34
+	ErrDataMissing            int64 = 35
+	ErrUnexpectedDataValue    int64 = 36
+)
+
+var errorNames = map[int64]string{
+	ErrUnknownSubscriber:      "unknownSubscriber",
+	ErrUnidentifiedSubscriber: "unidentifiedSubscriber",
+	ErrUnknownEquipment:       "unknownEquipment",
+	ErrRoamingNotAllowed:      "roamingNotAllowed",
+	ErrIllegalSubscriber:      "illegalSubscriber",
+	ErrIllegalEquipment:       "illegalEquipment",
+	ErrFacilityNotSupported:   "facilityNotSupported",
+	ErrSystemFailure:          "systemFailure",
+	ErrDataMissing:            "dataMissing",
+	ErrUnexpectedDataValue:    "unexpectedDataValue",
+}
+
+// Error is a MAP user error returned in a TCAP ReturnError component
+type Error struct {
+	Code int64
+}
+
+func (e *Error) Error() string {
+	if name, ok := errorNames[e.Code]; ok {
+		return fmt.Sprintf("MAP error: %s (%d)", name, e.Code)
+	}
+	return fmt.Sprintf("MAP error: %d", e.Code)
+}
+
+// Re-synchronisationInfo (29.002 17.7.1)
+type ResyncInfo struct {
+	RAND []byte
+	AUTS []byte
+}
+
+// SendAuthenticationInfoArg ::= SEQUENCE {
+//
+//	imsi                         [0] IMSI,
+//	numberOfRequestedVectors     NumberOfRequestedVectors,
+//	segmentationProhibited       NULL OPTIONAL,
+//	immediateResponsePreferred   [1] NULL OPTIONAL,
+//	re-synchronisationInfo       Re-synchronisationInfo OPTIONAL,
+//	extensionContainer           [2] ExtensionContainer OPTIONAL,
+//	...,
+//	requestingNodeType           [3] RequestingNodeType OPTIONAL,
+//	... }
+type SendAuthenticationInfoArg struct {
+	IMSI                       string
+	NumberOfRequestedVectors   uint32
+	ImmediateResponsePreferred bool
+	ResyncInfo                 *ResyncInfo
+	RequestingNodeType         *RequestingNodeType
+}
+
+// AuthenticationTriplet ::= SEQUENCE { rand RAND, sres SRES, kc Kc, ... }
+type AuthenticationTriplet struct {
+	RAND []byte
+	SRES []byte
+	Kc   []byte
+}
+
+// AuthenticationQuintuplet ::= SEQUENCE { rand RAND, xres XRES, ck CK, ik IK, autn AUTN, ... }
+type AuthenticationQuintuplet struct {
+	RAND []byte
+	XRES []byte
+	CK   []byte
+	IK   []byte
+	AUTN []byte
+}
+
+// SendAuthenticationInfoRes ::= [3] SEQUENCE {
+//
+//	authenticationSetList     AuthenticationSetList OPTIONAL,
+//	extensionContainer        ExtensionContainer OPTIONAL,
+//	... }
+//
+// AuthenticationSetList ::= CHOICE {
+//
+//	tripletList               [0] TripletList,
+//	quintupletList            [1] QuintupletList }
+type SendAuthenticationInfoRes struct {
+	Triplets    []AuthenticationTriplet
+	Quintuplets []AuthenticationQuintuplet
+}
+
+// UpdateGprsLocationArg ::= SEQUENCE {
+//
+//	imsi                      IMSI,
+//	sgsn-Number               ISDN-AddressString,
+//	sgsn-Address              GSN-Address,
+//	extensionContainer        ExtensionContainer OPTIONAL,
+//	...,
+//	usedRAT-Type              [8] Used-RAT-Type OPTIONAL,
+//	... }
+type UpdateGprsLocationArg struct {
+	IMSI        string
+	SGSNNumber  string
+	SGSNAddress net.IP
+	UsedRATType *UsedRATType
+}
+
+// UpdateGprsLocationRes ::= SEQUENCE {
+//
+//	hlr-Number                ISDN-AddressString,
+//	extensionContainer        ExtensionContainer OPTIONAL,
+//	... }
+type UpdateGprsLocationRes struct {
+	HLRNumber string
+}
+
+// Encode returns the BER element of the argument
+func (a *SendAuthenticationInfoArg) Encode() (*ber.TLV, error) {
+	imsi, err := EncodeTBCD(a.IMSI)
+	if err != nil {
+		return nil, err
+	}
+	if a.NumberOfRequestedVectors < 1 || a.NumberOfRequestedVectors > 5 {
+		return nil, fmt.Errorf("invalid number of requested vectors: %d", a.NumberOfRequestedVectors)
+	}
+	res := ber.NewSequence(
+		ber.NewPrimitive(ber.ClassContextSpecific, 0, imsi),
+		ber.NewInteger(int64(a.NumberOfRequestedVectors)))
+	if a.ImmediateResponsePreferred {
+		res.Children = append(res.Children, ber.NewNull(ber.ClassContextSpecific, 1))
+	}
+	if a.ResyncInfo != nil {
+		res.Children = append(res.Children, ber.NewSequence(
+			ber.NewOctetString(a.ResyncInfo.RAND),
+			ber.NewOctetString(a.ResyncInfo.AUTS)))
+	}
+	if a.RequestingNodeType != nil {
+		res.Children = append(res.Children,
+			ber.NewPrimitive(ber.ClassContextSpecific, 3, ber.EncodeInteger(int64(*a.RequestingNodeType))))
+	}
+	return res, nil
+}
+
+// DecodeSendAuthenticationInfoArg decodes the argument of a SendAuthenticationInfo invoke
+func DecodeSendAuthenticationInfoArg(t *ber.TLV) (*SendAuthenticationInfoArg, error) {
+	if !t.Is(ber.ClassUniversal, ber.TagSequence) {
+		return nil, errors.New("invalid SendAuthenticationInfoArg")
+	}
+	imsi := t.Find(ber.ClassContextSpecific, 0)
+	if imsi == nil {
+		return nil, errors.New("missing IMSI in SendAuthenticationInfoArg")
+	}
+	num, err := t.Find(ber.ClassUniversal, ber.TagInteger).Int()
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of requested vectors: %v", err)
+	}
+	a := &SendAuthenticationInfoArg{
+		IMSI:                       DecodeTBCD(imsi.Value),
+		NumberOfRequestedVectors:   uint32(num),
+		ImmediateResponsePreferred: t.Find(ber.ClassContextSpecific, 1) != nil,
+	}
+	if resync := t.Find(ber.ClassUniversal, ber.TagSequence); resync != nil {
+		if len(resync.Children) != 2 {
+			return nil, errors.New("invalid Re-synchronisationInfo")
+		}
+		a.ResyncInfo = &ResyncInfo{RAND: resync.Children[0].Value, AUTS: resync.Children[1].Value}
+	}
+	if nodeType := t.Find(ber.ClassContextSpecific, 3); nodeType != nil {
+		v, err := nodeType.Int()
+		if err != nil {
+			return nil, err
+		}
+		rnt := RequestingNodeType(v)
+		a.RequestingNodeType = &rnt
+	}
+	return a, nil
+}
+
+// Encode returns the BER element of the result
+func (r *SendAuthenticationInfoRes) Encode() *ber.TLV {
+	res := ber.NewConstructed(ber.ClassContextSpecific, 3)
+	if len(r.Quintuplets) > 0 {
+		list := ber.NewConstructed(ber.ClassContextSpecific, 1)
+		for _, q := range r.Quintuplets {
+			list.Children = append(list.Children, ber.NewSequence(
+				ber.NewOctetString(q.RAND),
+				ber.NewOctetString(q.XRES),
+				ber.NewOctetString(q.CK),
+				ber.NewOctetString(q.IK),
+				ber.NewOctetString(q.AUTN)))
+		}
+		res.Children = append(res.Children, list)
+	} else if len(r.Triplets) > 0 {
+		list := ber.NewConstructed(ber.ClassContextSpecific, 0)
+		for _, tr := range r.Triplets {
+			list.Children = append(list.Children, ber.NewSequence(
+				ber.NewOctetString(tr.RAND),
+				ber.NewOctetString(tr.SRES),
+				ber.NewOctetString(tr.Kc)))
+		}
+		res.Children = append(res.Children, list)
+	}
+	return res
+}
+
+// DecodeSendAuthenticationInfoRes decodes the result of SendAuthenticationInfo,
+// both the version 3 [3] tagged result & the version 2 triplet list are supported
+func DecodeSendAuthenticationInfoRes(t *ber.TLV) (*SendAuthenticationInfoRes, error) {
+	res := &SendAuthenticationInfoRes{}
+	if t == nil {
+		return res, nil
+	}
+	var triplets, quintuplets *ber.TLV
+	switch {
+	case t.Is(ber.ClassContextSpecific, 3):
+		triplets = t.Find(ber.ClassContextSpecific, 0)
+		quintuplets = t.Find(ber.ClassContextSpecific, 1)
+	case t.Is(ber.ClassUniversal, ber.TagSequence):
+		triplets = t
+	default:
+		return nil, fmt.Errorf("invalid SendAuthenticationInfoRes %s", t)
+	}
+	if triplets != nil {
+		for _, s := range triplets.Children {
+			if len(s.Children) < 3 {
+				return nil, errors.New("invalid AuthenticationTriplet")
+			}
+			res.Triplets = append(res.Triplets, AuthenticationTriplet{
+				RAND: s.Children[0].Value,
+				SRES: s.Children[1].Value,
+				Kc:   s.Children[2].Value,
+			})
+		}
+	}
+	if quintuplets != nil {
+		for _, s := range quintuplets.Children {
+			if len(s.Children) < 5 {
+				return nil, errors.New("invalid AuthenticationQuintuplet")
+			}
+			res.Quintuplets = append(res.Quintuplets, AuthenticationQuintuplet{
+				RAND: s.Children[0].Value,
+				XRES: s.Children[1].Value,
+				CK:   s.Children[2].Value,
+				IK:   s.Children[3].Value,
+				AUTN: s.Children[4].Value,
+			})
+		}
+	}
+	return res, nil
+}
+
+// Encode returns the BER element of the argument
+func (a *UpdateGprsLocationArg) Encode() (*ber.TLV, error) {
+	imsi, err := EncodeTBCD(a.IMSI)
+	if err != nil {
+		return nil, err
+	}
+	sgsnNumber, err := EncodeISDNAddress(a.SGSNNumber)
+	if err != nil {
+		return nil, err
+	}
+	sgsnAddress, err := EncodeGSNAddress(a.SGSNAddress)
+	if err != nil {
+		return nil, err
+	}
+	res := ber.NewSequence(
+		ber.NewOctetString(imsi),
+		ber.NewOctetString(sgsnNumber),
+		ber.NewOctetString(sgsnAddress))
+	if a.UsedRATType != nil {
+		res.Children = append(res.Children,
+			ber.NewPrimitive(ber.ClassContextSpecific, 8, ber.EncodeInteger(int64(*a.UsedRATType))))
+	}
+	return res, nil
+}
+
+// DecodeUpdateGprsLocationArg decodes the argument of an UpdateGprsLocation invoke
+func DecodeUpdateGprsLocationArg(t *ber.TLV) (*UpdateGprsLocationArg, error) {
+	if !t.Is(ber.ClassUniversal, ber.TagSequence) || len(t.Children) < 3 {
+		return nil, errors.New("invalid UpdateGprsLocationArg")
+	}
+	a := &UpdateGprsLocationArg{IMSI: DecodeTBCD(t.Children[0].Value)}
+	var err error
+	if a.SGSNNumber, err = DecodeISDNAddress(t.Children[1].Value); err != nil {
+		return nil, err
+	}
+	if a.SGSNAddress, err = DecodeGSNAddress(t.Children[2].Value); err != nil {
+		return nil, err
+	}
+	if rat := t.Find(ber.ClassContextSpecific, 8); rat != nil {
+		v, err := rat.Int()
+		if err != nil {
+			return nil, err
+		}
+		ratType := UsedRATType(v)
+		a.UsedRATType = &ratType
+	}
+	return a, nil
+}
+
+// Encode returns the BER element of the result
+func (r *UpdateGprsLocationRes) Encode() (*ber.TLV, error) {
+	hlrNumber, err := EncodeISDNAddress(r.HLRNumber)
+	if err != nil {
+		return nil, err
+	}
+	return ber.NewSequence(ber.NewOctetString(hlrNumber)), nil
+}
+
+// DecodeUpdateGprsLocationRes decodes the result of UpdateGprsLocation
+func DecodeUpdateGprsLocationRes(t *ber.TLV) (*UpdateGprsLocationRes, error) {
+	if !t.Is(ber.ClassUniversal, ber.TagSequence) || len(t.Children) < 1 {
+		return nil, errors.New("invalid UpdateGprsLocationRes")
+	}
+	hlrNumber, err := DecodeISDNAddress(t.Children[0].Value)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateGprsLocationRes{HLRNumber: hlrNumber}, nil
+}
+
+// EncodeTBCD encodes digits as a TBCD-STRING (29.002 17.7.8), an odd number
+// of digits is padded with the 0xF filler
+func EncodeTBCD(digits string) ([]byte, error) {
+	if len(digits) == 0 {
+		return nil, errors.New("empty TBCD string")
+	}
+	res := make([]byte, (len(digits)+1)/2)
+	for i, d := range digits {
+		if d < '0' || d > '9' {
+			return nil, fmt.Errorf("invalid digit '%c' in '%s'", d, digits)
+		}
+		if i%2 == 0 {
+			res[i/2] = byte(d-'0') | 0xF0
+		} else {
+			res[i/2] = res[i/2]&0x0F | byte(d-'0')<<4
+		}
+	}
+	return res, nil
+}
+
+// DecodeTBCD decodes a TBCD-STRING up to the first filler
+func DecodeTBCD(b []byte) string {
+	var sb strings.Builder
+	for _, o := range b {
+		for _, d := range []byte{o & 0x0F, o >> 4} {
+			if d > 9 {
+				return sb.String()
+			}
+			sb.WriteByte('0' + d)
+		}
+	}
+	return sb.String()
+}
+
+// international number, ISDN/telephony numbering plan (E.164)
+const isdnInternationalE164 = 0x91
+
+// EncodeISDNAddress encodes an international E.164 number as an ISDN-AddressString
+func EncodeISDNAddress(number string) ([]byte, error) {
+	digits, err := EncodeTBCD(strings.TrimPrefix(number, "+"))
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{isdnInternationalE164}, digits...), nil
+}
+
+// DecodeISDNAddress decodes an ISDN-AddressString, the nature of address
+// & numbering plan octet is skipped
+func DecodeISDNAddress(b []byte) (string, error) {
+	if len(b) < 2 {
+		return "", errors.New("invalid ISDN-AddressString")
+	}
+	return DecodeTBCD(b[1:]), nil
+}
+
+// EncodeGSNAddress encodes an IP address as a GSN-Address (3GPP TS 23.003 5.1):
+// address type & length octet followed by the address
+func EncodeGSNAddress(ip net.IP) ([]byte, error) {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return append([]byte{0x04}, ipv4...), nil
+	}
+	if len(ip) == net.IPv6len {
+		return append([]byte{0x50}, ip...), nil
+	}
+	return nil, fmt.Errorf("invalid GSN address: %v", ip)
+}
+
+// DecodeGSNAddress decodes a GSN-Address
+func DecodeGSNAddress(b []byte) (net.IP, error) {
+	if len(b) < 1 || int(b[0]&0x3F) != len(b)-1 {
+		return nil, errors.New("invalid GSN-Address")
+	}
+	switch b[0] >> 6 {
+	case 0:
+		if len(b) == 1+net.IPv4len {
+			return net.IP(b[1:]), nil
+		}
+	case 1:
+		if len(b) == 1+net.IPv6len {
+			return net.IP(b[1:]), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported GSN-Address type: 0x%02X", b[0])
+}
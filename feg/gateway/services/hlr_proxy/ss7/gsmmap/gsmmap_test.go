@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gsmmap_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/ber"
+	"magma/feg/gateway/services/hlr_proxy/ss7/gsmmap"
+	"magma/feg/gateway/services/hlr_proxy/ss7/tcap"
+)
+
+const imsi = "001010000000001"
+
+var tid = []byte{0, 0, 0, 1}
+
+// TC-BEGIN of SendAuthenticationInfo v3 requesting one vector for a WLAN AAA server
+const saiBegin = "6242" + "480400000001" +
+	"6b1e281c060700118605010101a011600f80020780a109060704000001000e03" +
+	"6c1aa118" + "020101" + "020138" +
+	"3010" + "800800010100000000f1" + "020101" + "830105"
+
+// TC-END of SendAuthenticationInfo v3 with one quintuplet
+var saiEnd = "648196" + "490400000001" +
+	"6b2a2828060700118605010101a01d611b80020780a109060704000001000e03a203020100a305a103020100" +
+	"6c62a260" + "020101" + "305b" + "020138" + "a356a1543052" +
+	"0410" + hex.EncodeToString(bytes.Repeat([]byte{0x11}, 16)) +
+	"0408" + hex.EncodeToString(bytes.Repeat([]byte{0x22}, 8)) +
+	"0410" + hex.EncodeToString(bytes.Repeat([]byte{0x33}, 16)) +
+	"0410" + hex.EncodeToString(bytes.Repeat([]byte{0x44}, 16)) +
+	"0410" + hex.EncodeToString(bytes.Repeat([]byte{0x55}, 16))
+
+func TestSendAuthenticationInfoBegin(t *testing.T) {
+	nodeType := gsmmap.NodeWLANAAAServer
+	arg, err := (&gsmmap.SendAuthenticationInfoArg{
+		IMSI:                     imsi,
+		NumberOfRequestedVectors: 1,
+		RequestingNodeType:       &nodeType,
+	}).Encode()
+	require.NoError(t, err)
+	b, err := (&tcap.Message{
+		Type:       tcap.Begin,
+		OTID:       tid,
+		Dialogue:   tcap.NewAARQ(gsmmap.InfoRetrievalContextV3),
+		Components: []*tcap.Component{tcap.NewInvoke(1, gsmmap.OpSendAuthenticationInfo, arg)},
+	}).Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, saiBegin, hex.EncodeToString(b))
+
+	msg, err := tcap.Unmarshal(b)
+	require.NoError(t, err)
+	require.Len(t, msg.Components, 1)
+	decoded, err := gsmmap.DecodeSendAuthenticationInfoArg(msg.Components[0].Parameter)
+	require.NoError(t, err)
+	assert.Equal(t, imsi, decoded.IMSI)
+	assert.Equal(t, uint32(1), decoded.NumberOfRequestedVectors)
+	require.NotNil(t, decoded.RequestingNodeType)
+	assert.Equal(t, gsmmap.NodeWLANAAAServer, *decoded.RequestingNodeType)
+	assert.Nil(t, decoded.ResyncInfo)
+
+	_, err = (&gsmmap.SendAuthenticationInfoArg{IMSI: imsi}).Encode()
+	assert.Error(t, err)
+}
+
+func TestSendAuthenticationInfoEnd(t *testing.T) {
+	b, _ := hex.DecodeString(saiEnd)
+	msg, err := tcap.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, tcap.End, msg.Type)
+	assert.Equal(t, gsmmap.InfoRetrievalContextV3, msg.Dialogue.ApplicationContext)
+	require.Len(t, msg.Components, 1)
+	c := msg.Components[0]
+	assert.Equal(t, tcap.ReturnResultLast, c.Type)
+	require.NotNil(t, c.OpCode)
+	assert.Equal(t, gsmmap.OpSendAuthenticationInfo, *c.OpCode)
+
+	res, err := gsmmap.DecodeSendAuthenticationInfoRes(c.Parameter)
+	require.NoError(t, err)
+	require.Len(t, res.Quintuplets, 1)
+	q := res.Quintuplets[0]
+	assert.Equal(t, bytes.Repeat([]byte{0x11}, 16), q.RAND)
+	assert.Equal(t, bytes.Repeat([]byte{0x22}, 8), q.XRES)
+	assert.Equal(t, bytes.Repeat([]byte{0x33}, 16), q.CK)
+	assert.Equal(t, bytes.Repeat([]byte{0x44}, 16), q.IK)
+	assert.Equal(t, bytes.Repeat([]byte{0x55}, 16), q.AUTN)
+
+	// Re-encode the same answer
+	end := &tcap.Message{
+		Type:     tcap.End,
+		DTID:     tid,
+		Dialogue: msg.Dialogue,
+		Components: []*tcap.Component{
+			tcap.NewReturnResultLast(1, gsmmap.OpSendAuthenticationInfo, res.Encode())},
+	}
+	b, err = end.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, saiEnd, hex.EncodeToString(b))
+}
+
+func TestSendAuthenticationInfoResV2(t *testing.T) {
+	// Version 2 result: SEQUENCE OF AuthenticationSet (triplets)
+	b, _ := hex.DecodeString("3024" + "3022" +
+		"0410" + "000102030405060708090a0b0c0d0e0f" + "0404" + "a0a1a2a3" + "0408" + "b0b1b2b3b4b5b6b7")
+	t2, _, err := ber.Unmarshal(b)
+	require.NoError(t, err)
+	res, err := gsmmap.DecodeSendAuthenticationInfoRes(t2)
+	require.NoError(t, err)
+	require.Len(t, res.Triplets, 1)
+	assert.Empty(t, res.Quintuplets)
+	assert.Equal(t, []byte{0xa0, 0xa1, 0xa2, 0xa3}, res.Triplets[0].SRES)
+	assert.Equal(t, []byte{0xb0, 0xb1, 0xb2, 0xb3, 0xb4, 0xb5, 0xb6, 0xb7}, res.Triplets[0].Kc)
+}
+
+func TestResyncInfo(t *testing.T) {
+	rand := bytes.Repeat([]byte{0xaa}, 16)
+	auts := bytes.Repeat([]byte{0xbb}, 14)
+	arg, err := (&gsmmap.SendAuthenticationInfoArg{
+		IMSI:                     imsi,
+		NumberOfRequestedVectors: 2,
+		ResyncInfo:               &gsmmap.ResyncInfo{RAND: rand, AUTS: auts},
+	}).Encode()
+	require.NoError(t, err)
+	assert.Equal(t,
+		"3031"+"800800010100000000f1"+"020102"+"3022"+"0410"+hex.EncodeToString(rand)+"040e"+hex.EncodeToString(auts),
+		hex.EncodeToString(arg.Marshal()))
+	decoded, err := gsmmap.DecodeSendAuthenticationInfoArg(arg)
+	require.NoError(t, err)
+	require.NotNil(t, decoded.ResyncInfo)
+	assert.Equal(t, rand, decoded.ResyncInfo.RAND)
+	assert.Equal(t, auts, decoded.ResyncInfo.AUTS)
+	assert.Nil(t, decoded.RequestingNodeType)
+}
+
+func TestUpdateGprsLocation(t *testing.T) {
+	arg, err := (&gsmmap.UpdateGprsLocationArg{
+		IMSI:        imsi,
+		SGSNNumber:  "15555550000",
+		SGSNAddress: net.ParseIP("10.0.0.1"),
+	}).Encode()
+	require.NoError(t, err)
+	assert.Equal(t,
+		"301a"+"040800010100000000f1"+"0407915155550500f0"+"0405040a000001",
+		hex.EncodeToString(arg.Marshal()))
+	decoded, err := gsmmap.DecodeUpdateGprsLocationArg(arg)
+	require.NoError(t, err)
+	assert.Equal(t, imsi, decoded.IMSI)
+	assert.Equal(t, "15555550000", decoded.SGSNNumber)
+	assert.True(t, net.ParseIP("10.0.0.1").Equal(decoded.SGSNAddress))
+
+	b, _ := hex.DecodeString("3009" + "0407915155550501f0")
+	t2, _, err := ber.Unmarshal(b)
+	require.NoError(t, err)
+	res, err := gsmmap.DecodeUpdateGprsLocationRes(t2)
+	require.NoError(t, err)
+	assert.Equal(t, "15555550100", res.HLRNumber)
+}
+
+func TestAddresses(t *testing.T) {
+	b, err := gsmmap.EncodeTBCD(imsi)
+	require.NoError(t, err)
+	assert.Equal(t, "00010100000000f1", hex.EncodeToString(b))
+	assert.Equal(t, imsi, gsmmap.DecodeTBCD(b))
+	_, err = gsmmap.EncodeTBCD("12a")
+	assert.Error(t, err)
+
+	b, err = gsmmap.EncodeGSNAddress(net.ParseIP("2001:db8::1"))
+	require.NoError(t, err)
+	assert.Equal(t, "5020010db8000000000000000000000001", hex.EncodeToString(b))
+	ip, err := gsmmap.DecodeGSNAddress(b)
+	require.NoError(t, err)
+	assert.True(t, net.ParseIP("2001:db8::1").Equal(ip))
+	_, err = gsmmap.DecodeGSNAddress([]byte{0x04, 1, 2})
+	assert.Error(t, err)
+}
+
+func TestError(t *testing.T) {
+	assert.Equal(t, "MAP error: unknownSubscriber (1)", (&gsmmap.Error{Code: gsmmap.ErrUnknownSubscriber}).Error())
+}
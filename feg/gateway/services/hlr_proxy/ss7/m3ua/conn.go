@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package m3ua
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/ishidawataru/sctp"
+)
+
+const (
+	// PayloadProtocolID is the SCTP Payload Protocol Identifier of M3UA (RFC 4666 1.4.7)
+	PayloadProtocolID = 3
+
+	DefaultHandshakeTimeout = 5 * time.Second
+	dataChanSize            = 64
+	maxSctpMsgLen           = maxMsgLen
+)
+
+// Config defines an M3UA association with a Signaling Gateway or an IPSP peer
+type Config struct {
+	Network           string // tcp or sctp
+	Address           string
+	LocalAddress      string
+	RoutingContext    uint32 // 0 - not sent
+	NetworkAppearance uint32 // 0 - not sent
+	TrafficMode       uint32 // 0 - not sent
+	HandshakeTimeout  time.Duration
+}
+
+// Conn is an ASP side M3UA association. Dial brings the ASP to the ASP-ACTIVE
+// state, Conn then answers heartbeats & delivers received DATA payloads on
+// the Data channel until the association is lost or closed
+type Conn struct {
+	cfg       Config
+	transport transport
+	data      chan *ProtocolData
+	done      chan struct{}
+	writeLock sync.Mutex
+	closeOnce sync.Once
+	err       error
+}
+
+// Dial connects to the peer and performs ASP Up & ASP Active procedures
+func Dial(cfg Config) (*Conn, error) {
+	if cfg.HandshakeTimeout == 0 {
+		cfg.HandshakeTimeout = DefaultHandshakeTimeout
+	}
+	t, err := dialTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c := &Conn{
+		cfg:       cfg,
+		transport: t,
+		data:      make(chan *ProtocolData, dataChanSize),
+		done:      make(chan struct{}),
+	}
+	if err = c.handshake(); err != nil {
+		t.Close()
+		return nil, err
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+// Data returns the channel of received DATA payloads, receivers should also
+// select on Done to detect the loss of the association
+func (c *Conn) Data() <-chan *ProtocolData {
+	return c.data
+}
+
+// Done returns a channel which is closed when the association is lost or closed
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the reason the association was lost
+func (c *Conn) Err() error {
+	select {
+	case <-c.done:
+		return c.err
+	default:
+		return nil
+	}
+}
+
+// WriteData sends a DATA message with the given payload
+func (c *Conn) WriteData(pd *ProtocolData) error {
+	select {
+	case <-c.done:
+		return fmt.Errorf("M3UA association with %s is down: %v", c.cfg.Address, c.err)
+	default:
+	}
+	return c.write(NewData(c.cfg.NetworkAppearance, c.cfg.RoutingContext, pd))
+}
+
+// Close sends ASP Down & closes the association
+func (c *Conn) Close() error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+	}
+	if err := c.write(NewMessage(ClassASPSM, TypeASPDN)); err != nil {
+		glog.V(2).Infof("failed to send M3UA ASPDN to %s: %v", c.cfg.Address, err)
+	}
+	c.shutdown(errors.New("closed"))
+	return nil
+}
+
+func (c *Conn) handshake() error {
+	deadline := time.Now().Add(c.cfg.HandshakeTimeout)
+	c.transport.SetDeadline(deadline)
+	defer c.transport.SetDeadline(time.Time{})
+
+	if err := c.write(NewMessage(ClassASPSM, TypeASPUP)); err != nil {
+		return err
+	}
+	if err := c.waitFor(ClassASPSM, TypeASPUPAck); err != nil {
+		return fmt.Errorf("M3UA ASP Up failed: %v", err)
+	}
+	aspac := NewMessage(ClassASPTM, TypeASPAC)
+	if c.cfg.TrafficMode != 0 {
+		aspac.Params = append(aspac.Params, Uint32Param(TagTrafficModeType, c.cfg.TrafficMode))
+	}
+	if c.cfg.RoutingContext != 0 {
+		aspac.Params = append(aspac.Params, Uint32Param(TagRoutingContext, c.cfg.RoutingContext))
+	}
+	if err := c.write(aspac); err != nil {
+		return err
+	}
+	if err := c.waitFor(ClassASPTM, TypeASPACAck); err != nil {
+		return fmt.Errorf("M3UA ASP Active failed: %v", err)
+	}
+	glog.Infof("M3UA ASP active with %s", c.cfg.Address)
+	return nil
+}
+
+// waitFor reads messages until the expected one is received, notifications
+// received during the handshake are only logged
+func (c *Conn) waitFor(class, msgType uint8) error {
+	for {
+		m, err := c.transport.ReadMessage()
+		if err != nil {
+			return err
+		}
+		switch {
+		case m.Class == class && m.Type == msgType:
+			return nil
+		case m.Class == ClassMGMT && m.Type == TypeERR:
+			code, _ := m.Uint32(TagErrorCode)
+			return fmt.Errorf("peer error code: 0x%02X", code)
+		case m.Class == ClassMGMT && m.Type == TypeNTFY:
+			status, _ := m.Uint32(TagStatus)
+			glog.V(2).Infof("M3UA notify from %s, status: 0x%08X", c.cfg.Address, status)
+		default:
+			glog.V(2).Infof("unexpected %s during M3UA handshake with %s", m, c.cfg.Address)
+		}
+	}
+}
+
+func (c *Conn) readLoop() {
+	for {
+		m, err := c.transport.ReadMessage()
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+		switch {
+		case m.Class == ClassTRANS && m.Type == TypeDATA:
+			pd, err := m.ProtocolData()
+			if err != nil {
+				glog.Errorf("invalid M3UA DATA from %s: %v", c.cfg.Address, err)
+				continue
+			}
+			select {
+			case c.data <- pd:
+			case <-c.done:
+				return
+			}
+		case m.Class == ClassASPSM && m.Type == TypeBEAT:
+			ack := NewMessage(ClassASPSM, TypeBEATAck)
+			if hb := m.Find(TagHeartbeatData); hb != nil {
+				ack.Params = append(ack.Params, *hb)
+			}
+			if err = c.write(ack); err != nil {
+				glog.Errorf("failed to send M3UA BEAT ACK to %s: %v", c.cfg.Address, err)
+			}
+		case m.Class == ClassMGMT && m.Type == TypeNTFY:
+			status, _ := m.Uint32(TagStatus)
+			glog.Infof("M3UA notify from %s, status: 0x%08X", c.cfg.Address, status)
+		case m.Class == ClassMGMT && m.Type == TypeERR:
+			code, _ := m.Uint32(TagErrorCode)
+			glog.Errorf("M3UA error from %s, code: 0x%02X", c.cfg.Address, code)
+		case m.Class == ClassASPSM && m.Type == TypeASPDNAck,
+			m.Class == ClassASPTM && m.Type == TypeASPIAAck:
+			c.shutdown(fmt.Errorf("ASP is no longer active at %s", c.cfg.Address))
+			return
+		default:
+			glog.V(2).Infof("ignoring %s from %s", m, c.cfg.Address)
+		}
+	}
+}
+
+func (c *Conn) write(m *Message) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	return c.transport.WriteMessage(m)
+}
+
+func (c *Conn) shutdown(err error) {
+	c.closeOnce.Do(func() {
+		c.err = err
+		close(c.done)
+		c.transport.Close()
+		glog.Infof("M3UA association with %s closed: %v", c.cfg.Address, err)
+	})
+}
+
+// transport hides the message framing differences of stream & SCTP transports
+type transport interface {
+	ReadMessage() (*Message, error)
+	WriteMessage(m *Message) error
+	SetDeadline(t time.Time) error
+	Close() error
+}
+
+func dialTransport(cfg Config) (transport, error) {
+	switch strings.ToLower(cfg.Network) {
+	case "", "tcp", "tcp4", "tcp6":
+		dialer := net.Dialer{Timeout: cfg.HandshakeTimeout}
+		if len(cfg.LocalAddress) > 0 {
+			localAddr, err := net.ResolveTCPAddr("tcp", cfg.LocalAddress)
+			if err != nil {
+				return nil, err
+			}
+			dialer.LocalAddr = localAddr
+		}
+		conn, err := dialer.Dial("tcp", cfg.Address)
+		if err != nil {
+			return nil, err
+		}
+		return &streamTransport{conn}, nil
+	case "sctp", "sctp4", "sctp6":
+		remoteAddr, err := sctp.ResolveSCTPAddr("sctp", cfg.Address)
+		if err != nil {
+			return nil, err
+		}
+		var localAddr *sctp.SCTPAddr
+		if len(cfg.LocalAddress) > 0 {
+			if localAddr, err = sctp.ResolveSCTPAddr("sctp", cfg.LocalAddress); err != nil {
+				return nil, err
+			}
+		}
+		conn, err := sctp.DialSCTP("sctp", localAddr, remoteAddr)
+		if err != nil {
+			return nil, err
+		}
+		return &sctpTransport{conn}, nil
+	default:
+		return nil, fmt.Errorf("unsupported M3UA transport: %s", cfg.Network)
+	}
+}
+
+type streamTransport struct {
+	net.Conn
+}
+
+func (t *streamTransport) ReadMessage() (*Message, error) {
+	return ReadMessage(t.Conn)
+}
+
+func (t *streamTransport) WriteMessage(m *Message) error {
+	_, err := t.Conn.Write(m.Marshal())
+	return err
+}
+
+type sctpTransport struct {
+	*sctp.SCTPConn
+}
+
+func (t *sctpTransport) ReadMessage() (*Message, error) {
+	buf := make([]byte, maxSctpMsgLen)
+	n, err := t.SCTPConn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return Unmarshal(buf[:n])
+}
+
+func (t *sctpTransport) WriteMessage(m *Message) error {
+	// Stream 0 is reserved for management messages, all others use stream 1
+	var stream uint16
+	if m.Class == ClassTRANS {
+		stream = 1
+	}
+	// sinfo_ppid is passed to the kernel as is and must be in network byte order,
+	// FeG platforms are little endian
+	ppid := make([]byte, 4)
+	binary.BigEndian.PutUint32(ppid, PayloadProtocolID)
+	_, err := t.SCTPConn.SCTPWrite(m.Marshal(), &sctp.SndRcvInfo{
+		Stream: stream,
+		PPID:   binary.LittleEndian.Uint32(ppid),
+	})
+	return err
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package m3ua implements SS7 MTP3 User Adaptation Layer (RFC 4666) message
+// framing & the ASP side of the ASP state maintenance procedures
+package m3ua
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	Version    = 1
+	HeaderLen  = 8
+	maxMsgLen  = 0xFFFF
+	paramHdrLn = 4
+)
+
+// Message Classes (RFC 4666 3.1.2)
+const (
+	ClassMGMT  uint8 = 0
+	ClassTRANS uint8 = 1
+	ClassSSNM  uint8 = 2
+	ClassASPSM uint8 = 3
+	ClassASPTM uint8 = 4
+	ClassRKM   uint8 = 9
+)
+
+// Message Types (RFC 4666 3.1.3)
+const (
+	// MGMT
+	TypeERR  uint8 = 0
+	TypeNTFY uint8 = 1
+	// Transfer
+	TypeDATA uint8 = 1
+	// ASPSM
+	TypeASPUP    uint8 = 1
+	TypeASPDN    uint8 = 2
+	TypeBEAT     uint8 = 3
+	TypeASPUPAck uint8 = 4
+	TypeASPDNAck uint8 = 5
+	TypeBEATAck  uint8 = 6
+	// ASPTM
+	TypeASPAC    uint8 = 1
+	TypeASPIA    uint8 = 2
+	TypeASPACAck uint8 = 3
+	TypeASPIAAck uint8 = 4
+)
+
+// Parameter Tags (RFC 4666 3.2)
+const (
+	TagInfoString        uint16 = 0x0004
+	TagRoutingContext    uint16 = 0x0006
+	TagDiagnosticInfo    uint16 = 0x0007
+	TagHeartbeatData     uint16 = 0x0009
+	TagTrafficModeType   uint16 = 0x000B
+	TagErrorCode         uint16 = 0x000C
+	TagStatus            uint16 = 0x000D
+	TagASPIdentifier     uint16 = 0x0011
+	TagCorrelationID     uint16 = 0x0013
+	TagNetworkAppearance uint16 = 0x0200
+	TagProtocolData      uint16 = 0x0210
+)
+
+// Traffic Mode Types (RFC 4666 3.8.1)
+const (
+	TrafficModeOverride  uint32 = 1
+	TrafficModeLoadshare uint32 = 2
+	TrafficModeBroadcast uint32 = 3
+)
+
+// Service Indicator of SCCP user data (ITU-T Q.704 14.2.1)
+const ServiceIndicatorSCCP uint8 = 3
+
+// Param is an M3UA message parameter in TLV format
+type Param struct {
+	Tag   uint16
+	Value []byte
+}
+
+// Message is an M3UA message
+type Message struct {
+	Class  uint8
+	Type   uint8
+	Params []Param
+}
+
+// ProtocolData is the value of the Protocol Data parameter carried by DATA
+// messages (RFC 4666 3.3.1)
+type ProtocolData struct {
+	OPC  uint32
+	DPC  uint32
+	SI   uint8
+	NI   uint8
+	MP   uint8
+	SLS  uint8
+	Data []byte
+}
+
+// NewMessage returns a new message of the given class & type
+func NewMessage(class, msgType uint8, params ...Param) *Message {
+	return &Message{Class: class, Type: msgType, Params: params}
+}
+
+// Uint32Param returns a parameter with a 32 bit value
+func Uint32Param(tag uint16, value uint32) Param {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, value)
+	return Param{Tag: tag, Value: b}
+}
+
+// NewData returns a DATA message carrying the given protocol data, the routing
+// context & network appearance parameters are only added if non zero
+func NewData(networkAppearance, routingContext uint32, pd *ProtocolData) *Message {
+	m := NewMessage(ClassTRANS, TypeDATA)
+	if networkAppearance != 0 {
+		m.Params = append(m.Params, Uint32Param(TagNetworkAppearance, networkAppearance))
+	}
+	if routingContext != 0 {
+		m.Params = append(m.Params, Uint32Param(TagRoutingContext, routingContext))
+	}
+	m.Params = append(m.Params, Param{Tag: TagProtocolData, Value: pd.Marshal()})
+	return m
+}
+
+// Find returns the first parameter with the given tag or nil if not found
+func (m *Message) Find(tag uint16) *Param {
+	for i := range m.Params {
+		if m.Params[i].Tag == tag {
+			return &m.Params[i]
+		}
+	}
+	return nil
+}
+
+// Uint32 returns the 32 bit value of the first parameter with the given tag
+func (m *Message) Uint32(tag uint16) (uint32, bool) {
+	p := m.Find(tag)
+	if p == nil || len(p.Value) != 4 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(p.Value), true
+}
+
+// ProtocolData returns the decoded Protocol Data parameter of a DATA message
+func (m *Message) ProtocolData() (*ProtocolData, error) {
+	p := m.Find(TagProtocolData)
+	if p == nil {
+		return nil, fmt.Errorf("missing Protocol Data in M3UA message %s", m)
+	}
+	return UnmarshalProtocolData(p.Value)
+}
+
+func (m *Message) String() string {
+	return fmt.Sprintf("M3UA{class: %d, type: %d, params: %d}", m.Class, m.Type, len(m.Params))
+}
+
+// Marshal returns the wire representation of the message
+func (m *Message) Marshal() []byte {
+	res := make([]byte, HeaderLen)
+	res[0] = Version
+	res[2] = m.Class
+	res[3] = m.Type
+	for _, p := range m.Params {
+		hdr := make([]byte, paramHdrLn)
+		binary.BigEndian.PutUint16(hdr, p.Tag)
+		binary.BigEndian.PutUint16(hdr[2:], uint16(paramHdrLn+len(p.Value)))
+		res = append(res, hdr...)
+		res = append(res, p.Value...)
+		// parameters are padded to 4 octets boundary, the padding isn't included in parameter length
+		if pad := len(p.Value) % 4; pad != 0 {
+			res = append(res, make([]byte, 4-pad)...)
+		}
+	}
+	binary.BigEndian.PutUint32(res[4:], uint32(len(res)))
+	return res
+}
+
+// Unmarshal decodes a complete M3UA message
+func Unmarshal(b []byte) (*Message, error) {
+	if len(b) < HeaderLen {
+		return nil, fmt.Errorf("M3UA message is too short: %d bytes", len(b))
+	}
+	if b[0] != Version {
+		return nil, fmt.Errorf("unsupported M3UA version: %d", b[0])
+	}
+	length := binary.BigEndian.Uint32(b[4:])
+	if int(length) != len(b) {
+		return nil, fmt.Errorf("M3UA message length %d doesn't match %d received bytes", length, len(b))
+	}
+	m := &Message{Class: b[2], Type: b[3]}
+	for rest := b[HeaderLen:]; len(rest) > 0; {
+		if len(rest) < paramHdrLn {
+			return nil, fmt.Errorf("truncated M3UA parameter header")
+		}
+		tag := binary.BigEndian.Uint16(rest)
+		plen := int(binary.BigEndian.Uint16(rest[2:]))
+		if plen < paramHdrLn || plen > len(rest) {
+			return nil, fmt.Errorf("invalid M3UA parameter 0x%04X length: %d", tag, plen)
+		}
+		m.Params = append(m.Params, Param{Tag: tag, Value: rest[paramHdrLn:plen]})
+		padded := (plen + 3) &^ 3
+		if padded > len(rest) {
+			padded = len(rest)
+		}
+		rest = rest[padded:]
+	}
+	return m, nil
+}
+
+// ReadMessage reads a single message from a stream oriented transport
+func ReadMessage(r io.Reader) (*Message, error) {
+	hdr := make([]byte, HeaderLen)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(hdr[4:])
+	if length < HeaderLen || length > maxMsgLen {
+		return nil, fmt.Errorf("invalid M3UA message length: %d", length)
+	}
+	b := make([]byte, length)
+	copy(b, hdr)
+	if _, err := io.ReadFull(r, b[HeaderLen:]); err != nil {
+		return nil, err
+	}
+	return Unmarshal(b)
+}
+
+// Marshal returns the Protocol Data parameter value
+func (pd *ProtocolData) Marshal() []byte {
+	res := make([]byte, 12, 12+len(pd.Data))
+	binary.BigEndian.PutUint32(res, pd.OPC)
+	binary.BigEndian.PutUint32(res[4:], pd.DPC)
+	res[8], res[9], res[10], res[11] = pd.SI, pd.NI, pd.MP, pd.SLS
+	return append(res, pd.Data...)
+}
+
+// UnmarshalProtocolData decodes a Protocol Data parameter value
+func UnmarshalProtocolData(b []byte) (*ProtocolData, error) {
+	if len(b) < 12 {
+		return nil, fmt.Errorf("M3UA Protocol Data is too short: %d bytes", len(b))
+	}
+	return &ProtocolData{
+		OPC:  binary.BigEndian.Uint32(b),
+		DPC:  binary.BigEndian.Uint32(b[4:]),
+		SI:   b[8],
+		NI:   b[9],
+		MP:   b[10],
+		SLS:  b[11],
+		Data: b[12:],
+	}, nil
+}
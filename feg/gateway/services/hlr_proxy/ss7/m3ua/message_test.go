@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package m3ua_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/m3ua"
+)
+
+func TestManagementMessages(t *testing.T) {
+	aspup := m3ua.NewMessage(m3ua.ClassASPSM, m3ua.TypeASPUP)
+	assert.Equal(t, "0100030100000008", hex.EncodeToString(aspup.Marshal()))
+
+	aspac := m3ua.NewMessage(m3ua.ClassASPTM, m3ua.TypeASPAC,
+		m3ua.Uint32Param(m3ua.TagTrafficModeType, m3ua.TrafficModeLoadshare),
+		m3ua.Uint32Param(m3ua.TagRoutingContext, 1))
+	assert.Equal(t,
+		"0100040100000018"+"000b000800000002"+"0006000800000001",
+		hex.EncodeToString(aspac.Marshal()))
+
+	b, _ := hex.DecodeString("0100000100000010000d000800010003")
+	ntfy, err := m3ua.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, m3ua.ClassMGMT, ntfy.Class)
+	assert.Equal(t, m3ua.TypeNTFY, ntfy.Type)
+	status, ok := ntfy.Uint32(m3ua.TagStatus)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0x00010003), status)
+	_, ok = ntfy.Uint32(m3ua.TagRoutingContext)
+	assert.False(t, ok)
+}
+
+func TestDataMessage(t *testing.T) {
+	pd := &m3ua.ProtocolData{
+		OPC:  1,
+		DPC:  2,
+		SI:   m3ua.ServiceIndicatorSCCP,
+		NI:   2,
+		SLS:  1,
+		Data: []byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee},
+	}
+	// Protocol Data is padded to a multiple of 4 octets, the padding isn't included in the parameter length
+	expected := "0100010100000028" +
+		"0006000800000001" +
+		"02100015000000010000000203020001aabbccddee000000"
+	b := m3ua.NewData(0, 1, pd).Marshal()
+	assert.Equal(t, expected, hex.EncodeToString(b))
+
+	m, err := m3ua.ReadMessage(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Equal(t, m3ua.ClassTRANS, m.Class)
+	assert.Equal(t, m3ua.TypeDATA, m.Type)
+	decoded, err := m.ProtocolData()
+	require.NoError(t, err)
+	assert.Equal(t, pd, decoded)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, encoded := range []string{
+		"",
+		"0200030100000008",                 // version
+		"0100030100000010",                 // length
+		"010001010000000c0210000300000000", // param length
+	} {
+		b, _ := hex.DecodeString(encoded)
+		_, err := m3ua.Unmarshal(b)
+		assert.Error(t, err, encoded)
+	}
+	// DATA without Protocol Data
+	b, _ := hex.DecodeString("01000101000000100006000800000001")
+	m, err := m3ua.Unmarshal(b)
+	require.NoError(t, err)
+	_, err = m.ProtocolData()
+	assert.Error(t, err)
+}
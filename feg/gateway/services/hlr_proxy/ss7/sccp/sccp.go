@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sccp implements encoding of SCCP connectionless messages (ITU-T Q.713)
+// used to carry TCAP over M3UA
+package sccp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MessageType is the SCCP message type code (Q.713 Table 1)
+type MessageType uint8
+
+const (
+	UDT   MessageType = 0x09
+	UDTS  MessageType = 0x0A
+	XUDT  MessageType = 0x11
+	XUDTS MessageType = 0x12
+)
+
+// Protocol class 0 with the return message on error option (Q.713 3.6)
+const ProtocolClass0ReturnOnError uint8 = 0x80
+
+// DefaultHopCounter is the hop counter of sent XUDT messages (Q.714 2.3.2)
+const DefaultHopCounter uint8 = 15
+
+// Subsystem numbers (3GPP TS 23.003 Annex C)
+const (
+	SSNHLR  uint8 = 6
+	SSNVLR  uint8 = 7
+	SSNMSC  uint8 = 8
+	SSNSGSN uint8 = 149
+	SSNGGSN uint8 = 150
+)
+
+// Global title numbering plans & nature of address indicators (Q.713 3.4.2.3)
+const (
+	NumberingPlanE164 uint8 = 1
+	NumberingPlanE214 uint8 = 7
+
+	NatureOfAddressInternational uint8 = 4
+
+	globalTitleIndicator4 = 4
+	encodingSchemeBCDOdd  = 1
+	encodingSchemeBCDEven = 2
+)
+
+// GlobalTitle is a global title with indicator 0100 (translation type,
+// numbering plan, encoding scheme & nature of address)
+type GlobalTitle struct {
+	TranslationType uint8
+	NumberingPlan   uint8
+	NatureOfAddress uint8
+	Digits          string
+}
+
+// Address is an SCCP called or calling party address (Q.713 3.4).
+// PointCode & SSN are only encoded if they are not 0
+type Address struct {
+	RouteOnSSN  bool
+	PointCode   uint16
+	SSN         uint8
+	GlobalTitle *GlobalTitle
+}
+
+// Message is an SCCP connectionless message
+type Message struct {
+	Type          MessageType
+	ProtocolClass uint8 // UDT & XUDT
+	ReturnCause   uint8 // UDTS & XUDTS
+	HopCounter    uint8 // XUDT & XUDTS
+	Called        Address
+	Calling       Address
+	Data          []byte
+}
+
+// NewUDT returns a class 0 unitdata message
+func NewUDT(called, calling Address, data []byte) *Message {
+	return &Message{
+		Type:          UDT,
+		ProtocolClass: ProtocolClass0ReturnOnError,
+		Called:        called,
+		Calling:       calling,
+		Data:          data,
+	}
+}
+
+// IsService returns true for UDTS & XUDTS messages returning undelivered data
+func (m *Message) IsService() bool {
+	return m.Type == UDTS || m.Type == XUDTS
+}
+
+// Marshal returns the wire representation of the message
+func (m *Message) Marshal() ([]byte, error) {
+	called, err := m.Called.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("invalid called party address: %v", err)
+	}
+	calling, err := m.Calling.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("invalid calling party address: %v", err)
+	}
+	if len(m.Data) > 0xFF {
+		return nil, fmt.Errorf("SCCP data is too long: %d bytes", len(m.Data))
+	}
+	var res []byte
+	var pointers int
+	switch m.Type {
+	case UDT:
+		res = []byte{byte(m.Type), m.ProtocolClass}
+		pointers = 3
+	case UDTS:
+		res = []byte{byte(m.Type), m.ReturnCause}
+		pointers = 3
+	case XUDT:
+		res = []byte{byte(m.Type), m.ProtocolClass, m.HopCounter}
+		pointers = 4
+	case XUDTS:
+		res = []byte{byte(m.Type), m.ReturnCause, m.HopCounter}
+		pointers = 4
+	default:
+		return nil, fmt.Errorf("unsupported SCCP message type: 0x%02X", uint8(m.Type))
+	}
+	// each pointer is the offset from the pointer octet to its parameter's length octet
+	res = append(res,
+		byte(pointers),
+		byte(pointers-1+1+len(called)),
+		byte(pointers-2+1+len(called)+1+len(calling)))
+	if pointers == 4 {
+		res = append(res, 0) // no optional part
+	}
+	res = append(res, byte(len(called)))
+	res = append(res, called...)
+	res = append(res, byte(len(calling)))
+	res = append(res, calling...)
+	res = append(res, byte(len(m.Data)))
+	return append(res, m.Data...), nil
+}
+
+// Unmarshal decodes UDT, UDTS, XUDT & XUDTS messages. Segmented XUDT messages
+// are not supported
+func Unmarshal(b []byte) (*Message, error) {
+	if len(b) < 1 {
+		return nil, errors.New("empty SCCP message")
+	}
+	m := &Message{Type: MessageType(b[0])}
+	var first int
+	switch m.Type {
+	case UDT, UDTS:
+		if len(b) < 5 {
+			return nil, fmt.Errorf("SCCP message is too short: %d bytes", len(b))
+		}
+		if m.Type == UDT {
+			m.ProtocolClass = b[1]
+		} else {
+			m.ReturnCause = b[1]
+		}
+		first = 2
+	case XUDT, XUDTS:
+		if len(b) < 7 {
+			return nil, fmt.Errorf("SCCP message is too short: %d bytes", len(b))
+		}
+		if m.Type == XUDT {
+			m.ProtocolClass = b[1]
+		} else {
+			m.ReturnCause = b[1]
+		}
+		m.HopCounter = b[2]
+		first = 3
+	default:
+		return nil, fmt.Errorf("unsupported SCCP message type: 0x%02X", b[0])
+	}
+	params := make([][]byte, 3)
+	for i := range params {
+		p, err := variableParam(b, first+i)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = p
+	}
+	var err error
+	if m.Called, err = UnmarshalAddress(params[0]); err != nil {
+		return nil, fmt.Errorf("invalid called party address: %v", err)
+	}
+	if m.Calling, err = UnmarshalAddress(params[1]); err != nil {
+		return nil, fmt.Errorf("invalid calling party address: %v", err)
+	}
+	m.Data = params[2]
+	return m, nil
+}
+
+// variableParam returns the mandatory variable part parameter pointed by the pointer at offset ptr
+func variableParam(b []byte, ptr int) ([]byte, error) {
+	start := ptr + int(b[ptr])
+	if b[ptr] == 0 || start >= len(b) {
+		return nil, fmt.Errorf("invalid SCCP pointer %d at offset %d", b[ptr], ptr)
+	}
+	end := start + 1 + int(b[start])
+	if end > len(b) {
+		return nil, fmt.Errorf("SCCP parameter at offset %d exceeds message length", start)
+	}
+	return b[start+1 : end], nil
+}
+
+// Marshal returns the address' encoding without the length octet
+func (a Address) Marshal() ([]byte, error) {
+	var indicator byte
+	if a.RouteOnSSN {
+		indicator |= 0x40
+	}
+	if a.PointCode != 0 {
+		indicator |= 0x01
+	}
+	if a.SSN != 0 {
+		indicator |= 0x02
+	}
+	res := []byte{indicator}
+	if a.PointCode != 0 {
+		if a.PointCode > 0x3FFF {
+			return nil, fmt.Errorf("invalid ITU point code: %d", a.PointCode)
+		}
+		res = append(res, byte(a.PointCode), byte(a.PointCode>>8))
+	}
+	if a.SSN != 0 {
+		res = append(res, a.SSN)
+	}
+	if a.GlobalTitle != nil {
+		res[0] |= globalTitleIndicator4 << 2
+		gt := a.GlobalTitle
+		digits, err := EncodeBCD(gt.Digits)
+		if err != nil {
+			return nil, err
+		}
+		scheme := byte(encodingSchemeBCDEven)
+		if len(gt.Digits)%2 == 1 {
+			scheme = encodingSchemeBCDOdd
+		}
+		res = append(res, gt.TranslationType, gt.NumberingPlan<<4|scheme, gt.NatureOfAddress&0x7F)
+		res = append(res, digits...)
+	} else if !a.RouteOnSSN {
+		return nil, errors.New("route on GT address without global title")
+	}
+	return res, nil
+}
+
+// UnmarshalAddress decodes an address without the length octet
+func UnmarshalAddress(b []byte) (Address, error) {
+	var a Address
+	if len(b) == 0 {
+		return a, errors.New("empty address")
+	}
+	indicator := b[0]
+	a.RouteOnSSN = indicator&0x40 != 0
+	rest := b[1:]
+	if indicator&0x01 != 0 {
+		if len(rest) < 2 {
+			return a, errors.New("truncated point code")
+		}
+		a.PointCode = uint16(rest[0]) | uint16(rest[1]&0x3F)<<8
+		rest = rest[2:]
+	}
+	if indicator&0x02 != 0 {
+		if len(rest) < 1 {
+			return a, errors.New("truncated subsystem number")
+		}
+		a.SSN = rest[0]
+		rest = rest[1:]
+	}
+	switch gti := (indicator >> 2) & 0x0F; gti {
+	case 0:
+	case globalTitleIndicator4:
+		if len(rest) < 3 {
+			return a, errors.New("truncated global title")
+		}
+		odd := rest[1]&0x0F == encodingSchemeBCDOdd
+		a.GlobalTitle = &GlobalTitle{
+			TranslationType: rest[0],
+			NumberingPlan:   rest[1] >> 4,
+			NatureOfAddress: rest[2] & 0x7F,
+			Digits:          DecodeBCD(rest[3:], odd),
+		}
+	default:
+		return a, fmt.Errorf("unsupported global title indicator: %d", gti)
+	}
+	return a, nil
+}
+
+// EncodeBCD encodes decimal digits two per octet, the first digit in the low
+// order nibble. An odd number of digits is padded with a 0 filler
+func EncodeBCD(digits string) ([]byte, error) {
+	res := make([]byte, (len(digits)+1)/2)
+	for i, d := range digits {
+		if d < '0' || d > '9' {
+			return nil, fmt.Errorf("invalid digit '%c' in '%s'", d, digits)
+		}
+		if i%2 == 0 {
+			res[i/2] = byte(d - '0')
+		} else {
+			res[i/2] |= byte(d-'0') << 4
+		}
+	}
+	return res, nil
+}
+
+// DecodeBCD decodes BCD digits, the last high order nibble is skipped if odd is set
+func DecodeBCD(b []byte, odd bool) string {
+	var sb strings.Builder
+	for i, o := range b {
+		sb.WriteByte('0' + o&0x0F)
+		if i == len(b)-1 && odd {
+			break
+		}
+		sb.WriteByte('0' + o>>4)
+	}
+	return sb.String()
+}
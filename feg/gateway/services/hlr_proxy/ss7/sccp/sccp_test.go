@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sccp_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/sccp"
+)
+
+func gtAddress(ssn uint8, digits string) sccp.Address {
+	return sccp.Address{
+		SSN: ssn,
+		GlobalTitle: &sccp.GlobalTitle{
+			NumberingPlan:   sccp.NumberingPlanE164,
+			NatureOfAddress: sccp.NatureOfAddressInternational,
+			Digits:          digits,
+		},
+	}
+}
+
+func TestUDT(t *testing.T) {
+	called := gtAddress(sccp.SSNHLR, "15555550001")
+	calling := gtAddress(sccp.SSNSGSN, "15555550000")
+	udt := sccp.NewUDT(called, calling, []byte{0xaa, 0xbb})
+	b, err := udt.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t,
+		"0980030e19"+
+			"0b"+"1206001104515555050001"+
+			"0b"+"1295001104515555050000"+
+			"02aabb",
+		hex.EncodeToString(b))
+
+	decoded, err := sccp.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, udt, decoded)
+	assert.False(t, decoded.IsService())
+}
+
+func TestUDTS(t *testing.T) {
+	b, _ := hex.DecodeString("0a0103070b" + "0443010095" + "0443020006" + "02aabb")
+	udts, err := sccp.Unmarshal(b)
+	require.NoError(t, err)
+	assert.True(t, udts.IsService())
+	assert.Equal(t, uint8(1), udts.ReturnCause)
+	assert.Equal(t, sccp.Address{RouteOnSSN: true, PointCode: 1, SSN: sccp.SSNSGSN}, udts.Called)
+	assert.Equal(t, sccp.Address{RouteOnSSN: true, PointCode: 2, SSN: sccp.SSNHLR}, udts.Calling)
+	assert.Equal(t, []byte{0xaa, 0xbb}, udts.Data)
+}
+
+func TestBCD(t *testing.T) {
+	b, err := sccp.EncodeBCD("15555550001")
+	require.NoError(t, err)
+	assert.Equal(t, "515555050001", hex.EncodeToString(b))
+	assert.Equal(t, "15555550001", sccp.DecodeBCD(b, true))
+	assert.Equal(t, "155555500010", sccp.DecodeBCD(b, false))
+	_, err = sccp.EncodeBCD("1a")
+	assert.Error(t, err)
+}
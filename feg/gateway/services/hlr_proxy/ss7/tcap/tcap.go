@@ -0,0 +1,440 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tcap implements encoding of ITU-T Q.773 TCAP transaction messages,
+// the dialogue portion used to negotiate MAP application contexts & the
+// component portion carrying MAP operations
+package tcap
+
+import (
+	"errors"
+	"fmt"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/ber"
+)
+
+// MessageType is the application tag of a TCAP message
+type MessageType uint32
+
+const (
+	Unidirectional MessageType = 1
+	Begin          MessageType = 2
+	End            MessageType = 4
+	Continue       MessageType = 5
+	Abort          MessageType = 7
+)
+
+// Transaction portion & dialogue portion tags
+const (
+	tagOTID             = 8
+	tagDTID             = 9
+	tagPAbortCause      = 10
+	tagDialoguePortion  = 11
+	tagComponentPortion = 12
+)
+
+// DialogueType is the application tag of a structured dialogue PDU (Q.773 4.2.3)
+type DialogueType uint32
+
+const (
+	AARQ DialogueType = 0
+	AARE DialogueType = 1
+	ABRT DialogueType = 4
+)
+
+// Associate results & diagnostics (Q.773 4.2.3)
+const (
+	ResultAccepted        = 0
+	ResultRejectPermanent = 1
+
+	DiagnosticSourceUser     = 1
+	DiagnosticSourceProvider = 2
+
+	DiagnosticNull                          = 0
+	DiagnosticNoReasonGiven                 = 1
+	DiagnosticApplicationContextUnsupported = 2
+)
+
+// DialogueAsID is the dialogue-as-id OBJECT IDENTIFIER of structured dialogues
+var DialogueAsID = []uint32{0, 0, 17, 773, 1, 1, 1}
+
+// protocol-version [0] IMPLICIT BIT STRING { version1 (0) }
+var protocolVersion1 = []byte{0x07, 0x80}
+
+// Dialogue is a structured dialogue PDU
+type Dialogue struct {
+	Type               DialogueType
+	ApplicationContext []uint32
+	// AARE only
+	Result           int64
+	DiagnosticSource int64
+	Diagnostic       int64
+	// ABRT only
+	AbortSource int64
+}
+
+// ComponentType is the context tag of a TCAP component
+type ComponentType uint32
+
+const (
+	Invoke              ComponentType = 1
+	ReturnResultLast    ComponentType = 2
+	ReturnError         ComponentType = 3
+	Reject              ComponentType = 4
+	ReturnResultNotLast ComponentType = 7
+)
+
+// Reject problem types & invoke problem codes (Q.773 4.2.2.2)
+const (
+	ProblemTypeGeneral      = 0
+	ProblemTypeInvoke       = 1
+	ProblemTypeReturnResult = 2
+	ProblemTypeReturnError  = 3
+
+	InvokeProblemDuplicateInvokeID     = 0
+	InvokeProblemUnrecognizedOperation = 1
+	InvokeProblemMistypedParameter     = 2
+)
+
+// Component is a TCAP component, Parameter is the encoded operation argument,
+// result or error parameter. OpCode is only set for Invoke & ReturnResult
+// components with a result, ErrorCode for ReturnError, Problem for Reject
+type Component struct {
+	Type           ComponentType
+	InvokeID       int64
+	LinkedID       *int64
+	OpCode         *int64
+	ErrorCode      int64
+	ProblemType    uint32
+	ProblemCode    int64
+	Parameter      *ber.TLV
+	invokeIDAbsent bool
+}
+
+// Message is a TCAP transaction message
+type Message struct {
+	Type        MessageType
+	OTID        []byte
+	DTID        []byte
+	PAbortCause *int64
+	Dialogue    *Dialogue
+	Components  []*Component
+}
+
+// NewInvoke returns an Invoke component of a local operation
+func NewInvoke(invokeID, opCode int64, parameter *ber.TLV) *Component {
+	return &Component{Type: Invoke, InvokeID: invokeID, OpCode: &opCode, Parameter: parameter}
+}
+
+// NewReturnResultLast returns a ReturnResultLast component, the operation code
+// & result are only included if the result parameter is not nil
+func NewReturnResultLast(invokeID, opCode int64, result *ber.TLV) *Component {
+	c := &Component{Type: ReturnResultLast, InvokeID: invokeID, Parameter: result}
+	if result != nil {
+		c.OpCode = &opCode
+	}
+	return c
+}
+
+// NewAARQ returns an association request of the given application context
+func NewAARQ(applicationContext []uint32) *Dialogue {
+	return &Dialogue{Type: AARQ, ApplicationContext: applicationContext}
+}
+
+// NewAARE returns an association response of the given application context
+func NewAARE(applicationContext []uint32, result, diagnosticSource, diagnostic int64) *Dialogue {
+	return &Dialogue{
+		Type:               AARE,
+		ApplicationContext: applicationContext,
+		Result:             result,
+		DiagnosticSource:   diagnosticSource,
+		Diagnostic:         diagnostic,
+	}
+}
+
+// Marshal returns the BER encoding of the message
+func (m *Message) Marshal() ([]byte, error) {
+	msg := ber.NewConstructed(ber.ClassApplication, uint32(m.Type))
+	switch m.Type {
+	case Begin, Continue:
+		if len(m.OTID) == 0 || len(m.OTID) > 4 {
+			return nil, fmt.Errorf("invalid TCAP OTID length: %d", len(m.OTID))
+		}
+		msg.Children = append(msg.Children, ber.NewPrimitive(ber.ClassApplication, tagOTID, m.OTID))
+	}
+	switch m.Type {
+	case End, Continue, Abort:
+		if len(m.DTID) == 0 || len(m.DTID) > 4 {
+			return nil, fmt.Errorf("invalid TCAP DTID length: %d", len(m.DTID))
+		}
+		msg.Children = append(msg.Children, ber.NewPrimitive(ber.ClassApplication, tagDTID, m.DTID))
+	case Begin:
+	default:
+		return nil, fmt.Errorf("unsupported TCAP message type: %d", m.Type)
+	}
+	if m.Type == Abort && m.PAbortCause != nil {
+		msg.Children = append(msg.Children,
+			ber.NewPrimitive(ber.ClassApplication, tagPAbortCause, ber.EncodeInteger(*m.PAbortCause)))
+		return msg.Marshal(), nil
+	}
+	if m.Dialogue != nil {
+		dialogue, err := m.Dialogue.marshal()
+		if err != nil {
+			return nil, err
+		}
+		msg.Children = append(msg.Children, dialogue)
+	}
+	if len(m.Components) > 0 {
+		components := ber.NewConstructed(ber.ClassApplication, tagComponentPortion)
+		for _, c := range m.Components {
+			components.Children = append(components.Children, c.marshal())
+		}
+		msg.Children = append(msg.Children, components)
+	}
+	return msg.Marshal(), nil
+}
+
+// Unmarshal decodes a TCAP message
+func Unmarshal(b []byte) (*Message, error) {
+	t, _, err := ber.Unmarshal(b)
+	if err != nil {
+		return nil, err
+	}
+	if t.Class != ber.ClassApplication || !t.Constructed {
+		return nil, fmt.Errorf("invalid TCAP message %s", t)
+	}
+	m := &Message{Type: MessageType(t.Tag)}
+	switch m.Type {
+	case Begin, End, Continue, Abort:
+	default:
+		return nil, fmt.Errorf("unsupported TCAP message type: %d", t.Tag)
+	}
+	for _, c := range t.Children {
+		if c.Class != ber.ClassApplication {
+			continue
+		}
+		switch c.Tag {
+		case tagOTID:
+			m.OTID = c.Value
+		case tagDTID:
+			m.DTID = c.Value
+		case tagPAbortCause:
+			cause, err := c.Int()
+			if err != nil {
+				return nil, err
+			}
+			m.PAbortCause = &cause
+		case tagDialoguePortion:
+			if m.Dialogue, err = unmarshalDialogue(c); err != nil {
+				return nil, err
+			}
+		case tagComponentPortion:
+			for _, cc := range c.Children {
+				component, err := unmarshalComponent(cc)
+				if err != nil {
+					return nil, err
+				}
+				m.Components = append(m.Components, component)
+			}
+		}
+	}
+	if (m.Type == Begin || m.Type == Continue) && len(m.OTID) == 0 {
+		return nil, errors.New("missing TCAP OTID")
+	}
+	if m.Type != Begin && len(m.DTID) == 0 {
+		return nil, errors.New("missing TCAP DTID")
+	}
+	return m, nil
+}
+
+func (d *Dialogue) marshal() (*ber.TLV, error) {
+	asID, err := ber.EncodeOID(DialogueAsID)
+	if err != nil {
+		return nil, err
+	}
+	pdu := ber.NewConstructed(ber.ClassApplication, uint32(d.Type))
+	switch d.Type {
+	case AARQ, AARE:
+		acn, err := ber.EncodeOID(d.ApplicationContext)
+		if err != nil {
+			return nil, err
+		}
+		pdu.Children = append(pdu.Children,
+			ber.NewPrimitive(ber.ClassContextSpecific, 0, protocolVersion1),
+			ber.NewConstructed(ber.ClassContextSpecific, 1, ber.NewPrimitive(ber.ClassUniversal, ber.TagObjectIdentifier, acn)))
+		if d.Type == AARE {
+			pdu.Children = append(pdu.Children,
+				ber.NewConstructed(ber.ClassContextSpecific, 2, ber.NewInteger(d.Result)),
+				ber.NewConstructed(ber.ClassContextSpecific, 3,
+					ber.NewConstructed(ber.ClassContextSpecific, uint32(d.DiagnosticSource), ber.NewInteger(d.Diagnostic))))
+		}
+	case ABRT:
+		pdu.Children = append(pdu.Children,
+			ber.NewPrimitive(ber.ClassContextSpecific, 0, ber.EncodeInteger(d.AbortSource)))
+	default:
+		return nil, fmt.Errorf("unsupported dialogue PDU: %d", d.Type)
+	}
+	return ber.NewConstructed(ber.ClassApplication, tagDialoguePortion,
+		ber.NewConstructed(ber.ClassUniversal, ber.TagExternal,
+			ber.NewPrimitive(ber.ClassUniversal, ber.TagObjectIdentifier, asID),
+			ber.NewConstructed(ber.ClassContextSpecific, 0, pdu))), nil
+}
+
+func unmarshalDialogue(t *ber.TLV) (*Dialogue, error) {
+	external := t.Find(ber.ClassUniversal, ber.TagExternal)
+	single := external.Find(ber.ClassContextSpecific, 0)
+	if single == nil || len(single.Children) == 0 || single.Children[0].Class != ber.ClassApplication {
+		return nil, errors.New("unsupported TCAP dialogue portion")
+	}
+	pdu := single.Children[0]
+	d := &Dialogue{Type: DialogueType(pdu.Tag)}
+	switch d.Type {
+	case AARQ, AARE:
+		acn := pdu.Find(ber.ClassContextSpecific, 1).Find(ber.ClassUniversal, ber.TagObjectIdentifier)
+		if acn == nil {
+			return nil, errors.New("missing application context name")
+		}
+		var err error
+		if d.ApplicationContext, err = ber.DecodeOID(acn.Value); err != nil {
+			return nil, err
+		}
+		if d.Type == AARE {
+			if d.Result, err = pdu.Find(ber.ClassContextSpecific, 2).Find(ber.ClassUniversal, ber.TagInteger).Int(); err != nil {
+				return nil, fmt.Errorf("invalid AARE result: %v", err)
+			}
+			if diag := pdu.Find(ber.ClassContextSpecific, 3); diag != nil && len(diag.Children) > 0 {
+				d.DiagnosticSource = int64(diag.Children[0].Tag)
+				d.Diagnostic, _ = diag.Children[0].Find(ber.ClassUniversal, ber.TagInteger).Int()
+			}
+		}
+	case ABRT:
+		if src := pdu.Find(ber.ClassContextSpecific, 0); src != nil {
+			d.AbortSource, _ = src.Int()
+		}
+	default:
+		return nil, fmt.Errorf("unsupported dialogue PDU: %d", pdu.Tag)
+	}
+	return d, nil
+}
+
+func (c *Component) marshal() *ber.TLV {
+	t := ber.NewConstructed(ber.ClassContextSpecific, uint32(c.Type))
+	if c.Type == Reject && c.invokeIDAbsent {
+		t.Children = append(t.Children, ber.NewNull(ber.ClassUniversal, ber.TagNull))
+	} else {
+		t.Children = append(t.Children, ber.NewInteger(c.InvokeID))
+	}
+	switch c.Type {
+	case Invoke:
+		if c.LinkedID != nil {
+			t.Children = append(t.Children, ber.NewPrimitive(ber.ClassContextSpecific, 0, ber.EncodeInteger(*c.LinkedID)))
+		}
+		t.Children = append(t.Children, ber.NewInteger(*c.OpCode))
+		if c.Parameter != nil {
+			t.Children = append(t.Children, c.Parameter)
+		}
+	case ReturnResultLast, ReturnResultNotLast:
+		if c.OpCode != nil {
+			t.Children = append(t.Children, ber.NewSequence(ber.NewInteger(*c.OpCode), c.Parameter))
+		}
+	case ReturnError:
+		t.Children = append(t.Children, ber.NewInteger(c.ErrorCode))
+		if c.Parameter != nil {
+			t.Children = append(t.Children, c.Parameter)
+		}
+	case Reject:
+		t.Children = append(t.Children, ber.NewPrimitive(ber.ClassContextSpecific, c.ProblemType, ber.EncodeInteger(c.ProblemCode)))
+	}
+	return t
+}
+
+func unmarshalComponent(t *ber.TLV) (*Component, error) {
+	if t.Class != ber.ClassContextSpecific || len(t.Children) == 0 {
+		return nil, fmt.Errorf("invalid TCAP component %s", t)
+	}
+	c := &Component{Type: ComponentType(t.Tag)}
+	id := t.Children[0]
+	if id.Is(ber.ClassUniversal, ber.TagNull) {
+		c.invokeIDAbsent = true
+	} else {
+		var err error
+		if c.InvokeID, err = id.Int(); err != nil {
+			return nil, fmt.Errorf("invalid invoke ID: %v", err)
+		}
+	}
+	rest := t.Children[1:]
+	switch c.Type {
+	case Invoke:
+		if len(rest) > 0 && rest[0].Is(ber.ClassContextSpecific, 0) {
+			linked, err := rest[0].Int()
+			if err != nil {
+				return nil, err
+			}
+			c.LinkedID = &linked
+			rest = rest[1:]
+		}
+		if len(rest) == 0 {
+			return nil, errors.New("missing invoke operation code")
+		}
+		opCode, err := localCode(rest[0])
+		if err != nil {
+			return nil, err
+		}
+		c.OpCode = &opCode
+		if len(rest) > 1 {
+			c.Parameter = rest[1]
+		}
+	case ReturnResultLast, ReturnResultNotLast:
+		if len(rest) > 0 {
+			if !rest[0].Is(ber.ClassUniversal, ber.TagSequence) || len(rest[0].Children) == 0 {
+				return nil, errors.New("invalid return result")
+			}
+			opCode, err := localCode(rest[0].Children[0])
+			if err != nil {
+				return nil, err
+			}
+			c.OpCode = &opCode
+			if len(rest[0].Children) > 1 {
+				c.Parameter = rest[0].Children[1]
+			}
+		}
+	case ReturnError:
+		if len(rest) == 0 {
+			return nil, errors.New("missing error code")
+		}
+		var err error
+		if c.ErrorCode, err = localCode(rest[0]); err != nil {
+			return nil, err
+		}
+		if len(rest) > 1 {
+			c.Parameter = rest[1]
+		}
+	case Reject:
+		if len(rest) == 0 || rest[0].Class != ber.ClassContextSpecific {
+			return nil, errors.New("missing reject problem")
+		}
+		c.ProblemType = rest[0].Tag
+		c.ProblemCode, _ = rest[0].Int()
+	default:
+		return nil, fmt.Errorf("unsupported TCAP component type: %d", t.Tag)
+	}
+	return c, nil
+}
+
+// localCode decodes a local operation or error code, global codes aren't used by MAP
+func localCode(t *ber.TLV) (int64, error) {
+	if !t.Is(ber.ClassUniversal, ber.TagInteger) {
+		return 0, fmt.Errorf("unsupported operation or error code %s", t)
+	}
+	return t.Int()
+}
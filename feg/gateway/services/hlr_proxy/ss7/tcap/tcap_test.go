@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tcap_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/services/hlr_proxy/ss7/ber"
+	"magma/feg/gateway/services/hlr_proxy/ss7/tcap"
+)
+
+var (
+	tid = []byte{0, 0, 0, 1}
+	// infoRetrievalContext-v3
+	acn = []uint32{0, 4, 0, 0, 1, 0, 14, 3}
+)
+
+func TestBeginWithDialogue(t *testing.T) {
+	msg := &tcap.Message{
+		Type:       tcap.Begin,
+		OTID:       tid,
+		Dialogue:   tcap.NewAARQ(acn),
+		Components: []*tcap.Component{tcap.NewInvoke(1, 56, ber.NewSequence())},
+	}
+	b, err := msg.Marshal()
+	require.NoError(t, err)
+	expected := "6232" + "480400000001" +
+		"6b1e281c060700118605010101a011600f80020780a109060704000001000e03" +
+		"6c0aa108020101020138" + "3000"
+	assert.Equal(t, expected, hex.EncodeToString(b))
+
+	decoded, err := tcap.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, tcap.Begin, decoded.Type)
+	assert.Equal(t, tid, decoded.OTID)
+	require.NotNil(t, decoded.Dialogue)
+	assert.Equal(t, tcap.AARQ, decoded.Dialogue.Type)
+	assert.Equal(t, acn, decoded.Dialogue.ApplicationContext)
+	require.Len(t, decoded.Components, 1)
+	c := decoded.Components[0]
+	assert.Equal(t, tcap.Invoke, c.Type)
+	assert.Equal(t, int64(1), c.InvokeID)
+	require.NotNil(t, c.OpCode)
+	assert.Equal(t, int64(56), *c.OpCode)
+	assert.True(t, c.Parameter.Is(ber.ClassUniversal, ber.TagSequence))
+}
+
+func TestEndWithAARE(t *testing.T) {
+	msg := &tcap.Message{
+		Type:       tcap.End,
+		DTID:       tid,
+		Dialogue:   tcap.NewAARE(acn, tcap.ResultAccepted, tcap.DiagnosticSourceUser, tcap.DiagnosticNull),
+		Components: []*tcap.Component{tcap.NewReturnResultLast(1, 56, nil)},
+	}
+	b, err := msg.Marshal()
+	require.NoError(t, err)
+	expected := "6439" + "490400000001" +
+		"6b2a2828060700118605010101a01d611b80020780a109060704000001000e03a203020100a305a103020100" +
+		"6c05a203020101"
+	assert.Equal(t, expected, hex.EncodeToString(b))
+
+	decoded, err := tcap.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, tcap.End, decoded.Type)
+	assert.Equal(t, tid, decoded.DTID)
+	require.NotNil(t, decoded.Dialogue)
+	assert.Equal(t, tcap.AARE, decoded.Dialogue.Type)
+	assert.Equal(t, acn, decoded.Dialogue.ApplicationContext)
+	assert.Equal(t, int64(tcap.ResultAccepted), decoded.Dialogue.Result)
+	assert.Equal(t, int64(tcap.DiagnosticSourceUser), decoded.Dialogue.DiagnosticSource)
+	require.Len(t, decoded.Components, 1)
+	assert.Equal(t, tcap.ReturnResultLast, decoded.Components[0].Type)
+	assert.Nil(t, decoded.Components[0].OpCode)
+	assert.Nil(t, decoded.Components[0].Parameter)
+}
+
+func TestErrorsAndAborts(t *testing.T) {
+	// ReturnError unknownSubscriber & Reject of an unrecognized operation
+	b, _ := hex.DecodeString("6418" + "490400000001" + "6c10" + "a306020101020101" + "a406020102810101")
+	msg, err := tcap.Unmarshal(b)
+	require.NoError(t, err)
+	require.Len(t, msg.Components, 2)
+	assert.Equal(t, tcap.ReturnError, msg.Components[0].Type)
+	assert.Equal(t, int64(1), msg.Components[0].ErrorCode)
+	assert.Equal(t, tcap.Reject, msg.Components[1].Type)
+	assert.Equal(t, int64(2), msg.Components[1].InvokeID)
+	assert.Equal(t, uint32(tcap.ProblemTypeInvoke), msg.Components[1].ProblemType)
+	assert.Equal(t, int64(tcap.InvokeProblemUnrecognizedOperation), msg.Components[1].ProblemCode)
+
+	// P-Abort: unrecognizedTransactionID
+	b, _ = hex.DecodeString("6709" + "490400000001" + "4a0101")
+	msg, err = tcap.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, tcap.Abort, msg.Type)
+	require.NotNil(t, msg.PAbortCause)
+	assert.Equal(t, int64(1), *msg.PAbortCause)
+
+	// Missing OTID
+	b, _ = hex.DecodeString("6200")
+	_, err = tcap.Unmarshal(b)
+	assert.Error(t, err)
+}
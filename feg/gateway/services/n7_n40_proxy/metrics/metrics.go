@@ -74,6 +74,45 @@ var (
 		Name: "n7_failures_since_last_success",
 		Help: "The total number of N7 request failures since the last successful request completed",
 	})
+
+	ChfChargingDataCreateRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "chf_charging_data_create_requests_total",
+		Help: "Total number of ChargingData Create requests sent to CHF",
+	})
+	ChfChargingDataCreateFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "chf_charging_data_create_failures_total",
+		Help: "Total number of ChargingData Create requests that failed to send to CHF",
+	})
+	ChfChargingDataUpdateRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "chf_charging_data_update_requests_total",
+		Help: "Total number of ChargingData Update requests sent to CHF",
+	})
+	ChfChargingDataUpdateFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "chf_charging_data_update_failures_total",
+		Help: "Total number of ChargingData Update requests that failed to send to CHF",
+	})
+	ChfChargingDataReleaseRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "chf_charging_data_release_requests_total",
+		Help: "Total number of ChargingData Release requests sent to CHF",
+	})
+	ChfChargingDataReleaseFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "chf_charging_data_release_failures_total",
+		Help: "Total number of ChargingData Release requests that failed to send to CHF",
+	})
+
+	N40Timeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "n40_timeouts_total",
+		Help: "Total number of N40 timeouts",
+	})
+
+	N40SuccessTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "n40_success_timestamp",
+		Help: "Timestamp of the last successfully completed N40 request",
+	})
+	N40FailuresSinceLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "n40_failures_since_last_success",
+		Help: "The total number of N40 request failures since the last successful request completed",
+	})
 )
 
 type SessionHealthTracker struct {
@@ -90,6 +129,14 @@ type SessionHealthMetrics struct {
 	SmPolicyDeleteTotal    int64
 	SmPolicyDeleteFailures int64
 	N7Timeouts             int64
+
+	ChargingDataCreateTotal     int64
+	ChargingDataCreateFailures  int64
+	ChargingDataUpdateTotal     int64
+	ChargingDataUpdateFailures  int64
+	ChargingDataReleaseTotal    int64
+	ChargingDataReleaseFailures int64
+	N40Timeouts                 int64
 }
 
 func init() {
@@ -97,6 +144,9 @@ func init() {
 		PcfSmPolicyCreateRequests, PcfSmPolicyCreateFailures, PcfSmPolicyUpdateRequests,
 		PcfSmPolicyUpdateFailures, PcfSmPolicyDeleteRequests, PcfSmPolicyDeleteFailures,
 		N7Timeouts, N7SuccessTimestamp, N7FailuresSinceLastSuccess,
+		ChfChargingDataCreateRequests, ChfChargingDataCreateFailures, ChfChargingDataUpdateRequests,
+		ChfChargingDataUpdateFailures, ChfChargingDataReleaseRequests, ChfChargingDataReleaseFailures,
+		N40Timeouts, N40SuccessTimestamp, N40FailuresSinceLastSuccess,
 	)
 }
 
@@ -166,6 +216,34 @@ func GetCurrentHealthMetrics() (*SessionHealthMetrics, error) {
 	if err != nil {
 		return nil, err
 	}
+	chargingDataCreateTotal, err := service_health_metrics.GetInt64("chf_charging_data_create_requests_total")
+	if err != nil {
+		return nil, err
+	}
+	chargingDataCreateFailures, err := service_health_metrics.GetInt64("chf_charging_data_create_failures_total")
+	if err != nil {
+		return nil, err
+	}
+	chargingDataUpdateTotal, err := service_health_metrics.GetInt64("chf_charging_data_update_requests_total")
+	if err != nil {
+		return nil, err
+	}
+	chargingDataUpdateFailures, err := service_health_metrics.GetInt64("chf_charging_data_update_failures_total")
+	if err != nil {
+		return nil, err
+	}
+	chargingDataReleaseTotal, err := service_health_metrics.GetInt64("chf_charging_data_release_requests_total")
+	if err != nil {
+		return nil, err
+	}
+	chargingDataReleaseFailures, err := service_health_metrics.GetInt64("chf_charging_data_release_failures_total")
+	if err != nil {
+		return nil, err
+	}
+	n40Timeouts, err := service_health_metrics.GetInt64("n40_timeouts_total")
+	if err != nil {
+		return nil, err
+	}
 	return &SessionHealthMetrics{
 		SmPolicyCreateTotal:    smPolicyCreateTotal,
 		SmpolicyCreateFailures: smPolicyCreateFailures,
@@ -174,6 +252,14 @@ func GetCurrentHealthMetrics() (*SessionHealthMetrics, error) {
 		SmPolicyDeleteTotal:    smPolicyDeleteTotal,
 		SmPolicyDeleteFailures: smPolicyDeleteFailure,
 		N7Timeouts:             n7Timeouts,
+
+		ChargingDataCreateTotal:     chargingDataCreateTotal,
+		ChargingDataCreateFailures:  chargingDataCreateFailures,
+		ChargingDataUpdateTotal:     chargingDataUpdateTotal,
+		ChargingDataUpdateFailures:  chargingDataUpdateFailures,
+		ChargingDataReleaseTotal:    chargingDataReleaseTotal,
+		ChargingDataReleaseFailures: chargingDataReleaseFailures,
+		N40Timeouts:                 n40Timeouts,
 	}, nil
 }
 
@@ -191,6 +277,14 @@ func (prevMetrics *SessionHealthMetrics) GetDelta(
 		SmPolicyDeleteTotal:    currentMetrics.SmPolicyDeleteTotal - prevMetrics.SmPolicyDeleteTotal,
 		SmPolicyDeleteFailures: currentMetrics.SmPolicyDeleteFailures - prevMetrics.SmPolicyDeleteFailures,
 		N7Timeouts:             currentMetrics.N7Timeouts - prevMetrics.N7Timeouts,
+
+		ChargingDataCreateTotal:     currentMetrics.ChargingDataCreateTotal - prevMetrics.ChargingDataCreateTotal,
+		ChargingDataCreateFailures:  currentMetrics.ChargingDataCreateFailures - prevMetrics.ChargingDataCreateFailures,
+		ChargingDataUpdateTotal:     currentMetrics.ChargingDataUpdateTotal - prevMetrics.ChargingDataUpdateTotal,
+		ChargingDataUpdateFailures:  currentMetrics.ChargingDataUpdateFailures - prevMetrics.ChargingDataUpdateFailures,
+		ChargingDataReleaseTotal:    currentMetrics.ChargingDataReleaseTotal - prevMetrics.ChargingDataReleaseTotal,
+		ChargingDataReleaseFailures: currentMetrics.ChargingDataReleaseFailures - prevMetrics.ChargingDataReleaseFailures,
+		N40Timeouts:                 currentMetrics.N40Timeouts - prevMetrics.N40Timeouts,
 	}
 	// Update stored counts to current metric totals
 	*prevMetrics = *currentMetrics
@@ -239,3 +333,45 @@ func ReportDeleteSmPolicy(err error) {
 	}
 	PcfSmPolicyDeleteRequests.Inc()
 }
+
+func UpdateN40RecentRequestMetrics(err error) {
+	if err == nil {
+		N40SuccessTimestamp.Set(float64(time.Now().Unix()))
+		N40FailuresSinceLastSuccess.Set(0)
+	} else {
+		N40FailuresSinceLastSuccess.Inc()
+	}
+}
+
+func ReportCreateChargingData(err error) {
+	UpdateN40RecentRequestMetrics(err)
+	if err != nil {
+		ChfChargingDataCreateFailures.Inc()
+		if errors.Is(err, context.DeadlineExceeded) {
+			N40Timeouts.Inc()
+		}
+	}
+	ChfChargingDataCreateRequests.Inc()
+}
+
+func ReportUpdateChargingData(err error) {
+	UpdateN40RecentRequestMetrics(err)
+	if err != nil {
+		ChfChargingDataUpdateFailures.Inc()
+		if errors.Is(err, context.DeadlineExceeded) {
+			N40Timeouts.Inc()
+		}
+	}
+	ChfChargingDataUpdateRequests.Inc()
+}
+
+func ReportReleaseChargingData(err error) {
+	UpdateN40RecentRequestMetrics(err)
+	if err != nil {
+		ChfChargingDataReleaseFailures.Inc()
+		if errors.Is(err, context.DeadlineExceeded) {
+			N40Timeouts.Inc()
+		}
+	}
+	ChfChargingDataReleaseRequests.Inc()
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n40
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/golang/glog"
+
+	mcfgprotos "magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/sbi"
+	"magma/feg/gateway/services/n7_n40_proxy/n7"
+	"magma/feg/gateway/utils"
+	"magma/gateway/mconfig"
+)
+
+const (
+	DisableN40Env          = "DISABLE_N40"
+	ChfApiRoot             = "CHF_API_ROOT"
+	ChfTokenUrl            = "CHF_TOKEN_URL"
+	ChfClientId            = "CHF_CLIENT_ID"
+	ChfClientSecret        = "CHF_CLIENT_SECRET"
+	N40ClientLocalAddr     = "N40_CONSUMER_LOCAL_ADDR"
+	N40ClientNotifyApiRoot = "N40_CONSUMER_NOTIFY_API_ROOT"
+
+	DefaultChfApiRoot       = "https://localhost/nchf-convergedcharging/v3"
+	DefaultChfTokenUrl      = "https://localhost/token"
+	DefaultN40ClientAddr    = "localhost:0"
+	DefaultN40ClientApiRoot = "https://localhost/nchf-convergedcharging/v3/notify"
+)
+
+type N40Config struct {
+	DisableN40   bool
+	ServerConfig sbi.RemoteConfig
+	ClientConfig sbi.NotifierConfig
+}
+
+// GetN40Config returns the N40 configuration from the n7_n40_proxy mconfig,
+// or from the environment when no N40 mconfig is present. N40 is disabled by
+// default when configured from the environment, since not every deployment
+// has a CHF.
+func GetN40Config() (*N40Config, error) {
+	configPtr := &mcfgprotos.N7N40ProxyConfig{}
+	conf := &N40Config{}
+
+	err := mconfig.GetServiceConfigs(n7.N7N40ProxyServiceName, configPtr)
+	if err != nil || !validManagedConfig(configPtr) {
+		glog.V(2).Infof("Managed Configs Load Error: %v Using EnvVars", err)
+		apiRoot, err := url.ParseRequestURI(utils.GetValueOrEnv("", ChfApiRoot, DefaultChfApiRoot))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CHF ApiRoot - %s", err)
+		}
+		conf.ServerConfig = sbi.RemoteConfig{
+			ApiRoot:      *apiRoot,
+			TokenUrl:     utils.GetValueOrEnv("", ChfTokenUrl, DefaultChfTokenUrl),
+			ClientId:     utils.GetValueOrEnv("", ChfClientId, n7.DefaultClientId),
+			ClientSecret: utils.GetValueOrEnv("", ChfClientSecret, n7.DefaultClientSecret),
+		}
+		conf.DisableN40 = utils.GetBoolValueOrEnv("", DisableN40Env, true)
+		conf.ClientConfig = sbi.NotifierConfig{
+			LocalAddr:     utils.GetValueOrEnv("", N40ClientLocalAddr, DefaultN40ClientAddr),
+			NotifyApiRoot: utils.GetValueOrEnv("", N40ClientNotifyApiRoot, DefaultN40ClientApiRoot),
+		}
+	} else {
+		n40configPtr := configPtr.N40Config
+		conf.DisableN40 = n40configPtr.DisableN40
+		apiRoot, err := url.ParseRequestURI(utils.GetValueOrEnv("", ChfApiRoot, n40configPtr.Server.GetApiRoot()))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CHF ApiRoot - %s", err)
+		}
+		conf.ServerConfig = sbi.RemoteConfig{
+			ApiRoot:      *apiRoot,
+			TokenUrl:     utils.GetValueOrEnv("", ChfTokenUrl, n40configPtr.Server.GetTokenUrl()),
+			ClientId:     utils.GetValueOrEnv("", ChfClientId, n40configPtr.Server.GetClientId()),
+			ClientSecret: utils.GetValueOrEnv("", ChfClientSecret, n40configPtr.Server.GetClientSecret()),
+		}
+		conf.ClientConfig = sbi.NotifierConfig{
+			LocalAddr:     utils.GetValueOrEnv("", N40ClientLocalAddr, n40configPtr.Client.LocalAddr),
+			NotifyApiRoot: utils.GetValueOrEnv("", N40ClientNotifyApiRoot, n40configPtr.Client.NotifyApiRoot),
+		}
+	}
+	err = validateN40Config(conf)
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func validManagedConfig(config *mcfgprotos.N7N40ProxyConfig) bool {
+	if config.N40Config == nil || config.N40Config.Server == nil || config.N40Config.Client == nil {
+		return false
+	}
+	return true
+}
+
+func validateN40Config(config *N40Config) error {
+	_, err := url.ParseRequestURI(config.ServerConfig.TokenUrl)
+	if err != nil {
+		return fmt.Errorf("invalid CHF TokenUrl - %s", err)
+	}
+	_, err = url.ParseRequestURI(config.ClientConfig.NotifyApiRoot)
+	if err != nil {
+		return fmt.Errorf("invalid BaseClientWithNotifier NotifyApiRoot - %s", err)
+	}
+	_, err = net.ResolveTCPAddr("tcp", config.ClientConfig.LocalAddr)
+	if err != nil {
+		return fmt.Errorf("invalid BaseClientWithNotifier LocalAddr - %s", err)
+	}
+	return nil
+}
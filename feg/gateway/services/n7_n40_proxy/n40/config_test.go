@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n40_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
+	"magma/feg/gateway/services/n7_n40_proxy/n7"
+	"magma/gateway/mconfig"
+)
+
+const (
+	URL1            = "https://mockchf/nchf-convergedcharging/v3"
+	TOKEN_URL       = "https://mockchf/oauth2/token"
+	CLIENT_ID       = "feg_magma_client"
+	CLIENT_SECRET   = "feg_magma_secret"
+	LOCAL_ADDR      = "127.0.0.1:10101"
+	NOTIFY_API_ROOT = "https://magma-feg.magam.com/nchf-convergedcharging/v3/notify"
+)
+
+var (
+	config = `{
+		"configsByKey": {
+			"n7_n40_proxy": {
+				"@type": "type.googleapis.com/magma.mconfig.N7N40ProxyConfig",
+				"logLevel": "INFO",
+				"n40_config": {
+					"disableN40": false,
+					"server": {
+						"apiRoot": "https://mockchf/nchf-convergedcharging/v3",
+						"tokenUrl": "https://mockchf/oauth2/token",
+						"clientId": "feg_magma_client",
+						"clientSecret": "feg_magma_secret"
+					},
+					"client": {
+						"local_addr": "127.0.0.1:10101",
+						"notify_api_root": "https://magma-feg.magam.com/nchf-convergedcharging/v3/notify"
+					}
+				}
+			}
+		}
+	}`
+	err_config = `{
+		"configsByKey": {
+			"n7_n40_proxy": {
+				"@type": "type.googleapis.com/magma.mconfig.N7N40ProxyConfig",
+				"logLevel": "INFO",
+				"n40_config": {
+					"disableN40": false,
+					"server": {
+						"apiRoot": "mockchf/nchf-convergedcharging/v3",
+						"tokenUrl": "https://mockchf/oauth2/token",
+						"clientId": "feg_magma_client",
+						"clientSecret": "feg_magma_secret"
+					},
+					"client": {
+						"local_addr": "127.0.0.1:10101",
+						"notify_api_root": "https://magma-feg.magam.com/nchf-convergedcharging/v3/notify"
+					}
+				}
+			}
+		}
+	}`
+	empty_config = `{
+		"configsByKey": {
+			"n7_n40_proxy": {
+				"@type": "type.googleapis.com/magma.mconfig.N7N40ProxyConfig"
+			}
+		}
+	}`
+)
+
+func TestGetN40Config(t *testing.T) {
+	conf, err := generateN40Mconfig(t, config)
+	require.NoError(t, err)
+	assert.Equal(t, false, conf.DisableN40)
+	url1, _ := url.ParseRequestURI(URL1)
+	assert.Equal(t, *url1, conf.ServerConfig.ApiRoot)
+	assert.Equal(t, TOKEN_URL, conf.ServerConfig.TokenUrl)
+	assert.Equal(t, CLIENT_ID, conf.ServerConfig.ClientId)
+	assert.Equal(t, CLIENT_SECRET, conf.ServerConfig.ClientSecret)
+	assert.Equal(t, LOCAL_ADDR, conf.ClientConfig.LocalAddr)
+	assert.Equal(t, NOTIFY_API_ROOT, conf.ClientConfig.NotifyApiRoot)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	_, err := generateN40Mconfig(t, err_config)
+	assert.Error(t, err)
+}
+
+func TestGetFromEnv(t *testing.T) {
+	conf, err := generateN40Mconfig(t, empty_config)
+	require.NoError(t, err)
+	assert.Equal(t, true, conf.DisableN40)
+	url1, _ := url.ParseRequestURI(n40.DefaultChfApiRoot)
+	assert.Equal(t, *url1, conf.ServerConfig.ApiRoot)
+	assert.Equal(t, n40.DefaultChfTokenUrl, conf.ServerConfig.TokenUrl)
+	assert.Equal(t, n7.DefaultClientId, conf.ServerConfig.ClientId)
+	assert.Equal(t, n7.DefaultClientSecret, conf.ServerConfig.ClientSecret)
+	assert.Equal(t, n40.DefaultN40ClientAddr, conf.ClientConfig.LocalAddr)
+	assert.Equal(t, n40.DefaultN40ClientApiRoot, conf.ClientConfig.NotifyApiRoot)
+}
+
+func generateN40Mconfig(t *testing.T, configString string) (*n40.N40Config, error) {
+	err := mconfig.CreateLoadTempConfig(configString)
+	assert.NoError(t, err)
+	return n40.GetN40Config()
+}
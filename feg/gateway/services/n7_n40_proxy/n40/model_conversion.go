@@ -0,0 +1,464 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n40
+
+import (
+	b64 "encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/policydb"
+	sbi "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	sbi_Nchf "magma/feg/gateway/sbi/specs/TS32291NchfConvergedCharging"
+	"magma/feg/gateway/services/n7_n40_proxy/n7"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	NodeFunctionalitySMF = "SMF"
+
+	ResultCodeSuccess = "SUCCESS"
+
+	NotificationTypeReauthorization = "REAUTHORIZATION"
+	NotificationTypeAbortCharging   = "ABORT_CHARGING"
+
+	TriggerCategoryImmediateReport = "IMMEDIATE_REPORT"
+
+	// DefaultRequestedUnits is the volume requested for a rating group when
+	// the gateway doesn't specify one
+	DefaultRequestedUnits = 100000
+)
+
+// resultCodeToDiameterMap maps the Nchf ResultCode values to the Gy result
+// codes session manager understands
+var resultCodeToDiameterMap = map[string]uint32{
+	ResultCodeSuccess:                 diameter.SuccessCode,
+	"END_USER_SERVICE_DENIED":         4010,
+	"QUOTA_MANAGEMENT_NOT_APPLICABLE": 4011,
+	"QUOTA_LIMIT_REACHED":             diameter.DiameterCreditLimitReached,
+	"END_USER_SERVICE_REJECTED":       4010,
+	"USER_UNKNOWN":                    5030,
+	"RATING_FAILED":                   diameter.DiameterRatingFailed,
+}
+
+// unknownResultCode is DIAMETER_UNABLE_TO_COMPLY
+const unknownResultCode = 5012
+
+var creditUsageTypeToTriggerMap = map[protos.CreditUsage_UpdateType]string{
+	protos.CreditUsage_THRESHOLD:               "QUOTA_THRESHOLD",
+	protos.CreditUsage_QHT:                     "QHT",
+	protos.CreditUsage_TERMINATED:              "FINAL",
+	protos.CreditUsage_QUOTA_EXHAUSTED:         "QUOTA_EXHAUSTED",
+	protos.CreditUsage_VALIDITY_TIMER_EXPIRED:  "VALIDITY_TIME",
+	protos.CreditUsage_OTHER_QUOTA_TYPE:        "OTHER_QUOTA_TYPE",
+	protos.CreditUsage_RATING_CONDITION_CHANGE: "RATING_CONDITION_CHANGE",
+	protos.CreditUsage_REAUTH_REQUIRED:         "FORCED_REAUTHORISATION",
+	protos.CreditUsage_POOL_EXHAUSTED:          "QUOTA_EXHAUSTED",
+}
+
+var finalUnitActionToProtoMap = map[string]protos.ChargingCredit_FinalAction{
+	"TERMINATE":       protos.ChargingCredit_TERMINATE,
+	"REDIRECT":        protos.ChargingCredit_REDIRECT,
+	"RESTRICT_ACCESS": protos.ChargingCredit_RESTRICT_ACCESS,
+}
+
+var redirectAddressTypeToProtoMap = map[string]protos.RedirectServer_RedirectAddressType{
+	"IPV4": protos.RedirectServer_IPV4,
+	"IPV6": protos.RedirectServer_IPV6,
+	"URL":  protos.RedirectServer_URL,
+	"URI":  protos.RedirectServer_SIP_URI,
+}
+
+// ChargingDataUpdateReqCtx holds a ChargingDataUpdate request for a single
+// session, along with the credit usage updates it was built from.
+type ChargingDataUpdateReqCtx struct {
+	ChargingDataRef string
+	SessionId       string
+	IMSI            string
+	TgppCtx         *protos.TgppContext
+	Updates         []*protos.CreditUsageUpdate
+	ReqBody         *sbi_Nchf.ChargingDataRequest
+}
+
+//
+// From Proto to SBI types
+//
+
+// GetChargingDataRequestN40 builds the ChargingDataCreate request for a new
+// session, requesting quota for each of the charging keys.
+func GetChargingDataRequestN40(
+	request *protos.CreateSessionRequest,
+	keys []policydb.ChargingKey,
+	notifyApiRoot string,
+) *sbi_Nchf.ChargingDataRequest {
+	common := request.GetCommonContext()
+	supi := sbi.Supi(removeIMSIPrefix(common.GetSid().GetId()))
+	notifyUri := GenNotifyUrl(notifyApiRoot, request.SessionId)
+
+	pduSessionInfo := sbi_Nchf.PDUSessionInformation{
+		DnnId:   sbi.Dnn(common.GetApn()),
+		RatType: n7.GetSbiRatType(common.GetRatType()),
+	}
+	if len(common.GetUeIpv4()) != 0 {
+		ipv4 := sbi.Ipv4Addr(common.GetUeIpv4())
+		pduSessionInfo.PduAddress = &sbi_Nchf.PDUAddress{PduIPv4Address: &ipv4}
+	}
+	var gpsi *sbi.Gpsi
+	if len(common.GetMsisdn()) != 0 {
+		msisdn := sbi.Gpsi(common.GetMsisdn())
+		gpsi = &msisdn
+	}
+	if request.RatSpecificContext != nil {
+		ratSpecific := request.GetRatSpecificContext().GetContext()
+		switch context := ratSpecific.(type) {
+		case *protos.RatSpecificContext_M5GsmSessionContext:
+			m5gCtx := context.M5GsmSessionContext
+			pduSessionInfo.PduSessionID = sbi.PduSessionId(m5gCtx.GetPduSessionId())
+			pduType := n7.GetSbiPduSessionType(m5gCtx.GetPduSessionType())
+			pduSessionInfo.PduType = &pduType
+			if len(m5gCtx.GetGpsi()) != 0 {
+				m5gGpsi := sbi.Gpsi(m5gCtx.GetGpsi())
+				gpsi = &m5gGpsi
+			}
+		}
+	}
+
+	units := make([]sbi_Nchf.MultipleUnitUsage, 0, len(keys))
+	for _, key := range keys {
+		units = append(units, sbi_Nchf.MultipleUnitUsage{
+			RatingGroup:   sbi.RatingGroup(key.RatingGroup),
+			RequestedUnit: getRequestedUnitN40(request.RequestedUnits),
+		})
+	}
+	return &sbi_Nchf.ChargingDataRequest{
+		InvocationSequenceNumber: 0,
+		InvocationTimeStamp:      sbi.DateTime(time.Now()),
+		NfConsumerIdentification: &sbi_Nchf.NFIdentification{NodeFunctionality: NodeFunctionalitySMF},
+		NotifyUri:                &notifyUri,
+		SubscriberIdentifier:     &supi,
+		MultipleUnitUsage:        &units,
+		PDUSessionChargingInformation: &sbi_Nchf.PDUSessionChargingInformation{
+			PduSessionInformation: pduSessionInfo,
+			UetimeZone:            n7.GetSbiTimeZone(request.GetAccessTimezone()),
+			UserInformation:       &sbi_Nchf.UserInformation{ServedGPSI: gpsi},
+		},
+	}
+}
+
+// GetChargingDataUpdateRequestsN40 merges the credit usage updates of each
+// session into a single ChargingDataUpdate request.
+func GetChargingDataUpdateRequestsN40(updates []*protos.CreditUsageUpdate) []*ChargingDataUpdateReqCtx {
+	reqCtxs := []*ChargingDataUpdateReqCtx{}
+	reqCtxBySession := map[string]*ChargingDataUpdateReqCtx{}
+	for _, update := range updates {
+		reqCtx, found := reqCtxBySession[update.SessionId]
+		if !found {
+			// ChargingDataRef is left empty when it can't be determined, the
+			// caller fails the updates of the session in that case
+			chargingDataRef, _ := GetChargingDataRef(update.GetTgppCtx())
+			supi := sbi.Supi(removeIMSIPrefix(update.GetCommonContext().GetSid().GetId()))
+			reqCtx = &ChargingDataUpdateReqCtx{
+				ChargingDataRef: chargingDataRef,
+				SessionId:       update.SessionId,
+				IMSI:            update.GetCommonContext().GetSid().GetId(),
+				TgppCtx:         update.GetTgppCtx(),
+				ReqBody: &sbi_Nchf.ChargingDataRequest{
+					InvocationSequenceNumber: sbi.Uint32(update.RequestNumber),
+					InvocationTimeStamp:      sbi.DateTime(time.Now()),
+					NfConsumerIdentification: &sbi_Nchf.NFIdentification{NodeFunctionality: NodeFunctionalitySMF},
+					SubscriberIdentifier:     &supi,
+					MultipleUnitUsage:        &[]sbi_Nchf.MultipleUnitUsage{},
+				},
+			}
+			reqCtxBySession[update.SessionId] = reqCtx
+			reqCtxs = append(reqCtxs, reqCtx)
+		}
+		reqCtx.Updates = append(reqCtx.Updates, update)
+		*reqCtx.ReqBody.MultipleUnitUsage = append(*reqCtx.ReqBody.MultipleUnitUsage,
+			getMultipleUnitUsageN40(update.GetUsage(), int(update.RequestNumber)))
+	}
+	return reqCtxs
+}
+
+// GetChargingDataReleaseReqBody builds the ChargingDataRelease request
+// reporting the final usage of a terminated session.
+func GetChargingDataReleaseReqBody(request *protos.SessionTerminateRequest) *sbi_Nchf.ChargingDataRequest {
+	supi := sbi.Supi(removeIMSIPrefix(request.GetCommonContext().GetSid().GetId()))
+	units := make([]sbi_Nchf.MultipleUnitUsage, 0, len(request.CreditUsages))
+	for _, usage := range request.CreditUsages {
+		units = append(units, getMultipleUnitUsageN40(usage, int(request.RequestNumber)))
+	}
+	return &sbi_Nchf.ChargingDataRequest{
+		InvocationSequenceNumber: sbi.Uint32(request.RequestNumber),
+		InvocationTimeStamp:      sbi.DateTime(time.Now()),
+		NfConsumerIdentification: &sbi_Nchf.NFIdentification{NodeFunctionality: NodeFunctionalitySMF},
+		SubscriberIdentifier:     &supi,
+		MultipleUnitUsage:        &units,
+	}
+}
+
+func getMultipleUnitUsageN40(usage *protos.CreditUsage, sequenceNumber int) sbi_Nchf.MultipleUnitUsage {
+	container := sbi_Nchf.UsedUnitContainer{
+		LocalSequenceNumber: sequenceNumber,
+		TotalVolume:         getSbiUint64(usage.GetBytesTx() + usage.GetBytesRx()),
+		UplinkVolume:        getSbiUint64(usage.GetBytesTx()),
+		DownlinkVolume:      getSbiUint64(usage.GetBytesRx()),
+	}
+	if usage.GetServiceIdentifier() != nil {
+		serviceId := sbi.ServiceId(usage.GetServiceIdentifier().GetValue())
+		container.ServiceId = &serviceId
+	}
+	if triggerType, found := creditUsageTypeToTriggerMap[usage.GetType()]; found {
+		container.Triggers = &[]sbi_Nchf.Trigger{{
+			TriggerCategory: TriggerCategoryImmediateReport,
+			TriggerType:     triggerType,
+		}}
+	}
+	unitUsage := sbi_Nchf.MultipleUnitUsage{
+		RatingGroup:       sbi.RatingGroup(usage.GetChargingKey()),
+		UsedUnitContainer: &[]sbi_Nchf.UsedUnitContainer{container},
+	}
+	if usage.GetType() != protos.CreditUsage_TERMINATED {
+		unitUsage.RequestedUnit = getRequestedUnitN40(usage.GetRequestedUnits())
+	}
+	return unitUsage
+}
+
+func getRequestedUnitN40(requestedUnits *protos.RequestedUnits) *sbi_Nchf.RequestedUnit {
+	if requestedUnits == nil {
+		requestedUnits = &protos.RequestedUnits{
+			Total: DefaultRequestedUnits,
+			Tx:    DefaultRequestedUnits,
+			Rx:    DefaultRequestedUnits,
+		}
+	}
+	return &sbi_Nchf.RequestedUnit{
+		TotalVolume:    getSbiUint64(requestedUnits.GetTotal()),
+		UplinkVolume:   getSbiUint64(requestedUnits.GetTx()),
+		DownlinkVolume: getSbiUint64(requestedUnits.GetRx()),
+	}
+}
+
+//
+// From SBI types to proto
+//
+
+// GetCreditResponsesProto converts the unit information of a ChargingDataResponse
+// into CreditUpdateResponses. serviceIds holds the service identifier of the
+// rating groups which track one.
+func GetCreditResponsesProto(
+	imsi string,
+	sessionId string,
+	tgppCtx *protos.TgppContext,
+	chargingData *sbi_Nchf.ChargingDataResponse,
+	serviceIds map[uint32]*protos.ServiceIdentifier,
+) []*protos.CreditUpdateResponse {
+	if chargingData == nil || chargingData.MultipleUnitInformation == nil {
+		return []*protos.CreditUpdateResponse{}
+	}
+	responses := make([]*protos.CreditUpdateResponse, 0, len(*chargingData.MultipleUnitInformation))
+	for _, unitInfo := range *chargingData.MultipleUnitInformation {
+		resultCode := getResultCodeProto(unitInfo.ResultCode)
+		responses = append(responses, &protos.CreditUpdateResponse{
+			Success:           resultCode == diameter.SuccessCode,
+			Sid:               addIMSIPrefix(imsi),
+			SessionId:         sessionId,
+			ChargingKey:       uint32(unitInfo.RatingGroup),
+			Credit:            getChargingCreditProto(&unitInfo),
+			ResultCode:        resultCode,
+			ServiceIdentifier: serviceIds[uint32(unitInfo.RatingGroup)],
+			TgppCtx:           tgppCtx,
+		})
+	}
+	return responses
+}
+
+// GetFailedCreditResponsesProto returns a failed CreditUpdateResponse for
+// every update of a ChargingDataUpdate request that couldn't be completed.
+func GetFailedCreditResponsesProto(reqCtx *ChargingDataUpdateReqCtx) []*protos.CreditUpdateResponse {
+	responses := make([]*protos.CreditUpdateResponse, 0, len(reqCtx.Updates))
+	for _, update := range reqCtx.Updates {
+		responses = append(responses, &protos.CreditUpdateResponse{
+			Success:           false,
+			Sid:               addIMSIPrefix(reqCtx.IMSI),
+			SessionId:         reqCtx.SessionId,
+			ChargingKey:       update.GetUsage().GetChargingKey(),
+			ServiceIdentifier: update.GetUsage().GetServiceIdentifier(),
+			TgppCtx:           reqCtx.TgppCtx,
+		})
+	}
+	return responses
+}
+
+// GetServiceIdsFromChargingKeys returns the service identifiers of the
+// charging keys which track one, by rating group
+func GetServiceIdsFromChargingKeys(keys []policydb.ChargingKey) map[uint32]*protos.ServiceIdentifier {
+	serviceIds := map[uint32]*protos.ServiceIdentifier{}
+	for _, key := range keys {
+		if key.ServiceIdTracking {
+			serviceIds[key.RatingGroup] = &protos.ServiceIdentifier{Value: key.ServiceIdentifier}
+		}
+	}
+	return serviceIds
+}
+
+// GetServiceIdsFromUpdates returns the service identifiers of the credit
+// usage updates which have one, by rating group
+func GetServiceIdsFromUpdates(updates []*protos.CreditUsageUpdate) map[uint32]*protos.ServiceIdentifier {
+	serviceIds := map[uint32]*protos.ServiceIdentifier{}
+	for _, update := range updates {
+		if update.GetUsage().GetServiceIdentifier() != nil {
+			serviceIds[update.GetUsage().GetChargingKey()] = update.GetUsage().GetServiceIdentifier()
+		}
+	}
+	return serviceIds
+}
+
+// GetChargingReAuthRequestsProto converts a REAUTHORIZATION notification from
+// the CHF into ChargingReAuthRequests. Without reauthorization details the
+// whole session is reauthorized.
+func GetChargingReAuthRequestsProto(
+	sessionId string,
+	imsi string,
+	notification *sbi_Nchf.ChargingNotifyRequest,
+) []*protos.ChargingReAuthRequest {
+	if notification.ReauthorizationDetails == nil || len(*notification.ReauthorizationDetails) == 0 {
+		return []*protos.ChargingReAuthRequest{{
+			SessionId: sessionId,
+			Sid:       imsi,
+			Type:      protos.ChargingReAuthRequest_ENTIRE_SESSION,
+		}}
+	}
+	requests := []*protos.ChargingReAuthRequest{}
+	for _, details := range *notification.ReauthorizationDetails {
+		request := &protos.ChargingReAuthRequest{
+			SessionId: sessionId,
+			Sid:       imsi,
+			Type:      protos.ChargingReAuthRequest_ENTIRE_SESSION,
+		}
+		if details.RatingGroup != nil {
+			request.Type = protos.ChargingReAuthRequest_SINGLE_SERVICE
+			request.ChargingKey = uint32(*details.RatingGroup)
+		}
+		if details.ServiceId != nil {
+			request.ServiceIdentifier = &protos.ServiceIdentifier{Value: uint32(*details.ServiceId)}
+		}
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func getChargingCreditProto(unitInfo *sbi_Nchf.MultipleUnitInformation) *protos.ChargingCredit {
+	credit := &protos.ChargingCredit{
+		Type:         protos.ChargingCredit_BYTES,
+		GrantedUnits: getGrantedUnitsProto(unitInfo.GrantedUnit),
+	}
+	if unitInfo.ValidityTime != nil {
+		credit.ValidityTime = uint32(*unitInfo.ValidityTime)
+	}
+	fui := unitInfo.FinalUnitIndication
+	if fui == nil {
+		return credit
+	}
+	credit.IsFinal = true
+	if action, ok := fui.FinalUnitAction.(string); ok {
+		credit.FinalAction = finalUnitActionToProtoMap[action]
+	}
+	if fui.RedirectServer != nil {
+		credit.RedirectServer = &protos.RedirectServer{
+			RedirectServerAddress: fui.RedirectServer.RedirectServerAddress,
+		}
+		if addrType, ok := fui.RedirectServer.RedirectAddressType.(string); ok {
+			credit.RedirectServer.RedirectAddressType = redirectAddressTypeToProtoMap[addrType]
+		}
+	}
+	if fui.FilterId != nil {
+		credit.RestrictRules = []string{*fui.FilterId}
+	}
+	return credit
+}
+
+func getGrantedUnitsProto(grantedUnit *sbi_Nchf.GrantedUnit) *protos.GrantedUnits {
+	if grantedUnit == nil {
+		return &protos.GrantedUnits{
+			Total: &protos.CreditUnit{},
+			Tx:    &protos.CreditUnit{},
+			Rx:    &protos.CreditUnit{},
+		}
+	}
+	return &protos.GrantedUnits{
+		Total: getCreditUnitProto(grantedUnit.TotalVolume),
+		Tx:    getCreditUnitProto(grantedUnit.UplinkVolume),
+		Rx:    getCreditUnitProto(grantedUnit.DownlinkVolume),
+	}
+}
+
+func getCreditUnitProto(volume *sbi.Uint64) *protos.CreditUnit {
+	if volume == nil {
+		return &protos.CreditUnit{}
+	}
+	return &protos.CreditUnit{IsValid: true, Volume: uint64(*volume)}
+}
+
+// getResultCodeProto maps a unit information ResultCode to a Gy result code.
+// A missing ResultCode means the unit information was successful.
+func getResultCodeProto(resultCode *sbi_Nchf.ResultCode) uint32 {
+	if resultCode == nil || *resultCode == nil {
+		return diameter.SuccessCode
+	}
+	codeStr, ok := (*resultCode).(string)
+	if !ok {
+		return unknownResultCode
+	}
+	code, found := resultCodeToDiameterMap[codeStr]
+	if !found {
+		return unknownResultCode
+	}
+	return code
+}
+
+//
+// Helpers
+//
+
+// GenNotifyUrl returns the notifyUri of a session. The CHF posts the charging
+// notifications of the session directly to it.
+func GenNotifyUrl(apiRoot string, sessionId string) sbi.Uri {
+	return sbi.Uri(fmt.Sprintf("%s/%s/notify", apiRoot, b64.URLEncoding.EncodeToString([]byte(sessionId))))
+}
+
+// GetChargingDataRef returns the ChargingDataRef from the TgppContext.
+// The charging data url is of the form https://{chf-host}/nchf-convergedcharging/v3/chargingdata/{ChargingDataRef}
+func GetChargingDataRef(tgppCtx *protos.TgppContext) (string, error) {
+	if tgppCtx == nil {
+		return "", fmt.Errorf("couldn't get url from TgppContext: nil TgppCtx")
+	}
+	chargingDataUrl := tgppCtx.GetGyDestHost()
+	if len(chargingDataUrl) == 0 {
+		return "", fmt.Errorf("empty ChargingDataUrl in TgppCtx")
+	}
+	parsedUrl, err := url.Parse(chargingDataUrl)
+	if err != nil {
+		return "", fmt.Errorf("chargingDataUrl parse error: %s", err)
+	}
+	return path.Base(parsedUrl.Path), nil
+}
+
+func getSbiUint64(val uint64) *sbi.Uint64 {
+	ret := sbi.Uint64(val)
+	return &ret
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n40_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/policydb"
+	sbi "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	sbi_Nchf "magma/feg/gateway/sbi/specs/TS32291NchfConvergedCharging"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	IMSI1            = "IMSI123456789012345"
+	IMSI1_NOPREFIX   = "123456789012345"
+	IMSI2            = "IMSI123456789012346"
+	SESSION_ID1      = IMSI1 + "-1234"
+	SESSION_ID2      = IMSI2 + "-5678"
+	APN1             = "apn1"
+	UE_IPV4          = "10.1.2.3"
+	CHARGING_DATA1   = "https://mockchf/nchf-convergedcharging/v3/chargingdata/cd-ref-1"
+	CHARGING_DATA2   = "https://mockchf/nchf-convergedcharging/v3/chargingdata/cd-ref-2"
+	REDIRECT_ADDRESS = "http://portal.magma.test"
+)
+
+func TestChargingDataRequestFromProto(t *testing.T) {
+	request := &protos.CreateSessionRequest{
+		CommonContext: &protos.CommonSessionContext{
+			Sid:     &protos.SubscriberID{Id: IMSI1},
+			RatType: protos.RATType_TGPP_LTE,
+			UeIpv4:  UE_IPV4,
+			Apn:     APN1,
+		},
+		SessionId: SESSION_ID1,
+	}
+	keys := []policydb.ChargingKey{{RatingGroup: 1}, {RatingGroup: 2}}
+
+	reqBody := n40.GetChargingDataRequestN40(request, keys, NOTIFY_API_ROOT)
+	require.NotNil(t, reqBody.SubscriberIdentifier)
+	assert.Equal(t, sbi.Supi(IMSI1_NOPREFIX), *reqBody.SubscriberIdentifier)
+	assert.Equal(t, n40.GenNotifyUrl(NOTIFY_API_ROOT, SESSION_ID1), *reqBody.NotifyUri)
+	assert.Equal(t, n40.NodeFunctionalitySMF, reqBody.NfConsumerIdentification.NodeFunctionality)
+	pduInfo := reqBody.PDUSessionChargingInformation.PduSessionInformation
+	assert.Equal(t, sbi.Dnn(APN1), pduInfo.DnnId)
+	assert.Equal(t, sbi.Ipv4Addr(UE_IPV4), *pduInfo.PduAddress.PduIPv4Address)
+	require.Equal(t, 2, len(*reqBody.MultipleUnitUsage))
+	for i, unitUsage := range *reqBody.MultipleUnitUsage {
+		assert.Equal(t, sbi.RatingGroup(keys[i].RatingGroup), unitUsage.RatingGroup)
+		assert.Equal(t, sbi.Uint64(n40.DefaultRequestedUnits), *unitUsage.RequestedUnit.TotalVolume)
+		assert.Nil(t, unitUsage.UsedUnitContainer)
+	}
+
+	// The invocation timestamp survives the JSON encoding
+	data, err := n40.MarshalChargingDataRequest(reqBody)
+	require.NoError(t, err)
+	decoded, err := n40.UnmarshalChargingDataRequest(data)
+	require.NoError(t, err)
+	assert.True(t, time.Time(reqBody.InvocationTimeStamp).Equal(time.Time(decoded.InvocationTimeStamp)))
+	assert.Equal(t, *reqBody.SubscriberIdentifier, *decoded.SubscriberIdentifier)
+}
+
+func TestChargingDataUpdateRequestsFromProto(t *testing.T) {
+	updates := []*protos.CreditUsageUpdate{
+		creditUsageUpdate(IMSI1, SESSION_ID1, CHARGING_DATA1, 1, protos.CreditUsage_QUOTA_EXHAUSTED),
+		creditUsageUpdate(IMSI2, SESSION_ID2, CHARGING_DATA2, 1, protos.CreditUsage_VALIDITY_TIMER_EXPIRED),
+		creditUsageUpdate(IMSI1, SESSION_ID1, CHARGING_DATA1, 2, protos.CreditUsage_THRESHOLD),
+		creditUsageUpdate(IMSI2, SESSION_ID2, "", 2, protos.CreditUsage_THRESHOLD),
+	}
+
+	reqCtxs := n40.GetChargingDataUpdateRequestsN40(updates)
+	require.Equal(t, 2, len(reqCtxs))
+
+	reqCtx := reqCtxs[0]
+	assert.Equal(t, "cd-ref-1", reqCtx.ChargingDataRef)
+	assert.Equal(t, SESSION_ID1, reqCtx.SessionId)
+	assert.Equal(t, IMSI1, reqCtx.IMSI)
+	assert.Equal(t, []*protos.CreditUsageUpdate{updates[0], updates[2]}, reqCtx.Updates)
+	unitUsages := *reqCtx.ReqBody.MultipleUnitUsage
+	require.Equal(t, 2, len(unitUsages))
+	assert.Equal(t, sbi.RatingGroup(1), unitUsages[0].RatingGroup)
+	container := (*unitUsages[0].UsedUnitContainer)[0]
+	assert.Equal(t, sbi.Uint64(300), *container.TotalVolume)
+	assert.Equal(t, sbi.Uint64(100), *container.UplinkVolume)
+	assert.Equal(t, sbi.Uint64(200), *container.DownlinkVolume)
+	assert.Equal(t, "QUOTA_EXHAUSTED", (*container.Triggers)[0].TriggerType)
+	assert.Equal(t, sbi.RatingGroup(2), unitUsages[1].RatingGroup)
+
+	// The first update of a session determines its charging data
+	assert.Equal(t, "cd-ref-2", reqCtxs[1].ChargingDataRef)
+	assert.Equal(t, 2, len(*reqCtxs[1].ReqBody.MultipleUnitUsage))
+
+	reqCtxs = n40.GetChargingDataUpdateRequestsN40(updates[3:])
+	require.Equal(t, 1, len(reqCtxs))
+	assert.Empty(t, reqCtxs[0].ChargingDataRef)
+	failed := n40.GetFailedCreditResponsesProto(reqCtxs[0])
+	require.Equal(t, 1, len(failed))
+	assert.False(t, failed[0].Success)
+	assert.Equal(t, uint32(2), failed[0].ChargingKey)
+	assert.Equal(t, IMSI2, failed[0].Sid)
+}
+
+func TestChargingDataReleaseFromProto(t *testing.T) {
+	reqBody := n40.GetChargingDataReleaseReqBody(&protos.SessionTerminateRequest{
+		CommonContext: &protos.CommonSessionContext{Sid: &protos.SubscriberID{Id: IMSI1}},
+		SessionId:     SESSION_ID1,
+		RequestNumber: 3,
+		CreditUsages: []*protos.CreditUsage{{
+			ChargingKey: 1,
+			BytesTx:     10,
+			BytesRx:     20,
+			Type:        protos.CreditUsage_TERMINATED,
+		}},
+	})
+	assert.Equal(t, sbi.Uint32(3), reqBody.InvocationSequenceNumber)
+	require.Equal(t, 1, len(*reqBody.MultipleUnitUsage))
+	unitUsage := (*reqBody.MultipleUnitUsage)[0]
+	assert.Nil(t, unitUsage.RequestedUnit)
+	container := (*unitUsage.UsedUnitContainer)[0]
+	assert.Equal(t, sbi.Uint64(30), *container.TotalVolume)
+	assert.Equal(t, "FINAL", (*container.Triggers)[0].TriggerType)
+}
+
+func TestCreditResponsesFromChargingData(t *testing.T) {
+	var (
+		granted       = sbi.Uint64(1000)
+		validity      = sbi.DurationSec(60)
+		quotaReached  = sbi_Nchf.ResultCode("QUOTA_LIMIT_REACHED")
+		unknownResult = sbi_Nchf.ResultCode("SOMETHING_ELSE")
+		filterId      = "restrict-filter"
+	)
+	chargingData := &sbi_Nchf.ChargingDataResponse{
+		MultipleUnitInformation: &[]sbi_Nchf.MultipleUnitInformation{
+			{
+				RatingGroup:  1,
+				GrantedUnit:  &sbi_Nchf.GrantedUnit{TotalVolume: &granted},
+				ValidityTime: &validity,
+			},
+			{
+				RatingGroup: 2,
+				GrantedUnit: &sbi_Nchf.GrantedUnit{TotalVolume: &granted},
+				FinalUnitIndication: &sbi_Nchf.FinalUnitIndication{
+					FinalUnitAction: "REDIRECT",
+					RedirectServer: &sbi_Nchf.RedirectServer{
+						RedirectAddressType:   "URL",
+						RedirectServerAddress: REDIRECT_ADDRESS,
+					},
+				},
+			},
+			{
+				RatingGroup: 3,
+				GrantedUnit: &sbi_Nchf.GrantedUnit{TotalVolume: &granted},
+				FinalUnitIndication: &sbi_Nchf.FinalUnitIndication{
+					FinalUnitAction: "RESTRICT_ACCESS",
+					FilterId:        &filterId,
+				},
+			},
+			{RatingGroup: 4, ResultCode: &quotaReached},
+			{RatingGroup: 5, ResultCode: &unknownResult},
+		},
+	}
+	tgppCtx := &protos.TgppContext{GyDestHost: CHARGING_DATA1}
+	serviceIds := map[uint32]*protos.ServiceIdentifier{1: {Value: 11}}
+
+	responses := n40.GetCreditResponsesProto(IMSI1_NOPREFIX, SESSION_ID1, tgppCtx, chargingData, serviceIds)
+	require.Equal(t, 5, len(responses))
+
+	assert.True(t, responses[0].Success)
+	assert.Equal(t, IMSI1, responses[0].Sid)
+	assert.Equal(t, tgppCtx, responses[0].TgppCtx)
+	assert.Equal(t, &protos.ServiceIdentifier{Value: 11}, responses[0].ServiceIdentifier)
+	assert.Equal(t, uint64(1000), responses[0].Credit.GrantedUnits.Total.Volume)
+	assert.Equal(t, uint32(60), responses[0].Credit.ValidityTime)
+	assert.False(t, responses[0].Credit.IsFinal)
+
+	assert.True(t, responses[1].Credit.IsFinal)
+	assert.Equal(t, protos.ChargingCredit_REDIRECT, responses[1].Credit.FinalAction)
+	assert.Equal(t, &protos.RedirectServer{
+		RedirectAddressType:   protos.RedirectServer_URL,
+		RedirectServerAddress: REDIRECT_ADDRESS,
+	}, responses[1].Credit.RedirectServer)
+
+	assert.Equal(t, protos.ChargingCredit_RESTRICT_ACCESS, responses[2].Credit.FinalAction)
+	assert.Equal(t, []string{filterId}, responses[2].Credit.RestrictRules)
+
+	assert.False(t, responses[3].Success)
+	assert.Equal(t, uint32(4012), responses[3].ResultCode)
+	assert.False(t, responses[4].Success)
+	assert.Equal(t, uint32(5012), responses[4].ResultCode)
+}
+
+func TestGetChargingDataRef(t *testing.T) {
+	ref, err := n40.GetChargingDataRef(&protos.TgppContext{GyDestHost: CHARGING_DATA1})
+	require.NoError(t, err)
+	assert.Equal(t, "cd-ref-1", ref)
+
+	_, err = n40.GetChargingDataRef(&protos.TgppContext{})
+	assert.Error(t, err)
+	_, err = n40.GetChargingDataRef(nil)
+	assert.Error(t, err)
+}
+
+func creditUsageUpdate(
+	imsi string,
+	sessionId string,
+	chargingDataUrl string,
+	chargingKey uint32,
+	updateType protos.CreditUsage_UpdateType,
+) *protos.CreditUsageUpdate {
+	return &protos.CreditUsageUpdate{
+		Usage: &protos.CreditUsage{
+			ChargingKey: chargingKey,
+			BytesTx:     100,
+			BytesRx:     200,
+			Type:        updateType,
+		},
+		SessionId:     sessionId,
+		RequestNumber: 2,
+		TgppCtx:       &protos.TgppContext{GyDestHost: chargingDataUrl},
+		CommonContext: &protos.CommonSessionContext{Sid: &protos.SubscriberID{Id: imsi}},
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n40
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"magma/feg/gateway/sbi"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	sbi_Nchf "magma/feg/gateway/sbi/specs/TS32291NchfConvergedCharging"
+	"magma/gateway/service_registry"
+)
+
+const (
+	chargingDataPath = "/chargingdata"
+	contentTypeJSON  = "application/json"
+)
+
+// ChargingDataClient sends Nchf_ConvergedCharging requests to a CHF.
+//
+// The TS32291NchfConvergedCharging spec package only contains the data types,
+// so unlike N7 the client isn't generated.
+type ChargingDataClient interface {
+	// PostChargingData creates a charging data resource and returns the CHF
+	// response along with the resource URL from the Location header.
+	PostChargingData(ctx context.Context, body *sbi_Nchf.ChargingDataRequest) (*sbi_Nchf.ChargingDataResponse, string, error)
+	// PostChargingDataUpdate updates the charging data resource identified by chargingDataRef
+	PostChargingDataUpdate(ctx context.Context, chargingDataRef string, body *sbi_Nchf.ChargingDataRequest) (*sbi_Nchf.ChargingDataResponse, error)
+	// PostChargingDataRelease releases the charging data resource identified by chargingDataRef
+	PostChargingDataRelease(ctx context.Context, chargingDataRef string, body *sbi_Nchf.ChargingDataRequest) error
+}
+
+type N40Client struct {
+	*sbi.BaseClientWithNotifier
+	ChargingDataClient
+	CloudRegistry service_registry.GatewayRegistry
+}
+
+// NewN40ClientWithHandlers creates a N40 client and adds N40 handlers
+func NewN40ClientWithHandlers(cfg *N40Config, cloudReg service_registry.GatewayRegistry) (*N40Client, error) {
+	// client creation to handle magma initiated request
+	chargingDataCli := NewChargingDataClient(cfg.ServerConfig.ApiRoot.String(), cfg.ServerConfig.BuildHttpClient())
+	n40Cli := NewN40Client(cfg, chargingDataCli, cloudReg)
+
+	// add handlers to handle CHF initiated requests
+	err := n40Cli.registerHandlers()
+	if err != nil {
+		return nil, fmt.Errorf("error registering handlers: %s", err)
+	}
+	err = n40Cli.NotifyServer.Start()
+	if err != nil {
+		return nil, fmt.Errorf("error starting notification handler: %s", err)
+	}
+	return n40Cli, nil
+}
+
+// NewN40Client creates a N40 api client
+func NewN40Client(cfg *N40Config, chargingDataCli ChargingDataClient, cloudReg service_registry.GatewayRegistry) *N40Client {
+	return &N40Client{
+		BaseClientWithNotifier: sbi.NewBaseClientWithNotifyServer(cfg.ClientConfig, cfg.ServerConfig),
+		ChargingDataClient:     chargingDataCli,
+		CloudRegistry:          cloudReg,
+	}
+}
+
+type chargingDataHttpClient struct {
+	apiRoot    string
+	httpClient *http.Client
+}
+
+// NewChargingDataClient creates a ChargingDataClient sending requests to the
+// CHF at apiRoot, e.g. https://chf.example.com/nchf-convergedcharging/v3
+func NewChargingDataClient(apiRoot string, httpClient *http.Client) ChargingDataClient {
+	return &chargingDataHttpClient{
+		apiRoot:    strings.TrimSuffix(apiRoot, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// PostChargingData handles POST /chargingdata
+func (c *chargingDataHttpClient) PostChargingData(
+	ctx context.Context,
+	body *sbi_Nchf.ChargingDataRequest,
+) (*sbi_Nchf.ChargingDataResponse, string, error) {
+	resp, err := c.post(ctx, c.apiRoot+chargingDataPath, body, http.StatusCreated)
+	if err != nil {
+		return nil, "", err
+	}
+	location := resp.Header.Get("Location")
+	if len(location) == 0 {
+		return nil, "", fmt.Errorf("ChargingDataCreate request failure: Location header not found")
+	}
+	chargingData, err := decodeChargingDataResponse(resp)
+	if err != nil {
+		return nil, "", err
+	}
+	return chargingData, location, nil
+}
+
+// PostChargingDataUpdate handles POST /chargingdata/{ChargingDataRef}/update
+func (c *chargingDataHttpClient) PostChargingDataUpdate(
+	ctx context.Context,
+	chargingDataRef string,
+	body *sbi_Nchf.ChargingDataRequest,
+) (*sbi_Nchf.ChargingDataResponse, error) {
+	reqUrl := fmt.Sprintf("%s%s/%s/update", c.apiRoot, chargingDataPath, chargingDataRef)
+	resp, err := c.post(ctx, reqUrl, body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return decodeChargingDataResponse(resp)
+}
+
+// PostChargingDataRelease handles POST /chargingdata/{ChargingDataRef}/release
+func (c *chargingDataHttpClient) PostChargingDataRelease(
+	ctx context.Context,
+	chargingDataRef string,
+	body *sbi_Nchf.ChargingDataRequest,
+) error {
+	reqUrl := fmt.Sprintf("%s%s/%s/release", c.apiRoot, chargingDataPath, chargingDataRef)
+	resp, err := c.post(ctx, reqUrl, body, http.StatusNoContent)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *chargingDataHttpClient) post(
+	ctx context.Context,
+	reqUrl string,
+	body *sbi_Nchf.ChargingDataRequest,
+	expectedStatus int,
+) (*http.Response, error) {
+	reqBody, err := MarshalChargingDataRequest(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqUrl, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != expectedStatus {
+		resp.Body.Close()
+		return nil, fmt.Errorf("http error status-code=%d url=%s", resp.StatusCode, reqUrl)
+	}
+	return resp, nil
+}
+
+func decodeChargingDataResponse(resp *http.Response) (*sbi_Nchf.ChargingDataResponse, error) {
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalChargingDataResponse(body)
+}
+
+// The generated TS29571 DateTime type has no JSON (un)marshaller, so the
+// invocation timestamps are shadowed with time.Time to get RFC 3339 strings
+// on the wire.
+type chargingDataRequestJSON struct {
+	*sbi_Nchf.ChargingDataRequest
+	InvocationTimeStamp time.Time `json:"invocationTimeStamp"`
+}
+
+type chargingDataResponseJSON struct {
+	*sbi_Nchf.ChargingDataResponse
+	InvocationTimeStamp *time.Time `json:"invocationTimeStamp,omitempty"`
+}
+
+// MarshalChargingDataRequest encodes a ChargingDataRequest to JSON
+func MarshalChargingDataRequest(req *sbi_Nchf.ChargingDataRequest) ([]byte, error) {
+	return json.Marshal(chargingDataRequestJSON{
+		ChargingDataRequest: req,
+		InvocationTimeStamp: time.Time(req.InvocationTimeStamp),
+	})
+}
+
+// UnmarshalChargingDataRequest decodes a ChargingDataRequest from JSON
+func UnmarshalChargingDataRequest(data []byte) (*sbi_Nchf.ChargingDataRequest, error) {
+	wrapped := chargingDataRequestJSON{ChargingDataRequest: &sbi_Nchf.ChargingDataRequest{}}
+	err := json.Unmarshal(data, &wrapped)
+	if err != nil {
+		return nil, err
+	}
+	wrapped.ChargingDataRequest.InvocationTimeStamp = sbi_CommonData.DateTime(wrapped.InvocationTimeStamp)
+	return wrapped.ChargingDataRequest, nil
+}
+
+// MarshalChargingDataResponse encodes a ChargingDataResponse to JSON
+func MarshalChargingDataResponse(resp *sbi_Nchf.ChargingDataResponse) ([]byte, error) {
+	wrapped := chargingDataResponseJSON{ChargingDataResponse: resp}
+	if resp.InvocationTimeStamp != nil {
+		ts := time.Time(*resp.InvocationTimeStamp)
+		wrapped.InvocationTimeStamp = &ts
+	}
+	return json.Marshal(wrapped)
+}
+
+// UnmarshalChargingDataResponse decodes a ChargingDataResponse from JSON
+func UnmarshalChargingDataResponse(data []byte) (*sbi_Nchf.ChargingDataResponse, error) {
+	wrapped := chargingDataResponseJSON{ChargingDataResponse: &sbi_Nchf.ChargingDataResponse{}}
+	err := json.Unmarshal(data, &wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped.InvocationTimeStamp != nil {
+		ts := sbi_CommonData.DateTime(*wrapped.InvocationTimeStamp)
+		wrapped.ChargingDataResponse.InvocationTimeStamp = &ts
+	}
+	return wrapped.ChargingDataResponse, nil
+}
+
+func removeIMSIPrefix(imsi string) string {
+	return strings.TrimPrefix(imsi, "IMSI")
+}
+
+func addIMSIPrefix(imsi string) string {
+	return "IMSI" + removeIMSIPrefix(imsi)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n40
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/golang/glog"
+	"github.com/labstack/echo/v4"
+
+	sbi_Nchf "magma/feg/gateway/sbi/specs/TS32291NchfConvergedCharging"
+	"magma/feg/gateway/services/session_proxy/relay"
+	"magma/lte/cloud/go/protos"
+)
+
+// notify_handler implements the following
+// - Creates a HTTP server to receive notifications from CHF
+// - Handles REAUTHORIZATION ChargingNotifyRequests from CHF, converts them to
+//   protos and sends them as ChargingReAuth to SessionProxyResponder(feg_relay)
+// - Handles ABORT_CHARGING ChargingNotifyRequests from CHF and sends them as
+//   AbortSession to feg_relay.
+
+const (
+	EncodedSessionId = "encodedSessionId"
+)
+
+// registerHandlers registers the ChargingNotify handler.
+// The notification url is of the form
+//
+//	{notifyRoot}/{encodedSessionId}/notify
+//
+// Example:
+//
+//	http://magma-feg.magma.com/nchf-convergedcharging/v3/notify/MTIzNDU2Nzg5MDsxMjM0NQo=/notify
+//
+// where
+//
+//	notifyRoot = http://magma-feg.magma.com/nchf-convergedcharging/v3/notify
+//	encodedSessionId = MTIzNDU2Nzg5MDsxMjM0NQo= (Session-Id is urlencoded)
+//
+// This notification url is sent to CHF as notifyUri in the ChargingDataCreate request
+func (c *N40Client) registerHandlers() error {
+	urlDef, err := url.ParseRequestURI(c.NotifyServer.NotifierCfg.NotifyApiRoot)
+	if err != nil {
+		return fmt.Errorf("error parsing notify api root - %s", err)
+	}
+	basePath := path.Join(urlDef.Path, fmt.Sprintf(":%s", EncodedSessionId))
+	c.NotifyServer.Server.POST(path.Join(basePath, "notify"), c.postChargingNotification)
+	return nil
+}
+
+// postChargingNotification handles charging notification requests from CHF
+func (c *N40Client) postChargingNotification(ctx echo.Context) error {
+	var notification sbi_Nchf.ChargingNotifyRequest
+	err := ctx.Bind(&notification)
+	if err != nil {
+		err = fmt.Errorf("invalid ChargingNotifyRequest received: %s", err)
+		glog.Errorf("postChargingNotification: %s", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	sessionId, imsi, err := getSessionIdAndIMSI(ctx.Param(EncodedSessionId))
+	if err != nil {
+		glog.Errorf("postChargingNotification unable to fetch session-id for ChargingNotify - %s", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	switch notification.NotificationType {
+	case NotificationTypeReauthorization:
+		return c.handleReauthorization(ctx, sessionId, imsi, &notification)
+	case NotificationTypeAbortCharging:
+		return c.handleAbortCharging(ctx, sessionId, imsi)
+	default:
+		err = fmt.Errorf("unsupported notificationType %v", notification.NotificationType)
+		glog.Errorf("postChargingNotification: %s", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+}
+
+func (c *N40Client) handleReauthorization(
+	ctx echo.Context,
+	sessionId string,
+	imsi string,
+	notification *sbi_Nchf.ChargingNotifyRequest,
+) error {
+	client, err := relay.GetSessionProxyResponderClient(c.CloudRegistry)
+	if err != nil {
+		glog.Errorf("postChargingNotification failed to get SessionProxyResponderClient: %s", err)
+		return fmt.Errorf("internal server error")
+	}
+	defer client.Close()
+
+	for _, rar := range GetChargingReAuthRequestsProto(sessionId, imsi, notification) {
+		ans, err := client.ChargingReAuth(context.Background(), rar)
+		if err != nil {
+			glog.Errorf("Error relaying N40 charging reauth request to gateway: %s", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, "error relaying to gateway")
+		}
+		switch ans.Result {
+		case protos.ReAuthResult_SESSION_NOT_FOUND:
+			return echo.NewHTTPError(http.StatusNotFound, "Session not found")
+		case protos.ReAuthResult_OTHER_FAILURE:
+			return echo.NewHTTPError(http.StatusInternalServerError, "Reauthorization failed")
+		}
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (c *N40Client) handleAbortCharging(ctx echo.Context, sessionId string, imsi string) error {
+	client, err := relay.GetAbortSessionResponderClient(c.CloudRegistry)
+	if err != nil {
+		glog.Errorf("postChargingNotification failed to get AbortSessionResponderClient: %s", err)
+		return fmt.Errorf("internal server error")
+	}
+	defer client.Close()
+
+	ans, err := client.AbortSession(context.Background(), &protos.AbortSessionRequest{
+		UserName:  imsi,
+		SessionId: sessionId,
+	})
+	if err != nil {
+		glog.Errorf("postChargingNotification error relaying ASR to gateway: %s", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "error relaying to gateway")
+	}
+
+	switch ans.Code {
+	case protos.AbortSessionResult_SESSION_NOT_FOUND:
+		return echo.NewHTTPError(http.StatusNotFound, "Session not found")
+	case protos.AbortSessionResult_USER_NOT_FOUND:
+		return echo.NewHTTPError(http.StatusNotFound, "User not found")
+	case protos.AbortSessionResult_GATEWAY_NOT_FOUND:
+		return echo.NewHTTPError(http.StatusInternalServerError, "Gateway not found")
+	default:
+		return ctx.NoContent(http.StatusNoContent)
+	}
+}
+
+func getSessionIdAndIMSI(encSessionId string) (sessionId string, imsi string, err error) {
+	if len(encSessionId) == 0 {
+		err = fmt.Errorf("encodedSessionId path parameter empty")
+		return
+	}
+	sessionIdBytes, err := base64.URLEncoding.DecodeString(encSessionId)
+	if err != nil {
+		err = fmt.Errorf("invalid encodedSessionId path parameter, unable to decode session-id: %s", err)
+		return
+	}
+	sessionId = string(sessionIdBytes)
+	imsi, err = protos.GetIMSIwithPrefixFromSessionId(sessionId)
+	if err != nil {
+		err = fmt.Errorf("invalid session-id unable to decode imsi: %s", err)
+		return
+	}
+	return
+}
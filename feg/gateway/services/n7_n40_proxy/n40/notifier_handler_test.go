@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n40
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/sbi"
+	relay_mocks "magma/feg/gateway/services/session_proxy/relay/mocks"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	LOCAL_ADDR = "127.0.0.1:0"
+	BASE_PATH  = "/nchf-convergedcharging/v3/notify"
+	HTTP_HOST  = "http://localhost"
+	API_ROOT   = HTTP_HOST + BASE_PATH
+	IMSI1      = "123456789012345"
+	SESS_ID    = "IMSI" + IMSI1 + "-987654321"
+)
+
+func TestReauthorizationNotify(t *testing.T) {
+	sm, cloudRegistry := relay_mocks.StartMockSessionProxyResponder(t)
+	n40Cli, err := NewN40ClientWithHandlers(getClientConfig(), cloudRegistry)
+	require.NoError(t, err)
+	defer n40Cli.NotifyServer.Stop()
+	notifyAddr, err := n40Cli.NotifyServer.Server.GetListenerAddr()
+	require.NoError(t, err)
+
+	// happy path, single rating group
+	sm.On("ChargingReAuth", mock.Anything, &protos.ChargingReAuthRequest{
+		SessionId:         SESS_ID,
+		Sid:               "IMSI" + IMSI1,
+		Type:              protos.ChargingReAuthRequest_SINGLE_SERVICE,
+		ChargingKey:       1,
+		ServiceIdentifier: &protos.ServiceIdentifier{Value: 12},
+	}).Return(&protos.ChargingReAuthAnswer{Result: protos.ReAuthResult_UPDATE_INITIATED}, nil).Once()
+	resp, err := postChargingNotify(notifyAddr.String(), `{
+		"notificationType": "REAUTHORIZATION",
+		"reauthorizationDetails": [{"ratingGroup": 1, "serviceId": 12}]
+	}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// whole session, unknown to the gateway
+	sm.On("ChargingReAuth", mock.Anything, &protos.ChargingReAuthRequest{
+		SessionId: SESS_ID,
+		Sid:       "IMSI" + IMSI1,
+		Type:      protos.ChargingReAuthRequest_ENTIRE_SESSION,
+	}).Return(&protos.ChargingReAuthAnswer{Result: protos.ReAuthResult_SESSION_NOT_FOUND}, nil).Once()
+	resp, err = postChargingNotify(notifyAddr.String(), `{"notificationType": "REAUTHORIZATION"}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	sm.AssertExpectations(t)
+
+	// unsupported notification
+	resp, err = postChargingNotify(notifyAddr.String(), `{"notificationType": "UNKNOWN"}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func getClientConfig() *N40Config {
+	return &N40Config{
+		DisableN40:   false,
+		ServerConfig: sbi.RemoteConfig{},
+		ClientConfig: sbi.NotifierConfig{
+			LocalAddr:     LOCAL_ADDR,
+			NotifyApiRoot: API_ROOT,
+		},
+	}
+}
+
+func postChargingNotify(notifAddr string, payload string) (*http.Response, error) {
+	apiRoot := fmt.Sprintf("http://%s%s", notifAddr, BASE_PATH)
+	postUrl := string(GenNotifyUrl(apiRoot, SESS_ID))
+	return http.Post(postUrl, "application/json", bytes.NewBuffer([]byte(payload)))
+}
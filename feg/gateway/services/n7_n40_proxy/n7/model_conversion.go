@@ -89,7 +89,7 @@ func GetSmPolicyContextDataN7(
 		Ipv6AddressPrefix: getSbiIpv6(common.GetUeIpv6()),
 		Dnn:               sbi.Dnn(common.GetApn()),
 		Gpsi:              getSbiGpsi(string(common.GetMsisdn())),
-		RatType:           getSbiRatType(ratType),
+		RatType:           GetSbiRatType(ratType),
 		AccessType:        getSbiAccessType(ratType),
 		UeTimeZone:        GetSbiTimeZone(request.GetAccessTimezone()),
 		NotificationUri:   GenNotifyUrl(notifyApiRoot, request.SessionId),
@@ -101,7 +101,7 @@ func GetSmPolicyContextDataN7(
 			m5gCtx := context.M5GsmSessionContext
 			reqBody.PduSessionId = sbi.PduSessionId(m5gCtx.GetPduSessionId())
 			reqBody.Gpsi = getSbiGpsi(m5gCtx.GetGpsi())
-			reqBody.PduSessionType = getSbiPduSesionType(m5gCtx.GetPduSessionType())
+			reqBody.PduSessionType = GetSbiPduSessionType(m5gCtx.GetPduSessionType())
 		}
 	}
 
@@ -180,7 +180,7 @@ func GetSmPolicyUpdateRequestsN7(
 			TgppCtx:       firstUpdate.TgppCtx,
 			ReqBody: &n7_sbi.PostSmPoliciesSmPolicyIdUpdateJSONRequestBody{
 				Ipv4Address:      getSbiIpv4(firstUpdate.UeIpv4),
-				RatType:          getSbiRatType(firstUpdate.RatType),
+				RatType:          GetSbiRatType(firstUpdate.RatType),
 				AccessType:       getSbiAccessType(firstUpdate.RatType),
 				AccuUsageReports: &accUsageReport,
 			},
@@ -189,7 +189,7 @@ func GetSmPolicyUpdateRequestsN7(
 	return reqCtxs
 }
 
-func getSbiRatType(ratType protos.RATType) *sbi.RatType {
+func GetSbiRatType(ratType protos.RATType) *sbi.RatType {
 	var sbiRatType sbi.RatType
 	switch ratType {
 	case protos.RATType_TGPP_LTE:
@@ -239,7 +239,7 @@ func GetSbiTimeZone(tzProto *protos.Timezone) *sbi.TimeZone {
 	return &tzStr
 }
 
-func getSbiPduSesionType(pduSessionType protos.PduSessionType) sbi.PduSessionType {
+func GetSbiPduSessionType(pduSessionType protos.PduSessionType) sbi.PduSessionType {
 	switch pduSessionType {
 	case protos.PduSessionType_IPV4:
 		return sbi.PduSessionTypeIPV4
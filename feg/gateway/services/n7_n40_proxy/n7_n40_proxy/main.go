@@ -21,6 +21,7 @@ import (
 	"magma/feg/cloud/go/protos"
 	"magma/feg/gateway/policydb"
 	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
 	"magma/feg/gateway/services/n7_n40_proxy/n7"
 	"magma/feg/gateway/services/n7_n40_proxy/servicers"
 	lteprotos "magma/lte/cloud/go/protos"
@@ -42,6 +43,10 @@ func main() {
 	if err != nil {
 		glog.Fatalf("Error fetching config: %s", err)
 	}
+	n40config, err := n40.GetN40Config()
+	if err != nil {
+		glog.Fatalf("Error fetching N40 config: %s", err)
+	}
 	cloudReg := registry.Get()
 	dbClient, err := policydb.NewRedisPolicyDBClient(cloudReg)
 	if err != nil {
@@ -51,7 +56,14 @@ func main() {
 	if err != nil {
 		glog.Fatalf("Creating N7 BaseClientWithNotifier failed: %s", err)
 	}
-	sessController, err := servicers.NewCentralSessionController(n7config, dbClient, policyClient)
+	var chargingClient *n40.N40Client
+	if !n40config.DisableN40 {
+		chargingClient, err = n40.NewN40ClientWithHandlers(n40config, cloudReg)
+		if err != nil {
+			glog.Fatalf("Creating N40 BaseClientWithNotifier failed: %s", err)
+		}
+	}
+	sessController, err := servicers.NewCentralSessionController(n7config, n40config, dbClient, policyClient, chargingClient)
 	if err != nil {
 		glog.Fatalf("Error creating session controller in N7_N40 Proxy: %s", err)
 	}
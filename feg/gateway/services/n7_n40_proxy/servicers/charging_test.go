@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/policydb"
+	mockPolicyDB "magma/feg/gateway/policydb/mocks"
+	"magma/feg/gateway/sbi"
+	sbi_NpcfSMPolicyControl "magma/feg/gateway/sbi/specs/TS29512NpcfSMPolicyControl"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
+	"magma/feg/gateway/services/n7_n40_proxy/n7"
+	mockN7 "magma/feg/gateway/services/n7_n40_proxy/n7/mocks"
+	"magma/feg/gateway/services/n7_n40_proxy/servicers"
+	relay_mocks "magma/feg/gateway/services/session_proxy/relay/mocks"
+	chf_servicers "magma/feg/gateway/services/testcore/chf/servicers"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	CHF_API_ROOT        = "http://mockchf/nchf-convergedcharging/v3"
+	N40_NOTIFY_API_ROOT = "https://magma-feg.magam.com/nchf-convergedcharging/v3/notify"
+	RATING_GROUP1       = 1
+	SERVICE_ID1         = 12
+)
+
+func TestChargingSessionLifecycle(t *testing.T) {
+	srv, mockDb, mockN7, chf := createCentralSessionControllerWithCHFForTest(t)
+	defer srv.Close()
+	defer chf.NotifierServer.Stop()
+
+	chf.CreateAccount(IMSI1_NOPREFIX)
+	require.NoError(t, chf.SetCredit(IMSI1_NOPREFIX, RATING_GROUP1, 150000))
+
+	// Create: the default 100000 bytes are granted
+	mockN7.On("PostSmPoliciesWithResponse", mock.Anything, mock.Anything).
+		Return(createSmPolicyResponse(t), nil).Once()
+	mockDb.On("GetOmnipresentRules").Return([]string{}, []string{}).Once()
+	mockDb.On("GetChargingKeysForRules", []string{"static_rule1"}, mock.Anything).Return([]policydb.ChargingKey{
+		{RatingGroup: RATING_GROUP1, ServiceIdTracking: true, ServiceIdentifier: SERVICE_ID1},
+		{RatingGroup: RATING_GROUP1, ServiceIdTracking: true, ServiceIdentifier: SERVICE_ID1},
+	}).Once()
+
+	createResp, err := srv.CreateSession(context.Background(), defaultCreateSessionRequest())
+	require.NoError(t, err)
+	mockDb.AssertExpectations(t)
+	require.NotNil(t, createResp.TgppCtx)
+	assert.Equal(t, SmPolicyUrl, createResp.TgppCtx.GxDestHost)
+	assert.Contains(t, createResp.TgppCtx.GyDestHost, CHF_API_ROOT+"/chargingdata/")
+	require.Equal(t, 1, len(createResp.Credits))
+	credit := createResp.Credits[0]
+	assert.True(t, credit.Success)
+	assert.Equal(t, IMSI1, credit.Sid)
+	assert.Equal(t, SESS_ID1, credit.SessionId)
+	assert.Equal(t, uint32(RATING_GROUP1), credit.ChargingKey)
+	assert.Equal(t, &protos.ServiceIdentifier{Value: SERVICE_ID1}, credit.ServiceIdentifier)
+	assert.Equal(t, uint64(100000), credit.Credit.GrantedUnits.Total.Volume)
+	assert.False(t, credit.Credit.IsFinal)
+
+	// Update: the last 50000 bytes are granted as final units
+	updateResp, err := srv.UpdateSession(context.Background(), &protos.UpdateSessionRequest{
+		Updates: []*protos.CreditUsageUpdate{
+			creditUsageUpdate(createResp.TgppCtx, protos.CreditUsage_QUOTA_EXHAUSTED, 60000, 40000),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updateResp.Responses))
+	credit = updateResp.Responses[0]
+	assert.True(t, credit.Success)
+	assert.Equal(t, uint64(50000), credit.Credit.GrantedUnits.Total.Volume)
+	assert.True(t, credit.Credit.IsFinal)
+	assert.Equal(t, protos.ChargingCredit_TERMINATE, credit.Credit.FinalAction)
+	assert.Equal(t, createResp.TgppCtx, credit.TgppCtx)
+	remaining, err := chf.GetCredit(IMSI1_NOPREFIX, RATING_GROUP1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(50000), remaining)
+
+	// Terminate: the final usage is reported and the charging data released
+	mockN7.On("PostSmPoliciesSmPolicyIdDeleteWithResponse", mock.Anything, "12345", mock.Anything).
+		Return(&sbi_NpcfSMPolicyControl.PostSmPoliciesSmPolicyIdDeleteResponse{
+			HTTPResponse: &http.Response{StatusCode: 204},
+		}, nil).Once()
+	_, err = srv.TerminateSession(context.Background(), &protos.SessionTerminateRequest{
+		CommonContext: &protos.CommonSessionContext{Sid: &protos.SubscriberID{Id: IMSI1}},
+		SessionId:     SESS_ID1,
+		RequestNumber: 2,
+		TgppCtx:       createResp.TgppCtx,
+		CreditUsages: []*protos.CreditUsage{{
+			ChargingKey: RATING_GROUP1,
+			BytesTx:     30000,
+			BytesRx:     20000,
+			Type:        protos.CreditUsage_TERMINATED,
+		}},
+	})
+	require.NoError(t, err)
+	mockN7.AssertExpectations(t)
+	remaining, err = chf.GetCredit(IMSI1_NOPREFIX, RATING_GROUP1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), remaining)
+
+	// Updates of a released charging data fail
+	updateResp, err = srv.UpdateSession(context.Background(), &protos.UpdateSessionRequest{
+		Updates: []*protos.CreditUsageUpdate{
+			creditUsageUpdate(createResp.TgppCtx, protos.CreditUsage_QUOTA_EXHAUSTED, 0, 0),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updateResp.Responses))
+	assert.False(t, updateResp.Responses[0].Success)
+	assert.Equal(t, uint32(RATING_GROUP1), updateResp.Responses[0].ChargingKey)
+}
+
+func TestCreateSessionCHFUnknownUser(t *testing.T) {
+	srv, mockDb, mockN7, chf := createCentralSessionControllerWithCHFForTest(t)
+	defer srv.Close()
+	defer chf.NotifierServer.Stop()
+
+	mockN7.On("PostSmPoliciesWithResponse", mock.Anything, mock.Anything).
+		Return(createSmPolicyResponse(t), nil).Once()
+	mockDb.On("GetOmnipresentRules").Return([]string{}, []string{}).Once()
+	mockDb.On("GetChargingKeysForRules", mock.Anything, mock.Anything).Return([]policydb.ChargingKey{
+		{RatingGroup: RATING_GROUP1},
+	}).Once()
+
+	// The SM policy created on PCF is deleted when charging fails
+	mockN7.On("PostSmPoliciesSmPolicyIdDeleteWithResponse", mock.Anything, "12345", mock.Anything).
+		Return(&sbi_NpcfSMPolicyControl.PostSmPoliciesSmPolicyIdDeleteResponse{
+			HTTPResponse: &http.Response{StatusCode: 204},
+		}, nil).Once()
+
+	response, err := srv.CreateSession(context.Background(), defaultCreateSessionRequest())
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	mockN7.AssertExpectations(t)
+}
+
+func TestUpdateSessionMissingChargingData(t *testing.T) {
+	srv, _, _, chf := createCentralSessionControllerWithCHFForTest(t)
+	defer srv.Close()
+	defer chf.NotifierServer.Stop()
+
+	updateResp, err := srv.UpdateSession(context.Background(), &protos.UpdateSessionRequest{
+		Updates: []*protos.CreditUsageUpdate{
+			creditUsageUpdate(&protos.TgppContext{GxDestHost: SmPolicyUrl}, protos.CreditUsage_QUOTA_EXHAUSTED, 10, 10),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updateResp.Responses))
+	assert.False(t, updateResp.Responses[0].Success)
+	assert.Equal(t, IMSI1, updateResp.Responses[0].Sid)
+}
+
+func createCentralSessionControllerWithCHFForTest(t *testing.T) (
+	*servicers.CentralSessionController,
+	*mockPolicyDB.PolicyDBClient,
+	*mockN7.ClientWithResponsesInterface,
+	*chf_servicers.MockCHFServer,
+) {
+	chf, err := chf_servicers.NewMockCHFServer(&sbi.NotifierConfig{
+		LocalAddr:     "127.0.0.1:0",
+		NotifyApiRoot: CHF_API_ROOT,
+	})
+	require.NoError(t, err)
+	chfAddr, err := chf.Server.GetListenerAddr()
+	require.NoError(t, err)
+
+	testN7Conf := getTestN7Config(t)
+	testN40Conf := &n40.N40Config{
+		DisableN40: false,
+		ClientConfig: sbi.NotifierConfig{
+			LocalAddr:     "127.0.0.1:0",
+			NotifyApiRoot: N40_NOTIFY_API_ROOT,
+		},
+	}
+	mockPolicyDBClient := &mockPolicyDB.PolicyDBClient{}
+	mockN7ClientWithResponsesInterface := &mockN7.ClientWithResponsesInterface{}
+	_, mockCloudRegistry := relay_mocks.StartMockSessionProxyResponder(t)
+
+	policyCli := n7.NewN7Client(testN7Conf, mockN7ClientWithResponsesInterface, mockCloudRegistry)
+	chargingDataCli := n40.NewChargingDataClient(
+		fmt.Sprintf("http://%s%s", chfAddr.String(), chf_servicers.BASE_PATH), &http.Client{})
+	chargingCli := n40.NewN40Client(testN40Conf, chargingDataCli, mockCloudRegistry)
+
+	srv, err := servicers.NewCentralSessionController(
+		testN7Conf, testN40Conf, mockPolicyDBClient, policyCli, chargingCli)
+	require.NoError(t, err)
+	return srv, mockPolicyDBClient, mockN7ClientWithResponsesInterface, chf
+}
+
+func creditUsageUpdate(tgppCtx *protos.TgppContext, updateType protos.CreditUsage_UpdateType, tx, rx uint64) *protos.CreditUsageUpdate {
+	return &protos.CreditUsageUpdate{
+		Usage: &protos.CreditUsage{
+			ChargingKey:       RATING_GROUP1,
+			BytesTx:           tx,
+			BytesRx:           rx,
+			Type:              updateType,
+			ServiceIdentifier: &protos.ServiceIdentifier{Value: SERVICE_ID1},
+		},
+		SessionId:     SESS_ID1,
+		RequestNumber: 1,
+		TgppCtx:       tgppCtx,
+		CommonContext: &protos.CommonSessionContext{Sid: &protos.SubscriberID{Id: IMSI1}},
+	}
+}
@@ -20,7 +20,10 @@ import (
 
 	"github.com/golang/glog"
 
+	"magma/feg/gateway/policydb"
 	n7_sbi "magma/feg/gateway/sbi/specs/TS29512NpcfSMPolicyControl"
+	"magma/feg/gateway/services/n7_n40_proxy/metrics"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
 	"magma/feg/gateway/services/n7_n40_proxy/n7"
 	"magma/lte/cloud/go/protos"
 )
@@ -79,6 +82,94 @@ func (srv *CentralSessionController) injectOmnipresentRules(policy *n7_sbi.SmPol
 	return nil
 }
 
+// getChargingCredits requests the initial credits for the charging keys of the
+// session rules from CHF when N40 is enabled and the session is online charged.
+// The url that uniquely identifies the charging data resource is stored in the
+// TgppContext of the response.
+func (srv *CentralSessionController) getChargingCredits(
+	request *protos.CreateSessionRequest,
+	response *protos.CreateSessionResponse,
+) ([]*protos.CreditUpdateResponse, error) {
+	credits := []*protos.CreditUpdateResponse{}
+	if srv.config.DisableN40 {
+		return credits, nil
+	}
+	// Without N7 there is no PCF to enable online charging, so charge every session
+	if !response.Online && !srv.config.DisableN7 {
+		glog.V(2).Info("Online charging not enabled by PCF. Not sending ChargingDataCreate")
+		return credits, nil
+	}
+	keys := srv.getChargingKeys(response.StaticRules, response.DynamicRules)
+	if len(keys) == 0 {
+		return credits, nil
+	}
+	reqBody := n40.GetChargingDataRequestN40(request, keys, srv.chargingClient.NotifyServer.NotifierCfg.NotifyApiRoot)
+	reqCtx, cancel := context.WithTimeout(context.Background(), srv.config.RequestTimeout)
+	defer cancel()
+	chargingData, chargingDataUrl, err := srv.chargingClient.PostChargingData(reqCtx, reqBody)
+	metrics.ReportCreateChargingData(err)
+	if err != nil {
+		return nil, err
+	}
+	if response.TgppCtx == nil {
+		response.TgppCtx = &protos.TgppContext{}
+	}
+	response.TgppCtx.GyDestHost = chargingDataUrl
+	return n40.GetCreditResponsesProto(
+		request.GetCommonContext().GetSid().GetId(),
+		request.SessionId,
+		response.TgppCtx,
+		chargingData,
+		n40.GetServiceIdsFromChargingKeys(keys),
+	), nil
+}
+
+// deleteRejectedSmPolicy deletes the SM policy created on PCF for a session that
+// failed to be created, so that the policy isn't left behind on PCF.
+func (srv *CentralSessionController) deleteRejectedSmPolicy(tgppCtx *protos.TgppContext) {
+	if srv.config.DisableN7 {
+		return
+	}
+	smPolicyId, err := n7.GetSmPolicyId(tgppCtx)
+	if err != nil {
+		glog.Errorf("CreateSessionRequest failed to get policyId of rejected session: %s", err)
+		return
+	}
+	err = srv.sendSmPolicyDelete(smPolicyId, &n7_sbi.PostSmPoliciesSmPolicyIdDeleteJSONRequestBody{})
+	metrics.ReportDeleteSmPolicy(err)
+	if err != nil {
+		glog.Errorf("CreateSessionRequest failed to delete SM policy %s of rejected session: %s", smPolicyId, err)
+	}
+}
+
+func (srv *CentralSessionController) getChargingKeys(
+	staticRuleInstalls []*protos.StaticRuleInstall,
+	dynamicRuleInstalls []*protos.DynamicRuleInstall,
+) []policydb.ChargingKey {
+	staticRuleIDs := make([]string, 0, len(staticRuleInstalls))
+	for _, staticRule := range staticRuleInstalls {
+		staticRuleIDs = append(staticRuleIDs, staticRule.RuleId)
+	}
+	dynamicRuleDefs := make([]*protos.PolicyRule, 0, len(dynamicRuleInstalls))
+	for _, dynamicRule := range dynamicRuleInstalls {
+		dynamicRuleDefs = append(dynamicRuleDefs, dynamicRule.PolicyRule)
+	}
+	keys := srv.dbClient.GetChargingKeysForRules(staticRuleIDs, dynamicRuleDefs)
+	return removeDuplicateChargingKeys(keys)
+}
+
+func removeDuplicateChargingKeys(keysIn []policydb.ChargingKey) []policydb.ChargingKey {
+	keysOut := []policydb.ChargingKey{}
+	keyMap := make(map[policydb.ChargingKey]struct{})
+	for _, k := range keysIn {
+		if _, ok := keyMap[k]; !ok {
+			keysOut = append(keysOut, k)
+			keyMap[k] = struct{}{}
+		}
+	}
+	return keysOut
+}
+
 func validateCreateSessionRequest(req *protos.CreateSessionRequest) error {
 	subscriber := req.GetCommonContext().GetSid()
 	if subscriber == nil || subscriber.GetId() == "" {
@@ -27,6 +27,7 @@ import (
 	"magma/feg/gateway/sbi"
 	sbi_NpcfSMPolicyControl "magma/feg/gateway/sbi/specs/TS29512NpcfSMPolicyControl"
 	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
 	"magma/feg/gateway/services/n7_n40_proxy/n7"
 	mockN7 "magma/feg/gateway/services/n7_n40_proxy/n7/mocks"
 	"magma/feg/gateway/services/n7_n40_proxy/servicers"
@@ -165,7 +166,7 @@ func createCentralSessionControllerForTest(t *testing.T, disableN7 bool) (*servi
 
 	mockN7Cli := n7.NewN7Client(testN7Conf, mockN7ClientWithResponsesInterface, mockCloudRegistry)
 
-	srv, err := servicers.NewCentralSessionController(testN7Conf, mockPolicyDBClient, mockN7Cli)
+	srv, err := servicers.NewCentralSessionController(testN7Conf, &n40.N40Config{DisableN40: true}, mockPolicyDBClient, mockN7Cli, nil)
 	require.NoError(t, err)
 	return srv, mockPolicyDBClient, mockN7ClientWithResponsesInterface
 }
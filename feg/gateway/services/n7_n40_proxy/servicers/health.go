@@ -63,6 +63,16 @@ func (srv *CentralSessionController) GetHealthStatus(ctx context.Context, void *
 		return n7Status, nil
 	}
 
+	n40ReqTotal := deltaMetrics.ChargingDataCreateTotal + deltaMetrics.ChargingDataUpdateTotal +
+		deltaMetrics.ChargingDataReleaseTotal
+	n40FailureTotal := deltaMetrics.ChargingDataCreateFailures + deltaMetrics.ChargingDataUpdateFailures +
+		deltaMetrics.ChargingDataReleaseFailures
+
+	n40Status := srv.getHealthStatusForN40Requests(n40FailureTotal, n40ReqTotal)
+	if n40Status.Health == fegprotos.HealthStatus_UNHEALTHY {
+		return n40Status, nil
+	}
+
 	return &fegprotos.HealthStatus{
 		Health:        fegprotos.HealthStatus_HEALTHY,
 		HealthMessage: "All metrics appear healthy",
@@ -90,3 +100,25 @@ func (srv *CentralSessionController) getHealthStatusForN7Requests(failures, tota
 		HealthMessage: "N7 metrics appear healthy",
 	}
 }
+
+func (srv *CentralSessionController) getHealthStatusForN40Requests(failures, total int64) *fegprotos.HealthStatus {
+	if !srv.config.DisableN40 {
+		n40ExceedsThreshold := total >= int64(srv.healthTracker.MinimumRequestThreshold) &&
+			float64(failures)/float64(total) >= float64(srv.healthTracker.RequestFailureThreshold)
+		if n40ExceedsThreshold {
+			unhealthyMsg := fmt.Sprintf("Metric N40 Request Failure Ratio >= threshold %f; %d / %d",
+				srv.healthTracker.RequestFailureThreshold,
+				failures,
+				total,
+			)
+			return &fegprotos.HealthStatus{
+				Health:        fegprotos.HealthStatus_UNHEALTHY,
+				HealthMessage: unhealthyMsg,
+			}
+		}
+	}
+	return &fegprotos.HealthStatus{
+		Health:        fegprotos.HealthStatus_HEALTHY,
+		HealthMessage: "N40 metrics appear healthy",
+	}
+}
@@ -16,12 +16,14 @@ package servicers
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang/glog"
 
 	"magma/feg/gateway/policydb"
 	"magma/feg/gateway/services/n7_n40_proxy/metrics"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
 	"magma/feg/gateway/services/n7_n40_proxy/n7"
 	"magma/lte/cloud/go/protos"
 )
@@ -31,36 +33,43 @@ const (
 )
 
 type CentralSessionController struct {
-	policyClient  *n7.N7Client
-	dbClient      policydb.PolicyDBClient
-	config        *SessionControllerConfig
-	healthTracker *metrics.SessionHealthTracker
+	policyClient   *n7.N7Client
+	chargingClient *n40.N40Client
+	dbClient       policydb.PolicyDBClient
+	config         *SessionControllerConfig
+	healthTracker  *metrics.SessionHealthTracker
 }
 
 type SessionControllerConfig struct {
 	DisableN7      bool
+	DisableN40     bool
 	RequestTimeout time.Duration
 }
 
 func NewCentralSessionController(
 	n7config *n7.N7Config,
+	n40config *n40.N40Config,
 	dbClient policydb.PolicyDBClient,
 	policyClient *n7.N7Client,
+	chargingClient *n40.N40Client,
 ) (*CentralSessionController, error) {
 
 	cfg := &SessionControllerConfig{
 		DisableN7:      n7config.DisableN7,
+		DisableN40:     n40config.DisableN40,
 		RequestTimeout: DefaultN7Timeout,
 	}
 	return &CentralSessionController{
-		policyClient:  policyClient,
-		dbClient:      dbClient,
-		config:        cfg,
-		healthTracker: metrics.NewSessionHealthTracker(),
+		policyClient:   policyClient,
+		chargingClient: chargingClient,
+		dbClient:       dbClient,
+		config:         cfg,
+		healthTracker:  metrics.NewSessionHealthTracker(),
 	}, nil
 }
 
-// CreateSession begins a UE session by requesting rules from PCF and returning them.
+// CreateSession begins a UE session by requesting rules from PCF, and credits
+// for the rules from CHF, and returning them.
 func (srv *CentralSessionController) CreateSession(
 	ctx context.Context,
 	request *protos.CreateSessionRequest,
@@ -82,7 +91,16 @@ func (srv *CentralSessionController) CreateSession(
 	if err != nil {
 		glog.Errorf("CreateSessionRequest Failed to inject omnipresent rules %s", err)
 	}
-	return n7.GetCreateSessionResponseProto(request, policy, policyId), nil
+	response := n7.GetCreateSessionResponseProto(request, policy, policyId)
+	credits, err := srv.getChargingCredits(request, response)
+	if err != nil {
+		err = fmt.Errorf("CreateSessionRequest failed to get charging credits: %s", err)
+		glog.Error(err)
+		srv.deleteRejectedSmPolicy(response.TgppCtx)
+		return nil, err
+	}
+	response.Credits = credits
+	return response, nil
 }
 
 // UpdateSession handles periodic updates from gateways that include quota
@@ -91,10 +109,28 @@ func (srv *CentralSessionController) UpdateSession(
 	ctx context.Context,
 	request *protos.UpdateSessionRequest,
 ) (*protos.UpdateSessionResponse, error) {
-	reqCtxts := n7.GetSmPolicyUpdateRequestsN7(request.UsageMonitors)
-	responses := srv.sendMutlipleSmPolicyUpdateRequests(reqCtxts)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	var creditResponses []*protos.CreditUpdateResponse
+	var monitorResponses []*protos.UsageMonitoringUpdateResponse
+	go func() {
+		defer wg.Done()
+		reqCtxts := n7.GetSmPolicyUpdateRequestsN7(request.UsageMonitors)
+		monitorResponses = srv.sendMutlipleSmPolicyUpdateRequests(reqCtxts)
+	}()
+	go func() {
+		defer wg.Done()
+		if srv.config.DisableN40 {
+			creditResponses = []*protos.CreditUpdateResponse{}
+			return
+		}
+		reqCtxts := n40.GetChargingDataUpdateRequestsN40(request.Updates)
+		creditResponses = srv.sendMultipleChargingDataUpdateRequests(reqCtxts)
+	}()
+	wg.Wait()
 	return &protos.UpdateSessionResponse{
-		UsageMonitorResponses: responses,
+		Responses:             creditResponses,
+		UsageMonitorResponses: monitorResponses,
 	}, nil
 }
 
@@ -108,6 +144,11 @@ func (srv *CentralSessionController) TerminateSession(
 		glog.Error(err)
 		return nil, err
 	}
+	err := srv.releaseChargingData(request)
+	if err != nil {
+		// The session is gone on the gateway regardless, so only log the failure
+		glog.Errorf("SessionTerminateRequest failed to release charging data: %s", err)
+	}
 	smPolicyId, err := n7.GetSmPolicyId(request.GetTgppCtx())
 	if err != nil {
 		err = fmt.Errorf("TerminateSession failed to get policyId: %s", err)
@@ -131,4 +172,7 @@ func (srv *CentralSessionController) TerminateSession(
 // Close gracefully shuts down the CentralSessionController
 func (srv *CentralSessionController) Close() {
 	srv.policyClient.NotifyServer.Server.Close()
+	if srv.chargingClient != nil {
+		srv.chargingClient.NotifyServer.Server.Close()
+	}
 }
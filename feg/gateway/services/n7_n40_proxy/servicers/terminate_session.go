@@ -19,6 +19,8 @@ import (
 	"net/http"
 
 	n7_sbi "magma/feg/gateway/sbi/specs/TS29512NpcfSMPolicyControl"
+	"magma/feg/gateway/services/n7_n40_proxy/metrics"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
 	"magma/lte/cloud/go/protos"
 )
 
@@ -48,3 +50,20 @@ func (srv *CentralSessionController) sendSmPolicyDelete(
 	}
 	return nil
 }
+
+// releaseChargingData reports the final usage of the session to CHF and
+// releases its charging data. Sessions without charging data are skipped.
+func (srv *CentralSessionController) releaseChargingData(request *protos.SessionTerminateRequest) error {
+	if srv.config.DisableN40 || len(request.GetTgppCtx().GetGyDestHost()) == 0 {
+		return nil
+	}
+	chargingDataRef, err := n40.GetChargingDataRef(request.GetTgppCtx())
+	if err != nil {
+		return err
+	}
+	reqCtx, cancel := context.WithTimeout(context.Background(), srv.config.RequestTimeout)
+	defer cancel()
+	err = srv.chargingClient.PostChargingDataRelease(reqCtx, chargingDataRef, n40.GetChargingDataReleaseReqBody(request))
+	metrics.ReportReleaseChargingData(err)
+	return err
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/golang/glog"
 
 	"magma/feg/gateway/services/n7_n40_proxy/metrics"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
 	"magma/feg/gateway/services/n7_n40_proxy/n7"
 	"magma/lte/cloud/go/protos"
 )
@@ -79,3 +80,57 @@ func (srv *CentralSessionController) sendSingleSmPolicyUpdate(
 	}
 	return n7.GetUsageMonitoringResponsesProto(updateCtx, resp.JSON200)
 }
+
+// sendMultipleChargingDataUpdateRequests sends the charging data update of each
+// session in parallel to CHF and returns the accumulated credit responses
+func (srv *CentralSessionController) sendMultipleChargingDataUpdateRequests(
+	reqCtxs []*n40.ChargingDataUpdateReqCtx,
+) []*protos.CreditUpdateResponse {
+	var wg sync.WaitGroup
+	respChan := make(chan []*protos.CreditUpdateResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), srv.config.RequestTimeout)
+	defer cancel()
+
+	accResponses := []*protos.CreditUpdateResponse{}
+	for _, reqCtx := range reqCtxs {
+		tmpReqCtx := reqCtx // don't use loop variable in func closure
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			respChan <- srv.sendSingleChargingDataUpdate(ctx, tmpReqCtx)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(respChan)
+	}()
+
+	for responses := range respChan {
+		accResponses = append(accResponses, responses...)
+	}
+	return accResponses
+}
+
+func (srv *CentralSessionController) sendSingleChargingDataUpdate(
+	ctx context.Context,
+	updateCtx *n40.ChargingDataUpdateReqCtx,
+) []*protos.CreditUpdateResponse {
+	if len(updateCtx.ChargingDataRef) == 0 {
+		glog.Errorf("ChargingDataUpdate request not sent: no charging data for session %s", updateCtx.SessionId)
+		return n40.GetFailedCreditResponsesProto(updateCtx)
+	}
+	chargingData, err := srv.chargingClient.PostChargingDataUpdate(ctx, updateCtx.ChargingDataRef, updateCtx.ReqBody)
+	metrics.ReportUpdateChargingData(err)
+	if err != nil {
+		glog.Errorf("ChargingDataUpdate request failed: %s chargingDataRef=%s", err, updateCtx.ChargingDataRef)
+		return n40.GetFailedCreditResponsesProto(updateCtx)
+	}
+	return n40.GetCreditResponsesProto(
+		updateCtx.IMSI,
+		updateCtx.SessionId,
+		updateCtx.TgppCtx,
+		chargingData,
+		n40.GetServiceIdsFromUpdates(updateCtx.Updates),
+	)
+}
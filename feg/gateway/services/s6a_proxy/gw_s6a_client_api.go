@@ -57,3 +57,25 @@ func GWS6AProxyReset(in *protos.ResetRequest) (*protos.ResetAnswer, error) {
 	client := protos.NewS6AGatewayServiceClient(conn)
 	return client.Reset(context.Background(), in)
 }
+
+// GWS6AProxyInsertSubscriberData forwards IDR to Controller
+func GWS6AProxyInsertSubscriberData(in *protos.InsertSubscriberDataRequest) (*protos.InsertSubscriberDataAnswer, error) {
+	conn, err := getCloudConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewS6AGatewayServiceClient(conn)
+	return client.InsertSubscriberData(context.Background(), in)
+}
+
+// GWS6AProxyDeleteSubscriberData forwards DSR to Controller
+func GWS6AProxyDeleteSubscriberData(in *protos.DeleteSubscriberDataRequest) (*protos.DeleteSubscriberDataAnswer, error) {
+	conn, err := getCloudConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewS6AGatewayServiceClient(conn)
+	return client.DeleteSubscriberData(context.Background(), in)
+}
@@ -36,10 +36,6 @@ import (
 
 // sendAIR - sends AIR with given Session ID (sid)
 func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
-	if err != nil {
-		return err
-	}
 	var irp uint32
 	if req.ImmediateResponsePreferred {
 		irp = 1
@@ -66,7 +62,7 @@ func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequ
 			genAuthInfoAvp(req.NumRequestedUtranGeranVectors, irp, req.UtranGeranResyncInfo))
 	}
 	glog.V(2).Infof("Sending S6a AIR message\n%s\n", m)
-	err = c.SendRequest(m, retryCount)
+	err := s.peers.SendRequest(m, retryCount)
 	if err != nil {
 		err = Error(codes.DataLoss, err)
 	}
@@ -100,6 +100,9 @@ func GetS6aProxyConfigs() *S6aProxyConfig {
 			DestRealm:         diameter.GetValueOrEnv(diameter.DestRealmFlag, HSSRealmEnv, configsPtr.Server.DestRealm),
 			DisableDestHost:   diameter.GetBoolValueOrEnv(diameter.DisableDestHostFlag, DisableDestHostEnv, configsPtr.GetServer().GetDisableDestHost()),
 			OverwriteDestHost: diameter.GetBoolValueOrEnv(diameter.OverwriteDestHostFlag, OverwriteDestHostEnv, configsPtr.GetServer().GetOverwriteDestHost()),
+			Priority:          configsPtr.GetServer().GetPriority(),
+			Weight:            configsPtr.GetServer().GetWeight(),
+			Peers:             diameter.GetPeerConfigs(configsPtr.GetServer()),
 		},
 		PlmnIds: plmn_filter.GetPlmnVals(configsPtr.PlmnIds),
 	}
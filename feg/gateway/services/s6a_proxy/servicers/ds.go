@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/s6a_proxy"
+)
+
+// DSR-Flags bits, 29.272 Table 7.3.26/1
+const (
+	DSRFlagRegionalSubscriptionWithdrawal              = 1 << 0
+	DSRFlagCompleteAPNConfigurationProfileWithdrawal   = 1 << 1
+	DSRFlagSubscribedChargingCharacteristicsWithdrawal = 1 << 2
+	DSRFlagPDNSubscriptionContextsWithdrawal           = 1 << 3
+)
+
+// S6a DSR
+func handleDSR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received S6a DSR message:\n%s\n", m)
+		var dsr DSR
+		err := m.Unmarshal(&dsr)
+		if err != nil {
+			glog.Errorf("DSR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		var code protos.ErrorCode
+		for retries := MaxSyncRPCRetries; retries >= 0; retries-- {
+			code, err = forwardDSRToGateway(&dsr)
+			if err != nil {
+				glog.Errorf("Failed to forward DSR to gateway. err: %v. Retries left: %v\n", err, retries)
+			} else {
+				break
+			}
+		}
+		err = s.sendSubscriberDataAnswer(c, m, dsr.SessionID, dsr.AuthSessionState, code, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send DSA: %s", err.Error())
+		} else {
+			glog.V(2).Infof("Successfully sent DSA\n")
+		}
+	}
+}
+
+func forwardDSRToGateway(dsr *DSR) (protos.ErrorCode, error) {
+	res, err := s6a_proxy.GWS6AProxyDeleteSubscriberData(dsr.getProtoRequest())
+	if err != nil {
+		return protos.ErrorCode_UNABLE_TO_DELIVER, err
+	}
+	return res.ErrorCode, nil
+}
+
+func (dsr *DSR) getProtoRequest() *protos.DeleteSubscriberDataRequest {
+	return &protos.DeleteSubscriberDataRequest{
+		UserName:                       dsr.UserName,
+		RegionalSubscriptionWithdrawal: dsr.DSRFlags&DSRFlagRegionalSubscriptionWithdrawal != 0,
+		CompleteApnConfigurationProfileWithdrawal:   dsr.DSRFlags&DSRFlagCompleteAPNConfigurationProfileWithdrawal != 0,
+		SubscribedChargingCharacteristicsWithdrawal: dsr.DSRFlags&DSRFlagSubscribedChargingCharacteristicsWithdrawal != 0,
+		PdnSubscriptionContextsWithdrawal:           dsr.DSRFlags&DSRFlagPDNSubscriptionContextsWithdrawal != 0,
+		ContextId:                                   dsr.ContextIdentifier,
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/s6a_proxy"
+)
+
+// S6a IDR
+func handleIDR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received S6a IDR message:\n%s\n", m)
+		var idr IDR
+		err := m.Unmarshal(&idr)
+		if err != nil {
+			glog.Errorf("IDR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		var code protos.ErrorCode
+		for retries := MaxSyncRPCRetries; retries >= 0; retries-- {
+			code, err = forwardIDRToGateway(&idr)
+			if err != nil {
+				glog.Errorf("Failed to forward IDR to gateway. err: %v. Retries left: %v\n", err, retries)
+			} else {
+				break
+			}
+		}
+		err = s.sendSubscriberDataAnswer(c, m, idr.SessionID, idr.AuthSessionState, code, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send IDA: %s", err.Error())
+		} else {
+			glog.V(2).Infof("Successfully sent IDA\n")
+		}
+	}
+}
+
+func forwardIDRToGateway(idr *IDR) (protos.ErrorCode, error) {
+	res, err := s6a_proxy.GWS6AProxyInsertSubscriberData(idr.getProtoRequest())
+	if err != nil {
+		return protos.ErrorCode_UNABLE_TO_DELIVER, err
+	}
+	return res.ErrorCode, nil
+}
+
+func (idr *IDR) getProtoRequest() *protos.InsertSubscriberDataRequest {
+	data := &idr.SubscriptionData
+	req := &protos.InsertSubscriberDataRequest{
+		UserName:                       idr.UserName,
+		Msisdn:                         data.MSISDN.Serialize(),
+		DefaultContextId:               data.APNConfigurationProfile.ContextIdentifier,
+		AllApnsIncluded:                data.APNConfigurationProfile.AllAPNConfigurationsIncludedIndicator == 0,
+		DefaultChargingCharacteristics: data.TgppChargingCharacteristics,
+		NetworkAccessMode:              protos.UpdateLocationAnswer_NetworkAccessMode(data.NetworkAccessMode),
+	}
+	// AMBR is optional in IDR, only modifications are sent
+	if data.AMBR != (AMBR{}) {
+		req.TotalAmbr = data.AMBR.getProtoAmbr()
+	}
+	for _, apnCfg := range data.APNConfigurationProfile.APNConfigs {
+		req.Apn = append(req.Apn, apnCfg.getProtoApn())
+	}
+	for _, code := range data.RegionalSubscriptionZoneCode {
+		req.RegionalSubscriptionZoneCode = append(req.RegionalSubscriptionZoneCode, code.Serialize())
+	}
+	return req
+}
+
+// sendSubscriberDataAnswer sends IDA or DSA with the result returned by the gateway.
+// 3GPP specific errors are sent as Experimental-Result, all others as Result-Code
+func (s *s6aProxy) sendSubscriberDataAnswer(
+	c diam.Conn, m *diam.Message, sessionID string, authSessionState int32, code protos.ErrorCode, retries uint,
+) error {
+	var ans *diam.Message
+	switch code {
+	case protos.ErrorCode_UNDEFINED, protos.ErrorCode_SUCCESS:
+		ans = m.Answer(diam.Success)
+	case protos.ErrorCode_USER_UNKNOWN, protos.ErrorCode_UNKNOWN_EPS_SUBSCRIPTION:
+		ans = m.Answer(0)
+		ans.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+				diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(code)),
+			},
+		})
+	default:
+		ans = m.Answer(uint32(mapProtoToDiamResult(code)))
+	}
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID)))
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(authSessionState))
+	s.addDiamOriginAVPs(ans)
+	glog.V(2).Infof("Sending S6a answer message\n%s\n", ans)
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
@@ -31,17 +31,13 @@ import (
 
 // sendPUR - sends PUR with given Session ID (sid)
 func (s *s6aProxy) sendPUR(sid string, req *protos.PurgeUERequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
-	if err != nil {
-		return err
-	}
 	m := diameter.NewProxiableRequest(diam.PurgeUE, diam.TGPP_S6A_APP_ID, dict.Default)
 	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
 	m.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
 	s.addDiamOriginAVPs(m)
 	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(req.UserName))
 
-	err = c.SendRequest(m, retryCount)
+	err := s.peers.SendRequest(m, retryCount)
 	if err != nil {
 		err = Error(codes.DataLoss, err)
 	}
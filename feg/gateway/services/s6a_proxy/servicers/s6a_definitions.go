@@ -384,3 +384,181 @@ type ULR struct {
 	ULRFlags          datatype.Unsigned32       `avp:"ULR-Flags"`
 	SupportedFeatures []SupportedFeatures       `avp:"Supported-Features"`
 }
+
+// IDR is Go representation of Insert-Subscriber-Data-Request message
+//
+//	< Insert-Subscriber-Data-Request> ::= < Diameter Header: 319, REQ, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Host }
+//	{ Destination-Realm }
+//	{ User-Name }
+//	*[ Supported-Features ]
+//	{ Subscription-Data }
+//	[ IDR-Flags ]
+//	*[ AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type IDR struct {
+	SessionID         string                    `avp:"Session-Id"`
+	AuthSessionState  int32                     `avp:"Auth-Session-State"`
+	OriginHost        datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm       datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationHost   datatype.DiameterIdentity `avp:"Destination-Host"`
+	DestinationRealm  datatype.DiameterIdentity `avp:"Destination-Realm"`
+	UserName          string                    `avp:"User-Name"`
+	SupportedFeatures []SupportedFeatures       `avp:"Supported-Features"`
+	SubscriptionData  SubscriptionData          `avp:"Subscription-Data"`
+	IDRFlags          uint32                    `avp:"IDR-Flags"`
+}
+
+// IDA is Go representation of Insert-Subscriber-Data-Answer message
+//
+//	< Insert-Subscriber-Data-Answer> ::= < Diameter Header: 319, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	*[ Supported-Features ]
+//	[ Result-Code ]
+//	[ Experimental-Result ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	[ IDA-Flags ]
+//	*[ AVP ]
+//	[ Failed-AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type IDA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	IDAFlags           uint32                    `avp:"IDA-Flags"`
+}
+
+// DSR is Go representation of Delete-Subscriber-Data-Request message
+//
+//	< Delete-Subscriber-Data-Request > ::= < Diameter Header: 320, REQ, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Host }
+//	{ Destination-Realm }
+//	{ User-Name }
+//	*[ Supported-Features ]
+//	{ DSR-Flags }
+//	*[ Context-Identifier ]
+//	*[ AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type DSR struct {
+	SessionID         string                    `avp:"Session-Id"`
+	AuthSessionState  int32                     `avp:"Auth-Session-State"`
+	OriginHost        datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm       datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationHost   datatype.DiameterIdentity `avp:"Destination-Host"`
+	DestinationRealm  datatype.DiameterIdentity `avp:"Destination-Realm"`
+	UserName          string                    `avp:"User-Name"`
+	SupportedFeatures []SupportedFeatures       `avp:"Supported-Features"`
+	DSRFlags          uint32                    `avp:"DSR-Flags"`
+	ContextIdentifier []uint32                  `avp:"Context-Identifier"`
+}
+
+// DSA is Go representation of Delete-Subscriber-Data-Answer message
+//
+//	< Delete-Subscriber-Data-Answer> ::= < Diameter Header: 320, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	*[ Supported-Features ]
+//	[ Result-Code ]
+//	[ Experimental-Result ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	[ DSA-Flags ]
+//	*[ AVP ]
+//	[ Failed-AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type DSA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DSAFlags           uint32                    `avp:"DSA-Flags"`
+}
+
+// NOR is Go representation of Notify-Request message
+//
+//	< Notify-Request> ::= < Diameter Header: 323, REQ, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	[ Destination-Host ]
+//	{ Destination-Realm }
+//	{ User-Name }
+//	*[ Supported-Features ]
+//	[ Terminal-Information ]
+//	[ MIP6-Agent-Info ]
+//	[ Visited-Network-Identifier ]
+//	[ Context-Identifier ]
+//	[ Service-Selection ]
+//	[ Alert-Reason ]
+//	[ UE-SRVCC-Capability ]
+//	[ NOR-Flags ]
+//	[ Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions ]
+//	*[ AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type NOR struct {
+	SessionID         string                    `avp:"Session-Id"`
+	AuthSessionState  int32                     `avp:"Auth-Session-State"`
+	OriginHost        datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm       datatype.DiameterIdentity `avp:"Origin-Realm"`
+	UserName          string                    `avp:"User-Name"`
+	MIP6AgentInfo     MIP6AgentInfo             `avp:"MIP6-Agent-Info"`
+	ContextIdentifier uint32                    `avp:"Context-Identifier"`
+	ServiceSelection  string                    `avp:"Service-Selection"`
+	NORFlags          uint32                    `avp:"NOR-Flags"`
+}
+
+// MIP6AgentInfo -> MIP6-Agent-Info AVP
+type MIP6AgentInfo struct {
+	MIPHomeAgentAddress []datatype.Address `avp:"MIP-Home-Agent-Address"`
+}
+
+// NOA is Go representation of Notify-Answer message
+//
+//	< Notify-Answer> ::= < Diameter Header: 323, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	[ Result-Code ]
+//	[ Experimental-Result ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	*[ Supported-Features ]
+//	*[ AVP ]
+//	[ Failed-AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type NOA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	SupportedFeatures  []SupportedFeatures       `avp:"Supported-Features"`
+}
@@ -63,6 +63,7 @@ type s6aProxy struct {
 	config         *S6aProxyConfig
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
+	peers          *diameter.PeerTable
 	requestTracker *diameter.RequestTracker
 	healthTracker  *metrics.S6aHealthTracker
 }
@@ -127,35 +128,50 @@ func NewS6aProxy(
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, serverCfg)
+	peers := diameter.NewPeerTable(
+		smClient, connMan, time.Second*time.Duration(clientCfg.RequestTimeout), serverCfg)
+	// create connections to all peers in connection map
+	peers.Connect()
 
 	proxy := &s6aProxy{
 		config:         config,
 		smClient:       smClient,
 		connMan:        connMan,
+		peers:          peers,
 		requestTracker: diameter.NewRequestTracker(),
 		healthTracker:  metrics.NewS6aHealthTracker(),
 	}
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.AuthenticationInformation, Request: false},
-		handleAIA(proxy))
+		peers.HandleAnswers(handleAIA(proxy)))
 
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.UpdateLocation, Request: false},
-		handleULA(proxy))
+		peers.HandleAnswers(handleULA(proxy)))
 
 	mux.HandleIdx(diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.CancelLocation, Request: true},
 		handleCLR(proxy))
 
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.PurgeUE, Request: false},
-		handlePUA(proxy))
+		peers.HandleAnswers(handlePUA(proxy)))
 
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Reset, Request: true},
 		handleRSR(proxy))
 
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: InsertSubscriberData, Request: true},
+		handleIDR(proxy))
+
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: DeleteSubscriberData, Request: true},
+		handleDSR(proxy))
+
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Notify, Request: false},
+		peers.HandleAnswers(handleNOA(proxy)))
+
 	return proxy, nil
 }
 
@@ -204,6 +220,17 @@ func (s *s6aProxy) PurgeUE(ctx context.Context, req *protos.PurgeUERequest) (*pr
 	return res, err
 }
 
+// Notify sends NOR (Code 323) over diameter connection,
+// waits (blocks) for NOA & returns its RPC representation
+func (s *s6aProxy) Notify(ctx context.Context, req *protos.NotifyRequest) (*protos.NotifyAnswer, error) {
+	res, err := s.NotifyImpl(req)
+	if err != nil {
+		glog.V(2).Infof("Error on NotifyImpl: %s", err)
+	}
+	metrics.UpdateS6aRecentRequestMetrics(err)
+	return res, err
+}
+
 // Disable closes all existing diameter connections and disables
 // connection creation for the time specified in the request
 func (s *s6aProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*orcprotos.Void, error) {
@@ -219,7 +246,7 @@ func (s *s6aProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*or
 // exists, Enable has no effect
 func (s *s6aProxy) Enable(ctx context.Context, req *orcprotos.Void) (*orcprotos.Void, error) {
 	s.connMan.Enable()
-	_, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
+	err := s.peers.Connect()
 	return &orcprotos.Void{}, err
 }
 
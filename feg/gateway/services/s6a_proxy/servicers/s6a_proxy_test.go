@@ -148,6 +148,24 @@ func TestS6aProxyService(t *testing.T) {
 		if puResp.ErrorCode != protos.ErrorCode_SUCCESS {
 			t.Errorf("Unexpected PUA Error Code: %d", puResp.ErrorCode)
 		}
+		noReq := &protos.NotifyRequest{
+			UserName:           test.TEST_IMSI,
+			PgwAddress:         test.TEST_PGW_ADDRESS,
+			ContextId:          1,
+			ServiceSelection:   "magma.ipv4",
+			UeReachableFromMme: true,
+		}
+		// NOR
+		noResp, err := c.Notify(context.Background(), noReq)
+		if err != nil {
+			t.Errorf("GRPC NOR Error: %v", err)
+			complChan <- err
+			return
+		}
+		t.Logf("GRPC NOA: %+v", noResp)
+		if noResp.ErrorCode != protos.ErrorCode_SUCCESS {
+			t.Errorf("Unexpected NOA Error Code: %d", noResp.ErrorCode)
+		}
 		// End
 		complChan <- nil
 	}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"strconv"
 	"time"
 
@@ -32,10 +33,11 @@ import (
 )
 
 const (
-	TEST_PLMN_ID = "\x00\xF1\x10"
-	TEST_IMSI    = "001010000000001"
-	TEST_IMSI_2  = "001030000000001"
-	VENDOR_3GPP  = diameter.Vendor3GPP
+	TEST_PLMN_ID     = "\x00\xF1\x10"
+	TEST_IMSI        = "001010000000001"
+	TEST_IMSI_2      = "001030000000001"
+	TEST_PGW_ADDRESS = "192.168.128.1"
+	VENDOR_3GPP      = diameter.Vendor3GPP
 )
 
 // StartTestS6aServer starts a new Test S6a Server on given network & address
@@ -70,6 +72,10 @@ func StartTestS6aServer(network, addr string, useStaticResp bool) error {
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.PurgeUE, Request: true},
 		testHandlePUR(settings))
 
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Notify, Request: true},
+		testHandleNOR(settings))
+
 	// Catch All
 	mux.HandleIdx(diam.ALL_CMD_INDEX, testHandleALL(results))
 
@@ -445,6 +451,39 @@ func testSendPUA(w io.Writer, m *diam.Message) (n int64, err error) {
 	return m.WriteTo(w)
 }
 
+// testHandleNOR answers NORs reporting UE reachability of a PDN on TEST_PGW_ADDRESS
+func testHandleNOR(settings *sm.Settings) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var req servicers.NOR
+		var code uint32
+
+		err := m.Unmarshal(&req)
+		if err != nil {
+			fmt.Printf("NOR Unmarshal for message: %s failed: %s", m, err)
+			code = diam.UnableToComply
+		} else if req.NORFlags != servicers.NORFlagUEReachableFromMME ||
+			len(req.MIP6AgentInfo.MIPHomeAgentAddress) != 1 ||
+			net.IP(req.MIP6AgentInfo.MIPHomeAgentAddress[0]).String() != TEST_PGW_ADDRESS {
+			fmt.Printf("Unexpected NOR: %+v", req)
+			code = diam.InvalidAVPValue
+		} else {
+			code = diam.Success
+		}
+
+		a := m.Answer(code)
+		// SessionID is required to be the AVP in position 1
+		a.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(req.SessionID)))
+		a.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
+
+		_, err = a.WriteTo(c)
+		if err != nil {
+			fmt.Printf("Failed to send NOA: %s", err.Error())
+		}
+	}
+}
+
 func testPrintErrors(ec <-chan *diam.ErrorReport) {
 	for err := range ec {
 		fmt.Printf("Error: %v for Message: %s", err.Error, err.Message)
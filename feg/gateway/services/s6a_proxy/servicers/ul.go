@@ -36,10 +36,6 @@ import (
 
 // sendULR - sends ULR with given Session ID (sid)
 func (s *s6aProxy) sendULR(sid string, req *protos.UpdateLocationRequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
-	if err != nil {
-		return err
-	}
 	m := diameter.NewProxiableRequest(diam.UpdateLocation, diam.TGPP_S6A_APP_ID, dict.Default)
 	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
 	s.addDiamOriginAVPs(m)
@@ -74,7 +70,7 @@ func (s *s6aProxy) sendULR(sid string, req *protos.UpdateLocationRequest, retryC
 	}
 
 	glog.V(2).Infof("Sending S6a ULR message\n%s\n", m)
-	err = c.SendRequest(m, retryCount)
+	err := s.peers.SendRequest(m, retryCount)
 	if err != nil {
 		err = Error(codes.DataLoss, err)
 	}
@@ -188,34 +184,8 @@ func (s *s6aProxy) UpdateLocationImpl(req *protos.UpdateLocationRequest) (*proto
 					for i, code := range ula.SubscriptionData.RegionalSubscriptionZoneCode {
 						res.RegionalSubscriptionZoneCode[i] = code.Serialize()
 					}
-					for i, apnCfg := range ula.SubscriptionData.APNConfigurationProfile.APNConfigs {
-						res.Apn = append(
-							res.Apn,
-							&protos.UpdateLocationAnswer_APNConfiguration{
-								ContextId:        apnCfg.ContextIdentifier,
-								Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
-								ServiceSelection: apnCfg.ServiceSelection,
-								QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
-									ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
-									PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
-									PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
-									PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
-								},
-								Ambr:                    apnCfg.AMBR.getProtoAmbr(),
-								ChargingCharacteristics: apnCfg.TgppChargingCharacteristics,
-								ServedPartyIpAddress:    make([]string, len(apnCfg.ServedPartyIpAddress)),
-							})
-						for j, address := range apnCfg.ServedPartyIpAddress {
-							if len(address) == 4 { // IPv4 address
-								res.Apn[i].ServedPartyIpAddress[j] = net.IPv4(address[0], address[1], address[2], address[3]).String()
-							} else if len(address) == 16 { // IPv6 address
-                                                                hexIPv6 := fmt.Sprintf("%x", address)
-                                                                res.Apn[i].ServedPartyIpAddress[j] = net.ParseIP(
-                                                                        fmt.Sprintf("%s:%s:%s:%s:%s:%s:%s:%s",
-                                                                        hexIPv6[0:4], hexIPv6[4:8], hexIPv6[8:12], hexIPv6[12:16],
-                                                                        hexIPv6[16:20], hexIPv6[20:24], hexIPv6[24:28], hexIPv6[28:32])).String()
-							}
-						}
+					for _, apnCfg := range ula.SubscriptionData.APNConfigurationProfile.APNConfigs {
+						res.Apn = append(res.Apn, apnCfg.getProtoApn())
 					}
 					return res, err
 				} else {
@@ -281,3 +251,32 @@ func (ambr *AMBR) getProtoAmbr() *protos.UpdateLocationAnswer_AggregatedMaximumB
 		Unit:           protos.UpdateLocationAnswer_AggregatedMaximumBitrate_BPS,
 	}
 }
+
+func (apnCfg *APNConfiguration) getProtoApn() *protos.UpdateLocationAnswer_APNConfiguration {
+	apn := &protos.UpdateLocationAnswer_APNConfiguration{
+		ContextId:        apnCfg.ContextIdentifier,
+		Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
+		ServiceSelection: apnCfg.ServiceSelection,
+		QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
+			ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
+			PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
+			PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
+			PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
+		},
+		Ambr:                    apnCfg.AMBR.getProtoAmbr(),
+		ChargingCharacteristics: apnCfg.TgppChargingCharacteristics,
+		ServedPartyIpAddress:    make([]string, len(apnCfg.ServedPartyIpAddress)),
+	}
+	for j, address := range apnCfg.ServedPartyIpAddress {
+		if len(address) == 4 { // IPv4 address
+			apn.ServedPartyIpAddress[j] = net.IPv4(address[0], address[1], address[2], address[3]).String()
+		} else if len(address) == 16 { // IPv6 address
+			hexIPv6 := fmt.Sprintf("%x", address)
+			apn.ServedPartyIpAddress[j] = net.ParseIP(
+				fmt.Sprintf("%s:%s:%s:%s:%s:%s:%s:%s",
+					hexIPv6[0:4], hexIPv6[4:8], hexIPv6[8:12], hexIPv6[12:16],
+					hexIPv6[16:20], hexIPv6[20:24], hexIPv6[24:28], hexIPv6[28:32])).String()
+		}
+	}
+	return apn
+}
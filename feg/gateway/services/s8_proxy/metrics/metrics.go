@@ -64,6 +64,22 @@ var (
 		Name: "s8_bearer_delete_failures",
 		Help: "Total number of delete bearer requests that failed.",
 	})
+	BearerModifyRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_modify_requests_total",
+		Help: "Total number of modify bearer requests.",
+	})
+	BearerModifyFails = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_modify_failures",
+		Help: "Total number of modify bearer requests that failed.",
+	})
+	BearerUpdateRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_update_requests_total",
+		Help: "Total number of update bearer requests.",
+	})
+	BearerUpdateFails = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_update_failures",
+		Help: "Total number of update bearer requests that failed.",
+	})
 )
 
 type S8HealthTracker struct {
@@ -81,12 +97,17 @@ type S8HealthMetrics struct {
 	BearerCreateFails     int64
 	BearerDeleteRequests  int64
 	BearerDeleteFails     int64
+	BearerModifyRequests  int64
+	BearerModifyFails     int64
+	BearerUpdateRequests  int64
+	BearerUpdateFails     int64
 }
 
 func init() {
 	prometheus.MustRegister(SessionCreateRequests, SessionCreateFails,
 		SessionDeleteRequests, SessionDeleteFails, BearerCreateRequests,
-		BearerCreateFails, BearerDeleteRequests, BearerDeleteFails)
+		BearerCreateFails, BearerDeleteRequests, BearerDeleteFails,
+		BearerModifyRequests, BearerModifyFails, BearerUpdateRequests, BearerUpdateFails)
 }
 
 func NewS8HealthTracker() *S8HealthTracker {
@@ -99,6 +120,10 @@ func NewS8HealthTracker() *S8HealthTracker {
 		BearerCreateFails:     0,
 		BearerDeleteRequests:  0,
 		BearerDeleteFails:     0,
+		BearerModifyRequests:  0,
+		BearerModifyFails:     0,
+		BearerUpdateRequests:  0,
+		BearerUpdateFails:     0,
 	}
 	defaultHealthTracker := &S8HealthTracker{
 		Metrics:                 initMetrics,
@@ -162,6 +187,22 @@ func GetCurrentHealthMetrics() (*S8HealthMetrics, error) {
 	if err != nil {
 		return nil, err
 	}
+	bearerModifyRequests, err := service_health_metrics.GetInt64("s8_bearer_modify_requests_total")
+	if err != nil {
+		return nil, err
+	}
+	bearerModifyFails, err := service_health_metrics.GetInt64("s8_bearer_modify_failures")
+	if err != nil {
+		return nil, err
+	}
+	bearerUpdateRequests, err := service_health_metrics.GetInt64("s8_bearer_update_requests_total")
+	if err != nil {
+		return nil, err
+	}
+	bearerUpdateFails, err := service_health_metrics.GetInt64("s8_bearer_update_failures")
+	if err != nil {
+		return nil, err
+	}
 
 	return &S8HealthMetrics{
 		SessionCreateRequests: sessionCreateRequests,
@@ -172,6 +213,10 @@ func GetCurrentHealthMetrics() (*S8HealthMetrics, error) {
 		BearerCreateFails:     bearerCreateFails,
 		BearerDeleteRequests:  bearerDeleteRequests,
 		BearerDeleteFails:     bearerDeleteFails,
+		BearerModifyRequests:  bearerModifyRequests,
+		BearerModifyFails:     bearerModifyFails,
+		BearerUpdateRequests:  bearerUpdateRequests,
+		BearerUpdateFails:     bearerUpdateFails,
 	}, nil
 }
 
@@ -188,6 +233,10 @@ func (prevMetrics *S8HealthMetrics) GetDelta(currentMetrics *S8HealthMetrics) (*
 		BearerCreateFails:     currentMetrics.BearerCreateFails - prevMetrics.BearerCreateFails,
 		BearerDeleteRequests:  currentMetrics.BearerDeleteRequests - prevMetrics.BearerDeleteRequests,
 		BearerDeleteFails:     currentMetrics.BearerDeleteFails - prevMetrics.BearerDeleteFails,
+		BearerModifyRequests:  currentMetrics.BearerModifyRequests - prevMetrics.BearerModifyRequests,
+		BearerModifyFails:     currentMetrics.BearerModifyFails - prevMetrics.BearerModifyFails,
+		BearerUpdateRequests:  currentMetrics.BearerUpdateRequests - prevMetrics.BearerUpdateRequests,
+		BearerUpdateFails:     currentMetrics.BearerUpdateFails - prevMetrics.BearerUpdateFails,
 	}
 	// Update stored counts to current metric totals
 	*prevMetrics = *currentMetrics
@@ -108,6 +108,45 @@ func buildDeleteSessionRequestMsg(cPgwUDPAddr *net.UDPAddr, req *protos.DeleteSe
 	return message.NewDeleteSessionRequest(req.CPgwTeid, 0, ies...), nil
 }
 
+func buildModifyBearerRequestMsg(cPgwUDPAddr *net.UDPAddr, req *protos.ModifyBearerRequestPgw) (message.Message, error) {
+	// TODO: look for a better way to find the local ip (avoid pinging on each request)
+	// (obtain the IP that is going to send the packet first)
+	ip, err := gtp.GetLocalOutboundIP(cPgwUDPAddr)
+	if err != nil {
+		return nil, err
+	}
+	// Control plane TEID
+	cFegFTeid := ie.NewFullyQualifiedTEID(gtpv2.IFTypeS5S8SGWGTPC, req.CAgwTeid, ip.String(), "").WithInstance(0)
+
+	ies := []*ie.IE{cFegFTeid}
+	for _, bearerCtx := range req.BearerContext {
+		bearerIEs := []*ie.IE{ie.NewEPSBearerID(uint8(bearerCtx.Id))}
+		// User plane TEID (only included if AGW user plane changed)
+		if uAgwFTeidReq := bearerCtx.GetUserPlaneFteid(); uAgwFTeidReq != nil {
+			bearerIEs = append(bearerIEs, ie.NewFullyQualifiedTEID(gtpv2.IFTypeS5S8SGWGTPU,
+				uAgwFTeidReq.Teid, uAgwFTeidReq.Ipv4Address, uAgwFTeidReq.Ipv6Address).WithInstance(1))
+		}
+		ies = append(ies, ie.NewBearerContext(bearerIEs...))
+	}
+	if req.Uli != nil {
+		ies = append(ies, getUserLocationIndication(req.ServingNetwork, req.Uli))
+	}
+	if req.ServingNetwork != nil {
+		ies = append(ies, ie.NewServingNetwork(req.ServingNetwork.Mcc, req.ServingNetwork.Mnc))
+	}
+	if req.RatType != protos.RATType_RESERVED {
+		ies = append(ies, getRatType(req.RatType))
+	}
+	if len(req.IndicationFlag) != 0 {
+		ies = append(ies, ie.NewIndicationFromOctets(req.IndicationFlag...))
+	}
+	if req.TimeZone != nil {
+		offset := time.Duration(req.TimeZone.DeltaSeconds) * time.Second
+		ies = append(ies, ie.NewUETimeZone(offset, uint8(req.TimeZone.DaylightSavingTime)))
+	}
+	return message.NewModifyBearerRequest(req.CPgwTeid, 0, ies...), nil
+}
+
 func buildCreateBearerResMsg(res *protos.CreateBearerResponsePgw) (message.Message, error) {
 	if res.Cause != uint32(gtpv2.CauseRequestAccepted) {
 		return buildCreateBearerResWithErrorCauseMsg(res.Cause, res.CPgwTeid, res.SequenceNumber), nil
@@ -174,6 +213,36 @@ func buildDeleteBearerResMsg(res *protos.DeleteBearerResponsePgw) (message.Messa
 		res.CPgwTeid, res.SequenceNumber, response...), nil
 }
 
+func buildUpdateBearerResMsg(res *protos.UpdateBearerResponsePgw) (message.Message, error) {
+	if res.Cause != uint32(gtpv2.CauseRequestAccepted) {
+		return message.NewUpdateBearerResponse(
+			res.CPgwTeid, res.SequenceNumber, ie.NewCause(uint8(res.Cause), 0, 0, 0, nil)), nil
+	}
+	if len(res.BearerContext) == 0 {
+		return nil, fmt.Errorf("UpdateBearerResponse could not be sent. Missing Bearer Contex")
+	}
+
+	response := []*ie.IE{
+		ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+		getProtocolConfigurationOptions(res.ProtocolConfigurationOptions),
+	}
+	for _, bearerCtx := range res.BearerContext {
+		bearerCause := ie.NewCause(uint8(bearerCtx.Cause), 0, 0, 0, nil)
+		bearerId := ie.NewEPSBearerID(uint8(bearerCtx.Id))
+		response = append(response, ie.NewBearerContext(bearerId, bearerCause))
+	}
+
+	// ULI and timezone are only included if they changed
+	if res.Uli != nil && res.ServingNetwork != nil {
+		response = append(response, getUserLocationIndication(res.ServingNetwork, res.Uli))
+	}
+	if res.TimeZone != nil {
+		offset := time.Duration(res.TimeZone.DeltaSeconds) * time.Second
+		response = append(response, ie.NewUETimeZone(offset, uint8(res.TimeZone.DaylightSavingTime)))
+	}
+	return message.NewUpdateBearerResponse(res.CPgwTeid, res.SequenceNumber, response...), nil
+}
+
 func buildCreateBearerResWithErrorCauseMsg(cause uint32, cPgwTeid uint32, seq uint32) message.Message {
 	return message.NewCreateBearerResponse(
 		cPgwTeid, seq, ie.NewCause(uint8(cause), 0, 0, 0, nil))
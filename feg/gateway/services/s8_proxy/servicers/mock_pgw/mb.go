@@ -22,39 +22,88 @@ import (
 	"github.com/wmnsk/go-gtp/gtpv2/message"
 )
 
-// TODO
+// getHandleModifyBearerRequest updates the SGW user plane F-TEIDs of the bearers to be modified
+// and responds with a Modify Bearer Response
 func (mPgw *MockPgw) getHandleModifyBearerRequest() gtpv2.HandlerFunc {
 	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received a ModifyBearerRequest")
+		mbReqFromSGW := msg.(*message.ModifyBearerRequest)
+
 		session, err := c.GetSessionByTEID(msg.TEID(), sgwAddr)
 		if err != nil {
-			dsr := message.NewDeleteSessionResponse(
-				0, 0,
-				ie.NewCause(gtpv2.CauseIMSIIMEINotKnown, 0, 0, 0, nil),
-			)
-			if err := c.RespondTo(sgwAddr, msg, dsr); err != nil {
-				return err
+			// no session, so use the sender teid to respond
+			var sgwTeidC uint32
+			if senderFTEIDC := mbReqFromSGW.SenderFTEIDC; senderFTEIDC != nil {
+				sgwTeidC, _ = senderFTEIDC.TEID()
 			}
+			mbr := message.NewModifyBearerResponse(
+				sgwTeidC, msg.Sequence(),
+				ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
+			)
+			return c.RespondTo(sgwAddr, msg, mbr)
+		}
+
+		sgwTeidC, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+		if err != nil {
 			return err
 		}
-		bearer := session.GetDefaultBearer()
 
-		mbReqFromMME := msg.(*message.ModifyBearerRequest)
-		if brCtxIE := mbReqFromMME.BearerContextsToBeModified[0]; brCtxIE != nil {
+		if len(mbReqFromSGW.BearerContextsToBeModified) == 0 {
+			mbr := message.NewModifyBearerResponse(
+				sgwTeidC, msg.Sequence(),
+				ie.NewCause(gtpv2.CauseMandatoryIEMissing, 0, 0, 0, ie.NewBearerContext()),
+			)
+			return c.RespondTo(sgwAddr, msg, mbr)
+		}
+
+		ies := []*ie.IE{ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)}
+		for _, brCtxIE := range mbReqFromSGW.BearerContextsToBeModified {
+			var (
+				ebi      uint8
+				fteiduIE *ie.IE
+			)
 			for _, childIE := range brCtxIE.ChildIEs {
 				switch childIE.Type {
-				case ie.Indication:
-					// TODO:
-					// do nothing in this example.
-					// S-GW should change its behavior based on indication flags like;
-					//  - pass Modify Bearer Request to P-GW if handover is indicated.
-					//  - XXX...
-				case ie.FullyQualifiedTEID:
-					if err := handleFTEIDU(childIE, session, bearer); err != nil {
+				case ie.EPSBearerID:
+					ebi, err = childIE.EPSBearerID()
+					if err != nil {
 						return err
 					}
+				case ie.FullyQualifiedTEID:
+					fteiduIE = childIE
+				}
+			}
+			bearer, err := session.LookupBearerByEBI(ebi)
+			if err != nil {
+				ies = append(ies, ie.NewBearerContext(
+					ie.NewEPSBearerID(ebi),
+					ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil)))
+				continue
+			}
+			if fteiduIE != nil {
+				if err := handleFTEIDU(fteiduIE, session, bearer); err != nil {
+					return err
 				}
 			}
+			ies = append(ies, ie.NewBearerContext(
+				ie.NewEPSBearerID(ebi),
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewChargingID(bearer.ChargingID)))
+		}
+
+		// save values given for testing purposes
+		if uliIE := mbReqFromSGW.ULI; uliIE != nil {
+			mPgw.LastULI, err = uliIE.UserLocationInformation()
+			if err != nil {
+				return err
+			}
+		}
+
+		mbr := message.NewModifyBearerResponse(sgwTeidC, msg.Sequence(), ies...)
+		if err := c.RespondTo(sgwAddr, msg, mbr); err != nil {
+			return err
 		}
+		fmt.Printf("mock PGW modified bearers for: %s\n", session.IMSI)
 		return nil
 	}
 }
@@ -77,11 +77,12 @@ func (mPgw *MockPgw) Start(ctx context.Context, pgwAddrsStr string) error {
 
 	// register handlers for ALL the message you expect remote endpoint to send.
 	mPgw.AddHandlers(map[uint8]gtpv2.HandlerFunc{
-		message.MsgTypeCreateSessionRequest:       mPgw.getHandleCreateSessionRequest(),
-		message.MsgTypeModifyAccessBearersRequest: mPgw.getHandleModifyBearerRequest(),
-		message.MsgTypeDeleteSessionRequest:       mPgw.getHandleDeleteSessionRequest(),
-		message.MsgTypeCreateBearerResponse:       mPgw.getHandleCreateBearerResponse(),
-		message.MsgTypeDeleteBearerResponse:       mPgw.getHandleDeleteBearerResponse(),
+		message.MsgTypeCreateSessionRequest: mPgw.getHandleCreateSessionRequest(),
+		message.MsgTypeModifyBearerRequest:  mPgw.getHandleModifyBearerRequest(),
+		message.MsgTypeDeleteSessionRequest: mPgw.getHandleDeleteSessionRequest(),
+		message.MsgTypeCreateBearerResponse: mPgw.getHandleCreateBearerResponse(),
+		message.MsgTypeDeleteBearerResponse: mPgw.getHandleDeleteBearerResponse(),
+		message.MsgTypeUpdateBearerResponse: mPgw.getHandleUpdateBearerResponse(),
 	})
 	return nil
 }
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_pgw
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+type UpdateBearerRequest struct {
+	Imsi        string
+	EpsBearerId uint8
+	QosQCI      uint8
+	ApnAmbrUl   uint32
+	ApnAmbrDl   uint32
+}
+
+type UBReq struct {
+	Res *message.UpdateBearerResponse
+	Err error
+}
+
+func (mPgw *MockPgw) UpdateBearerRequest(req UpdateBearerRequest) (chan UBReq, error) {
+	session, err := mPgw.GetSessionByIMSI(req.Imsi)
+	if err != nil {
+		return nil, err
+	}
+
+	sgwTeidC, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+	if err != nil {
+		err = fmt.Errorf("Error, couldnt find teid on Update Bearer Request: %w", err)
+		return nil, err
+	}
+
+	ubReqMsg := message.NewUpdateBearerRequest(sgwTeidC, 0, buildUpdateBearerRequestIEs(req)...)
+
+	sequence, err := mPgw.SendMessageTo(ubReqMsg, session.PeerAddr())
+	if err != nil {
+		return nil, err
+	}
+	mPgw.LastSequenceNumber = sequence
+
+	out := make(chan UBReq)
+	// this routine is needed due to the fact AGW req/res is split into two grpc servers
+	go func() {
+		// wait for getHandleUpdateBearerResponse to process the response of sgw
+		incomingMsg, err := session.WaitMessage(sequence, mPgw.GtpTimeout)
+		if err != nil {
+			fmt.Printf("mockPgw couldn't process received UpdateBearerResponse: %s\n", err)
+			out <- UBReq{Err: err}
+			return
+		}
+		var ubRspFromSGW *message.UpdateBearerResponse
+		switch m := incomingMsg.(type) {
+		case *message.UpdateBearerResponse:
+			// move forward
+			ubRspFromSGW = m
+		default:
+			errMsg := "mockPgw couldn't parse UpdateBearerResponse"
+			fmt.Println(errMsg)
+			out <- UBReq{Err: errors.New(errMsg)}
+			return
+		}
+		fmt.Printf("mockPGW received UpdateBearerResponse: %s\n", ubRspFromSGW.String())
+		out <- UBReq{
+			Res: ubRspFromSGW,
+			Err: nil,
+		}
+	}()
+	return out, nil
+}
+
+func buildUpdateBearerRequestIEs(req UpdateBearerRequest) []*ie.IE {
+	return []*ie.IE{
+		ie.NewBearerContext(
+			ie.NewEPSBearerID(req.EpsBearerId),
+			ie.NewBearerQoS(1, 0, 1, req.QosQCI, 0x1111111111, 0x2222222222, 0x1111111111, 0x2222222222),
+		),
+		ie.NewAggregateMaximumBitRate(req.ApnAmbrUl, req.ApnAmbrDl),
+	}
+}
+
+// getHandleUpdateBearerResponse just handle Update Bearer Response and return it back to
+// UpdateBearerRequest function so it can return it s result
+func (mPgw *MockPgw) getHandleUpdateBearerResponse() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received an UpdateBearerResponse")
+		session, err := c.GetSessionByTEID(msg.TEID(), sgwAddr)
+		if err != nil {
+			return fmt.Errorf("Mock PGW could not handle UpdateBearerResponse: %s", err)
+		}
+		// pass message to same session
+		if err = gtpv2.PassMessageTo(session, msg, mPgw.GtpTimeout); err != nil {
+			return fmt.Errorf("Mock PGW could not pass the UpdateBearerResponse %s", err)
+		}
+		return nil
+	}
+}
@@ -129,6 +129,50 @@ func parseDeleteSessionResponse(msg message.Message) (
 	return dsRes, nil
 }
 
+// parseModifyBearerResponse parses a gtp message into a ModifyBearerResponsePgw. In case
+// there is an error it returns the cause of error
+func parseModifyBearerResponse(msg message.Message) (*protos.ModifyBearerResponsePgw, error) {
+	mbResGtp := msg.(*message.ModifyBearerResponse)
+	glog.V(2).Infof("Received Modify Bearer Response (gtp):\n%s", mbResGtp.String())
+
+	mbRes := &protos.ModifyBearerResponsePgw{}
+	var err error
+	// check Cause value first.
+	if causeIE := mbResGtp.Cause; causeIE != nil {
+		mbRes.GtpError, err = handleCause(causeIE, msg)
+		if err != nil || mbRes.GtpError != nil {
+			// return either GtpError or err
+			return mbRes, err
+		}
+		// If we get here, the message will be processed
+	} else {
+		mbRes.GtpError = errorIeMissing(ie.Cause)
+		// error is in GtpError
+		return mbRes, nil
+	}
+
+	// get C AGW Teid (is the same used on Modify Bearer Request by MME)
+	mbRes.CAgwTeid = msg.TEID()
+
+	// Protocol Configuration Options (PCO) optional
+	if pgwPcoIE := mbResGtp.PCO; pgwPcoIE != nil {
+		mbRes.ProtocolConfigurationOptions, err = handlePCO(pgwPcoIE)
+		if err != nil {
+			err = fmt.Errorf("Couldn't get Protocol Configuration Options: %s ", err)
+			return nil, err
+		}
+	}
+
+	for _, brCtxIE := range mbResGtp.BearerContextsModified {
+		bearerCtx, err := handleModifiedBearerCtx(brCtxIE)
+		if err != nil {
+			return nil, err
+		}
+		mbRes.BearerContext = append(mbRes.BearerContext, bearerCtx)
+	}
+	return mbRes, nil
+}
+
 func parseCreateBearerRequest(msg message.Message, senderAddr net.Addr) (*protos.CreateBearerRequestPgw, *protos.GtpError, error) {
 	cbReqGtp := msg.(*message.CreateBearerRequest)
 	glog.V(2).Infof("Received Create Bearer Request (gtp):\n%s", cbReqGtp.String())
@@ -217,6 +261,65 @@ func parseDeleteBearerRequest(msg message.Message, senderAddr net.Addr) (*protos
 	return dbReq, nil, nil
 }
 
+func parseUpdateBearerRequest(msg message.Message, senderAddr net.Addr) (*protos.UpdateBearerRequestPgw, *protos.GtpError, error) {
+	ubReqGtp := msg.(*message.UpdateBearerRequest)
+	glog.V(2).Infof("Received Update Bearer Request (gtp):\n%s", ubReqGtp.String())
+	ubReq := &protos.UpdateBearerRequestPgw{}
+	ubReq.PgwAddrs = senderAddr.String()
+	ubReq.SequenceNumber = ubReqGtp.SequenceNumber
+
+	// cgw control plane teid
+	if !ubReqGtp.HasTEID() {
+		return nil, errorIeMissing(ie.FullyQualifiedTEID), nil
+	}
+	ubReq.CAgwTeid = ubReqGtp.TEID()
+
+	if ambrIE := ubReqGtp.APNAMBR; ambrIE != nil {
+		ambr, err := ambrIE.AggregateMaximumBitRate()
+		if err != nil {
+			return nil, nil, fmt.Errorf("parseUpdateBearerRequest Couldn't get APN AMBR: %s", err)
+		}
+		ubReq.ApnAmbr = &protos.Ambr{
+			BrUl: uint64(ambr.APNAMBRForUplink),
+			BrDl: uint64(ambr.APNAMBRForDownlink),
+		}
+	} else {
+		return nil, errorIeMissing(ie.AggregateMaximumBitRate), nil
+	}
+
+	if len(ubReqGtp.BearerContexts) == 0 {
+		return nil, errorIeMissing(ie.BearerContext), nil
+	}
+	for _, brCtxIE := range ubReqGtp.BearerContexts {
+		bearerContext, gtpError, err := handleBearerCtx([]*ie.IE{brCtxIE})
+		if err != nil || gtpError != nil {
+			return nil, gtpError, err
+		}
+		ubReq.BearerContext = append(ubReq.BearerContext, bearerContext)
+	}
+
+	// Procedure Transaction Id optional (only present if UE requested the modification)
+	if ptiIE := ubReqGtp.PTI; ptiIE != nil {
+		pti, err := ptiIE.ProcedureTransactionID()
+		if err != nil {
+			return nil, nil, fmt.Errorf("parseUpdateBearerRequest Couldn't get Procedure Transaction Id: %s", err)
+		}
+		ubReq.ProcedureTransactionId = uint32(pti)
+	}
+
+	// Protocol Configuration Options (PCO) optional
+	if pgwPcoIE := ubReqGtp.PCO; pgwPcoIE != nil {
+		pco, err := handlePCO(pgwPcoIE)
+		if err != nil {
+			err = fmt.Errorf("parseUpdateBearerRequest Couldn't get Protocol Configuration Options: %s ", err)
+			return nil, nil, err
+		}
+		ubReq.ProtocolConfigurationOptions = pco
+	}
+
+	return ubReq, nil, nil
+}
+
 func handleCause(causeIE *ie.IE, msg message.Message) (*protos.GtpError, error) {
 	cause, err := causeIE.Cause()
 	if err != nil {
@@ -418,6 +521,47 @@ func handleBearerCtx(brCtxsIE []*ie.IE) (*protos.BearerContext, *protos.GtpError
 	return bearerCtx, nil, nil
 }
 
+// handleModifiedBearerCtx converts a bearer context of a Modify Bearer Response into Proto format.
+// Unlike handleBearerCtx, a rejected bearer is not an error, its cause is returned within the bearer
+func handleModifiedBearerCtx(brCtxIE *ie.IE) (*protos.BearerContext, error) {
+	bearerCtx := &protos.BearerContext{}
+	for _, childIE := range brCtxIE.ChildIEs {
+		switch childIE.Type {
+		case ie.Cause:
+			cause, err := childIE.Cause()
+			if err != nil {
+				return nil, err
+			}
+			bearerCtx.Cause = uint32(cause)
+
+		case ie.EPSBearerID:
+			ebi, err := childIE.EPSBearerID()
+			if err != nil {
+				return nil, err
+			}
+			bearerCtx.Id = uint32(ebi)
+
+		case ie.FullyQualifiedTEID:
+			userPlaneFteid, _, err := handleFTEID(childIE)
+			if err != nil {
+				return nil, err
+			}
+			bearerCtx.UserPlaneFteid = userPlaneFteid
+
+		case ie.ChargingID:
+			chargingId, err := childIE.ChargingID()
+			if err != nil {
+				return nil, err
+			}
+			bearerCtx.ChargingId = chargingId
+		}
+	}
+	if bearerCtx.Cause == 0 {
+		return nil, &gtpv2.RequiredIEMissingError{Type: ie.Cause}
+	}
+	return bearerCtx, nil
+}
+
 func handlePCO(pcoIE *ie.IE) (*protos.ProtocolConfigurationOptions, error) {
 	pgwPcoField, err := pcoIE.ProtocolConfigurationOptions()
 	if err != nil {
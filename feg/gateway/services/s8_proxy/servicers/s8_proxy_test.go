@@ -644,7 +644,7 @@ func TestS8proxyCreateSessionNoProtocolConfigurationOptions(t *testing.T) {
 	assert.Nil(t, csRes.ProtocolConfigurationOptions)
 }
 
-func TestCreateAndDeleteBearerRequest(t *testing.T) {
+func TestCreateUpdateAndDeleteBearerRequest(t *testing.T) {
 	// set up client ans server
 	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
 	defer mockPgw.Close()
@@ -825,6 +825,64 @@ func TestCreateAndDeleteBearerRequest(t *testing.T) {
 		}
 	}
 
+	// ------------------------
+	// ---- Update Bearer ----
+	pgwUpdateBearerRequest := mock_pgw.UpdateBearerRequest{
+		Imsi:        csReq.Imsi,
+		EpsBearerId: DEDICATEDBEARER_1,
+		QosQCI:      8,
+		ApnAmbrUl:   2000,
+		ApnAmbrDl:   4000,
+	}
+	// Send UpdateBearerRequest from mockPGW to S8_proxy and obtain outUBR channel to wait for the response
+	outUBR, err := mockPgw.UpdateBearerRequest(pgwUpdateBearerRequest)
+	require.NoError(t, err)
+
+	// wait for mock feg_relay to process the request
+	<-fegRelayTestSrv.Ready
+
+	ubReqReceived := fegRelayTestSrv.ReceivedUpdateBearerRequest
+	require.NotEmpty(t, ubReqReceived)
+	assert.Equal(t, csReq.CAgwTeid, ubReqReceived.CAgwTeid)
+	assert.Equal(t, mockPgw.LastSequenceNumber, ubReqReceived.SequenceNumber)
+	require.NotNil(t, ubReqReceived.ApnAmbr)
+	assert.Equal(t, uint64(pgwUpdateBearerRequest.ApnAmbrUl), ubReqReceived.ApnAmbr.BrUl)
+	assert.Equal(t, uint64(pgwUpdateBearerRequest.ApnAmbrDl), ubReqReceived.ApnAmbr.BrDl)
+	require.Equal(t, 1, len(ubReqReceived.BearerContext))
+	assert.Equal(t, uint32(DEDICATEDBEARER_1), ubReqReceived.BearerContext[0].Id)
+	require.NotNil(t, ubReqReceived.BearerContext[0].Qos)
+	assert.Equal(t, uint32(pgwUpdateBearerRequest.QosQCI), ubReqReceived.BearerContext[0].Qos.Qci)
+
+	// send the response from agw to feg
+	ubResGrpc := &protos.UpdateBearerResponsePgw{
+		PgwAddrs:       ubReqReceived.PgwAddrs,
+		Imsi:           csReq.Imsi,
+		SequenceNumber: ubReqReceived.SequenceNumber,
+		CPgwTeid:       PgwTEIDc,
+		Cause:          uint32(gtpv2.CauseRequestAccepted),
+		BearerContext: []*protos.BearerContext{
+			{
+				Id:    DEDICATEDBEARER_1,
+				Cause: uint32(gtpv2.CauseRequestAccepted),
+			},
+		},
+		ServingNetwork: &protos.ServingNetwork{Mcc: "011", Mnc: "99"},
+		Uli:            &protos.UserLocationInformation{Tac: 1},
+	}
+	_, err = s8p.UpdateBearerResponse(context.Background(), ubResGrpc)
+	require.NoError(t, err)
+
+	// wait for the answer to be sent from agw to feg
+	ubResFromChan := <-outUBR
+	require.NoError(t, ubResFromChan.Err)
+	ubResGtp := ubResFromChan.Res
+	require.NotEmpty(t, ubResGtp)
+	assert.Equal(t, ubReqReceived.SequenceNumber, ubResGtp.SequenceNumber)
+	assert.Equal(t, PgwTEIDc, ubResGtp.TEID())
+	assert.Equal(t, gtpv2.CauseRequestAccepted, ubResGtp.Cause.MustCause())
+	require.NotEmpty(t, ubResGtp.BearerContexts)
+	assert.Equal(t, uint8(DEDICATEDBEARER_1), ubResGtp.BearerContexts[0].MustEPSBearerID())
+
 	// ------------------------
 	// ---- Delete Bearer ----
 	outDBR, err := mockPgw.DeleteBearerRequest(mock_pgw.DeleteBearerRequest{Imsi: IMSI1, EpsBearerId: DEDICATEDBEARER_1})
@@ -868,6 +926,74 @@ func TestCreateAndDeleteBearerRequest(t *testing.T) {
 	assert.Equal(t, uint8(DEDICATEDBEARER_1), dbResGtp.BearerContexts[0].MustEPSBearerID())
 }
 
+func TestS8proxyModifyBearer(t *testing.T) {
+	// set up client ans server
+	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
+	defer mockPgw.Close()
+
+	// force PGW to return specific control plane PGW TEID
+	PgwTEIDc := uint32(111)
+	mockPgw.CreateSessionOptions.PgwTEIDc = PgwTEIDc
+
+	// ------------------------
+	// ---- Create Session ----
+	csReq := getDefaultCreateSessionRequest(mockPgw.LocalAddr().String())
+	csRes, err := s8p.CreateSession(context.Background(), csReq)
+	assert.NoError(t, err)
+	require.Nil(t, csRes.GtpError)
+
+	// ------------------------
+	// ---- Modify Bearer ----
+	// AGW user plane changes (i.e. handover)
+	newAGWTeidU := uint32(99)
+	mbReq := getModifyBearerRequest(mockPgw.LocalAddr().String(), PgwTEIDc, BEARER, newAGWTeidU)
+	mbRes, err := s8p.ModifyBearer(context.Background(), mbReq)
+	require.NoError(t, err)
+	require.Nil(t, mbRes.GtpError)
+	assert.Equal(t, AGWTeidC, mbRes.CAgwTeid)
+	require.Equal(t, 1, len(mbRes.BearerContext))
+	assert.Equal(t, uint32(BEARER), mbRes.BearerContext[0].Id)
+	assert.Equal(t, uint32(gtpv2.CauseRequestAccepted), mbRes.BearerContext[0].Cause)
+
+	// check PGW updated the AGW user plane TEID and location
+	session, err := mockPgw.GetSessionByIMSI(IMSI1)
+	require.NoError(t, err)
+	sgwTeidU, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPU)
+	assert.NoError(t, err)
+	assert.Equal(t, newAGWTeidU, sgwTeidU)
+	assert.Equal(t, uint16(mbReq.Uli.Tac), mockPgw.LastULI.TAI.TAC)
+
+	// ---- Modify Bearer for unknown bearer ----
+	mbReq = getModifyBearerRequest(mockPgw.LocalAddr().String(), PgwTEIDc, DEDICATEDBEARER_2, newAGWTeidU)
+	mbRes, err = s8p.ModifyBearer(context.Background(), mbReq)
+	require.NoError(t, err)
+	require.Nil(t, mbRes.GtpError)
+	require.Equal(t, 1, len(mbRes.BearerContext))
+	assert.Equal(t, uint32(gtpv2.CauseContextNotFound), mbRes.BearerContext[0].Cause)
+
+	// ---- Modify Bearer for unknown session ----
+	mbReq = getModifyBearerRequest(mockPgw.LocalAddr().String(), PgwTEIDc+1, BEARER, newAGWTeidU)
+	mbRes, err = s8p.ModifyBearer(context.Background(), mbReq)
+	require.NoError(t, err)
+	require.NotNil(t, mbRes.GtpError)
+	assert.Equal(t, uint32(gtpv2.CauseContextNotFound), mbRes.GtpError.Cause)
+}
+
+func TestS8proxyModifyBearerWithMissingParam(t *testing.T) {
+	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
+	defer mockPgw.Close()
+
+	mbReq := getModifyBearerRequest(mockPgw.LocalAddr().String(), 111, BEARER, AGWTeidU)
+	mbReq.BearerContext = nil
+	_, err := s8p.ModifyBearer(context.Background(), mbReq)
+	assert.Error(t, err)
+
+	mbReq = getModifyBearerRequest(mockPgw.LocalAddr().String(), 111, BEARER, AGWTeidU)
+	mbReq.ServingNetwork = nil
+	_, err = s8p.ModifyBearer(context.Background(), mbReq)
+	assert.Error(t, err)
+}
+
 func TestS8proxyEcho(t *testing.T) {
 	s8p, mockPgw := startSgwAndPgw(t, 3*time.Second)
 	defer mockPgw.Close()
@@ -1106,6 +1232,30 @@ func getDeleteSessionRequest(pgwAddrs string, cPgwTeid uint32) *protos.DeleteSes
 	return res
 }
 
+func getModifyBearerRequest(pgwAddrs string, cPgwTeid uint32, bearerId uint32, agwTeidU uint32) *protos.ModifyBearerRequestPgw {
+	return &protos.ModifyBearerRequestPgw{
+		PgwAddrs: pgwAddrs,
+		Imsi:     IMSI1,
+		CAgwTeid: AGWTeidC,
+		CPgwTeid: cPgwTeid,
+		ServingNetwork: &protos.ServingNetwork{
+			Mcc: "222",
+			Mnc: "333",
+		},
+		Uli:     &protos.UserLocationInformation{Tac: 15, Eci: 16},
+		RatType: protos.RATType_EUTRAN,
+		BearerContext: []*protos.BearerContext{
+			{
+				Id: bearerId,
+				UserPlaneFteid: &protos.Fteid{
+					Ipv4Address: "127.0.0.11",
+					Teid:        agwTeidU,
+				},
+			},
+		},
+	}
+}
+
 func getDefaultConfig(pgwActualAddrs string, gtpTimeout time.Duration) *S8ProxyConfig {
 	return &S8ProxyConfig{
 		GtpTimeout: gtpTimeout,
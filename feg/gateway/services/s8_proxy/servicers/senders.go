@@ -81,6 +81,29 @@ func (s *S8Proxy) sendAndReceiveDeleteSession(req *protos.DeleteSessionRequestPg
 	return dsRes, err
 }
 
+// sendAndReceiveModifyBearer sends modify bearer request GTP-C message to PGW and
+// waits for its answers.
+// Returns a GRPC message translated from the GTP-C modify bearer response
+func (s *S8Proxy) sendAndReceiveModifyBearer(req *protos.ModifyBearerRequestPgw,
+	cPgwUDPAddr *net.UDPAddr,
+	mbReqMsg message.Message) (*protos.ModifyBearerResponsePgw, error) {
+	glog.V(2).Infof("Send Modify Bearer Request (grpc) to %s:\n%s", cPgwUDPAddr,
+		req)
+	glog.V(2).Infof("Send Modify Bearer Request (gtp) to %s:\n%s",
+		cPgwUDPAddr.String(), mbReqMsg.(*message.ModifyBearerRequest).String())
+
+	grpcMessage, err := s.gtpClient.SendMessageAndExtractGrpc(req.Imsi, req.CAgwTeid, cPgwUDPAddr, mbReqMsg)
+	if err != nil {
+		return nil, fmt.Errorf("no response message to ModifyBearerRequest: %s", err)
+	}
+	mbRes, ok := grpcMessage.(*protos.ModifyBearerResponsePgw)
+	if !ok {
+		return nil, fmt.Errorf("Wrong response type (no ModifyBearerResponse), maybe received out of order response message: %s", err)
+	}
+	glog.V(2).Infof("Modify Bearer Response (grpc):\n%s", mbRes.String())
+	return mbRes, err
+}
+
 // sendAndReceiveCreateBearerResponse
 func (s *S8Proxy) sendAndReceiveCreateBearerResponse(res *protos.CreateBearerResponsePgw,
 	cPgwUDPAddr *net.UDPAddr,
@@ -110,3 +133,19 @@ func (s *S8Proxy) sendAndReceiveDeleteBearerResponse(res *protos.DeleteBearerRes
 	}
 	return &orc8r_protos.Void{}, err
 }
+
+// sendAndReceiveUpdateBearerResponse
+func (s *S8Proxy) sendAndReceiveUpdateBearerResponse(res *protos.UpdateBearerResponsePgw,
+	cPgwUDPAddr *net.UDPAddr,
+	ubResMsg message.Message) (*orc8r_protos.Void, error) {
+	glog.V(2).Infof("Send Update Bearer Response (grpc) to %s:\n%s", cPgwUDPAddr,
+		res)
+	glog.V(2).Infof("Send Update Bearer Response (gtp) to %s:\n%s",
+		cPgwUDPAddr.String(), ubResMsg.(*message.UpdateBearerResponse).String())
+
+	err := s.gtpClient.SendMessageToWithoutIncSequence(ubResMsg, cPgwUDPAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed so send UpdateBearerResponse message: %s", err)
+	}
+	return &orc8r_protos.Void{}, err
+}
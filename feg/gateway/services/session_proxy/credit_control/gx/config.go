@@ -89,6 +89,9 @@ func GetPCRFConfiguration() []*diameter.DiameterServerConfig {
 			DestRealm:         diameter.GetValueOrEnv(diameter.DestRealmFlag, PCRFRealmEnv, gxCfg.GetDestRealm(), i),
 			DisableDestHost:   diameter.GetBoolValueOrEnv(diameter.DisableDestHostFlag, DisableDestHostEnv, gxCfg.GetDisableDestHost(), i),
 			OverwriteDestHost: diameter.GetBoolValueOrEnv(diameter.OverwriteDestHostFlag, OverwriteDestHostEnv, gxCfg.GetOverwriteDestHost(), i),
+			Priority:          gxCfg.GetPriority(),
+			Weight:            gxCfg.GetWeight(),
+			Peers:             diameter.GetPeerConfigs(gxCfg),
 		}
 		diamServerConfigs = append(diamServerConfigs, diamSrvCfg)
 	}
@@ -38,8 +38,16 @@ const UsageMonitoringReport MonitoringReport = 0x0
 type EventTrigger uint32
 
 const (
+	RATChange                EventTrigger = 2
+	PLMNChange               EventTrigger = 4
+	IPCANChange              EventTrigger = 7
+	UserLocationChange       EventTrigger = 13
 	RevalidationTimeout      EventTrigger = 17
+	UETimeZoneChange         EventTrigger = 25
+	ECGIChange               EventTrigger = 27
 	UsageReportTrigger       EventTrigger = 33
+	ApplicationStart         EventTrigger = 39
+	ApplicationStop          EventTrigger = 40
 	PCRF91UsageReportTrigger EventTrigger = 26
 )
 
@@ -67,6 +75,7 @@ type CreditControlRequest struct {
 	EventTrigger            EventTrigger
 	AccessTimezone          *protos.Timezone
 	ChargingCharacteristics string
+	AppDetectionInfos       []*ApplicationDetectionInfo
 }
 
 type QosRequestInfo struct {
@@ -128,6 +137,19 @@ type FlowInformation struct {
 	FlowDescription string `avp:"Flow-Description"`
 }
 
+// Application-Detection-Information ::=
+//
+//	< AVP Header: 1098 >
+//	{ TDF-Application-Identifier }
+//	[ TDF-Application-Instance-Identifier ]
+//	*[ Flow-Information ]
+//	*[ AVP ]
+type ApplicationDetectionInfo struct {
+	ApplicationID    string             `avp:"TDF-Application-Identifier"`
+	InstanceID       string             `avp:"TDF-Application-Instance-Identifier"`
+	FlowInformations []*FlowInformation `avp:"Flow-Information"`
+}
+
 type RuleDefinition struct {
 	RuleName            string               `avp:"Charging-Rule-Name"`
 	RatingGroup         *uint32              `avp:"Rating-Group"`
@@ -140,6 +162,7 @@ type RuleDefinition struct {
 	ServiceIdentifier   *uint32              `avp:"Service-Identifier"`
 	Online              int32                `avp:"Online"`
 	Offline             int32                `avp:"Offline"`
+	TDFApplicationID    string               `avp:"TDF-Application-Identifier"`
 }
 
 // QoS per service date flow message
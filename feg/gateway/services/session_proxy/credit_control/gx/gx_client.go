@@ -453,6 +453,7 @@ func (gxClient *GxClient) getAdditionalAvps(request *CreditControlRequest) ([]*d
 	}
 	if request.Type == credit_control.CRTUpdate {
 		avpList = append(avpList, gxClient.getEventTriggerAVP(request.EventTrigger))
+		avpList = append(avpList, getEventReportAVPs(request)...)
 	}
 
 	return avpList, nil
@@ -474,6 +475,48 @@ func getUsageMonitoringAVP(usage *UsageReport) *diam.AVP {
 	})
 }
 
+// getEventReportAVPs returns the AVPs reporting the values which changed with
+// the subscribed event trigger (3GPP 29.212 4.5.2.3 & 4.5.2.5)
+func getEventReportAVPs(request *CreditControlRequest) []*diam.AVP {
+	avpList := []*diam.AVP{}
+	if len(request.UserLocation) > 0 {
+		avpList = append(avpList,
+			diam.NewAVP(avp.TGPPUserLocationInfo, avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(request.UserLocation)))
+	}
+	if request.AccessTimezone != nil {
+		timezone := GetTimezoneByte(request.AccessTimezone)
+		avpList = append(avpList,
+			diam.NewAVP(avp.TGPPMSTimeZone, avp.Vbit, diameter.Vendor3GPP, datatype.OctetString([]byte{timezone, 0})))
+	}
+	if request.EventTrigger == ApplicationStart || request.EventTrigger == ApplicationStop {
+		for _, info := range request.AppDetectionInfos {
+			avpList = append(avpList, getApplicationDetectionInfoAVP(info))
+		}
+	}
+	return avpList
+}
+
+func getApplicationDetectionInfoAVP(info *ApplicationDetectionInfo) *diam.AVP {
+	avps := []*diam.AVP{
+		diam.NewAVP(TDFApplicationIdentifierAVPCode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.OctetString(info.ApplicationID)),
+	}
+	if len(info.InstanceID) > 0 {
+		avps = append(avps, diam.NewAVP(TDFApplicationInstanceIdentifierAVPCode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.OctetString(info.InstanceID)))
+	}
+	for _, flowInfo := range info.FlowInformations {
+		avps = append(avps, diam.NewAVP(avp.FlowInformation, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.FlowDescription, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+					datatype.IPFilterRule(flowInfo.FlowDescription)),
+			},
+		}))
+	}
+	return diam.NewAVP(ApplicationDetectionInformationAVPCode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+		&diam.GroupedAVP{AVP: avps})
+}
+
 func (gxClient *GxClient) getEventTriggerAVP(eventTrigger EventTrigger) *diam.AVP {
 	if eventTrigger == UsageReportTrigger {
 		if gxClient.pcrf91Compliant {
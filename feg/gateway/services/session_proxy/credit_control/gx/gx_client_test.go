@@ -42,6 +42,7 @@ const (
 	testIMSI2   = "4321"
 	testIMSI3   = "4499"
 	testIMSI4   = "5000"
+	testIMSI5   = "5500"
 	HOUR_IN_MIN = 60
 )
 
@@ -374,6 +375,116 @@ func TestGxClientUsageMonitoring(t *testing.T) {
 	}
 }
 
+func TestGxClientApplicationDetection(t *testing.T) {
+	serverConfig := defaultLocalServerConfig
+	clientConfig := getClientConfig()
+	globalConfig := getGxGlobalConfig("", "", "")
+	pcrf := startServer(clientConfig, &serverConfig)
+	ctx := context.Background()
+	pcrf.CreateAccount(ctx, &protos.SubscriberID{Id: testIMSI5})
+	pcrf.SetRules(ctx, &fegprotos.AccountRules{
+		Imsi: testIMSI5,
+		DynamicRuleDefinitions: []*fegprotos.RuleDefinition{
+			{
+				RuleName:                 "youtube_rule",
+				Precedence:               100,
+				MonitoringKey:            "mkey",
+				TdfApplicationIdentifier: "youtube",
+			},
+		},
+		EventTriggers: []uint32{
+			uint32(gx.RATChange), uint32(gx.UserLocationChange), uint32(gx.ApplicationStart), uint32(gx.ApplicationStop),
+		},
+	})
+
+	gxClient := gx.NewGxClient(
+		clientConfig,
+		&serverConfig,
+		getMockReAuthHandler(),
+		nil,
+		globalConfig,
+	)
+	done := make(chan interface{}, 1000)
+
+	init := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTInit,
+		IMSI:          testIMSI5,
+		RequestNumber: 0,
+		IPAddr:        "192.168.1.1",
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(&serverConfig, done, init))
+	initAnswer := gx.GetAnswer(done)
+	assert.Equal(t, init.SessionID, initAnswer.SessionID)
+	assert.ElementsMatch(t,
+		[]gx.EventTrigger{gx.RATChange, gx.UserLocationChange, gx.ApplicationStart, gx.ApplicationStop},
+		initAnswer.EventTriggers)
+	eventTriggers, _ := gx.GetEventTriggersRelatedInfo(initAnswer.EventTriggers, nil)
+	assert.ElementsMatch(t,
+		[]protos.EventTrigger{
+			protos.EventTrigger_RAT_CHANGE,
+			protos.EventTrigger_USER_LOCATION_CHANGE,
+			protos.EventTrigger_APPLICATION_START,
+			protos.EventTrigger_APPLICATION_STOP,
+		},
+		eventTriggers)
+	assert.Equal(t, 1, len(initAnswer.RuleInstallAVP))
+	assert.Equal(t, 1, len(initAnswer.RuleInstallAVP[0].RuleDefinitions))
+	ruleDefinition := initAnswer.RuleInstallAVP[0].RuleDefinitions[0]
+	assert.Equal(t, "youtube", ruleDefinition.TDFApplicationID)
+	assert.Equal(t, protos.PolicyRule_YOUTUBE, ruleDefinition.ToProto().AppName)
+
+	start := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTUpdate,
+		IMSI:          testIMSI5,
+		RequestNumber: 1,
+		IPAddr:        "192.168.1.1",
+		EventTrigger:  gx.ApplicationStart,
+		UserLocation:  []byte{0x82, 0x00, 0xf1, 0x10, 0x00, 0x01},
+		AppDetectionInfos: []*gx.ApplicationDetectionInfo{
+			{
+				ApplicationID: "youtube",
+				InstanceID:    "1",
+				FlowInformations: []*gx.FlowInformation{
+					{FlowDescription: "permit out ip from 192.168.1.1 to any"},
+				},
+			},
+		},
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(&serverConfig, done, start))
+	startAnswer := gx.GetAnswer(done)
+	assert.Equal(t, start.RequestNumber, startAnswer.RequestNumber)
+	lastMessage, err := pcrf.GetLastAVPreceived()
+	assert.NoError(t, err)
+	uli, err := lastMessage.FindAVP(avp.TGPPUserLocationInfo, diameter.Vendor3GPP)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.OctetString(start.UserLocation), uli.Data)
+
+	stop := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTUpdate,
+		IMSI:          testIMSI5,
+		RequestNumber: 2,
+		IPAddr:        "192.168.1.1",
+		EventTrigger:  gx.ApplicationStop,
+		AppDetectionInfos: []*gx.ApplicationDetectionInfo{
+			{ApplicationID: "youtube", InstanceID: "1"},
+		},
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(&serverConfig, done, stop))
+	stopAnswer := gx.GetAnswer(done)
+	assert.Equal(t, stop.RequestNumber, stopAnswer.RequestNumber)
+
+	reports, err := pcrf.GetApplicationDetectionReports(testIMSI5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(reports))
+	assert.Equal(t, start.AppDetectionInfos[0], reports[0])
+	assert.Equal(t, "youtube", reports[1].ApplicationID)
+	assert.Equal(t, "1", reports[1].InstanceID)
+	assert.Empty(t, reports[1].FlowInformations)
+}
+
 func TestGxReAuthRemoveRules(t *testing.T) {
 	log.Printf("Start TestGxReAuth")
 	if t != nil {
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gx
+
+import (
+	"strings"
+
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+
+	"magma/feg/gateway/diameter"
+)
+
+const (
+	// AVP codes missing from the default dictionary (3GPP 29.212 5.3)
+	TDFApplicationIdentifierAVPCode         = 1088
+	ApplicationDetectionInformationAVPCode  = 1098
+	TDFApplicationInstanceIdentifierAVPCode = 2802
+)
+
+// gxDictExtension adds the application detection AVPs used by the Gx Sd/TDF
+// style APPLICATION_START/APPLICATION_STOP reporting which are missing from
+// the go-diameter Gx dictionary. They are added to the base application, which
+// the dictionary falls back to for unknown AVPs.
+const gxDictExtension = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+    <application id="0" type="auth" name="TGPP Gx Application Detection">
+        <vendor id="10415" name="TGPP"/>
+        <avp name="TDF-Application-Identifier" code="1088" must="V,M" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="OctetString"/>
+        </avp>
+        <avp name="Application-Detection-Information" code="1098" must="V,M" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Grouped">
+                <rule avp="TDF-Application-Identifier" required="true" max="1"/>
+                <rule avp="TDF-Application-Instance-Identifier" required="false" max="1"/>
+                <rule avp="Flow-Information" required="false"/>
+            </data>
+        </avp>
+        <avp name="TDF-Application-Instance-Identifier" code="2802" must="V,M" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="OctetString"/>
+        </avp>
+    </application>
+</diameter>`
+
+func init() {
+	if _, err := dict.Default.FindAVPWithVendor(0, TDFApplicationIdentifierAVPCode, diameter.Vendor3GPP); err == nil {
+		return // already loaded
+	}
+	if err := dict.Default.Load(strings.NewReader(gxDictExtension)); err != nil {
+		panic(err)
+	}
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package gx
 
 import (
+	"strings"
 	"time"
 
 	"github.com/fiorix/go-diameter/v4/diam"
@@ -55,9 +56,21 @@ func FromUsageMonitorUpdates(updates []*protos.UsageMonitoringUpdateRequest) []*
 
 		request.UsageReports = []*UsageReport{}
 		for _, updateN := range listUpdates {
-			if updateN.EventTrigger == protos.EventTrigger_USAGE_REPORT {
+			// Usage-Monitoring-Information is reported whenever usage is present,
+			// not only on USAGE_REPORT, so the accumulated usage isn't lost when
+			// the update is triggered by another event (3GPP 29.212 4.5.17.2)
+			if updateN.EventTrigger == protos.EventTrigger_USAGE_REPORT || updateN.Update != nil {
 				request.UsageReports = append(request.UsageReports, (&UsageReport{}).FromUsageMonitorUpdate(updateN.Update))
 			}
+			if len(updateN.UserLocation) > 0 {
+				request.UserLocation = updateN.UserLocation
+			}
+			if updateN.AccessTimezone != nil {
+				request.AccessTimezone = updateN.AccessTimezone
+			}
+			for _, info := range updateN.ApplicationDetectionInfos {
+				request.AppDetectionInfos = append(request.AppDetectionInfos, (&ApplicationDetectionInfo{}).FromProto(info))
+			}
 		}
 		requests = append(requests, request)
 	}
@@ -95,7 +108,42 @@ func (rd *RuleDefinition) ToProto() *protos.PolicyRule {
 		TrackingType:      rd.GetTrackingType(),
 		Offline:           Int32ToBoolean(rd.Offline),
 		Online:            Int32ToBoolean(rd.Online),
+		AppName:           GetAppName(rd.TDFApplicationID),
+	}
+}
+
+// GetAppName maps a TDF-Application-Identifier to the application names known
+// by the gateway DPI. Unknown identifiers map to NO_APP_NAME
+func GetAppName(tdfApplicationID string) protos.PolicyRule_AppName {
+	if len(tdfApplicationID) == 0 {
+		return protos.PolicyRule_NO_APP_NAME
 	}
+	appName, ok := protos.PolicyRule_AppName_value[strings.ToUpper(tdfApplicationID)]
+	if !ok {
+		glog.Warningf("Unknown TDF-Application-Identifier: %s", tdfApplicationID)
+		return protos.PolicyRule_NO_APP_NAME
+	}
+	return protos.PolicyRule_AppName(appName)
+}
+
+func (info *ApplicationDetectionInfo) FromProto(pInfo *protos.ApplicationDetectionInfo) *ApplicationDetectionInfo {
+	info.ApplicationID = pInfo.GetApplicationId()
+	info.InstanceID = pInfo.GetInstanceId()
+	for _, flow := range pInfo.GetFlowDescriptions() {
+		info.FlowInformations = append(info.FlowInformations, &FlowInformation{FlowDescription: flow})
+	}
+	return info
+}
+
+func (info *ApplicationDetectionInfo) ToProto() *protos.ApplicationDetectionInfo {
+	pInfo := &protos.ApplicationDetectionInfo{
+		ApplicationId: info.ApplicationID,
+		InstanceId:    info.InstanceID,
+	}
+	for _, flowInfo := range info.FlowInformations {
+		pInfo.FlowDescriptions = append(pInfo.FlowDescriptions, flowInfo.FlowDescription)
+	}
+	return pInfo
 }
 
 func ServiceIdentifierToProto(si *uint32) *protos.ServiceIdentifier {
@@ -289,6 +337,9 @@ func GetEventTriggersRelatedInfo(
 		case RevalidationTimeout:
 			protoRevalidationTime = ConvertToProtoTimestamp(revalidationTime)
 			protoEventTriggers = append(protoEventTriggers, protos.EventTrigger(eventTrigger))
+		case RATChange, PLMNChange, IPCANChange, UserLocationChange, UETimeZoneChange, ECGIChange,
+			ApplicationStart, ApplicationStop:
+			protoEventTriggers = append(protoEventTriggers, protos.EventTrigger(eventTrigger))
 		default:
 			protoEventTriggers = append(protoEventTriggers, protos.EventTrigger_UNSUPPORTED)
 		}
@@ -261,3 +261,113 @@ func TestRuleDefinition_ToProto(t *testing.T) {
 	assert.Equal(t, uint32(0), ruleOut.RatingGroup)
 	assert.Equal(t, protos.PolicyRule_NO_TRACKING, ruleOut.TrackingType)
 }
+
+func TestRuleDefinition_ToProtoAppName(t *testing.T) {
+	ruleOut := (&gx.RuleDefinition{RuleName: "app", TDFApplicationID: "Facebook_Messenger"}).ToProto()
+	assert.Equal(t, protos.PolicyRule_FACEBOOK_MESSENGER, ruleOut.AppName)
+
+	ruleOut = (&gx.RuleDefinition{RuleName: "unknown", TDFApplicationID: "unknown_app"}).ToProto()
+	assert.Equal(t, protos.PolicyRule_NO_APP_NAME, ruleOut.AppName)
+
+	ruleOut = (&gx.RuleDefinition{RuleName: "none"}).ToProto()
+	assert.Equal(t, protos.PolicyRule_NO_APP_NAME, ruleOut.AppName)
+}
+
+func TestGetEventTriggersRelatedInfo(t *testing.T) {
+	revalidationTime := time.Unix(1600000000, 0)
+	eventTriggers, protoRevalidationTime := gx.GetEventTriggersRelatedInfo(
+		[]gx.EventTrigger{
+			gx.RATChange,
+			gx.PLMNChange,
+			gx.IPCANChange,
+			gx.UserLocationChange,
+			gx.RevalidationTimeout,
+			gx.UETimeZoneChange,
+			gx.ECGIChange,
+			gx.UsageReportTrigger,
+			gx.ApplicationStart,
+			gx.ApplicationStop,
+			gx.EventTrigger(100),
+		},
+		&revalidationTime,
+	)
+	assert.Equal(t, []protos.EventTrigger{
+		protos.EventTrigger_RAT_CHANGE,
+		protos.EventTrigger_PLMN_CHANGE,
+		protos.EventTrigger_IP_CAN_CHANGE,
+		protos.EventTrigger_USER_LOCATION_CHANGE,
+		protos.EventTrigger_REVALIDATION_TIMEOUT,
+		protos.EventTrigger_UE_TIME_ZONE_CHANGE,
+		protos.EventTrigger_ECGI_CHANGE,
+		protos.EventTrigger_UNSUPPORTED,
+		protos.EventTrigger_APPLICATION_START,
+		protos.EventTrigger_APPLICATION_STOP,
+		protos.EventTrigger_UNSUPPORTED,
+	}, eventTriggers)
+	assert.Equal(t, revalidationTime.Unix(), protoRevalidationTime.GetSeconds())
+}
+
+func TestFromUsageMonitorUpdates(t *testing.T) {
+	timezone := &protos.Timezone{OffsetMinutes: 120}
+	userLocation := []byte{0x82, 0x00, 0xf1, 0x10, 0x00, 0x01}
+	requests := gx.FromUsageMonitorUpdates([]*protos.UsageMonitoringUpdateRequest{
+		{
+			SessionId:     "sid1",
+			Sid:           "IMSI001010000000001",
+			RequestNumber: 2,
+			EventTrigger:  protos.EventTrigger_APPLICATION_START,
+			Update: &protos.UsageMonitorUpdate{
+				MonitoringKey: []byte("mkey"),
+				Level:         protos.MonitoringLevel_PCC_RULE_LEVEL,
+				BytesTx:       10,
+				BytesRx:       20,
+			},
+			UserLocation:   userLocation,
+			AccessTimezone: timezone,
+			ApplicationDetectionInfos: []*protos.ApplicationDetectionInfo{
+				{
+					ApplicationId:    "youtube",
+					InstanceId:       "7",
+					FlowDescriptions: []string{"permit out ip from any to 192.168.1.1"},
+				},
+			},
+		},
+		{
+			SessionId:     "sid1",
+			Sid:           "IMSI001010000000001",
+			RequestNumber: 2,
+			EventTrigger:  protos.EventTrigger_APPLICATION_START,
+		},
+	})
+	assert.Equal(t, 1, len(requests))
+	request := requests[0]
+	assert.Equal(t, "001010000000001", request.IMSI)
+	assert.Equal(t, gx.ApplicationStart, request.EventTrigger)
+	assert.Equal(t, userLocation, request.UserLocation)
+	assert.Equal(t, timezone, request.AccessTimezone)
+	assert.Equal(t, []*gx.UsageReport{
+		{
+			MonitoringKey: []byte("mkey"),
+			Level:         gx.RuleLevel,
+			InputOctets:   10,
+			OutputOctets:  20,
+			TotalOctets:   30,
+		},
+	}, request.UsageReports)
+	assert.Equal(t, []*gx.ApplicationDetectionInfo{
+		{
+			ApplicationID: "youtube",
+			InstanceID:    "7",
+			FlowInformations: []*gx.FlowInformation{
+				{FlowDescription: "permit out ip from any to 192.168.1.1"},
+			},
+		},
+	}, request.AppDetectionInfos)
+	assert.Equal(t,
+		&protos.ApplicationDetectionInfo{
+			ApplicationId:    "youtube",
+			InstanceId:       "7",
+			FlowDescriptions: []string{"permit out ip from any to 192.168.1.1"},
+		},
+		request.AppDetectionInfos[0].ToProto())
+}
@@ -130,6 +130,9 @@ func GetOCSConfiguration() []*diameter.DiameterServerConfig {
 			DestRealm:         diameter.GetValueOrEnv(diameter.DestRealmFlag, OCSRealmEnv, gyCfg.GetDestRealm(), i),
 			DisableDestHost:   diameter.GetBoolValueOrEnv(diameter.DisableDestHostFlag, DisableDestHostEnv, gyCfg.GetDisableDestHost(), i),
 			OverwriteDestHost: diameter.GetBoolValueOrEnv(diameter.OverwriteDestHostFlag, OverwriteDestHostEnv, gyCfg.GetOverwriteDestHost(), i),
+			Priority:          gyCfg.GetPriority(),
+			Weight:            gyCfg.GetWeight(),
+			Peers:             diameter.GetPeerConfigs(gyCfg),
 		}
 		diamServerConfigs = append(diamServerConfigs, diamSrvCfg)
 	}
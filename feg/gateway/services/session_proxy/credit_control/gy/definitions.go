@@ -15,6 +15,8 @@ limitations under the License.
 package gy
 
 import (
+	"time"
+
 	"magma/feg/gateway/services/session_proxy/credit_control"
 	"magma/lte/cloud/go/protos"
 )
@@ -41,6 +43,15 @@ const (
 	POOL_EXHAUSTED
 )
 
+// TariffChangeUsage (AVP 452) tells if the used units were consumed before or after a tariff change
+type TariffChangeUsage uint32
+
+const (
+	UNIT_BEFORE_TARIFF_CHANGE TariffChangeUsage = iota
+	UNIT_AFTER_TARIFF_CHANGE
+	UNIT_INDETERMINATE
+)
+
 const (
 	// 3GPP TS 29.274 RAT Types (for Gy)
 	RAT_TYPE_WLAN   = "\x03"
@@ -55,6 +66,9 @@ type UsedCredits struct {
 	TotalOctets       uint64
 	Type              UsedCreditsType
 	RequestedUnits    *protos.RequestedUnits
+	// TariffChangeUsage is set if the usage is split by a tariff change, usages of the same
+	// rating group & service are reported as multiple Used-Service-Units of one MSCC
+	TariffChangeUsage *TariffChangeUsage
 }
 
 type CreditControlRequest struct {
@@ -83,12 +97,16 @@ type QosRequestInfo struct {
 }
 
 type ReceivedCredits struct {
-	ResultCode          uint32
-	RatingGroup         uint32
-	ServiceIdentifier   *uint32
-	GrantedUnits        *credit_control.GrantedServiceUnit
-	ValidityTime        uint32
-	FinalUnitIndication *FinalUnitIndication
+	ResultCode           uint32
+	RatingGroup          uint32
+	ServiceIdentifier    *uint32
+	GrantedUnits         *credit_control.GrantedServiceUnit
+	ValidityTime         uint32
+	FinalUnitIndication  *FinalUnitIndication
+	TariffTimeChange     *time.Time
+	QuotaHoldingTime     uint32
+	QuotaConsumptionTime uint32
+	Trigger              *Trigger
 }
 
 type CreditControlAnswer struct {
@@ -110,6 +128,12 @@ type RedirectServer struct {
 	RedirectServerAddress string              `avp:"Redirect-Server-Address"`
 }
 
+// Trigger lists events causing re-authorization of the credit, Trigger without
+// Trigger-Types disables all triggers
+type Trigger struct {
+	TriggerTypes []uint32 `avp:"Trigger-Type"`
+}
+
 type RedirectAddressType uint8
 
 const (
@@ -120,12 +144,16 @@ const (
 )
 
 type MSCCDiameterMessage struct {
-	ResultCode          uint32                            `avp:"Result-Code"`
-	GrantedServiceUnit  credit_control.GrantedServiceUnit `avp:"Granted-Service-Unit"`
-	ValidityTime        uint32                            `avp:"Validity-Time"`
-	FinalUnitIndication *FinalUnitIndication              `avp:"Final-Unit-Indication"`
-	RatingGroup         uint32                            `avp:"Rating-Group"`
-	ServiceIdentifier   *uint32                           `avp:"Service-Identifier"`
+	ResultCode           uint32                            `avp:"Result-Code"`
+	GrantedServiceUnit   credit_control.GrantedServiceUnit `avp:"Granted-Service-Unit"`
+	ValidityTime         uint32                            `avp:"Validity-Time"`
+	FinalUnitIndication  *FinalUnitIndication              `avp:"Final-Unit-Indication"`
+	RatingGroup          uint32                            `avp:"Rating-Group"`
+	ServiceIdentifier    *uint32                           `avp:"Service-Identifier"`
+	TariffTimeChange     *time.Time                        `avp:"Tariff-Time-Change"`
+	QuotaHoldingTime     uint32                            `avp:"Quota-Holding-Time"`
+	QuotaConsumptionTime uint32                            `avp:"Quota-Consumption-Time"`
+	Trigger              *Trigger                          `avp:"Trigger"`
 }
 
 type CCADiameterMessage struct {
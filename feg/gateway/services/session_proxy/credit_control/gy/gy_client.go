@@ -225,12 +225,35 @@ func getAdditionalAvps(request *CreditControlRequest, globalConfig *GyGlobalConf
 		avpList = append(avpList,
 			diam.NewAVP(avp.DestinationHost, avp.Mbit, 0, datatype.DiameterIdentity(request.TgppCtx.GetGyDestHost())))
 	}
+	// usages split by a tariff change are reported as additional Used-Service-Units
+	// of the MSCC of the first usage of the same rating group & service
+	splitMSCCs := map[string]*diam.GroupedAVP{}
 	for _, credit := range request.Credits {
-		avpList = append(avpList, getMSCCAVP(request.Type, credit, globalConfig))
+		if credit.TariffChangeUsage == nil || request.Type == credit_control.CRTInit {
+			avpList = append(avpList, getMSCCAVP(request.Type, credit, globalConfig))
+			continue
+		}
+		key := getServiceKey(credit)
+		if mscc, found := splitMSCCs[key]; found {
+			usu, _ := getUsedServiceUnitAVP(credit)
+			mscc.AddAVP(usu)
+			continue
+		}
+		mscc := getMSCCAVP(request.Type, credit, globalConfig)
+		splitMSCCs[key] = mscc.Data.(*diam.GroupedAVP)
+		avpList = append(avpList, mscc)
 	}
 	return avpList, nil
 }
 
+func getServiceKey(credits *UsedCredits) string {
+	key := strconv.FormatUint(uint64(credits.RatingGroup), 10)
+	if credits.ServiceIdentifier != nil {
+		key += ":" + strconv.FormatUint(uint64(*credits.ServiceIdentifier), 10)
+	}
+	return key
+}
+
 // createCreditControlMessage creates a base message to be used for any Credit
 // Control Request message. Init will just use this, and update and terminate
 // pass in extra AVPs through additionalAVPs
@@ -418,29 +441,11 @@ func getMSCCAVP(requestType credit_control.CreditRequestType, credits *UsedCredi
 
 	// Used credits can only be sent on updates and terminates
 	if requestType != credit_control.CRTInit {
-		usuGrp := []*diam.AVP{
-			diam.NewAVP(avp.CCInputOctets, avp.Mbit, 0, datatype.Unsigned64(credits.InputOctets)),
-			diam.NewAVP(avp.CCOutputOctets, avp.Mbit, 0, datatype.Unsigned64(credits.OutputOctets)),
-			diam.NewAVP(avp.CCTotalOctets, avp.Mbit, 0, datatype.Unsigned64(credits.TotalOctets)),
-		}
-
-		// For documentation on where the reporting reason AVP is placed, see section 7.2.175 on
-		// https://www.etsi.org/deliver/etsi_ts/132200_132299/132299/12.06.00_60/ts_132299v120600p.pdf
-		switch credits.Type {
-		case FINAL, VALIDITY_TIMER_EXPIRED, FORCED_REAUTHORISATION, QHT, RATING_CONDITION_CHANGE:
-			avpGroup = append(
-				avpGroup,
-				diam.NewAVP(
-					avp.ReportingReason, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.Enumerated(credits.Type)))
-		case QUOTA_EXHAUSTED, THRESHOLD, OTHER_QUOTA_TYPE, POOL_EXHAUSTED:
-			usuGrp = append(
-				usuGrp,
-				diam.NewAVP(
-					avp.ReportingReason, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.Enumerated(credits.Type)))
-
+		usu, reportingReason := getUsedServiceUnitAVP(credits)
+		if reportingReason != nil {
+			avpGroup = append(avpGroup, reportingReason)
 		}
-		avpGroup = append(
-			avpGroup, diam.NewAVP(avp.UsedServiceUnit, avp.Mbit, 0, &diam.GroupedAVP{AVP: usuGrp}))
+		avpGroup = append(avpGroup, usu)
 	}
 
 	return diam.NewAVP(avp.MultipleServicesCreditControl, avp.Mbit, 0, &diam.GroupedAVP{
@@ -448,18 +453,53 @@ func getMSCCAVP(requestType credit_control.CreditRequestType, credits *UsedCredi
 	})
 }
 
+// getUsedServiceUnitAVP returns the Used-Service-Unit AVP for the given used credits and the
+// Reporting-Reason AVP to be added to the MSCC level if the reason doesn't belong to the Used-Service-Unit
+func getUsedServiceUnitAVP(credits *UsedCredits) (*diam.AVP, *diam.AVP) {
+	var msccReportingReason *diam.AVP
+	usuGrp := []*diam.AVP{
+		diam.NewAVP(avp.CCInputOctets, avp.Mbit, 0, datatype.Unsigned64(credits.InputOctets)),
+		diam.NewAVP(avp.CCOutputOctets, avp.Mbit, 0, datatype.Unsigned64(credits.OutputOctets)),
+		diam.NewAVP(avp.CCTotalOctets, avp.Mbit, 0, datatype.Unsigned64(credits.TotalOctets)),
+	}
+	if credits.TariffChangeUsage != nil {
+		usuGrp = append(
+			usuGrp,
+			diam.NewAVP(avp.TariffChangeUsage, avp.Mbit, 0, datatype.Enumerated(*credits.TariffChangeUsage)))
+	}
+
+	// For documentation on where the reporting reason AVP is placed, see section 7.2.175 on
+	// https://www.etsi.org/deliver/etsi_ts/132200_132299/132299/12.06.00_60/ts_132299v120600p.pdf
+	switch credits.Type {
+	case FINAL, VALIDITY_TIMER_EXPIRED, FORCED_REAUTHORISATION, QHT, RATING_CONDITION_CHANGE:
+		msccReportingReason = diam.NewAVP(
+			avp.ReportingReason, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.Enumerated(credits.Type))
+	case QUOTA_EXHAUSTED, THRESHOLD, OTHER_QUOTA_TYPE, POOL_EXHAUSTED:
+		usuGrp = append(
+			usuGrp,
+			diam.NewAVP(
+				avp.ReportingReason, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.Enumerated(credits.Type)))
+
+	}
+	return diam.NewAVP(avp.UsedServiceUnit, avp.Mbit, 0, &diam.GroupedAVP{AVP: usuGrp}), msccReportingReason
+}
+
 // getReceivedCredits gets the received octets if applicable from the unmarshalled
 // diameter message,
 func getReceivedCredits(cca *CCADiameterMessage) []*ReceivedCredits {
 	creditList := make([]*ReceivedCredits, 0, len(cca.CreditControl))
 	for _, mscc := range cca.CreditControl {
 		receivedCredits := &ReceivedCredits{
-			ResultCode:          mscc.ResultCode,
-			GrantedUnits:        &mscc.GrantedServiceUnit,
-			ValidityTime:        mscc.ValidityTime,
-			RatingGroup:         mscc.RatingGroup,
-			ServiceIdentifier:   mscc.ServiceIdentifier,
-			FinalUnitIndication: mscc.FinalUnitIndication,
+			ResultCode:           mscc.ResultCode,
+			GrantedUnits:         &mscc.GrantedServiceUnit,
+			ValidityTime:         mscc.ValidityTime,
+			RatingGroup:          mscc.RatingGroup,
+			ServiceIdentifier:    mscc.ServiceIdentifier,
+			FinalUnitIndication:  mscc.FinalUnitIndication,
+			TariffTimeChange:     mscc.TariffTimeChange,
+			QuotaHoldingTime:     mscc.QuotaHoldingTime,
+			QuotaConsumptionTime: mscc.QuotaConsumptionTime,
+			Trigger:              mscc.Trigger,
 		}
 		creditList = append(creditList, receivedCredits)
 	}
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 
 	fegprotos "magma/feg/cloud/go/protos"
@@ -176,6 +178,104 @@ func TestGyClient(t *testing.T) {
 	assert.NoError(t, gyClient.SendCreditControlRequest(&serverConfig, done, ccrInit))
 }
 
+// TestGyClientTariffTimeChange tests parsing of tariff time change, quota timers & triggers
+// and reporting of usage split by the tariff change
+func TestGyClientTariffTimeChange(t *testing.T) {
+	serverConfig := defaultLocalServerConfig
+	clientConfig := getClientConfig()
+	ocs := startServer(clientConfig, &serverConfig, gy.PerSessionInit, defaultfinalUnitConfig)
+	seedAccountConfigurations(ocs)
+	tariffTimeChange := time.Now().Add(time.Hour).Truncate(time.Second)
+	tariffTimeChangeProto, err := ptypes.TimestampProto(tariffTimeChange)
+	assert.NoError(t, err)
+	_, err = ocs.SetOCSSettings(context.Background(), &fegprotos.OCSConfig{
+		MaxUsageOctets: &fegprotos.Octets{TotalOctets: returnedOctets},
+		MaxUsageTime:   1000,
+		ValidityTime:   validityTime,
+		QuotaTimers: &fegprotos.QuotaTimers{
+			TariffTimeChange:     tariffTimeChangeProto,
+			QuotaHoldingTime:     30,
+			QuotaConsumptionTime: 10,
+			TriggerTypes:         []uint32{2, 3},
+		},
+	})
+	assert.NoError(t, err)
+	gyClient := gy.NewGyClient(
+		clientConfig,
+		&serverConfig,
+		getReAuthHandler(), nil, getGyGlobalConfig("", ""),
+	)
+	done := make(chan interface{}, 1000)
+
+	ccrInit := &gy.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTInit,
+		IMSI:          testIMSI1,
+		RequestNumber: 0,
+		Apn:           "gy.Apn.magma.com",
+		Credits:       []*gy.UsedCredits{{RatingGroup: 1, RequestedUnits: defaultRSU}},
+	}
+	assert.NoError(t, gyClient.SendCreditControlRequest(&serverConfig, done, ccrInit))
+	answer := gy.GetAnswer(done)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+	assert.Equal(t, 1, len(answer.Credits))
+	credit := answer.Credits[0]
+	assert.NotNil(t, credit.TariffTimeChange)
+	assert.True(t, tariffTimeChange.Equal(*credit.TariffTimeChange))
+	assert.Equal(t, tariffTimeChangeProto, credit.GetTariffTimeChangeProto())
+	assert.Equal(t, uint32(30), credit.QuotaHoldingTime)
+	assert.Equal(t, uint32(10), credit.QuotaConsumptionTime)
+	assert.Equal(t, &protos.ChargingTriggers{TriggerTypes: []uint32{2, 3}}, credit.Trigger.ToProto())
+
+	// usage before & after the tariff change is reported in a single MSCC
+	before, after := gy.UNIT_BEFORE_TARIFF_CHANGE, gy.UNIT_AFTER_TARIFF_CHANGE
+	ccrUpdate := &gy.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTUpdate,
+		IMSI:          testIMSI1,
+		RequestNumber: 1,
+		Apn:           "gy.Apn.magma.com",
+		Credits: []*gy.UsedCredits{
+			{
+				RatingGroup:       1,
+				TotalOctets:       1000,
+				Type:              gy.RATING_CONDITION_CHANGE,
+				RequestedUnits:    defaultRSU,
+				TariffChangeUsage: &before,
+			},
+			{
+				RatingGroup:       1,
+				TotalOctets:       2000,
+				Type:              gy.RATING_CONDITION_CHANGE,
+				RequestedUnits:    defaultRSU,
+				TariffChangeUsage: &after,
+			},
+		},
+	}
+	assert.NoError(t, gyClient.SendCreditControlRequest(&serverConfig, done, ccrUpdate))
+	answer = gy.GetAnswer(done)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+
+	ccr, err := ocs.GetLastAVPreceived()
+	assert.NoError(t, err)
+	msccs, err := ccr.FindAVPs(avp.MultipleServicesCreditControl, 0)
+	assert.NoError(t, err)
+	assert.Len(t, msccs, 1)
+	usus, err := ccr.FindAVPs(avp.UsedServiceUnit, 0)
+	assert.NoError(t, err)
+	assert.Len(t, usus, 2)
+	tariffChangeUsages, err := ccr.FindAVPs(avp.TariffChangeUsage, 0)
+	assert.NoError(t, err)
+	assert.Len(t, tariffChangeUsages, 2)
+	reportingReasons, err := ccr.FindAVPs(avp.ReportingReason, diameter.Vendor3GPP)
+	assert.NoError(t, err)
+	assert.Len(t, reportingReasons, 1)
+
+	credits, err := ocs.GetCredits(context.Background(), &protos.SubscriberID{Id: testIMSI1})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000000-3000), credits.CreditInformation[1].Volume.TotalOctets)
+}
+
 // TestGyClient test different options on global configuration
 func TestGyClientWithGyGlobalConf(t *testing.T) {
 	serverConfig := defaultLocalServerConfig
@@ -14,6 +14,10 @@ limitations under the License.
 package gy
 
 import (
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
 	"magma/feg/gateway/services/session_proxy/credit_control"
 	"magma/lte/cloud/go/protos"
 )
@@ -35,9 +39,34 @@ func (credits *UsedCredits) FromCreditUsage(usage *protos.CreditUsage) *UsedCred
 	credits.TotalOctets = usage.BytesTx + usage.BytesRx
 	credits.Type = UsedCreditsType(usage.Type)
 	credits.ServiceIdentifier = fromServiceIdentifier(usage.ServiceIdentifier)
+	credits.TariffChangeUsage = fromTariffChangeUsage(usage.TariffChangeUsage)
 	return credits
 }
 
+// ToProto converts Trigger AVP to ChargingTriggers proto, nil Trigger (Trigger AVP was not
+// received) returns nil
+func (trigger *Trigger) ToProto() *protos.ChargingTriggers {
+	if trigger == nil {
+		return nil
+	}
+	return &protos.ChargingTriggers{TriggerTypes: trigger.TriggerTypes}
+}
+
+// GetTariffTimeChangeProto returns Tariff-Time-Change of the received credits as proto timestamp
+// or nil if the OCS didn't send it
+func (credits *ReceivedCredits) GetTariffTimeChangeProto() *timestamp.Timestamp {
+	if credits.TariffTimeChange == nil {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(*credits.TariffTimeChange)
+	if err != nil {
+		glog.Errorf("Invalid Tariff-Time-Change %v for rating group %d: %v",
+			credits.TariffTimeChange, credits.RatingGroup, err)
+		return nil
+	}
+	return ts
+}
+
 // FromCreditUsageUpdates returns a slice of CCRs from usage update protos
 // It merges updates from same session into one single request
 func FromCreditUsageUpdates(updates []*protos.CreditUsageUpdate) []*CreditControlRequest {
@@ -79,6 +108,7 @@ func FromCreditUsageUpdates(updates []*protos.CreditUsageUpdate) []*CreditContro
 				TotalOctets:       updateN.Usage.BytesTx + updateN.Usage.BytesRx,
 				Type:              UsedCreditsType(updateN.Usage.Type),
 				RequestedUnits:    updateN.Usage.GetRequestedUnits(),
+				TariffChangeUsage: fromTariffChangeUsage(updateN.Usage.TariffChangeUsage),
 			})
 		}
 		requests = append(requests, request)
@@ -93,6 +123,15 @@ func fromServiceIdentifier(si *protos.ServiceIdentifier) *uint32 {
 	return &si.Value
 }
 
+func fromTariffChangeUsage(usage protos.CreditUsage_TariffChangeUsage) *TariffChangeUsage {
+	if usage == protos.CreditUsage_NOT_APPLICABLE {
+		return nil
+	}
+	// proto values are shifted by one to reserve 0 for usages not split by a tariff change
+	res := TariffChangeUsage(usage - 1)
+	return &res
+}
+
 func GetRATType(prt protos.RATType) string {
 	switch prt {
 	case protos.RATType_TGPP_WLAN:
@@ -37,3 +37,35 @@ func TestRedirectServer_ToProto(t *testing.T) {
 
 	assert.Equal(t, &protos.RedirectServer{}, convertedRedirectServer)
 }
+
+func TestTrigger_ToProto(t *testing.T) {
+	var nilTrigger *gy.Trigger = nil
+	assert.Nil(t, nilTrigger.ToProto())
+	assert.Equal(t, &protos.ChargingTriggers{}, (&gy.Trigger{}).ToProto())
+	assert.Equal(t,
+		&protos.ChargingTriggers{TriggerTypes: []uint32{1, 4}},
+		(&gy.Trigger{TriggerTypes: []uint32{1, 4}}).ToProto())
+}
+
+func TestUsedCredits_FromCreditUsageTariffChange(t *testing.T) {
+	credits := (&gy.UsedCredits{}).FromCreditUsage(&protos.CreditUsage{ChargingKey: 1, BytesTx: 10})
+	assert.Nil(t, credits.TariffChangeUsage)
+
+	credits = (&gy.UsedCredits{}).FromCreditUsage(&protos.CreditUsage{
+		ChargingKey:       1,
+		BytesTx:           10,
+		TariffChangeUsage: protos.CreditUsage_UNIT_AFTER_TARIFF_CHANGE,
+	})
+	assert.NotNil(t, credits.TariffChangeUsage)
+	assert.Equal(t, gy.UNIT_AFTER_TARIFF_CHANGE, *credits.TariffChangeUsage)
+
+	requests := gy.FromCreditUsageUpdates([]*protos.CreditUsageUpdate{{
+		SessionId: "sid1",
+		Usage: &protos.CreditUsage{
+			ChargingKey:       1,
+			TariffChangeUsage: protos.CreditUsage_UNIT_BEFORE_TARIFF_CHANGE,
+		},
+	}})
+	assert.Len(t, requests, 1)
+	assert.Equal(t, gy.UNIT_BEFORE_TARIFF_CHANGE, *requests[0].Credits[0].TariffChangeUsage)
+}
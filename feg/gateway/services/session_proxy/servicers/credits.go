@@ -330,9 +330,13 @@ func getSingleChargingCreditFromCCA(
 	credits *gy.ReceivedCredits,
 ) *protos.ChargingCredit {
 	chargingCredit := &protos.ChargingCredit{
-		GrantedUnits: credits.GrantedUnits.ToProto(),
-		Type:         protos.ChargingCredit_BYTES,
-		ValidityTime: credits.ValidityTime,
+		GrantedUnits:         credits.GrantedUnits.ToProto(),
+		Type:                 protos.ChargingCredit_BYTES,
+		ValidityTime:         credits.ValidityTime,
+		TariffTimeChange:     credits.GetTariffTimeChangeProto(),
+		QuotaHoldingTime:     credits.QuotaHoldingTime,
+		QuotaConsumptionTime: credits.QuotaConsumptionTime,
+		Triggers:             credits.Trigger.ToProto(),
 	}
 	if credits.FinalUnitIndication != nil {
 		chargingCredit.IsFinal = true
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/protobuf/proto"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/object_store"
+	"magma/feg/gateway/services/swx_proxy/metrics"
+)
+
+const (
+	// PersistenceNone - vectors are kept in memory only
+	PersistenceNone = "none"
+	// PersistenceRedis - vectors are persisted into a Redis hash
+	PersistenceRedis = "redis"
+	// PersistenceFile - vectors are persisted into a local JSON file
+	PersistenceFile = "file"
+
+	// DefaultRedisHash - Redis hash used by the redis persistence backend
+	DefaultRedisHash = "swx_vectors"
+	// DefaultPersistenceFile - vectors file used by the file persistence backend
+	DefaultPersistenceFile = "/var/opt/magma/swx_vectors.json"
+
+	// fileFlushInterval - how often the file backend writes out modified vectors
+	fileFlushInterval = time.Second * 10
+	// persistQueueLen - max number of store updates waiting to be written
+	persistQueueLen = 4096
+)
+
+// PersistenceConfig configures the optional persistent store of the cache
+type PersistenceConfig struct {
+	// Backend is one of PersistenceNone, PersistenceRedis or PersistenceFile
+	Backend string
+	// FilePath is the vectors file of the file backend
+	FilePath string
+	// RedisHash is the Redis hash of the redis backend
+	RedisHash string
+	// WarmUp loads persisted vectors into the cache on startup
+	WarmUp bool
+}
+
+// NewStore creates the persistent store described by the config,
+// it returns a nil store if persistence is disabled
+func NewStore(cfg *PersistenceConfig) (object_store.ObjectMap, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	switch strings.ToLower(cfg.Backend) {
+	case "", PersistenceNone:
+		return nil, nil
+	case PersistenceRedis:
+		client, err := object_store.NewRedisClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SWx cache Redis client: %v", err)
+		}
+		hash := cfg.RedisHash
+		if len(hash) == 0 {
+			hash = DefaultRedisHash
+		}
+		return object_store.NewRedisMap(client, hash, serializeEntry, deserializeEntry), nil
+	case PersistenceFile:
+		path := cfg.FilePath
+		if len(path) == 0 {
+			path = DefaultPersistenceFile
+		}
+		return object_store.NewFileMap(path, serializeEntry, deserializeEntry, fileFlushInterval)
+	default:
+		return nil, fmt.Errorf("unknown SWx cache persistence backend: %s", cfg.Backend)
+	}
+}
+
+// persistedEntry is the serialized form of a cached entity
+type persistedEntry struct {
+	LastUsed int64  `json:"last_used"`
+	Answer   []byte `json:"answer"`
+}
+
+func serializeEntry(object interface{}) (string, error) {
+	ent, ok := object.(*authEnt)
+	if !ok || ent.ans == nil {
+		return "", fmt.Errorf("invalid SWx cache entry type: %T", object)
+	}
+	ans, err := proto.Marshal(ent.ans)
+	if err != nil {
+		return "", err
+	}
+	res, err := json.Marshal(&persistedEntry{LastUsed: ent.lastUsed.UnixNano(), Answer: ans})
+	return string(res), err
+}
+
+func deserializeEntry(serialized string) (interface{}, error) {
+	var pe persistedEntry
+	if err := json.Unmarshal([]byte(serialized), &pe); err != nil {
+		return nil, err
+	}
+	ans := &protos.AuthenticationAnswer{}
+	if err := proto.Unmarshal(pe.Answer, ans); err != nil {
+		return nil, err
+	}
+	return &authEnt{idx: -1, lastUsed: time.Unix(0, pe.LastUsed), ans: ans}, nil
+}
+
+// persistOp is a single store update, a nil ent deletes the key,
+// clearAll deletes all keys and a non nil synced is closed once all
+// preceding updates are written
+type persistOp struct {
+	key      string
+	ent      *authEnt
+	clearAll bool
+	synced   chan struct{}
+}
+
+// persistSet queues a write of the entity's snapshot, must be called with swxCache.mu held
+func (swxCache *Impl) persistSet(ent *authEnt) {
+	if swxCache.store == nil {
+		return
+	}
+	snapshot := &authEnt{idx: -1, lastUsed: ent.lastUsed, ans: proto.Clone(ent.ans).(*protos.AuthenticationAnswer)}
+	swxCache.enqueue(persistOp{key: ent.ans.UserName, ent: snapshot})
+}
+
+// persistDelete queues removal of the IMSI's vectors, must be called with swxCache.mu held
+func (swxCache *Impl) persistDelete(imsi string) {
+	if swxCache.store == nil {
+		return
+	}
+	swxCache.enqueue(persistOp{key: imsi})
+}
+
+// enqueue passes the update to the store writer without blocking the cache,
+// updates are dropped if the store cannot keep up
+func (swxCache *Impl) enqueue(op persistOp) {
+	select {
+	case swxCache.updates <- op:
+	default:
+		metrics.SwxCachePersistenceFailures.Inc()
+		glog.Warningf("SWx cache persistence queue is full, dropping update for '%s'", op.key)
+	}
+}
+
+// Sync blocks until all queued updates are written to the persistent store
+// and flushes the store if it buffers writes
+func (swxCache *Impl) Sync() error {
+	if swxCache.store == nil {
+		return nil
+	}
+	synced := make(chan struct{})
+	swxCache.updates <- persistOp{synced: synced}
+	<-synced
+	if flusher, ok := swxCache.store.(interface{ Flush() error }); ok {
+		return flusher.Flush()
+	}
+	return nil
+}
+
+// writeStore applies queued updates to the persistent store in order
+func (swxCache *Impl) writeStore() {
+	for op := range swxCache.updates {
+		var err error
+		switch {
+		case op.synced != nil:
+			close(op.synced)
+			continue
+		case op.clearAll:
+			err = swxCache.store.DeleteAll()
+		case op.ent == nil:
+			err = swxCache.store.Delete(op.key)
+		default:
+			err = swxCache.store.Set(op.key, op.ent)
+		}
+		if err != nil {
+			metrics.SwxCachePersistenceFailures.Inc()
+			glog.Errorf("failed to persist SWx cache update for '%s': %v", op.key, err)
+		}
+	}
+}
+
+// warmUp loads persisted, not yet expired vectors into the cache and
+// removes expired ones from the store
+func (swxCache *Impl) warmUp(ttl time.Duration) {
+	all, err := swxCache.store.GetAll()
+	if err != nil {
+		metrics.SwxCachePersistenceFailures.Inc()
+		glog.Errorf("failed to load persisted SWx cache: %v", err)
+		return
+	}
+	stale := time.Now().Add(-ttl)
+	loaded := 0
+	swxCache.mu.Lock()
+	defer swxCache.mu.Unlock()
+	for imsi, obj := range all {
+		ent, ok := obj.(*authEnt)
+		if !ok || ent.ans == nil || len(ent.ans.SipAuthVectors) == 0 || ent.lastUsed.Before(stale) {
+			swxCache.persistDelete(imsi)
+			continue
+		}
+		ent.ans.UserName = imsi
+		if old, found := swxCache.data.vectors[imsi]; found {
+			heap.Remove(&swxCache.data, old.idx)
+		}
+		swxCache.data.vectors[imsi] = ent
+		heap.Push(&swxCache.data, ent)
+		loaded++
+	}
+	metrics.SwxCacheWarmUpEntries.Add(float64(loaded))
+	glog.Infof("SWx cache warm up loaded vectors for %d users", loaded)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/swx_proxy/cache"
+)
+
+const testImsi = "001010000000001"
+
+func testAnswer(vectors int) *protos.AuthenticationAnswer {
+	ans := &protos.AuthenticationAnswer{UserName: testImsi}
+	for i := 0; i < vectors; i++ {
+		ans.SipAuthVectors = append(ans.SipAuthVectors, &protos.AuthenticationAnswer_SIPAuthVector{
+			RandAutn: []byte{byte(i), 1, 2, 3},
+			Xres:     []byte{byte(i), 4, 5, 6},
+		})
+	}
+	return ans
+}
+
+func newFileStoreCache(t *testing.T, path string, ttl time.Duration) (*cache.Impl, chan struct{}) {
+	store, err := cache.NewStore(&cache.PersistenceConfig{Backend: cache.PersistenceFile, FilePath: path})
+	assert.NoError(t, err)
+	return cache.NewPersistent(time.Hour, ttl, store, true)
+}
+
+func TestSwxCachePersistenceWarmUp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "swx_vectors.json")
+	swxCache, done := newFileStoreCache(t, path, time.Hour)
+	res := swxCache.Put(testAnswer(5), 1)
+	assert.Len(t, res.SipAuthVectors, 1)
+	res = swxCache.Get(testImsi, 1)
+	assert.Len(t, res.SipAuthVectors, 1)
+	assert.Equal(t, byte(1), res.SipAuthVectors[0].RandAutn[0])
+	assert.NoError(t, swxCache.Sync())
+	done <- struct{}{}
+
+	// A restarted cache gets the remaining 3 vectors from the store
+	swxCache, done = newFileStoreCache(t, path, time.Hour)
+	res = swxCache.Get(testImsi, 5)
+	assert.NotNil(t, res)
+	assert.Len(t, res.SipAuthVectors, 3)
+	assert.Equal(t, byte(2), res.SipAuthVectors[0].RandAutn[0])
+	assert.NoError(t, swxCache.Sync())
+	done <- struct{}{}
+
+	// All vectors were consumed, nothing is left in the store
+	swxCache, done = newFileStoreCache(t, path, time.Hour)
+	assert.Nil(t, swxCache.Get(testImsi, 1))
+	done <- struct{}{}
+}
+
+func TestSwxCachePersistenceExpired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "swx_vectors.json")
+	ttl := time.Millisecond * 100
+	swxCache, done := newFileStoreCache(t, path, ttl)
+	swxCache.Put(testAnswer(3), 1)
+	assert.NoError(t, swxCache.Sync())
+	done <- struct{}{}
+
+	time.Sleep(ttl * 2)
+	swxCache, done = newFileStoreCache(t, path, ttl)
+	assert.Nil(t, swxCache.Get(testImsi, 1))
+	done <- struct{}{}
+}
+
+func TestSwxCachePersistenceClearAll(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "swx_vectors.json")
+	swxCache, done := newFileStoreCache(t, path, time.Hour)
+	swxCache.Put(testAnswer(3), 1)
+	swxCache.ClearAll()
+	assert.NoError(t, swxCache.Sync())
+	done <- struct{}{}
+
+	swxCache, done = newFileStoreCache(t, path, time.Hour)
+	assert.Nil(t, swxCache.Get(testImsi, 1))
+	done <- struct{}{}
+
+	_, err := cache.NewStore(&cache.PersistenceConfig{Backend: "memcached"})
+	assert.Error(t, err)
+}
@@ -20,6 +20,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/object_store"
+	"magma/feg/gateway/services/swx_proxy/metrics"
 )
 
 const (
@@ -41,8 +43,10 @@ type storage struct {
 }
 
 type Impl struct {
-	mu   sync.Mutex
-	data storage
+	mu      sync.Mutex
+	data    storage
+	store   object_store.ObjectMap // optional persistent store, nil if persistence is disabled
+	updates chan persistOp
 }
 
 // Go Heap interface implementation
@@ -82,7 +86,21 @@ func New() *Impl {
 
 // NewExt creates & returns a new instance of the cache and GC cancellation chan
 func NewExt(interval, ttl time.Duration) (*Impl, chan struct{}) {
-	cache := &Impl{data: storage{pq: []*authEnt{}, vectors: map[string]*authEnt{}}}
+	return NewPersistent(interval, ttl, nil, false)
+}
+
+// NewPersistent creates & returns a new instance of the cache backed by the given persistent store
+// and GC cancellation chan. All cache modifications are asynchronously written through to the store,
+// if warmUp is set, the cache is pre-loaded with not expired vectors from the store.
+func NewPersistent(interval, ttl time.Duration, store object_store.ObjectMap, warmUp bool) (*Impl, chan struct{}) {
+	cache := &Impl{data: storage{pq: []*authEnt{}, vectors: map[string]*authEnt{}}, store: store}
+	if store != nil {
+		cache.updates = make(chan persistOp, persistQueueLen)
+		go cache.writeStore()
+		if warmUp {
+			cache.warmUp(ttl)
+		}
+	}
 	return cache, cache.Gc(interval, ttl) // start garbage collector with given interval & ttl
 }
 
@@ -93,9 +111,11 @@ func (swxCache *Impl) Get(imsi string, neededNumber int) *protos.AuthenticationA
 	defer swxCache.mu.Unlock()
 	ent, found := swxCache.data.vectors[imsi]
 	if found {
+		metrics.SwxCacheHits.Inc()
 		if len(ent.ans.SipAuthVectors) <= neededNumber {
 			delete(swxCache.data.vectors, imsi)
 			heap.Remove(&swxCache.data, ent.idx)
+			swxCache.persistDelete(imsi)
 			return ent.ans
 		}
 		res := proto.Clone(ent.ans).(*protos.AuthenticationAnswer) // copy answer
@@ -103,8 +123,10 @@ func (swxCache *Impl) Get(imsi string, neededNumber int) *protos.AuthenticationA
 		ent.ans.SipAuthVectors = ent.ans.SipAuthVectors[neededNumber:]
 		ent.lastUsed = time.Now()
 		heap.Fix(&swxCache.data, ent.idx)
+		swxCache.persistSet(ent)
 		return res
 	}
+	metrics.SwxCacheMisses.Inc()
 	return nil
 }
 
@@ -116,6 +138,7 @@ func (swxCache *Impl) Remove(imsi string) *protos.AuthenticationAnswer {
 	if found {
 		delete(swxCache.data.vectors, imsi)
 		heap.Remove(&swxCache.data, ent.idx)
+		swxCache.persistDelete(imsi)
 		return ent.ans
 	}
 	return nil
@@ -142,6 +165,7 @@ func (swxCache *Impl) Put(ans *protos.AuthenticationAnswer, neededNumber int) *p
 	if len(ans.SipAuthVectors) <= neededNumber {
 		if found {
 			delete(swxCache.data.vectors, ans.UserName)
+			swxCache.persistDelete(ans.UserName)
 		}
 		return ans // only needed # of vectors, nothing to cache, just return it
 	}
@@ -153,6 +177,7 @@ func (swxCache *Impl) Put(ans *protos.AuthenticationAnswer, neededNumber int) *p
 	ent = &authEnt{lastUsed: time.Now(), ans: ans}
 	swxCache.data.vectors[ans.UserName] = ent
 	heap.Push(&swxCache.data, ent)
+	swxCache.persistSet(ent)
 	return res
 }
 
@@ -160,6 +185,9 @@ func (swxCache *Impl) Put(ans *protos.AuthenticationAnswer, neededNumber int) *p
 func (swxCache *Impl) ClearAll() {
 	swxCache.mu.Lock()
 	swxCache.data = storage{pq: []*authEnt{}, vectors: map[string]*authEnt{}}
+	if swxCache.store != nil {
+		swxCache.enqueue(persistOp{clearAll: true})
+	}
 	swxCache.mu.Unlock()
 }
 
@@ -181,8 +209,11 @@ func (swxCache *Impl) Gc(interval, ttl time.Duration) chan struct{} {
 				swxCache.mu.Lock()
 				// Cleanup all expired cache entries
 				for swxCache.data.Len() > 0 && swxCache.data.pq[0].lastUsed.Before(stale) {
-					delete(swxCache.data.vectors, swxCache.data.pq[0].ans.UserName)
+					imsi := swxCache.data.pq[0].ans.UserName
+					delete(swxCache.data.vectors, imsi)
 					heap.Pop(&swxCache.data)
+					swxCache.persistDelete(imsi)
+					metrics.SwxCacheEvictions.Inc()
 				}
 				swxCache.mu.Unlock()
 			}
@@ -78,6 +78,26 @@ var (
 		Name: "unauthorized_auth_requests_total",
 		Help: "Total number of authentication requests for un-authorized users",
 	})
+	SwxCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swx_cache_hits_total",
+		Help: "Total number of authentication requests served from the vector cache",
+	})
+	SwxCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swx_cache_misses_total",
+		Help: "Total number of authentication requests not found in the vector cache",
+	})
+	SwxCacheEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swx_cache_evictions_total",
+		Help: "Total number of expired vector cache entries removed by the garbage collector",
+	})
+	SwxCacheWarmUpEntries = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swx_cache_warm_up_entries_total",
+		Help: "Total number of vector cache entries restored from the persistent store",
+	})
+	SwxCachePersistenceFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swx_cache_persistence_failures_total",
+		Help: "Total number of vector cache updates that failed to reach the persistent store",
+	})
 
 	// Latency Metrics
 	MARLatency = prometheus.NewSummary(prometheus.SummaryOpts{
@@ -111,7 +131,9 @@ func init() {
 	prometheus.MustRegister(MARRequests, MARSendFailures, SARRequests,
 		SARSendFailures, SwxTimeouts, SwxUnparseableMsg, SwxInvalidSessions,
 		SwxResultCodes, SwxExperimentalResultCodes, UnauthorizedAuthAttempts,
-		MARLatency, SARLatency, AuthLatency, RegisterLatency, DeregisterLatency)
+		SwxCacheHits, SwxCacheMisses, SwxCacheEvictions, SwxCacheWarmUpEntries,
+		SwxCachePersistenceFailures, MARLatency, SARLatency, AuthLatency,
+		RegisterLatency, DeregisterLatency)
 }
 
 type SwxHealthMetrics struct {
@@ -56,7 +56,10 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 	} else if requestedVectors > MaxReturnedVectors {
 		requestedVectors = MaxReturnedVectors
 	}
-	if s.cache != nil {
+	// The cache is keyed by IMSI & holds EAP-AKA vectors only, EAP-AKA' vectors carry CK'/IK' instead of
+	// CK/IK and must never be served to EAP-AKA requests or vice versa
+	useCache := s.cache != nil && req.GetAuthenticationScheme() == protos.AuthenticationScheme_EAP_AKA
+	if useCache {
 		// Check if we still have valid vectors for the user in the cache
 		if len(req.GetResyncInfo()) == 0 { // Only try to get cached vectors if it's not resync request
 			cachedRes = s.cache.Get(res.UserName, requestedVectors)
@@ -125,7 +128,7 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 	}
 	res.SipAuthVectors = getSIPAuthenticationVectors(maa.SIPAuthDataItems)
 	// The only point when we cache vectors
-	if s.cache != nil {
+	if useCache {
 		if cachedRes != nil {
 			cacheVectors := len(cachedRes.SipAuthVectors)
 			requestedVectors -= cacheVectors
@@ -261,6 +264,10 @@ func (s *swxProxy) createMAR(sid string, req *protos.AuthenticationRequest) (*di
 		)
 	}
 	msg.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{AVP: authDataAvp})
+	// HSS binds CK'/IK' to the access network identity, 3GPP TS 29.273, 8.2.2.1
+	if req.GetAuthenticationScheme() == protos.AuthenticationScheme_EAP_AKA_PRIME {
+		msg.NewAVP(avp.ANID, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(req.GetAccessNetworkIdentity()))
+	}
 	return msg, nil
 }
 
@@ -318,6 +325,9 @@ func validateAuthRequest(req *protos.AuthenticationRequest) error {
 	if len(req.GetUserName()) > 15 {
 		return fmt.Errorf("provided username %s is greater than 15 digits", req.GetUserName())
 	}
+	if req.GetAuthenticationScheme() == protos.AuthenticationScheme_EAP_AKA_PRIME && len(req.GetAccessNetworkIdentity()) == 0 {
+		return fmt.Errorf("empty access network identity provided in EAP-AKA' authentication request")
+	}
 	return nil
 }
 
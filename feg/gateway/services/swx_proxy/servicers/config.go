@@ -92,6 +92,16 @@ func GetSwxProxyConfig() []*SwxProxyConfig {
 		ttl = uint32(cache.DefaultTtl.Seconds())
 	}
 
+	var cachePersistence *cache.PersistenceConfig
+	if p := configsPtr.GetCachePersistence(); p != nil {
+		cachePersistence = &cache.PersistenceConfig{
+			Backend:   p.GetBackend(),
+			FilePath:  p.GetFilePath(),
+			RedisHash: p.GetRedisHash(),
+			WarmUp:    p.GetWarmUp(),
+		}
+	}
+
 	swxConfigs := configsPtr.GetServers()
 	var diamServerConfigs []*SwxProxyConfig
 	for i, swxConfig := range swxConfigs {
@@ -114,11 +124,15 @@ func GetSwxProxyConfig() []*SwxProxyConfig {
 				DestRealm:         diameter.GetValueOrEnv(diameter.DestRealmFlag, HSSRealmEnv, swxConfig.GetDestRealm(), i),
 				DisableDestHost:   diameter.GetBoolValueOrEnv(diameter.DisableDestHostFlag, DisableDestHostEnv, swxConfig.GetDisableDestHost(), i),
 				OverwriteDestHost: diameter.GetBoolValueOrEnv(diameter.OverwriteDestHostFlag, OverwriteDestHostEnv, swxConfig.GetOverwriteDestHost(), i),
+				Priority:          swxConfig.GetPriority(),
+				Weight:            swxConfig.GetWeight(),
+				Peers:             diameter.GetPeerConfigs(swxConfig),
 			},
 			VerifyAuthorization:   configsPtr.GetVerifyAuthorization(),
 			RegisterOnAuth:        configsPtr.GetRegisterOnAuth(),
 			DeriveUnregisterRealm: configsPtr.GetDeriveUnregisterRealm(),
 			CacheTTLSeconds:       ttl,
+			CachePersistence:      cachePersistence,
 			HlrPlmnIds:            hlrPlmnIds,
 		}
 		diamServerConfigs = append(diamServerConfigs, diamSrvCfg)
@@ -39,6 +39,8 @@ import (
 type SwxProxiesWithHealth interface {
 	fegprotos.SwxProxyServer
 	fegprotos.ServiceHealthServer
+	// SyncCache writes all pending auth vector cache updates to the persistent store
+	SyncCache() error
 }
 
 type SwxProxies struct {
@@ -133,7 +135,7 @@ func (s *SwxProxies) Enable(ctx context.Context, req *orcprotos.Void) (*orcproto
 	errs := &multierror.Error{}
 	for i, proxy := range s.proxies {
 		proxy.connMan.Enable()
-		_, err := proxy.connMan.GetConnection(proxy.smClient, proxy.config.ServerCfg)
+		err := proxy.peers.Connect()
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("error(%d): %v", i+1, err))
 		}
@@ -155,6 +157,14 @@ func (s *SwxProxies) GetHealthStatus(ctx context.Context, req *orcprotos.Void) (
 	}, nil
 }
 
+// SyncCache syncs the auth vector cache shared by all Swx Proxies
+func (s *SwxProxies) SyncCache() error {
+	if len(s.proxies) == 0 {
+		return nil
+	}
+	return s.proxies[0].SyncCache()
+}
+
 // getProxyPerKey provides the proxy per a given IMSI
 func getProxyPerKey(imsi string, proxies []*swxProxy, mux multiplex.Multiplexor) (*swxProxy, error) {
 	index, err := mux.GetIndex(multiplex.NewContext().WithIMSI(imsi))
@@ -52,6 +52,7 @@ type MAR struct {
 	RATType             datatype.Enumerated         `avp:"RAT-Type"`
 	AuthData            SIPAuthDataItem             `avp:"SIP-Auth-Data-Item"`
 	NumberAuthItems     uint32                      `avp:"SIP-Number-Auth-Items"`
+	ANID                string                      `avp:"ANID"`
 }
 
 // 3GPP 29.273 8.2.2.1 - Multimedia Authentication Answer
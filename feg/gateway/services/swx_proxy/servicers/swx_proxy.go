@@ -51,6 +51,7 @@ type swxProxy struct {
 	config         *SwxProxyConfig
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
+	peers          *diameter.PeerTable
 	requestTracker *diameter.RequestTracker
 	originStateID  uint32
 	cache          *cache.Impl
@@ -65,6 +66,7 @@ type SwxProxyConfig struct {
 	RegisterOnAuth        bool // should we send SAR REGISTER on every MAR/A
 	DeriveUnregisterRealm bool // use returned maa.AAAServerName to derive Origin Realm from
 	CacheTTLSeconds       uint32
+	CachePersistence      *cache.PersistenceConfig // optional persistence of cached auth vectors
 	HlrPlmnIds            plmn_filter.PlmnIdVals
 }
 
@@ -124,13 +126,16 @@ func NewSwxProxyWithCache(config *SwxProxyConfig, cache *cache.Impl) (*swxProxy,
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, config.ServerCfg)
+	peers := diameter.NewPeerTable(
+		smClient, connMan, time.Second*time.Duration(config.ClientCfg.RequestTimeout), config.ServerCfg)
+	// create connections to all peers in connection map
+	peers.Connect()
 
 	proxy := &swxProxy{
 		config:         config,
 		smClient:       smClient,
 		connMan:        connMan,
+		peers:          peers,
 		healthTracker:  metrics.NewSwxHealthTracker(),
 		requestTracker: diameter.NewRequestTracker(),
 		originStateID:  originStateID,
@@ -139,10 +144,10 @@ func NewSwxProxyWithCache(config *SwxProxyConfig, cache *cache.Impl) (*swxProxy,
 	}
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.MultimediaAuthentication, Request: false},
-		handleMAA(proxy))
+		peers.HandleAnswers(handleMAA(proxy)))
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.ServerAssignment, Request: false},
-		handleSAA(proxy))
+		peers.HandleAnswers(handleSAA(proxy)))
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.RegistrationTermination, Request: true},
 		handleRTR(proxy))
@@ -234,10 +239,16 @@ func (s *swxProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*or
 // exists, Enable has no effect
 func (s *swxProxy) Enable(ctx context.Context, req *orcprotos.Void) (*orcprotos.Void, error) {
 	s.connMan.Enable()
-	_, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
+	err := s.peers.Connect()
 	return &orcprotos.Void{}, err
 }
 
+// SyncCache writes all pending auth vector cache updates to the persistent store,
+// it should be called on shutdown to not lose the most recently cached vectors
+func (s *swxProxy) SyncCache() error {
+	return s.cache.Sync()
+}
+
 // GetHealthStatus retrieves a health status object which contains the current
 // health of the service
 func (s *swxProxy) GetHealthStatus(ctx context.Context, req *orcprotos.Void) (*protos.HealthStatus, error) {
@@ -286,7 +297,16 @@ func (s *swxProxy) genSID(imsi string) string {
 // CreateCache creates a cache initialized with SWx config parameters
 func createCache(config *SwxProxyConfig) *cache.Impl {
 	fixConfigCacheMinTTL(config)
-	cch, _ := cache.NewExt(cache.DefaultGcInterval, time.Second*time.Duration(config.CacheTTLSeconds))
+	ttl := time.Second * time.Duration(config.CacheTTLSeconds)
+	store, err := cache.NewStore(config.CachePersistence)
+	if err != nil {
+		glog.Errorf("SWx cache persistence is disabled: %v", err)
+	}
+	if store == nil {
+		cch, _ := cache.NewExt(cache.DefaultGcInterval, ttl)
+		return cch
+	}
+	cch, _ := cache.NewPersistent(cache.DefaultGcInterval, ttl, store, config.CachePersistence.WarmUp)
 	return cch
 }
 
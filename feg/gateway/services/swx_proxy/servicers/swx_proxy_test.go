@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
 	"magma/feg/cloud/go/protos"
@@ -121,6 +122,14 @@ func TestSwxProxyService_ValidationErrors(t *testing.T) {
 	_, err = client.Authenticate(context.Background(), badUserNameReq)
 	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = SIPNumAuthVectors in authentication request must be greater than 0")
 
+	noANIDReq := &protos.AuthenticationRequest{
+		UserName:             "10111011000110",
+		AuthenticationScheme: protos.AuthenticationScheme_EAP_AKA_PRIME,
+		SipNumAuthVectors:    1,
+	}
+	_, err = client.Authenticate(context.Background(), noANIDReq)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = empty access network identity provided in EAP-AKA' authentication request")
+
 	// Test Register Error Handling
 	_, err = client.Register(context.Background(), nil)
 	assert.EqualError(t, err, "rpc error: code = Internal desc = grpc: error while marshaling: proto: Marshal called with nil")
@@ -136,6 +145,50 @@ func TestSwxProxyService_ValidationErrors(t *testing.T) {
 	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = Provided username 1234567890123456 is greater than 15 digits")
 }
 
+// TestSwxProxyService_MixedAuthSchemes verifies that cached EAP-AKA vectors are never returned
+// for EAP-AKA' requests of the same user & EAP-AKA' vectors don't replace cached EAP-AKA vectors
+func TestSwxProxyService_MixedAuthSchemes(t *testing.T) {
+	config := getSwxTestConfig(false)
+	addr := initSwxTestSetup(t, config)
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("GRPC connect error: %v", err)
+		return
+	}
+	defer conn.Close()
+	client := protos.NewSwxProxyClient(conn)
+
+	akaReq := &protos.AuthenticationRequest{
+		UserName:             test.BASE_IMSI + "77777",
+		SipNumAuthVectors:    1,
+		AuthenticationScheme: protos.AuthenticationScheme_EAP_AKA,
+	}
+	akaPrimeReq := &protos.AuthenticationRequest{
+		UserName:              akaReq.UserName,
+		SipNumAuthVectors:     1,
+		AuthenticationScheme:  protos.AuthenticationScheme_EAP_AKA_PRIME,
+		AccessNetworkIdentity: "WLAN",
+	}
+	expected := []struct {
+		req      *protos.AuthenticationRequest
+		randAutn int
+	}{
+		{akaReq, 14},      // MAR, remaining EAP-AKA vectors are cached
+		{akaPrimeReq, 14}, // MAR, cached EAP-AKA vectors must not be used
+		{akaReq, 15},      // cached EAP-AKA vector
+		{akaPrimeReq, 14}, // MAR, EAP-AKA' vectors are not cached
+		{akaReq, 16},      // cached EAP-AKA vector
+	}
+	for i, e := range expected {
+		authRes, err := client.Authenticate(context.Background(), e.req)
+		require.NoError(t, err, "request #%d", i)
+		require.Len(t, authRes.SipAuthVectors, 1, "request #%d", i)
+		v := authRes.SipAuthVectors[0]
+		assert.Equal(t, e.req.AuthenticationScheme, v.GetAuthenticationScheme(), "request #%d", i)
+		assert.Equal(t, []byte(test.DefaultSIPAuthenticate+strconv.Itoa(e.randAutn)), v.GetRandAutn(), "request #%d", i)
+	}
+}
+
 func swxStandardTest(t *testing.T, client protos.SwxProxyClient, test_loops int) {
 	complChan := make(chan error, test_loops+1)
 
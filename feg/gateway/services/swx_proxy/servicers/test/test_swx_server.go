@@ -107,6 +107,9 @@ func testHandleMAR(settings *sm.Settings) diam.HandlerFunc {
 		if err != nil {
 			fmt.Printf("MAR Unmarshal for message: %s failed: %s", m, err)
 			code = diam.UnableToComply
+		} else if req.AuthData.AuthScheme == swx.SipAuthScheme_EAP_AKA_PRIME && len(req.ANID) == 0 {
+			fmt.Printf("EAP-AKA' MAR without ANID: %s", m)
+			code = diam.MissingAVP
 		} else {
 			code = diam.Success
 		}
@@ -117,7 +120,7 @@ func testHandleMAR(settings *sm.Settings) diam.HandlerFunc {
 		a.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
 		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
 		a.NewAVP(avp.OriginStateID, avp.Mbit, 0, settings.OriginStateID)
-		_, err = testSendMAA(c, a, int(req.NumberAuthItems))
+		_, err = testSendMAA(c, a, int(req.NumberAuthItems), req.AuthData.AuthScheme)
 		if err != nil {
 			fmt.Printf("Failed to send MAA: %s", err.Error())
 		}
@@ -151,13 +154,16 @@ func testHandleSAR(settings *sm.Settings) diam.HandlerFunc {
 	}
 }
 
-// Send Multimedia Authentication Answer
-func testSendMAA(w io.Writer, m *diam.Message, vectors int) (n int64, err error) {
+// Send Multimedia Authentication Answer with vectors of the requested scheme
+func testSendMAA(w io.Writer, m *diam.Message, vectors int, scheme string) (n int64, err error) {
+	if len(scheme) == 0 {
+		scheme = swx.SipAuthScheme_EAP_AKA
+	}
 	m.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.Unsigned32(vectors))
 	for i := 0; i < vectors; i++ {
 		m.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, VENDOR_3GPP, &diam.GroupedAVP{
 			AVP: []*diam.AVP{
-				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.UTF8String("EAP-AKA")),
+				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.UTF8String(scheme)),
 				diam.NewAVP(avp.SIPAuthenticate, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.OctetString(DefaultSIPAuthenticate+strconv.Itoa(14+i))),
 				diam.NewAVP(avp.SIPAuthorization, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.OctetString(DefaultSIPAuthorization)),
 				diam.NewAVP(avp.ConfidentialityKey, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.OctetString(DefaultCK)),
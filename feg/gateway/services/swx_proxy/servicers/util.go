@@ -22,11 +22,7 @@ import (
 )
 
 func (s *swxProxy) sendDiameterMsg(msg *diam.Message, retryCount uint) error {
-	conn, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
-	if err != nil {
-		return err
-	}
-	err = conn.SendRequest(msg, retryCount)
+	err := s.peers.SendRequest(msg, retryCount)
 	if err != nil {
 		err = status.Errorf(codes.DataLoss, err.Error())
 	}
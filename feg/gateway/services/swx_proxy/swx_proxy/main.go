@@ -16,6 +16,9 @@ package main
 
 import (
 	"flag"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/golang/glog"
 
@@ -47,9 +50,22 @@ func main() {
 	protos.RegisterSwxProxyServer(srv.GrpcServer, servicer)
 	protos.RegisterServiceHealthServer(srv.GrpcServer, servicer)
 
+	// Stop serving on SIGTERM, the cache is synced once in-flight requests are done
+	sigtermChannel := make(chan os.Signal, 1)
+	signal.Notify(sigtermChannel, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigtermChannel
+		glog.Info("Received SIGTERM, stopping Swx Proxy service")
+		srv.GrpcServer.GracefulStop()
+		srv.ProtectedGrpcServer.GracefulStop()
+	}()
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error running service: %s", err)
 	}
+	if err = servicer.SyncCache(); err != nil {
+		glog.Errorf("Failed to sync SWx auth vector cache: %v", err)
+	}
 }
@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicers implements a mock CHF serving the Nchf_ConvergedCharging
+// API, for testing the N40 interface of n7_n40_proxy.
+package servicers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+
+	"magma/feg/gateway/sbi"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	sbi_Nchf "magma/feg/gateway/sbi/specs/TS32291NchfConvergedCharging"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
+)
+
+var BASE_PATH = "/nchf-convergedcharging/v3"
+
+type MockCHFServer struct {
+	// HTTP SBI server
+	*sbi.NotifierServer
+	// N40 server config
+	serverConfig *sbi.NotifierConfig
+	// Configuration of the quota grants
+	chfConfig *CHFConfig
+	// accounts stores the credit buckets of the subscribers with imsi as the key
+	accounts map[string]*subscriberAccount
+	// chargingSessions stores the charging data resources with chargingDataRef as the key
+	chargingSessions map[string]*chargingSession
+	mutex            sync.Mutex
+}
+
+// CHFConfig configures how the mock CHF grants quota
+type CHFConfig struct {
+	// MaxUsageBytes is the maximum volume granted per rating group and request.
+	// 0 means no limit other than the requested and remaining volume.
+	MaxUsageBytes uint64
+	// ValidityTime is the validity time in seconds of the granted units
+	ValidityTime uint32
+	// FinalUnitAction is the action sent along with the last units of a
+	// bucket, one of TERMINATE, REDIRECT or RESTRICT_ACCESS
+	FinalUnitAction string
+	// RedirectAddress is the redirect server URL for the REDIRECT final unit action
+	RedirectAddress string
+	// RestrictFilterId is the filter id for the RESTRICT_ACCESS final unit action
+	RestrictFilterId string
+}
+
+type subscriberAccount struct {
+	// buckets holds the remaining volume with the rating group as the key
+	buckets map[uint32]uint64
+}
+
+type chargingSession struct {
+	chargingDataRef string
+	imsi            string
+	notifyUri       string
+}
+
+func NewMockCHFServer(serverConfig *sbi.NotifierConfig) (*MockCHFServer, error) {
+	chfServer := &MockCHFServer{
+		serverConfig:     serverConfig,
+		chfConfig:        &CHFConfig{FinalUnitAction: "TERMINATE"},
+		accounts:         map[string]*subscriberAccount{},
+		chargingSessions: map[string]*chargingSession{},
+	}
+	chfServer.NotifierServer = sbi.NewNotifierServer(*serverConfig)
+	chargingDataPath := path.Join(BASE_PATH, "chargingdata")
+	chfServer.Server.POST(chargingDataPath, chfServer.PostChargingData)
+	chfServer.Server.POST(path.Join(chargingDataPath, ":chargingDataRef", "update"), chfServer.PostChargingDataUpdate)
+	chfServer.Server.POST(path.Join(chargingDataPath, ":chargingDataRef", "release"), chfServer.PostChargingDataRelease)
+
+	err := chfServer.NotifierServer.Start()
+	if err != nil {
+		return nil, err
+	}
+	return chfServer, nil
+}
+
+// SetCHFConfig sets how quota is granted
+func (srv *MockCHFServer) SetCHFConfig(config *CHFConfig) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	srv.chfConfig = config
+}
+
+// CreateAccount adds a subscriber without any credit
+func (srv *MockCHFServer) CreateAccount(imsi string) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	srv.accounts[imsi] = &subscriberAccount{buckets: map[uint32]uint64{}}
+	glog.V(2).Infof("New account %s added", imsi)
+}
+
+// SetCredit sets the remaining volume of a subscriber for a rating group
+func (srv *MockCHFServer) SetCredit(imsi string, ratingGroup uint32, volume uint64) error {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	account, found := srv.accounts[imsi]
+	if !found {
+		return fmt.Errorf("subscriber account %s not found", imsi)
+	}
+	account.buckets[ratingGroup] = volume
+	return nil
+}
+
+// GetCredit returns the remaining volume of a subscriber for a rating group
+func (srv *MockCHFServer) GetCredit(imsi string, ratingGroup uint32) (uint64, error) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	account, found := srv.accounts[imsi]
+	if !found {
+		return 0, fmt.Errorf("subscriber account %s not found", imsi)
+	}
+	volume, found := account.buckets[ratingGroup]
+	if !found {
+		return 0, fmt.Errorf("rating group %d not found for %s", ratingGroup, imsi)
+	}
+	return volume, nil
+}
+
+// ClearSubscribers removes all the accounts and charging sessions
+func (srv *MockCHFServer) ClearSubscribers() {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	srv.accounts = map[string]*subscriberAccount{}
+	srv.chargingSessions = map[string]*chargingSession{}
+	glog.V(2).Info("All accounts deleted.")
+}
+
+// ReauthorizeSession sends a REAUTHORIZATION notification for the charging
+// session of a subscriber. Without ratingGroups, the whole session is
+// reauthorized. Returns the HTTP status code of the notification.
+func (srv *MockCHFServer) ReauthorizeSession(imsi string, ratingGroups ...uint32) (int, error) {
+	notification := sbi_Nchf.ChargingNotifyRequest{NotificationType: n40.NotificationTypeReauthorization}
+	if len(ratingGroups) > 0 {
+		details := make([]sbi_Nchf.ReauthorizationDetails, 0, len(ratingGroups))
+		for _, rg := range ratingGroups {
+			ratingGroup := sbi_CommonData.RatingGroup(rg)
+			details = append(details, sbi_Nchf.ReauthorizationDetails{RatingGroup: &ratingGroup})
+		}
+		notification.ReauthorizationDetails = &details
+	}
+	return srv.notify(imsi, &notification)
+}
+
+// AbortCharging sends an ABORT_CHARGING notification for the charging
+// session of a subscriber. Returns the HTTP status code of the notification.
+func (srv *MockCHFServer) AbortCharging(imsi string) (int, error) {
+	return srv.notify(imsi, &sbi_Nchf.ChargingNotifyRequest{NotificationType: n40.NotificationTypeAbortCharging})
+}
+
+func (srv *MockCHFServer) notify(imsi string, notification *sbi_Nchf.ChargingNotifyRequest) (int, error) {
+	sess, err := srv.getChargingSessionByImsi(imsi)
+	if err != nil {
+		return 0, err
+	}
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := http.Post(sess.notifyUri, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error sending charging notification to %s: %s", sess.notifyUri, err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func (srv *MockCHFServer) getChargingSessionByImsi(imsi string) (*chargingSession, error) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	for _, sess := range srv.chargingSessions {
+		if sess.imsi == imsi {
+			return sess, nil
+		}
+	}
+	return nil, fmt.Errorf("charging session not found for imsi %s", imsi)
+}
+
+func (srv *MockCHFServer) createChargingSession(imsi string, notifyUri string) string {
+	chargingDataRef := uuid.New().String()
+	srv.chargingSessions[chargingDataRef] = &chargingSession{
+		chargingDataRef: chargingDataRef,
+		imsi:            imsi,
+		notifyUri:       notifyUri,
+	}
+	return chargingDataRef
+}
+
+func (srv *MockCHFServer) getChargingDataUrl(chargingDataRef string) string {
+	return fmt.Sprintf("%s/chargingdata/%s", srv.serverConfig.NotifyApiRoot, chargingDataRef)
+}
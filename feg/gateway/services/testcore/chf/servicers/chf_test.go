@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/sbi"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	sbi_Nchf "magma/feg/gateway/sbi/specs/TS32291NchfConvergedCharging"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
+	relay_mocks "magma/feg/gateway/services/session_proxy/relay/mocks"
+	"magma/feg/gateway/services/testcore/chf/servicers"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	IMSI1            = "123456789012345"
+	SESSION_ID1      = "IMSI" + IMSI1 + "-1234"
+	REDIRECT_ADDRESS = "http://portal.magma.test"
+)
+
+func TestMockCHFQuotaGrants(t *testing.T) {
+	chf, chargingDataCli := startMockCHF(t)
+	defer chf.NotifierServer.Stop()
+	chf.CreateAccount(IMSI1)
+	require.NoError(t, chf.SetCredit(IMSI1, 1, 5000))
+	chf.SetCHFConfig(&servicers.CHFConfig{
+		MaxUsageBytes:   2000,
+		ValidityTime:    30,
+		FinalUnitAction: "REDIRECT",
+		RedirectAddress: REDIRECT_ADDRESS,
+	})
+
+	resp, location, err := chargingDataCli.PostChargingData(context.Background(),
+		chargingDataRequest(0, map[uint32]uint64{1: 0, 2: 0}))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(*resp.MultipleUnitInformation))
+	unitInfo := (*resp.MultipleUnitInformation)[0]
+	assert.Equal(t, sbi_CommonData.Uint64(2000), *unitInfo.GrantedUnit.TotalVolume)
+	assert.Equal(t, sbi_CommonData.DurationSec(30), *unitInfo.ValidityTime)
+	assert.Nil(t, unitInfo.FinalUnitIndication)
+	assert.Equal(t, "RATING_FAILED", *(*resp.MultipleUnitInformation)[1].ResultCode)
+
+	chargingDataRef, err := n40.GetChargingDataRef(&protos.TgppContext{GyDestHost: location})
+	require.NoError(t, err)
+
+	// 2000 used, the remaining 3000 are capped to 2000
+	resp, err = chargingDataCli.PostChargingDataUpdate(context.Background(), chargingDataRef,
+		chargingDataRequest(1, map[uint32]uint64{1: 2000}))
+	require.NoError(t, err)
+	unitInfo = (*resp.MultipleUnitInformation)[0]
+	assert.Equal(t, sbi_CommonData.Uint64(2000), *unitInfo.GrantedUnit.TotalVolume)
+	assert.Nil(t, unitInfo.FinalUnitIndication)
+
+	// 2000 used, the last 1000 are final units
+	resp, err = chargingDataCli.PostChargingDataUpdate(context.Background(), chargingDataRef,
+		chargingDataRequest(2, map[uint32]uint64{1: 2000}))
+	require.NoError(t, err)
+	unitInfo = (*resp.MultipleUnitInformation)[0]
+	assert.Equal(t, sbi_CommonData.Uint64(1000), *unitInfo.GrantedUnit.TotalVolume)
+	require.NotNil(t, unitInfo.FinalUnitIndication)
+	assert.Equal(t, "REDIRECT", unitInfo.FinalUnitIndication.FinalUnitAction)
+	assert.Equal(t, REDIRECT_ADDRESS, unitInfo.FinalUnitIndication.RedirectServer.RedirectServerAddress)
+
+	// Bucket is empty
+	resp, err = chargingDataCli.PostChargingDataUpdate(context.Background(), chargingDataRef,
+		chargingDataRequest(3, map[uint32]uint64{1: 1000}))
+	require.NoError(t, err)
+	assert.Equal(t, "QUOTA_LIMIT_REACHED", *(*resp.MultipleUnitInformation)[0].ResultCode)
+
+	require.NoError(t, chargingDataCli.PostChargingDataRelease(context.Background(), chargingDataRef,
+		chargingDataRequest(4, nil)))
+	_, err = chargingDataCli.PostChargingDataUpdate(context.Background(), chargingDataRef,
+		chargingDataRequest(5, map[uint32]uint64{1: 0}))
+	assert.Error(t, err)
+}
+
+func TestMockCHFUnknownSubscriber(t *testing.T) {
+	chf, chargingDataCli := startMockCHF(t)
+	defer chf.NotifierServer.Stop()
+
+	_, _, err := chargingDataCli.PostChargingData(context.Background(),
+		chargingDataRequest(0, map[uint32]uint64{1: 0}))
+	assert.Error(t, err)
+}
+
+func TestMockCHFReauthorization(t *testing.T) {
+	chf, chargingDataCli := startMockCHF(t)
+	defer chf.NotifierServer.Stop()
+	chf.CreateAccount(IMSI1)
+	require.NoError(t, chf.SetCredit(IMSI1, 1, 5000))
+
+	sm, cloudRegistry := relay_mocks.StartMockSessionProxyResponder(t)
+	n40Cli, err := n40.NewN40ClientWithHandlers(&n40.N40Config{
+		ClientConfig: sbi.NotifierConfig{
+			LocalAddr:     "127.0.0.1:0",
+			NotifyApiRoot: "http://localhost/nchf-convergedcharging/v3/notify",
+		},
+	}, cloudRegistry)
+	require.NoError(t, err)
+	defer n40Cli.NotifyServer.Stop()
+	notifyAddr, err := n40Cli.NotifyServer.Server.GetListenerAddr()
+	require.NoError(t, err)
+
+	// Sessions are created with the notifyUri of the local N40 client
+	request := chargingDataRequest(0, map[uint32]uint64{1: 0})
+	notifyUri := n40.GenNotifyUrl(
+		fmt.Sprintf("http://%s/nchf-convergedcharging/v3/notify", notifyAddr.String()), SESSION_ID1)
+	request.NotifyUri = &notifyUri
+	_, _, err = chargingDataCli.PostChargingData(context.Background(), request)
+	require.NoError(t, err)
+
+	sm.On("ChargingReAuth", mock.Anything, &protos.ChargingReAuthRequest{
+		SessionId:   SESSION_ID1,
+		Sid:         "IMSI" + IMSI1,
+		Type:        protos.ChargingReAuthRequest_SINGLE_SERVICE,
+		ChargingKey: 1,
+	}).Return(&protos.ChargingReAuthAnswer{Result: protos.ReAuthResult_UPDATE_INITIATED}, nil).Once()
+	status, err := chf.ReauthorizeSession(IMSI1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	sm.AssertExpectations(t)
+
+	_, err = chf.ReauthorizeSession("001010000000001")
+	assert.Error(t, err)
+}
+
+func startMockCHF(t *testing.T) (*servicers.MockCHFServer, n40.ChargingDataClient) {
+	chf, err := servicers.NewMockCHFServer(&sbi.NotifierConfig{
+		LocalAddr:     "127.0.0.1:0",
+		NotifyApiRoot: "http://mockchf" + servicers.BASE_PATH,
+	})
+	require.NoError(t, err)
+	chfAddr, err := chf.Server.GetListenerAddr()
+	require.NoError(t, err)
+	chargingDataCli := n40.NewChargingDataClient(
+		fmt.Sprintf("http://%s%s", chfAddr.String(), servicers.BASE_PATH), &http.Client{})
+	return chf, chargingDataCli
+}
+
+// chargingDataRequest reports usedUnits for each rating group in usage, and
+// requests new units for them
+func chargingDataRequest(seq uint32, usage map[uint32]uint64) *sbi_Nchf.ChargingDataRequest {
+	supi := sbi_CommonData.Supi(IMSI1)
+	units := []sbi_Nchf.MultipleUnitUsage{}
+	for _, rg := range []uint32{1, 2} {
+		used, found := usage[rg]
+		if !found {
+			continue
+		}
+		total := sbi_CommonData.Uint64(used)
+		units = append(units, sbi_Nchf.MultipleUnitUsage{
+			RatingGroup:       sbi_CommonData.RatingGroup(rg),
+			RequestedUnit:     &sbi_Nchf.RequestedUnit{},
+			UsedUnitContainer: &[]sbi_Nchf.UsedUnitContainer{{TotalVolume: &total}},
+		})
+	}
+	return &sbi_Nchf.ChargingDataRequest{
+		InvocationSequenceNumber: sbi_CommonData.Uint32(seq),
+		SubscriberIdentifier:     &supi,
+		MultipleUnitUsage:        &units,
+	}
+}
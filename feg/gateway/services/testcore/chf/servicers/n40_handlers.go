@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/labstack/echo/v4"
+
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	sbi_Nchf "magma/feg/gateway/sbi/specs/TS32291NchfConvergedCharging"
+	"magma/feg/gateway/services/n7_n40_proxy/n40"
+)
+
+const (
+	resultCodeQuotaLimitReached = "QUOTA_LIMIT_REACHED"
+	resultCodeRatingFailed      = "RATING_FAILED"
+	resultCodeUserUnknown       = "USER_UNKNOWN"
+)
+
+// PostChargingData handles POST /chargingdata
+func (srv *MockCHFServer) PostChargingData(ctx echo.Context) error {
+	request, err := bindChargingDataRequest(ctx)
+	if err != nil {
+		glog.Errorf("PostChargingData error binding the request body: %s", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	if request.SubscriberIdentifier == nil {
+		glog.Errorf("PostChargingData missing subscriberIdentifier")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	imsi := string(*request.SubscriberIdentifier)
+	account, found := srv.accounts[imsi]
+	if !found {
+		glog.Errorf("PostChargingData unable to fetch account %s", imsi)
+		return srv.sendChargingDataResponse(ctx, http.StatusNotFound, &sbi_Nchf.ChargingDataResponse{
+			InvocationSequenceNumber: request.InvocationSequenceNumber,
+			MultipleUnitInformation:  srv.getRejectedUnits(request, resultCodeUserUnknown),
+		})
+	}
+	notifyUri := ""
+	if request.NotifyUri != nil {
+		notifyUri = string(*request.NotifyUri)
+	}
+	chargingDataRef := srv.createChargingSession(imsi, notifyUri)
+	ctx.Response().Header()["Location"] = []string{srv.getChargingDataUrl(chargingDataRef)}
+	return srv.sendChargingDataResponse(ctx, http.StatusCreated, &sbi_Nchf.ChargingDataResponse{
+		InvocationSequenceNumber: request.InvocationSequenceNumber,
+		MultipleUnitInformation:  srv.getUnitInformation(account, request),
+	})
+}
+
+// PostChargingDataUpdate handles POST /chargingdata/{ChargingDataRef}/update
+func (srv *MockCHFServer) PostChargingDataUpdate(ctx echo.Context) error {
+	request, err := bindChargingDataRequest(ctx)
+	if err != nil {
+		glog.Errorf("PostChargingDataUpdate error binding the request body: %s", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	account, found := srv.getAccountForChargingData(ctx.Param("chargingDataRef"))
+	if !found {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	return srv.sendChargingDataResponse(ctx, http.StatusOK, &sbi_Nchf.ChargingDataResponse{
+		InvocationSequenceNumber: request.InvocationSequenceNumber,
+		MultipleUnitInformation:  srv.getUnitInformation(account, request),
+	})
+}
+
+// PostChargingDataRelease handles POST /chargingdata/{ChargingDataRef}/release
+func (srv *MockCHFServer) PostChargingDataRelease(ctx echo.Context) error {
+	request, err := bindChargingDataRequest(ctx)
+	if err != nil {
+		glog.Errorf("PostChargingDataRelease error binding the request body: %s", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	chargingDataRef := ctx.Param("chargingDataRef")
+	account, found := srv.getAccountForChargingData(chargingDataRef)
+	if !found {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	for _, unitUsage := range getMultipleUnitUsage(request) {
+		debitUsedUnits(account, &unitUsage)
+	}
+	delete(srv.chargingSessions, chargingDataRef)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (srv *MockCHFServer) getAccountForChargingData(chargingDataRef string) (*subscriberAccount, bool) {
+	sess, found := srv.chargingSessions[chargingDataRef]
+	if !found {
+		glog.Errorf("Charging session for %s not found", chargingDataRef)
+		return nil, false
+	}
+	account, found := srv.accounts[sess.imsi]
+	if !found {
+		glog.Errorf("Subscriber account %s for charging session %s not found", sess.imsi, chargingDataRef)
+	}
+	return account, found
+}
+
+// getUnitInformation debits the used units reported for each rating group
+// and grants new units for the rating groups requesting them
+func (srv *MockCHFServer) getUnitInformation(
+	account *subscriberAccount,
+	request *sbi_Nchf.ChargingDataRequest,
+) *[]sbi_Nchf.MultipleUnitInformation {
+	unitInfos := []sbi_Nchf.MultipleUnitInformation{}
+	for _, unitUsage := range getMultipleUnitUsage(request) {
+		debitUsedUnits(account, &unitUsage)
+		if unitUsage.RequestedUnit == nil {
+			continue
+		}
+		unitInfos = append(unitInfos, srv.grantUnits(account, &unitUsage))
+	}
+	return &unitInfos
+}
+
+func (srv *MockCHFServer) grantUnits(
+	account *subscriberAccount,
+	unitUsage *sbi_Nchf.MultipleUnitUsage,
+) sbi_Nchf.MultipleUnitInformation {
+	unitInfo := sbi_Nchf.MultipleUnitInformation{RatingGroup: unitUsage.RatingGroup}
+	remaining, found := account.buckets[uint32(unitUsage.RatingGroup)]
+	if !found {
+		var resultCode sbi_Nchf.ResultCode = resultCodeRatingFailed
+		unitInfo.ResultCode = &resultCode
+		return unitInfo
+	}
+	if remaining == 0 {
+		var resultCode sbi_Nchf.ResultCode = resultCodeQuotaLimitReached
+		unitInfo.ResultCode = &resultCode
+		return unitInfo
+	}
+	grant := remaining
+	if unitUsage.RequestedUnit.TotalVolume != nil && uint64(*unitUsage.RequestedUnit.TotalVolume) < grant {
+		grant = uint64(*unitUsage.RequestedUnit.TotalVolume)
+	}
+	if srv.chfConfig.MaxUsageBytes != 0 && srv.chfConfig.MaxUsageBytes < grant {
+		grant = srv.chfConfig.MaxUsageBytes
+	}
+	grantedVolume := sbi_CommonData.Uint64(grant)
+	unitInfo.GrantedUnit = &sbi_Nchf.GrantedUnit{TotalVolume: &grantedVolume}
+	if srv.chfConfig.ValidityTime != 0 {
+		validityTime := sbi_CommonData.DurationSec(srv.chfConfig.ValidityTime)
+		unitInfo.ValidityTime = &validityTime
+	}
+	if grant == remaining {
+		unitInfo.FinalUnitIndication = srv.getFinalUnitIndication()
+	}
+	return unitInfo
+}
+
+func (srv *MockCHFServer) getFinalUnitIndication() *sbi_Nchf.FinalUnitIndication {
+	fui := &sbi_Nchf.FinalUnitIndication{FinalUnitAction: srv.chfConfig.FinalUnitAction}
+	switch srv.chfConfig.FinalUnitAction {
+	case "REDIRECT":
+		fui.RedirectServer = &sbi_Nchf.RedirectServer{
+			RedirectAddressType:   "URL",
+			RedirectServerAddress: srv.chfConfig.RedirectAddress,
+		}
+	case "RESTRICT_ACCESS":
+		filterId := srv.chfConfig.RestrictFilterId
+		fui.FilterId = &filterId
+	}
+	return fui
+}
+
+func (srv *MockCHFServer) getRejectedUnits(
+	request *sbi_Nchf.ChargingDataRequest,
+	resultCode sbi_Nchf.ResultCode,
+) *[]sbi_Nchf.MultipleUnitInformation {
+	unitInfos := []sbi_Nchf.MultipleUnitInformation{}
+	for _, unitUsage := range getMultipleUnitUsage(request) {
+		unitInfos = append(unitInfos, sbi_Nchf.MultipleUnitInformation{
+			RatingGroup: unitUsage.RatingGroup,
+			ResultCode:  &resultCode,
+		})
+	}
+	return &unitInfos
+}
+
+func debitUsedUnits(account *subscriberAccount, unitUsage *sbi_Nchf.MultipleUnitUsage) {
+	if unitUsage.UsedUnitContainer == nil {
+		return
+	}
+	ratingGroup := uint32(unitUsage.RatingGroup)
+	remaining, found := account.buckets[ratingGroup]
+	if !found {
+		return
+	}
+	for _, container := range *unitUsage.UsedUnitContainer {
+		if container.TotalVolume == nil {
+			continue
+		}
+		used := uint64(*container.TotalVolume)
+		if used > remaining {
+			used = remaining
+		}
+		remaining -= used
+	}
+	account.buckets[ratingGroup] = remaining
+}
+
+func getMultipleUnitUsage(request *sbi_Nchf.ChargingDataRequest) []sbi_Nchf.MultipleUnitUsage {
+	if request.MultipleUnitUsage == nil {
+		return nil
+	}
+	return *request.MultipleUnitUsage
+}
+
+func bindChargingDataRequest(ctx echo.Context) (*sbi_Nchf.ChargingDataRequest, error) {
+	body, err := ioutil.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return nil, err
+	}
+	return n40.UnmarshalChargingDataRequest(body)
+}
+
+func (srv *MockCHFServer) sendChargingDataResponse(
+	ctx echo.Context,
+	statusCode int,
+	response *sbi_Nchf.ChargingDataResponse,
+) error {
+	body, err := n40.MarshalChargingDataResponse(response)
+	if err != nil {
+		glog.Errorf("Error encoding ChargingDataResponse: %s", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	return ctx.JSONBlob(statusCode, body)
+}
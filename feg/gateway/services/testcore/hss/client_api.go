@@ -158,6 +158,43 @@ func DeregisterSubscriber(id string) error {
 	return err
 }
 
+// InsertSubscriberData sends the subscriber's profile to the serving MME (S6a IDR).
+// If the subscriber is not found or isn't served by any MME, an error is returned instead.
+// Input: The id of the subscriber to be updated.
+func InsertSubscriberData(id string) error {
+	err := verifyID(id)
+	if err != nil {
+		errMsg := fmt.Errorf("Invalid InsertSubscriberDataRequest provided: %s", err)
+		return errors.New(errMsg.Error())
+	}
+	cli, err := getHSSClient()
+	if err != nil {
+		return err
+	}
+	subID := &lteprotos.SubscriberID{
+		Id: id,
+	}
+	_, err = cli.InsertSubscriberData(context.Background(), subID)
+	return err
+}
+
+// DeleteSubscriberData withdraws subscription data from the serving MME (S6a DSR).
+// If the subscriber is not found or isn't served by any MME, an error is returned instead.
+// Input: The id of the subscriber and the subscription data to withdraw.
+func DeleteSubscriberData(req *fegprotos.DeleteSubscriberDataRequest) error {
+	err := verifyID(req.GetUserName())
+	if err != nil {
+		errMsg := fmt.Errorf("Invalid DeleteSubscriberDataRequest provided: %s", err)
+		return errors.New(errMsg.Error())
+	}
+	cli, err := getHSSClient()
+	if err != nil {
+		return err
+	}
+	_, err = cli.DeleteSubscriberData(context.Background(), req)
+	return err
+}
+
 func VerifySubscriberData(sub *lteprotos.SubscriberData) error {
 	if sub == nil {
 		return fmt.Errorf("subscriber is nil")
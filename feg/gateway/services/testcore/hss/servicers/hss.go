@@ -16,6 +16,7 @@ package servicers
 import (
 	"context"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/emakeev/milenage"
@@ -25,8 +26,10 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/dict"
 	"github.com/fiorix/go-diameter/v4/diam/sm"
 
+	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/orc8r/lib/go/protos"
@@ -48,6 +51,11 @@ type HomeSubscriberServer struct {
 	requestTracker *diameter.RequestTracker
 	clientMapping  map[string]string
 
+	// servingMMEs maps subscriber IDs to the Origin-Host of the MME which
+	// last updated their location, it's the destination of IDR & DSR
+	servingMMEs   map[string]string
+	servingMMEsMu sync.RWMutex
+
 	// authSqnInd is an index used in the array scheme described by 3GPP TS 33.102 Appendix C.1.2 and C.2.2.
 	// SQN consists of two parts (SQN = SEQ||IND).
 	AuthSqnInd uint64
@@ -67,6 +75,7 @@ func NewHomeSubscriberServer(store storage.SubscriberStore, config *mconfig.HSSC
 		requestTracker: diameter.NewRequestTracker(),
 		connMan:        diameter.NewConnectionManager(),
 		clientMapping:  map[string]string{},
+		servingMMEs:    map[string]string{},
 	}, nil
 }
 
@@ -132,6 +141,29 @@ func (srv *HomeSubscriberServer) DeregisterSubscriber(ctx context.Context, req *
 	return &protos.Void{}, srv.TerminateRegistration(sub)
 }
 
+// InsertSubscriberData sends the subscriber's profile to the MME serving the subscriber in an IDR.
+// If the subscriber is not found or isn't served by any MME, an error is returned instead.
+// Input: The id of the subscriber to be updated.
+func (srv *HomeSubscriberServer) InsertSubscriberData(ctx context.Context, req *lteprotos.SubscriberID) (*protos.Void, error) {
+	log.Println("Received InsertSubscriberData")
+	sub, err := srv.store.GetSubscriberData(req.Id)
+	if err != nil {
+		return &protos.Void{}, storage.ConvertStorageErrorToGrpcStatus(err)
+	}
+	return &protos.Void{}, srv.SendInsertSubscriberData(sub)
+}
+
+// DeleteSubscriberData withdraws subscription data from the MME serving the subscriber in a DSR.
+// If the subscriber is not found or isn't served by any MME, an error is returned instead.
+// Input: The id of the subscriber and the subscription data to withdraw.
+func (srv *HomeSubscriberServer) DeleteSubscriberData(ctx context.Context, req *fegprotos.DeleteSubscriberDataRequest) (*protos.Void, error) {
+	log.Println("Received DeleteSubscriberData")
+	if _, err := srv.store.GetSubscriberData(req.GetUserName()); err != nil {
+		return &protos.Void{}, storage.ConvertStorageErrorToGrpcStatus(err)
+	}
+	return &protos.Void{}, srv.SendDeleteSubscriberData(req)
+}
+
 // Start begins the server and blocks, listening to the network
 // Input: a channel to signal when the server is started & return the local server address string
 // Output: error if the server could not be started
@@ -152,9 +184,16 @@ func (srv *HomeSubscriberServer) Start(started chan string) error {
 	mux.Handle(diam.ULR, srv.handleMessage(NewULA))
 	mux.Handle(diam.MAR, srv.handleMessage(NewMAA))
 	mux.Handle(diam.SAR, srv.handleMessage(NewSAA))
+	mux.Handle(diam.NOR, srv.handleMessage(NewNOA))
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.RegistrationTermination, Request: false},
 		handleRTA(srv))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.InsertSubscriberData, Request: false},
+		handleIDA(srv))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.DeleteSubscriberData, Request: false},
+		handleDSA(srv))
 
 	clientCfg := diameter.DiameterClientConfig{}
 	clientCfg.FillInDefaults()
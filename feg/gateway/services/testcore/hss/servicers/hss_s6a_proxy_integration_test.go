@@ -24,7 +24,9 @@ import (
 	"magma/feg/gateway/diameter"
 	"magma/feg/gateway/plmn_filter"
 	"magma/feg/gateway/services/s6a_proxy/servicers"
+	hss "magma/feg/gateway/services/testcore/hss/servicers"
 	"magma/feg/gateway/services/testcore/hss/servicers/test_utils"
+	lteprotos "magma/lte/cloud/go/protos"
 )
 
 func TestAIR_Successful(t *testing.T) {
@@ -167,11 +169,64 @@ func TestULR_UnknownIMSI(t *testing.T) {
 	assert.Equal(t, 0, len(ula.Apn))
 }
 
+func TestNOR_Successful(t *testing.T) {
+	s6aProxy := getTestS6aProxy(t, []string{})
+	ula, err := s6aProxy.UpdateLocation(context.Background(), &protos.UpdateLocationRequest{
+		UserName:    "sub1",
+		VisitedPlmn: []byte{0, 0, 0},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, protos.ErrorCode_UNDEFINED, ula.ErrorCode)
+
+	noa, err := s6aProxy.Notify(context.Background(), &protos.NotifyRequest{
+		UserName:           "sub1",
+		PgwAddress:         "192.168.128.1",
+		ContextId:          1,
+		ServiceSelection:   "oai.ipv4",
+		UeReachableFromMme: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, protos.ErrorCode_SUCCESS, noa.ErrorCode)
+}
+
+func TestNOR_UnknownIMSI(t *testing.T) {
+	s6aProxy := getTestS6aProxy(t, []string{})
+	noa, err := s6aProxy.Notify(context.Background(), &protos.NotifyRequest{UserName: "sub_unknown"})
+	assert.NoError(t, err)
+	assert.Equal(t, protos.ErrorCode_USER_UNKNOWN, noa.ErrorCode)
+}
+
+func TestNOR_UnknownServingNode(t *testing.T) {
+	s6aProxy := getTestS6aProxy(t, []string{})
+	noa, err := s6aProxy.Notify(context.Background(), &protos.NotifyRequest{UserName: "sub1"})
+	assert.NoError(t, err)
+	assert.Equal(t, protos.ErrorCode_UNKNOWN_SERVING_NODE, noa.ErrorCode)
+}
+
+func TestIDR_DSR_NoServingMME(t *testing.T) {
+	hss, _ := getTestHSSAndS6aProxy(t, []string{})
+	sub, err := hss.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+
+	err = hss.SendInsertSubscriberData(sub)
+	assert.EqualError(t, err, "InsertSubscriberData error: no MME found for subscriber: sub1")
+
+	err = hss.SendDeleteSubscriberData(&protos.DeleteSubscriberDataRequest{UserName: "sub1"})
+	assert.EqualError(t, err, "DeleteSubscriberData error: no MME found for subscriber: sub1")
+}
+
 // getTestS6aProxy creates a s6a proxy server and test hss diameter
 // server which are configured to communicate with each other.
 func getTestS6aProxy(t *testing.T, plmns []string) protos.S6AProxyServer {
-	hss := getTestHSSDiameterServer(t)
-	serverCfg := hss.Config.Server
+	_, s6aProxy := getTestHSSAndS6aProxy(t, plmns)
+	return s6aProxy
+}
+
+// getTestHSSAndS6aProxy creates a s6a proxy server and test hss diameter
+// server which are configured to communicate with each other and returns both.
+func getTestHSSAndS6aProxy(t *testing.T, plmns []string) (*hss.HomeSubscriberServer, protos.S6AProxyServer) {
+	hssSrv := getTestHSSDiameterServer(t)
+	serverCfg := hssSrv.Config.Server
 
 	// Create an s6a proxy server and client configuration
 	config := &servicers.S6aProxyConfig{
@@ -200,5 +255,5 @@ func getTestS6aProxy(t *testing.T, plmns []string) protos.S6AProxyServer {
 	s6aProxy, err := servicers.NewS6aProxy(config)
 	assert.NoError(t, err)
 
-	return s6aProxy
+	return hssSrv, s6aProxy
 }
@@ -38,7 +38,7 @@ func (srv *HomeSubscriberServer) TerminateRegistration(sub *protos.SubscriberDat
 	if sub.GetState().GetTgppAaaServerName() == "" {
 		return fmt.Errorf("No AAA server found for subscriber: %s. Cannot send RTR", sub.GetSid().GetId())
 	}
-	aaaServerCfg, err := srv.genAAAServerConfig(sub.GetState().GetTgppAaaServerName())
+	aaaServerCfg, err := srv.genPeerServerConfig(sub.GetState().GetTgppAaaServerName())
 	if err != nil {
 		return fmt.Errorf("TerminateRegistration error: %s", err)
 	}
@@ -127,7 +127,7 @@ func (srv *HomeSubscriberServer) deregisterSubscriber(subscriber *protos.Subscri
 	return srv.store.UpdateSubscriber(&protos.SubscriberUpdate{Data: subscriber})
 }
 
-func (srv *HomeSubscriberServer) genAAAServerConfig(serverName string) (*diameter.DiameterServerConfig, error) {
+func (srv *HomeSubscriberServer) genPeerServerConfig(serverName string) (*diameter.DiameterServerConfig, error) {
 	var destRealm string
 	splitServerName := strings.Split(serverName, ".")
 	if len(splitServerName) < 2 {
@@ -137,7 +137,7 @@ func (srv *HomeSubscriberServer) genAAAServerConfig(serverName string) (*diamete
 	}
 	addr, ok := srv.clientMapping[serverName]
 	if !ok {
-		return nil, fmt.Errorf("could not find IP address for AAA server: %s", serverName)
+		return nil, fmt.Errorf("could not find IP address for diameter peer: %s", serverName)
 	}
 	return &diameter.DiameterServerConfig{
 		DestHost:  serverName,
@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+	"magma/lte/cloud/go/protos"
+)
+
+// SendInsertSubscriberData sends an IDR with the subscriber's profile to the MME serving
+// the subscriber and waits for the IDA
+func (srv *HomeSubscriberServer) SendInsertSubscriberData(sub *protos.SubscriberData) error {
+	imsi := sub.GetSid().GetId()
+	profile, err := srv.getSubscriptionProfile(sub)
+	if err != nil {
+		return err
+	}
+	mmeCfg, err := srv.genServingMMEConfig(imsi)
+	if err != nil {
+		return fmt.Errorf("InsertSubscriberData error: %s", err)
+	}
+	sid := (&diameter.DiameterClientConfig{}).GenSessionID("s6a")
+	msg := srv.createS6aRequest(s6a.InsertSubscriberData, sid, mmeCfg, imsi)
+	msg.AddAVP(newSubscriptionDataAVP(profile))
+	return srv.sendS6aRequest(sid, msg, mmeCfg)
+}
+
+// SendDeleteSubscriberData sends a DSR withdrawing the requested subscription data from
+// the MME serving the subscriber and waits for the DSA
+func (srv *HomeSubscriberServer) SendDeleteSubscriberData(req *fegprotos.DeleteSubscriberDataRequest) error {
+	imsi := req.GetUserName()
+	mmeCfg, err := srv.genServingMMEConfig(imsi)
+	if err != nil {
+		return fmt.Errorf("DeleteSubscriberData error: %s", err)
+	}
+	sid := (&diameter.DiameterClientConfig{}).GenSessionID("s6a")
+	msg := srv.createS6aRequest(s6a.DeleteSubscriberData, sid, mmeCfg, imsi)
+	msg.NewAVP(s6a.DSRFlagsAVPCode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(getDSRFlags(req)))
+	if req.GetPdnSubscriptionContextsWithdrawal() {
+		for _, contextID := range req.GetContextId() {
+			msg.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(contextID))
+		}
+	}
+	return srv.sendS6aRequest(sid, msg, mmeCfg)
+}
+
+func getDSRFlags(req *fegprotos.DeleteSubscriberDataRequest) uint32 {
+	var flags uint32
+	if req.GetRegionalSubscriptionWithdrawal() {
+		flags |= s6a.DSRFlagRegionalSubscriptionWithdrawal
+	}
+	if req.GetCompleteApnConfigurationProfileWithdrawal() {
+		flags |= s6a.DSRFlagCompleteAPNConfigurationProfileWithdrawal
+	}
+	if req.GetSubscribedChargingCharacteristicsWithdrawal() {
+		flags |= s6a.DSRFlagSubscribedChargingCharacteristicsWithdrawal
+	}
+	if req.GetPdnSubscriptionContextsWithdrawal() {
+		flags |= s6a.DSRFlagPDNSubscriptionContextsWithdrawal
+	}
+	return flags
+}
+
+// createS6aRequest creates an HSS initiated S6a request with provided SessionID (sid)
+// and userName to be sent over diameter to the serving MME
+func (srv *HomeSubscriberServer) createS6aRequest(
+	code uint32, sessionID string, mmeCfg *diameter.DiameterServerConfig, username string) *diam.Message {
+
+	msg := diameter.NewProxiableRequest(code, diam.TGPP_S6A_APP_ID, dict.Default)
+	msg.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	msg.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_S6A_APP_ID)),
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	})
+	msg.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+	// Set origin host and realm to server's host and realm since the request is sent from HSS
+	msg.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestHost))
+	msg.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestRealm))
+	msg.NewAVP(avp.DestinationHost, avp.Mbit, 0, datatype.DiameterIdentity(mmeCfg.DestHost))
+	msg.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(mmeCfg.DestRealm))
+	msg.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(username))
+	return msg
+}
+
+// sendS6aRequest sends an IDR or DSR to the MME and blocks until its answer is received
+func (srv *HomeSubscriberServer) sendS6aRequest(sid string, msg *diam.Message, mmeCfg *diameter.DiameterServerConfig) error {
+	ch := make(chan interface{})
+	srv.requestTracker.RegisterRequest(sid, ch)
+	// if request hasn't been removed by end of transaction, remove it
+	defer srv.requestTracker.DeregisterRequest(sid)
+
+	err := srv.sendDiameterMsg(msg, mmeCfg, maxDiamRetries)
+	if err != nil {
+		return err
+	}
+	select {
+	case resp, open := <-ch:
+		if !open {
+			err = status.Errorf(codes.Aborted, "S6a answer for Session ID: %s is canceled", sid)
+			glog.Error(err)
+			return err
+		}
+		var resultCode, experimentalResultCode uint32
+		switch ans := resp.(type) {
+		case *s6a.IDA:
+			resultCode, experimentalResultCode = ans.ResultCode, ans.ExperimentalResult.ExperimentalResultCode
+		case *s6a.DSA:
+			resultCode, experimentalResultCode = ans.ResultCode, ans.ExperimentalResult.ExperimentalResultCode
+		default:
+			err = status.Errorf(codes.Internal, "Invalid Response Type: %T, IDA or DSA expected.", resp)
+			glog.Error(err)
+			return err
+		}
+		if err = diameter.TranslateDiamResultCode(resultCode); err != nil {
+			return err
+		}
+		// If there is no base diameter error, check that there is no experimental error either
+		return diameter.TranslateDiamResultCode(experimentalResultCode)
+
+	case <-time.After(time.Second * timeoutSeconds):
+		err = status.Errorf(codes.DeadlineExceeded, "S6a answer Timed Out for Session ID: %s", sid)
+		glog.Error(err)
+		return err
+	}
+}
+
+// getSubscriptionProfile returns the subscriber's profile or the default profile
+// if the subscriber's profile isn't configured
+func (srv *HomeSubscriberServer) getSubscriptionProfile(sub *protos.SubscriberData) (*mconfig.HSSConfig_SubscriptionProfile, error) {
+	profile, ok := srv.Config.SubProfiles[sub.GetSubProfile()]
+	if ok && profile != nil {
+		return profile, nil
+	}
+	if srv.Config.DefaultSubProfile == nil {
+		return nil, fmt.Errorf("unknown subscriber profile: %s and default profile was not initialized", sub.GetSubProfile())
+	}
+	return srv.Config.DefaultSubProfile, nil
+}
+
+// genServingMMEConfig returns the diameter config of the MME which last updated
+// the subscriber's location
+func (srv *HomeSubscriberServer) genServingMMEConfig(imsi string) (*diameter.DiameterServerConfig, error) {
+	mme := srv.getServingMME(imsi)
+	if len(mme) == 0 {
+		return nil, fmt.Errorf("no MME found for subscriber: %s", imsi)
+	}
+	return srv.genPeerServerConfig(mme)
+}
+
+func (srv *HomeSubscriberServer) getServingMME(imsi string) string {
+	srv.servingMMEsMu.RLock()
+	defer srv.servingMMEsMu.RUnlock()
+	return srv.servingMMEs[imsi]
+}
+
+func (srv *HomeSubscriberServer) setServingMME(imsi, mme string) {
+	srv.servingMMEsMu.Lock()
+	defer srv.servingMMEsMu.Unlock()
+	srv.servingMMEs[imsi] = mme
+}
+
+func handleIDA(srv *HomeSubscriberServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var ida s6a.IDA
+		err := m.Unmarshal(&ida)
+		if err != nil {
+			glog.Errorf("IDA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := srv.requestTracker.DeregisterRequest(ida.SessionID)
+		if ch != nil {
+			ch <- &ida
+		} else {
+			glog.Errorf("IDA SessionID %s not found. Message: %s, Remote: %s", ida.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
+
+func handleDSA(srv *HomeSubscriberServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var dsa s6a.DSA
+		err := m.Unmarshal(&dsa)
+		if err != nil {
+			glog.Errorf("DSA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := srv.requestTracker.DeregisterRequest(dsa.SessionID)
+		if ch != nil {
+			ch <- &dsa
+		} else {
+			glog.Errorf("DSA SessionID %s not found. Message: %s, Remote: %s", dsa.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
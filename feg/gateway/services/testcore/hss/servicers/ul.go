@@ -81,6 +81,7 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return answer, fmt.Errorf("RAT-Type not allowed: %v", uint32(ulr.RATType))
 	}
 
+	srv.setServingMME(string(ulr.UserName), string(ulr.OriginHost))
 	return srv.NewSuccessfulULA(msg, ulr.SessionID, profile), nil
 }
 
@@ -90,7 +91,14 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID datatype.UTF8String, profile *mconfig.HSSConfig_SubscriptionProfile) *diam.Message {
 	ula := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_S6A_APP_ID)
 	ula.NewAVP(avp.ULAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(ulaFlags))
-	ula.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+	ula.AddAVP(newSubscriptionDataAVP(profile))
+	return ula
+}
+
+// newSubscriptionDataAVP returns the Subscription-Data AVP with the subscriber profile information
+// sent in ULA & IDR
+func newSubscriptionDataAVP(profile *mconfig.HSSConfig_SubscriptionProfile) *diam.AVP {
+	return diam.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 		AVP: []*diam.AVP{
 			diam.NewAVP(avp.MSISDN, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(msisdn)),
 			diam.NewAVP(avp.AccessRestrictionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(accessRestrictionData)),
@@ -135,7 +143,6 @@ func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID d
 			}),
 		},
 	})
-	return ula
 }
 
 // ValidateULR returns an error if the message is missing any mandatory AVPs.
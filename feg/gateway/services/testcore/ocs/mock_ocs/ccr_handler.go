@@ -21,9 +21,12 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/avp"
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
 
 	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
 	"magma/feg/gateway/services/session_proxy/credit_control"
+	"magma/feg/gateway/services/session_proxy/credit_control/gy"
 )
 
 type ccrMessage struct {
@@ -53,17 +56,21 @@ type subscriptionID struct {
 }
 
 type ccrCredit struct {
-	RatingGroup          uint32                `avp:"Rating-Group"`
-	UsedServiceUnit      *usedServiceUnit      `avp:"Used-Service-Unit"`
+	RatingGroup     uint32           `avp:"Rating-Group"`
+	UsedServiceUnit *usedServiceUnit `avp:"Used-Service-Unit"`
+	// UsedServiceUnits holds all Used-Service-Units of the MSCC, usage split by a tariff change
+	// is reported in multiple units
+	UsedServiceUnits     []*usedServiceUnit    `avp:"Used-Service-Unit"`
 	ReportingReason      uint32                `avp:"Reporting-Reason"`
 	RequestedServiceUnit *RequestedServiceUnit `avp:"Requested-Service-Unit"`
 }
 
 type usedServiceUnit struct {
-	InputOctets     uint64 `avp:"CC-Input-Octets"`
-	OutputOctets    uint64 `avp:"CC-Output-Octets"`
-	TotalOctets     uint64 `avp:"CC-Total-Octets"`
-	ReportingReason uint32 `avp:"Reporting-Reason"`
+	InputOctets       uint64  `avp:"CC-Input-Octets"`
+	OutputOctets      uint64  `avp:"CC-Output-Octets"`
+	TotalOctets       uint64  `avp:"CC-Total-Octets"`
+	ReportingReason   uint32  `avp:"Reporting-Reason"`
+	TariffChangeUsage *uint32 `avp:"Tariff-Change-Usage"`
 }
 
 type RequestedServiceUnit struct {
@@ -126,16 +133,17 @@ func getCCRHandler(srv *OCSDiamServer) diam.HandlerFunc {
 		for _, mscc := range ccr.MSCC {
 			// Only check usage for CCR-U and CCR-T,
 			if requestType != credit_control.CRTInit {
-				if mscc.UsedServiceUnit != nil {
-					glog.V(2).Infof("Received credit usage from %s:%d, balance will be decremented by Total:%d Tx:%d Rx:%d",
+				for _, usu := range mscc.UsedServiceUnits {
+					glog.V(2).Infof("Received credit usage from %s:%d%s, balance will be decremented by Total:%d Tx:%d Rx:%d",
 						imsi, mscc.RatingGroup,
-						mscc.UsedServiceUnit.TotalOctets,
-						mscc.UsedServiceUnit.OutputOctets,
-						mscc.UsedServiceUnit.InputOctets,
+						tariffChangeUsageString(usu.TariffChangeUsage),
+						usu.TotalOctets,
+						usu.OutputOctets,
+						usu.InputOctets,
 					)
 					decrementUsedCredit(
 						account.ChargingCredit[mscc.RatingGroup],
-						mscc.UsedServiceUnit,
+						usu,
 					)
 					glog.V(2).Infof("Current balance for %s:%d is Total:%d Tx:%d Rx:%d",
 						imsi, mscc.RatingGroup,
@@ -169,6 +177,7 @@ func getCCRHandler(srv *OCSDiamServer) diam.HandlerFunc {
 						srv.ocsConfig.FinalUnitIndication.FinalUnitAction,
 						srv.ocsConfig.FinalUnitIndication.RedirectAddress,
 						srv.ocsConfig.FinalUnitIndication.RestrictRules,
+						srv.ocsConfig.QuotaTimers,
 					))
 			}
 		}
@@ -385,7 +394,7 @@ func toFinalUnitActionAVP(finalUnitAction protos.FinalUnitAction, redirectAddres
 	return fuaAVPs
 }
 
-func toGrantedUnitsAVP(resultCode uint32, validityTime uint32, quotaGrant *protos.Octets, isFinalUnit bool, ratingGroup uint32, fuAction protos.FinalUnitAction, redirectAddr string, restrict_rules []string) *diam.AVP {
+func toGrantedUnitsAVP(resultCode uint32, validityTime uint32, quotaGrant *protos.Octets, isFinalUnit bool, ratingGroup uint32, fuAction protos.FinalUnitAction, redirectAddr string, restrict_rules []string, quotaTimers *protos.QuotaTimers) *diam.AVP {
 	creditGroup := &diam.GroupedAVP{
 		AVP: []*diam.AVP{
 			diam.NewAVP(avp.GrantedServiceUnit, avp.Mbit, 0, &diam.GroupedAVP{
@@ -401,9 +410,59 @@ func toGrantedUnitsAVP(resultCode uint32, validityTime uint32, quotaGrant *proto
 			diam.NewAVP(avp.FinalUnitIndication, avp.Mbit, 0, &diam.GroupedAVP{AVP: toFinalUnitActionAVP(fuAction, redirectAddr, restrict_rules)}),
 		)
 	}
+	creditGroup.AVP = append(creditGroup.AVP, toQuotaTimersAVPs(quotaTimers)...)
 	return diam.NewAVP(avp.MultipleServicesCreditControl, avp.Mbit, 0, creditGroup)
 }
 
+// toQuotaTimersAVPs returns Tariff-Time-Change, Quota-Holding-Time, Quota-Consumption-Time &
+// Trigger AVPs for the set quota timers
+func toQuotaTimersAVPs(quotaTimers *protos.QuotaTimers) []*diam.AVP {
+	res := []*diam.AVP{}
+	if quotaTimers == nil {
+		return res
+	}
+	if quotaTimers.TariffTimeChange != nil {
+		tariffTimeChange, err := ptypes.Timestamp(quotaTimers.TariffTimeChange)
+		if err != nil {
+			glog.Errorf("Invalid tariff time change %v: %v", quotaTimers.TariffTimeChange, err)
+		} else {
+			res = append(res, diam.NewAVP(avp.TariffTimeChange, avp.Mbit, 0, datatype.Time(tariffTimeChange)))
+		}
+	}
+	if quotaTimers.QuotaHoldingTime != 0 {
+		res = append(res, diam.NewAVP(avp.QuotaHoldingTime, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(quotaTimers.QuotaHoldingTime)))
+	}
+	if quotaTimers.QuotaConsumptionTime != 0 {
+		res = append(res, diam.NewAVP(avp.QuotaConsumptionTime, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(quotaTimers.QuotaConsumptionTime)))
+	}
+	if len(quotaTimers.TriggerTypes) > 0 {
+		triggerTypes := make([]*diam.AVP, 0, len(quotaTimers.TriggerTypes))
+		for _, triggerType := range quotaTimers.TriggerTypes {
+			triggerTypes = append(triggerTypes, diam.NewAVP(avp.TriggerType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+				datatype.Enumerated(triggerType)))
+		}
+		res = append(res, diam.NewAVP(avp.Trigger, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			&diam.GroupedAVP{AVP: triggerTypes}))
+	}
+	return res
+}
+
+func tariffChangeUsageString(tariffChangeUsage *uint32) string {
+	if tariffChangeUsage == nil {
+		return ""
+	}
+	switch gy.TariffChangeUsage(*tariffChangeUsage) {
+	case gy.UNIT_BEFORE_TARIFF_CHANGE:
+		return " (before tariff change)"
+	case gy.UNIT_AFTER_TARIFF_CHANGE:
+		return " (after tariff change)"
+	default:
+		return " (indeterminate tariff)"
+	}
+}
+
 func toGrantedServiceUnitAVP(quotaGrant *protos.Octets) []*diam.AVP {
 	res := []*diam.AVP{}
 	if quotaGrant.GetTotalOctets() != 0 {
@@ -79,7 +79,8 @@ func (answer GyAnswer) toAVPs() ([]*diam.AVP, uint32) {
 				grant.GetRatingGroup(),
 				grant.GetFinalUnitIndication().GetFinalUnitAction(),
 				grant.GetFinalUnitIndication().GetRedirectServer().GetRedirectServerAddress(),
-				grant.GetFinalUnitIndication().GetRestrictRules()))
+				grant.GetFinalUnitIndication().GetRestrictRules(),
+				grant.GetQuotaTimers()))
 	}
 	return avps, answer.GetResultCode()
 }
@@ -66,6 +66,7 @@ type OCSConfig struct {
 	GyInitMethod        gy.InitMethod
 	UseMockDriver       bool
 	FinalUnitIndication FinalUnitIndication
+	QuotaTimers         *protos.QuotaTimers
 	grantTypeProcedure  protos.OCSConfig_GrantType
 }
 
@@ -174,6 +175,7 @@ func (srv *OCSDiamServer) CreateAccount(
 //   - *uint32 optional maximum bytes to return in a CCA
 //   - *uint32 optional maximum time to return in a CCA
 //   - *uint32 optional credit validity time to return in a CCA
+//   - *QuotaTimers optional tariff time change, quota timers & triggers to return in a CCA
 func (srv *OCSDiamServer) SetOCSSettings(
 	_ context.Context,
 	ocsConfig *protos.OCSConfig,
@@ -183,6 +185,7 @@ func (srv *OCSDiamServer) SetOCSSettings(
 	config.MaxUsageTime = ocsConfig.MaxUsageTime
 	config.ValidityTime = ocsConfig.ValidityTime
 	config.UseMockDriver = ocsConfig.UseMockDriver
+	config.QuotaTimers = ocsConfig.QuotaTimers
 	if ocsConfig.FinalUnitIndication != nil {
 		config.FinalUnitIndication.RedirectAddress = ocsConfig.FinalUnitIndication.RedirectServer.RedirectServerAddress
 		config.FinalUnitIndication.RestrictRules = ocsConfig.FinalUnitIndication.RestrictRules
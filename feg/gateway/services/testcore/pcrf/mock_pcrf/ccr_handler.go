@@ -30,20 +30,21 @@ import (
 )
 
 type ccrMessage struct {
-	SessionID        datatype.UTF8String       `avp:"Session-Id"`
-	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
-	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
-	DestinationRealm datatype.DiameterIdentity `avp:"Destination-Realm"`
-	DestinationHost  datatype.DiameterIdentity `avp:"Destination-Host"`
-	RequestType      datatype.Enumerated       `avp:"CC-Request-Type"`
-	RequestNumber    datatype.Unsigned32       `avp:"CC-Request-Number"`
-	SubscriptionIDs  []*subscriptionIDDiam     `avp:"Subscription-Id"`
-	IPAddr           datatype.OctetString      `avp:"Framed-IP-Address"`
-	UsageMonitors    []*usageMonitorRequestAVP `avp:"Usage-Monitoring-Information"`
-	CalledStationId  string                    `avp:"Called-Station-Id"`
-	EventTrigger     datatype.Enumerated       `avp:"Event-Trigger"`
-	Online           int32                     `avp:"Online"`
-	Offline          int32                     `avp:"Offline"`
+	SessionID        datatype.UTF8String            `avp:"Session-Id"`
+	OriginHost       datatype.DiameterIdentity      `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity      `avp:"Origin-Realm"`
+	DestinationRealm datatype.DiameterIdentity      `avp:"Destination-Realm"`
+	DestinationHost  datatype.DiameterIdentity      `avp:"Destination-Host"`
+	RequestType      datatype.Enumerated            `avp:"CC-Request-Type"`
+	RequestNumber    datatype.Unsigned32            `avp:"CC-Request-Number"`
+	SubscriptionIDs  []*subscriptionIDDiam          `avp:"Subscription-Id"`
+	IPAddr           datatype.OctetString           `avp:"Framed-IP-Address"`
+	UsageMonitors    []*usageMonitorRequestAVP      `avp:"Usage-Monitoring-Information"`
+	CalledStationId  string                         `avp:"Called-Station-Id"`
+	EventTrigger     datatype.Enumerated            `avp:"Event-Trigger"`
+	Online           int32                          `avp:"Online"`
+	Offline          int32                          `avp:"Offline"`
+	AppDetections    []*gx.ApplicationDetectionInfo `avp:"Application-Detection-Information"`
 }
 
 type subscriptionIDDiam struct {
@@ -116,7 +117,14 @@ func getCCRHandler(srv *PCRFServer) diam.HandlerFunc {
 			// Install all monitors attached to the subscriber for the initial answer
 			usageMonitors := toUsageMonitorAVPs(account.UsageMonitors)
 			avps = append(ruleInstalls, usageMonitors...)
+			// Subscribe to the event triggers attached to the subscriber
+			avps = append(avps, toEventTriggersAVPs(account.EventTriggers)...)
 		} else {
+			if len(ccr.AppDetections) > 0 {
+				glog.V(2).Infof("\tGot %d application detection reports with event trigger %d from IMSI %s\n",
+					len(ccr.AppDetections), ccr.EventTrigger, imsi)
+				account.AppDetectionReports = append(account.AppDetectionReports, ccr.AppDetections...)
+			}
 			// Update the subscriber state with the usage updates in CCR-U/T
 			glog.V(2).Infof("\tGot xCCR type \"%d\" from IMSI %s. Quota be updated\n", ccrType, imsi)
 			creditByMkey, err := updateSubscriberAccountWithUsageUpdates(account, ccr.UsageMonitors)
@@ -22,6 +22,7 @@ import (
 
 	"magma/feg/cloud/go/protos"
 	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
 	lteprotos "magma/lte/cloud/go/protos"
 )
 
@@ -96,6 +97,13 @@ func toDynamicRuleInstallAVP(rule *protos.RuleDefinition, activationTime *timest
 			diam.NewAVP(avp.FlowDescription, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.IPFilterRule(flowDescription)),
 		)
 	}
+	if rule.TdfApplicationIdentifier != "" {
+		ruleDefinitionAVPs = append(
+			ruleDefinitionAVPs,
+			diam.NewAVP(gx.TDFApplicationIdentifierAVPCode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+				datatype.OctetString(rule.TdfApplicationIdentifier)),
+		)
+	}
 	if rule.RedirectInformation != nil {
 		ruleDefinitionAVPs = append(
 			ruleDefinitionAVPs,
@@ -53,7 +53,10 @@ type subscriberAccount struct {
 	RuleBaseNames   []string
 	RuleDefinitions []*protos.RuleDefinition
 	UsageMonitors   creditByMkey
-	CurrentState    *SubscriberSessionState
+	EventTriggers   []uint32
+	// Application-Detection-Information received with APPLICATION_START/STOP
+	AppDetectionReports []*gx.ApplicationDetectionInfo
+	CurrentState        *SubscriberSessionState
 }
 
 // PCRFServer wraps an PCRF storing subscribers and their rules
@@ -188,6 +191,7 @@ func (srv *PCRFServer) SetRules(
 	account.RuleNames = accountRules.StaticRuleNames
 	account.RuleBaseNames = accountRules.StaticRuleBaseNames
 	account.RuleDefinitions = accountRules.DynamicRuleDefinitions
+	account.EventTriggers = accountRules.EventTriggers
 	return &orcprotos.Void{}, nil
 }
 
@@ -253,6 +257,24 @@ func (srv *PCRFServer) GetRuleDefinitions(
 	return account.RuleDefinitions, nil
 }
 
+// GetApplicationDetectionReports returns all the Application-Detection-Information
+// reported by the PCEF for a subscriber
+// Input:
+//   - string IMSI for the subscriber
+//
+// Output:
+//   - []*gx.ApplicationDetectionInfo containing all the reports in the order received
+//     error if subscriber could not be found
+func (srv *PCRFServer) GetApplicationDetectionReports(
+	imsi string,
+) ([]*gx.ApplicationDetectionInfo, error) {
+	account, ok := srv.subscribers[imsi]
+	if !ok {
+		return nil, fmt.Errorf("Could not find imsi %s", imsi)
+	}
+	return account.AppDetectionReports, nil
+}
+
 // Reset eliminates all the subscribers allocated for the system.
 func (srv *PCRFServer) ClearSubscribers(_ context.Context, void *orcprotos.Void) (*orcprotos.Void, error) {
 	srv.subscribers = map[string]*subscriberAccount{}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// diam_replay is a CLI for replaying Diameter sessions captured by the FeG diameter client capture
+// hook (see DIAMETER_CAPTURE_FILE) against a Diameter server or an in process testcore mock OCS/PCRF.
+// Redacted subscriber identities of captured requests are replaced with the identities given by the
+// imsi & msisdn flags, result codes of replayed answers are compared with the captured answers.
+// Example usage:
+//
+//	diam_replay -file=/var/opt/magma/diameter_capture.jsonl -mock=ocs -imsi=001010000000001
+//	diam_replay -file=capture.jsonl -addr=10.0.0.1:3868 -network=tcp -dest_realm=ocs.operator.com
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"magma/feg/gateway/diameter"
+)
+
+var (
+	captureFile string
+	session     string
+	command     uint
+	mockServer  string
+	imsi        string
+	msisdn      string
+	timeout     time.Duration
+	help        bool
+)
+
+func init() {
+	flag.StringVar(&captureFile, "file", "", "Diameter capture file to replay")
+	flag.StringVar(&session, "session", "", "[optional] Replay only the captured session with given (redacted) Session-Id")
+	flag.UintVar(&command, "command", 0, "[optional] Replay only requests with given command code")
+	flag.StringVar(&mockServer, "mock", "",
+		fmt.Sprintf("[optional] Replay against in process mock server instead of addr (%s or %s)", mockOCS, mockPCRF))
+	flag.StringVar(&imsi, "imsi", "001010000000001", "IMSI to use in place of redacted IMSIs & User-Names")
+	flag.StringVar(&msisdn, "msisdn", "5100001234", "MSISDN to use in place of redacted MSISDNs")
+	flag.DurationVar(&timeout, "timeout", 5*time.Second, "Answer wait timeout")
+	flag.BoolVar(&help, "help", false, "[optional] Display this help message")
+}
+
+func main() {
+	flag.Parse()
+	if help || len(captureFile) == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	records, err := diameter.ReadCaptureFile(captureFile)
+	if err != nil {
+		fmt.Printf("Failed to read capture file %s: %v\n", captureFile, err)
+		os.Exit(1)
+	}
+	records = filterRecords(records, session, uint32(command))
+	if len(records) == 0 {
+		fmt.Println("No captured requests to replay")
+		os.Exit(1)
+	}
+	cfg := &replayConfig{
+		network:   flagValue(diameter.NetworkFlag, "tcp"),
+		addr:      flagValue(diameter.AddrFlag, ""),
+		host:      flagValue(diameter.HostFlag, "replay.magma.com"),
+		realm:     flagValue(diameter.RealmFlag, "magma.com"),
+		destHost:  flagValue(diameter.DestHostFlag, ""),
+		destRealm: flagValue(diameter.DestRealmFlag, ""),
+		imsi:      imsi,
+		msisdn:    msisdn,
+		timeout:   timeout,
+	}
+	if len(mockServer) > 0 {
+		addr, err := startMockServer(mockServer, cfg.network, imsi, records)
+		if err != nil {
+			fmt.Printf("Failed to start mock %s: %v\n", mockServer, err)
+			os.Exit(1)
+		}
+		cfg.addr = addr.String()
+		fmt.Printf("Started mock %s on %s\n", mockServer, cfg.addr)
+	}
+	if len(cfg.addr) == 0 {
+		fmt.Printf("Either -%s or -mock must be specified\n", diameter.AddrFlag)
+		os.Exit(1)
+	}
+	r, err := newReplayer(cfg, records)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	mismatches := 0
+	for _, res := range r.replay(records) {
+		fmt.Println(res)
+		if !res.match() {
+			mismatches++
+		}
+	}
+	r.close()
+	fmt.Printf("Replayed %d requests, %d mismatches\n", len(records), mismatches)
+	if mismatches > 0 {
+		os.Exit(2)
+	}
+}
+
+// filterRecords returns records of the given session & command code, empty session & zero
+// command match all records
+func filterRecords(records []*diameter.CaptureRecord, session string, command uint32) []*diameter.CaptureRecord {
+	res := make([]*diameter.CaptureRecord, 0, len(records))
+	for _, rec := range records {
+		if rec.Request == nil ||
+			(len(session) > 0 && rec.SessionID != session) ||
+			(command != 0 && rec.CommandCode != command) {
+			continue
+		}
+		res = append(res, rec)
+	}
+	return res
+}
+
+// flagValue returns the value of a flag registered by the diameter package or the default if it's not set
+func flagValue(name, defaultValue string) string {
+	if f := flag.Lookup(name); f != nil && len(f.Value.String()) > 0 {
+		return f.Value.String()
+	}
+	return defaultValue
+}
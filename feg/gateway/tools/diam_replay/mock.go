@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/testcore/ocs/mock_ocs"
+	"magma/feg/gateway/services/testcore/pcrf/mock_pcrf"
+	lteprotos "magma/lte/cloud/go/protos"
+)
+
+const (
+	mockOCS  = "ocs"
+	mockPCRF = "pcrf"
+
+	mockHost  = "mock.magma.com"
+	mockRealm = "magma.com"
+)
+
+// startMockServer starts an in process testcore mock OCS or PCRF on a random local port, provisions
+// the replay subscriber in it & returns the mock's listener address
+func startMockServer(kind, network, imsi string, records []*diameter.CaptureRecord) (net.Addr, error) {
+	clientCfg := &diameter.DiameterClientConfig{
+		Host:        mockHost,
+		Realm:       mockRealm,
+		ProductName: diameter.DiamProductName,
+	}
+	serverCfg := &diameter.DiameterServerConfig{
+		DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr:     "127.0.0.1:0",
+			Protocol: network,
+		},
+	}
+	ctx := context.Background()
+	switch kind {
+	case mockOCS:
+		ocs := mock_ocs.NewOCSDiamServer(clientCfg, &mock_ocs.OCSConfig{
+			MaxUsageOctets: &fegprotos.Octets{TotalOctets: math.MaxUint32},
+			MaxUsageTime:   math.MaxUint32,
+			ValidityTime:   math.MaxUint32,
+			ServerConfig:   serverCfg,
+		})
+		if _, err := ocs.CreateAccount(ctx, &lteprotos.SubscriberID{Id: imsi}); err != nil {
+			return nil, err
+		}
+		for rg := range ratingGroups(records) {
+			_, err := ocs.SetCredit(ctx, &fegprotos.CreditInfo{
+				Imsi:        imsi,
+				ChargingKey: rg,
+				Volume:      &fegprotos.Octets{TotalOctets: math.MaxUint64},
+				UnitType:    fegprotos.CreditInfo_Bytes,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		lis, err := ocs.StartListener()
+		if err != nil {
+			return nil, err
+		}
+		go ocs.Start(lis)
+		return lis.Addr(), nil
+	case mockPCRF:
+		pcrf := mock_pcrf.NewPCRFServer(clientCfg, serverCfg)
+		if _, err := pcrf.CreateAccount(ctx, &lteprotos.SubscriberID{Id: imsi}); err != nil {
+			return nil, err
+		}
+		lis, err := pcrf.StartListener()
+		if err != nil {
+			return nil, err
+		}
+		go pcrf.Start(lis)
+		return lis.Addr(), nil
+	default:
+		return nil, fmt.Errorf("unknown mock server type '%s', supported types: %s, %s", kind, mockOCS, mockPCRF)
+	}
+}
+
+// ratingGroups returns all Rating-Group values found in captured requests
+func ratingGroups(records []*diameter.CaptureRecord) map[uint32]bool {
+	res := map[uint32]bool{}
+	for _, rec := range records {
+		m, err := rec.Request.Message()
+		if err != nil {
+			continue
+		}
+		avps, err := m.FindAVPs(avp.RatingGroup, 0)
+		if err != nil {
+			continue
+		}
+		for _, a := range avps {
+			if rg, ok := a.Data.(datatype.Unsigned32); ok {
+				res[uint32(rg)] = true
+			}
+		}
+	}
+	return res
+}
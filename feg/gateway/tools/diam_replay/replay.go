@@ -0,0 +1,324 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+
+	"magma/feg/gateway/diameter"
+)
+
+// endUserE164 is the Subscription-Id-Type of MSISDN subscription IDs
+const endUserE164 = 0
+
+type replayConfig struct {
+	network   string
+	addr      string
+	host      string
+	realm     string
+	destHost  string // if set, overwrites captured Destination-Host
+	destRealm string // if set, overwrites captured Destination-Realm
+	imsi      string // replaces redacted User-Name & IMSI Subscription-Id-Data
+	msisdn    string // replaces redacted MSISDN, Calling-Station-Id & E164 Subscription-Id-Data
+	timeout   time.Duration
+}
+
+// replayResult is the outcome of a single replayed request
+type replayResult struct {
+	record       *diameter.CaptureRecord
+	expectedCode uint32 // result code of the captured answer, 0 if the request was not answered
+	actualCode   uint32 // result code of the replayed answer, 0 if the replayed request was not answered
+	err          error
+}
+
+func (r *replayResult) match() bool {
+	return r.err == nil && r.expectedCode == r.actualCode
+}
+
+func (r *replayResult) String() string {
+	status := "OK"
+	if !r.match() {
+		status = "MISMATCH"
+	}
+	res := fmt.Sprintf("%-8s app: %d, cmd: %d, session: %s, captured result: %d, replayed result: %d",
+		status, r.record.ApplicationID, r.record.CommandCode, r.record.SessionID, r.expectedCode, r.actualCode)
+	if r.err != nil {
+		res += fmt.Sprintf(", error: %v", r.err)
+	}
+	return res
+}
+
+// replayer sends captured requests over a single diameter connection in their capture order and
+// waits for the answer of each request before sending the next one
+type replayer struct {
+	cfg      *replayConfig
+	conn     diam.Conn
+	answers  map[uint32]chan *diam.Message // keyed by Hop-by-Hop ID of replayed requests
+	sessions map[string]string             // redacted captured Session-Id -> replayed Session-Id
+	mutex    sync.Mutex
+}
+
+func newReplayer(cfg *replayConfig, records []*diameter.CaptureRecord) (*replayer, error) {
+	r := &replayer{
+		cfg:      cfg,
+		answers:  map[uint32]chan *diam.Message{},
+		sessions: map[string]string{},
+	}
+	mux := sm.New(&sm.Settings{
+		OriginHost:       datatype.DiameterIdentity(cfg.host),
+		OriginRealm:      datatype.DiameterIdentity(cfg.realm),
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      datatype.UTF8String(diameter.DiamProductName),
+		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+		FirmwareRevision: 1,
+	})
+	mux.HandleFunc("ALL", r.handleAnswer)
+	client := &sm.Client{
+		Dict:               dict.Default,
+		Handler:            mux,
+		MaxRetransmits:     1,
+		RetransmitInterval: time.Second,
+		SupportedVendorID: []*diam.AVP{
+			diam.NewAVP(avp.SupportedVendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	}
+	addApplicationIDs(client, records)
+	conn, err := client.DialNetwork(cfg.network, cfg.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s %s: %v", cfg.network, cfg.addr, err)
+	}
+	r.conn = conn
+	return r, nil
+}
+
+func (r *replayer) close() {
+	r.conn.Close()
+}
+
+// replay sends all given captured requests & compares result codes of their answers with
+// the captured answers
+func (r *replayer) replay(records []*diameter.CaptureRecord) []*replayResult {
+	results := make([]*replayResult, 0, len(records))
+	for _, rec := range records {
+		res := &replayResult{record: rec}
+		if rec.Answer != nil {
+			if ans, err := rec.Answer.Message(); err == nil {
+				res.expectedCode = resultCode(ans)
+			}
+		}
+		ans, err := r.send(rec)
+		if err != nil {
+			res.err = err
+		} else if ans != nil {
+			res.actualCode = resultCode(ans)
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// send replays a single request & returns its answer, nil answer is returned if the request
+// times out
+func (r *replayer) send(rec *diameter.CaptureRecord) (*diam.Message, error) {
+	m, err := rec.Request.Message()
+	if err != nil {
+		return nil, fmt.Errorf("invalid captured request: %v", err)
+	}
+	r.prepare(m)
+	ch := make(chan *diam.Message, 1)
+	r.mutex.Lock()
+	r.answers[m.Header.HopByHopID] = ch
+	r.mutex.Unlock()
+	defer func() {
+		r.mutex.Lock()
+		delete(r.answers, m.Header.HopByHopID)
+		r.mutex.Unlock()
+	}()
+	if _, err = m.WriteTo(r.conn); err != nil {
+		return nil, err
+	}
+	select {
+	case ans := <-ch:
+		return ans, nil
+	case <-time.After(r.cfg.timeout):
+		return nil, nil
+	}
+}
+
+func (r *replayer) handleAnswer(_ diam.Conn, m *diam.Message) {
+	if m == nil || m.Header.CommandFlags&diam.RequestFlag != 0 {
+		return
+	}
+	r.mutex.Lock()
+	ch, ok := r.answers[m.Header.HopByHopID]
+	r.mutex.Unlock()
+	if ok {
+		ch <- m
+	}
+}
+
+// prepare makes the captured request a new request of the replayer: it sets new Hop-by-Hop & End-to-End
+// IDs, replayer's origin & configured destination and replaces redacted subscriber identities
+func (r *replayer) prepare(m *diam.Message) {
+	m.Header.HopByHopID = rand.Uint32()
+	m.Header.EndToEndID = rand.Uint32()
+	m.Header.CommandFlags &^= diam.RetransmittedFlag
+	r.replaceIdentities(m.AVP, -1)
+	for _, a := range m.AVP {
+		switch a.Code {
+		case avp.OriginHost:
+			a.Data = datatype.DiameterIdentity(r.cfg.host)
+		case avp.OriginRealm:
+			a.Data = datatype.DiameterIdentity(r.cfg.realm)
+		case avp.DestinationHost:
+			if len(r.cfg.destHost) > 0 {
+				a.Data = datatype.DiameterIdentity(r.cfg.destHost)
+			}
+		case avp.DestinationRealm:
+			if len(r.cfg.destRealm) > 0 {
+				a.Data = datatype.DiameterIdentity(r.cfg.destRealm)
+			}
+		}
+		a.Length = a.Len()
+	}
+	m.Header.MessageLength = uint32(m.Len())
+}
+
+// replaceIdentities replaces redacted values of identity AVPs, subscriptionIDType is the
+// Subscription-Id-Type of the enclosing Subscription-Id AVP or -1
+func (r *replayer) replaceIdentities(avps []*diam.AVP, subscriptionIDType int) {
+	for _, a := range avps {
+		if group, ok := a.Data.(*diam.GroupedAVP); ok {
+			subIDType := -1
+			if a.Code == avp.SubscriptionID {
+				for _, ga := range group.AVP {
+					if t, ok := ga.Data.(datatype.Enumerated); ok && ga.Code == avp.SubscriptionIDType {
+						subIDType = int(t)
+					}
+				}
+			}
+			r.replaceIdentities(group.AVP, subIDType)
+			a.Length = a.Len()
+			continue
+		}
+		value, ok := redactedValue(a.Data)
+		if !ok {
+			continue
+		}
+		var replacement string
+		switch a.Code {
+		case avp.SessionID:
+			replacement = r.sessionID(value)
+		case avp.UserName:
+			replacement = r.cfg.imsi
+		case avp.SubscriptionIDData:
+			replacement = r.cfg.imsi
+			if subscriptionIDType == endUserE164 {
+				replacement = r.cfg.msisdn
+			}
+		case avp.MSISDN, avp.CallingStationID:
+			replacement = r.cfg.msisdn
+		default:
+			continue
+		}
+		switch a.Data.(type) {
+		case datatype.UTF8String:
+			a.Data = datatype.UTF8String(replacement)
+		case datatype.OctetString:
+			a.Data = datatype.OctetString(replacement)
+		case datatype.DiameterIdentity:
+			a.Data = datatype.DiameterIdentity(replacement)
+		}
+		a.Length = a.Len()
+	}
+}
+
+// sessionID returns the replay Session-Id of the captured redacted Session-Id, all requests of
+// a captured session are replayed with the same new Session-Id
+func (r *replayer) sessionID(captured string) string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	sid, ok := r.sessions[captured]
+	if !ok {
+		sid = diameter.GenSessionID(r.cfg.host, "replay")
+		r.sessions[captured] = sid
+	}
+	return sid
+}
+
+// redactedValue returns the string value of the AVP data & true if the value is redacted
+func redactedValue(data datatype.Type) (string, bool) {
+	var value string
+	switch v := data.(type) {
+	case datatype.UTF8String:
+		value = string(v)
+	case datatype.OctetString:
+		value = string(v)
+	case datatype.DiameterIdentity:
+		value = string(v)
+	default:
+		return "", false
+	}
+	return value, strings.HasPrefix(value, diameter.RedactedPrefix)
+}
+
+// resultCode returns Result-Code or Experimental-Result-Code of the answer
+func resultCode(m *diam.Message) uint32 {
+	if a, err := m.FindAVP(avp.ResultCode, 0); err == nil && a != nil {
+		if code, ok := a.Data.(datatype.Unsigned32); ok {
+			return uint32(code)
+		}
+	}
+	if a, err := m.FindAVP(avp.ExperimentalResultCode, 0); err == nil && a != nil {
+		if code, ok := a.Data.(datatype.Unsigned32); ok {
+			return uint32(code)
+		}
+	}
+	return 0
+}
+
+// addApplicationIDs advertises applications of all captured requests in the client's CER
+func addApplicationIDs(client *sm.Client, records []*diameter.CaptureRecord) {
+	apps := map[uint32]bool{}
+	for _, rec := range records {
+		apps[rec.ApplicationID] = true
+	}
+	for _, app := range sm.PrepareSupportedApps(dict.Default) {
+		if app.ID == 0 || !apps[app.ID] || app.AppType != "auth" {
+			continue
+		}
+		if app.Vendor == 0 {
+			client.AuthApplicationID = append(client.AuthApplicationID,
+				diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(app.ID)))
+			continue
+		}
+		client.VendorSpecificApplicationID = append(client.VendorSpecificApplicationID,
+			diam.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(app.ID)),
+					diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(app.Vendor)),
+				},
+			}))
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control"
+)
+
+const (
+	testIMSI      = "001010000000055"
+	testSessionID = "magma-fedgw;123;456;IMSI001010000000055"
+	testRG        = 11
+)
+
+func newTestCCR(requestType credit_control.CreditRequestType, requestNumber uint32) *diam.Message {
+	m := diam.NewRequest(diam.CreditControl, diam.CHARGING_CONTROL_APP_ID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(testSessionID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("magma-fedgw.magma.com"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity("ocs.operator.com"))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID))
+	m.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(requestType))
+	m.NewAVP(avp.CCRequestNumber, avp.Mbit, 0, datatype.Unsigned32(requestNumber))
+	m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(1)),
+			diam.NewAVP(avp.SubscriptionIDData, avp.Mbit, 0, datatype.UTF8String(testIMSI)),
+		},
+	})
+	m.NewAVP(avp.MultipleServicesCreditControl, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.RequestedServiceUnit, avp.Mbit, 0, &diam.GroupedAVP{}),
+			diam.NewAVP(avp.RatingGroup, avp.Mbit, 0, datatype.Unsigned32(testRG)),
+		},
+	})
+	return m
+}
+
+// writeTestCapture captures CCR-I/CCR-T pair of a Gy session, the CCR-I is captured with the given result code
+func writeTestCapture(t *testing.T, initResultCode uint32) string {
+	path := filepath.Join(t.TempDir(), "capture.jsonl")
+	c, err := diameter.StartCapture(path, 1<<20, 1)
+	require.NoError(t, err)
+	for i, rt := range []credit_control.CreditRequestType{credit_control.CRTInit, credit_control.CRTTerminate} {
+		req := newTestCCR(rt, uint32(i))
+		c.RequestSent(req, "10.0.0.1:3868")
+		code := uint32(diam.Success)
+		if rt == credit_control.CRTInit {
+			code = initResultCode
+		}
+		ans := req.Answer(code)
+		ans.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(testSessionID))
+		c.AnswerReceived(ans)
+	}
+	require.NoError(t, c.Close())
+	return path
+}
+
+func replayTestCapture(t *testing.T, path string) []*replayResult {
+	records, err := diameter.ReadCaptureFile(path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	addr, err := startMockServer(mockOCS, "tcp", testIMSI, records)
+	require.NoError(t, err)
+
+	r, err := newReplayer(&replayConfig{
+		network: "tcp",
+		addr:    addr.String(),
+		host:    "replay.magma.com",
+		realm:   "magma.com",
+		imsi:    testIMSI,
+		msisdn:  "5100001234",
+		timeout: 5 * time.Second,
+	}, records)
+	require.NoError(t, err)
+	defer r.close()
+	return r.replay(records)
+}
+
+func TestReplayMatch(t *testing.T) {
+	results := replayTestCapture(t, writeTestCapture(t, diam.Success))
+	require.Len(t, results, 2)
+	for _, res := range results {
+		assert.True(t, res.match(), res.String())
+		assert.Equal(t, uint32(diam.Success), res.actualCode)
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	results := replayTestCapture(t, writeTestCapture(t, diam.UnableToComply))
+	require.Len(t, results, 2)
+	assert.False(t, results[0].match())
+	assert.Equal(t, uint32(diam.UnableToComply), results[0].expectedCode)
+	assert.Equal(t, uint32(diam.Success), results[0].actualCode)
+	assert.True(t, results[1].match(), results[1].String())
+}
+
+func TestFilterRecords(t *testing.T) {
+	records := []*diameter.CaptureRecord{
+		{SessionID: "redacted-1", CommandCode: diam.CreditControl, Request: &diameter.CapturedMessage{}},
+		{SessionID: "redacted-2", CommandCode: diam.CreditControl, Request: &diameter.CapturedMessage{}},
+		{SessionID: "redacted-2", CommandCode: diam.ReAuth, Request: &diameter.CapturedMessage{}},
+		{SessionID: "redacted-2", CommandCode: diam.CreditControl},
+	}
+	assert.Len(t, filterRecords(records, "", 0), 3)
+	assert.Len(t, filterRecords(records, "redacted-2", 0), 2)
+	assert.Len(t, filterRecords(records, "redacted-2", diam.ReAuth), 1)
+	assert.Len(t, filterRecords(records, "redacted-3", 0), 0)
+}
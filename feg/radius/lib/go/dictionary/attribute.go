@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dictionary
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"layeh.com/radius"
+	"layeh.com/radius/dictionary"
+)
+
+// DataType of attribute values
+type DataType = dictionary.AttributeType
+
+// Attribute a standard or vendor specific attribute definition
+type Attribute struct {
+	Name       string
+	Type       radius.Type // VendorSpecificType for vendor attributes
+	VendorID   uint32      // 0 for standard attributes
+	VendorType byte
+	DataType   DataType
+	Encrypted  bool // the value is encrypted with the shared secret (User-Password, Tunnel-Password...)
+
+	values     map[string]uint64 // enumerated values by lower case name
+	valueNames map[uint64]string
+}
+
+func newAttribute(def *dictionary.Attribute, typ radius.Type, vendorID uint32, vendorType byte) *Attribute {
+	return &Attribute{
+		Name:       def.Name,
+		Type:       typ,
+		VendorID:   vendorID,
+		VendorType: vendorType,
+		DataType:   def.Type,
+		Encrypted:  def.FlagEncrypt.Valid && def.FlagEncrypt.Int != 0,
+	}
+}
+
+// IsVendorSpecific returns true for vendor specific attributes
+func (a *Attribute) IsVendorSpecific() bool {
+	return a.VendorID != 0
+}
+
+func (a *Attribute) addValue(name string, number uint64) {
+	if a.values == nil {
+		a.values = map[string]uint64{}
+		a.valueNames = map[uint64]string{}
+	}
+	a.values[strings.ToLower(name)] = number
+	a.valueNames[number] = name
+}
+
+// Encode converts the string representation of a value to the attribute's wire format.
+// Enumerated integer values can be given by their names, octets - as 0x prefixed hex strings.
+func (a *Attribute) Encode(value string) (radius.Attribute, error) {
+	switch a.DataType {
+	case dictionary.AttributeString:
+		return radius.NewString(value)
+	case dictionary.AttributeInteger, dictionary.AttributeByte, dictionary.AttributeShort,
+		dictionary.AttributeSigned, dictionary.AttributeInteger64:
+		return a.encodeNumber(value)
+	case dictionary.AttributeIPAddr:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address '%s' for %s", value, a.Name)
+		}
+		return radius.NewIPAddr(ip)
+	case dictionary.AttributeIPv6Addr:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IPv6 address '%s' for %s", value, a.Name)
+		}
+		return radius.NewIPv6Addr(ip)
+	case dictionary.AttributeIPv6Prefix:
+		_, prefix, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IPv6 prefix '%s' for %s", value, a.Name)
+		}
+		return radius.NewIPv6Prefix(prefix)
+	case dictionary.AttributeDate:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date '%s' for %s", value, a.Name)
+		}
+		return radius.NewDate(t)
+	case dictionary.AttributeEther, dictionary.AttributeIFID:
+		hw, err := net.ParseMAC(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address '%s' for %s", value, a.Name)
+		}
+		return radius.Attribute(hw), nil
+	}
+	if strings.HasPrefix(value, "0x") {
+		b, err := hex.DecodeString(value[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex value '%s' for %s", value, a.Name)
+		}
+		return radius.NewBytes(b)
+	}
+	return radius.NewBytes([]byte(value))
+}
+
+func (a *Attribute) encodeNumber(value string) (radius.Attribute, error) {
+	n, ok := a.values[strings.ToLower(value)]
+	if !ok {
+		var err error
+		if a.DataType == dictionary.AttributeSigned {
+			var i int64
+			i, err = strconv.ParseInt(value, 10, 32)
+			n = uint64(uint32(int32(i)))
+		} else {
+			n, err = strconv.ParseUint(value, 10, 64)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value '%s' for %s", a.DataType, value, a.Name)
+		}
+	}
+	switch a.DataType {
+	case dictionary.AttributeByte:
+		if n > 0xFF {
+			return nil, fmt.Errorf("%s value %d is out of range", a.Name, n)
+		}
+		return radius.Attribute{byte(n)}, nil
+	case dictionary.AttributeShort:
+		if n > 0xFFFF {
+			return nil, fmt.Errorf("%s value %d is out of range", a.Name, n)
+		}
+		return radius.NewShort(uint16(n)), nil
+	case dictionary.AttributeInteger64:
+		return radius.NewInteger64(n), nil
+	}
+	if n > 0xFFFFFFFF {
+		return nil, fmt.Errorf("%s value %d is out of range", a.Name, n)
+	}
+	return radius.NewInteger(uint32(n)), nil
+}
+
+// Decode returns the string representation of the attribute value, enumerated values are
+// returned by their names & values which can't be decoded as the attribute's type - as hex strings
+func (a *Attribute) Decode(value radius.Attribute) string {
+	switch a.DataType {
+	case dictionary.AttributeString:
+		return string(value)
+	case dictionary.AttributeInteger, dictionary.AttributeByte, dictionary.AttributeShort,
+		dictionary.AttributeSigned, dictionary.AttributeInteger64:
+		if n, ok := decodeNumber(a.DataType, value); ok {
+			if name, ok := a.valueNames[n]; ok {
+				return name
+			}
+			if a.DataType == dictionary.AttributeSigned {
+				return strconv.FormatInt(int64(int32(uint32(n))), 10)
+			}
+			return strconv.FormatUint(n, 10)
+		}
+	case dictionary.AttributeIPAddr:
+		if ip, err := radius.IPAddr(value); err == nil {
+			return ip.String()
+		}
+	case dictionary.AttributeIPv6Addr:
+		if ip, err := radius.IPv6Addr(value); err == nil {
+			return ip.String()
+		}
+	case dictionary.AttributeIPv6Prefix:
+		if prefix, err := radius.IPv6Prefix(value); err == nil {
+			return prefix.String()
+		}
+	case dictionary.AttributeDate:
+		if t, err := radius.Date(value); err == nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+	case dictionary.AttributeEther, dictionary.AttributeIFID:
+		return net.HardwareAddr(value).String()
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+func decodeNumber(dataType DataType, value radius.Attribute) (uint64, bool) {
+	switch {
+	case dataType == dictionary.AttributeByte && len(value) == 1:
+		return uint64(value[0]), true
+	case dataType == dictionary.AttributeShort && len(value) == 2:
+		return uint64(binary.BigEndian.Uint16(value)), true
+	case dataType == dictionary.AttributeInteger64 && len(value) == 8:
+		return binary.BigEndian.Uint64(value), true
+	case len(value) == 4:
+		return uint64(binary.BigEndian.Uint32(value)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dictionary loads FreeRADIUS format dictionaries & decodes/encodes
+// standard and vendor specific RADIUS attributes by name at runtime
+package dictionary
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"layeh.com/radius"
+	"layeh.com/radius/dictionary"
+)
+
+//go:embed dictionary.rfc
+var builtin string
+
+// VendorSpecificType the Vendor-Specific attribute type (RFC 2865, section 5.26)
+const VendorSpecificType radius.Type = 26
+
+// Dictionary holds attribute definitions, a loaded dictionary is safe for concurrent use
+type Dictionary struct {
+	byName   map[string]*Attribute
+	standard map[radius.Type]*Attribute
+	vendor   map[uint32]map[byte]*Attribute
+	vendors  map[uint32]string
+}
+
+var defaultDictionary atomic.Value
+
+// New returns a dictionary of the built-in standard attributes
+func New() *Dictionary {
+	d := &Dictionary{
+		byName:   map[string]*Attribute{},
+		standard: map[radius.Type]*Attribute{},
+		vendor:   map[uint32]map[byte]*Attribute{},
+		vendors:  map[uint32]string{},
+	}
+	if err := d.parse(&stringFile{Reader: strings.NewReader(builtin), name: "dictionary.rfc"}, ""); err != nil {
+		panic(fmt.Sprintf("invalid built-in dictionary: %v", err))
+	}
+	return d
+}
+
+// Load returns a dictionary of the built-in attributes extended with the given dictionary files
+func Load(filenames ...string) (*Dictionary, error) {
+	d := New()
+	for _, filename := range filenames {
+		if err := d.Load(filename); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Load adds attributes of a FreeRADIUS format dictionary file to the dictionary,
+// relative $INCLUDE paths are resolved against the directory of the file. Definitions loaded
+// later override earlier definitions with the same name. Load must not be called concurrently
+// with lookups.
+func (d *Dictionary) Load(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return d.parse(file, filename)
+}
+
+// Default returns the process wide dictionary, the built-in dictionary unless SetDefault was called
+func Default() *Dictionary {
+	if d, ok := defaultDictionary.Load().(*Dictionary); ok {
+		return d
+	}
+	d := New()
+	defaultDictionary.CompareAndSwap(nil, d)
+	return defaultDictionary.Load().(*Dictionary)
+}
+
+// SetDefault sets the process wide dictionary
+func SetDefault(d *Dictionary) {
+	if d != nil {
+		defaultDictionary.Store(d)
+	}
+}
+
+// Attribute returns the attribute definition by its name (case insensitive)
+func (d *Dictionary) Attribute(name string) (*Attribute, bool) {
+	attr, ok := d.byName[strings.ToLower(strings.TrimSpace(name))]
+	return attr, ok
+}
+
+// StandardAttribute returns the definition of a standard attribute type
+func (d *Dictionary) StandardAttribute(typ radius.Type) (*Attribute, bool) {
+	attr, ok := d.standard[typ]
+	return attr, ok
+}
+
+// VendorAttribute returns the definition of a vendor specific attribute
+func (d *Dictionary) VendorAttribute(vendorID uint32, vendorType byte) (*Attribute, bool) {
+	attr, ok := d.vendor[vendorID][vendorType]
+	return attr, ok
+}
+
+// VendorName returns the name of the vendor, empty string if the vendor is unknown
+func (d *Dictionary) VendorName(vendorID uint32) string {
+	return d.vendors[vendorID]
+}
+
+func (d *Dictionary) parse(file dictionary.File, filename string) error {
+	parser := &dictionary.Parser{
+		Opener:                    &dictionary.FileSystemOpener{Root: filepath.Dir(filename)},
+		IgnoreIdenticalAttributes: true,
+	}
+	parsed, err := parser.Parse(file)
+	if err != nil {
+		return err
+	}
+	for _, attr := range parsed.Attributes {
+		if len(attr.OID) != 1 || attr.OID[0] <= 0 || attr.OID[0] > 255 {
+			continue // nested (TLV) attributes are not supported
+		}
+		d.add(newAttribute(attr, radius.Type(attr.OID[0]), 0, 0), parsed.Values)
+	}
+	for _, vendor := range parsed.Vendors {
+		// Only the RFC 2865 recommended 1 octet type & 1 octet length format is supported
+		if vendor.GetTypeOctets() != 1 || vendor.GetLengthOctets() != 1 || vendor.Number <= 0 {
+			continue
+		}
+		vendorID := uint32(vendor.Number)
+		d.vendors[vendorID] = vendor.Name
+		for _, attr := range vendor.Attributes {
+			if len(attr.OID) != 1 || attr.OID[0] < 0 || attr.OID[0] > 255 {
+				continue
+			}
+			d.add(newAttribute(attr, VendorSpecificType, vendorID, byte(attr.OID[0])), vendor.Values)
+		}
+	}
+	return nil
+}
+
+func (d *Dictionary) add(attr *Attribute, values []*dictionary.Value) {
+	for _, v := range values {
+		if strings.EqualFold(v.Attribute, attr.Name) {
+			attr.addValue(v.Name, v.Number)
+		}
+	}
+	if old, ok := d.byName[strings.ToLower(attr.Name)]; ok {
+		if old.IsVendorSpecific() {
+			delete(d.vendor[old.VendorID], old.VendorType)
+		} else {
+			delete(d.standard, old.Type)
+		}
+	}
+	d.byName[strings.ToLower(attr.Name)] = attr
+	if !attr.IsVendorSpecific() {
+		d.standard[attr.Type] = attr
+		return
+	}
+	if _, ok := d.vendor[attr.VendorID]; !ok {
+		d.vendor[attr.VendorID] = map[byte]*Attribute{}
+	}
+	d.vendor[attr.VendorID][attr.VendorType] = attr
+}
+
+type stringFile struct {
+	io.Reader
+	name string
+}
+
+func (f *stringFile) Name() string {
+	return f.name
+}
+
+func (f *stringFile) Close() error {
+	return nil
+}
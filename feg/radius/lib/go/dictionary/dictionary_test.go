@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dictionary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestBuiltinDictionary(t *testing.T) {
+	d := New()
+	attrs := radius.Attributes{}
+	require.NoError(t, d.Add(&attrs, "user-name", "tim"))
+	require.NoError(t, d.Add(&attrs, "Acct-Status-Type", "Interim-Update"))
+	require.NoError(t, d.Add(&attrs, "NAS-IP-Address", "10.0.0.1"))
+	require.NoError(t, d.Add(&attrs, "Session-Timeout", "3600"))
+	attrs.Add(rfc2865.UserPassword_Type, radius.Attribute("encrypted"))
+	attrs.Add(radius.Type(200), radius.Attribute{1, 2})
+
+	assert.Equal(t, "tim", rfc2865.UserName_GetString(&radius.Packet{Attributes: attrs}))
+	v, ok := d.Get(attrs, "Acct-Status-Type")
+	assert.True(t, ok)
+	assert.Equal(t, "Interim-Update", v)
+	assert.Equal(t, []Field{
+		{Name: "User-Name", Value: "tim"},
+		{Name: "Acct-Status-Type", Value: "Interim-Update"},
+		{Name: "NAS-IP-Address", Value: "10.0.0.1"},
+		{Name: "Session-Timeout", Value: "3600"},
+		{Name: "User-Password", Value: maskedValue},
+		{Name: "Attr-200", Value: "0x0102"},
+	}, d.Describe(attrs))
+
+	assert.Error(t, d.Add(&attrs, "Session-Timeout", "forever"))
+	assert.Error(t, d.Add(&attrs, "NAS-IP-Address", "nas"))
+	assert.Error(t, d.Add(&attrs, "Cisco-AVPair", "audit=on"))
+}
+
+func TestVendorDictionary(t *testing.T) {
+	d, err := Load("testdata/dictionary.test")
+	require.NoError(t, err)
+	assert.Equal(t, "Ruckus", d.VendorName(25053))
+	attr, ok := d.Attribute("cisco-avpair")
+	require.True(t, ok)
+	assert.True(t, attr.IsVendorSpecific())
+	assert.Equal(t, uint32(9), attr.VendorID)
+	assert.Equal(t, byte(1), attr.VendorType)
+
+	attrs := radius.Attributes{}
+	require.NoError(t, d.Add(&attrs, "Cisco-AVPair", "audit=on"))
+	require.NoError(t, d.Add(&attrs, "Ruckus-SSID", "magma"))
+	vsa, err := radius.NewVendorSpecific(9, radius.Attribute{
+		1, 7, 'a', '=', 'b', 'c', 'd',
+		195, 6, 0, 0, 0, 4,
+		100, 3, 0xFF,
+	})
+	require.NoError(t, err)
+	attrs.Add(VendorSpecificType, vsa)
+
+	values, err := d.Values(attrs, "Cisco-AVPair")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"audit=on", "a=bcd"}, values)
+	assert.Equal(t, []Field{
+		{Name: "Cisco-AVPair", Value: "audit=on"},
+		{Name: "Ruckus-SSID", Value: "magma"},
+		{Name: "Cisco-AVPair", Value: "a=bcd"},
+		{Name: "Cisco-Disconnect-Cause", Value: "Idle-Timeout"},
+		{Name: "Vendor-9-Attr-100", Value: "0xff"},
+	}, d.Describe(attrs))
+
+	// Removing a vendor attribute keeps other sub-attributes of the Vendor-Specific attribute
+	require.NoError(t, d.Del(&attrs, "Cisco-AVPair"))
+	assert.Len(t, attrs, 2)
+	v, ok := d.Get(attrs, "Cisco-Disconnect-Cause")
+	assert.True(t, ok)
+	assert.Equal(t, "Idle-Timeout", v)
+	require.NoError(t, d.Set(&attrs, "Cisco-Disconnect-Cause", "5"))
+	values, err = d.Values(attrs, "Cisco-Disconnect-Cause")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5"}, values)
+
+	_, err = Load("testdata/no_such.dictionary")
+	assert.Error(t, err)
+}
+
+func TestDefault(t *testing.T) {
+	_, ok := Default().Attribute("Cisco-AVPair")
+	assert.False(t, ok)
+	d, err := Load("testdata/dictionary.test")
+	require.NoError(t, err)
+	SetDefault(d)
+	_, ok = Default().Attribute("Cisco-AVPair")
+	assert.True(t, ok)
+}
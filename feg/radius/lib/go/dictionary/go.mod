@@ -0,0 +1,14 @@
+module fbc/lib/go/dictionary
+
+go 1.20
+
+require (
+	github.com/stretchr/testify v1.7.0
+	layeh.com/radius v0.0.0-20210819152912-ad72663a72ab
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
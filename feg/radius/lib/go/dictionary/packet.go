@@ -0,0 +1,221 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dictionary
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"layeh.com/radius"
+)
+
+const maskedValue = "******"
+
+// Field a decoded attribute
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Values returns the decoded values of the named attribute found in attrs
+func (d *Dictionary) Values(attrs radius.Attributes, name string) ([]string, error) {
+	attr, ok := d.Attribute(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown attribute '%s'", name)
+	}
+	var result []string
+	for _, v := range attr.Lookup(attrs) {
+		result = append(result, attr.Decode(v))
+	}
+	return result, nil
+}
+
+// Get returns the first decoded value of the named attribute
+func (d *Dictionary) Get(attrs radius.Attributes, name string) (string, bool) {
+	values, err := d.Values(attrs, name)
+	if err != nil || len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
+}
+
+// Add encodes & appends a value of the named attribute to attrs
+func (d *Dictionary) Add(attrs *radius.Attributes, name, value string) error {
+	attr, ok := d.Attribute(name)
+	if !ok {
+		return fmt.Errorf("unknown attribute '%s'", name)
+	}
+	encoded, err := attr.Encode(value)
+	if err != nil {
+		return err
+	}
+	return attr.Add(attrs, encoded)
+}
+
+// Set replaces all values of the named attribute in attrs with the given value
+func (d *Dictionary) Set(attrs *radius.Attributes, name, value string) error {
+	attr, ok := d.Attribute(name)
+	if !ok {
+		return fmt.Errorf("unknown attribute '%s'", name)
+	}
+	encoded, err := attr.Encode(value)
+	if err != nil {
+		return err
+	}
+	attr.Del(attrs)
+	return attr.Add(attrs, encoded)
+}
+
+// Del removes all values of the named attribute from attrs
+func (d *Dictionary) Del(attrs *radius.Attributes, name string) error {
+	attr, ok := d.Attribute(name)
+	if !ok {
+		return fmt.Errorf("unknown attribute '%s'", name)
+	}
+	attr.Del(attrs)
+	return nil
+}
+
+// Describe decodes all attributes for logging, vendor specific attributes are split into their
+// sub-attributes, unknown attributes are named after their types & encrypted values are masked
+func (d *Dictionary) Describe(attrs radius.Attributes) []Field {
+	result := make([]Field, 0, len(attrs))
+	for _, avp := range attrs {
+		if avp.Type == VendorSpecificType {
+			if subs, ok := parseVendorAttributes(avp.Attribute); ok {
+				vendorID := binary.BigEndian.Uint32(avp.Attribute)
+				for _, sub := range subs {
+					result = append(result, d.describe(d.vendor[vendorID][sub.typ], sub.value,
+						fmt.Sprintf("Vendor-%d-Attr-%d", vendorID, sub.typ)))
+				}
+				continue
+			}
+		}
+		result = append(result, d.describe(d.standard[avp.Type], avp.Attribute, fmt.Sprintf("Attr-%d", avp.Type)))
+	}
+	return result
+}
+
+func (d *Dictionary) describe(attr *Attribute, value radius.Attribute, unknownName string) Field {
+	switch {
+	case attr == nil:
+		return Field{Name: unknownName, Value: "0x" + hex.EncodeToString(value)}
+	case attr.Encrypted:
+		return Field{Name: attr.Name, Value: maskedValue}
+	}
+	return Field{Name: attr.Name, Value: attr.Decode(value)}
+}
+
+// Lookup returns all raw values of the attribute found in attrs
+func (a *Attribute) Lookup(attrs radius.Attributes) []radius.Attribute {
+	var result []radius.Attribute
+	for _, avp := range attrs {
+		if avp.Type != a.Type {
+			continue
+		}
+		if !a.IsVendorSpecific() {
+			result = append(result, avp.Attribute)
+			continue
+		}
+		subs, ok := parseVendorAttributes(avp.Attribute)
+		if !ok || binary.BigEndian.Uint32(avp.Attribute) != a.VendorID {
+			continue
+		}
+		for _, sub := range subs {
+			if sub.typ == a.VendorType {
+				result = append(result, sub.value)
+			}
+		}
+	}
+	return result
+}
+
+// Add appends a raw value of the attribute to attrs, vendor attributes are added in their own
+// Vendor-Specific attribute
+func (a *Attribute) Add(attrs *radius.Attributes, value radius.Attribute) error {
+	if !a.IsVendorSpecific() {
+		if len(value) > 253 {
+			return fmt.Errorf("%s value is too long", a.Name)
+		}
+		attrs.Add(a.Type, value)
+		return nil
+	}
+	if len(value) > 247 {
+		return fmt.Errorf("%s value is too long", a.Name)
+	}
+	attrs.Add(a.Type, encodeVendorAttributes(a.VendorID, []vendorAttribute{{typ: a.VendorType, value: value}}))
+	return nil
+}
+
+// Del removes all values of the attribute from attrs, other sub-attributes of
+// Vendor-Specific attributes are preserved
+func (a *Attribute) Del(attrs *radius.Attributes) {
+	result := (*attrs)[:0]
+	for _, avp := range *attrs {
+		if avp.Type != a.Type {
+			result = append(result, avp)
+			continue
+		}
+		if !a.IsVendorSpecific() {
+			continue
+		}
+		subs, ok := parseVendorAttributes(avp.Attribute)
+		if !ok || binary.BigEndian.Uint32(avp.Attribute) != a.VendorID {
+			result = append(result, avp)
+			continue
+		}
+		remaining := subs[:0]
+		for _, sub := range subs {
+			if sub.typ != a.VendorType {
+				remaining = append(remaining, sub)
+			}
+		}
+		if len(remaining) > 0 {
+			result = append(result, &radius.AVP{Type: avp.Type, Attribute: encodeVendorAttributes(a.VendorID, remaining)})
+		}
+	}
+	*attrs = result
+}
+
+type vendorAttribute struct {
+	typ   byte
+	value radius.Attribute
+}
+
+// parseVendorAttributes parses Vendor-Specific attribute data formatted as recommended by RFC 2865, section 5.26
+func parseVendorAttributes(vsa radius.Attribute) ([]vendorAttribute, bool) {
+	if len(vsa) < 6 {
+		return nil, false
+	}
+	var result []vendorAttribute
+	for data := vsa[4:]; len(data) > 0; {
+		if len(data) < 2 || int(data[1]) < 2 || int(data[1]) > len(data) {
+			return nil, false
+		}
+		result = append(result, vendorAttribute{typ: data[0], value: data[2:data[1]]})
+		data = data[data[1]:]
+	}
+	return result, true
+}
+
+func encodeVendorAttributes(vendorID uint32, subs []vendorAttribute) radius.Attribute {
+	result := make(radius.Attribute, 4, 6)
+	binary.BigEndian.PutUint32(result, vendorID)
+	for _, sub := range subs {
+		result = append(result, sub.typ, byte(len(sub.value)+2))
+		result = append(result, sub.value...)
+	}
+	return result
+}
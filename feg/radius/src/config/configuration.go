@@ -69,6 +69,28 @@ type (
 		DefaultTier  string        `json:"defaultTier"`
 	}
 
+	// RateLimit a token bucket limit of requests
+	RateLimit struct {
+		Rate  float64 `json:"rate"`  // sustained requests per second, 0 - not limited
+		Burst int     `json:"burst"` // bucket size, defaults to the rate rounded up
+	}
+
+	// DuplicateBurstConfig limits identical requests, retransmitted with new RADIUS identifiers
+	DuplicateBurstConfig struct {
+		Window    Duration `json:"window"`
+		Threshold int      `json:"threshold"` // max identical requests within the window, 0 - not limited
+	}
+
+	// RateLimitConfig holds the configuration of the ratelimit filter
+	RateLimitConfig struct {
+		PerNAS            RateLimit            `json:"perNas"`
+		PerCallingStation RateLimit            `json:"perCallingStation"`
+		DuplicateBurst    DuplicateBurstConfig `json:"duplicateBurst"`
+		Action            string               `json:"action"`     // drop (default), reject or delay
+		Delay             Duration             `json:"delay"`      // delay of the delay action
+		MaxDelayed        int                  `json:"maxDelayed"` // max concurrently delayed requests, the rest is dropped
+	}
+
 	// RedisConfig the configuration of redus server
 	RedisConfig struct {
 		Addr     string `json:"addr"`
@@ -87,9 +109,11 @@ type (
 		Secret         string                `json:"secret"`
 		DedupWindow    Duration              `json:"dedupWindow"`
 		LoadBalance    LoadBalanceConfig     `json:"loadBalance"`
+		RateLimit      RateLimitConfig       `json:"rateLimit"`
 		Listeners      []ListenerConfig      `json:"listeners"`
 		Filters        []string              `json:"filters"`
 		SessionStorage *SessionStorageConfig `json:"sessionStorage"`
+		Dictionaries   []string              `json:"dictionaries"` // FreeRADIUS format dictionary files
 	}
 
 	// MonitoringConfig ...
@@ -14,12 +14,23 @@ limitations under the License.
 package filters
 
 import (
+	"errors"
+
 	"fbc/cwf/radius/config"
 	"fbc/cwf/radius/modules"
 
 	"layeh.com/radius"
 )
 
+var (
+	// ErrReject is returned (possibly wrapped) by a filter to have an Access-Request answered
+	// with Access-Reject, other requests are dropped
+	ErrReject = errors.New("request rejected by filter")
+	// ErrDrop is returned (possibly wrapped) by a filter to have the request dropped silently, e.g. by
+	// a policy, any other filter error also drops the request but is reported as a filter failure
+	ErrDrop = errors.New("request dropped by filter")
+)
+
 type (
 	// Filter represents a request filter action
 	Filter interface {
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/config"
+)
+
+// sweepInterval is the minimal interval between removals of idle entries
+const sweepInterval = time.Minute
+
+// buckets is a set of token buckets with the same rate & size, keyed by the limited entity
+type buckets struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	entries   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newBuckets returns nil for a disabled (zero rate) limit
+func newBuckets(limit config.RateLimit) (*buckets, error) {
+	if limit.Rate < 0 || limit.Burst < 0 {
+		return nil, errors.New("rate and burst must not be negative")
+	}
+	if limit.Rate == 0 {
+		return nil, nil
+	}
+	burst := float64(limit.Burst)
+	if burst == 0 {
+		burst = math.Ceil(limit.Rate)
+	}
+	return &buckets{rate: limit.Rate, burst: burst, entries: map[string]*bucket{}}, nil
+}
+
+// take takes a token from the key's bucket, it returns false if the bucket is empty
+func (b *buckets) take(key string, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sweep(now)
+	e, ok := b.entries[key]
+	if !ok {
+		e = &bucket{tokens: b.burst, last: now}
+		b.entries[key] = e
+	}
+	e.tokens = b.refill(e, now)
+	e.last = now
+	if e.tokens < 1 {
+		return false
+	}
+	e.tokens--
+	return true
+}
+
+func (b *buckets) refill(e *bucket, now time.Time) float64 {
+	return math.Min(b.burst, e.tokens+now.Sub(e.last).Seconds()*b.rate)
+}
+
+// sweep removes full buckets, they are the same as new ones
+func (b *buckets) sweep(now time.Time) {
+	if now.Sub(b.lastSweep) < sweepInterval {
+		return
+	}
+	b.lastSweep = now
+	for key, e := range b.entries {
+		if b.refill(e, now) >= b.burst {
+			delete(b.entries, key)
+		}
+	}
+}
+
+// windows counts occurrences of keys within fixed time windows starting at a key's first occurrence
+type windows struct {
+	mu        sync.Mutex
+	length    time.Duration
+	threshold int
+	entries   map[uint64]*window
+	lastSweep time.Time
+}
+
+type window struct {
+	start time.Time
+	count int
+}
+
+func newWindows(length time.Duration, threshold int) *windows {
+	return &windows{length: length, threshold: threshold, entries: map[uint64]*window{}}
+}
+
+// add counts an occurrence of the key, it returns false if the key occurred more than threshold
+// times within its current window
+func (w *windows) add(key uint64, now time.Time) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.sweep(now)
+	e, ok := w.entries[key]
+	if !ok || now.Sub(e.start) >= w.length {
+		e = &window{start: now}
+		w.entries[key] = e
+	}
+	e.count++
+	return e.count <= w.threshold
+}
+
+func (w *windows) sweep(now time.Time) {
+	if now.Sub(w.lastSweep) < sweepInterval {
+		return
+	}
+	w.lastSweep = now
+	for key, e := range w.entries {
+		if now.Sub(e.start) >= w.length {
+			delete(w.entries, key)
+		}
+	}
+}
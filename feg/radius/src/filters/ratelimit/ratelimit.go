@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit implements a filter limiting the rate of requests per NAS and per
+// calling station, and suppressing bursts of identical requests retransmitted by a NAS
+// with new RADIUS identifiers (which the server's dedup logic can't detect)
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/filters"
+	"fbc/cwf/radius/modules"
+	"fbc/cwf/radius/monitoring"
+
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+	"layeh.com/radius/rfc2869"
+)
+
+// Actions taken on a request exceeding a limit
+const (
+	ActionDrop   = "drop"
+	ActionReject = "reject"
+	ActionDelay  = "delay"
+)
+
+const (
+	filterName = "ratelimit"
+
+	limitNAS            = "nas"
+	limitCallingStation = "calling_station"
+	limitDuplicate      = "duplicate"
+
+	defaultDuplicateWindow = 10 * time.Second
+	defaultDelay           = time.Second
+	defaultMaxDelayed      = 1000
+)
+
+// attributes which differ between retransmissions of the same request by a NAS
+var volatileAttributes = map[radius.Type]bool{
+	rfc2865.UserPassword_Type:         true,
+	rfc2865.ProxyState_Type:           true,
+	rfc2866.AcctDelayTime_Type:        true,
+	rfc2869.EventTimestamp_Type:       true,
+	rfc2869.MessageAuthenticator_Type: true,
+}
+
+type limiter struct {
+	nas        *buckets
+	stations   *buckets
+	duplicates *windows
+	action     string
+	delay      time.Duration
+	maxDelayed int32
+	delayed    int32
+	counters   monitoring.RateLimitCounters
+}
+
+var l *limiter
+
+// Init filter interface implementation
+func Init(c *config.ServerConfig) error {
+	lim, err := newLimiter(c.RateLimit)
+	if err != nil {
+		return err
+	}
+	l = lim
+	return nil
+}
+
+// Process filter interface implementation
+func Process(c *modules.RequestContext, listener string, r *radius.Request) error {
+	return l.process(c, listener, r, time.Now())
+}
+
+func newLimiter(cfg config.RateLimitConfig) (*limiter, error) {
+	lim := &limiter{
+		action:     cfg.Action,
+		delay:      cfg.Delay.Duration,
+		maxDelayed: int32(cfg.MaxDelayed),
+		counters:   monitoring.CreateRateLimitCounters(filterName),
+	}
+	switch lim.action {
+	case "":
+		lim.action = ActionDrop
+	case ActionDrop, ActionReject, ActionDelay:
+	default:
+		return nil, fmt.Errorf("invalid rate limit action '%s', must be one of %s, %s or %s",
+			cfg.Action, ActionDrop, ActionReject, ActionDelay)
+	}
+	if lim.delay <= 0 {
+		lim.delay = defaultDelay
+	}
+	if lim.maxDelayed <= 0 {
+		lim.maxDelayed = defaultMaxDelayed
+	}
+	var err error
+	if lim.nas, err = newBuckets(cfg.PerNAS); err != nil {
+		return nil, fmt.Errorf("invalid per NAS rate limit: %v", err)
+	}
+	if lim.stations, err = newBuckets(cfg.PerCallingStation); err != nil {
+		return nil, fmt.Errorf("invalid per calling station rate limit: %v", err)
+	}
+	if cfg.DuplicateBurst.Threshold < 0 || cfg.DuplicateBurst.Window.Duration < 0 {
+		return nil, errors.New("invalid duplicate burst threshold or window")
+	}
+	if cfg.DuplicateBurst.Threshold > 0 {
+		window := cfg.DuplicateBurst.Window.Duration
+		if window == 0 {
+			window = defaultDuplicateWindow
+		}
+		lim.duplicates = newWindows(window, cfg.DuplicateBurst.Threshold)
+	}
+	return lim, nil
+}
+
+func (lim *limiter) process(c *modules.RequestContext, listener string, r *radius.Request, now time.Time) error {
+	if r.Code != radius.CodeAccessRequest && r.Code != radius.CodeAccountingRequest {
+		return nil
+	}
+	nas := nasID(r)
+	station := rfc2865.CallingStationID_GetString(r.Packet)
+
+	var limit string
+	switch {
+	case lim.duplicates != nil && !lim.duplicates.add(fingerprint(nas, r), now):
+		limit = limitDuplicate
+	case lim.nas != nil && !lim.nas.take(nas, now):
+		limit = limitNAS
+	case lim.stations != nil && len(station) > 0 && !lim.stations.take(station, now):
+		limit = limitCallingStation
+	default:
+		lim.counters.Allowed(listener, r.Code.String())
+		return nil
+	}
+
+	action := lim.action
+	if action == ActionReject && r.Code != radius.CodeAccessRequest {
+		action = ActionDrop // there is no reject for other requests
+	}
+	if action == ActionDelay {
+		if atomic.AddInt32(&lim.delayed, 1) > lim.maxDelayed {
+			atomic.AddInt32(&lim.delayed, -1)
+			action = ActionDrop
+		} else {
+			defer atomic.AddInt32(&lim.delayed, -1)
+		}
+	}
+	lim.counters.Limited(listener, r.Code.String(), limit, action)
+	if ce := c.Logger.Check(zap.DebugLevel, "Request exceeded rate limit"); ce != nil {
+		ce.Write(
+			zap.String("limit", limit),
+			zap.String("action", action),
+			zap.String("nas", nas),
+			zap.String("calling_station", station),
+		)
+	}
+
+	switch action {
+	case ActionDelay:
+		time.Sleep(lim.delay)
+		return nil
+	case ActionReject:
+		return fmt.Errorf("%w: %s rate limit exceeded by NAS %s", filters.ErrReject, limit, nas)
+	default:
+		return fmt.Errorf("%w: %s rate limit exceeded by NAS %s", filters.ErrDrop, limit, nas)
+	}
+}
+
+// nasID identifies the NAS of the request by its NAS-IP-Address, NAS-Identifier or source address
+func nasID(r *radius.Request) string {
+	if ip := rfc2865.NASIPAddress_Get(r.Packet); ip != nil {
+		return ip.String()
+	}
+	if id := rfc2865.NASIdentifier_GetString(r.Packet); len(id) > 0 {
+		return id
+	}
+	if r.RemoteAddr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr.String()); err == nil {
+		return host
+	}
+	return r.RemoteAddr.String()
+}
+
+// fingerprint hashes the request content which is the same for all its retransmissions
+func fingerprint(nas string, r *radius.Request) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(nas))
+	h.Write([]byte{byte(r.Code)})
+	for _, avp := range r.Attributes {
+		if volatileAttributes[avp.Type] {
+			continue
+		}
+		h.Write([]byte{byte(avp.Type), byte(len(avp.Attribute))})
+		h.Write(avp.Attribute)
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/filters"
+	"fbc/cwf/radius/modules"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+const dummyListener = "dummyListener"
+
+var requestContext = &modules.RequestContext{Logger: zap.NewNop()}
+
+func newRequest(code radius.Code, nas string, station string, eap string) *radius.Request {
+	packet := radius.New(code, []byte("123456"))
+	rfc2865.NASIdentifier_SetString(packet, nas)
+	if station != "" {
+		rfc2865.CallingStationID_SetString(packet, station)
+	}
+	rfc2869.EAPMessage_Set(packet, []byte(eap))
+	rfc2869.MessageAuthenticator_Set(packet, packet.Authenticator[:])
+	return &radius.Request{
+		Packet:     packet,
+		RemoteAddr: &net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1812},
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	require.Error(t, Init(&config.ServerConfig{RateLimit: config.RateLimitConfig{Action: "block"}}))
+	require.Error(t, Init(&config.ServerConfig{RateLimit: config.RateLimitConfig{PerNAS: config.RateLimit{Rate: -1}}}))
+	require.Error(t, Init(&config.ServerConfig{
+		RateLimit: config.RateLimitConfig{DuplicateBurst: config.DuplicateBurstConfig{Threshold: -1}},
+	}))
+	require.NoError(t, Init(&config.ServerConfig{}))
+}
+
+func TestPerNASLimit(t *testing.T) {
+	lim, err := newLimiter(config.RateLimitConfig{PerNAS: config.RateLimit{Rate: 1, Burst: 2}})
+	require.NoError(t, err)
+	now := time.Now()
+
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "1"), now))
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s2", "2"), now))
+	err = lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s3", "3"), now)
+	require.True(t, errors.Is(err, filters.ErrDrop))
+	require.False(t, errors.Is(err, filters.ErrReject))
+
+	// other NAS has its own bucket & the bucket refills over time
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap2", "s1", "4"), now))
+	require.NoError(t, lim.process(
+		requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s3", "3"), now.Add(time.Second)))
+
+	// requests other than Access & Accounting requests are not limited
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeStatusServer, "ap1", "", ""), now))
+}
+
+func TestPerCallingStationLimitWithReject(t *testing.T) {
+	lim, err := newLimiter(config.RateLimitConfig{
+		PerCallingStation: config.RateLimit{Rate: 1},
+		Action:            ActionReject,
+	})
+	require.NoError(t, err)
+	now := time.Now()
+
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "1"), now))
+	err = lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap2", "s1", "2"), now)
+	require.True(t, errors.Is(err, filters.ErrReject))
+
+	// Accounting-Request can't be rejected, it is dropped
+	err = lim.process(requestContext, dummyListener, newRequest(radius.CodeAccountingRequest, "ap1", "s1", "3"), now)
+	require.True(t, errors.Is(err, filters.ErrDrop))
+	require.False(t, errors.Is(err, filters.ErrReject))
+
+	// requests without Calling-Station-Id are not limited per calling station
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "", "4"), now))
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "", "5"), now))
+}
+
+func TestDuplicateBurst(t *testing.T) {
+	lim, err := newLimiter(config.RateLimitConfig{
+		DuplicateBurst: config.DuplicateBurstConfig{Threshold: 2, Window: config.Duration{Duration: 10 * time.Second}},
+	})
+	require.NoError(t, err)
+	now := time.Now()
+
+	// retransmissions get new identifiers, authenticators & Message-Authenticators
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "1"), now))
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "1"), now))
+	require.Error(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "1"), now))
+
+	// a different request is not a duplicate
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "2"), now))
+
+	// a new window starts after the current one ends
+	require.NoError(t, lim.process(
+		requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "1"), now.Add(10*time.Second)))
+}
+
+func TestDelay(t *testing.T) {
+	lim, err := newLimiter(config.RateLimitConfig{
+		PerNAS:     config.RateLimit{Rate: 1},
+		Action:     ActionDelay,
+		Delay:      config.Duration{Duration: 20 * time.Millisecond},
+		MaxDelayed: 1,
+	})
+	require.NoError(t, err)
+	now := time.Now()
+
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "1"), now))
+	start := time.Now()
+	require.NoError(t, lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "2"), now))
+	require.True(t, time.Since(start) >= 20*time.Millisecond)
+
+	// requests over the max delayed are dropped
+	lim.delayed = 1
+	err = lim.process(requestContext, dummyListener, newRequest(radius.CodeAccessRequest, "ap1", "s1", "3"), now)
+	require.True(t, errors.Is(err, filters.ErrDrop))
+}
+
+func TestSweep(t *testing.T) {
+	b, err := newBuckets(config.RateLimit{Rate: 10, Burst: 10})
+	require.NoError(t, err)
+	w := newWindows(time.Second, 1)
+	now := time.Now()
+
+	require.True(t, b.take("ap1", now))
+	require.True(t, w.add(1, now))
+	require.Len(t, b.entries, 1)
+	require.Len(t, w.entries, 1)
+
+	require.True(t, b.take("ap2", now.Add(sweepInterval)))
+	require.True(t, w.add(2, now.Add(sweepInterval)))
+	require.Len(t, b.entries, 1)
+	require.Contains(t, b.entries, "ap2")
+	require.Len(t, w.entries, 1)
+	require.Contains(t, w.entries, uint64(2))
+}
@@ -1,6 +1,7 @@
 module fbc/cwf/radius
 
 replace (
+	fbc/lib/go/dictionary => ../lib/go/dictionary
 	fbc/lib/go/machine => ../lib/go/machine
 	magma/orc8r/lib/go => ../../../orc8r/lib/go
 	magma/orc8r/lib/go/protos => ../../../orc8r/lib/go/protos
@@ -8,6 +9,7 @@ replace (
 
 require (
 	contrib.go.opencensus.io/exporter/prometheus v0.1.0
+	fbc/lib/go/dictionary v0.0.0
 	github.com/alicebob/miniredis v2.5.0+incompatible
 	github.com/go-redis/redis v6.15.5+incompatible
 	github.com/google/uuid v1.1.2
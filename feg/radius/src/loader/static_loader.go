@@ -20,6 +20,7 @@ import (
 	"fbc/cwf/radius/filters"
 	filtlballocate "fbc/cwf/radius/filters/lballocate"
 	filtlbcanary "fbc/cwf/radius/filters/lbcanary"
+	filtratelimit "fbc/cwf/radius/filters/ratelimit"
 	"fbc/cwf/radius/modules"
 	modalwaysaccept "fbc/cwf/radius/modules/alwaysaccept"
 	modan "fbc/cwf/radius/modules/analytics"
@@ -31,6 +32,7 @@ import (
 	modmagmaacct "fbc/cwf/radius/modules/magmaacct"
 	ofpanalytics "fbc/cwf/radius/modules/ofpanalytics"
 	modproxy "fbc/cwf/radius/modules/proxy"
+	modrewrite "fbc/cwf/radius/modules/rewrite"
 	modloopback "fbc/cwf/radius/modules/testloopback"
 	testsessionstorage "fbc/cwf/radius/modules/testsessionstorage"
 
@@ -69,11 +71,13 @@ var CWFModuleMap = ModuleNameMap{
 	"alwaysaccept":       func() modules.Module { return NewModule(modalwaysaccept.Init, modalwaysaccept.Handle) },
 	"magmaacct":          func() modules.Module { return NewModule(modmagmaacct.Init, modmagmaacct.Handle) },
 	"testsessionstorage": func() modules.Module { return NewModule(testsessionstorage.Init, testsessionstorage.Handle) },
+	"rewrite":            func() modules.Module { return NewModule(modrewrite.Init, modrewrite.Handle) },
 }
 
 var CWFFilterMap = FilterNameMap{
 	"lballocate": func() filters.Filter { return NewFilter(filtlballocate.Init, filtlballocate.Process) },
 	"lbcanary":   func() filters.Filter { return NewFilter(filtlbcanary.Init, filtlbcanary.Process) },
+	"ratelimit":  func() filters.Filter { return NewFilter(filtratelimit.Init, filtratelimit.Process) },
 }
 
 // NewStaticLoader create a loader that loads from file system
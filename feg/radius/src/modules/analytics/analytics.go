@@ -24,6 +24,7 @@ import (
 	"fbc/cwf/radius/modules"
 	"fbc/cwf/radius/modules/analytics/graphql"
 	"fbc/cwf/radius/session"
+	"fbc/lib/go/dictionary"
 
 	"github.com/mitchellh/mapstructure"
 	"go.uber.org/zap"
@@ -41,6 +42,9 @@ type Config struct {
 	GraphQLURL    string // the GraphQL endpoint to issue calls to
 	DryRunGraphQL bool   // true means all GraphQL operations will be skipped & assumed successful.
 	AllowPII      bool   // If true, PII will not be tokenized before sending to GraphQL
+	// Names of additional (e.g. vendor specific) attributes to log with processed packets,
+	// attributes are decoded using the server's dictionary
+	LogAttributes []string
 }
 
 type (
@@ -74,6 +78,12 @@ func Init(logger *zap.Logger, config modules.ModuleConfig) (modules.Context, err
 		logger.Warn("ANALYTICS IS SET TO ALLOW PII BE SENT OUT")
 	}
 
+	for _, name := range ctx.cfg.LogAttributes {
+		if _, ok := dictionary.Default().Attribute(name); !ok {
+			logger.Warn("attribute to log is not found in the dictionary", zap.String("attribute", name))
+		}
+	}
+
 	ctx.graphQLOps = make(map[string]*Queue)
 	// Create client
 	ctx.graphqlClient = graphql.NewClient(graphql.ClientConfig{
@@ -137,7 +147,7 @@ func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, nex
 			normalizedMacAddress = strings.ToUpper(normalizedMacAddress[:calledStationIDSeparator])
 		}
 		c.Logger.Info("processing auth packet", zap.String("framed_ip_addr", framedIPAddr),
-			zap.String("nas_ip_addr", nasIDAddr))
+			zap.String("nas_ip_addr", nasIDAddr), logAttributes(mCtx.cfg, pkt))
 		session := RadiusSession{
 			NASIPAddress:         nasIDAddr,
 			NASIdentifier:        rfc2865.NASIdentifier_GetString(pkt),
@@ -196,6 +206,7 @@ func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, nex
 				"processing accounting packet",
 				zap.Int64("input_bytes", inputBytes),
 				zap.Int64("output_bytes", outputBytes),
+				logAttributes(mCtx.cfg, pkt),
 			)
 
 			// Extract accounting octets
@@ -239,6 +250,21 @@ func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, nex
 	return resp, err
 }
 
+// logAttributes returns a log field of the configured additional attributes found in the packet
+func logAttributes(cfg Config, pkt *radius.Packet) zap.Field {
+	if len(cfg.LogAttributes) == 0 {
+		return zap.Skip()
+	}
+	dict := dictionary.Default()
+	attributes := map[string][]string{}
+	for _, name := range cfg.LogAttributes {
+		if values, err := dict.Values(pkt.Attributes, name); err == nil && len(values) > 0 {
+			attributes[name] = values
+		}
+	}
+	return zap.Any("attributes", attributes)
+}
+
 // tokenize tokenize a PII string field
 func tokenize(s string) string {
 	h := sha1.New()
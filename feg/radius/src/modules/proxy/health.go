@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"time"
+
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2869"
+)
+
+// healthChecker periodically probes the upstreams of a pool with
+// Status-Server requests (RFC 5997)
+type healthChecker struct {
+	pool     *pool
+	secret   []byte
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// run probes all upstreams every interval until ctx is done
+func (h *healthChecker) run(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		h.probeAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *healthChecker) probeAll(ctx context.Context) {
+	done := make(chan struct{}, len(h.pool.upstreams))
+	for _, u := range h.pool.upstreams {
+		go func(u *upstream) {
+			h.probe(ctx, u)
+			done <- struct{}{}
+		}(u)
+	}
+	for range h.pool.upstreams {
+		<-done
+	}
+}
+
+// probe sends a single Status-Server request to the upstream; any authentic
+// response means the upstream is alive
+func (h *healthChecker) probe(ctx context.Context, u *upstream) {
+	packet, err := newStatusServer(h.secret)
+	if err != nil {
+		h.pool.logger.Error("failed to create Status-Server request", zap.Error(err))
+		return
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	_, err = radius.Exchange(probeCtx, packet, u.address)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		reason, _ := classify(err)
+		u.counters.HealthCheck(reason)
+		h.pool.logger.Debug("Status-Server probe failed", zap.String("upstream", u.address), zap.Error(err))
+		u.failed(h.pool)
+		return
+	}
+	u.counters.HealthCheck("")
+	u.succeeded(h.pool)
+}
+
+// newStatusServer creates a Status-Server request signed with a
+// Message-Authenticator, as required by RFC 5997 section 3
+func newStatusServer(secret []byte) (*radius.Packet, error) {
+	packet := radius.New(radius.CodeStatusServer, secret)
+	if err := rfc2869.MessageAuthenticator_Set(packet, make([]byte, md5.Size)); err != nil {
+		return nil, err
+	}
+	// Status-Server is sent with its request authenticator as-is, so the
+	// HMAC over the marshaled packet matches the bytes on the wire
+	b, err := packet.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(md5.New, secret)
+	mac.Write(b)
+	if err := rfc2869.MessageAuthenticator_Set(packet, mac.Sum(nil)); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
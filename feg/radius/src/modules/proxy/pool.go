@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/monitoring"
+
+	"go.uber.org/zap"
+	"layeh.com/radius"
+)
+
+type (
+	// upstream is a single RADIUS server of the pool along with its health state
+	upstream struct {
+		address  string
+		weight   int
+		counters monitoring.UpstreamCounters
+
+		mu        sync.Mutex
+		healthy   bool
+		failures  int // consecutive failed requests & probes
+		successes int // consecutive successful probes while unhealthy
+	}
+
+	// pool selects upstreams by weight and fails over between them
+	pool struct {
+		upstreams        []*upstream
+		timeout          time.Duration
+		retries          int
+		failThreshold    int // 0 means upstreams are never marked unhealthy
+		recoverThreshold int
+		logger           *zap.Logger
+	}
+)
+
+// errNoUpstream is returned when the pool has no upstream left to try
+var errNoUpstream = errors.New("no upstream available")
+
+// exchange sends the packet to an upstream of the pool, retrying on the next
+// selected upstream when an attempt times out or fails on the network level
+func (p *pool) exchange(ctx context.Context, packet *radius.Packet) (*radius.Packet, *upstream, error) {
+	tried := map[*upstream]bool{}
+	err := errNoUpstream
+	for attempt := 0; attempt <= p.retries; attempt++ {
+		u := p.pick(tried)
+		if u == nil {
+			break
+		}
+		tried[u] = true
+
+		var res *radius.Packet
+		start := time.Now()
+		attemptCtx, cancel := context.WithTimeout(ctx, p.timeout)
+		res, err = radius.Exchange(attemptCtx, packet, u.address)
+		cancel()
+		latency := time.Since(start)
+		if err == nil {
+			u.counters.Success(packet.Code.String(), res.Code.String(), latency)
+			u.succeeded(p)
+			return res, u, nil
+		}
+
+		reason, retriable := classify(err)
+		u.counters.Failure(packet.Code.String(), reason, latency)
+		if !retriable || ctx.Err() != nil {
+			return nil, u, err
+		}
+		u.failed(p)
+	}
+	return nil, nil, err
+}
+
+// pick selects an upstream by weight, preferring healthy upstreams which were
+// not tried yet. When all of them were tried, unhealthy upstreams are tried
+// next and only then healthy upstreams are retried.
+func (p *pool) pick(tried map[*upstream]bool) *upstream {
+	tiers := []func(u *upstream) bool{
+		func(u *upstream) bool { return u.isHealthy() && !tried[u] },
+		func(u *upstream) bool { return !tried[u] },
+		func(u *upstream) bool { return u.isHealthy() },
+		func(u *upstream) bool { return true },
+	}
+	for _, eligible := range tiers {
+		total := 0
+		candidates := make([]*upstream, 0, len(p.upstreams))
+		for _, u := range p.upstreams {
+			if eligible(u) {
+				candidates = append(candidates, u)
+				total += u.weight
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		n := rand.Intn(total)
+		for _, u := range candidates {
+			if n < u.weight {
+				return u
+			}
+			n -= u.weight
+		}
+	}
+	return nil
+}
+
+// classify returns a metric error code for err and whether the request should
+// be retried on another upstream
+func classify(err error) (string, bool) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout", true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return "timeout", true
+		}
+		return "network_error", true
+	}
+	return "exchange_error", false
+}
+
+func (u *upstream) isHealthy() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.healthy
+}
+
+// succeeded resets the consecutive failures of the upstream
+func (u *upstream) succeeded(p *pool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.failures = 0
+	if !u.healthy {
+		u.successes++
+		if u.successes >= p.recoverThreshold {
+			u.setHealthy(p, true)
+		}
+	}
+}
+
+// failed marks the upstream unhealthy after too many consecutive failures
+func (u *upstream) failed(p *pool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.successes = 0
+	u.failures++
+	if u.healthy && p.failThreshold > 0 && u.failures >= p.failThreshold {
+		u.setHealthy(p, false)
+	}
+}
+
+// setHealthy must be called with the upstream lock held
+func (u *upstream) setHealthy(p *pool, healthy bool) {
+	p.logger.Warn("upstream health changed", zap.String("upstream", u.address), zap.Bool("healthy", healthy))
+	u.healthy = healthy
+	u.failures = 0
+	u.successes = 0
+	u.counters.Healthy(healthy)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/modules"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+var testSecret = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+// testUpstream is a RADIUS server counting the requests it answered
+type testUpstream struct {
+	addr         string
+	requests     int32
+	statusServer int32
+	down         int32 // when set, requests are silently dropped
+	conn         net.PacketConn
+}
+
+func startUpstream(t *testing.T, name string) *testUpstream {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	u := &testUpstream{addr: conn.LocalAddr().String(), conn: conn}
+	server := radius.PacketServer{
+		Handler: radius.HandlerFunc(func(w radius.ResponseWriter, r *radius.Request) {
+			if atomic.LoadInt32(&u.down) != 0 {
+				return
+			}
+			if r.Code == radius.CodeStatusServer {
+				if validMessageAuthenticator(r.Packet) {
+					atomic.AddInt32(&u.statusServer, 1)
+					w.Write(r.Response(radius.CodeAccessAccept))
+				}
+				return
+			}
+			atomic.AddInt32(&u.requests, 1)
+			resp := r.Response(radius.CodeAccessAccept)
+			resp.Add(rfc2865.ReplyMessage_Type, radius.Attribute(name))
+			w.Write(resp)
+		}),
+		SecretSource: radius.StaticSecretSource(testSecret),
+	}
+	go server.Serve(conn)
+	t.Cleanup(func() { server.Shutdown(context.Background()) })
+	return u
+}
+
+func validMessageAuthenticator(p *radius.Packet) bool {
+	received := rfc2869.MessageAuthenticator_Get(p)
+	if len(received) != md5.Size {
+		return false
+	}
+	clone := *p
+	clone.Attributes = radius.Attributes{}
+	for _, avp := range p.Attributes {
+		clone.Attributes = append(clone.Attributes, avp)
+	}
+	rfc2869.MessageAuthenticator_Set(&clone, make([]byte, md5.Size))
+	b, err := clone.MarshalBinary()
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(md5.New, testSecret)
+	mac.Write(b)
+	return hmac.Equal(received, mac.Sum(nil))
+}
+
+func proxyRequest(t *testing.T, mCtx modules.Context) (*modules.Response, error) {
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+	return Handle(
+		mCtx,
+		&modules.RequestContext{Logger: logger},
+		createRadiusRequest("called", "calling"),
+		func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+			require.Fail(t, "Should never be called (proxy module should not call next()")
+			return nil, nil
+		},
+	)
+}
+
+func TestWeightedSelection(t *testing.T) {
+	heavy, light := startUpstream(t, "heavy"), startUpstream(t, "light")
+	mCtx, err := Init(zap.NewNop(), modules.ModuleConfig{
+		"upstreams": []map[string]interface{}{
+			{"address": heavy.addr, "weight": 4},
+			{"address": light.addr, "weight": 1},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 200; i++ {
+		_, err := proxyRequest(t, mCtx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(200), atomic.LoadInt32(&heavy.requests)+atomic.LoadInt32(&light.requests))
+	require.Greater(t, atomic.LoadInt32(&heavy.requests), 2*atomic.LoadInt32(&light.requests))
+	require.Greater(t, atomic.LoadInt32(&light.requests), int32(0))
+}
+
+func TestFailoverOnTimeout(t *testing.T) {
+	dead, alive := startUpstream(t, "dead"), startUpstream(t, "alive")
+	atomic.StoreInt32(&dead.down, 1)
+	mCtx, err := Init(zap.NewNop(), modules.ModuleConfig{
+		"upstreams": []map[string]interface{}{
+			{"address": dead.addr, "weight": 100},
+			{"address": alive.addr, "weight": 1},
+		},
+		"timeout": "100ms",
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		res, err := proxyRequest(t, mCtx)
+		require.NoError(t, err)
+		require.Equal(t, "alive", rfc2865.ReplyMessage_GetString(&radius.Packet{Attributes: res.Attributes}))
+	}
+	require.Equal(t, int32(5), atomic.LoadInt32(&alive.requests))
+}
+
+func TestRetriesExhausted(t *testing.T) {
+	dead := startUpstream(t, "dead")
+	atomic.StoreInt32(&dead.down, 1)
+	mCtx, err := Init(zap.NewNop(), modules.ModuleConfig{
+		"target":  dead.addr,
+		"timeout": "50ms",
+		"retries": 2,
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = proxyRequest(t, mCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestHealthCheck(t *testing.T) {
+	primary, secondary := startUpstream(t, "primary"), startUpstream(t, "secondary")
+	mCtx, err := Init(zap.NewNop(), modules.ModuleConfig{
+		"upstreams": []map[string]interface{}{
+			{"address": primary.addr, "weight": 100},
+			{"address": secondary.addr, "weight": 1},
+		},
+		"timeout": "100ms",
+		"healthCheck": map[string]interface{}{
+			"interval":         "20ms",
+			"timeout":          "10ms",
+			"secret":           string(testSecret),
+			"failThreshold":    2,
+			"recoverThreshold": 2,
+		},
+	})
+	require.NoError(t, err)
+	p := mCtx.(ModuleCtx).pool
+
+	// Probes carry a valid Message-Authenticator
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&primary.statusServer) > 0 && atomic.LoadInt32(&secondary.statusServer) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	// Unresponsive upstream is taken out of the pool
+	atomic.StoreInt32(&primary.down, 1)
+	require.Eventually(t, func() bool { return !p.upstreams[0].isHealthy() }, time.Second, 10*time.Millisecond)
+	for i := 0; i < 5; i++ {
+		res, err := proxyRequest(t, mCtx)
+		require.NoError(t, err)
+		require.Equal(t, "secondary", rfc2865.ReplyMessage_GetString(&radius.Packet{Attributes: res.Attributes}))
+	}
+
+	// And is back once it answers the probes again
+	atomic.StoreInt32(&primary.down, 0)
+	require.Eventually(t, func() bool { return p.upstreams[0].isHealthy() }, time.Second, 10*time.Millisecond)
+}
+
+func TestInvalidPoolConfig(t *testing.T) {
+	for name, config := range map[string]modules.ModuleConfig{
+		"empty address":    {"upstreams": []map[string]interface{}{{"weight": 1}}},
+		"negative weight":  {"upstreams": []map[string]interface{}{{"address": "127.0.0.1:1812", "weight": -1}}},
+		"negative retries": {"target": "127.0.0.1:1812", "retries": -1},
+		"invalid timeout":  {"target": "127.0.0.1:1812", "timeout": "soon"},
+		"missing secret":   {"target": "127.0.0.1:1812", "healthCheck": map[string]interface{}{"interval": "1s"}},
+	} {
+		_, err := Init(zap.NewNop(), config)
+		require.Error(t, err, name)
+	}
+}
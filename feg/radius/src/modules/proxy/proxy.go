@@ -16,23 +16,60 @@ package proxy
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"fbc/cwf/radius/modules"
+	"fbc/cwf/radius/monitoring"
+	"fbc/lib/go/dictionary"
 
 	"github.com/mitchellh/mapstructure"
 	"go.uber.org/zap"
 	"layeh.com/radius"
 )
 
-// Config configuration structure for proxy module
-type Config struct {
-	Target string
-}
+const (
+	// DefaultTimeout is the default time to wait for an upstream to answer a single attempt
+	DefaultTimeout = 3 * time.Second
+	// DefaultHealthCheckTimeout is the default time to wait for a Status-Server response
+	DefaultHealthCheckTimeout = 2 * time.Second
+	// DefaultFailThreshold is the default number of consecutive failures marking an upstream unhealthy
+	DefaultFailThreshold = 3
+	// DefaultRecoverThreshold is the default number of consecutive successful probes marking an upstream healthy
+	DefaultRecoverThreshold = 2
+)
 
-// ModuleCtx ...
-type ModuleCtx struct {
-	target string
-}
+type (
+	// Config configuration structure for proxy module
+	Config struct {
+		Target      string           // A single upstream, shorthand for Upstreams with one entry
+		Upstreams   []UpstreamConfig // The pool of upstreams to forward requests to
+		Timeout     string           // Time to wait for an upstream on each attempt (default 3s)
+		Retries     *int             // Attempts after a failed one (default: one per additional upstream)
+		HealthCheck HealthCheckConfig
+	}
+
+	// UpstreamConfig a single upstream RADIUS server
+	UpstreamConfig struct {
+		Address string
+		Weight  int // Relative share of the requests sent to this upstream (default 1)
+	}
+
+	// HealthCheckConfig Status-Server (RFC 5997) probing of the upstreams,
+	// enabled when Interval is set
+	HealthCheckConfig struct {
+		Interval         string
+		Timeout          string // default 2s
+		Secret           string // The secret shared with the upstreams
+		FailThreshold    int    // Consecutive failures marking an upstream unhealthy (default 3)
+		RecoverThreshold int    // Consecutive successful probes marking it healthy again (default 2)
+	}
+
+	// ModuleCtx ...
+	ModuleCtx struct {
+		pool *pool
+	}
+)
 
 // Init module interface implementation
 func Init(logger *zap.Logger, config modules.ModuleConfig) (modules.Context, error) {
@@ -42,20 +79,125 @@ func Init(logger *zap.Logger, config modules.ModuleConfig) (modules.Context, err
 		return nil, err
 	}
 
-	if proxyConfig.Target == "" {
+	upstreams := proxyConfig.Upstreams
+	if proxyConfig.Target != "" {
+		upstreams = append([]UpstreamConfig{{Address: proxyConfig.Target}}, upstreams...)
+	}
+	if len(upstreams) == 0 {
 		return nil, errors.New("proxy module cannot be initialize with empty Target value")
 	}
 
-	return ModuleCtx{target: proxyConfig.Target}, nil
+	p := &pool{
+		timeout: DefaultTimeout,
+		retries: len(upstreams) - 1,
+		logger:  logger,
+	}
+	for _, upstreamConfig := range upstreams {
+		if upstreamConfig.Address == "" {
+			return nil, errors.New("proxy module cannot be initialized with an empty upstream Address")
+		}
+		if upstreamConfig.Weight < 0 {
+			return nil, fmt.Errorf("invalid weight %d of upstream %s", upstreamConfig.Weight, upstreamConfig.Address)
+		}
+		weight := upstreamConfig.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		u := &upstream{
+			address:  upstreamConfig.Address,
+			weight:   weight,
+			healthy:  true,
+			counters: monitoring.CreateUpstreamCounters("proxy", upstreamConfig.Address),
+		}
+		u.counters.Healthy(true)
+		p.upstreams = append(p.upstreams, u)
+	}
+	if proxyConfig.Timeout != "" {
+		if p.timeout, err = parsePositiveDuration("Timeout", proxyConfig.Timeout); err != nil {
+			return nil, err
+		}
+	}
+	if proxyConfig.Retries != nil {
+		if *proxyConfig.Retries < 0 {
+			return nil, fmt.Errorf("invalid Retries value %d", *proxyConfig.Retries)
+		}
+		p.retries = *proxyConfig.Retries
+	}
+
+	if proxyConfig.HealthCheck.Interval != "" {
+		checker, err := newHealthChecker(p, proxyConfig.HealthCheck)
+		if err != nil {
+			return nil, err
+		}
+		go checker.run(context.Background())
+	}
+
+	return ModuleCtx{pool: p}, nil
+}
+
+func newHealthChecker(p *pool, cfg HealthCheckConfig) (*healthChecker, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("proxy module health checks require a Secret")
+	}
+	checker := &healthChecker{
+		pool:    p,
+		secret:  []byte(cfg.Secret),
+		timeout: DefaultHealthCheckTimeout,
+	}
+	var err error
+	if checker.interval, err = parsePositiveDuration("HealthCheck.Interval", cfg.Interval); err != nil {
+		return nil, err
+	}
+	if cfg.Timeout != "" {
+		if checker.timeout, err = parsePositiveDuration("HealthCheck.Timeout", cfg.Timeout); err != nil {
+			return nil, err
+		}
+	}
+	p.failThreshold = DefaultFailThreshold
+	if cfg.FailThreshold > 0 {
+		p.failThreshold = cfg.FailThreshold
+	}
+	p.recoverThreshold = DefaultRecoverThreshold
+	if cfg.RecoverThreshold > 0 {
+		p.recoverThreshold = cfg.RecoverThreshold
+	}
+	return checker, nil
+}
+
+func parsePositiveDuration(name string, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q: %w", name, value, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid %s value %q: must be positive", name, value)
+	}
+	return d, nil
 }
 
 // Handle module interface implementation
-func Handle(m modules.Context, _ *modules.RequestContext, r *radius.Request, _ modules.Middleware) (*modules.Response, error) {
+func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, _ modules.Middleware) (*modules.Response, error) {
 	mCtx := m.(ModuleCtx)
-	res, err := radius.Exchange(context.Background(), r.Packet, mCtx.target)
+	res, u, err := mCtx.pool.exchange(context.Background(), r.Packet)
 	if err != nil {
+		if c != nil && c.Logger != nil {
+			target := ""
+			if u != nil {
+				target = u.address
+			}
+			c.Logger.Warn("failed to proxy request", zap.String("target", target), zap.Error(err))
+		}
 		return nil, err
 	}
+	if c != nil && c.Logger != nil {
+		if ce := c.Logger.Check(zap.DebugLevel, "got upstream response"); ce != nil {
+			ce.Write(
+				zap.String("target", u.address),
+				zap.Stringer("code", res.Code),
+				zap.Any("attributes", dictionary.Default().Describe(res.Attributes)),
+			)
+		}
+	}
 
 	return &modules.Response{
 		Code:       res.Code,
@@ -0,0 +1,298 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rewrite
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	radiusdict "fbc/lib/go/dictionary"
+
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// Attribute data types
+const (
+	dataTypeString  = "string"
+	dataTypeOctets  = "octets"
+	dataTypeInteger = "integer"
+	dataTypeIPAddr  = "ipaddr"
+)
+
+type (
+	// VendorConfig a vendor dictionary, its attributes can be referenced by name in rules
+	VendorConfig struct {
+		Name       string
+		ID         uint32
+		Attributes []VendorAttributeConfig
+	}
+
+	// VendorAttributeConfig a vendor specific attribute definition
+	VendorAttributeConfig struct {
+		Name     string
+		Type     uint8
+		DataType string // string (default), octets, integer or ipaddr
+	}
+
+	// attrRef identifies a standard or a vendor specific attribute
+	attrRef struct {
+		name       string
+		typ        radius.Type
+		vendorID   uint32 // 0 for standard attributes
+		vendorType byte
+		dataType   string
+		def        *radiusdict.Attribute // server dictionary definition, if any
+	}
+
+	// dictionary resolves attribute names of vendors configured for the module,
+	// other names are resolved using the server's dictionary
+	dictionary map[string]attrRef
+)
+
+func newDictionary(vendors []VendorConfig) (dictionary, error) {
+	dict := dictionary{}
+	for _, vendor := range vendors {
+		if vendor.ID == 0 {
+			return nil, fmt.Errorf("vendor '%s' has invalid ID", vendor.Name)
+		}
+		for _, attr := range vendor.Attributes {
+			if len(attr.Name) == 0 {
+				return nil, fmt.Errorf("vendor '%s' attribute %d has no name", vendor.Name, attr.Type)
+			}
+			dataType, err := parseDataType(attr.DataType)
+			if err != nil {
+				return nil, fmt.Errorf("vendor '%s' attribute '%s': %v", vendor.Name, attr.Name, err)
+			}
+			dict[strings.ToLower(attr.Name)] = attrRef{
+				name:       attr.Name,
+				typ:        rfc2865.VendorSpecific_Type,
+				vendorID:   vendor.ID,
+				vendorType: attr.Type,
+				dataType:   dataType,
+			}
+		}
+	}
+	return dict, nil
+}
+
+// resolve returns the attribute reference by its name, type number or
+// vendor specific attribute in '26.<vendor ID>.<vendor type>' form
+func (d dictionary) resolve(name string) (attrRef, error) {
+	if attr, ok := d[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return attr, nil
+	}
+	if def, ok := radiusdict.Default().Attribute(name); ok {
+		return attrRef{
+			name:       def.Name,
+			typ:        def.Type,
+			vendorID:   def.VendorID,
+			vendorType: def.VendorType,
+			def:        def,
+		}, nil
+	}
+	parts := strings.Split(strings.TrimSpace(name), ".")
+	switch len(parts) {
+	case 1:
+		typ, err := strconv.ParseUint(parts[0], 10, 8)
+		if err != nil || typ == 0 {
+			break
+		}
+		return attrRef{name: name, typ: radius.Type(typ), dataType: dataTypeOctets}, nil
+	case 3:
+		vendorID, err1 := strconv.ParseUint(parts[1], 10, 32)
+		vendorType, err2 := strconv.ParseUint(parts[2], 10, 8)
+		if parts[0] != "26" || err1 != nil || err2 != nil || vendorID == 0 {
+			break
+		}
+		return attrRef{
+			name:       name,
+			typ:        rfc2865.VendorSpecific_Type,
+			vendorID:   uint32(vendorID),
+			vendorType: byte(vendorType),
+			dataType:   dataTypeOctets,
+		}, nil
+	}
+	return attrRef{}, fmt.Errorf("unknown attribute '%s'", name)
+}
+
+func parseDataType(dataType string) (string, error) {
+	switch strings.ToLower(dataType) {
+	case "", dataTypeString:
+		return dataTypeString, nil
+	case dataTypeOctets, dataTypeInteger, dataTypeIPAddr:
+		return strings.ToLower(dataType), nil
+	}
+	return "", fmt.Errorf("unsupported data type '%s'", dataType)
+}
+
+func (a attrRef) isVendorSpecific() bool {
+	return a.vendorID != 0
+}
+
+// encode converts the string representation of a value to the attribute's wire format
+func (a attrRef) encode(value string) (radius.Attribute, error) {
+	if a.def != nil {
+		return a.def.Encode(value)
+	}
+	switch a.dataType {
+	case dataTypeInteger:
+		i, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer value '%s' for %s", value, a.name)
+		}
+		return radius.NewInteger(uint32(i)), nil
+	case dataTypeIPAddr:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address '%s' for %s", value, a.name)
+		}
+		return radius.NewIPAddr(ip)
+	case dataTypeOctets:
+		if strings.HasPrefix(value, "0x") {
+			return hex.DecodeString(value[2:])
+		}
+	}
+	return radius.Attribute(value), nil
+}
+
+// decode returns the string representation of the attribute value
+func (a attrRef) decode(value radius.Attribute) string {
+	if a.def != nil {
+		return a.def.Decode(value)
+	}
+	switch a.dataType {
+	case dataTypeInteger:
+		if i, err := radius.Integer(value); err == nil {
+			return strconv.FormatUint(uint64(i), 10)
+		}
+	case dataTypeIPAddr:
+		if ip, err := radius.IPAddr(value); err == nil {
+			return ip.String()
+		}
+	}
+	return string(value)
+}
+
+// values returns all values of the attribute found in attrs
+func (a attrRef) values(attrs radius.Attributes) []radius.Attribute {
+	var result []radius.Attribute
+	for _, avp := range attrs {
+		if avp.Type != a.typ {
+			continue
+		}
+		if !a.isVendorSpecific() {
+			result = append(result, avp.Attribute)
+			continue
+		}
+		for _, sub := range a.vendorAttributes(avp.Attribute) {
+			result = append(result, sub.value)
+		}
+	}
+	return result
+}
+
+// remove deletes all values of the attribute from attrs
+func (a attrRef) remove(attrs *radius.Attributes) {
+	result := (*attrs)[:0]
+	for _, avp := range *attrs {
+		if avp.Type != a.typ {
+			result = append(result, avp)
+			continue
+		}
+		if !a.isVendorSpecific() {
+			continue
+		}
+		subs, ok := parseVendorAttributes(avp.Attribute)
+		if !ok || binary.BigEndian.Uint32(avp.Attribute) != a.vendorID {
+			result = append(result, avp)
+			continue
+		}
+		remaining := subs[:0]
+		for _, sub := range subs {
+			if sub.typ != a.vendorType {
+				remaining = append(remaining, sub)
+			}
+		}
+		if len(remaining) > 0 {
+			result = append(result, &radius.AVP{Type: avp.Type, Attribute: encodeVendorAttributes(a.vendorID, remaining)})
+		}
+	}
+	*attrs = result
+}
+
+// add appends a new value of the attribute to attrs
+func (a attrRef) add(attrs *radius.Attributes, value radius.Attribute) error {
+	if !a.isVendorSpecific() {
+		if len(value) > 253 {
+			return fmt.Errorf("%s value is too long", a.name)
+		}
+		attrs.Add(a.typ, value)
+		return nil
+	}
+	if len(value) > 247 {
+		return fmt.Errorf("%s value is too long", a.name)
+	}
+	attrs.Add(a.typ, encodeVendorAttributes(a.vendorID, []vendorAttribute{{typ: a.vendorType, value: value}}))
+	return nil
+}
+
+type vendorAttribute struct {
+	typ   byte
+	value radius.Attribute
+}
+
+func (a attrRef) vendorAttributes(vsa radius.Attribute) []vendorAttribute {
+	subs, ok := parseVendorAttributes(vsa)
+	if !ok || binary.BigEndian.Uint32(vsa) != a.vendorID {
+		return nil
+	}
+	var result []vendorAttribute
+	for _, sub := range subs {
+		if sub.typ == a.vendorType {
+			result = append(result, sub)
+		}
+	}
+	return result
+}
+
+// parseVendorAttributes parses Vendor-Specific attribute data formatted as recommended by RFC 2865, section 5.26
+func parseVendorAttributes(vsa radius.Attribute) ([]vendorAttribute, bool) {
+	if len(vsa) < 6 {
+		return nil, false
+	}
+	var result []vendorAttribute
+	for data := vsa[4:]; len(data) > 0; {
+		if len(data) < 2 || int(data[1]) < 2 || int(data[1]) > len(data) {
+			return nil, false
+		}
+		result = append(result, vendorAttribute{typ: data[0], value: data[2:data[1]]})
+		data = data[data[1]:]
+	}
+	return result, true
+}
+
+func encodeVendorAttributes(vendorID uint32, subs []vendorAttribute) radius.Attribute {
+	result := make(radius.Attribute, 4, 6)
+	binary.BigEndian.PutUint32(result, vendorID)
+	for _, sub := range subs {
+		result = append(result, sub.typ, byte(len(sub.value)+2))
+		result = append(result, sub.value...)
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rewrite
+
+import (
+	"fbc/cwf/radius/modules"
+
+	"github.com/mitchellh/mapstructure"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+)
+
+// Config configuration structure for the rewrite module
+type Config struct {
+	Vendors []VendorConfig // vendor dictionaries, vendor attributes can be referenced by name
+	Rules   []RuleConfig   // ordered rewrite rules
+}
+
+// ModuleCtx ...
+type ModuleCtx struct {
+	rules       []rule
+	hasRequest  bool
+	hasResponse bool
+	logger      *zap.Logger
+}
+
+// Init module interface implementation
+func Init(logger *zap.Logger, config modules.ModuleConfig) (modules.Context, error) {
+	var rewriteConfig Config
+	err := mapstructure.Decode(config, &rewriteConfig)
+	if err != nil {
+		return nil, err
+	}
+	dict, err := newDictionary(rewriteConfig.Vendors)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := compileRules(rewriteConfig.Rules, dict)
+	if err != nil {
+		return nil, err
+	}
+
+	mCtx := &ModuleCtx{rules: rules, logger: logger}
+	for _, r := range rules {
+		mCtx.hasRequest = mCtx.hasRequest || r.request
+		mCtx.hasResponse = mCtx.hasResponse || r.response
+	}
+	logger.Info("rewrite module initialized", zap.Int("num_rules", len(rules)))
+	return mCtx, nil
+}
+
+// Handle module interface implementation
+func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, next modules.Middleware) (*modules.Response, error) {
+	mCtx := m.(*ModuleCtx)
+	logger := mCtx.logger
+	if c != nil && c.Logger != nil {
+		logger = c.Logger
+	}
+	nasIDs := nasIdentities(r.Attributes)
+
+	// Rewrite a copy of the request, attributes of the original packet are left intact
+	if mCtx.hasRequest {
+		packet := *r.Packet
+		packet.Attributes = append(radius.Attributes(nil), r.Attributes...)
+		err := applyRules(mCtx.rules, false, packetView{code: packet.Code, attrs: &packet.Attributes, nasIDs: nasIDs}, logger)
+		if err != nil {
+			logger.Error("failed to rewrite request", zap.Error(err))
+			return nil, err
+		}
+		r = r.WithContext(r.Context())
+		r.Packet = &packet
+	}
+
+	res, err := next(c, r)
+	if err != nil || res == nil || !mCtx.hasResponse {
+		return res, err
+	}
+	err = applyRules(mCtx.rules, true, packetView{code: res.Code, attrs: &res.Attributes, nasIDs: nasIDs}, logger)
+	if err != nil {
+		logger.Error("failed to rewrite response", zap.Error(err))
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rewrite
+
+import (
+	"context"
+	"testing"
+
+	"fbc/cwf/radius/modules"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+)
+
+const ciscoVendorID = 9
+
+var testConfig = modules.ModuleConfig{
+	"Vendors": []map[string]interface{}{
+		{
+			"Name": "Cisco",
+			"ID":   ciscoVendorID,
+			"Attributes": []map[string]interface{}{
+				{"Name": "Cisco-AVPair", "Type": 1},
+				{"Name": "Cisco-Account-Info", "Type": 250},
+			},
+		},
+	},
+	"Rules": []map[string]interface{}{
+		{
+			"Name": "strip realm",
+			"Actions": []map[string]interface{}{
+				{"Op": "rewrite", "Attribute": "User-Name", "Match": "^([^@]+)@.*$", "Replace": "$1"},
+			},
+		},
+		{
+			"Name": "per NAS Called-Station-Id format",
+			"When": []map[string]interface{}{
+				{"NAS": "^aruba-"},
+			},
+			"Actions": []map[string]interface{}{
+				{
+					"Op":        "rewrite",
+					"Attribute": "Called-Station-Id",
+					"Match":     "^([0-9a-fA-F]{2})([0-9a-fA-F]{2})([0-9a-fA-F]{2})([0-9a-fA-F]{2})([0-9a-fA-F]{2})([0-9a-fA-F]{2})$",
+					"Replace":   "$1-$2-$3-$4-$5-$6",
+				},
+			},
+			"Else": []map[string]interface{}{
+				{"Op": "remove", "Attribute": "Cisco-AVPair"},
+			},
+		},
+		{
+			"Name":      "tag accepts",
+			"Direction": "response",
+			"When": []map[string]interface{}{
+				{"Code": "Access-Accept"},
+			},
+			"Actions": []map[string]interface{}{
+				{"Op": "add", "Attribute": "Cisco-AVPair", "Value": "subscriber:accounting-list=default"},
+				{"Op": "copy", "Attribute": "Class", "From": "26.9.250"},
+				{"Op": "remove", "Attribute": "26.9.250"},
+			},
+			"Last": true,
+		},
+		{
+			"Name":      "never reached for accepts",
+			"Direction": "response",
+			"Actions": []map[string]interface{}{
+				{"Op": "set", "Attribute": "Reply-Message", "Value": "rejected"},
+			},
+		},
+	},
+}
+
+func TestRewriteRequest(t *testing.T) {
+	mCtx, err := Init(zap.NewNop(), testConfig)
+	require.NoError(t, err)
+
+	// Aruba NAS: realm is stripped & Called-Station-Id is reformatted
+	req := createRequest("aruba-ap-1")
+	var handled *radius.Request
+	_, err = Handle(mCtx, &modules.RequestContext{Logger: zap.NewNop()}, req, func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+		handled = r
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "user", rfc2865.UserName_GetString(handled.Packet))
+	require.Equal(t, "AA-BB-CC-DD-EE-FF", rfc2865.CalledStationID_GetString(handled.Packet))
+	require.Len(t, vsaValues(handled.Attributes, 1), 1)
+	// original request is intact
+	require.Equal(t, "user@realm.com", rfc2865.UserName_GetString(req.Packet))
+
+	// Other NAS: Cisco-AVPair is removed, the other Cisco VSA is kept
+	_, err = Handle(mCtx, &modules.RequestContext{}, createRequest("other-nas"), func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+		handled = r
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "AABBCCDDEEFF", rfc2865.CalledStationID_GetString(handled.Packet))
+	require.Empty(t, vsaValues(handled.Attributes, 1))
+	require.Equal(t, []string{"account"}, vsaValues(handled.Attributes, 250))
+}
+
+func TestRewriteResponse(t *testing.T) {
+	mCtx, err := Init(zap.NewNop(), testConfig)
+	require.NoError(t, err)
+
+	respond := func(code radius.Code) *modules.Response {
+		res, err := Handle(mCtx, &modules.RequestContext{}, createRequest("nas"), func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+			attrs := radius.Attributes{}
+			vsa, _ := radius.NewVendorSpecific(ciscoVendorID, radius.Attribute{250, 9, 'a', 'c', 'c', 'o', 'u', 'n', 't'})
+			attrs.Add(rfc2865.VendorSpecific_Type, vsa)
+			return &modules.Response{Code: code, Attributes: attrs}, nil
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	res := respond(radius.CodeAccessAccept)
+	require.Equal(t, []string{"subscriber:accounting-list=default"}, vsaValues(res.Attributes, 1))
+	require.Empty(t, vsaValues(res.Attributes, 250))
+	require.Equal(t, "account", string(res.Attributes.Get(rfc2865.Class_Type)))
+	_, found := res.Attributes.Lookup(rfc2865.ReplyMessage_Type)
+	require.False(t, found)
+
+	res = respond(radius.CodeAccessReject)
+	require.Equal(t, "rejected", string(res.Attributes.Get(rfc2865.ReplyMessage_Type)))
+	require.Equal(t, []string{"account"}, vsaValues(res.Attributes, 250))
+}
+
+func TestInvalidConfig(t *testing.T) {
+	for _, rules := range [][]map[string]interface{}{
+		{{"Actions": []map[string]interface{}{{"Op": "add", "Attribute": "No-Such-Attribute", "Value": "x"}}}},
+		{{"Actions": []map[string]interface{}{{"Op": "rewrite", "Attribute": "User-Name"}}}},
+		{{"Actions": []map[string]interface{}{{"Op": "set", "Attribute": "Session-Timeout", "Value": "forever"}}}},
+		{{"Actions": []map[string]interface{}{{"Op": "explode", "Attribute": "User-Name"}}}},
+		{{"Direction": "sideways", "Actions": []map[string]interface{}{{"Op": "remove", "Attribute": "Class"}}}},
+		{{"When": []map[string]interface{}{{"NAS": "("}}, "Actions": []map[string]interface{}{{"Op": "remove", "Attribute": "Class"}}}},
+		{{"Name": "no actions"}},
+	} {
+		_, err := Init(zap.NewNop(), modules.ModuleConfig{"Rules": rules})
+		require.Error(t, err, "%v", rules)
+	}
+}
+
+func createRequest(nasID string) *radius.Request {
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	rfc2865.UserName_SetString(packet, "user@realm.com")
+	rfc2865.CalledStationID_SetString(packet, "AABBCCDDEEFF")
+	rfc2865.NASIdentifier_SetString(packet, nasID)
+	vsa, _ := radius.NewVendorSpecific(ciscoVendorID, radius.Attribute{
+		1, 11, 'a', 'u', 'd', 'i', 't', '=', 'o', 'n', '!',
+		250, 9, 'a', 'c', 'c', 'o', 'u', 'n', 't',
+	})
+	packet.Add(rfc2865.VendorSpecific_Type, vsa)
+	req := &radius.Request{}
+	req = req.WithContext(context.Background())
+	req.Packet = packet
+	return req
+}
+
+func vsaValues(attrs radius.Attributes, vendorType byte) []string {
+	ref := attrRef{typ: rfc2865.VendorSpecific_Type, vendorID: ciscoVendorID, vendorType: vendorType}
+	var result []string
+	for _, v := range ref.values(attrs) {
+		result = append(result, string(v))
+	}
+	return result
+}
+
+func TestRewriteWithServerDictionary(t *testing.T) {
+	mCtx, err := Init(zap.NewNop(), modules.ModuleConfig{
+		"Rules": []map[string]interface{}{
+			{
+				"When": []map[string]interface{}{
+					{"Code": "Accounting-Request", "Attribute": "Acct-Status-Type", "Match": "^Stop$"},
+				},
+				"Actions": []map[string]interface{}{
+					{"Op": "set", "Attribute": "Acct-Terminate-Cause", "Value": "Session-Timeout"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	packet := radius.New(radius.CodeAccountingRequest, []byte("secret"))
+	rfc2866.AcctStatusType_Set(packet, rfc2866.AcctStatusType_Value_Stop)
+	req := &radius.Request{Packet: packet}
+	var handled *radius.Request
+	_, err = Handle(mCtx, &modules.RequestContext{}, req, func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+		handled = r
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, rfc2866.AcctTerminateCause_Value_SessionTimeout, rfc2866.AcctTerminateCause_Get(handled.Packet))
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rewrite
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// Rule directions
+const (
+	DirectionRequest  = "request"
+	DirectionResponse = "response"
+	DirectionBoth     = "both"
+)
+
+// Action operations
+const (
+	OpAdd     = "add"     // adds a new value
+	OpSet     = "set"     // replaces all values with a new one
+	OpRemove  = "remove"  // removes all values
+	OpRewrite = "rewrite" // replaces Match regex in all values with Replace
+	OpCopy    = "copy"    // sets the attribute to the values of the From attribute
+)
+
+type (
+	// RuleConfig a match/transform rule, rules are evaluated in the configured order
+	RuleConfig struct {
+		Name      string
+		Direction string            // request (default), response or both
+		When      []ConditionConfig // all conditions must match, empty - always matches
+		Actions   []ActionConfig    // applied when the conditions match
+		Else      []ActionConfig    // applied when the conditions don't match
+		Last      bool              // stop evaluating subsequent rules when the conditions match
+	}
+
+	// ConditionConfig a single rule condition
+	ConditionConfig struct {
+		Code      string // RADIUS code of the packet (Access-Request, Access-Accept, ...)
+		NAS       string // regex matched against NAS-Identifier & NAS-IP-Address of the request
+		Attribute string // attribute to test, without Match tests the attribute presence
+		Match     string // regex matched against the attribute values
+		Negate    bool   // negates the condition result
+	}
+
+	// ActionConfig a single attribute transformation
+	ActionConfig struct {
+		Op        string
+		Attribute string
+		Value     string // add & set value
+		Match     string // rewrite regex, values which don't match are left intact
+		Replace   string // rewrite replacement, may reference capture groups ($1)
+		From      string // copy source attribute
+	}
+
+	rule struct {
+		name       string
+		request    bool
+		response   bool
+		conditions []condition
+		actions    []action
+		otherwise  []action
+		last       bool
+	}
+
+	condition struct {
+		code   radius.Code
+		nas    *regexp.Regexp
+		attr   *attrRef
+		match  *regexp.Regexp
+		negate bool
+	}
+
+	action struct {
+		op      string
+		attr    attrRef
+		value   radius.Attribute
+		match   *regexp.Regexp
+		replace string
+		from    attrRef
+	}
+
+	// packetView is the packet being rewritten along with the identity of the NAS the request came from
+	packetView struct {
+		code   radius.Code
+		attrs  *radius.Attributes
+		nasIDs []string
+	}
+)
+
+func compileRules(configs []RuleConfig, dict dictionary) ([]rule, error) {
+	var result []rule
+	for i, cfg := range configs {
+		name := cfg.Name
+		if len(name) == 0 {
+			name = fmt.Sprintf("rule %d", i)
+		}
+		r := rule{name: name, last: cfg.Last}
+		switch strings.ToLower(cfg.Direction) {
+		case "", DirectionRequest:
+			r.request = true
+		case DirectionResponse:
+			r.response = true
+		case DirectionBoth:
+			r.request, r.response = true, true
+		default:
+			return nil, fmt.Errorf("%s: invalid direction '%s'", name, cfg.Direction)
+		}
+		for _, c := range cfg.When {
+			cond, err := compileCondition(c, dict)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			r.conditions = append(r.conditions, cond)
+		}
+		var err error
+		if r.actions, err = compileActions(cfg.Actions, dict); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if r.otherwise, err = compileActions(cfg.Else, dict); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if len(r.actions) == 0 && len(r.otherwise) == 0 {
+			return nil, fmt.Errorf("%s: no actions", name)
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func compileCondition(cfg ConditionConfig, dict dictionary) (condition, error) {
+	result := condition{negate: cfg.Negate}
+	var err error
+	if len(cfg.Code) > 0 {
+		if result.code, err = parseCode(cfg.Code); err != nil {
+			return result, err
+		}
+	}
+	if len(cfg.NAS) > 0 {
+		if result.nas, err = regexp.Compile(cfg.NAS); err != nil {
+			return result, fmt.Errorf("invalid NAS regex: %v", err)
+		}
+	}
+	if len(cfg.Attribute) > 0 {
+		attr, err := dict.resolve(cfg.Attribute)
+		if err != nil {
+			return result, err
+		}
+		result.attr = &attr
+	}
+	if len(cfg.Match) > 0 {
+		if result.attr == nil {
+			return result, errors.New("condition Match requires an Attribute")
+		}
+		if result.match, err = regexp.Compile(cfg.Match); err != nil {
+			return result, fmt.Errorf("invalid %s regex: %v", cfg.Attribute, err)
+		}
+	}
+	if result.code == 0 && result.nas == nil && result.attr == nil {
+		return result, errors.New("empty condition")
+	}
+	return result, nil
+}
+
+func compileActions(configs []ActionConfig, dict dictionary) ([]action, error) {
+	var result []action
+	for _, cfg := range configs {
+		attr, err := dict.resolve(cfg.Attribute)
+		if err != nil {
+			return nil, err
+		}
+		a := action{op: strings.ToLower(cfg.Op), attr: attr, replace: cfg.Replace}
+		switch a.op {
+		case OpAdd, OpSet:
+			if a.value, err = attr.encode(cfg.Value); err != nil {
+				return nil, err
+			}
+		case OpRemove:
+		case OpRewrite:
+			if len(cfg.Match) == 0 {
+				return nil, fmt.Errorf("rewrite of %s requires Match", cfg.Attribute)
+			}
+			if a.match, err = regexp.Compile(cfg.Match); err != nil {
+				return nil, fmt.Errorf("invalid %s rewrite regex: %v", cfg.Attribute, err)
+			}
+		case OpCopy:
+			if a.from, err = dict.resolve(cfg.From); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown operation '%s'", cfg.Op)
+		}
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+func parseCode(name string) (radius.Code, error) {
+	for code := radius.Code(1); code < 255; code++ {
+		if strings.EqualFold(code.String(), name) {
+			return code, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown RADIUS code '%s'", name)
+}
+
+// applyRules applies request or response rules to the packet
+func applyRules(rules []rule, isResponse bool, packet packetView, logger *zap.Logger) error {
+	for _, r := range rules {
+		if (isResponse && !r.response) || (!isResponse && !r.request) {
+			continue
+		}
+		matched := r.matches(packet)
+		actions := r.otherwise
+		if matched {
+			actions = r.actions
+		}
+		for _, a := range actions {
+			if err := a.apply(packet.attrs); err != nil {
+				return fmt.Errorf("%s: %v", r.name, err)
+			}
+		}
+		if logger != nil && len(actions) > 0 {
+			logger.Debug("rewrite rule applied", zap.String("rule", r.name), zap.Bool("matched", matched))
+		}
+		if matched && r.last {
+			break
+		}
+	}
+	return nil
+}
+
+func (r rule) matches(packet packetView) bool {
+	for _, c := range r.conditions {
+		if c.matches(packet) == c.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (c condition) matches(packet packetView) bool {
+	if c.code != 0 && c.code != packet.code {
+		return false
+	}
+	if c.nas != nil && !matchAny(c.nas, packet.nasIDs) {
+		return false
+	}
+	if c.attr != nil {
+		values := c.attr.values(*packet.attrs)
+		if len(values) == 0 {
+			return false
+		}
+		if c.match != nil {
+			decoded := make([]string, 0, len(values))
+			for _, v := range values {
+				decoded = append(decoded, c.attr.decode(v))
+			}
+			return matchAny(c.match, decoded)
+		}
+	}
+	return true
+}
+
+func (a action) apply(attrs *radius.Attributes) error {
+	switch a.op {
+	case OpAdd:
+		return a.attr.add(attrs, a.value)
+	case OpSet:
+		a.attr.remove(attrs)
+		return a.attr.add(attrs, a.value)
+	case OpRemove:
+		a.attr.remove(attrs)
+	case OpRewrite:
+		values := a.attr.values(*attrs)
+		if len(values) == 0 {
+			return nil
+		}
+		rewritten := make([]radius.Attribute, 0, len(values))
+		for _, v := range values {
+			decoded := a.attr.decode(v)
+			if !a.match.MatchString(decoded) {
+				rewritten = append(rewritten, v)
+				continue
+			}
+			encoded, err := a.attr.encode(a.match.ReplaceAllString(decoded, a.replace))
+			if err != nil {
+				return err
+			}
+			rewritten = append(rewritten, encoded)
+		}
+		return a.setValues(attrs, rewritten)
+	case OpCopy:
+		values := a.from.values(*attrs)
+		if len(values) == 0 {
+			return nil
+		}
+		copied := make([]radius.Attribute, 0, len(values))
+		for _, v := range values {
+			encoded, err := a.attr.encode(a.from.decode(v))
+			if err != nil {
+				return err
+			}
+			copied = append(copied, encoded)
+		}
+		return a.setValues(attrs, copied)
+	}
+	return nil
+}
+
+func (a action) setValues(attrs *radius.Attributes, values []radius.Attribute) error {
+	a.attr.remove(attrs)
+	for _, v := range values {
+		if err := a.attr.add(attrs, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchAny(re *regexp.Regexp, values []string) bool {
+	for _, v := range values {
+		if re.MatchString(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// nasIdentities returns NAS-Identifier & NAS-IP-Address values of the request
+func nasIdentities(attrs radius.Attributes) []string {
+	var result []string
+	if id, ok := attrs.Lookup(rfc2865.NASIdentifier_Type); ok {
+		result = append(result, string(id))
+	}
+	if ip, ok := attrs.Lookup(rfc2865.NASIPAddress_Type); ok && len(ip) == net.IPv4len {
+		result = append(result, net.IP(ip).String())
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+type (
+	// ConnectionCounters tracks connections of stream (TCP & TLS) listeners
+	ConnectionCounters interface {
+		Opened()
+		Closed(reason string)
+		HandshakeFailed(reason string)
+	}
+
+	connectionCounters struct {
+		listenerTag tag.Mutator
+		active      int64
+	}
+)
+
+var (
+	connectionOpened = stats.Int64(
+		"connection/opened",
+		"Stream connections accepted by the listener",
+		stats.UnitDimensionless,
+	)
+	connectionClosed = stats.Int64(
+		"connection/closed",
+		"Stream connections closed by the listener",
+		stats.UnitDimensionless,
+	)
+	connectionActive = stats.Int64(
+		"connection/active",
+		"Currently open stream connections",
+		stats.UnitDimensionless,
+	)
+	connectionHandshakeFailed = stats.Int64(
+		"connection/handshake_failed",
+		"TLS handshakes which failed",
+		stats.UnitDimensionless,
+	)
+	registerConnectionViews sync.Once
+)
+
+func (c *connectionCounters) Opened() {
+	active := atomic.AddInt64(&c.active, 1)
+	c.record(nil, connectionOpened.M(1), connectionActive.M(active))
+}
+
+func (c *connectionCounters) Closed(reason string) {
+	active := atomic.AddInt64(&c.active, -1)
+	c.record(
+		[]tag.Mutator{tag.Upsert(ErrorCodeTag, reason)},
+		connectionClosed.M(1),
+		connectionActive.M(active),
+	)
+}
+
+func (c *connectionCounters) HandshakeFailed(reason string) {
+	c.record([]tag.Mutator{tag.Upsert(ErrorCodeTag, reason)}, connectionHandshakeFailed.M(1))
+}
+
+func (c *connectionCounters) record(tags []tag.Mutator, ms ...stats.Measurement) {
+	stats.RecordWithTags(context.Background(), append(tags, c.listenerTag), ms...)
+}
+
+// CreateConnectionCounters ...
+func CreateConnectionCounters(name string) ConnectionCounters {
+	registerConnectionViews.Do(func() {
+		view.Register(
+			&view.View{
+				Name:        "connection/opened",
+				Measure:     connectionOpened,
+				Description: "The number of accepted stream connections",
+				Aggregation: view.Count(),
+				TagKeys:     AllTagKeys(),
+			},
+			&view.View{
+				Name:        "connection/closed",
+				Measure:     connectionClosed,
+				Description: "The number of closed stream connections",
+				Aggregation: view.Count(),
+				TagKeys:     AllTagKeys(),
+			},
+			&view.View{
+				Name:        "connection/active",
+				Measure:     connectionActive,
+				Description: "The number of currently open stream connections",
+				Aggregation: view.LastValue(),
+				TagKeys:     []tag.Key{ListenerTag},
+			},
+			&view.View{
+				Name:        "connection/handshake_failed",
+				Measure:     connectionHandshakeFailed,
+				Description: "The number of failed TLS handshakes",
+				Aggregation: view.Count(),
+				TagKeys:     AllTagKeys(),
+			},
+		)
+	})
+	return &connectionCounters{listenerTag: tag.Upsert(ListenerTag, name)}
+}
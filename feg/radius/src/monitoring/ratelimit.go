@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+type (
+	// RateLimitCounters tracks requests checked by a rate limiting filter
+	RateLimitCounters interface {
+		Allowed(listener string, requestCode string)
+		Limited(listener string, requestCode string, limit string, action string)
+	}
+
+	rateLimitCounters struct {
+		filter string
+	}
+)
+
+var (
+	rateLimitAllowed = stats.Int64(
+		"ratelimit/allowed",
+		"Requests within the rate limits",
+		stats.UnitDimensionless,
+	)
+	rateLimitLimited = stats.Int64(
+		"ratelimit/limited",
+		"Requests exceeding a rate limit",
+		stats.UnitDimensionless,
+	)
+	registerRateLimitViews sync.Once
+)
+
+func (r *rateLimitCounters) Allowed(listener string, requestCode string) {
+	stats.RecordWithTags(
+		context.Background(),
+		[]tag.Mutator{
+			tag.Upsert(FilterTag, r.filter),
+			tag.Upsert(ListenerTag, listener),
+			tag.Upsert(RequestCodeTag, requestCode),
+		},
+		rateLimitAllowed.M(1),
+	)
+}
+
+func (r *rateLimitCounters) Limited(listener string, requestCode string, limit string, action string) {
+	stats.RecordWithTags(
+		context.Background(),
+		[]tag.Mutator{
+			tag.Upsert(FilterTag, r.filter),
+			tag.Upsert(ListenerTag, listener),
+			tag.Upsert(RequestCodeTag, requestCode),
+			tag.Upsert(LimitTag, limit),
+			tag.Upsert(ActionTag, action),
+		},
+		rateLimitLimited.M(1),
+	)
+}
+
+// CreateRateLimitCounters ...
+func CreateRateLimitCounters(filter string) RateLimitCounters {
+	registerRateLimitViews.Do(func() {
+		view.Register(
+			&view.View{
+				Name:        "ratelimit/allowed",
+				Measure:     rateLimitAllowed,
+				Description: "The number of requests within the rate limits",
+				Aggregation: view.Count(),
+				TagKeys:     []tag.Key{FilterTag, ListenerTag, RequestCodeTag},
+			},
+			&view.View{
+				Name:        "ratelimit/limited",
+				Measure:     rateLimitLimited,
+				Description: "The number of requests exceeding a rate limit, by limit & action taken",
+				Aggregation: view.Count(),
+				TagKeys:     []tag.Key{FilterTag, ListenerTag, RequestCodeTag, LimitTag, ActionTag},
+			},
+		)
+	})
+	return &rateLimitCounters{filter: filter}
+}
@@ -42,9 +42,18 @@ var (
 
 	// ResponseCodeTag RADIUS response code
 	ResponseCodeTag, _ = tag.NewKey("response_code")
+
+	// UpstreamTag The upstream RADIUS server a request was proxied to
+	UpstreamTag, _ = tag.NewKey("upstream")
+
+	// LimitTag The rate limit a request exceeded
+	LimitTag, _ = tag.NewKey("limit")
+
+	// ActionTag The action taken on a rate limited request
+	ActionTag, _ = tag.NewKey("action")
 )
 
 // AllTagKeys ...
 func AllTagKeys() []tag.Key {
-	return []tag.Key{ListenerTag, ModuleTag, FilterTag, RadiusTypeTag, ErrorCodeTag, SessionIDTag, StorageTag, RequestCodeTag, ResponseCodeTag}
+	return []tag.Key{ListenerTag, ModuleTag, FilterTag, RadiusTypeTag, ErrorCodeTag, SessionIDTag, StorageTag, RequestCodeTag, ResponseCodeTag, UpstreamTag, LimitTag, ActionTag}
 }
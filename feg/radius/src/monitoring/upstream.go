@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+type (
+	// UpstreamCounters tracks requests proxied to a single upstream RADIUS server
+	UpstreamCounters interface {
+		Success(requestCode string, responseCode string, latency time.Duration)
+		Failure(requestCode string, errorCode string, latency time.Duration)
+		HealthCheck(errorCode string)
+		Healthy(healthy bool)
+	}
+
+	upstreamCounters struct {
+		tags []tag.Mutator
+	}
+)
+
+var (
+	upstreamRequests = stats.Int64(
+		"upstream/requests",
+		"Requests answered by the upstream",
+		stats.UnitDimensionless,
+	)
+	upstreamErrors = stats.Int64(
+		"upstream/errors",
+		"Requests to the upstream which failed",
+		stats.UnitDimensionless,
+	)
+	upstreamLatency = stats.Int64(
+		"upstream/latency",
+		"Round trip time of requests to the upstream",
+		stats.UnitMilliseconds,
+	)
+	upstreamHealthChecks = stats.Int64(
+		"upstream/health_checks",
+		"Status-Server probes sent to the upstream",
+		stats.UnitDimensionless,
+	)
+	upstreamHealthy = stats.Int64(
+		"upstream/healthy",
+		"Whether the upstream is considered healthy (1) or not (0)",
+		stats.UnitDimensionless,
+	)
+	registerUpstreamViews sync.Once
+)
+
+func (u *upstreamCounters) Success(requestCode string, responseCode string, latency time.Duration) {
+	u.record(
+		[]tag.Mutator{tag.Upsert(RequestCodeTag, requestCode), tag.Upsert(ResponseCodeTag, responseCode)},
+		upstreamRequests.M(1),
+		upstreamLatency.M(latency.Milliseconds()),
+	)
+}
+
+func (u *upstreamCounters) Failure(requestCode string, errorCode string, latency time.Duration) {
+	u.record(
+		[]tag.Mutator{tag.Upsert(RequestCodeTag, requestCode), tag.Upsert(ErrorCodeTag, errorCode)},
+		upstreamErrors.M(1),
+		upstreamLatency.M(latency.Milliseconds()),
+	)
+}
+
+func (u *upstreamCounters) HealthCheck(errorCode string) {
+	tags := []tag.Mutator{}
+	if errorCode != "" {
+		tags = append(tags, tag.Upsert(ErrorCodeTag, errorCode))
+	}
+	u.record(tags, upstreamHealthChecks.M(1))
+}
+
+func (u *upstreamCounters) Healthy(healthy bool) {
+	var value int64
+	if healthy {
+		value = 1
+	}
+	u.record(nil, upstreamHealthy.M(value))
+}
+
+func (u *upstreamCounters) record(tags []tag.Mutator, ms ...stats.Measurement) {
+	stats.RecordWithTags(context.Background(), append(tags, u.tags...), ms...)
+}
+
+// CreateUpstreamCounters ...
+func CreateUpstreamCounters(module string, upstream string) UpstreamCounters {
+	registerUpstreamViews.Do(func() {
+		view.Register(
+			&view.View{
+				Name:        "upstream/requests",
+				Measure:     upstreamRequests,
+				Description: "The number of requests answered by the upstream",
+				Aggregation: view.Count(),
+				TagKeys:     AllTagKeys(),
+			},
+			&view.View{
+				Name:        "upstream/errors",
+				Measure:     upstreamErrors,
+				Description: "The number of requests to the upstream which failed",
+				Aggregation: view.Count(),
+				TagKeys:     AllTagKeys(),
+			},
+			&view.View{
+				Name:        "upstream/latency",
+				Measure:     upstreamLatency,
+				Description: "The round trip time of requests to the upstream",
+				Aggregation: view.Distribution(1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000),
+				TagKeys:     []tag.Key{ModuleTag, UpstreamTag, RequestCodeTag},
+			},
+			&view.View{
+				Name:        "upstream/health_checks",
+				Measure:     upstreamHealthChecks,
+				Description: "The number of Status-Server probes sent to the upstream",
+				Aggregation: view.Count(),
+				TagKeys:     AllTagKeys(),
+			},
+			&view.View{
+				Name:        "upstream/healthy",
+				Measure:     upstreamHealthy,
+				Description: "The health state of the upstream",
+				Aggregation: view.LastValue(),
+				TagKeys:     []tag.Key{ModuleTag, UpstreamTag},
+			},
+		)
+	})
+	return &upstreamCounters{
+		tags: []tag.Mutator{tag.Upsert(ModuleTag, module), tag.Upsert(UpstreamTag, upstream)},
+	}
+}
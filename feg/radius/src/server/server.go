@@ -25,6 +25,7 @@ import (
 	"fbc/cwf/radius/modules"
 	"fbc/cwf/radius/monitoring"
 	"fbc/cwf/radius/session"
+	"fbc/lib/go/dictionary"
 
 	"github.com/patrickmn/go-cache"
 	"go.opencensus.io/tag"
@@ -71,6 +72,7 @@ type (
 		multiSessionStorage session.GlobalStorage
 		dedupSet            *cache.Cache
 		counters            *monitoring.ServerCounters
+		dictionary          *dictionary.Dictionary
 	}
 )
 
@@ -82,6 +84,14 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 		return nil, err
 	}
 
+	// Load attribute dictionaries, modules may resolve attributes by name on Init
+	dict, err := dictionary.Load(config.Dictionaries...)
+	if err != nil {
+		logger.Error("failed to load dictionaries", zap.Strings("dictionaries", config.Dictionaries), zap.Error(err))
+		return nil, err
+	}
+	dictionary.SetDefault(dict)
+
 	// Init server object
 	server := Server{
 		listeners:           make(map[string]ListenerInterface), // Will be populated by "Start" method
@@ -93,6 +103,7 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 		multiSessionStorage: multiSessionStorage,
 		dedupSet:            cache.New(config.DedupWindow.Duration, time.Minute),
 		counters:            monitoring.CreateServerCounters(),
+		dictionary:          dict,
 	}
 
 	serverInitCounter := server.counters.Init.Start()
@@ -140,6 +151,10 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 			listener = NewUDPListener()
 		case "grpc":
 			listener = NewGRPCListener()
+		case "tcp":
+			listener = NewTCPListener()
+		case "tls":
+			listener = NewTLSListener()
 		default:
 			logger.Error(
 				fmt.Sprintf("failed to create listener, listener type '%s'", lconfig.Type),
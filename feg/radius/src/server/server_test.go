@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/filters"
 	"fbc/cwf/radius/filters/filterstest"
 	"fbc/cwf/radius/loader"
 	"fbc/cwf/radius/loader/loaderstest"
@@ -332,6 +333,78 @@ func TestFilterFailsToProcess(t *testing.T) {
 	mFilter1.AssertExpectations(t)
 }
 
+func TestFilterRejects(t *testing.T) {
+	// Arrange
+	config := getConfigWithFilters(t, []string{"filter.1"})
+
+	mFilter1 := &filterstest.MockFilter{}
+	mFilter1.On("Init", mock.Anything).Return(nil).On(
+		"Process",
+		mock.AnythingOfType("*modules.RequestContext"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("*radius.Request"),
+	).Return(fmt.Errorf("%w: rate limit exceeded", filters.ErrReject))
+
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadFilter", "filter.1").Return(mFilter1, nil)
+
+	mModule1 := createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessAccept}, nil)
+	loader.On("LoadModule", "module.auth.1").Return(mModule1, nil)
+
+	server, err := New(config, zap.NewNop(), &loader)
+	assert.Equal(t, err, nil)
+	isReady := server.StartAndWait()
+	require.True(t, isReady, "failed to initialize the server")
+
+	// Act
+	packet := radius.New(radius.CodeAccessRequest, []byte(config.Secret))
+	client := radius.Client{
+		Retry: 0,
+	}
+	port := config.Listeners[0].Extra["Port"].(int)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	response, err := client.Exchange(ctx, packet, fmt.Sprintf(":%d", port))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, radius.CodeAccessReject, response.Code)
+	mFilter1.AssertExpectations(t)
+	mModule1.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFilterDrops(t *testing.T) {
+	// Arrange
+	config := getConfigWithFilters(t, []string{"filter.1"})
+
+	mFilter1 := createMockFilterWithReturn(fmt.Errorf("%w: rate limit exceeded", filters.ErrDrop))
+
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadFilter", "filter.1").Return(mFilter1, nil)
+
+	mModule1 := createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessAccept}, nil)
+	loader.On("LoadModule", "module.auth.1").Return(mModule1, nil)
+
+	server, err := New(config, zap.NewNop(), &loader)
+	assert.Equal(t, err, nil)
+	isReady := server.StartAndWait()
+	require.True(t, isReady, "failed to initialize the server")
+
+	// Act
+	packet := radius.New(radius.CodeAccountingRequest, []byte(config.Secret))
+	client := radius.Client{
+		Retry: 0,
+	}
+	port := config.Listeners[0].Extra["Port"].(int)
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	_, err = client.Exchange(ctx, packet, fmt.Sprintf(":%d", port))
+
+	// Assert
+	require.Error(t, err)
+	mFilter1.AssertExpectations(t)
+}
+
 func TestDedup(t *testing.T) {
 	// Arrange
 	logger, err := zap.NewDevelopment()
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+)
+
+// RadSecDefaultSecret the shared secret used for RadSec connections (see RFC 6614, section 2.3)
+const RadSecDefaultSecret = "radsec"
+
+type (
+	// TLSListenerConfig TLS configuration of a RadSec listener
+	TLSListenerConfig struct {
+		CertFile     string `json:"certFile"`     // Server certificate chain (PEM)
+		KeyFile      string `json:"keyFile"`      // Server private key (PEM)
+		ClientCAFile string `json:"clientCAFile"` // CAs used to verify client certificates (PEM)
+	}
+
+	// ClientSecretConfig maps a stream client to its shared secret. A client matches the entry if
+	// its certificate name (Common Name or DNS SAN) equals Name and its address is within CIDR,
+	// empty Name or CIDR match any client.
+	ClientSecretConfig struct {
+		Name   string `json:"name"`
+		CIDR   string `json:"cidr"`
+		Secret string `json:"secret"`
+	}
+
+	clientSecret struct {
+		name   string
+		subnet *net.IPNet
+		secret []byte
+	}
+
+	// clientSecrets resolves shared secrets of stream clients
+	clientSecrets struct {
+		clients       []clientSecret
+		defaultSecret []byte
+		rejectUnknown bool
+	}
+)
+
+// newTLSConfig creates a mutual TLS server configuration
+func newTLSConfig(cfg TLSListenerConfig) (*tls.Config, error) {
+	if len(cfg.CertFile) == 0 || len(cfg.KeyFile) == 0 {
+		return nil, errors.New("tls listener: certFile and keyFile must be configured")
+	}
+	if len(cfg.ClientCAFile) == 0 {
+		return nil, errors.New("tls listener: clientCAFile must be configured for client authentication")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("tls listener: failed to load server certificate: %v", err)
+	}
+	caPEM, err := ioutil.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("tls listener: failed to read client CA file: %v", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("tls listener: no certificates found in %s", cfg.ClientCAFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func newClientSecrets(cfg []ClientSecretConfig, defaultSecret string, rejectUnknown bool) (*clientSecrets, error) {
+	result := &clientSecrets{defaultSecret: []byte(defaultSecret), rejectUnknown: rejectUnknown}
+	for i, c := range cfg {
+		if len(c.Secret) == 0 {
+			return nil, fmt.Errorf("client %d: empty secret", i)
+		}
+		entry := clientSecret{name: c.Name, secret: []byte(c.Secret)}
+		if len(c.CIDR) > 0 {
+			_, subnet, err := net.ParseCIDR(c.CIDR)
+			if err != nil {
+				return nil, fmt.Errorf("client %d: %v", i, err)
+			}
+			entry.subnet = subnet
+		}
+		result.clients = append(result.clients, entry)
+	}
+	return result, nil
+}
+
+// secretFor returns the shared secret of the connected client, false if the client is not allowed
+func (c *clientSecrets) secretFor(conn net.Conn) ([]byte, bool) {
+	var names []string
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		if certs := tlsConn.ConnectionState().PeerCertificates; len(certs) > 0 {
+			names = append([]string{certs[0].Subject.CommonName}, certs[0].DNSNames...)
+		}
+	}
+	var ip net.IP
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		ip = addr.IP
+	}
+	for _, client := range c.clients {
+		if client.matches(names, ip) {
+			return client.secret, true
+		}
+	}
+	return c.defaultSecret, !c.rejectUnknown && len(c.defaultSecret) > 0
+}
+
+func (c clientSecret) matches(names []string, ip net.IP) bool {
+	if c.subnet != nil && (ip == nil || !c.subnet.Contains(ip)) {
+		return false
+	}
+	if len(c.name) == 0 {
+		return true
+	}
+	for _, name := range names {
+		if name == c.name {
+			return true
+		}
+	}
+	return false
+}
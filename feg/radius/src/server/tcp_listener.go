@@ -0,0 +1,372 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/modules"
+	"fbc/cwf/radius/monitoring"
+
+	"github.com/mitchellh/mapstructure"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+)
+
+const (
+	radiusHeaderLength  = 4
+	radiusMinLength     = 20
+	tlsHandshakeTimeout = 10 * time.Second
+	acceptMinBackoff    = 5 * time.Millisecond
+	acceptMaxBackoff    = time.Second
+)
+
+// TCPListener listens to Radius packets over TCP (RFC 6613) or TLS (RadSec, RFC 6614) streams
+type TCPListener struct {
+	Listener
+	Server      *Server
+	Port        int
+	useTLS      bool
+	tlsConfig   *tls.Config
+	idleTimeout time.Duration
+	secrets     *clientSecrets
+	handler     func(radius.ResponseWriter, *radius.Request)
+	connCtrs    monitoring.ConnectionCounters
+	ready       chan bool
+
+	mu       sync.Mutex
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	shutdown bool
+	active   sync.WaitGroup
+}
+
+// TCPListenerExtraConfig extra config for TCP & TLS listeners
+type TCPListenerExtraConfig struct {
+	Port                 int                  `json:"port"`
+	IdleTimeout          string               `json:"idleTimeout"` // Connection idle timeout, empty - no timeout
+	TLS                  TLSListenerConfig    `json:"tls"`
+	Clients              []ClientSecretConfig `json:"clients"`
+	RejectUnknownClients bool                 `json:"rejectUnknownClients"`
+}
+
+// NewTCPListener ...
+func NewTCPListener() *TCPListener {
+	return &TCPListener{
+		ready: make(chan bool),
+		conns: map[net.Conn]struct{}{},
+	}
+}
+
+// NewTLSListener ...
+func NewTLSListener() *TCPListener {
+	l := NewTCPListener()
+	l.useTLS = true
+	return l
+}
+
+// Init override
+func (l *TCPListener) Init(
+	server *Server,
+	serverConfig config.ServerConfig,
+	listenerConfig config.ListenerConfig,
+	ctrs monitoring.ListenerCounters,
+) error {
+	if server == nil {
+		return errors.New("cannot initialize TCP listener with null server")
+	}
+
+	// Parse configuration
+	var cfg TCPListenerExtraConfig
+	err := mapstructure.Decode(listenerConfig.Extra, &cfg)
+	if err != nil {
+		return err
+	}
+	if len(cfg.IdleTimeout) > 0 {
+		l.idleTimeout, err = time.ParseDuration(cfg.IdleTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid idle timeout: %v", err)
+		}
+	}
+
+	defaultSecret := serverConfig.Secret
+	if l.useTLS {
+		defaultSecret = RadSecDefaultSecret
+		l.tlsConfig, err = newTLSConfig(cfg.TLS)
+		if err != nil {
+			return err
+		}
+	}
+	l.secrets, err = newClientSecrets(cfg.Clients, defaultSecret, cfg.RejectUnknownClients)
+	if err != nil {
+		return err
+	}
+
+	l.Server = server
+	l.Port = cfg.Port
+	l.handler = generatePacketHandler(l, server, ctrs)
+	l.connCtrs = monitoring.CreateConnectionCounters(listenerConfig.Name)
+	return nil
+}
+
+// ListenAndServe override
+func (l *TCPListener) ListenAndServe() error {
+	listenAddress := fmt.Sprintf(":%d", l.Port)
+	lis, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		go func() {
+			l.ready <- false
+		}()
+		return fmt.Errorf("tcp listener: failed to listen on %s: %v", listenAddress, err)
+	}
+	l.mu.Lock()
+	l.listener = lis
+	l.mu.Unlock()
+
+	go l.serve(lis)
+
+	// Signal listener is ready
+	go func() {
+		l.ready <- true
+	}()
+	return nil
+}
+
+// Addr returns the address the listener accepts connections on, nil if not listening
+func (l *TCPListener) Addr() net.Addr {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.listener == nil {
+		return nil
+	}
+	return l.listener.Addr()
+}
+
+// GetHandleRequest override
+func (l *TCPListener) GetHandleRequest() modules.Middleware {
+	return l.HandleRequest
+}
+
+// Shutdown override
+func (l *TCPListener) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	l.shutdown = true
+	if l.listener != nil {
+		l.listener.Close()
+	}
+	for conn := range l.conns {
+		conn.Close()
+	}
+	l.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		l.active.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Ready override
+func (l *TCPListener) Ready() chan bool {
+	return l.ready
+}
+
+// SetConfig override
+func (l *TCPListener) SetConfig(c config.ListenerConfig) {
+	l.Config = c
+}
+
+// serve accepts connections until the listener is closed. Other accept errors (e.g. EMFILE,
+// ECONNABORTED) are transient, so they are logged & retried with a capped backoff
+func (l *TCPListener) serve(lis net.Listener) {
+	var backoff time.Duration
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if l.isShutdown() || errors.Is(err, net.ErrClosed) {
+				return
+			}
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				continue
+			}
+			if backoff == 0 {
+				backoff = acceptMinBackoff
+			} else if backoff *= 2; backoff > acceptMaxBackoff {
+				backoff = acceptMaxBackoff
+			}
+			l.Server.logger.Error(
+				"tcp listener: accept failed, retrying",
+				zap.Error(err),
+				zap.Duration("backoff", backoff),
+			)
+			time.Sleep(backoff)
+			continue
+		}
+		backoff = 0
+		if !l.track(conn) {
+			conn.Close()
+			return
+		}
+		go l.serveConn(conn)
+	}
+}
+
+// serveConn reads RADIUS packets from the connection & dispatches them to the packet handler.
+// The connection is closed on malformed packets as required by RFC 6613, section 2.6.4
+func (l *TCPListener) serveConn(conn net.Conn) {
+	reason := "closed"
+	l.connCtrs.Opened()
+	defer func() {
+		l.untrack(conn)
+		conn.Close()
+		l.connCtrs.Closed(reason)
+	}()
+	logger := l.Server.logger.With(
+		zap.String("listener", l.GetConfig().Name),
+		zap.Stringer("remote_addr", conn.RemoteAddr()),
+	)
+
+	if l.useTLS {
+		tlsConn := tls.Server(conn, l.tlsConfig)
+		tlsConn.SetDeadline(time.Now().Add(tlsHandshakeTimeout))
+		if err := tlsConn.Handshake(); err != nil {
+			logger.Warn("TLS handshake failed", zap.Error(err))
+			l.connCtrs.HandshakeFailed("handshake_error")
+			reason = "handshake_failed"
+			return
+		}
+		tlsConn.SetDeadline(time.Time{})
+		conn = tlsConn
+	}
+
+	secret, allowed := l.secrets.secretFor(conn)
+	if !allowed {
+		logger.Warn("connection from unknown client rejected")
+		reason = "unknown_client"
+		return
+	}
+
+	writer := &streamResponseWriter{conn: conn}
+	buff := make([]byte, radius.MaxPacketLength)
+	for {
+		if l.idleTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(l.idleTimeout))
+		}
+		if _, err := io.ReadFull(conn, buff[:radiusHeaderLength]); err != nil {
+			reason = readFailureReason(err, l.isShutdown())
+			return
+		}
+		length := int(binary.BigEndian.Uint16(buff[2:radiusHeaderLength]))
+		if length < radiusMinLength || length > radius.MaxPacketLength {
+			logger.Warn("invalid RADIUS packet length, closing connection", zap.Int("length", length))
+			reason = "framing_error"
+			return
+		}
+		if _, err := io.ReadFull(conn, buff[radiusHeaderLength:length]); err != nil {
+			reason = readFailureReason(err, l.isShutdown())
+			return
+		}
+		packetBytes := append([]byte(nil), buff[:length]...)
+		if !radius.IsAuthenticRequest(packetBytes, secret) {
+			logger.Warn("RADIUS packet validation failed, closing connection")
+			reason = "bad_authenticator"
+			return
+		}
+		packet, err := radius.Parse(packetBytes, secret)
+		if err != nil {
+			logger.Warn("unable to parse RADIUS packet, closing connection", zap.Error(err))
+			reason = "malformed_packet"
+			return
+		}
+
+		l.active.Add(1)
+		go func(request *radius.Request) {
+			defer l.active.Done()
+			l.handler(writer, request)
+		}(&radius.Request{
+			LocalAddr:  conn.LocalAddr(),
+			RemoteAddr: conn.RemoteAddr(),
+			Packet:     packet,
+		})
+	}
+}
+
+func (l *TCPListener) track(conn net.Conn) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.shutdown {
+		return false
+	}
+	l.conns[conn] = struct{}{}
+	l.active.Add(1)
+	return true
+}
+
+func (l *TCPListener) untrack(conn net.Conn) {
+	l.mu.Lock()
+	delete(l.conns, conn)
+	l.mu.Unlock()
+	l.active.Done()
+}
+
+func (l *TCPListener) isShutdown() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.shutdown
+}
+
+func readFailureReason(err error, shutdown bool) string {
+	switch {
+	case shutdown:
+		return "shutdown"
+	case err == io.EOF:
+		return "closed"
+	}
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		return "idle_timeout"
+	}
+	return "read_error"
+}
+
+// streamResponseWriter serializes responses written to a stream connection
+type streamResponseWriter struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func (w *streamResponseWriter) Write(packet *radius.Packet) error {
+	encoded, err := packet.Encode()
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err = w.conn.Write(encoded)
+	return err
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/loader/loaderstest"
+	"fbc/cwf/radius/modules"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+type testPKI struct {
+	caCert   *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	dir      string
+	certFile string
+	keyFile  string
+	caFile   string
+}
+
+func TestTLSListener(t *testing.T) {
+	pki := newTestPKI(t)
+	server, addr := startStreamServer(t, "tls", map[string]interface{}{
+		"Port": 0,
+		"tls": map[string]interface{}{
+			"certFile":     pki.certFile,
+			"keyFile":      pki.keyFile,
+			"clientCAFile": pki.caFile,
+		},
+		"clients": []map[string]interface{}{
+			{"name": "nas-1", "secret": "nas-1-secret"},
+		},
+		"rejectUnknownClients": true,
+	})
+	defer server.Stop()
+
+	// Known client gets a response signed with its own secret
+	conn, err := tls.Dial("tcp", addr, pki.clientConfig(t, "nas-1"))
+	require.NoError(t, err)
+	response := exchangeStream(t, conn, []byte("nas-1-secret"))
+	assert.Equal(t, radius.CodeAccessAccept, response.Code)
+	conn.Close()
+
+	// Unknown client is disconnected
+	conn, err = tls.Dial("tcp", addr, pki.clientConfig(t, "nas-2"))
+	require.NoError(t, err)
+	assertClosed(t, conn)
+
+	// Client without a certificate fails the handshake
+	conn, err = tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true})
+	if err == nil {
+		assertClosed(t, conn)
+	}
+}
+
+func TestTCPListener(t *testing.T) {
+	server, addr := startStreamServer(t, "tcp", map[string]interface{}{
+		"Port": 0,
+		"clients": []map[string]interface{}{
+			{"cidr": "10.0.0.0/8", "secret": "private-secret"},
+		},
+	})
+	defer server.Stop()
+
+	// Clients outside of the configured ranges use the server's secret
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	response := exchangeStream(t, conn, []byte(server.config.Secret))
+	assert.Equal(t, radius.CodeAccessAccept, response.Code)
+
+	// Invalid packet length closes the connection
+	_, err = conn.Write([]byte{byte(radius.CodeAccessRequest), 1, 0, 5})
+	require.NoError(t, err)
+	assertClosed(t, conn)
+}
+
+func TestTCPListenerAcceptErrors(t *testing.T) {
+	lis := &flakyListener{errs: []error{
+		&net.OpError{Op: "accept", Net: "tcp", Err: syscall.EMFILE},
+		&net.OpError{Op: "accept", Net: "tcp", Err: syscall.ECONNABORTED},
+		net.ErrClosed,
+	}}
+	l := NewTCPListener()
+	l.Server = &Server{logger: zap.NewNop()}
+
+	done := make(chan struct{})
+	go func() {
+		l.serve(lis)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("accept loop did not exit after listener was closed")
+	}
+	assert.Equal(t, 3, lis.accepts)
+}
+
+// flakyListener fails every Accept with the next error in errs
+type flakyListener struct {
+	net.Listener
+	errs    []error
+	accepts int
+}
+
+func (l *flakyListener) Accept() (net.Conn, error) {
+	err := l.errs[l.accepts]
+	l.accepts++
+	return nil, err
+}
+
+func TestClientSecrets(t *testing.T) {
+	secrets, err := newClientSecrets([]ClientSecretConfig{
+		{CIDR: "10.1.0.0/16", Secret: "a"},
+		{Name: "nas", Secret: "b"},
+	}, "default", false)
+	require.NoError(t, err)
+
+	secret, ok := secrets.secretFor(&addrConn{addr: &net.TCPAddr{IP: net.ParseIP("10.1.2.3")}})
+	assert.True(t, ok)
+	assert.Equal(t, []byte("a"), secret)
+	secret, ok = secrets.secretFor(&addrConn{addr: &net.TCPAddr{IP: net.ParseIP("10.2.2.3")}})
+	assert.True(t, ok)
+	assert.Equal(t, []byte("default"), secret)
+
+	secrets.rejectUnknown = true
+	_, ok = secrets.secretFor(&addrConn{addr: &net.TCPAddr{IP: net.ParseIP("10.2.2.3")}})
+	assert.False(t, ok)
+
+	_, err = newClientSecrets([]ClientSecretConfig{{CIDR: "10.1.0.0", Secret: "a"}}, "", false)
+	assert.Error(t, err)
+	_, err = newClientSecrets([]ClientSecretConfig{{Name: "nas"}}, "", false)
+	assert.Error(t, err)
+}
+
+type addrConn struct {
+	net.Conn
+	addr net.Addr
+}
+
+func (c *addrConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+func startStreamServer(t *testing.T, listenerType string, extra map[string]interface{}) (*Server, string) {
+	module := createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessAccept}, nil)
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadModule", "module.auth.1").Return(module, nil)
+
+	cfg := config.ServerConfig{
+		Secret:         "123456",
+		SessionStorage: &config.SessionStorageConfig{StorageType: "memory"},
+		Listeners: []config.ListenerConfig{{
+			Name:    "stream",
+			Type:    listenerType,
+			Extra:   extra,
+			Modules: []config.ModuleDescriptor{{Name: "module.auth.1", Config: modules.ModuleConfig{}}},
+		}},
+	}
+	server, err := New(cfg, zap.NewNop(), &loader)
+	require.NoError(t, err)
+	require.True(t, server.StartAndWait())
+	addr := server.listeners["stream"].(*TCPListener).Addr()
+	require.NotNil(t, addr)
+	return server, addr.String()
+}
+
+func exchangeStream(t *testing.T, conn net.Conn, secret []byte) *radius.Packet {
+	request := radius.New(radius.CodeAccessRequest, secret)
+	rfc2865.UserName_SetString(request, "tim")
+	encoded, err := request.Encode()
+	require.NoError(t, err)
+	_, err = conn.Write(encoded)
+	require.NoError(t, err)
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	header := make([]byte, 4)
+	_, err = io.ReadFull(conn, header)
+	require.NoError(t, err)
+	buff := make([]byte, binary.BigEndian.Uint16(header[2:]))
+	copy(buff, header)
+	_, err = io.ReadFull(conn, buff[4:])
+	require.NoError(t, err)
+	require.True(t, radius.IsAuthenticResponse(buff, encoded, secret))
+	response, err := radius.Parse(buff, secret)
+	require.NoError(t, err)
+	return response
+}
+
+func assertClosed(t *testing.T, conn net.Conn) {
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err := conn.Read(make([]byte, 1))
+	assert.Error(t, err)
+	if ne, ok := err.(net.Error); ok {
+		assert.False(t, ne.Timeout(), "connection was not closed")
+	}
+	conn.Close()
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	dir, err := ioutil.TempDir("", "radsec")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	pki := &testPKI{dir: dir}
+	pki.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "radsec-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &pki.caKey.PublicKey, pki.caKey)
+	require.NoError(t, err)
+	pki.caCert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pki.caFile = pki.writePEM(t, "ca.pem", "CERTIFICATE", der)
+
+	cert := pki.issue(t, "radius-server", x509.ExtKeyUsageServerAuth)
+	pki.certFile = pki.writePEM(t, "server.pem", "CERTIFICATE", cert.Certificate[0])
+	keyDer, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	require.NoError(t, err)
+	pki.keyFile = pki.writePEM(t, "server.key", "EC PRIVATE KEY", keyDer)
+	return pki
+}
+
+func (pki *testPKI) issue(t *testing.T, cn string, usage x509.ExtKeyUsage) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, pki.caCert, &key.PublicKey, pki.caKey)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func (pki *testPKI) clientConfig(t *testing.T, cn string) *tls.Config {
+	return &tls.Config{
+		Certificates:       []tls.Certificate{pki.issue(t, cn, x509.ExtKeyUsageClientAuth)},
+		InsecureSkipVerify: true,
+	}
+}
+
+func (pki *testPKI) writePEM(t *testing.T, name, blockType string, der []byte) string {
+	path := filepath.Join(pki.dir, name)
+	err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	require.NoError(t, err)
+	return path
+}
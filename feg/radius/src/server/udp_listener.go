@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/filters"
 	"fbc/cwf/radius/modules"
 	"fbc/cwf/radius/monitoring"
 	"fbc/cwf/radius/session"
@@ -189,10 +190,37 @@ func generatePacketHandler(
 			SessionStorage: session.NewSessionStorageExt(server.multiSessionStorage, sessionID, generatedSessionID),
 		}
 
+		if ce := requestContext.Logger.Check(zap.DebugLevel, "Request received"); ce != nil {
+			ce.Write(
+				zap.Stringer("code", r.Code),
+				zap.Stringer("source_ip", r.RemoteAddr),
+				zap.Any("attributes", server.dictionary.Describe(r.Attributes)),
+			)
+		}
+
 		// Execute filters
 		filterProcessCounter := monitoring.NewOperation("filter_process").Start()
 		for _, filter := range server.filters {
 			err := filter.Code.Process(&requestContext, l.GetConfig().Name, r)
+			if errors.Is(err, filters.ErrReject) && r.Code == radius.CodeAccessRequest {
+				server.logger.Warn("Request rejected by filter", zap.String("filter", filter.Name), zap.Error(err), correlationField)
+				filterProcessCounter.Failure(
+					"filter_rejected",
+					tag.Upsert(monitoring.FilterTag, filter.Name),
+				)
+				w.Write(r.Response(radius.CodeAccessReject))
+				return
+			}
+			if errors.Is(err, filters.ErrDrop) || errors.Is(err, filters.ErrReject) {
+				if ce := server.logger.Check(zap.DebugLevel, "Request dropped by filter"); ce != nil {
+					ce.Write(zap.String("filter", filter.Name), zap.Error(err), correlationField)
+				}
+				filterProcessCounter.Failure(
+					"filter_dropped",
+					tag.Upsert(monitoring.FilterTag, filter.Name),
+				)
+				return
+			}
 			if err != nil {
 				server.logger.Error("Failed to process reqeust by filter", zap.Error(err), correlationField)
 				filterProcessCounter.Failure(
@@ -224,6 +252,12 @@ func generatePacketHandler(
 			"Request successfully handled",
 			correlationField,
 		)
+		if ce := requestContext.Logger.Check(zap.DebugLevel, "Sending response"); ce != nil {
+			ce.Write(
+				zap.Stringer("code", response.Code),
+				zap.Any("attributes", server.dictionary.Describe(response.Attributes)),
+			)
+		}
 		radiusResponse := r.Response(response.Code)
 		for _, attr := range response.Attributes {
 			radiusResponse.Add(attr.Type, attr.Attribute)
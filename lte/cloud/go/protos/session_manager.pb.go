@@ -757,31 +757,31 @@ var (
 		40: "OPERATION_SUCCESS",
 	}
 	M5GSMCause_value = map[string]int32{
-		"OPERATOR_DETERMINED_BARRING":                                             0,
-		"INSUFFICIENT_RESOURCES":                                                  1,
-		"MISSING_OR_UNKNOWN_DNN":                                                  2,
-		"UNKNOWN_PDU_SESSION_TYPE":                                                3,
-		"USER_AUTHENTICATION_OR_AUTHORIZATION_FAILED":                             4,
-		"REQUEST_REJECTED_UNSPECIFIED":                                            5,
-		"SERVICE_OPTION_NOT_SUPPORTED":                                            6,
-		"REQUESTED_SERVICE_OPTION_NOT_SUBSCRIBED":                                 7,
-		"SERVICE_OPTION_TEMPORARILY_OUT_OF_ORDER":                                 8,
-		"REGULAR_DEACTIVATION":                                                    10,
-		"NETWORK_FAILURE":                                                         11,
-		"REACTIVATION_REQUESTED":                                                  12,
-		"INVALID_PDU_SESSION_IDENTITY":                                            13,
-		"SEMANTIC_ERRORS_IN_PACKET_FILTER":                                        14,
-		"SYNTACTICAL_ERROR_IN_PACKET_FILTER":                                      15,
-		"OUT_OF_LADN_SERVICE_AREA":                                                16,
-		"PTI_MISMATCH":                                                            17,
-		"PDU_SESSION_TYPE_IPV4_ONLY_ALLOWED":                                      18,
-		"PDU_SESSION_TYPE_IPV6_ONLY_ALLOWED":                                      19,
-		"PDU_SESSION_DOES_NOT_EXIST":                                              20,
-		"INSUFFICIENT_RESOURCES_FOR_SPECIFIC_SLICE_AND_DNN":                       21,
-		"NOT_SUPPORTED_SSC_MODE":                                                  22,
-		"INSUFFICIENT_RESOURCES_FOR_SPECIFIC_SLICE":                               23,
-		"MISSING_OR_UNKNOWN_DNN_IN_A_SLICE":                                       24,
-		"INVALID_PTI_VALUE":                                                       25,
+		"OPERATOR_DETERMINED_BARRING":                       0,
+		"INSUFFICIENT_RESOURCES":                            1,
+		"MISSING_OR_UNKNOWN_DNN":                            2,
+		"UNKNOWN_PDU_SESSION_TYPE":                          3,
+		"USER_AUTHENTICATION_OR_AUTHORIZATION_FAILED":       4,
+		"REQUEST_REJECTED_UNSPECIFIED":                      5,
+		"SERVICE_OPTION_NOT_SUPPORTED":                      6,
+		"REQUESTED_SERVICE_OPTION_NOT_SUBSCRIBED":           7,
+		"SERVICE_OPTION_TEMPORARILY_OUT_OF_ORDER":           8,
+		"REGULAR_DEACTIVATION":                              10,
+		"NETWORK_FAILURE":                                   11,
+		"REACTIVATION_REQUESTED":                            12,
+		"INVALID_PDU_SESSION_IDENTITY":                      13,
+		"SEMANTIC_ERRORS_IN_PACKET_FILTER":                  14,
+		"SYNTACTICAL_ERROR_IN_PACKET_FILTER":                15,
+		"OUT_OF_LADN_SERVICE_AREA":                          16,
+		"PTI_MISMATCH":                                      17,
+		"PDU_SESSION_TYPE_IPV4_ONLY_ALLOWED":                18,
+		"PDU_SESSION_TYPE_IPV6_ONLY_ALLOWED":                19,
+		"PDU_SESSION_DOES_NOT_EXIST":                        20,
+		"INSUFFICIENT_RESOURCES_FOR_SPECIFIC_SLICE_AND_DNN": 21,
+		"NOT_SUPPORTED_SSC_MODE":                            22,
+		"INSUFFICIENT_RESOURCES_FOR_SPECIFIC_SLICE":         23,
+		"MISSING_OR_UNKNOWN_DNN_IN_A_SLICE":                 24,
+		"INVALID_PTI_VALUE":                                 25,
 		"MAXIMUM_DATA_RATE_PER_UE_FOR_USER_PLANE_INTEGRITY_PROTECTION_IS_TOO_LOW": 26,
 		"SEMANTIC_ERROR_IN_THE_QOS_OPERATION":                                     27,
 		"SYNTACTICAL_ERROR_IN_THE_QOS_OPERATION":                                  28,
@@ -1608,7 +1608,60 @@ func (x CreditUsage_UpdateType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CreditUsage_UpdateType.Descriptor instead.
 func (CreditUsage_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{32, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{33, 0}
+}
+
+type CreditUsage_TariffChangeUsage int32
+
+const (
+	// usage is not split by a tariff change
+	CreditUsage_NOT_APPLICABLE            CreditUsage_TariffChangeUsage = 0
+	CreditUsage_UNIT_BEFORE_TARIFF_CHANGE CreditUsage_TariffChangeUsage = 1
+	CreditUsage_UNIT_AFTER_TARIFF_CHANGE  CreditUsage_TariffChangeUsage = 2
+	CreditUsage_UNIT_INDETERMINATE        CreditUsage_TariffChangeUsage = 3
+)
+
+// Enum value maps for CreditUsage_TariffChangeUsage.
+var (
+	CreditUsage_TariffChangeUsage_name = map[int32]string{
+		0: "NOT_APPLICABLE",
+		1: "UNIT_BEFORE_TARIFF_CHANGE",
+		2: "UNIT_AFTER_TARIFF_CHANGE",
+		3: "UNIT_INDETERMINATE",
+	}
+	CreditUsage_TariffChangeUsage_value = map[string]int32{
+		"NOT_APPLICABLE":            0,
+		"UNIT_BEFORE_TARIFF_CHANGE": 1,
+		"UNIT_AFTER_TARIFF_CHANGE":  2,
+		"UNIT_INDETERMINATE":        3,
+	}
+)
+
+func (x CreditUsage_TariffChangeUsage) Enum() *CreditUsage_TariffChangeUsage {
+	p := new(CreditUsage_TariffChangeUsage)
+	*p = x
+	return p
+}
+
+func (x CreditUsage_TariffChangeUsage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreditUsage_TariffChangeUsage) Descriptor() protoreflect.EnumDescriptor {
+	return file_lte_protos_session_manager_proto_enumTypes[24].Descriptor()
+}
+
+func (CreditUsage_TariffChangeUsage) Type() protoreflect.EnumType {
+	return &file_lte_protos_session_manager_proto_enumTypes[24]
+}
+
+func (x CreditUsage_TariffChangeUsage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreditUsage_TariffChangeUsage.Descriptor instead.
+func (CreditUsage_TariffChangeUsage) EnumDescriptor() ([]byte, []int) {
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{33, 1}
 }
 
 type UsageMonitoringCredit_Action int32
@@ -1647,11 +1700,11 @@ func (x UsageMonitoringCredit_Action) String() string {
 }
 
 func (UsageMonitoringCredit_Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[24].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[25].Descriptor()
 }
 
 func (UsageMonitoringCredit_Action) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[24]
+	return &file_lte_protos_session_manager_proto_enumTypes[25]
 }
 
 func (x UsageMonitoringCredit_Action) Number() protoreflect.EnumNumber {
@@ -1660,7 +1713,7 @@ func (x UsageMonitoringCredit_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UsageMonitoringCredit_Action.Descriptor instead.
 func (UsageMonitoringCredit_Action) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{36, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{37, 0}
 }
 
 type QosInformationRequest_BitrateUnitsAMBR int32
@@ -1693,11 +1746,11 @@ func (x QosInformationRequest_BitrateUnitsAMBR) String() string {
 }
 
 func (QosInformationRequest_BitrateUnitsAMBR) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[25].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[26].Descriptor()
 }
 
 func (QosInformationRequest_BitrateUnitsAMBR) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[25]
+	return &file_lte_protos_session_manager_proto_enumTypes[26]
 }
 
 func (x QosInformationRequest_BitrateUnitsAMBR) Number() protoreflect.EnumNumber {
@@ -1706,7 +1759,7 @@ func (x QosInformationRequest_BitrateUnitsAMBR) Number() protoreflect.EnumNumber
 
 // Deprecated: Use QosInformationRequest_BitrateUnitsAMBR.Descriptor instead.
 func (QosInformationRequest_BitrateUnitsAMBR) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{39, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{41, 0}
 }
 
 type M5GQosInformationRequest_BitrateUnitsAMBR int32
@@ -1739,11 +1792,11 @@ func (x M5GQosInformationRequest_BitrateUnitsAMBR) String() string {
 }
 
 func (M5GQosInformationRequest_BitrateUnitsAMBR) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[26].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[27].Descriptor()
 }
 
 func (M5GQosInformationRequest_BitrateUnitsAMBR) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[26]
+	return &file_lte_protos_session_manager_proto_enumTypes[27]
 }
 
 func (x M5GQosInformationRequest_BitrateUnitsAMBR) Number() protoreflect.EnumNumber {
@@ -1752,7 +1805,7 @@ func (x M5GQosInformationRequest_BitrateUnitsAMBR) Number() protoreflect.EnumNum
 
 // Deprecated: Use M5GQosInformationRequest_BitrateUnitsAMBR.Descriptor instead.
 func (M5GQosInformationRequest_BitrateUnitsAMBR) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{40, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{42, 0}
 }
 
 type NodeID_NodeIDType int32
@@ -1788,11 +1841,11 @@ func (x NodeID_NodeIDType) String() string {
 }
 
 func (NodeID_NodeIDType) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[27].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[28].Descriptor()
 }
 
 func (NodeID_NodeIDType) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[27]
+	return &file_lte_protos_session_manager_proto_enumTypes[28]
 }
 
 func (x NodeID_NodeIDType) Number() protoreflect.EnumNumber {
@@ -1801,7 +1854,7 @@ func (x NodeID_NodeIDType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NodeID_NodeIDType.Descriptor instead.
 func (NodeID_NodeIDType) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{50, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type QosPolicy_PolicyState int32
@@ -1837,11 +1890,11 @@ func (x QosPolicy_PolicyState) String() string {
 }
 
 func (QosPolicy_PolicyState) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[28].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[29].Descriptor()
 }
 
 func (QosPolicy_PolicyState) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[28]
+	return &file_lte_protos_session_manager_proto_enumTypes[29]
 }
 
 func (x QosPolicy_PolicyState) Number() protoreflect.EnumNumber {
@@ -1850,7 +1903,7 @@ func (x QosPolicy_PolicyState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QosPolicy_PolicyState.Descriptor instead.
 func (QosPolicy_PolicyState) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{53, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{55, 0}
 }
 
 type QosPolicy_PolicyAction int32
@@ -1886,11 +1939,11 @@ func (x QosPolicy_PolicyAction) String() string {
 }
 
 func (QosPolicy_PolicyAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[29].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[30].Descriptor()
 }
 
 func (QosPolicy_PolicyAction) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[29]
+	return &file_lte_protos_session_manager_proto_enumTypes[30]
 }
 
 func (x QosPolicy_PolicyAction) Number() protoreflect.EnumNumber {
@@ -1899,7 +1952,7 @@ func (x QosPolicy_PolicyAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QosPolicy_PolicyAction.Descriptor instead.
 func (QosPolicy_PolicyAction) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{53, 1}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{55, 1}
 }
 
 // 15.8 (Sec 8.2.2) Source Interface
@@ -1939,11 +1992,11 @@ func (x UserPlaneIPResourceSchema_InterfaceValue) String() string {
 }
 
 func (UserPlaneIPResourceSchema_InterfaceValue) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[30].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[31].Descriptor()
 }
 
 func (UserPlaneIPResourceSchema_InterfaceValue) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[30]
+	return &file_lte_protos_session_manager_proto_enumTypes[31]
 }
 
 func (x UserPlaneIPResourceSchema_InterfaceValue) Number() protoreflect.EnumNumber {
@@ -1952,7 +2005,7 @@ func (x UserPlaneIPResourceSchema_InterfaceValue) Number() protoreflect.EnumNumb
 
 // Deprecated: Use UserPlaneIPResourceSchema_InterfaceValue.Descriptor instead.
 func (UserPlaneIPResourceSchema_InterfaceValue) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{68, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{70, 0}
 }
 
 type UPFAssociationState_AssociationState int32
@@ -1994,11 +2047,11 @@ func (x UPFAssociationState_AssociationState) String() string {
 }
 
 func (UPFAssociationState_AssociationState) Descriptor() protoreflect.EnumDescriptor {
-	return file_lte_protos_session_manager_proto_enumTypes[31].Descriptor()
+	return file_lte_protos_session_manager_proto_enumTypes[32].Descriptor()
 }
 
 func (UPFAssociationState_AssociationState) Type() protoreflect.EnumType {
-	return &file_lte_protos_session_manager_proto_enumTypes[31]
+	return &file_lte_protos_session_manager_proto_enumTypes[32]
 }
 
 func (x UPFAssociationState_AssociationState) Number() protoreflect.EnumNumber {
@@ -2007,7 +2060,7 @@ func (x UPFAssociationState_AssociationState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UPFAssociationState_AssociationState.Descriptor instead.
 func (UPFAssociationState_AssociationState) EnumDescriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{69, 0}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{71, 0}
 }
 
 type RuleRecord struct {
@@ -2030,7 +2083,7 @@ type RuleRecord struct {
 	RuleVersion uint64 `protobuf:"varint,9,opt,name=rule_version,json=ruleVersion,proto3" json:"rule_version,omitempty"`
 	// Uplink TEID
 	Teid uint32 `protobuf:"varint,10,opt,name=teid,proto3" json:"teid,omitempty"`
-	// Indicates that the report is final and the flow will be deleted
+	//Indicates that the report is final and the flow will be deleted
 	FinalRecord bool `protobuf:"varint,11,opt,name=final_record,json=finalRecord,proto3" json:"final_record,omitempty"`
 }
 
@@ -2437,7 +2490,6 @@ type RatSpecificContext struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Context:
-	//
 	//	*RatSpecificContext_LteContext
 	//	*RatSpecificContext_WlanContext
 	//	*RatSpecificContext_M5GsmSessionContext
@@ -2609,7 +2661,7 @@ type RatSpecificNotification struct {
 	PduSessionType  PduSessionType   `protobuf:"varint,4,opt,name=pdu_session_type,json=pduSessionType,proto3,enum=magma.lte.PduSessionType" json:"pdu_session_type,omitempty"`
 	M5GSmCapability *M5GSMCapability `protobuf:"bytes,5,opt,name=m5g_sm_capability,json=m5gSmCapability,proto3" json:"m5g_sm_capability,omitempty"`
 	M5GsmCause      M5GSMCause       `protobuf:"varint,6,opt,name=m5gsm_cause,json=m5gsmCause,proto3,enum=magma.lte.M5GSMCause" json:"m5gsm_cause,omitempty"`
-	// Idle/paging/periodic_reg events and UE state notification
+	//Idle/paging/periodic_reg events and UE state notification
 	NotifyUeEvent NotifyUeEvents `protobuf:"varint,7,opt,name=notify_ue_event,json=notifyUeEvent,proto3,enum=magma.lte.NotifyUeEvents" json:"notify_ue_event,omitempty"`
 }
 
@@ -4062,6 +4114,17 @@ type ChargingCredit struct {
 	GrantedUnits   *GrantedUnits              `protobuf:"bytes,6,opt,name=granted_units,json=grantedUnits,proto3" json:"granted_units,omitempty"`
 	RedirectServer *RedirectServer            `protobuf:"bytes,7,opt,name=redirect_server,json=redirectServer,proto3" json:"redirect_server,omitempty"`
 	RestrictRules  []string                   `protobuf:"bytes,8,rep,name=restrict_rules,json=restrictRules,proto3" json:"restrict_rules,omitempty"`
+	// Time of the next tariff switch, usage must be reported separately
+	// for units used before and after it (AVP 451)
+	TariffTimeChange *timestamp.Timestamp `protobuf:"bytes,9,opt,name=tariff_time_change,json=tariffTimeChange,proto3" json:"tariff_time_change,omitempty"`
+	// Seconds of inactivity after which the quota must be reported (AVP 871)
+	QuotaHoldingTime uint32 `protobuf:"varint,10,opt,name=quota_holding_time,json=quotaHoldingTime,proto3" json:"quota_holding_time,omitempty"`
+	// Idle period in seconds after which the quota consumption time stops
+	// (AVP 881)
+	QuotaConsumptionTime uint32 `protobuf:"varint,11,opt,name=quota_consumption_time,json=quotaConsumptionTime,proto3" json:"quota_consumption_time,omitempty"`
+	// Re-authorization triggers of the credit, not set if the OCS didn't send
+	// a Trigger AVP (AVP 1264)
+	Triggers *ChargingTriggers `protobuf:"bytes,12,opt,name=triggers,proto3" json:"triggers,omitempty"`
 }
 
 func (x *ChargingCredit) Reset() {
@@ -4145,6 +4208,82 @@ func (x *ChargingCredit) GetRestrictRules() []string {
 	return nil
 }
 
+func (x *ChargingCredit) GetTariffTimeChange() *timestamp.Timestamp {
+	if x != nil {
+		return x.TariffTimeChange
+	}
+	return nil
+}
+
+func (x *ChargingCredit) GetQuotaHoldingTime() uint32 {
+	if x != nil {
+		return x.QuotaHoldingTime
+	}
+	return 0
+}
+
+func (x *ChargingCredit) GetQuotaConsumptionTime() uint32 {
+	if x != nil {
+		return x.QuotaConsumptionTime
+	}
+	return 0
+}
+
+func (x *ChargingCredit) GetTriggers() *ChargingTriggers {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+type ChargingTriggers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 3GPP TS 32.299 Trigger-Type values, empty list disables all triggers
+	TriggerTypes []uint32 `protobuf:"varint,1,rep,packed,name=trigger_types,json=triggerTypes,proto3" json:"trigger_types,omitempty"`
+}
+
+func (x *ChargingTriggers) Reset() {
+	*x = ChargingTriggers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_protos_session_manager_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargingTriggers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargingTriggers) ProtoMessage() {}
+
+func (x *ChargingTriggers) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_protos_session_manager_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargingTriggers.ProtoReflect.Descriptor instead.
+func (*ChargingTriggers) Descriptor() ([]byte, []int) {
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChargingTriggers) GetTriggerTypes() []uint32 {
+	if x != nil {
+		return x.TriggerTypes
+	}
+	return nil
+}
+
 type RequestedUnits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4158,7 +4297,7 @@ type RequestedUnits struct {
 func (x *RequestedUnits) Reset() {
 	*x = RequestedUnits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[31]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4171,7 +4310,7 @@ func (x *RequestedUnits) String() string {
 func (*RequestedUnits) ProtoMessage() {}
 
 func (x *RequestedUnits) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[31]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4184,7 +4323,7 @@ func (x *RequestedUnits) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestedUnits.ProtoReflect.Descriptor instead.
 func (*RequestedUnits) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{31}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RequestedUnits) GetTotal() uint64 {
@@ -4213,19 +4352,20 @@ type CreditUsage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BytesTx           uint64                 `protobuf:"varint,1,opt,name=bytes_tx,json=bytesTx,proto3" json:"bytes_tx,omitempty"`
-	BytesRx           uint64                 `protobuf:"varint,2,opt,name=bytes_rx,json=bytesRx,proto3" json:"bytes_rx,omitempty"`
-	ChargingKey       uint32                 `protobuf:"varint,4,opt,name=charging_key,json=chargingKey,proto3" json:"charging_key,omitempty"`
-	Type              CreditUsage_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=magma.lte.CreditUsage_UpdateType" json:"type,omitempty"`
-	MonitoringKey     []byte                 `protobuf:"bytes,6,opt,name=monitoring_key,json=monitoringKey,proto3" json:"monitoring_key,omitempty"`
-	ServiceIdentifier *ServiceIdentifier     `protobuf:"bytes,7,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
-	RequestedUnits    *RequestedUnits        `protobuf:"bytes,8,opt,name=requested_units,json=requestedUnits,proto3" json:"requested_units,omitempty"`
+	BytesTx           uint64                        `protobuf:"varint,1,opt,name=bytes_tx,json=bytesTx,proto3" json:"bytes_tx,omitempty"`
+	BytesRx           uint64                        `protobuf:"varint,2,opt,name=bytes_rx,json=bytesRx,proto3" json:"bytes_rx,omitempty"`
+	ChargingKey       uint32                        `protobuf:"varint,4,opt,name=charging_key,json=chargingKey,proto3" json:"charging_key,omitempty"`
+	Type              CreditUsage_UpdateType        `protobuf:"varint,5,opt,name=type,proto3,enum=magma.lte.CreditUsage_UpdateType" json:"type,omitempty"`
+	MonitoringKey     []byte                        `protobuf:"bytes,6,opt,name=monitoring_key,json=monitoringKey,proto3" json:"monitoring_key,omitempty"`
+	ServiceIdentifier *ServiceIdentifier            `protobuf:"bytes,7,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	RequestedUnits    *RequestedUnits               `protobuf:"bytes,8,opt,name=requested_units,json=requestedUnits,proto3" json:"requested_units,omitempty"`
+	TariffChangeUsage CreditUsage_TariffChangeUsage `protobuf:"varint,9,opt,name=tariff_change_usage,json=tariffChangeUsage,proto3,enum=magma.lte.CreditUsage_TariffChangeUsage" json:"tariff_change_usage,omitempty"`
 }
 
 func (x *CreditUsage) Reset() {
 	*x = CreditUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[32]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4238,7 +4378,7 @@ func (x *CreditUsage) String() string {
 func (*CreditUsage) ProtoMessage() {}
 
 func (x *CreditUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[32]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4251,7 +4391,7 @@ func (x *CreditUsage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditUsage.ProtoReflect.Descriptor instead.
 func (*CreditUsage) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{32}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CreditUsage) GetBytesTx() uint64 {
@@ -4303,6 +4443,13 @@ func (x *CreditUsage) GetRequestedUnits() *RequestedUnits {
 	return nil
 }
 
+func (x *CreditUsage) GetTariffChangeUsage() CreditUsage_TariffChangeUsage {
+	if x != nil {
+		return x.TariffChangeUsage
+	}
+	return CreditUsage_NOT_APPLICABLE
+}
+
 type CreditUsageUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4328,7 +4475,7 @@ type CreditUsageUpdate struct {
 func (x *CreditUsageUpdate) Reset() {
 	*x = CreditUsageUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[33]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4341,7 +4488,7 @@ func (x *CreditUsageUpdate) String() string {
 func (*CreditUsageUpdate) ProtoMessage() {}
 
 func (x *CreditUsageUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[33]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4354,7 +4501,7 @@ func (x *CreditUsageUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditUsageUpdate.ProtoReflect.Descriptor instead.
 func (*CreditUsageUpdate) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{33}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CreditUsageUpdate) GetUsage() *CreditUsage {
@@ -4469,7 +4616,7 @@ type CreditUpdateResponse struct {
 func (x *CreditUpdateResponse) Reset() {
 	*x = CreditUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[34]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4482,7 +4629,7 @@ func (x *CreditUpdateResponse) String() string {
 func (*CreditUpdateResponse) ProtoMessage() {}
 
 func (x *CreditUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[34]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4495,7 +4642,7 @@ func (x *CreditUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditUpdateResponse.ProtoReflect.Descriptor instead.
 func (*CreditUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{34}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreditUpdateResponse) GetSuccess() bool {
@@ -4576,7 +4723,7 @@ type UsageMonitorUpdate struct {
 func (x *UsageMonitorUpdate) Reset() {
 	*x = UsageMonitorUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[35]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4589,7 +4736,7 @@ func (x *UsageMonitorUpdate) String() string {
 func (*UsageMonitorUpdate) ProtoMessage() {}
 
 func (x *UsageMonitorUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[35]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4602,7 +4749,7 @@ func (x *UsageMonitorUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageMonitorUpdate.ProtoReflect.Descriptor instead.
 func (*UsageMonitorUpdate) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{35}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UsageMonitorUpdate) GetMonitoringKey() []byte {
@@ -4648,7 +4795,7 @@ type UsageMonitoringCredit struct {
 func (x *UsageMonitoringCredit) Reset() {
 	*x = UsageMonitoringCredit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[36]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4661,7 +4808,7 @@ func (x *UsageMonitoringCredit) String() string {
 func (*UsageMonitoringCredit) ProtoMessage() {}
 
 func (x *UsageMonitoringCredit) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[36]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4674,7 +4821,7 @@ func (x *UsageMonitoringCredit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageMonitoringCredit.ProtoReflect.Descriptor instead.
 func (*UsageMonitoringCredit) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{36}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UsageMonitoringCredit) GetAction() UsageMonitoringCredit_Action {
@@ -4726,12 +4873,19 @@ type UsageMonitoringUpdateRequest struct {
 	TgppCtx                 *TgppContext `protobuf:"bytes,8,opt,name=tgpp_ctx,json=tgppCtx,proto3" json:"tgpp_ctx,omitempty"`
 	EventTrigger            EventTrigger `protobuf:"varint,9,opt,name=event_trigger,json=eventTrigger,proto3,enum=magma.lte.EventTrigger" json:"event_trigger,omitempty"`
 	ChargingCharacteristics string       `protobuf:"bytes,10,opt,name=charging_characteristics,json=chargingCharacteristics,proto3" json:"charging_characteristics,omitempty"`
+	// 3GPP-User-Location-Info reported with USER_LOCATION_CHANGE & ECGI_CHANGE
+	UserLocation []byte `protobuf:"bytes,11,opt,name=user_location,json=userLocation,proto3" json:"user_location,omitempty"`
+	// reported with UE_TIME_ZONE_CHANGE
+	AccessTimezone *Timezone `protobuf:"bytes,12,opt,name=access_timezone,json=accessTimezone,proto3" json:"access_timezone,omitempty"`
+	// applications detected with APPLICATION_START or no longer detected with
+	// APPLICATION_STOP
+	ApplicationDetectionInfos []*ApplicationDetectionInfo `protobuf:"bytes,13,rep,name=application_detection_infos,json=applicationDetectionInfos,proto3" json:"application_detection_infos,omitempty"`
 }
 
 func (x *UsageMonitoringUpdateRequest) Reset() {
 	*x = UsageMonitoringUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[37]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4744,7 +4898,7 @@ func (x *UsageMonitoringUpdateRequest) String() string {
 func (*UsageMonitoringUpdateRequest) ProtoMessage() {}
 
 func (x *UsageMonitoringUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[37]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4757,7 +4911,7 @@ func (x *UsageMonitoringUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageMonitoringUpdateRequest.ProtoReflect.Descriptor instead.
 func (*UsageMonitoringUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{37}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UsageMonitoringUpdateRequest) GetUpdate() *UsageMonitorUpdate {
@@ -4830,6 +4984,94 @@ func (x *UsageMonitoringUpdateRequest) GetChargingCharacteristics() string {
 	return ""
 }
 
+func (x *UsageMonitoringUpdateRequest) GetUserLocation() []byte {
+	if x != nil {
+		return x.UserLocation
+	}
+	return nil
+}
+
+func (x *UsageMonitoringUpdateRequest) GetAccessTimezone() *Timezone {
+	if x != nil {
+		return x.AccessTimezone
+	}
+	return nil
+}
+
+func (x *UsageMonitoringUpdateRequest) GetApplicationDetectionInfos() []*ApplicationDetectionInfo {
+	if x != nil {
+		return x.ApplicationDetectionInfos
+	}
+	return nil
+}
+
+// Application-Detection-Information (3GPP 29.212 5.3.91)
+type ApplicationDetectionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TDF-Application-Identifier of the detected application
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// TDF-Application-Instance-Identifier, correlates start & stop reports
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// IPFilterRule flow descriptions of the detected application traffic
+	FlowDescriptions []string `protobuf:"bytes,3,rep,name=flow_descriptions,json=flowDescriptions,proto3" json:"flow_descriptions,omitempty"`
+}
+
+func (x *ApplicationDetectionInfo) Reset() {
+	*x = ApplicationDetectionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_protos_session_manager_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationDetectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationDetectionInfo) ProtoMessage() {}
+
+func (x *ApplicationDetectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_protos_session_manager_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationDetectionInfo.ProtoReflect.Descriptor instead.
+func (*ApplicationDetectionInfo) Descriptor() ([]byte, []int) {
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ApplicationDetectionInfo) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplicationDetectionInfo) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ApplicationDetectionInfo) GetFlowDescriptions() []string {
+	if x != nil {
+		return x.FlowDescriptions
+	}
+	return nil
+}
+
 // Response to a usage monitor update with the credit received and session info
 type UsageMonitoringUpdateResponse struct {
 	state         protoimpl.MessageState
@@ -4853,7 +5095,7 @@ type UsageMonitoringUpdateResponse struct {
 func (x *UsageMonitoringUpdateResponse) Reset() {
 	*x = UsageMonitoringUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[38]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4866,7 +5108,7 @@ func (x *UsageMonitoringUpdateResponse) String() string {
 func (*UsageMonitoringUpdateResponse) ProtoMessage() {}
 
 func (x *UsageMonitoringUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[38]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4879,7 +5121,7 @@ func (x *UsageMonitoringUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageMonitoringUpdateResponse.ProtoReflect.Descriptor instead.
 func (*UsageMonitoringUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{38}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UsageMonitoringUpdateResponse) GetCredit() *UsageMonitoringCredit {
@@ -4978,7 +5220,7 @@ type QosInformationRequest struct {
 func (x *QosInformationRequest) Reset() {
 	*x = QosInformationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[39]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4991,7 +5233,7 @@ func (x *QosInformationRequest) String() string {
 func (*QosInformationRequest) ProtoMessage() {}
 
 func (x *QosInformationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[39]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5004,7 +5246,7 @@ func (x *QosInformationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QosInformationRequest.ProtoReflect.Descriptor instead.
 func (*QosInformationRequest) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{39}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *QosInformationRequest) GetApnAmbrDl() uint32 {
@@ -5074,7 +5316,7 @@ type M5GQosInformationRequest struct {
 func (x *M5GQosInformationRequest) Reset() {
 	*x = M5GQosInformationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[40]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5087,7 +5329,7 @@ func (x *M5GQosInformationRequest) String() string {
 func (*M5GQosInformationRequest) ProtoMessage() {}
 
 func (x *M5GQosInformationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[40]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5100,7 +5342,7 @@ func (x *M5GQosInformationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use M5GQosInformationRequest.ProtoReflect.Descriptor instead.
 func (*M5GQosInformationRequest) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{40}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *M5GQosInformationRequest) GetApnAmbrDl() uint64 {
@@ -5165,7 +5407,7 @@ type TgppContext struct {
 func (x *TgppContext) Reset() {
 	*x = TgppContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[41]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5178,7 +5420,7 @@ func (x *TgppContext) String() string {
 func (*TgppContext) ProtoMessage() {}
 
 func (x *TgppContext) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[41]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5191,7 +5433,7 @@ func (x *TgppContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TgppContext.ProtoReflect.Descriptor instead.
 func (*TgppContext) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{41}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *TgppContext) GetGxDestHost() string {
@@ -5223,7 +5465,7 @@ type CreateSessionRequest struct {
 func (x *CreateSessionRequest) Reset() {
 	*x = CreateSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[42]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5236,7 +5478,7 @@ func (x *CreateSessionRequest) String() string {
 func (*CreateSessionRequest) ProtoMessage() {}
 
 func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[42]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5249,7 +5491,7 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
 func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{42}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CreateSessionRequest) GetAccessTimezone() *Timezone {
@@ -5309,7 +5551,7 @@ type CreateSessionResponse struct {
 func (x *CreateSessionResponse) Reset() {
 	*x = CreateSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[43]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5322,7 +5564,7 @@ func (x *CreateSessionResponse) String() string {
 func (*CreateSessionResponse) ProtoMessage() {}
 
 func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[43]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5335,7 +5577,7 @@ func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
 func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{43}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CreateSessionResponse) GetCredits() []*CreditUpdateResponse {
@@ -5422,7 +5664,7 @@ type StaticRuleInstall struct {
 func (x *StaticRuleInstall) Reset() {
 	*x = StaticRuleInstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[44]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5435,7 +5677,7 @@ func (x *StaticRuleInstall) String() string {
 func (*StaticRuleInstall) ProtoMessage() {}
 
 func (x *StaticRuleInstall) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[44]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5448,7 +5690,7 @@ func (x *StaticRuleInstall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticRuleInstall.ProtoReflect.Descriptor instead.
 func (*StaticRuleInstall) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{44}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *StaticRuleInstall) GetRuleId() string {
@@ -5486,7 +5728,7 @@ type DynamicRuleInstall struct {
 func (x *DynamicRuleInstall) Reset() {
 	*x = DynamicRuleInstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[45]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5499,7 +5741,7 @@ func (x *DynamicRuleInstall) String() string {
 func (*DynamicRuleInstall) ProtoMessage() {}
 
 func (x *DynamicRuleInstall) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[45]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5512,7 +5754,7 @@ func (x *DynamicRuleInstall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DynamicRuleInstall.ProtoReflect.Descriptor instead.
 func (*DynamicRuleInstall) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{45}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DynamicRuleInstall) GetPolicyRule() *PolicyRule {
@@ -5548,7 +5790,7 @@ type UpdateSessionRequest struct {
 func (x *UpdateSessionRequest) Reset() {
 	*x = UpdateSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[46]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5561,7 +5803,7 @@ func (x *UpdateSessionRequest) String() string {
 func (*UpdateSessionRequest) ProtoMessage() {}
 
 func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[46]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5574,7 +5816,7 @@ func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSessionRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{46}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UpdateSessionRequest) GetUpdates() []*CreditUsageUpdate {
@@ -5603,7 +5845,7 @@ type UpdateSessionResponse struct {
 func (x *UpdateSessionResponse) Reset() {
 	*x = UpdateSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[47]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5616,7 +5858,7 @@ func (x *UpdateSessionResponse) String() string {
 func (*UpdateSessionResponse) ProtoMessage() {}
 
 func (x *UpdateSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[47]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5629,7 +5871,7 @@ func (x *UpdateSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSessionResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSessionResponse) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{47}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *UpdateSessionResponse) GetResponses() []*CreditUpdateResponse {
@@ -5659,7 +5901,7 @@ type SessionTerminateResponse struct {
 func (x *SessionTerminateResponse) Reset() {
 	*x = SessionTerminateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[48]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5672,7 +5914,7 @@ func (x *SessionTerminateResponse) String() string {
 func (*SessionTerminateResponse) ProtoMessage() {}
 
 func (x *SessionTerminateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[48]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5685,7 +5927,7 @@ func (x *SessionTerminateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionTerminateResponse.ProtoReflect.Descriptor instead.
 func (*SessionTerminateResponse) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{48}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SessionTerminateResponse) GetSid() string {
@@ -5726,7 +5968,7 @@ type SessionTerminateRequest struct {
 func (x *SessionTerminateRequest) Reset() {
 	*x = SessionTerminateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[49]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5739,7 +5981,7 @@ func (x *SessionTerminateRequest) String() string {
 func (*SessionTerminateRequest) ProtoMessage() {}
 
 func (x *SessionTerminateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[49]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5752,7 +5994,7 @@ func (x *SessionTerminateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionTerminateRequest.ProtoReflect.Descriptor instead.
 func (*SessionTerminateRequest) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{49}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *SessionTerminateRequest) GetSessionId() string {
@@ -5858,7 +6100,7 @@ type NodeID struct {
 func (x *NodeID) Reset() {
 	*x = NodeID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[50]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5871,7 +6113,7 @@ func (x *NodeID) String() string {
 func (*NodeID) ProtoMessage() {}
 
 func (x *NodeID) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[50]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5884,7 +6126,7 @@ func (x *NodeID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeID.ProtoReflect.Descriptor instead.
 func (*NodeID) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{50}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *NodeID) GetNodeIdType() NodeID_NodeIDType {
@@ -5914,7 +6156,7 @@ type M5GSMCapability struct {
 func (x *M5GSMCapability) Reset() {
 	*x = M5GSMCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[51]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5927,7 +6169,7 @@ func (x *M5GSMCapability) String() string {
 func (*M5GSMCapability) ProtoMessage() {}
 
 func (x *M5GSMCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[51]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5940,7 +6182,7 @@ func (x *M5GSMCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use M5GSMCapability.ProtoReflect.Descriptor instead.
 func (*M5GSMCapability) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{51}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *M5GSMCapability) GetReflectiveQos() bool {
@@ -5975,7 +6217,7 @@ type QosRules struct {
 func (x *QosRules) Reset() {
 	*x = QosRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[52]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5988,7 +6230,7 @@ func (x *QosRules) String() string {
 func (*QosRules) ProtoMessage() {}
 
 func (x *QosRules) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[52]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6001,7 +6243,7 @@ func (x *QosRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QosRules.ProtoReflect.Descriptor instead.
 func (*QosRules) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{52}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *QosRules) GetQosRuleIdentifier() uint32 {
@@ -6067,7 +6309,7 @@ type QosPolicy struct {
 func (x *QosPolicy) Reset() {
 	*x = QosPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[53]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6080,7 +6322,7 @@ func (x *QosPolicy) String() string {
 func (*QosPolicy) ProtoMessage() {}
 
 func (x *QosPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[53]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6093,7 +6335,7 @@ func (x *QosPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QosPolicy.ProtoReflect.Descriptor instead.
 func (*QosPolicy) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{53}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *QosPolicy) GetPolicyState() QosPolicy_PolicyState {
@@ -6137,7 +6379,7 @@ type AllocationAndRetentionPrio struct {
 func (x *AllocationAndRetentionPrio) Reset() {
 	*x = AllocationAndRetentionPrio{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[54]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6150,7 +6392,7 @@ func (x *AllocationAndRetentionPrio) String() string {
 func (*AllocationAndRetentionPrio) ProtoMessage() {}
 
 func (x *AllocationAndRetentionPrio) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[54]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6163,7 +6405,7 @@ func (x *AllocationAndRetentionPrio) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllocationAndRetentionPrio.ProtoReflect.Descriptor instead.
 func (*AllocationAndRetentionPrio) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{54}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AllocationAndRetentionPrio) GetPrioLevel() uint32 {
@@ -6198,7 +6440,7 @@ type QosCharacteristics struct {
 func (x *QosCharacteristics) Reset() {
 	*x = QosCharacteristics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[55]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6211,7 +6453,7 @@ func (x *QosCharacteristics) String() string {
 func (*QosCharacteristics) ProtoMessage() {}
 
 func (x *QosCharacteristics) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[55]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6224,7 +6466,7 @@ func (x *QosCharacteristics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QosCharacteristics.ProtoReflect.Descriptor instead.
 func (*QosCharacteristics) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{55}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *QosCharacteristics) GetFiveQi() uint32 {
@@ -6246,7 +6488,7 @@ type FlowLevelParams struct {
 func (x *FlowLevelParams) Reset() {
 	*x = FlowLevelParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[56]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6259,7 +6501,7 @@ func (x *FlowLevelParams) String() string {
 func (*FlowLevelParams) ProtoMessage() {}
 
 func (x *FlowLevelParams) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[56]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6272,7 +6514,7 @@ func (x *FlowLevelParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowLevelParams.ProtoReflect.Descriptor instead.
 func (*FlowLevelParams) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{56}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *FlowLevelParams) GetQosChars() *QosCharacteristics {
@@ -6301,7 +6543,7 @@ type QosFlow struct {
 func (x *QosFlow) Reset() {
 	*x = QosFlow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[57]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6314,7 +6556,7 @@ func (x *QosFlow) String() string {
 func (*QosFlow) ProtoMessage() {}
 
 func (x *QosFlow) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[57]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6327,7 +6569,7 @@ func (x *QosFlow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QosFlow.ProtoReflect.Descriptor instead.
 func (*QosFlow) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{57}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *QosFlow) GetQosFlowIdent() uint32 {
@@ -6355,7 +6597,7 @@ type QosFlowRequestList struct {
 func (x *QosFlowRequestList) Reset() {
 	*x = QosFlowRequestList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[58]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6368,7 +6610,7 @@ func (x *QosFlowRequestList) String() string {
 func (*QosFlowRequestList) ProtoMessage() {}
 
 func (x *QosFlowRequestList) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[58]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6381,7 +6623,7 @@ func (x *QosFlowRequestList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QosFlowRequestList.ProtoReflect.Descriptor instead.
 func (*QosFlowRequestList) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{58}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *QosFlowRequestList) GetFlow() *QosFlow {
@@ -6403,7 +6645,7 @@ type LadnServiceArea struct {
 func (x *LadnServiceArea) Reset() {
 	*x = LadnServiceArea{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[59]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6416,7 +6658,7 @@ func (x *LadnServiceArea) String() string {
 func (*LadnServiceArea) ProtoMessage() {}
 
 func (x *LadnServiceArea) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[59]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6429,7 +6671,7 @@ func (x *LadnServiceArea) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LadnServiceArea.ProtoReflect.Descriptor instead.
 func (*LadnServiceArea) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{59}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *LadnServiceArea) GetDnnValue() string {
@@ -6464,7 +6706,7 @@ type TrackingAreaIdentityList struct {
 func (x *TrackingAreaIdentityList) Reset() {
 	*x = TrackingAreaIdentityList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[60]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6477,7 +6719,7 @@ func (x *TrackingAreaIdentityList) String() string {
 func (*TrackingAreaIdentityList) ProtoMessage() {}
 
 func (x *TrackingAreaIdentityList) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[60]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6490,7 +6732,7 @@ func (x *TrackingAreaIdentityList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackingAreaIdentityList.ProtoReflect.Descriptor instead.
 func (*TrackingAreaIdentityList) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{60}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *TrackingAreaIdentityList) GetTypeOfList() []TypeOfList {
@@ -6585,7 +6827,7 @@ type M5GSMSessionContext struct {
 func (x *M5GSMSessionContext) Reset() {
 	*x = M5GSMSessionContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[61]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6598,7 +6840,7 @@ func (x *M5GSMSessionContext) String() string {
 func (*M5GSMSessionContext) ProtoMessage() {}
 
 func (x *M5GSMSessionContext) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[61]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6611,7 +6853,7 @@ func (x *M5GSMSessionContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use M5GSMSessionContext.ProtoReflect.Descriptor instead.
 func (*M5GSMSessionContext) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{61}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *M5GSMSessionContext) GetPduSessionId() uint32 {
@@ -6816,7 +7058,7 @@ type SetSMSessionContext struct {
 func (x *SetSMSessionContext) Reset() {
 	*x = SetSMSessionContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[62]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6829,7 +7071,7 @@ func (x *SetSMSessionContext) String() string {
 func (*SetSMSessionContext) ProtoMessage() {}
 
 func (x *SetSMSessionContext) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[62]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6842,7 +7084,7 @@ func (x *SetSMSessionContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSMSessionContext.ProtoReflect.Descriptor instead.
 func (*SetSMSessionContext) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{62}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *SetSMSessionContext) GetCommonContext() *CommonSessionContext {
@@ -6871,7 +7113,7 @@ type SmContextVoid struct {
 func (x *SmContextVoid) Reset() {
 	*x = SmContextVoid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[63]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6884,7 +7126,7 @@ func (x *SmContextVoid) String() string {
 func (*SmContextVoid) ProtoMessage() {}
 
 func (x *SmContextVoid) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[63]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6897,7 +7139,7 @@ func (x *SmContextVoid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmContextVoid.ProtoReflect.Descriptor instead.
 func (*SmContextVoid) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{63}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SmContextVoid) GetOutput() string {
@@ -6921,7 +7163,7 @@ type RatSpecificContextAccess struct {
 func (x *RatSpecificContextAccess) Reset() {
 	*x = RatSpecificContextAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[64]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6934,7 +7176,7 @@ func (x *RatSpecificContextAccess) String() string {
 func (*RatSpecificContextAccess) ProtoMessage() {}
 
 func (x *RatSpecificContextAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[64]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6947,7 +7189,7 @@ func (x *RatSpecificContextAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RatSpecificContextAccess.ProtoReflect.Descriptor instead.
 func (*RatSpecificContextAccess) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{64}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *RatSpecificContextAccess) GetM5GSessionContextRsp() *M5GSMSessionContextAccess {
@@ -6990,7 +7232,7 @@ type M5GSMSessionContextAccess struct {
 func (x *M5GSMSessionContextAccess) Reset() {
 	*x = M5GSMSessionContextAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[65]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7003,7 +7245,7 @@ func (x *M5GSMSessionContextAccess) String() string {
 func (*M5GSMSessionContextAccess) ProtoMessage() {}
 
 func (x *M5GSMSessionContextAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[65]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7016,7 +7258,7 @@ func (x *M5GSMSessionContextAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use M5GSMSessionContextAccess.ProtoReflect.Descriptor instead.
 func (*M5GSMSessionContextAccess) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{65}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *M5GSMSessionContextAccess) GetPduSessionId() uint32 {
@@ -7123,7 +7365,7 @@ type SetSMSessionContextAccess struct {
 func (x *SetSMSessionContextAccess) Reset() {
 	*x = SetSMSessionContextAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[66]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7136,7 +7378,7 @@ func (x *SetSMSessionContextAccess) String() string {
 func (*SetSMSessionContextAccess) ProtoMessage() {}
 
 func (x *SetSMSessionContextAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[66]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7149,7 +7391,7 @@ func (x *SetSMSessionContextAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSMSessionContextAccess.ProtoReflect.Descriptor instead.
 func (*SetSMSessionContextAccess) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{66}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *SetSMSessionContextAccess) GetCommonContext() *CommonSessionContext {
@@ -7174,7 +7416,6 @@ type UPFNodeState struct {
 
 	UpfId string `protobuf:"bytes,1,opt,name=upf_id,json=upfId,proto3" json:"upf_id,omitempty"`
 	// Types that are assignable to UpfNodeMessages:
-	//
 	//	*UPFNodeState_AssociatonState
 	//	*UPFNodeState_NodeReport
 	UpfNodeMessages isUPFNodeState_UpfNodeMessages `protobuf_oneof:"upf_node_messages"`
@@ -7183,7 +7424,7 @@ type UPFNodeState struct {
 func (x *UPFNodeState) Reset() {
 	*x = UPFNodeState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[67]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7196,7 +7437,7 @@ func (x *UPFNodeState) String() string {
 func (*UPFNodeState) ProtoMessage() {}
 
 func (x *UPFNodeState) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[67]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7209,7 +7450,7 @@ func (x *UPFNodeState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPFNodeState.ProtoReflect.Descriptor instead.
 func (*UPFNodeState) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{67}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *UPFNodeState) GetUpfId() string {
@@ -7275,7 +7516,7 @@ type UserPlaneIPResourceSchema struct {
 func (x *UserPlaneIPResourceSchema) Reset() {
 	*x = UserPlaneIPResourceSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[68]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7288,7 +7529,7 @@ func (x *UserPlaneIPResourceSchema) String() string {
 func (*UserPlaneIPResourceSchema) ProtoMessage() {}
 
 func (x *UserPlaneIPResourceSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[68]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7301,7 +7542,7 @@ func (x *UserPlaneIPResourceSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserPlaneIPResourceSchema.ProtoReflect.Descriptor instead.
 func (*UserPlaneIPResourceSchema) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{68}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *UserPlaneIPResourceSchema) GetIpv4Address() string {
@@ -7368,7 +7609,7 @@ type UPFAssociationState struct {
 func (x *UPFAssociationState) Reset() {
 	*x = UPFAssociationState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[69]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7381,7 +7622,7 @@ func (x *UPFAssociationState) String() string {
 func (*UPFAssociationState) ProtoMessage() {}
 
 func (x *UPFAssociationState) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[69]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7394,7 +7635,7 @@ func (x *UPFAssociationState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPFAssociationState.ProtoReflect.Descriptor instead.
 func (*UPFAssociationState) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{69}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *UPFAssociationState) GetStateVersion() uint32 {
@@ -7479,7 +7720,7 @@ type UPFFeatureSet struct {
 func (x *UPFFeatureSet) Reset() {
 	*x = UPFFeatureSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[70]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7492,7 +7733,7 @@ func (x *UPFFeatureSet) String() string {
 func (*UPFFeatureSet) ProtoMessage() {}
 
 func (x *UPFFeatureSet) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[70]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7505,7 +7746,7 @@ func (x *UPFFeatureSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPFFeatureSet.ProtoReflect.Descriptor instead.
 func (*UPFFeatureSet) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{70}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *UPFFeatureSet) GetDownlinkDataBufferingCp() bool {
@@ -7630,7 +7871,7 @@ type UPFNodeReport struct {
 func (x *UPFNodeReport) Reset() {
 	*x = UPFNodeReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[71]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7643,7 +7884,7 @@ func (x *UPFNodeReport) String() string {
 func (*UPFNodeReport) ProtoMessage() {}
 
 func (x *UPFNodeReport) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[71]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7656,7 +7897,7 @@ func (x *UPFNodeReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPFNodeReport.ProtoReflect.Descriptor instead.
 func (*UPFNodeReport) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{71}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *UPFNodeReport) GetStateVersion() uint32 {
@@ -7695,7 +7936,7 @@ type LoadControlInformation struct {
 func (x *LoadControlInformation) Reset() {
 	*x = LoadControlInformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[72]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7708,7 +7949,7 @@ func (x *LoadControlInformation) String() string {
 func (*LoadControlInformation) ProtoMessage() {}
 
 func (x *LoadControlInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[72]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7721,7 +7962,7 @@ func (x *LoadControlInformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadControlInformation.ProtoReflect.Descriptor instead.
 func (*LoadControlInformation) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{72}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *LoadControlInformation) GetLoadControlSeqNumber() uint32 {
@@ -7751,7 +7992,7 @@ type OverloadTimer struct {
 func (x *OverloadTimer) Reset() {
 	*x = OverloadTimer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[73]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7764,7 +8005,7 @@ func (x *OverloadTimer) String() string {
 func (*OverloadTimer) ProtoMessage() {}
 
 func (x *OverloadTimer) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[73]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7777,7 +8018,7 @@ func (x *OverloadTimer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OverloadTimer.ProtoReflect.Descriptor instead.
 func (*OverloadTimer) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{73}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *OverloadTimer) GetTimerUnit() uint32 {
@@ -7809,7 +8050,7 @@ type OverloadControlInformation struct {
 func (x *OverloadControlInformation) Reset() {
 	*x = OverloadControlInformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[74]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7822,7 +8063,7 @@ func (x *OverloadControlInformation) String() string {
 func (*OverloadControlInformation) ProtoMessage() {}
 
 func (x *OverloadControlInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[74]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7835,7 +8076,7 @@ func (x *OverloadControlInformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OverloadControlInformation.ProtoReflect.Descriptor instead.
 func (*OverloadControlInformation) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{74}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *OverloadControlInformation) GetOverloadCtrlSeqno() uint32 {
@@ -7883,7 +8124,7 @@ type UPFSessionState struct {
 func (x *UPFSessionState) Reset() {
 	*x = UPFSessionState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[75]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7896,7 +8137,7 @@ func (x *UPFSessionState) String() string {
 func (*UPFSessionState) ProtoMessage() {}
 
 func (x *UPFSessionState) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[75]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7909,7 +8150,7 @@ func (x *UPFSessionState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPFSessionState.ProtoReflect.Descriptor instead.
 func (*UPFSessionState) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{75}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *UPFSessionState) GetSubscriberId() string {
@@ -7945,7 +8186,7 @@ type UPFSessionConfigState struct {
 func (x *UPFSessionConfigState) Reset() {
 	*x = UPFSessionConfigState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[76]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7958,7 +8199,7 @@ func (x *UPFSessionConfigState) String() string {
 func (*UPFSessionConfigState) ProtoMessage() {}
 
 func (x *UPFSessionConfigState) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[76]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7971,7 +8212,7 @@ func (x *UPFSessionConfigState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPFSessionConfigState.ProtoReflect.Descriptor instead.
 func (*UPFSessionConfigState) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{76}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *UPFSessionConfigState) GetUpfSessionState() []*UPFSessionState {
@@ -7993,7 +8234,7 @@ type UPFPagingInfo struct {
 func (x *UPFPagingInfo) Reset() {
 	*x = UPFPagingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lte_protos_session_manager_proto_msgTypes[77]
+		mi := &file_lte_protos_session_manager_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8006,7 +8247,7 @@ func (x *UPFPagingInfo) String() string {
 func (*UPFPagingInfo) ProtoMessage() {}
 
 func (x *UPFPagingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lte_protos_session_manager_proto_msgTypes[77]
+	mi := &file_lte_protos_session_manager_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8019,7 +8260,7 @@ func (x *UPFPagingInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPFPagingInfo.ProtoReflect.Descriptor instead.
 func (*UPFPagingInfo) Descriptor() ([]byte, []int) {
-	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{77}
+	return file_lte_protos_session_manager_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *UPFPagingInfo) GetLocalFTeid() uint32 {
@@ -8433,7 +8674,7 @@ var file_lte_protos_session_manager_proto_rawDesc = []byte{
 	0x65, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
 	0x0a, 0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36,
 	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53,
-	0x49, 0x50, 0x5f, 0x55, 0x52, 0x49, 0x10, 0x03, 0x22, 0xe0, 0x03, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x49, 0x50, 0x5f, 0x55, 0x52, 0x49, 0x10, 0x03, 0x22, 0xc7, 0x05, 0x0a, 0x0e, 0x43, 0x68, 0x61,
 	0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
 	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x72,
@@ -8457,18 +8698,36 @@ var file_lte_protos_session_manager_proto_rawDesc = []byte{
 	0x0e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
 	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65,
 	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
-	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x08, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x22, 0x3f, 0x0a, 0x0b, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52,
-	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x44, 0x49,
-	0x52, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49,
-	0x43, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x22, 0x46, 0x0a, 0x0e, 0x52,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
+	0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x16, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x73, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a,
+	0x08, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54,
+	0x45, 0x53, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10,
+	0x01, 0x22, 0x3f, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x02, 0x22, 0x37, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x0e, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a,
 	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f,
 	0x74, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x02, 0x74, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x72, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x72, 0x78, 0x22, 0x99, 0x04, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73,
+	0x02, 0x72, 0x78, 0x22, 0xf1, 0x05, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73,
 	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x78, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x78, 0x12, 0x19,
 	0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
@@ -8489,1223 +8748,1258 @@ var file_lte_protos_session_manager_proto_rawDesc = []byte{
 	0x73, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0a,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48,
-	0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x51, 0x48, 0x54,
-	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x48, 0x41,
-	0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x49, 0x54, 0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x51, 0x55, 0x4f,
-	0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x41, 0x54,
-	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x55, 0x54, 0x48,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x50,
-	0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x22,
-	0xc4, 0x04, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x67,
-	0x77, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70,
-	0x67, 0x77, 0x49, 0x70, 0x76, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c,
-	0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x6d,
-	0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x6d, 0x73, 0x69, 0x5f, 0x70, 0x6c, 0x6d, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x73, 0x69, 0x50,
-	0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x75, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61,
-	0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x67,
-	0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x74, 0x67, 0x70, 0x70, 0x43,
-	0x74, 0x78, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x72, 0x61,
-	0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x12, 0x72, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x18, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x93, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x31,
-	0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x11, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x39, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67,
-	0x70, 0x70, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x74, 0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a,
-	0x12, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x5f, 0x72, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x52, 0x78, 0x22, 0x9f, 0x02, 0x0a, 0x15, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
-	0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
-	0x67, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x55,
-	0x6e, 0x69, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c,
-	0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x52,
-	0x43, 0x45, 0x10, 0x02, 0x22, 0xc6, 0x03, 0x0a, 0x1c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x65, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x65, 0x49, 0x70, 0x76, 0x34, 0x12, 0x23, 0x0a,
-	0x0d, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x52, 0x41, 0x54, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x72, 0x61, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x74, 0x67, 0x70,
-	0x70, 0x43, 0x74, 0x78, 0x12, 0x3c, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x12, 0x39, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68,
-	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xd6, 0x04,
-	0x0a, 0x1d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x38, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72, 0x65, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26,
-	0x0a, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x6f,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x53, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6c, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x54, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x56, 0x0a, 0x18, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x15, 0x64, 0x79,
-	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63, 0x74, 0x78, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x74,
-	0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x22, 0x83, 0x03, 0x0a, 0x15, 0x51, 0x6f, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x70, 0x6e, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x64, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72, 0x44, 0x6c,
-	0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x70, 0x6e, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x75, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72, 0x55, 0x6c,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x65, 0x6d,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x18,
-	0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65,
-	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17,
-	0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x71, 0x6f, 0x73, 0x5f, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x71,
-	0x6f, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x07, 0x62, 0x72, 0x5f,
-	0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x69, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x41, 0x4d, 0x42, 0x52, 0x52, 0x06, 0x62,
-	0x72, 0x55, 0x6e, 0x69, 0x74, 0x22, 0x25, 0x0a, 0x10, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x55, 0x6e, 0x69, 0x74, 0x73, 0x41, 0x4d, 0x42, 0x52, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x50, 0x53,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x42, 0x50, 0x53, 0x10, 0x01, 0x22, 0xc7, 0x03, 0x0a,
-	0x18, 0x4d, 0x35, 0x47, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x70, 0x6e,
-	0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x64, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72, 0x44, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x70, 0x6e,
-	0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72, 0x55, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x4a, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x65, 0x6d,
-	0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x52, 0x14, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x18,
-	0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65,
-	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x65, 0x6d, 0x5f,
-	0x76, 0x75, 0x6e, 0x65, 0x72, 0x52, 0x17, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x30,
-	0x0a, 0x0c, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x51, 0x43, 0x49, 0x52, 0x0a, 0x71, 0x6f, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64,
-	0x12, 0x4d, 0x0a, 0x07, 0x62, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x34, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35,
-	0x47, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x41, 0x4d, 0x42, 0x52, 0x52, 0x06, 0x62, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x22,
-	0x25, 0x0a, 0x10, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x41,
-	0x4d, 0x42, 0x52, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x50, 0x53, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x4b, 0x42, 0x50, 0x53, 0x10, 0x01, 0x22, 0x51, 0x0a, 0x0b, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x74,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x78, 0x44,
-	0x65, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x79, 0x5f, 0x64, 0x65,
-	0x73, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
-	0x79, 0x44, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x22, 0xd0, 0x02, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
-	0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x72,
-	0x61, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x12, 0x72, 0x61, 0x74, 0x53, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb5, 0x04, 0x0a,
-	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x75,
-	0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x0c, 0x64, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63,
-	0x74, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x52, 0x07, 0x74, 0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x10, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66,
-	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66,
-	0x6c, 0x69, 0x6e, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52,
-	0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x10, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x75, 0x6c,
-	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65,
-	0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x64, 0x65,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9e,
-	0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x13, 0x74,
+	0x61, 0x72, 0x69, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
 	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x4e, 0x0a, 0x0e, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x22,
-	0xb8, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x11, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c,
+	0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x51, 0x48, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x14, 0x0a,
+	0x10, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f,
+	0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x06,
+	0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x58,
+	0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x22, 0x7c, 0x0a, 0x11, 0x54, 0x61, 0x72,
+	0x69, 0x66, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x0e, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52,
+	0x45, 0x5f, 0x54, 0x41, 0x52, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f,
+	0x54, 0x41, 0x52, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x54, 0x45, 0x52, 0x4d,
+	0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x03, 0x22, 0xc4, 0x04, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
 	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x17, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x15, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x18, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xce, 0x04, 0x0a, 0x17, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x6d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x70, 0x67, 0x77, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x70, 0x67, 0x77, 0x49, 0x70, 0x76, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x65,
-	0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x6d, 0x73, 0x69, 0x5f, 0x70,
-	0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d,
-	0x73, 0x69, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0c, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
-	0x0d, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x10,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x67, 0x77, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x67, 0x77, 0x49, 0x70, 0x76, 0x34, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d,
+	0x65, 0x69, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69,
+	0x6d, 0x73, 0x69, 0x5f, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6d, 0x73, 0x69, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x77,
+	0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f,
+	0x63, 0x74, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x07, 0x74, 0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x72, 0x61, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x61, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x12, 0x72, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x93,
+	0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x0a,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
 	0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x74, 0x67,
-	0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x78, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x78, 0x22, 0x9f, 0x02, 0x0a, 0x15, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
+	0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3c,
+	0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
+	0x65, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e,
+	0x55, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x10, 0x02, 0x22, 0x8e, 0x05, 0x0a,
+	0x1c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x65, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x65, 0x49, 0x70, 0x76, 0x34, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x68, 0x61,
+	0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x61,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x41, 0x54, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x07, 0x72, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70,
+	0x70, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x07, 0x74, 0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x3c, 0x0a, 0x0d,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x18, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x75, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x63, 0x0a, 0x1b, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x19, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x8f, 0x01,
+	0x0a, 0x18, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66,
+	0x6c, 0x6f, 0x77, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xd6, 0x04, 0x0a, 0x1d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72,
+	0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x54, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x53, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x56, 0x0a,
+	0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x15,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63, 0x74,
+	0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x07, 0x74, 0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x22, 0x83, 0x03, 0x0a, 0x15, 0x51, 0x6f, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x70, 0x6e, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x64,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72,
+	0x44, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x70, 0x6e, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x75,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72,
+	0x55, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x15, 0x70, 0x72, 0x65,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x39,
+	0x0a, 0x18, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x17, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x71, 0x6f, 0x73,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x71, 0x6f, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x07, 0x62,
+	0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42,
+	0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x41, 0x4d, 0x42, 0x52, 0x52,
+	0x06, 0x62, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x22, 0x25, 0x0a, 0x10, 0x42, 0x69, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x41, 0x4d, 0x42, 0x52, 0x12, 0x07, 0x0a, 0x03, 0x42,
+	0x50, 0x53, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x42, 0x50, 0x53, 0x10, 0x01, 0x22, 0xc7,
+	0x03, 0x0a, 0x18, 0x4d, 0x35, 0x47, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x61,
+	0x70, 0x6e, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x64, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72, 0x44, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x61,
+	0x70, 0x6e, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72, 0x55, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x4a, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x65, 0x6d, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x52, 0x14, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x50,
+	0x0a, 0x18, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x65,
+	0x6d, 0x5f, 0x76, 0x75, 0x6e, 0x65, 0x72, 0x52, 0x17, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x30, 0x0a, 0x0c, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x51, 0x43, 0x49, 0x52, 0x0a, 0x71, 0x6f, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x49, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x62, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x4d, 0x35, 0x47, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x41, 0x4d, 0x42, 0x52, 0x52, 0x06, 0x62, 0x72, 0x55, 0x6e, 0x69,
+	0x74, 0x22, 0x25, 0x0a, 0x10, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x41, 0x4d, 0x42, 0x52, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x50, 0x53, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x4b, 0x42, 0x50, 0x53, 0x10, 0x01, 0x22, 0x51, 0x0a, 0x0b, 0x54, 0x67, 0x70, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x78, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
+	0x78, 0x44, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x79, 0x5f,
+	0x64, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x67, 0x79, 0x44, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x22, 0xd0, 0x02, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
 	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a,
-	0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x17, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
-	0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x06, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0a,
-	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50,
-	0x76, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x76, 0x36, 0x10, 0x01, 0x12, 0x08,
-	0x0a, 0x04, 0x46, 0x51, 0x44, 0x4e, 0x10, 0x02, 0x22, 0x78, 0x0a, 0x0f, 0x4d, 0x35, 0x47, 0x53,
-	0x4d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72,
-	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x71, 0x6f, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x51,
-	0x6f, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x68, 0x6f, 0x6d, 0x65,
-	0x64, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x48,
-	0x6f, 0x6d, 0x65, 0x64, 0x49, 0x70, 0x76, 0x36, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0xc1, 0x02, 0x0a, 0x08, 0x51, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
-	0x2e, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x71, 0x6f,
-	0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x10, 0x0a, 0x03, 0x64, 0x71, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x71,
-	0x72, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x16, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65,
-	0x5f, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x11, 0x71, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x63, 0x65, 0x64,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f, 0x66, 0x6c,
-	0x6f, 0x77, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x11, 0x71, 0x6f, 0x73, 0x46, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xbb, 0x02, 0x0a, 0x09, 0x51, 0x6f, 0x73, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x03, 0x71,
-	0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52,
-	0x03, 0x71, 0x6f, 0x73, 0x22, 0x33, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0a, 0x0a,
-	0x06, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x22, 0x29, 0x0a, 0x0c, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4d,
-	0x4f, 0x44, 0x10, 0x02, 0x22, 0xb8, 0x01, 0x0a, 0x1d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x5f, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6f,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x65, 0x6d, 0x5f, 0x63,
-	0x61, 0x70, 0x61, 0x62, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x45, 0x6d, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x61, 0x70, 0x12, 0x3b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x76, 0x75, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x65, 0x6d, 0x5f, 0x76, 0x75, 0x6e, 0x65,
-	0x72, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x45, 0x6d, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x75, 0x6c, 0x22,
-	0x2d, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x76, 0x65, 0x51, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x69, 0x76, 0x65, 0x51, 0x69, 0x22, 0xa4,
-	0x01, 0x0a, 0x11, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6c, 0x74, 0x65, 0x2e, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x08, 0x71, 0x6f, 0x73, 0x43, 0x68, 0x61, 0x72,
-	0x73, 0x12, 0x52, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e,
-	0x5f, 0x70, 0x72, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x70, 0x72, 0x69, 0x6f, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x52, 0x65, 0x74, 0x65,
-	0x6e, 0x50, 0x72, 0x69, 0x6f, 0x22, 0x64, 0x0a, 0x08, 0x71, 0x6f, 0x73, 0x5f, 0x66, 0x6c, 0x6f,
-	0x77, 0x12, 0x24, 0x0a, 0x0e, 0x71, 0x6f, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x71, 0x6f, 0x73, 0x46, 0x6c,
-	0x6f, 0x77, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x40, 0x0a, 0x15, 0x71,
-	0x6f, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x71,
-	0x6f, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x78, 0x0a,
-	0x0f, 0x4c, 0x61, 0x64, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
-	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6e, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6e, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a,
-	0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6f, 0x66, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x66, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x12, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x4f, 0x66, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x4d,
-	0x43, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x43, 0x43, 0x12, 0x10, 0x0a,
-	0x03, 0x4d, 0x4e, 0x43, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x4e, 0x43, 0x12,
-	0x10, 0x0a, 0x03, 0x54, 0x41, 0x43, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x41,
-	0x43, 0x22, 0xf1, 0x0b, 0x0a, 0x13, 0x4d, 0x35, 0x47, 0x53, 0x4d, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75,
-	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0c, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x39, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x64,
-	0x75, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x64, 0x75, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x70, 0x73, 0x69, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x70, 0x73, 0x69, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x63, 0x66, 0x5f,
-	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x63, 0x66, 0x49, 0x64, 0x12,
-	0x46, 0x0a, 0x11, 0x6c, 0x61, 0x64, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x61, 0x72, 0x65, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x61, 0x64, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x0f, 0x6c, 0x61, 0x64, 0x6e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x64, 0x75, 0x5f, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x1f, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x75, 0x62, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x6e, 0x6e, 0x5f, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x44, 0x6e,
-	0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x10,
-	0x64, 0x6e, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a,
-	0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x69, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
-	0x78, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x70, 0x64, 0x75,
-	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e,
-	0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d,
-	0x0a, 0x08, 0x73, 0x73, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4f, 0x0a,
+	0x14, 0x72, 0x61, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x12, 0x72, 0x61, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb5,
+	0x04, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x0c, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70,
+	0x5f, 0x63, 0x74, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x74, 0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x3e, 0x0a, 0x0e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0d, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x72,
+	0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x10, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x10, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52,
+	0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
+	0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x9e, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x73, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x17, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x15, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x18,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xce, 0x04, 0x0a, 0x17, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0d, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x70, 0x67, 0x77, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x70, 0x67, 0x77, 0x49, 0x70, 0x76, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x6d, 0x65, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x6d, 0x73, 0x69,
+	0x5f, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6d, 0x73, 0x69, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x67, 0x70, 0x70, 0x5f, 0x63, 0x74, 0x78,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x54, 0x67, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07,
+	0x74, 0x67, 0x70, 0x70, 0x43, 0x74, 0x78, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x39, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x17, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x06, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x2a,
+	0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x49, 0x50, 0x76, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x76, 0x36, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x51, 0x44, 0x4e, 0x10, 0x02, 0x22, 0x78, 0x0a, 0x0f, 0x4d, 0x35,
+	0x47, 0x53, 0x4d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x71, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x51, 0x6f, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x68, 0x6f,
+	0x6d, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x48, 0x6f, 0x6d, 0x65, 0x64, 0x49, 0x70, 0x76, 0x36, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x02, 0x0a, 0x08, 0x51, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x71, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x71, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x64, 0x71, 0x72, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x18,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x16,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x71, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f,
+	0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x71, 0x6f, 0x73, 0x46, 0x6c, 0x6f, 0x77, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xbb, 0x02, 0x0a, 0x09, 0x51, 0x6f, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x03, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x22, 0x33, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x22, 0x29, 0x0a, 0x0c, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x44, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x4d, 0x4f, 0x44, 0x10, 0x02, 0x22, 0xb8, 0x01, 0x0a, 0x1d, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6f,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x6f, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x5f, 0x65,
+	0x6d, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x65, 0x6d,
+	0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x45, 0x6d, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x70, 0x12, 0x3b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x76, 0x75, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x65, 0x6d, 0x5f, 0x76, 0x75,
+	0x6e, 0x65, 0x72, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x45, 0x6d, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x75,
+	0x6c, 0x22, 0x2d, 0x0a, 0x13, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x76, 0x65,
+	0x51, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x69, 0x76, 0x65, 0x51, 0x69,
+	0x22, 0xa4, 0x01, 0x0a, 0x11, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x68,
+	0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x71, 0x6f, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x08, 0x71, 0x6f, 0x73, 0x43, 0x68,
+	0x61, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x6f, 0x22, 0x64, 0x0a, 0x08, 0x71, 0x6f, 0x73, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x0e, 0x71, 0x6f, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x71, 0x6f, 0x73,
+	0x46, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x40, 0x0a,
+	0x15, 0x71, 0x6f, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
+	0x2e, 0x71, 0x6f, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x22,
+	0x78, 0x0a, 0x0f, 0x4c, 0x61, 0x64, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6e, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6e, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x48, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6f,
+	0x66, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x66, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4f, 0x66, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x4d, 0x43, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x43, 0x43, 0x12,
+	0x10, 0x0a, 0x03, 0x4d, 0x4e, 0x43, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x4e,
+	0x43, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x41, 0x43, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x54, 0x41, 0x43, 0x22, 0xf1, 0x0b, 0x0a, 0x13, 0x4d, 0x35, 0x47, 0x53, 0x4d, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70,
+	0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0b,
+	0x70, 0x64, 0x75, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x64,
+	0x75, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x65, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x70, 0x73, 0x69,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x70, 0x73, 0x69, 0x12, 0x2c, 0x0a, 0x12,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x63,
+	0x66, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x63, 0x66, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x64, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x61, 0x64, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x0f, 0x6c, 0x61, 0x64, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x64, 0x75,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x62,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1f, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x6e, 0x6e, 0x5f, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x44, 0x6e, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x10, 0x64, 0x6e, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x4a, 0x0a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x69, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x61, 0x78, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x70,
+	0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
+	0x65, 0x2e, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0e, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2d, 0x0a, 0x08, 0x73, 0x73, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53,
+	0x73, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x73, 0x73, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x46, 0x0a, 0x11, 0x6d, 0x35, 0x67, 0x5f, 0x73, 0x6d, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x53, 0x4d, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x6d, 0x35, 0x67, 0x53, 0x6d, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x6b, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x50, 0x6b,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x38, 0x0a, 0x19, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x5f, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x41, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x4f, 0x6e, 0x12, 0x21, 0x0a, 0x0d, 0x73, 0x6d,
+	0x5f, 0x70, 0x64, 0x75, 0x5f, 0x64, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6d, 0x50, 0x64, 0x75, 0x44, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a,
+	0x0b, 0x6d, 0x35, 0x67, 0x73, 0x6d, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d,
+	0x35, 0x47, 0x53, 0x4d, 0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x0a, 0x6d, 0x35, 0x67, 0x73, 0x6d,
+	0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51,
+	0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x51, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x67, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54,
+	0x65, 0x69, 0x64, 0x53, 0x65, 0x74, 0x52, 0x0d, 0x67, 0x6e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x75, 0x70, 0x66, 0x5f, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x65, 0x69, 0x64, 0x53, 0x65, 0x74, 0x52,
+	0x0b, 0x75, 0x70, 0x66, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x5f, 0x71, 0x6f, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x51, 0x6f,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x51,
+	0x6f, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x71, 0x6f, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x71, 0x6f,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53,
+	0x4d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x72, 0x61, 0x74, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
+	0x65, 0x2e, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x12, 0x72, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x6d, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x77, 0x0a, 0x18, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a,
+	0x17, 0x6d, 0x35, 0x67, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x53, 0x4d,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x14, 0x6d, 0x35, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x73, 0x70, 0x22, 0xcb, 0x06, 0x0a, 0x19, 0x4d,
+	0x35, 0x47, 0x53, 0x4d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x43,
+	0x0a, 0x10, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0e, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x73, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x73, 0x63, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x73, 0x63, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x51, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x5f, 0x71, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d,
+	0x35, 0x47, 0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x64, 0x51, 0x6f, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x4d, 0x35, 0x67, 0x73, 0x6d, 0x5f,
+	0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x53, 0x4d, 0x43, 0x61, 0x75,
+	0x73, 0x65, 0x52, 0x0a, 0x4d, 0x35, 0x67, 0x73, 0x6d, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x20, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x64, 0x75, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73,
+	0x4f, 0x6e, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x73, 0x73, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x73, 0x63,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x73, 0x73, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a,
-	0x11, 0x6d, 0x35, 0x67, 0x5f, 0x73, 0x6d, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x53, 0x4d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x6d, 0x35, 0x67, 0x53, 0x6d, 0x43, 0x61, 0x70, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6b, 0x74,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x50, 0x6b, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x38,
-	0x0a, 0x19, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x71, 0x5f, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x15, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x41, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x4f, 0x6e, 0x12, 0x21, 0x0a, 0x0d, 0x73, 0x6d, 0x5f, 0x70,
-	0x64, 0x75, 0x5f, 0x64, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x6d, 0x50, 0x64, 0x75, 0x44, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x0b, 0x6d,
-	0x35, 0x67, 0x73, 0x6d, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47,
-	0x53, 0x4d, 0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x0a, 0x6d, 0x35, 0x67, 0x73, 0x6d, 0x43, 0x61,
-	0x75, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x5f, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x51, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x67, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x65, 0x69,
-	0x64, 0x53, 0x65, 0x74, 0x52, 0x0d, 0x67, 0x6e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x75, 0x70, 0x66, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x65, 0x69, 0x64, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x75,
-	0x70, 0x66, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x64, 0x5f, 0x71, 0x6f, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x51, 0x6f, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x51, 0x6f, 0x73,
-	0x12, 0x33, 0x0a, 0x0a, 0x71, 0x6f, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x71, 0x6f, 0x73, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x4d, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x46, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x72, 0x61, 0x74, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x52, 0x12, 0x72, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0x77, 0x0a, 0x18, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a, 0x17, 0x6d,
-	0x35, 0x67, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x5f, 0x72, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x53, 0x4d, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x14, 0x6d, 0x35, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x73, 0x70, 0x22, 0xcb, 0x06, 0x0a, 0x19, 0x4d, 0x35, 0x47,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x53, 0x73, 0x63,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x50, 0x0a, 0x25, 0x4d, 0x35, 0x47, 0x5f, 0x73, 0x6d, 0x5f, 0x63,
+	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x21, 0x4d, 0x35, 0x47, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x64, 0x75, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x64, 0x75, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x75, 0x70, 0x66, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x54, 0x65, 0x69, 0x64, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x75, 0x70,
+	0x66, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x71, 0x6f, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x71,
+	0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xba, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74,
 	0x53, 0x4d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
-	0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x10,
-	0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0e, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3e, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x73,
-	0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x73, 0x63, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x73, 0x63, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x45, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f,
-	0x71, 0x6f, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x51, 0x6f, 0x73, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
-	0x51, 0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x5f, 0x71, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47,
-	0x51, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x64, 0x51, 0x6f, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x4d, 0x35, 0x67, 0x73, 0x6d, 0x5f, 0x63, 0x61,
-	0x75, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4d, 0x35, 0x47, 0x53, 0x4d, 0x43, 0x61, 0x75, 0x73, 0x65,
-	0x52, 0x0a, 0x4d, 0x35, 0x67, 0x73, 0x6d, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x20,
-	0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x4f, 0x6e,
-	0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
-	0x73, 0x73, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x73, 0x63, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x53, 0x73, 0x63, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x50, 0x0a, 0x25, 0x4d, 0x35, 0x47, 0x5f, 0x73, 0x6d, 0x5f, 0x63, 0x6f, 0x6e,
-	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x21, 0x4d, 0x35, 0x47, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x6e, 0x64, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x64, 0x75, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x64, 0x75, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x35, 0x0a, 0x0c, 0x75, 0x70, 0x66, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x54, 0x65, 0x69, 0x64, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x75, 0x70, 0x66, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x64, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x64, 0x75, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x33, 0x0a, 0x0a, 0x71, 0x6f, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
-	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x51, 0x6f, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x71, 0x6f, 0x73,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xba, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x53, 0x4d,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x55, 0x0a, 0x14,
-	0x72, 0x61, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x12, 0x72, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x22, 0xc4, 0x01, 0x0a, 0x0c, 0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x70, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x70, 0x66, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x10, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x55, 0x50, 0x46, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x75, 0x70, 0x66, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xe2, 0x02, 0x0a, 0x19, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x49, 0x50, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x34,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x69, 0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69,
-	0x70, 0x76, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32,
-	0x0a, 0x15, 0x74, 0x65, 0x69, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x74,
-	0x65, 0x69, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x69, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x65, 0x69, 0x64, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x14, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63,
-	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x40, 0x0a,
-	0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x43,
-	0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x43, 0x50, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22,
-	0x97, 0x04, 0x0a, 0x13, 0x55, 0x50, 0x46, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0b,
-	0x61, 0x73, 0x73, 0x6f, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x2f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50,
-	0x46, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a,
-	0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0b, 0x66, 0x65,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50, 0x46, 0x46,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x0a, 0x66, 0x65, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x49, 0x50, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x10, 0x69, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x52, 0x0a, 0x17, 0x67, 0x72, 0x61,
-	0x63, 0x65, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c,
-	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x5a, 0x0a,
-	0x10, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d,
-	0x0a, 0x09, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a,
-	0x0b, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
-	0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x04, 0x22, 0xf5, 0x05, 0x0a, 0x0d, 0x55, 0x50,
-	0x46, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x17, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x70, 0x12, 0x47, 0x0a, 0x20, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x1d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61,
-	0x79, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x13, 0x64, 0x6c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x5f, 0x73, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x15, 0x0a, 0x06, 0x66, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x66, 0x54, 0x65, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x66, 0x64, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x70, 0x66, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38,
-	0x0a, 0x18, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x16, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x46, 0x0a, 0x1f, 0x74, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x1d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x2f, 0x0a, 0x14, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
-	0x65, 0x6e, 0x64, 0x4f, 0x66, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x64, 0x69, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x70, 0x64, 0x69, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x35, 0x0a, 0x17, 0x75, 0x6c, 0x5f, 0x64, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x14, 0x75, 0x6c, 0x44, 0x6c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3b, 0x0a, 0x1a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x5f, 0x61,
-	0x70, 0x70, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x71, 0x75, 0x6f, 0x74,
-	0x61, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x61,
-	0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21,
-	0x0a, 0x0c, 0x70, 0x66, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x66, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0xde, 0x01, 0x0a, 0x0d, 0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x11, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x59, 0x0a, 0x15, 0x6f, 0x76, 0x65, 0x72, 0x6c,
-	0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x6f,
-	0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x22, 0x70, 0x0a, 0x16, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x17,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x73, 0x65, 0x71,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6c,
-	0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x22, 0x4f, 0x0a, 0x0d, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x75,
-	0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x1a, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x63, 0x74, 0x72, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x74, 0x72, 0x6c, 0x53,
-	0x65, 0x71, 0x6e, 0x6f, 0x12, 0x3a, 0x0a, 0x19, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61,
-	0x64, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x46, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6f, 0x66, 0x5f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x10, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x66,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x81,
-	0x01, 0x0a, 0x0f, 0x55, 0x50, 0x46, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x66, 0x5f, 0x74, 0x65, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x46, 0x54, 0x65,
-	0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x55, 0x50, 0x46, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x75,
-	0x70, 0x66, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x55, 0x50, 0x46, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x0f, 0x75, 0x70, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x55, 0x50, 0x46, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x66, 0x5f,
-	0x74, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x46, 0x54, 0x65, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x75, 0x65, 0x5f, 0x69, 0x70, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x65, 0x49, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x2a, 0x33, 0x0a, 0x07, 0x52, 0x41, 0x54, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0c, 0x0a, 0x08, 0x54, 0x47, 0x50, 0x50, 0x5f, 0x4c, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x54, 0x47, 0x50, 0x50, 0x5f, 0x57, 0x4c, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
-	0x54, 0x47, 0x50, 0x50, 0x5f, 0x4e, 0x52, 0x10, 0x02, 0x2a, 0xc8, 0x01, 0x0a, 0x0e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x55, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x1b,
-	0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x55, 0x45, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
-	0x54, 0x49, 0x46, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x45, 0x5f, 0x50, 0x41, 0x47,
-	0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22,
-	0x55, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x49, 0x43, 0x5f, 0x52, 0x45, 0x47, 0x5f,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49,
-	0x46, 0x59, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59,
-	0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x47, 0x49,
-	0x4e, 0x47, 0x10, 0x05, 0x2a, 0xb3, 0x09, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x47, 0x53, 0x4e, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x4f, 0x53, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x54, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x46, 0x54, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4c, 0x4d, 0x4e, 0x5f, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x53, 0x53, 0x5f,
-	0x4f, 0x46, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x52,
-	0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45,
-	0x52, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x50, 0x5f, 0x43, 0x41, 0x4e, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x10, 0x07, 0x12, 0x26, 0x0a, 0x22, 0x51, 0x4f, 0x53, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x41,
-	0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0e,
-	0x0a, 0x0a, 0x52, 0x41, 0x49, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x0c, 0x12, 0x18,
-	0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x53, 0x10, 0x0e, 0x12,
-	0x11, 0x0a, 0x0d, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54,
-	0x10, 0x0f, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x10, 0x12, 0x18,
-	0x0a, 0x14, 0x52, 0x45, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x11, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x45, 0x5f, 0x49,
-	0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x45, 0x10, 0x12, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x45, 0x5f, 0x49, 0x50, 0x5f, 0x41, 0x44,
-	0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x13, 0x12,
-	0x21, 0x0a, 0x1d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x50, 0x53, 0x5f, 0x42,
-	0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x51, 0x4f, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x10, 0x14, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4e, 0x5f, 0x47, 0x57, 0x5f, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x10, 0x15, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46,
-	0x55, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
-	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x16, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x17, 0x12, 0x15, 0x0a, 0x11, 0x50,
-	0x47, 0x57, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x10, 0x18, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x5a, 0x4f,
-	0x4e, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x19, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
-	0x41, 0x49, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x1a, 0x12, 0x0f, 0x0a, 0x0b, 0x45,
-	0x43, 0x47, 0x49, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x1b, 0x12, 0x21, 0x0a, 0x1d,
-	0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x1c, 0x12,
-	0x21, 0x0a, 0x1d, 0x41, 0x50, 0x4e, 0x5f, 0x41, 0x4d, 0x42, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x49,
-	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
-	0x10, 0x1d, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x53, 0x47, 0x5f, 0x49,
-	0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x10, 0x1e, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x50,
-	0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x2f, 0x0a, 0x2b, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x5f, 0x45, 0x50, 0x53, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x51, 0x4f, 0x53, 0x5f,
-	0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x55, 0x52, 0x45, 0x10, 0x22, 0x12, 0x31, 0x0a, 0x2d, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43,
-	0x53, 0x47, 0x5f, 0x48, 0x59, 0x42, 0x52, 0x49, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52,
-	0x49, 0x42, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x23, 0x12, 0x33, 0x0a, 0x2f, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x43, 0x53, 0x47, 0x5f, 0x48, 0x59, 0x42, 0x52, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x53,
-	0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x24, 0x12, 0x17,
-	0x0a, 0x13, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x25, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x50, 0x50, 0x4c, 0x49,
-	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x27, 0x12, 0x14,
-	0x0a, 0x10, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
-	0x4f, 0x50, 0x10, 0x28, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x53,
-	0x5f, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x2a, 0x12, 0x1e, 0x0a, 0x1a, 0x55,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45,
-	0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2b, 0x12, 0x20, 0x0a, 0x1c, 0x48,
-	0x45, 0x4e, 0x42, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44,
-	0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2c, 0x12, 0x1e, 0x0a,
-	0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f,
-	0x49, 0x4e, 0x46, 0x4f, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x2d, 0x12, 0x25, 0x0a,
-	0x21, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
-	0x52, 0x45, 0x10, 0x2e, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f,
-	0x51, 0x4f, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2f, 0x12, 0x3b, 0x0a, 0x37,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x55, 0x45, 0x5f, 0x50, 0x52, 0x45,
-	0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43,
-	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x52, 0x45, 0x41,
-	0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x30, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
-	0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x31, 0x2a, 0xbb, 0x01, 0x0a, 0x03, 0x51,
-	0x43, 0x49, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x30, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x51, 0x43, 0x49, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f,
-	0x32, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x33, 0x10, 0x03, 0x12, 0x09,
-	0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x34, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49,
-	0x5f, 0x35, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x36, 0x10, 0x06, 0x12,
-	0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x37, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43,
-	0x49, 0x5f, 0x38, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x39, 0x10, 0x09,
-	0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x36, 0x35, 0x10, 0x41, 0x12, 0x0a, 0x0a, 0x06,
-	0x51, 0x43, 0x49, 0x5f, 0x36, 0x36, 0x10, 0x42, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f,
-	0x36, 0x37, 0x10, 0x43, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x37, 0x30, 0x10, 0x46,
-	0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x37, 0x35, 0x10, 0x4b, 0x12, 0x0a, 0x0a, 0x06,
-	0x51, 0x43, 0x49, 0x5f, 0x37, 0x39, 0x10, 0x4f, 0x2a, 0x65, 0x0a, 0x0c, 0x52, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x50, 0x44, 0x41,
-	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
-	0x0a, 0x11, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4e, 0x45, 0x45,
-	0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
-	0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x2a,
-	0x4b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x45, 0x10, 0x00, 0x12, 0x16,
-	0x0a, 0x12, 0x49, 0x4e, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4d, 0x45, 0x54,
-	0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x46, 0x49, 0x4e, 0x49,
-	0x54, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x38, 0x0a, 0x0f,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x11, 0x0a, 0x0d, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x43, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4c,
-	0x45, 0x56, 0x45, 0x4c, 0x10, 0x01, 0x2a, 0x44, 0x0a, 0x0e, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x34,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
-	0x49, 0x50, 0x56, 0x34, 0x49, 0x50, 0x56, 0x36, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e,
-	0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x39, 0x0a, 0x07,
-	0x53, 0x73, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x53, 0x43, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x5f, 0x31, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x53, 0x43, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x5f, 0x32, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x53, 0x43, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x5f, 0x33, 0x10, 0x02, 0x2a, 0xbf, 0x0b, 0x0a, 0x0a, 0x4d, 0x35, 0x47, 0x53,
-	0x4d, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x4f, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x42, 0x41,
-	0x52, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x53, 0x55, 0x46,
-	0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x53, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4f,
-	0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x4e, 0x4e, 0x10, 0x02, 0x12,
-	0x1c, 0x0a, 0x18, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x44, 0x55, 0x5f, 0x53,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x2f, 0x0a,
-	0x2b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x20,
-	0x0a, 0x1c, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
-	0x45, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x05,
-	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
-	0x10, 0x06, 0x12, 0x2b, 0x0a, 0x27, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x5f,
-	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x10, 0x07, 0x12,
-	0x2b, 0x0a, 0x27, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x49, 0x4c, 0x59, 0x5f, 0x4f, 0x55,
-	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14,
-	0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
-	0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x0b, 0x12, 0x1a, 0x0a, 0x16, 0x52,
-	0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x5f, 0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49,
-	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x0d, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x45, 0x4d,
-	0x41, 0x4e, 0x54, 0x49, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x5f, 0x49, 0x4e, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x0e, 0x12,
-	0x26, 0x0a, 0x22, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x46,
-	0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x0f, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x55, 0x54, 0x5f, 0x4f,
-	0x46, 0x5f, 0x4c, 0x41, 0x44, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x41,
-	0x52, 0x45, 0x41, 0x10, 0x10, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x54, 0x49, 0x5f, 0x4d, 0x49, 0x53,
-	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x11, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x44, 0x55, 0x5f, 0x53,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x34,
-	0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x12, 0x12,
-	0x26, 0x0a, 0x22, 0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x41, 0x4c,
-	0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x13, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x44, 0x55, 0x5f, 0x53,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x14, 0x12, 0x35, 0x0a, 0x31, 0x49, 0x4e, 0x53, 0x55, 0x46,
-	0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x5f, 0x53,
-	0x4c, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x4e, 0x4e, 0x10, 0x15, 0x12, 0x1a,
-	0x0a, 0x16, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f,
-	0x53, 0x53, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x16, 0x12, 0x2d, 0x0a, 0x29, 0x49, 0x4e,
-	0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x43, 0x5f, 0x53, 0x4c, 0x49, 0x43, 0x45, 0x10, 0x17, 0x12, 0x25, 0x0a, 0x21, 0x4d, 0x49, 0x53,
-	0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
-	0x44, 0x4e, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x41, 0x5f, 0x53, 0x4c, 0x49, 0x43, 0x45, 0x10, 0x18,
-	0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x54, 0x49, 0x5f,
-	0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x19, 0x12, 0x4b, 0x0a, 0x47, 0x4d, 0x41, 0x58, 0x49, 0x4d,
-	0x55, 0x4d, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x52,
-	0x5f, 0x55, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x4c, 0x41,
-	0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x4f,
-	0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c,
-	0x4f, 0x57, 0x10, 0x1a, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x51, 0x4f,
-	0x53, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1b, 0x12, 0x2a, 0x0a,
-	0x26, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x51, 0x4f, 0x53, 0x5f, 0x4f, 0x50,
-	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1c, 0x12, 0x26, 0x0a, 0x22, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x45, 0x50, 0x53, 0x5f,
-	0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10,
-	0x1d, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x4c,
-	0x59, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53,
-	0x41, 0x47, 0x45, 0x10, 0x1e, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x5f, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52,
-	0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1f, 0x12, 0x30, 0x0a, 0x2c, 0x4d, 0x45, 0x53, 0x53,
-	0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x49,
-	0x53, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50,
-	0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x20, 0x12, 0x37, 0x0a, 0x33, 0x4d, 0x45,
-	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x54,
-	0x48, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x10, 0x21, 0x12, 0x37, 0x0a, 0x33, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x45,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x55,
+	0x0a, 0x14, 0x72, 0x61, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x12, 0x72, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc4, 0x01, 0x0a, 0x0c, 0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x70, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x70, 0x66, 0x49, 0x64, 0x12, 0x4b, 0x0a,
+	0x10, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50, 0x46, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50, 0x46, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x75, 0x70, 0x66, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xe2, 0x02, 0x0a,
+	0x19, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x49, 0x50, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70,
+	0x76, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x74, 0x65, 0x69, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x74, 0x65, 0x69, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x69, 0x64, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x65, 0x69, 0x64, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x5f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73,
+	0x6f, 0x63, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22,
+	0x40, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x41, 0x4e, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x50, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x03, 0x22, 0x97, 0x04, 0x0a, 0x13, 0x55, 0x50, 0x46, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x50,
+	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x55, 0x50, 0x46, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x4a, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0b,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50,
+	0x46, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x0a, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x49, 0x50, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x10, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x52, 0x0a, 0x17, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66,
+	0x75, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x5a, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b,
+	0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x04, 0x22, 0xf5, 0x05, 0x0a, 0x0d,
+	0x55, 0x50, 0x46, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x3b, 0x0a,
+	0x1a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x17, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x70, 0x12, 0x47, 0x0a, 0x20, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x61,
+	0x74, 0x61, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x64, 0x6c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x5f, 0x73, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x54, 0x65, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x66, 0x64,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x70, 0x66, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x38, 0x0a, 0x18, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x16, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x46, 0x0a, 0x1f, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x1d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x64, 0x69, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x69, 0x6e,
+	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x70, 0x64, 0x69, 0x4f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x35, 0x0a, 0x17, 0x75, 0x6c, 0x5f, 0x64, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x75, 0x6c, 0x44, 0x6c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3b, 0x0a, 0x1a, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
+	0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x66, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x66, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x0d, 0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x11, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
+	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x59, 0x0a, 0x15, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x13, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x70, 0x0a, 0x16, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35,
+	0x0a, 0x17, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x73,
+	0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x14, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x71, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x4f, 0x0a, 0x0d, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x1a, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x63, 0x74, 0x72, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x6e, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x74, 0x72,
+	0x6c, 0x53, 0x65, 0x71, 0x6e, 0x6f, 0x12, 0x3a, 0x0a, 0x19, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x12, 0x46, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6f, 0x66, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x10, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x4f, 0x66, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x22, 0x81, 0x01, 0x0a, 0x0f, 0x55, 0x50, 0x46, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x66, 0x5f, 0x74, 0x65,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x46,
+	0x54, 0x65, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x55, 0x50, 0x46, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a,
+	0x11, 0x75, 0x70, 0x66, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50, 0x46, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x75, 0x70, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x55, 0x50, 0x46, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x66, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x46, 0x54, 0x65, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x75, 0x65, 0x5f, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x65,
+	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x2a, 0x33, 0x0a, 0x07, 0x52, 0x41, 0x54, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x47, 0x50, 0x50, 0x5f, 0x4c, 0x54, 0x45, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x54, 0x47, 0x50, 0x50, 0x5f, 0x57, 0x4c, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x54, 0x47, 0x50, 0x50, 0x5f, 0x4e, 0x52, 0x10, 0x02, 0x2a, 0xc8, 0x01, 0x0a, 0x0e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x1b, 0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x55, 0x45, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x45, 0x5f, 0x50,
+	0x41, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x02, 0x12, 0x26,
+	0x0a, 0x22, 0x55, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x49, 0x43, 0x5f, 0x52, 0x45,
+	0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x49, 0x46, 0x59, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x44, 0x55, 0x5f, 0x53, 0x45,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x59, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x5f, 0x50, 0x41,
+	0x47, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x2a, 0xb3, 0x09, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x47, 0x53, 0x4e, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x4f, 0x53, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x54, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x46, 0x54, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4c, 0x4d, 0x4e,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x53,
+	0x53, 0x5f, 0x4f, 0x46, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x10, 0x05, 0x12, 0x16, 0x0a,
+	0x12, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x42, 0x45, 0x41,
+	0x52, 0x45, 0x52, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x50, 0x5f, 0x43, 0x41, 0x4e, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x07, 0x12, 0x26, 0x0a, 0x22, 0x51, 0x4f, 0x53, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b,
+	0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x49, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x0c,
+	0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x53, 0x10,
+	0x0e, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x43, 0x52, 0x45, 0x44,
+	0x49, 0x54, 0x10, 0x0f, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x10,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x11, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x45,
+	0x5f, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
+	0x43, 0x41, 0x54, 0x45, 0x10, 0x12, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x45, 0x5f, 0x49, 0x50, 0x5f,
+	0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10,
+	0x13, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x50, 0x53,
+	0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x51, 0x4f, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x14, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4e, 0x5f, 0x47, 0x57, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x10, 0x15, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x46, 0x55, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x4c,
+	0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x16, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x17, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x47, 0x57, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x10, 0x18, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x5a, 0x4f, 0x4e, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x19, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x41, 0x49, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x1a, 0x12, 0x0f, 0x0a,
+	0x0b, 0x45, 0x43, 0x47, 0x49, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x1b, 0x12, 0x21,
+	0x0a, 0x1d, 0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10,
+	0x1c, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x50, 0x4e, 0x5f, 0x41, 0x4d, 0x42, 0x52, 0x5f, 0x4d, 0x4f,
+	0x44, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x1d, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x53, 0x47,
+	0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x10, 0x1e, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x2f, 0x0a, 0x2b, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x5f, 0x45, 0x50, 0x53, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x51, 0x4f,
+	0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x22, 0x12, 0x31, 0x0a, 0x2d, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x43, 0x53, 0x47, 0x5f, 0x48, 0x59, 0x42, 0x52, 0x49, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x53,
+	0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x23, 0x12, 0x33, 0x0a, 0x2f, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x43, 0x53, 0x47, 0x5f, 0x48, 0x59, 0x42, 0x52, 0x49, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x24,
+	0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x55, 0x4c, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x25, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x50, 0x50,
+	0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x27,
+	0x12, 0x14, 0x0a, 0x10, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x4f, 0x50, 0x10, 0x28, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x53, 0x5f, 0x54, 0x4f, 0x5f,
+	0x50, 0x53, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x2a, 0x12, 0x1e, 0x0a,
+	0x1a, 0x55, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2b, 0x12, 0x20, 0x0a,
+	0x1c, 0x48, 0x45, 0x4e, 0x42, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x49, 0x50, 0x5f, 0x41,
+	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2c, 0x12,
+	0x1e, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
+	0x4b, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x2d, 0x12,
+	0x25, 0x0a, 0x21, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x55, 0x52, 0x45, 0x10, 0x2e, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x51, 0x4f, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2f, 0x12, 0x3b,
+	0x0a, 0x37, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x55, 0x45, 0x5f, 0x50,
+	0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45,
+	0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x52,
+	0x45, 0x41, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x30, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
+	0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x31, 0x2a, 0xbb, 0x01, 0x0a,
+	0x03, 0x51, 0x43, 0x49, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x30, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43,
+	0x49, 0x5f, 0x32, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x33, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x34, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x51,
+	0x43, 0x49, 0x5f, 0x35, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x36, 0x10,
+	0x06, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x37, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05,
+	0x51, 0x43, 0x49, 0x5f, 0x38, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x49, 0x5f, 0x39,
+	0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x36, 0x35, 0x10, 0x41, 0x12, 0x0a,
+	0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x36, 0x36, 0x10, 0x42, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43,
+	0x49, 0x5f, 0x36, 0x37, 0x10, 0x43, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x37, 0x30,
+	0x10, 0x46, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x37, 0x35, 0x10, 0x4b, 0x12, 0x0a,
+	0x0a, 0x06, 0x51, 0x43, 0x49, 0x5f, 0x37, 0x39, 0x10, 0x4f, 0x2a, 0x65, 0x0a, 0x0c, 0x52, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4e,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x11,
+	0x0a, 0x0d, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
+	0x03, 0x2a, 0x4b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x45, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4d,
+	0x45, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x46, 0x49,
+	0x4e, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x38,
+	0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x43, 0x5f, 0x52, 0x55, 0x4c, 0x45,
+	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x10, 0x01, 0x2a, 0x44, 0x0a, 0x0e, 0x50, 0x64, 0x75, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50,
+	0x56, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x49, 0x50, 0x56, 0x34, 0x49, 0x50, 0x56, 0x36, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x55, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x39,
+	0x0a, 0x07, 0x53, 0x73, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x53, 0x43,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x31, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x53, 0x43,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x32, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x53, 0x43,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x33, 0x10, 0x02, 0x2a, 0xbf, 0x0b, 0x0a, 0x0a, 0x4d, 0x35,
+	0x47, 0x53, 0x4d, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x45, 0x44, 0x5f,
+	0x42, 0x41, 0x52, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x53,
+	0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x53, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x5f, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x4e, 0x4e, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x44, 0x55,
+	0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12,
+	0x2f, 0x0a, 0x2b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52,
+	0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x2b, 0x0a, 0x27, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45,
+	0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x10,
+	0x07, 0x12, 0x2b, 0x0a, 0x27, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x49, 0x4c, 0x59, 0x5f,
+	0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x08, 0x12, 0x18,
+	0x0a, 0x14, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x0b, 0x12, 0x1a, 0x0a,
+	0x16, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x0d, 0x12, 0x24, 0x0a, 0x20, 0x53,
+	0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x5f, 0x49,
+	0x4e, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10,
+	0x0e, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x49, 0x43, 0x41, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54,
+	0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x0f, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x55, 0x54,
+	0x5f, 0x4f, 0x46, 0x5f, 0x4c, 0x41, 0x44, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x10, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x54, 0x49, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x11, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x44, 0x55,
+	0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50,
+	0x56, 0x34, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10,
+	0x12, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x13, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x44, 0x55,
+	0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x14, 0x12, 0x35, 0x0a, 0x31, 0x49, 0x4e, 0x53,
+	0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43,
+	0x5f, 0x53, 0x4c, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x4e, 0x4e, 0x10, 0x15,
+	0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45,
+	0x44, 0x5f, 0x53, 0x53, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x16, 0x12, 0x2d, 0x0a, 0x29,
+	0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x43, 0x5f, 0x53, 0x4c, 0x49, 0x43, 0x45, 0x10, 0x17, 0x12, 0x25, 0x0a, 0x21, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x44, 0x4e, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x41, 0x5f, 0x53, 0x4c, 0x49, 0x43, 0x45,
+	0x10, 0x18, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x54,
+	0x49, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x19, 0x12, 0x4b, 0x0a, 0x47, 0x4d, 0x41, 0x58,
+	0x49, 0x4d, 0x55, 0x4d, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x45, 0x52, 0x5f, 0x55, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50,
+	0x4c, 0x41, 0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x50,
+	0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x1a, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54,
+	0x49, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f,
+	0x51, 0x4f, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1b, 0x12,
+	0x2a, 0x0a, 0x26, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x51, 0x4f, 0x53, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1c, 0x12, 0x26, 0x0a, 0x22, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x45, 0x50,
+	0x53, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54,
+	0x59, 0x10, 0x1d, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x41,
+	0x4c, 0x4c, 0x59, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x1e, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1f, 0x12, 0x30, 0x0a, 0x2c, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x45,
 	0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49,
-	0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x22, 0x12, 0x18, 0x0a, 0x14,
-	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x45, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x23, 0x12, 0x32, 0x0a, 0x2e, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
-	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x4c, 0x45,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x24, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x25, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x54,
-	0x49, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x55, 0x53, 0x45,
-	0x10, 0x26, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x28, 0x2a, 0x99, 0x01, 0x0a, 0x0b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x49,
-	0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x18,
-	0x0a, 0x14, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x44, 0x55, 0x5f, 0x53,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x49, 0x54,
-	0x49, 0x41, 0x4c, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x58, 0x49, 0x53, 0x54,
-	0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x44,
-	0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x4d,
-	0x4f, 0x44, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x10, 0x04, 0x2a, 0x39, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x5f, 0x33, 0x47, 0x50, 0x50, 0x5f, 0x41, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x5f, 0x33, 0x47, 0x50, 0x50, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4e,
-	0x4f, 0x4e, 0x5f, 0x33, 0x47, 0x50, 0x50, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01,
-	0x2a, 0x52, 0x0a, 0x10, 0x44, 0x6e, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x45, 0x5f, 0x44, 0x4e, 0x4e, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4e,
-	0x57, 0x5f, 0x44, 0x4e, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x5f, 0x63, 0x61, 0x70,
-	0x61, 0x62, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x59, 0x5f, 0x54, 0x52, 0x49,
-	0x47, 0x47, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0x01, 0x2a, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x5f, 0x76, 0x75, 0x6e, 0x65, 0x72,
-	0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x41,
-	0x42, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0xc7, 0x01, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x66,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x42, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x46, 0x5f,
-	0x54, 0x41, 0x43, 0x53, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x54,
-	0x4f, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x50, 0x4c, 0x4d, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x43, 0x55, 0x54, 0x49, 0x56, 0x45, 0x5f,
-	0x54, 0x41, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x00, 0x12, 0x42, 0x0a, 0x3e,
-	0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x41, 0x43, 0x53, 0x5f, 0x42, 0x45, 0x4c,
-	0x4f, 0x4e, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x50, 0x4c,
-	0x4d, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x43, 0x55, 0x54,
-	0x49, 0x56, 0x45, 0x5f, 0x54, 0x41, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x01,
-	0x12, 0x2d, 0x0a, 0x29, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x41, 0x49, 0x53,
-	0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x49,
-	0x46, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x4c, 0x4d, 0x4e, 0x53, 0x10, 0x02, 0x2a,
-	0x2f, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x69, 0x67, 0x68, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
-	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x6f, 0x77, 0x10, 0x02,
-	0x2a, 0x5f, 0x0a, 0x11, 0x53, 0x4d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x53, 0x4d,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4e,
-	0x47, 0x5f, 0x30, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
-	0x31, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x32, 0x10,
-	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x33, 0x10,
-	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x5f, 0x34, 0x10,
-	0x04, 0x32, 0x93, 0x04, 0x0a, 0x13, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x5e, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a,
-	0x0a, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x64,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x32, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x65, 0x61, 0x72,
-	0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x53, 0x65,
-	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
-	0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x21,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xbf, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65,
-	0x72, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
-	0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
+	0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x20, 0x12, 0x37, 0x0a, 0x33,
+	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x54, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x10, 0x21, 0x12, 0x37, 0x0a, 0x33, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x22, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x23, 0x12, 0x32, 0x0a, 0x2e, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42,
+	0x4c, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x24, 0x12, 0x1e, 0x0a, 0x1a,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x25, 0x12, 0x16, 0x0a, 0x12,
+	0x50, 0x54, 0x49, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x55,
+	0x53, 0x45, 0x10, 0x26, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x28, 0x2a, 0x99, 0x01, 0x0a, 0x0b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x49,
+	0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x44, 0x55,
+	0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e,
+	0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x5f,
+	0x50, 0x44, 0x55, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x04, 0x2a, 0x39, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x5f, 0x33, 0x47, 0x50, 0x50, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x33, 0x47, 0x50, 0x50, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x4e, 0x4f, 0x4e, 0x5f, 0x33, 0x47, 0x50, 0x50, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x01, 0x2a, 0x52, 0x0a, 0x10, 0x44, 0x6e, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x45, 0x5f, 0x44, 0x4e, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x4e, 0x57, 0x5f, 0x44, 0x4e, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x5f, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x45, 0x4d,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x59, 0x5f, 0x54,
+	0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x5f, 0x76, 0x75, 0x6e,
+	0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x54, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x45, 0x5f, 0x45, 0x4d, 0x50,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0xc7, 0x01, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65,
+	0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x42, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f,
+	0x46, 0x5f, 0x54, 0x41, 0x43, 0x53, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x4f, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x50, 0x4c, 0x4d, 0x4e, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x43, 0x55, 0x54, 0x49, 0x56,
+	0x45, 0x5f, 0x54, 0x41, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x00, 0x12, 0x42,
+	0x0a, 0x3e, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x41, 0x43, 0x53, 0x5f, 0x42,
+	0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x5f, 0x4f, 0x4e, 0x45, 0x5f,
+	0x50, 0x4c, 0x4d, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x54, 0x41, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53,
+	0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x41,
+	0x49, 0x53, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x5f,
+	0x44, 0x49, 0x46, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x4c, 0x4d, 0x4e, 0x53, 0x10,
+	0x02, 0x2a, 0x2f, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x69, 0x67, 0x68, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x6f, 0x77,
+	0x10, 0x02, 0x2a, 0x5f, 0x0a, 0x11, 0x53, 0x4d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46,
+	0x53, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x30, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f,
+	0x32, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f,
+	0x33, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x5f,
+	0x34, 0x10, 0x04, 0x32, 0x93, 0x04, 0x0a, 0x13, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x5e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45,
+	0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x32, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73,
+	0x12, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xbf, 0x01, 0x0a, 0x15, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
 	0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x32, 0xa5, 0x02, 0x0a, 0x18, 0x43, 0x65,
-	0x6e, 0x74, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x32, 0x83, 0x02, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x46,
-	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50,
-	0x46, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x55, 0x50, 0x46,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50, 0x46, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11,
-	0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50,
-	0x46, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x18, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0xc1, 0x01, 0x0a, 0x16, 0x41, 0x6d, 0x66, 0x50,
-	0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x12, 0x52, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x6d, 0x66, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x4d, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x66,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x32, 0xc7, 0x01, 0x0a, 0x16,
-	0x53, 0x6d, 0x66, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6d, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x55, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x6d, 0x66,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x14, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x4d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x18, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x56, 0x6f, 0x69, 0x64, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x6c,
-	0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x32, 0xa5, 0x02, 0x0a, 0x18,
+	0x43, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x32, 0x83, 0x02, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x65,
+	0x12, 0x46, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x55, 0x50, 0x46, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x18, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x55,
+	0x50, 0x46, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x55, 0x50, 0x46,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x55, 0x50, 0x46, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x18, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0xc1, 0x01, 0x0a, 0x16, 0x41, 0x6d,
+	0x66, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6d, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x52, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x6d, 0x66, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x4d, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x18, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x53,
+	0x6d, 0x66, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6d,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x32, 0xc7, 0x01,
+	0x0a, 0x16, 0x53, 0x6d, 0x66, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x55, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41,
+	0x6d, 0x66, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6d,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x4d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x18, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x6d, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2f, 0x6c, 0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9720,8 +10014,8 @@ func file_lte_protos_session_manager_proto_rawDescGZIP() []byte {
 	return file_lte_protos_session_manager_proto_rawDescData
 }
 
-var file_lte_protos_session_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 32)
-var file_lte_protos_session_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_lte_protos_session_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 33)
+var file_lte_protos_session_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_lte_protos_session_manager_proto_goTypes = []interface{}{
 	(RATType)(0),                                   // 0: magma.lte.RATType
 	(NotifyUeEvents)(0),                            // 1: magma.lte.NotifyUeEvents
@@ -9747,295 +10041,303 @@ var file_lte_protos_session_manager_proto_goTypes = []interface{}{
 	(ChargingCredit_UnitType)(0),                   // 21: magma.lte.ChargingCredit.UnitType
 	(ChargingCredit_FinalAction)(0),                // 22: magma.lte.ChargingCredit.FinalAction
 	(CreditUsage_UpdateType)(0),                    // 23: magma.lte.CreditUsage.UpdateType
-	(UsageMonitoringCredit_Action)(0),              // 24: magma.lte.UsageMonitoringCredit.Action
-	(QosInformationRequest_BitrateUnitsAMBR)(0),    // 25: magma.lte.QosInformationRequest.BitrateUnitsAMBR
-	(M5GQosInformationRequest_BitrateUnitsAMBR)(0), // 26: magma.lte.M5GQosInformationRequest.BitrateUnitsAMBR
-	(NodeID_NodeIDType)(0),                         // 27: magma.lte.NodeID.NodeIDType
-	(QosPolicy_PolicyState)(0),                     // 28: magma.lte.QosPolicy.PolicyState
-	(QosPolicy_PolicyAction)(0),                    // 29: magma.lte.QosPolicy.PolicyAction
-	(UserPlaneIPResourceSchema_InterfaceValue)(0),  // 30: magma.lte.UserPlaneIPResourceSchema.InterfaceValue
-	(UPFAssociationState_AssociationState)(0),      // 31: magma.lte.UPFAssociationState.AssociationState
-	(*RuleRecord)(nil),                             // 32: magma.lte.RuleRecord
-	(*RuleRecordTable)(nil),                        // 33: magma.lte.RuleRecordTable
-	(*LocalCreateSessionRequest)(nil),              // 34: magma.lte.LocalCreateSessionRequest
-	(*Teids)(nil),                                  // 35: magma.lte.Teids
-	(*CommonSessionContext)(nil),                   // 36: magma.lte.CommonSessionContext
-	(*RatSpecificContext)(nil),                     // 37: magma.lte.RatSpecificContext
-	(*TeidSet)(nil),                                // 38: magma.lte.TeidSet
-	(*RatSpecificNotification)(nil),                // 39: magma.lte.RatSpecificNotification
-	(*SetSmNotificationContext)(nil),               // 40: magma.lte.SetSmNotificationContext
-	(*LTESessionContext)(nil),                      // 41: magma.lte.LTESessionContext
-	(*WLANSessionContext)(nil),                     // 42: magma.lte.WLANSessionContext
-	(*LocalCreateSessionResponse)(nil),             // 43: magma.lte.LocalCreateSessionResponse
-	(*LocalEndSessionRequest)(nil),                 // 44: magma.lte.LocalEndSessionRequest
-	(*LocalEndSessionResponse)(nil),                // 45: magma.lte.LocalEndSessionResponse
-	(*UpdateTunnelIdsRequest)(nil),                 // 46: magma.lte.UpdateTunnelIdsRequest
-	(*UpdateTunnelIdsResponse)(nil),                // 47: magma.lte.UpdateTunnelIdsResponse
-	(*PolicyBearerBindingRequest)(nil),             // 48: magma.lte.PolicyBearerBindingRequest
-	(*PolicyBearerBindingResponse)(nil),            // 49: magma.lte.PolicyBearerBindingResponse
-	(*ChargingReAuthRequest)(nil),                  // 50: magma.lte.ChargingReAuthRequest
-	(*ChargingReAuthAnswer)(nil),                   // 51: magma.lte.ChargingReAuthAnswer
-	(*PolicyReAuthRequest)(nil),                    // 52: magma.lte.PolicyReAuthRequest
-	(*QoSInformation)(nil),                         // 53: magma.lte.QoSInformation
-	(*PolicyReAuthAnswer)(nil),                     // 54: magma.lte.PolicyReAuthAnswer
-	(*RuleSet)(nil),                                // 55: magma.lte.RuleSet
-	(*RulesPerSubscriber)(nil),                     // 56: magma.lte.RulesPerSubscriber
-	(*SessionRules)(nil),                           // 57: magma.lte.SessionRules
-	(*Timezone)(nil),                               // 58: magma.lte.Timezone
-	(*CreditUnit)(nil),                             // 59: magma.lte.CreditUnit
-	(*GrantedUnits)(nil),                           // 60: magma.lte.GrantedUnits
-	(*RedirectServer)(nil),                         // 61: magma.lte.RedirectServer
-	(*ChargingCredit)(nil),                         // 62: magma.lte.ChargingCredit
-	(*RequestedUnits)(nil),                         // 63: magma.lte.RequestedUnits
-	(*CreditUsage)(nil),                            // 64: magma.lte.CreditUsage
-	(*CreditUsageUpdate)(nil),                      // 65: magma.lte.CreditUsageUpdate
-	(*CreditUpdateResponse)(nil),                   // 66: magma.lte.CreditUpdateResponse
-	(*UsageMonitorUpdate)(nil),                     // 67: magma.lte.UsageMonitorUpdate
-	(*UsageMonitoringCredit)(nil),                  // 68: magma.lte.UsageMonitoringCredit
-	(*UsageMonitoringUpdateRequest)(nil),           // 69: magma.lte.UsageMonitoringUpdateRequest
-	(*UsageMonitoringUpdateResponse)(nil),          // 70: magma.lte.UsageMonitoringUpdateResponse
-	(*QosInformationRequest)(nil),                  // 71: magma.lte.QosInformationRequest
-	(*M5GQosInformationRequest)(nil),               // 72: magma.lte.M5GQosInformationRequest
-	(*TgppContext)(nil),                            // 73: magma.lte.TgppContext
-	(*CreateSessionRequest)(nil),                   // 74: magma.lte.CreateSessionRequest
-	(*CreateSessionResponse)(nil),                  // 75: magma.lte.CreateSessionResponse
-	(*StaticRuleInstall)(nil),                      // 76: magma.lte.StaticRuleInstall
-	(*DynamicRuleInstall)(nil),                     // 77: magma.lte.DynamicRuleInstall
-	(*UpdateSessionRequest)(nil),                   // 78: magma.lte.UpdateSessionRequest
-	(*UpdateSessionResponse)(nil),                  // 79: magma.lte.UpdateSessionResponse
-	(*SessionTerminateResponse)(nil),               // 80: magma.lte.SessionTerminateResponse
-	(*SessionTerminateRequest)(nil),                // 81: magma.lte.SessionTerminateRequest
-	(*NodeID)(nil),                                 // 82: magma.lte.NodeID
-	(*M5GSMCapability)(nil),                        // 83: magma.lte.M5GSMCapability
-	(*QosRules)(nil),                               // 84: magma.lte.QosRules
-	(*QosPolicy)(nil),                              // 85: magma.lte.QosPolicy
-	(*AllocationAndRetentionPrio)(nil),             // 86: magma.lte.allocation_and_retention_prio
-	(*QosCharacteristics)(nil),                     // 87: magma.lte.qos_characteristics
-	(*FlowLevelParams)(nil),                        // 88: magma.lte.flow_level_params
-	(*QosFlow)(nil),                                // 89: magma.lte.qos_flow
-	(*QosFlowRequestList)(nil),                     // 90: magma.lte.qos_flow_request_list
-	(*LadnServiceArea)(nil),                        // 91: magma.lte.LadnServiceArea
-	(*TrackingAreaIdentityList)(nil),               // 92: magma.lte.TrackingAreaIdentityList
-	(*M5GSMSessionContext)(nil),                    // 93: magma.lte.M5GSMSessionContext
-	(*SetSMSessionContext)(nil),                    // 94: magma.lte.SetSMSessionContext
-	(*SmContextVoid)(nil),                          // 95: magma.lte.SmContextVoid
-	(*RatSpecificContextAccess)(nil),               // 96: magma.lte.RatSpecificContextAccess
-	(*M5GSMSessionContextAccess)(nil),              // 97: magma.lte.M5GSMSessionContextAccess
-	(*SetSMSessionContextAccess)(nil),              // 98: magma.lte.SetSMSessionContextAccess
-	(*UPFNodeState)(nil),                           // 99: magma.lte.UPFNodeState
-	(*UserPlaneIPResourceSchema)(nil),              // 100: magma.lte.UserPlaneIPResourceSchema
-	(*UPFAssociationState)(nil),                    // 101: magma.lte.UPFAssociationState
-	(*UPFFeatureSet)(nil),                          // 102: magma.lte.UPFFeatureSet
-	(*UPFNodeReport)(nil),                          // 103: magma.lte.UPFNodeReport
-	(*LoadControlInformation)(nil),                 // 104: magma.lte.LoadControlInformation
-	(*OverloadTimer)(nil),                          // 105: magma.lte.OverloadTimer
-	(*OverloadControlInformation)(nil),             // 106: magma.lte.OverloadControlInformation
-	(*UPFSessionState)(nil),                        // 107: magma.lte.UPFSessionState
-	(*UPFSessionConfigState)(nil),                  // 108: magma.lte.UPFSessionConfigState
-	(*UPFPagingInfo)(nil),                          // 109: magma.lte.UPFPagingInfo
-	nil,                                            // 110: magma.lte.PolicyReAuthAnswer.FailedRulesEntry
-	(*SubscriberID)(nil),                           // 111: magma.lte.SubscriberID
-	(*ServiceIdentifier)(nil),                      // 112: magma.lte.ServiceIdentifier
-	(*timestamp.Timestamp)(nil),                    // 113: google.protobuf.Timestamp
-	(*PolicyRule)(nil),                             // 114: magma.lte.PolicyRule
-	(*protos.Void)(nil),                            // 115: magma.orc8r.Void
+	(CreditUsage_TariffChangeUsage)(0),             // 24: magma.lte.CreditUsage.TariffChangeUsage
+	(UsageMonitoringCredit_Action)(0),              // 25: magma.lte.UsageMonitoringCredit.Action
+	(QosInformationRequest_BitrateUnitsAMBR)(0),    // 26: magma.lte.QosInformationRequest.BitrateUnitsAMBR
+	(M5GQosInformationRequest_BitrateUnitsAMBR)(0), // 27: magma.lte.M5GQosInformationRequest.BitrateUnitsAMBR
+	(NodeID_NodeIDType)(0),                         // 28: magma.lte.NodeID.NodeIDType
+	(QosPolicy_PolicyState)(0),                     // 29: magma.lte.QosPolicy.PolicyState
+	(QosPolicy_PolicyAction)(0),                    // 30: magma.lte.QosPolicy.PolicyAction
+	(UserPlaneIPResourceSchema_InterfaceValue)(0),  // 31: magma.lte.UserPlaneIPResourceSchema.InterfaceValue
+	(UPFAssociationState_AssociationState)(0),      // 32: magma.lte.UPFAssociationState.AssociationState
+	(*RuleRecord)(nil),                             // 33: magma.lte.RuleRecord
+	(*RuleRecordTable)(nil),                        // 34: magma.lte.RuleRecordTable
+	(*LocalCreateSessionRequest)(nil),              // 35: magma.lte.LocalCreateSessionRequest
+	(*Teids)(nil),                                  // 36: magma.lte.Teids
+	(*CommonSessionContext)(nil),                   // 37: magma.lte.CommonSessionContext
+	(*RatSpecificContext)(nil),                     // 38: magma.lte.RatSpecificContext
+	(*TeidSet)(nil),                                // 39: magma.lte.TeidSet
+	(*RatSpecificNotification)(nil),                // 40: magma.lte.RatSpecificNotification
+	(*SetSmNotificationContext)(nil),               // 41: magma.lte.SetSmNotificationContext
+	(*LTESessionContext)(nil),                      // 42: magma.lte.LTESessionContext
+	(*WLANSessionContext)(nil),                     // 43: magma.lte.WLANSessionContext
+	(*LocalCreateSessionResponse)(nil),             // 44: magma.lte.LocalCreateSessionResponse
+	(*LocalEndSessionRequest)(nil),                 // 45: magma.lte.LocalEndSessionRequest
+	(*LocalEndSessionResponse)(nil),                // 46: magma.lte.LocalEndSessionResponse
+	(*UpdateTunnelIdsRequest)(nil),                 // 47: magma.lte.UpdateTunnelIdsRequest
+	(*UpdateTunnelIdsResponse)(nil),                // 48: magma.lte.UpdateTunnelIdsResponse
+	(*PolicyBearerBindingRequest)(nil),             // 49: magma.lte.PolicyBearerBindingRequest
+	(*PolicyBearerBindingResponse)(nil),            // 50: magma.lte.PolicyBearerBindingResponse
+	(*ChargingReAuthRequest)(nil),                  // 51: magma.lte.ChargingReAuthRequest
+	(*ChargingReAuthAnswer)(nil),                   // 52: magma.lte.ChargingReAuthAnswer
+	(*PolicyReAuthRequest)(nil),                    // 53: magma.lte.PolicyReAuthRequest
+	(*QoSInformation)(nil),                         // 54: magma.lte.QoSInformation
+	(*PolicyReAuthAnswer)(nil),                     // 55: magma.lte.PolicyReAuthAnswer
+	(*RuleSet)(nil),                                // 56: magma.lte.RuleSet
+	(*RulesPerSubscriber)(nil),                     // 57: magma.lte.RulesPerSubscriber
+	(*SessionRules)(nil),                           // 58: magma.lte.SessionRules
+	(*Timezone)(nil),                               // 59: magma.lte.Timezone
+	(*CreditUnit)(nil),                             // 60: magma.lte.CreditUnit
+	(*GrantedUnits)(nil),                           // 61: magma.lte.GrantedUnits
+	(*RedirectServer)(nil),                         // 62: magma.lte.RedirectServer
+	(*ChargingCredit)(nil),                         // 63: magma.lte.ChargingCredit
+	(*ChargingTriggers)(nil),                       // 64: magma.lte.ChargingTriggers
+	(*RequestedUnits)(nil),                         // 65: magma.lte.RequestedUnits
+	(*CreditUsage)(nil),                            // 66: magma.lte.CreditUsage
+	(*CreditUsageUpdate)(nil),                      // 67: magma.lte.CreditUsageUpdate
+	(*CreditUpdateResponse)(nil),                   // 68: magma.lte.CreditUpdateResponse
+	(*UsageMonitorUpdate)(nil),                     // 69: magma.lte.UsageMonitorUpdate
+	(*UsageMonitoringCredit)(nil),                  // 70: magma.lte.UsageMonitoringCredit
+	(*UsageMonitoringUpdateRequest)(nil),           // 71: magma.lte.UsageMonitoringUpdateRequest
+	(*ApplicationDetectionInfo)(nil),               // 72: magma.lte.ApplicationDetectionInfo
+	(*UsageMonitoringUpdateResponse)(nil),          // 73: magma.lte.UsageMonitoringUpdateResponse
+	(*QosInformationRequest)(nil),                  // 74: magma.lte.QosInformationRequest
+	(*M5GQosInformationRequest)(nil),               // 75: magma.lte.M5GQosInformationRequest
+	(*TgppContext)(nil),                            // 76: magma.lte.TgppContext
+	(*CreateSessionRequest)(nil),                   // 77: magma.lte.CreateSessionRequest
+	(*CreateSessionResponse)(nil),                  // 78: magma.lte.CreateSessionResponse
+	(*StaticRuleInstall)(nil),                      // 79: magma.lte.StaticRuleInstall
+	(*DynamicRuleInstall)(nil),                     // 80: magma.lte.DynamicRuleInstall
+	(*UpdateSessionRequest)(nil),                   // 81: magma.lte.UpdateSessionRequest
+	(*UpdateSessionResponse)(nil),                  // 82: magma.lte.UpdateSessionResponse
+	(*SessionTerminateResponse)(nil),               // 83: magma.lte.SessionTerminateResponse
+	(*SessionTerminateRequest)(nil),                // 84: magma.lte.SessionTerminateRequest
+	(*NodeID)(nil),                                 // 85: magma.lte.NodeID
+	(*M5GSMCapability)(nil),                        // 86: magma.lte.M5GSMCapability
+	(*QosRules)(nil),                               // 87: magma.lte.QosRules
+	(*QosPolicy)(nil),                              // 88: magma.lte.QosPolicy
+	(*AllocationAndRetentionPrio)(nil),             // 89: magma.lte.allocation_and_retention_prio
+	(*QosCharacteristics)(nil),                     // 90: magma.lte.qos_characteristics
+	(*FlowLevelParams)(nil),                        // 91: magma.lte.flow_level_params
+	(*QosFlow)(nil),                                // 92: magma.lte.qos_flow
+	(*QosFlowRequestList)(nil),                     // 93: magma.lte.qos_flow_request_list
+	(*LadnServiceArea)(nil),                        // 94: magma.lte.LadnServiceArea
+	(*TrackingAreaIdentityList)(nil),               // 95: magma.lte.TrackingAreaIdentityList
+	(*M5GSMSessionContext)(nil),                    // 96: magma.lte.M5GSMSessionContext
+	(*SetSMSessionContext)(nil),                    // 97: magma.lte.SetSMSessionContext
+	(*SmContextVoid)(nil),                          // 98: magma.lte.SmContextVoid
+	(*RatSpecificContextAccess)(nil),               // 99: magma.lte.RatSpecificContextAccess
+	(*M5GSMSessionContextAccess)(nil),              // 100: magma.lte.M5GSMSessionContextAccess
+	(*SetSMSessionContextAccess)(nil),              // 101: magma.lte.SetSMSessionContextAccess
+	(*UPFNodeState)(nil),                           // 102: magma.lte.UPFNodeState
+	(*UserPlaneIPResourceSchema)(nil),              // 103: magma.lte.UserPlaneIPResourceSchema
+	(*UPFAssociationState)(nil),                    // 104: magma.lte.UPFAssociationState
+	(*UPFFeatureSet)(nil),                          // 105: magma.lte.UPFFeatureSet
+	(*UPFNodeReport)(nil),                          // 106: magma.lte.UPFNodeReport
+	(*LoadControlInformation)(nil),                 // 107: magma.lte.LoadControlInformation
+	(*OverloadTimer)(nil),                          // 108: magma.lte.OverloadTimer
+	(*OverloadControlInformation)(nil),             // 109: magma.lte.OverloadControlInformation
+	(*UPFSessionState)(nil),                        // 110: magma.lte.UPFSessionState
+	(*UPFSessionConfigState)(nil),                  // 111: magma.lte.UPFSessionConfigState
+	(*UPFPagingInfo)(nil),                          // 112: magma.lte.UPFPagingInfo
+	nil,                                            // 113: magma.lte.PolicyReAuthAnswer.FailedRulesEntry
+	(*SubscriberID)(nil),                           // 114: magma.lte.SubscriberID
+	(*ServiceIdentifier)(nil),                      // 115: magma.lte.ServiceIdentifier
+	(*timestamp.Timestamp)(nil),                    // 116: google.protobuf.Timestamp
+	(*PolicyRule)(nil),                             // 117: magma.lte.PolicyRule
+	(*protos.Void)(nil),                            // 118: magma.orc8r.Void
 }
 var file_lte_protos_session_manager_proto_depIdxs = []int32{
-	32,  // 0: magma.lte.RuleRecordTable.records:type_name -> magma.lte.RuleRecord
-	36,  // 1: magma.lte.LocalCreateSessionRequest.common_context:type_name -> magma.lte.CommonSessionContext
-	37,  // 2: magma.lte.LocalCreateSessionRequest.rat_specific_context:type_name -> magma.lte.RatSpecificContext
-	111, // 3: magma.lte.CommonSessionContext.sid:type_name -> magma.lte.SubscriberID
+	33,  // 0: magma.lte.RuleRecordTable.records:type_name -> magma.lte.RuleRecord
+	37,  // 1: magma.lte.LocalCreateSessionRequest.common_context:type_name -> magma.lte.CommonSessionContext
+	38,  // 2: magma.lte.LocalCreateSessionRequest.rat_specific_context:type_name -> magma.lte.RatSpecificContext
+	114, // 3: magma.lte.CommonSessionContext.sid:type_name -> magma.lte.SubscriberID
 	0,   // 4: magma.lte.CommonSessionContext.rat_type:type_name -> magma.lte.RATType
 	17,  // 5: magma.lte.CommonSessionContext.sm_session_state:type_name -> magma.lte.SMSessionFSMState
-	35,  // 6: magma.lte.CommonSessionContext.teids:type_name -> magma.lte.Teids
-	41,  // 7: magma.lte.RatSpecificContext.lte_context:type_name -> magma.lte.LTESessionContext
-	42,  // 8: magma.lte.RatSpecificContext.wlan_context:type_name -> magma.lte.WLANSessionContext
-	93,  // 9: magma.lte.RatSpecificContext.m5gsm_session_context:type_name -> magma.lte.M5GSMSessionContext
+	36,  // 6: magma.lte.CommonSessionContext.teids:type_name -> magma.lte.Teids
+	42,  // 7: magma.lte.RatSpecificContext.lte_context:type_name -> magma.lte.LTESessionContext
+	43,  // 8: magma.lte.RatSpecificContext.wlan_context:type_name -> magma.lte.WLANSessionContext
+	96,  // 9: magma.lte.RatSpecificContext.m5gsm_session_context:type_name -> magma.lte.M5GSMSessionContext
 	10,  // 10: magma.lte.RatSpecificNotification.request_type:type_name -> magma.lte.RequestType
 	11,  // 11: magma.lte.RatSpecificNotification.access_type:type_name -> magma.lte.AccessType
 	7,   // 12: magma.lte.RatSpecificNotification.pdu_session_type:type_name -> magma.lte.PduSessionType
-	83,  // 13: magma.lte.RatSpecificNotification.m5g_sm_capability:type_name -> magma.lte.M5GSMCapability
+	86,  // 13: magma.lte.RatSpecificNotification.m5g_sm_capability:type_name -> magma.lte.M5GSMCapability
 	9,   // 14: magma.lte.RatSpecificNotification.m5gsm_cause:type_name -> magma.lte.M5GSMCause
 	1,   // 15: magma.lte.RatSpecificNotification.notify_ue_event:type_name -> magma.lte.NotifyUeEvents
-	36,  // 16: magma.lte.SetSmNotificationContext.common_context:type_name -> magma.lte.CommonSessionContext
-	39,  // 17: magma.lte.SetSmNotificationContext.rat_specific_notification:type_name -> magma.lte.RatSpecificNotification
-	71,  // 18: magma.lte.LTESessionContext.qos_info:type_name -> magma.lte.QosInformationRequest
-	111, // 19: magma.lte.LocalEndSessionRequest.sid:type_name -> magma.lte.SubscriberID
-	111, // 20: magma.lte.UpdateTunnelIdsRequest.sid:type_name -> magma.lte.SubscriberID
-	111, // 21: magma.lte.PolicyBearerBindingRequest.sid:type_name -> magma.lte.SubscriberID
-	35,  // 22: magma.lte.PolicyBearerBindingRequest.teids:type_name -> magma.lte.Teids
+	37,  // 16: magma.lte.SetSmNotificationContext.common_context:type_name -> magma.lte.CommonSessionContext
+	40,  // 17: magma.lte.SetSmNotificationContext.rat_specific_notification:type_name -> magma.lte.RatSpecificNotification
+	74,  // 18: magma.lte.LTESessionContext.qos_info:type_name -> magma.lte.QosInformationRequest
+	114, // 19: magma.lte.LocalEndSessionRequest.sid:type_name -> magma.lte.SubscriberID
+	114, // 20: magma.lte.UpdateTunnelIdsRequest.sid:type_name -> magma.lte.SubscriberID
+	114, // 21: magma.lte.PolicyBearerBindingRequest.sid:type_name -> magma.lte.SubscriberID
+	36,  // 22: magma.lte.PolicyBearerBindingRequest.teids:type_name -> magma.lte.Teids
 	18,  // 23: magma.lte.ChargingReAuthRequest.type:type_name -> magma.lte.ChargingReAuthRequest.Type
-	112, // 24: magma.lte.ChargingReAuthRequest.service_identifier:type_name -> magma.lte.ServiceIdentifier
+	115, // 24: magma.lte.ChargingReAuthRequest.service_identifier:type_name -> magma.lte.ServiceIdentifier
 	4,   // 25: magma.lte.ChargingReAuthAnswer.result:type_name -> magma.lte.ReAuthResult
-	76,  // 26: magma.lte.PolicyReAuthRequest.rules_to_install:type_name -> magma.lte.StaticRuleInstall
-	77,  // 27: magma.lte.PolicyReAuthRequest.dynamic_rules_to_install:type_name -> magma.lte.DynamicRuleInstall
+	79,  // 26: magma.lte.PolicyReAuthRequest.rules_to_install:type_name -> magma.lte.StaticRuleInstall
+	80,  // 27: magma.lte.PolicyReAuthRequest.dynamic_rules_to_install:type_name -> magma.lte.DynamicRuleInstall
 	2,   // 28: magma.lte.PolicyReAuthRequest.event_triggers:type_name -> magma.lte.EventTrigger
-	113, // 29: magma.lte.PolicyReAuthRequest.revalidation_time:type_name -> google.protobuf.Timestamp
-	68,  // 30: magma.lte.PolicyReAuthRequest.usage_monitoring_credits:type_name -> magma.lte.UsageMonitoringCredit
-	53,  // 31: magma.lte.PolicyReAuthRequest.qos_info:type_name -> magma.lte.QoSInformation
+	116, // 29: magma.lte.PolicyReAuthRequest.revalidation_time:type_name -> google.protobuf.Timestamp
+	70,  // 30: magma.lte.PolicyReAuthRequest.usage_monitoring_credits:type_name -> magma.lte.UsageMonitoringCredit
+	54,  // 31: magma.lte.PolicyReAuthRequest.qos_info:type_name -> magma.lte.QoSInformation
 	3,   // 32: magma.lte.QoSInformation.qci:type_name -> magma.lte.QCI
 	4,   // 33: magma.lte.PolicyReAuthAnswer.result:type_name -> magma.lte.ReAuthResult
-	110, // 34: magma.lte.PolicyReAuthAnswer.failed_rules:type_name -> magma.lte.PolicyReAuthAnswer.FailedRulesEntry
-	76,  // 35: magma.lte.RuleSet.static_rules:type_name -> magma.lte.StaticRuleInstall
-	77,  // 36: magma.lte.RuleSet.dynamic_rules:type_name -> magma.lte.DynamicRuleInstall
-	55,  // 37: magma.lte.RulesPerSubscriber.rule_set:type_name -> magma.lte.RuleSet
-	56,  // 38: magma.lte.SessionRules.rules_per_subscriber:type_name -> magma.lte.RulesPerSubscriber
-	59,  // 39: magma.lte.GrantedUnits.total:type_name -> magma.lte.CreditUnit
-	59,  // 40: magma.lte.GrantedUnits.tx:type_name -> magma.lte.CreditUnit
-	59,  // 41: magma.lte.GrantedUnits.rx:type_name -> magma.lte.CreditUnit
+	113, // 34: magma.lte.PolicyReAuthAnswer.failed_rules:type_name -> magma.lte.PolicyReAuthAnswer.FailedRulesEntry
+	79,  // 35: magma.lte.RuleSet.static_rules:type_name -> magma.lte.StaticRuleInstall
+	80,  // 36: magma.lte.RuleSet.dynamic_rules:type_name -> magma.lte.DynamicRuleInstall
+	56,  // 37: magma.lte.RulesPerSubscriber.rule_set:type_name -> magma.lte.RuleSet
+	57,  // 38: magma.lte.SessionRules.rules_per_subscriber:type_name -> magma.lte.RulesPerSubscriber
+	60,  // 39: magma.lte.GrantedUnits.total:type_name -> magma.lte.CreditUnit
+	60,  // 40: magma.lte.GrantedUnits.tx:type_name -> magma.lte.CreditUnit
+	60,  // 41: magma.lte.GrantedUnits.rx:type_name -> magma.lte.CreditUnit
 	20,  // 42: magma.lte.RedirectServer.redirect_address_type:type_name -> magma.lte.RedirectServer.RedirectAddressType
 	21,  // 43: magma.lte.ChargingCredit.type:type_name -> magma.lte.ChargingCredit.UnitType
 	22,  // 44: magma.lte.ChargingCredit.final_action:type_name -> magma.lte.ChargingCredit.FinalAction
-	60,  // 45: magma.lte.ChargingCredit.granted_units:type_name -> magma.lte.GrantedUnits
-	61,  // 46: magma.lte.ChargingCredit.redirect_server:type_name -> magma.lte.RedirectServer
-	23,  // 47: magma.lte.CreditUsage.type:type_name -> magma.lte.CreditUsage.UpdateType
-	112, // 48: magma.lte.CreditUsage.service_identifier:type_name -> magma.lte.ServiceIdentifier
-	63,  // 49: magma.lte.CreditUsage.requested_units:type_name -> magma.lte.RequestedUnits
-	64,  // 50: magma.lte.CreditUsageUpdate.usage:type_name -> magma.lte.CreditUsage
-	73,  // 51: magma.lte.CreditUsageUpdate.tgpp_ctx:type_name -> magma.lte.TgppContext
-	36,  // 52: magma.lte.CreditUsageUpdate.common_context:type_name -> magma.lte.CommonSessionContext
-	37,  // 53: magma.lte.CreditUsageUpdate.rat_specific_context:type_name -> magma.lte.RatSpecificContext
-	62,  // 54: magma.lte.CreditUpdateResponse.credit:type_name -> magma.lte.ChargingCredit
-	112, // 55: magma.lte.CreditUpdateResponse.service_identifier:type_name -> magma.lte.ServiceIdentifier
-	5,   // 56: magma.lte.CreditUpdateResponse.limit_type:type_name -> magma.lte.CreditLimitType
-	73,  // 57: magma.lte.CreditUpdateResponse.tgpp_ctx:type_name -> magma.lte.TgppContext
-	6,   // 58: magma.lte.UsageMonitorUpdate.level:type_name -> magma.lte.MonitoringLevel
-	24,  // 59: magma.lte.UsageMonitoringCredit.action:type_name -> magma.lte.UsageMonitoringCredit.Action
-	6,   // 60: magma.lte.UsageMonitoringCredit.level:type_name -> magma.lte.MonitoringLevel
-	60,  // 61: magma.lte.UsageMonitoringCredit.granted_units:type_name -> magma.lte.GrantedUnits
-	67,  // 62: magma.lte.UsageMonitoringUpdateRequest.update:type_name -> magma.lte.UsageMonitorUpdate
-	0,   // 63: magma.lte.UsageMonitoringUpdateRequest.rat_type:type_name -> magma.lte.RATType
-	73,  // 64: magma.lte.UsageMonitoringUpdateRequest.tgpp_ctx:type_name -> magma.lte.TgppContext
-	2,   // 65: magma.lte.UsageMonitoringUpdateRequest.event_trigger:type_name -> magma.lte.EventTrigger
-	68,  // 66: magma.lte.UsageMonitoringUpdateResponse.credit:type_name -> magma.lte.UsageMonitoringCredit
-	2,   // 67: magma.lte.UsageMonitoringUpdateResponse.event_triggers:type_name -> magma.lte.EventTrigger
-	113, // 68: magma.lte.UsageMonitoringUpdateResponse.revalidation_time:type_name -> google.protobuf.Timestamp
-	76,  // 69: magma.lte.UsageMonitoringUpdateResponse.static_rules_to_install:type_name -> magma.lte.StaticRuleInstall
-	77,  // 70: magma.lte.UsageMonitoringUpdateResponse.dynamic_rules_to_install:type_name -> magma.lte.DynamicRuleInstall
-	73,  // 71: magma.lte.UsageMonitoringUpdateResponse.tgpp_ctx:type_name -> magma.lte.TgppContext
-	25,  // 72: magma.lte.QosInformationRequest.br_unit:type_name -> magma.lte.QosInformationRequest.BitrateUnitsAMBR
-	13,  // 73: magma.lte.M5GQosInformationRequest.preemption_capability:type_name -> magma.lte.prem_capab
-	14,  // 74: magma.lte.M5GQosInformationRequest.preemption_vulnerability:type_name -> magma.lte.prem_vuner
-	3,   // 75: magma.lte.M5GQosInformationRequest.qos_class_id:type_name -> magma.lte.QCI
-	26,  // 76: magma.lte.M5GQosInformationRequest.br_unit:type_name -> magma.lte.M5GQosInformationRequest.BitrateUnitsAMBR
-	58,  // 77: magma.lte.CreateSessionRequest.access_timezone:type_name -> magma.lte.Timezone
-	63,  // 78: magma.lte.CreateSessionRequest.requested_units:type_name -> magma.lte.RequestedUnits
-	36,  // 79: magma.lte.CreateSessionRequest.common_context:type_name -> magma.lte.CommonSessionContext
-	37,  // 80: magma.lte.CreateSessionRequest.rat_specific_context:type_name -> magma.lte.RatSpecificContext
-	66,  // 81: magma.lte.CreateSessionResponse.credits:type_name -> magma.lte.CreditUpdateResponse
-	70,  // 82: magma.lte.CreateSessionResponse.usage_monitors:type_name -> magma.lte.UsageMonitoringUpdateResponse
-	76,  // 83: magma.lte.CreateSessionResponse.static_rules:type_name -> magma.lte.StaticRuleInstall
-	77,  // 84: magma.lte.CreateSessionResponse.dynamic_rules:type_name -> magma.lte.DynamicRuleInstall
-	73,  // 85: magma.lte.CreateSessionResponse.tgpp_ctx:type_name -> magma.lte.TgppContext
-	2,   // 86: magma.lte.CreateSessionResponse.event_triggers:type_name -> magma.lte.EventTrigger
-	113, // 87: magma.lte.CreateSessionResponse.revalidation_time:type_name -> google.protobuf.Timestamp
-	113, // 88: magma.lte.StaticRuleInstall.activation_time:type_name -> google.protobuf.Timestamp
-	113, // 89: magma.lte.StaticRuleInstall.deactivation_time:type_name -> google.protobuf.Timestamp
-	114, // 90: magma.lte.DynamicRuleInstall.policy_rule:type_name -> magma.lte.PolicyRule
-	113, // 91: magma.lte.DynamicRuleInstall.activation_time:type_name -> google.protobuf.Timestamp
-	113, // 92: magma.lte.DynamicRuleInstall.deactivation_time:type_name -> google.protobuf.Timestamp
-	65,  // 93: magma.lte.UpdateSessionRequest.updates:type_name -> magma.lte.CreditUsageUpdate
-	69,  // 94: magma.lte.UpdateSessionRequest.usage_monitors:type_name -> magma.lte.UsageMonitoringUpdateRequest
-	66,  // 95: magma.lte.UpdateSessionResponse.responses:type_name -> magma.lte.CreditUpdateResponse
-	70,  // 96: magma.lte.UpdateSessionResponse.usage_monitor_responses:type_name -> magma.lte.UsageMonitoringUpdateResponse
-	64,  // 97: magma.lte.SessionTerminateRequest.credit_usages:type_name -> magma.lte.CreditUsage
-	67,  // 98: magma.lte.SessionTerminateRequest.monitor_usages:type_name -> magma.lte.UsageMonitorUpdate
-	73,  // 99: magma.lte.SessionTerminateRequest.tgpp_ctx:type_name -> magma.lte.TgppContext
-	36,  // 100: magma.lte.SessionTerminateRequest.common_context:type_name -> magma.lte.CommonSessionContext
-	27,  // 101: magma.lte.NodeID.node_id_type:type_name -> magma.lte.NodeID.NodeIDType
-	28,  // 102: magma.lte.QosPolicy.policy_state:type_name -> magma.lte.QosPolicy.PolicyState
-	29,  // 103: magma.lte.QosPolicy.policy_action:type_name -> magma.lte.QosPolicy.PolicyAction
-	114, // 104: magma.lte.QosPolicy.qos:type_name -> magma.lte.PolicyRule
-	13,  // 105: magma.lte.allocation_and_retention_prio.pre_emtion_cap:type_name -> magma.lte.prem_capab
-	14,  // 106: magma.lte.allocation_and_retention_prio.pre_emtion_vul:type_name -> magma.lte.prem_vuner
-	87,  // 107: magma.lte.flow_level_params.qos_chars:type_name -> magma.lte.qos_characteristics
-	86,  // 108: magma.lte.flow_level_params.alloc_reten_prio:type_name -> magma.lte.allocation_and_retention_prio
-	88,  // 109: magma.lte.qos_flow.param:type_name -> magma.lte.flow_level_params
-	89,  // 110: magma.lte.qos_flow_request_list.flow:type_name -> magma.lte.qos_flow
-	92,  // 111: magma.lte.LadnServiceArea.identity_list:type_name -> magma.lte.TrackingAreaIdentityList
-	15,  // 112: magma.lte.TrackingAreaIdentityList.type_of_list:type_name -> magma.lte.TypeOfList
-	10,  // 113: magma.lte.M5GSMSessionContext.request_type:type_name -> magma.lte.RequestType
-	61,  // 114: magma.lte.M5GSMSessionContext.pdu_address:type_name -> magma.lte.RedirectServer
-	16,  // 115: magma.lte.M5GSMSessionContext.priority_access:type_name -> magma.lte.priorityaccess
-	11,  // 116: magma.lte.M5GSMSessionContext.access_type:type_name -> magma.lte.AccessType
-	91,  // 117: magma.lte.M5GSMSessionContext.ladn_service_area:type_name -> magma.lte.LadnServiceArea
-	12,  // 118: magma.lte.M5GSMSessionContext.dnn_selection_mode:type_name -> magma.lte.DnnSelectionMode
-	7,   // 119: magma.lte.M5GSMSessionContext.pdu_session_type:type_name -> magma.lte.PduSessionType
-	8,   // 120: magma.lte.M5GSMSessionContext.ssc_mode:type_name -> magma.lte.SscMode
-	83,  // 121: magma.lte.M5GSMSessionContext.m5g_sm_capability:type_name -> magma.lte.M5GSMCapability
-	9,   // 122: magma.lte.M5GSMSessionContext.m5gsm_cause:type_name -> magma.lte.M5GSMCause
-	84,  // 123: magma.lte.M5GSMSessionContext.requested_qos_rules:type_name -> magma.lte.QosRules
-	38,  // 124: magma.lte.M5GSMSessionContext.gnode_endpoint:type_name -> magma.lte.TeidSet
-	38,  // 125: magma.lte.M5GSMSessionContext.upf_endpoint:type_name -> magma.lte.TeidSet
-	72,  // 126: magma.lte.M5GSMSessionContext.subscribed_qos:type_name -> magma.lte.M5GQosInformationRequest
-	85,  // 127: magma.lte.M5GSMSessionContext.qos_policy:type_name -> magma.lte.QosPolicy
-	36,  // 128: magma.lte.SetSMSessionContext.common_context:type_name -> magma.lte.CommonSessionContext
-	37,  // 129: magma.lte.SetSMSessionContext.rat_specific_context:type_name -> magma.lte.RatSpecificContext
-	97,  // 130: magma.lte.RatSpecificContextAccess.m5g_session_context_rsp:type_name -> magma.lte.M5GSMSessionContextAccess
-	7,   // 131: magma.lte.M5GSMSessionContextAccess.pdu_session_type:type_name -> magma.lte.PduSessionType
-	8,   // 132: magma.lte.M5GSMSessionContextAccess.selected_ssc_mode:type_name -> magma.lte.SscMode
-	84,  // 133: magma.lte.M5GSMSessionContextAccess.authorized_qos_rules:type_name -> magma.lte.QosRules
-	72,  // 134: magma.lte.M5GSMSessionContextAccess.subscribed_qos:type_name -> magma.lte.M5GQosInformationRequest
-	9,   // 135: magma.lte.M5GSMSessionContextAccess.M5gsm_cause:type_name -> magma.lte.M5GSMCause
-	8,   // 136: magma.lte.M5GSMSessionContextAccess.allowed_ssc_mode:type_name -> magma.lte.SscMode
-	61,  // 137: magma.lte.M5GSMSessionContextAccess.pdu_address:type_name -> magma.lte.RedirectServer
-	38,  // 138: magma.lte.M5GSMSessionContextAccess.upf_endpoint:type_name -> magma.lte.TeidSet
-	85,  // 139: magma.lte.M5GSMSessionContextAccess.qos_policy:type_name -> magma.lte.QosPolicy
-	36,  // 140: magma.lte.SetSMSessionContextAccess.common_context:type_name -> magma.lte.CommonSessionContext
-	96,  // 141: magma.lte.SetSMSessionContextAccess.rat_specific_context:type_name -> magma.lte.RatSpecificContextAccess
-	101, // 142: magma.lte.UPFNodeState.associaton_state:type_name -> magma.lte.UPFAssociationState
-	103, // 143: magma.lte.UPFNodeState.node_report:type_name -> magma.lte.UPFNodeReport
-	31,  // 144: magma.lte.UPFAssociationState.assoc_state:type_name -> magma.lte.UPFAssociationState.AssociationState
-	113, // 145: magma.lte.UPFAssociationState.recovery_time_stamp:type_name -> google.protobuf.Timestamp
-	102, // 146: magma.lte.UPFAssociationState.feature_set:type_name -> magma.lte.UPFFeatureSet
-	100, // 147: magma.lte.UPFAssociationState.ip_resource_schema:type_name -> magma.lte.UserPlaneIPResourceSchema
-	113, // 148: magma.lte.UPFAssociationState.graceful_release_period:type_name -> google.protobuf.Timestamp
-	104, // 149: magma.lte.UPFNodeReport.load_control_info:type_name -> magma.lte.LoadControlInformation
-	106, // 150: magma.lte.UPFNodeReport.overload_control_info:type_name -> magma.lte.OverloadControlInformation
-	105, // 151: magma.lte.OverloadControlInformation.period_of_validity:type_name -> magma.lte.OverloadTimer
-	107, // 152: magma.lte.UPFSessionConfigState.upf_session_state:type_name -> magma.lte.UPFSessionState
-	19,  // 153: magma.lte.PolicyReAuthAnswer.FailedRulesEntry.value:type_name -> magma.lte.PolicyReAuthAnswer.FailureCode
-	33,  // 154: magma.lte.LocalSessionManager.ReportRuleStats:input_type -> magma.lte.RuleRecordTable
-	34,  // 155: magma.lte.LocalSessionManager.CreateSession:input_type -> magma.lte.LocalCreateSessionRequest
-	44,  // 156: magma.lte.LocalSessionManager.EndSession:input_type -> magma.lte.LocalEndSessionRequest
-	48,  // 157: magma.lte.LocalSessionManager.BindPolicy2Bearer:input_type -> magma.lte.PolicyBearerBindingRequest
-	57,  // 158: magma.lte.LocalSessionManager.SetSessionRules:input_type -> magma.lte.SessionRules
-	46,  // 159: magma.lte.LocalSessionManager.UpdateTunnelIds:input_type -> magma.lte.UpdateTunnelIdsRequest
-	50,  // 160: magma.lte.SessionProxyResponder.ChargingReAuth:input_type -> magma.lte.ChargingReAuthRequest
-	52,  // 161: magma.lte.SessionProxyResponder.PolicyReAuth:input_type -> magma.lte.PolicyReAuthRequest
-	74,  // 162: magma.lte.CentralSessionController.CreateSession:input_type -> magma.lte.CreateSessionRequest
-	78,  // 163: magma.lte.CentralSessionController.UpdateSession:input_type -> magma.lte.UpdateSessionRequest
-	81,  // 164: magma.lte.CentralSessionController.TerminateSession:input_type -> magma.lte.SessionTerminateRequest
-	99,  // 165: magma.lte.SetInterfaceForUserPlane.SetUPFNodeState:input_type -> magma.lte.UPFNodeState
-	108, // 166: magma.lte.SetInterfaceForUserPlane.SetUPFSessionsConfig:input_type -> magma.lte.UPFSessionConfigState
-	109, // 167: magma.lte.SetInterfaceForUserPlane.SendPagingRequest:input_type -> magma.lte.UPFPagingInfo
-	94,  // 168: magma.lte.AmfPduSessionSmContext.SetAmfSessionContext:input_type -> magma.lte.SetSMSessionContext
-	40,  // 169: magma.lte.AmfPduSessionSmContext.SetSmfNotification:input_type -> magma.lte.SetSmNotificationContext
-	40,  // 170: magma.lte.SmfPduSessionSmContext.SetAmfNotification:input_type -> magma.lte.SetSmNotificationContext
-	98,  // 171: magma.lte.SmfPduSessionSmContext.SetSmfSessionContext:input_type -> magma.lte.SetSMSessionContextAccess
-	115, // 172: magma.lte.LocalSessionManager.ReportRuleStats:output_type -> magma.orc8r.Void
-	43,  // 173: magma.lte.LocalSessionManager.CreateSession:output_type -> magma.lte.LocalCreateSessionResponse
-	45,  // 174: magma.lte.LocalSessionManager.EndSession:output_type -> magma.lte.LocalEndSessionResponse
-	49,  // 175: magma.lte.LocalSessionManager.BindPolicy2Bearer:output_type -> magma.lte.PolicyBearerBindingResponse
-	115, // 176: magma.lte.LocalSessionManager.SetSessionRules:output_type -> magma.orc8r.Void
-	47,  // 177: magma.lte.LocalSessionManager.UpdateTunnelIds:output_type -> magma.lte.UpdateTunnelIdsResponse
-	51,  // 178: magma.lte.SessionProxyResponder.ChargingReAuth:output_type -> magma.lte.ChargingReAuthAnswer
-	54,  // 179: magma.lte.SessionProxyResponder.PolicyReAuth:output_type -> magma.lte.PolicyReAuthAnswer
-	75,  // 180: magma.lte.CentralSessionController.CreateSession:output_type -> magma.lte.CreateSessionResponse
-	79,  // 181: magma.lte.CentralSessionController.UpdateSession:output_type -> magma.lte.UpdateSessionResponse
-	80,  // 182: magma.lte.CentralSessionController.TerminateSession:output_type -> magma.lte.SessionTerminateResponse
-	95,  // 183: magma.lte.SetInterfaceForUserPlane.SetUPFNodeState:output_type -> magma.lte.SmContextVoid
-	95,  // 184: magma.lte.SetInterfaceForUserPlane.SetUPFSessionsConfig:output_type -> magma.lte.SmContextVoid
-	95,  // 185: magma.lte.SetInterfaceForUserPlane.SendPagingRequest:output_type -> magma.lte.SmContextVoid
-	95,  // 186: magma.lte.AmfPduSessionSmContext.SetAmfSessionContext:output_type -> magma.lte.SmContextVoid
-	95,  // 187: magma.lte.AmfPduSessionSmContext.SetSmfNotification:output_type -> magma.lte.SmContextVoid
-	95,  // 188: magma.lte.SmfPduSessionSmContext.SetAmfNotification:output_type -> magma.lte.SmContextVoid
-	95,  // 189: magma.lte.SmfPduSessionSmContext.SetSmfSessionContext:output_type -> magma.lte.SmContextVoid
-	172, // [172:190] is the sub-list for method output_type
-	154, // [154:172] is the sub-list for method input_type
-	154, // [154:154] is the sub-list for extension type_name
-	154, // [154:154] is the sub-list for extension extendee
-	0,   // [0:154] is the sub-list for field type_name
+	61,  // 45: magma.lte.ChargingCredit.granted_units:type_name -> magma.lte.GrantedUnits
+	62,  // 46: magma.lte.ChargingCredit.redirect_server:type_name -> magma.lte.RedirectServer
+	116, // 47: magma.lte.ChargingCredit.tariff_time_change:type_name -> google.protobuf.Timestamp
+	64,  // 48: magma.lte.ChargingCredit.triggers:type_name -> magma.lte.ChargingTriggers
+	23,  // 49: magma.lte.CreditUsage.type:type_name -> magma.lte.CreditUsage.UpdateType
+	115, // 50: magma.lte.CreditUsage.service_identifier:type_name -> magma.lte.ServiceIdentifier
+	65,  // 51: magma.lte.CreditUsage.requested_units:type_name -> magma.lte.RequestedUnits
+	24,  // 52: magma.lte.CreditUsage.tariff_change_usage:type_name -> magma.lte.CreditUsage.TariffChangeUsage
+	66,  // 53: magma.lte.CreditUsageUpdate.usage:type_name -> magma.lte.CreditUsage
+	76,  // 54: magma.lte.CreditUsageUpdate.tgpp_ctx:type_name -> magma.lte.TgppContext
+	37,  // 55: magma.lte.CreditUsageUpdate.common_context:type_name -> magma.lte.CommonSessionContext
+	38,  // 56: magma.lte.CreditUsageUpdate.rat_specific_context:type_name -> magma.lte.RatSpecificContext
+	63,  // 57: magma.lte.CreditUpdateResponse.credit:type_name -> magma.lte.ChargingCredit
+	115, // 58: magma.lte.CreditUpdateResponse.service_identifier:type_name -> magma.lte.ServiceIdentifier
+	5,   // 59: magma.lte.CreditUpdateResponse.limit_type:type_name -> magma.lte.CreditLimitType
+	76,  // 60: magma.lte.CreditUpdateResponse.tgpp_ctx:type_name -> magma.lte.TgppContext
+	6,   // 61: magma.lte.UsageMonitorUpdate.level:type_name -> magma.lte.MonitoringLevel
+	25,  // 62: magma.lte.UsageMonitoringCredit.action:type_name -> magma.lte.UsageMonitoringCredit.Action
+	6,   // 63: magma.lte.UsageMonitoringCredit.level:type_name -> magma.lte.MonitoringLevel
+	61,  // 64: magma.lte.UsageMonitoringCredit.granted_units:type_name -> magma.lte.GrantedUnits
+	69,  // 65: magma.lte.UsageMonitoringUpdateRequest.update:type_name -> magma.lte.UsageMonitorUpdate
+	0,   // 66: magma.lte.UsageMonitoringUpdateRequest.rat_type:type_name -> magma.lte.RATType
+	76,  // 67: magma.lte.UsageMonitoringUpdateRequest.tgpp_ctx:type_name -> magma.lte.TgppContext
+	2,   // 68: magma.lte.UsageMonitoringUpdateRequest.event_trigger:type_name -> magma.lte.EventTrigger
+	59,  // 69: magma.lte.UsageMonitoringUpdateRequest.access_timezone:type_name -> magma.lte.Timezone
+	72,  // 70: magma.lte.UsageMonitoringUpdateRequest.application_detection_infos:type_name -> magma.lte.ApplicationDetectionInfo
+	70,  // 71: magma.lte.UsageMonitoringUpdateResponse.credit:type_name -> magma.lte.UsageMonitoringCredit
+	2,   // 72: magma.lte.UsageMonitoringUpdateResponse.event_triggers:type_name -> magma.lte.EventTrigger
+	116, // 73: magma.lte.UsageMonitoringUpdateResponse.revalidation_time:type_name -> google.protobuf.Timestamp
+	79,  // 74: magma.lte.UsageMonitoringUpdateResponse.static_rules_to_install:type_name -> magma.lte.StaticRuleInstall
+	80,  // 75: magma.lte.UsageMonitoringUpdateResponse.dynamic_rules_to_install:type_name -> magma.lte.DynamicRuleInstall
+	76,  // 76: magma.lte.UsageMonitoringUpdateResponse.tgpp_ctx:type_name -> magma.lte.TgppContext
+	26,  // 77: magma.lte.QosInformationRequest.br_unit:type_name -> magma.lte.QosInformationRequest.BitrateUnitsAMBR
+	13,  // 78: magma.lte.M5GQosInformationRequest.preemption_capability:type_name -> magma.lte.prem_capab
+	14,  // 79: magma.lte.M5GQosInformationRequest.preemption_vulnerability:type_name -> magma.lte.prem_vuner
+	3,   // 80: magma.lte.M5GQosInformationRequest.qos_class_id:type_name -> magma.lte.QCI
+	27,  // 81: magma.lte.M5GQosInformationRequest.br_unit:type_name -> magma.lte.M5GQosInformationRequest.BitrateUnitsAMBR
+	59,  // 82: magma.lte.CreateSessionRequest.access_timezone:type_name -> magma.lte.Timezone
+	65,  // 83: magma.lte.CreateSessionRequest.requested_units:type_name -> magma.lte.RequestedUnits
+	37,  // 84: magma.lte.CreateSessionRequest.common_context:type_name -> magma.lte.CommonSessionContext
+	38,  // 85: magma.lte.CreateSessionRequest.rat_specific_context:type_name -> magma.lte.RatSpecificContext
+	68,  // 86: magma.lte.CreateSessionResponse.credits:type_name -> magma.lte.CreditUpdateResponse
+	73,  // 87: magma.lte.CreateSessionResponse.usage_monitors:type_name -> magma.lte.UsageMonitoringUpdateResponse
+	79,  // 88: magma.lte.CreateSessionResponse.static_rules:type_name -> magma.lte.StaticRuleInstall
+	80,  // 89: magma.lte.CreateSessionResponse.dynamic_rules:type_name -> magma.lte.DynamicRuleInstall
+	76,  // 90: magma.lte.CreateSessionResponse.tgpp_ctx:type_name -> magma.lte.TgppContext
+	2,   // 91: magma.lte.CreateSessionResponse.event_triggers:type_name -> magma.lte.EventTrigger
+	116, // 92: magma.lte.CreateSessionResponse.revalidation_time:type_name -> google.protobuf.Timestamp
+	116, // 93: magma.lte.StaticRuleInstall.activation_time:type_name -> google.protobuf.Timestamp
+	116, // 94: magma.lte.StaticRuleInstall.deactivation_time:type_name -> google.protobuf.Timestamp
+	117, // 95: magma.lte.DynamicRuleInstall.policy_rule:type_name -> magma.lte.PolicyRule
+	116, // 96: magma.lte.DynamicRuleInstall.activation_time:type_name -> google.protobuf.Timestamp
+	116, // 97: magma.lte.DynamicRuleInstall.deactivation_time:type_name -> google.protobuf.Timestamp
+	67,  // 98: magma.lte.UpdateSessionRequest.updates:type_name -> magma.lte.CreditUsageUpdate
+	71,  // 99: magma.lte.UpdateSessionRequest.usage_monitors:type_name -> magma.lte.UsageMonitoringUpdateRequest
+	68,  // 100: magma.lte.UpdateSessionResponse.responses:type_name -> magma.lte.CreditUpdateResponse
+	73,  // 101: magma.lte.UpdateSessionResponse.usage_monitor_responses:type_name -> magma.lte.UsageMonitoringUpdateResponse
+	66,  // 102: magma.lte.SessionTerminateRequest.credit_usages:type_name -> magma.lte.CreditUsage
+	69,  // 103: magma.lte.SessionTerminateRequest.monitor_usages:type_name -> magma.lte.UsageMonitorUpdate
+	76,  // 104: magma.lte.SessionTerminateRequest.tgpp_ctx:type_name -> magma.lte.TgppContext
+	37,  // 105: magma.lte.SessionTerminateRequest.common_context:type_name -> magma.lte.CommonSessionContext
+	28,  // 106: magma.lte.NodeID.node_id_type:type_name -> magma.lte.NodeID.NodeIDType
+	29,  // 107: magma.lte.QosPolicy.policy_state:type_name -> magma.lte.QosPolicy.PolicyState
+	30,  // 108: magma.lte.QosPolicy.policy_action:type_name -> magma.lte.QosPolicy.PolicyAction
+	117, // 109: magma.lte.QosPolicy.qos:type_name -> magma.lte.PolicyRule
+	13,  // 110: magma.lte.allocation_and_retention_prio.pre_emtion_cap:type_name -> magma.lte.prem_capab
+	14,  // 111: magma.lte.allocation_and_retention_prio.pre_emtion_vul:type_name -> magma.lte.prem_vuner
+	90,  // 112: magma.lte.flow_level_params.qos_chars:type_name -> magma.lte.qos_characteristics
+	89,  // 113: magma.lte.flow_level_params.alloc_reten_prio:type_name -> magma.lte.allocation_and_retention_prio
+	91,  // 114: magma.lte.qos_flow.param:type_name -> magma.lte.flow_level_params
+	92,  // 115: magma.lte.qos_flow_request_list.flow:type_name -> magma.lte.qos_flow
+	95,  // 116: magma.lte.LadnServiceArea.identity_list:type_name -> magma.lte.TrackingAreaIdentityList
+	15,  // 117: magma.lte.TrackingAreaIdentityList.type_of_list:type_name -> magma.lte.TypeOfList
+	10,  // 118: magma.lte.M5GSMSessionContext.request_type:type_name -> magma.lte.RequestType
+	62,  // 119: magma.lte.M5GSMSessionContext.pdu_address:type_name -> magma.lte.RedirectServer
+	16,  // 120: magma.lte.M5GSMSessionContext.priority_access:type_name -> magma.lte.priorityaccess
+	11,  // 121: magma.lte.M5GSMSessionContext.access_type:type_name -> magma.lte.AccessType
+	94,  // 122: magma.lte.M5GSMSessionContext.ladn_service_area:type_name -> magma.lte.LadnServiceArea
+	12,  // 123: magma.lte.M5GSMSessionContext.dnn_selection_mode:type_name -> magma.lte.DnnSelectionMode
+	7,   // 124: magma.lte.M5GSMSessionContext.pdu_session_type:type_name -> magma.lte.PduSessionType
+	8,   // 125: magma.lte.M5GSMSessionContext.ssc_mode:type_name -> magma.lte.SscMode
+	86,  // 126: magma.lte.M5GSMSessionContext.m5g_sm_capability:type_name -> magma.lte.M5GSMCapability
+	9,   // 127: magma.lte.M5GSMSessionContext.m5gsm_cause:type_name -> magma.lte.M5GSMCause
+	87,  // 128: magma.lte.M5GSMSessionContext.requested_qos_rules:type_name -> magma.lte.QosRules
+	39,  // 129: magma.lte.M5GSMSessionContext.gnode_endpoint:type_name -> magma.lte.TeidSet
+	39,  // 130: magma.lte.M5GSMSessionContext.upf_endpoint:type_name -> magma.lte.TeidSet
+	75,  // 131: magma.lte.M5GSMSessionContext.subscribed_qos:type_name -> magma.lte.M5GQosInformationRequest
+	88,  // 132: magma.lte.M5GSMSessionContext.qos_policy:type_name -> magma.lte.QosPolicy
+	37,  // 133: magma.lte.SetSMSessionContext.common_context:type_name -> magma.lte.CommonSessionContext
+	38,  // 134: magma.lte.SetSMSessionContext.rat_specific_context:type_name -> magma.lte.RatSpecificContext
+	100, // 135: magma.lte.RatSpecificContextAccess.m5g_session_context_rsp:type_name -> magma.lte.M5GSMSessionContextAccess
+	7,   // 136: magma.lte.M5GSMSessionContextAccess.pdu_session_type:type_name -> magma.lte.PduSessionType
+	8,   // 137: magma.lte.M5GSMSessionContextAccess.selected_ssc_mode:type_name -> magma.lte.SscMode
+	87,  // 138: magma.lte.M5GSMSessionContextAccess.authorized_qos_rules:type_name -> magma.lte.QosRules
+	75,  // 139: magma.lte.M5GSMSessionContextAccess.subscribed_qos:type_name -> magma.lte.M5GQosInformationRequest
+	9,   // 140: magma.lte.M5GSMSessionContextAccess.M5gsm_cause:type_name -> magma.lte.M5GSMCause
+	8,   // 141: magma.lte.M5GSMSessionContextAccess.allowed_ssc_mode:type_name -> magma.lte.SscMode
+	62,  // 142: magma.lte.M5GSMSessionContextAccess.pdu_address:type_name -> magma.lte.RedirectServer
+	39,  // 143: magma.lte.M5GSMSessionContextAccess.upf_endpoint:type_name -> magma.lte.TeidSet
+	88,  // 144: magma.lte.M5GSMSessionContextAccess.qos_policy:type_name -> magma.lte.QosPolicy
+	37,  // 145: magma.lte.SetSMSessionContextAccess.common_context:type_name -> magma.lte.CommonSessionContext
+	99,  // 146: magma.lte.SetSMSessionContextAccess.rat_specific_context:type_name -> magma.lte.RatSpecificContextAccess
+	104, // 147: magma.lte.UPFNodeState.associaton_state:type_name -> magma.lte.UPFAssociationState
+	106, // 148: magma.lte.UPFNodeState.node_report:type_name -> magma.lte.UPFNodeReport
+	32,  // 149: magma.lte.UPFAssociationState.assoc_state:type_name -> magma.lte.UPFAssociationState.AssociationState
+	116, // 150: magma.lte.UPFAssociationState.recovery_time_stamp:type_name -> google.protobuf.Timestamp
+	105, // 151: magma.lte.UPFAssociationState.feature_set:type_name -> magma.lte.UPFFeatureSet
+	103, // 152: magma.lte.UPFAssociationState.ip_resource_schema:type_name -> magma.lte.UserPlaneIPResourceSchema
+	116, // 153: magma.lte.UPFAssociationState.graceful_release_period:type_name -> google.protobuf.Timestamp
+	107, // 154: magma.lte.UPFNodeReport.load_control_info:type_name -> magma.lte.LoadControlInformation
+	109, // 155: magma.lte.UPFNodeReport.overload_control_info:type_name -> magma.lte.OverloadControlInformation
+	108, // 156: magma.lte.OverloadControlInformation.period_of_validity:type_name -> magma.lte.OverloadTimer
+	110, // 157: magma.lte.UPFSessionConfigState.upf_session_state:type_name -> magma.lte.UPFSessionState
+	19,  // 158: magma.lte.PolicyReAuthAnswer.FailedRulesEntry.value:type_name -> magma.lte.PolicyReAuthAnswer.FailureCode
+	34,  // 159: magma.lte.LocalSessionManager.ReportRuleStats:input_type -> magma.lte.RuleRecordTable
+	35,  // 160: magma.lte.LocalSessionManager.CreateSession:input_type -> magma.lte.LocalCreateSessionRequest
+	45,  // 161: magma.lte.LocalSessionManager.EndSession:input_type -> magma.lte.LocalEndSessionRequest
+	49,  // 162: magma.lte.LocalSessionManager.BindPolicy2Bearer:input_type -> magma.lte.PolicyBearerBindingRequest
+	58,  // 163: magma.lte.LocalSessionManager.SetSessionRules:input_type -> magma.lte.SessionRules
+	47,  // 164: magma.lte.LocalSessionManager.UpdateTunnelIds:input_type -> magma.lte.UpdateTunnelIdsRequest
+	51,  // 165: magma.lte.SessionProxyResponder.ChargingReAuth:input_type -> magma.lte.ChargingReAuthRequest
+	53,  // 166: magma.lte.SessionProxyResponder.PolicyReAuth:input_type -> magma.lte.PolicyReAuthRequest
+	77,  // 167: magma.lte.CentralSessionController.CreateSession:input_type -> magma.lte.CreateSessionRequest
+	81,  // 168: magma.lte.CentralSessionController.UpdateSession:input_type -> magma.lte.UpdateSessionRequest
+	84,  // 169: magma.lte.CentralSessionController.TerminateSession:input_type -> magma.lte.SessionTerminateRequest
+	102, // 170: magma.lte.SetInterfaceForUserPlane.SetUPFNodeState:input_type -> magma.lte.UPFNodeState
+	111, // 171: magma.lte.SetInterfaceForUserPlane.SetUPFSessionsConfig:input_type -> magma.lte.UPFSessionConfigState
+	112, // 172: magma.lte.SetInterfaceForUserPlane.SendPagingRequest:input_type -> magma.lte.UPFPagingInfo
+	97,  // 173: magma.lte.AmfPduSessionSmContext.SetAmfSessionContext:input_type -> magma.lte.SetSMSessionContext
+	41,  // 174: magma.lte.AmfPduSessionSmContext.SetSmfNotification:input_type -> magma.lte.SetSmNotificationContext
+	41,  // 175: magma.lte.SmfPduSessionSmContext.SetAmfNotification:input_type -> magma.lte.SetSmNotificationContext
+	101, // 176: magma.lte.SmfPduSessionSmContext.SetSmfSessionContext:input_type -> magma.lte.SetSMSessionContextAccess
+	118, // 177: magma.lte.LocalSessionManager.ReportRuleStats:output_type -> magma.orc8r.Void
+	44,  // 178: magma.lte.LocalSessionManager.CreateSession:output_type -> magma.lte.LocalCreateSessionResponse
+	46,  // 179: magma.lte.LocalSessionManager.EndSession:output_type -> magma.lte.LocalEndSessionResponse
+	50,  // 180: magma.lte.LocalSessionManager.BindPolicy2Bearer:output_type -> magma.lte.PolicyBearerBindingResponse
+	118, // 181: magma.lte.LocalSessionManager.SetSessionRules:output_type -> magma.orc8r.Void
+	48,  // 182: magma.lte.LocalSessionManager.UpdateTunnelIds:output_type -> magma.lte.UpdateTunnelIdsResponse
+	52,  // 183: magma.lte.SessionProxyResponder.ChargingReAuth:output_type -> magma.lte.ChargingReAuthAnswer
+	55,  // 184: magma.lte.SessionProxyResponder.PolicyReAuth:output_type -> magma.lte.PolicyReAuthAnswer
+	78,  // 185: magma.lte.CentralSessionController.CreateSession:output_type -> magma.lte.CreateSessionResponse
+	82,  // 186: magma.lte.CentralSessionController.UpdateSession:output_type -> magma.lte.UpdateSessionResponse
+	83,  // 187: magma.lte.CentralSessionController.TerminateSession:output_type -> magma.lte.SessionTerminateResponse
+	98,  // 188: magma.lte.SetInterfaceForUserPlane.SetUPFNodeState:output_type -> magma.lte.SmContextVoid
+	98,  // 189: magma.lte.SetInterfaceForUserPlane.SetUPFSessionsConfig:output_type -> magma.lte.SmContextVoid
+	98,  // 190: magma.lte.SetInterfaceForUserPlane.SendPagingRequest:output_type -> magma.lte.SmContextVoid
+	98,  // 191: magma.lte.AmfPduSessionSmContext.SetAmfSessionContext:output_type -> magma.lte.SmContextVoid
+	98,  // 192: magma.lte.AmfPduSessionSmContext.SetSmfNotification:output_type -> magma.lte.SmContextVoid
+	98,  // 193: magma.lte.SmfPduSessionSmContext.SetAmfNotification:output_type -> magma.lte.SmContextVoid
+	98,  // 194: magma.lte.SmfPduSessionSmContext.SetSmfSessionContext:output_type -> magma.lte.SmContextVoid
+	177, // [177:195] is the sub-list for method output_type
+	159, // [159:177] is the sub-list for method input_type
+	159, // [159:159] is the sub-list for extension type_name
+	159, // [159:159] is the sub-list for extension extendee
+	0,   // [0:159] is the sub-list for field type_name
 }
 
 func init() { file_lte_protos_session_manager_proto_init() }
@@ -10419,7 +10721,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestedUnits); i {
+			switch v := v.(*ChargingTriggers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10431,7 +10733,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreditUsage); i {
+			switch v := v.(*RequestedUnits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10443,7 +10745,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreditUsageUpdate); i {
+			switch v := v.(*CreditUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10455,7 +10757,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreditUpdateResponse); i {
+			switch v := v.(*CreditUsageUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10467,7 +10769,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsageMonitorUpdate); i {
+			switch v := v.(*CreditUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10479,7 +10781,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsageMonitoringCredit); i {
+			switch v := v.(*UsageMonitorUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10491,7 +10793,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsageMonitoringUpdateRequest); i {
+			switch v := v.(*UsageMonitoringCredit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10503,7 +10805,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsageMonitoringUpdateResponse); i {
+			switch v := v.(*UsageMonitoringUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10515,7 +10817,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QosInformationRequest); i {
+			switch v := v.(*ApplicationDetectionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10527,7 +10829,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*M5GQosInformationRequest); i {
+			switch v := v.(*UsageMonitoringUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10539,7 +10841,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TgppContext); i {
+			switch v := v.(*QosInformationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10551,7 +10853,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSessionRequest); i {
+			switch v := v.(*M5GQosInformationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10563,7 +10865,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSessionResponse); i {
+			switch v := v.(*TgppContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10575,7 +10877,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StaticRuleInstall); i {
+			switch v := v.(*CreateSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10587,7 +10889,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DynamicRuleInstall); i {
+			switch v := v.(*CreateSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10599,7 +10901,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSessionRequest); i {
+			switch v := v.(*StaticRuleInstall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10611,7 +10913,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSessionResponse); i {
+			switch v := v.(*DynamicRuleInstall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10623,7 +10925,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionTerminateResponse); i {
+			switch v := v.(*UpdateSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10635,7 +10937,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionTerminateRequest); i {
+			switch v := v.(*UpdateSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10647,7 +10949,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeID); i {
+			switch v := v.(*SessionTerminateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10659,7 +10961,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*M5GSMCapability); i {
+			switch v := v.(*SessionTerminateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10671,7 +10973,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QosRules); i {
+			switch v := v.(*NodeID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10683,7 +10985,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QosPolicy); i {
+			switch v := v.(*M5GSMCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10695,7 +10997,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllocationAndRetentionPrio); i {
+			switch v := v.(*QosRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10707,7 +11009,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QosCharacteristics); i {
+			switch v := v.(*QosPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10719,7 +11021,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlowLevelParams); i {
+			switch v := v.(*AllocationAndRetentionPrio); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10731,7 +11033,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QosFlow); i {
+			switch v := v.(*QosCharacteristics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10743,7 +11045,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QosFlowRequestList); i {
+			switch v := v.(*FlowLevelParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10755,7 +11057,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LadnServiceArea); i {
+			switch v := v.(*QosFlow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10767,7 +11069,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackingAreaIdentityList); i {
+			switch v := v.(*QosFlowRequestList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10779,7 +11081,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*M5GSMSessionContext); i {
+			switch v := v.(*LadnServiceArea); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10791,7 +11093,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSMSessionContext); i {
+			switch v := v.(*TrackingAreaIdentityList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10803,7 +11105,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmContextVoid); i {
+			switch v := v.(*M5GSMSessionContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10815,7 +11117,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RatSpecificContextAccess); i {
+			switch v := v.(*SetSMSessionContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10827,7 +11129,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*M5GSMSessionContextAccess); i {
+			switch v := v.(*SmContextVoid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10839,7 +11141,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSMSessionContextAccess); i {
+			switch v := v.(*RatSpecificContextAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10851,7 +11153,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPFNodeState); i {
+			switch v := v.(*M5GSMSessionContextAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10863,7 +11165,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPlaneIPResourceSchema); i {
+			switch v := v.(*SetSMSessionContextAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10875,7 +11177,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPFAssociationState); i {
+			switch v := v.(*UPFNodeState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10887,7 +11189,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPFFeatureSet); i {
+			switch v := v.(*UserPlaneIPResourceSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10899,7 +11201,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPFNodeReport); i {
+			switch v := v.(*UPFAssociationState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10911,7 +11213,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadControlInformation); i {
+			switch v := v.(*UPFFeatureSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10923,7 +11225,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OverloadTimer); i {
+			switch v := v.(*UPFNodeReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10935,7 +11237,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OverloadControlInformation); i {
+			switch v := v.(*LoadControlInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10947,7 +11249,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPFSessionState); i {
+			switch v := v.(*OverloadTimer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10959,7 +11261,7 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPFSessionConfigState); i {
+			switch v := v.(*OverloadControlInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10971,6 +11273,30 @@ func file_lte_protos_session_manager_proto_init() {
 			}
 		}
 		file_lte_protos_session_manager_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UPFSessionState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_protos_session_manager_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UPFSessionConfigState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_protos_session_manager_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UPFPagingInfo); i {
 			case 0:
 				return &v.state
@@ -10988,7 +11314,7 @@ func file_lte_protos_session_manager_proto_init() {
 		(*RatSpecificContext_WlanContext)(nil),
 		(*RatSpecificContext_M5GsmSessionContext)(nil),
 	}
-	file_lte_protos_session_manager_proto_msgTypes[67].OneofWrappers = []interface{}{
+	file_lte_protos_session_manager_proto_msgTypes[69].OneofWrappers = []interface{}{
 		(*UPFNodeState_AssociatonState)(nil),
 		(*UPFNodeState_NodeReport)(nil),
 	}
@@ -10997,8 +11323,8 @@ func file_lte_protos_session_manager_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lte_protos_session_manager_proto_rawDesc,
-			NumEnums:      32,
-			NumMessages:   79,
+			NumEnums:      33,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   6,
 		},
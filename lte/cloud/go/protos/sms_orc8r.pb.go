@@ -193,6 +193,10 @@ type ReportDeliveryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// NAS messages to send back to the UE in response to the uplink, e.g. the
+	// acknowledgement of a mobile-originated SMS
+	Responses []*SMODownlinkUnitdata `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
 func (x *ReportDeliveryResponse) Reset() {
@@ -227,6 +231,13 @@ func (*ReportDeliveryResponse) Descriptor() ([]byte, []int) {
 	return file_lte_protos_sms_orc8r_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *ReportDeliveryResponse) GetResponses() []*SMODownlinkUnitdata {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
 type ReportDeliveryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -396,46 +407,50 @@ var file_lte_protos_sms_orc8r_proto_rawDesc = []byte{
 	0x62, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x61, 0x73, 0x73,
 	0x6d, 0x61, 0x72, 0x6b, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x69, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x03, 0x74, 0x61, 0x69, 0x12, 0x13, 0x0a, 0x05, 0x65, 0x5f, 0x63, 0x67, 0x69,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x65, 0x43, 0x67, 0x69, 0x22, 0x18, 0x0a, 0x16,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x65, 0x43, 0x67, 0x69, 0x22, 0x56, 0x0a, 0x16,
 	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x34, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x4d, 0x4f, 0x55,
-	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6d, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x73, 0x69,
-	0x73, 0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x4d, 0x4f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x32, 0x51, 0x0a, 0x0f, 0x53, 0x4d, 0x53, 0x4f, 0x72, 0x63, 0x38, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x53, 0x4d, 0x4f, 0x55, 0x70,
-	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
-	0x2e, 0x53, 0x4d, 0x4f, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61,
-	0x74, 0x61, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0x5c, 0x0a, 0x16, 0x53, 0x4d, 0x53, 0x4f, 0x72,
-	0x63, 0x38, 0x72, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x42, 0x0a, 0x0b, 0x53, 0x4d, 0x4f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x4d, 0x4f,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x4d, 0x4f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x4d, 0x4f, 0x55, 0x70, 0x6c,
+	0x69, 0x6e, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x22, 0x2a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x73,
+	0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x73, 0x69, 0x73, 0x22,
+	0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x4d, 0x4f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x32, 0x51, 0x0a, 0x0f, 0x53, 0x4d, 0x53, 0x4f, 0x72, 0x63, 0x38, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x53, 0x4d, 0x4f, 0x55, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53,
+	0x4d, 0x4f, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61,
 	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0xaf, 0x01, 0x0a, 0x04, 0x53, 0x6d, 0x73, 0x44, 0x12, 0x57,
-	0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x12, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
-	0x74, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2f, 0x6c, 0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0x5c, 0x0a, 0x16, 0x53, 0x4d, 0x53, 0x4f, 0x72, 0x63, 0x38,
+	0x72, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x42, 0x0a, 0x0b, 0x53, 0x4d, 0x4f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1e,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x53, 0x4d, 0x4f, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x11,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x32, 0xaf, 0x01, 0x0a, 0x04, 0x53, 0x6d, 0x73, 0x44, 0x12, 0x57, 0x0a, 0x0e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x20,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x6c,
+	0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -461,21 +476,22 @@ var file_lte_protos_sms_orc8r_proto_goTypes = []interface{}{
 	(*protos.Void)(nil),            // 6: magma.orc8r.Void
 }
 var file_lte_protos_sms_orc8r_proto_depIdxs = []int32{
-	1, // 0: magma.lte.ReportDeliveryRequest.report:type_name -> magma.lte.SMOUplinkUnitdata
-	0, // 1: magma.lte.GetMessagesResponse.messages:type_name -> magma.lte.SMODownlinkUnitdata
-	1, // 2: magma.lte.SMSOrc8rService.SMOUplink:input_type -> magma.lte.SMOUplinkUnitdata
-	0, // 3: magma.lte.SMSOrc8rGatewayService.SMODownlink:input_type -> magma.lte.SMODownlinkUnitdata
-	3, // 4: magma.lte.SmsD.ReportDelivery:input_type -> magma.lte.ReportDeliveryRequest
-	4, // 5: magma.lte.SmsD.GetMessages:input_type -> magma.lte.GetMessagesRequest
-	6, // 6: magma.lte.SMSOrc8rService.SMOUplink:output_type -> magma.orc8r.Void
-	6, // 7: magma.lte.SMSOrc8rGatewayService.SMODownlink:output_type -> magma.orc8r.Void
-	2, // 8: magma.lte.SmsD.ReportDelivery:output_type -> magma.lte.ReportDeliveryResponse
-	5, // 9: magma.lte.SmsD.GetMessages:output_type -> magma.lte.GetMessagesResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: magma.lte.ReportDeliveryResponse.responses:type_name -> magma.lte.SMODownlinkUnitdata
+	1, // 1: magma.lte.ReportDeliveryRequest.report:type_name -> magma.lte.SMOUplinkUnitdata
+	0, // 2: magma.lte.GetMessagesResponse.messages:type_name -> magma.lte.SMODownlinkUnitdata
+	1, // 3: magma.lte.SMSOrc8rService.SMOUplink:input_type -> magma.lte.SMOUplinkUnitdata
+	0, // 4: magma.lte.SMSOrc8rGatewayService.SMODownlink:input_type -> magma.lte.SMODownlinkUnitdata
+	3, // 5: magma.lte.SmsD.ReportDelivery:input_type -> magma.lte.ReportDeliveryRequest
+	4, // 6: magma.lte.SmsD.GetMessages:input_type -> magma.lte.GetMessagesRequest
+	6, // 7: magma.lte.SMSOrc8rService.SMOUplink:output_type -> magma.orc8r.Void
+	6, // 8: magma.lte.SMSOrc8rGatewayService.SMODownlink:output_type -> magma.orc8r.Void
+	2, // 9: magma.lte.SmsD.ReportDelivery:output_type -> magma.lte.ReportDeliveryResponse
+	5, // 10: magma.lte.SmsD.GetMessages:output_type -> magma.lte.GetMessagesResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_lte_protos_sms_orc8r_proto_init() }
@@ -32,6 +32,10 @@ var (
 		Name: "pu_requests_total",
 		Help: "Total number of PURs received",
 	})
+	NORequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "no_requests_total",
+		Help: "Total number of NORs received",
+	})
 	InvalidRequests = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "invalid_request_total",
 		Help: "Total number of requests which did not contain the correct data",
@@ -86,6 +90,7 @@ func init() {
 		AIRequests,
 		ULRequests,
 		PURequests,
+		NORequests,
 		InvalidRequests,
 		NetworkIDErrors,
 		ConfigErrors,
@@ -67,6 +67,10 @@ func (suite *EpsAuthTestSuite) PurgeUE(purge *fegprotos.PurgeUERequest) (*fegpro
 	return suite.Server.PurgeUE(getTestContext(), purge)
 }
 
+func (suite *EpsAuthTestSuite) Notify(notify *fegprotos.NotifyRequest) (*fegprotos.NotifyAnswer, error) {
+	return suite.Server.Notify(getTestContext(), notify)
+}
+
 func (*EpsAuthTestSuite) SetupTest() {
 }
 
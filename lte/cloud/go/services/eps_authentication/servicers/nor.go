@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"errors"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/cloud/go/protos"
+	"magma/lte/cloud/go/services/eps_authentication/metrics"
+	"magma/orc8r/cloud/go/identity"
+)
+
+// Notify acknowledges the NOR of a known subscriber, the PGW and SMS
+// reachability reported by the MME are not stored.
+func (srv *EPSAuthServer) Notify(ctx context.Context, notify *protos.NotifyRequest) (*protos.NotifyAnswer, error) {
+	glog.V(2).Infof("received NOR from: %s", notify.GetUserName())
+	metrics.NORequests.Inc()
+	if err := validateNOR(notify); err != nil {
+		glog.V(2).Infof("NOR is invalid: %v", err.Error())
+		metrics.InvalidRequests.Inc()
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	networkID, err := identity.GetClientNetworkID(ctx)
+	if err != nil {
+		glog.V(2).Infof("could not lookup networkID: %v", err.Error())
+		metrics.NetworkIDErrors.Inc()
+		return nil, err
+	}
+	_, errorCode, err := srv.lookupSubscriber(notify.UserName, networkID)
+	if err != nil {
+		glog.V(2).Infof("failed to lookup subscriber '%s': %v", notify.UserName, err.Error())
+		metrics.UnknownSubscribers.Inc()
+		return &protos.NotifyAnswer{ErrorCode: errorCode}, err
+	}
+	return &protos.NotifyAnswer{ErrorCode: protos.ErrorCode_SUCCESS}, nil
+}
+
+// validateNOR returns an error iff the NOR is invalid.
+func validateNOR(notify *protos.NotifyRequest) error {
+	if notify == nil {
+		return errors.New("received a nil NotifyRequest")
+	}
+	if len(notify.UserName) == 0 {
+		return errors.New("user name was empty")
+	}
+	return nil
+}
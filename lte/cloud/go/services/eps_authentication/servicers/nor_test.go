@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import "magma/feg/cloud/go/protos"
+
+func (suite *EpsAuthTestSuite) TestNotify_UnknownSubscriber() {
+	notify := &protos.NotifyRequest{UserName: "sub_unknown"}
+	answer, err := suite.Notify(notify)
+	suite.EqualError(
+		err,
+		"rpc error: code = NotFound desc = error loading subscriber ent for network ID: test, SID: sub_unknown: Not found")
+	suite.Equal(protos.ErrorCode_USER_UNKNOWN, answer.ErrorCode)
+}
+
+func (suite *EpsAuthTestSuite) TestNotify_EmptyUserName() {
+	_, err := suite.Notify(&protos.NotifyRequest{})
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = user name was empty")
+}
+
+func (suite *EpsAuthTestSuite) TestNotify_Success() {
+	notify := &protos.NotifyRequest{UserName: "sub1", ContextId: 1, ServiceSelection: "apn1", UeReachableFromMme: true}
+	answer, err := suite.Notify(notify)
+	suite.NoError(err)
+	suite.Equal(protos.ErrorCode_SUCCESS, answer.ErrorCode)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ha
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/lte/cloud/go/services/ha/protos"
+	"magma/orc8r/lib/go/merrors"
+	lib_protos "magma/orc8r/lib/go/protos"
+	"magma/orc8r/lib/go/registry"
+)
+
+// GetGatewayPoolAssignment returns the current eNodeB assignment of an
+// active-active gateway pool.
+// Returns merrors.ErrNotFound if the pool hasn't been assigned yet.
+func GetGatewayPoolAssignment(ctx context.Context, networkID string, gatewayPoolID string) (*protos.GatewayPoolAssignment, error) {
+	client, err := getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetGatewayPoolAssignment(
+		ctx,
+		&protos.GetGatewayPoolAssignmentRequest{
+			NetworkId:     networkID,
+			GatewayPoolId: gatewayPoolID,
+		},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, merrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.Assignment, nil
+}
+
+func getClient() (protos.GatewayPoolAssignmentLookupClient, error) {
+	conn, err := registry.GetConnection(ServiceName, lib_protos.ServiceType_PROTECTED)
+	if err != nil {
+		initErr := merrors.NewInitError(err, ServiceName)
+		glog.Error(initErr)
+		return nil, initErr
+	}
+	return protos.NewGatewayPoolAssignmentLookupClient(conn), nil
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ha
+
+import (
+	"fmt"
+	"time"
+)
+
+type Config struct {
+	// RebalanceIntervalSecs is the time interval between each rebalance of
+	// the eNodeBs of active-active gateway pools. Member failures and
+	// recoveries are detected at this granularity.
+	RebalanceIntervalSecs int `yaml:"rebalanceIntervalSecs"`
+}
+
+func (config Config) Validate() error {
+	if config.RebalanceIntervalSecs <= 0 {
+		return fmt.Errorf("invalid rebalance interval")
+	}
+	return nil
+}
+
+func (config Config) GetRebalanceInterval() time.Duration {
+	return time.Duration(config.RebalanceIntervalSecs) * time.Second
+}
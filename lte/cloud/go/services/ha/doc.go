@@ -28,5 +28,15 @@ configured ENBs and then checks the following state:
 
 The service then sends back the ENB ID -> offload state
 for all of these.
+
+Gateway pools configured in active_active mode have no fixed primaries.
+Instead, a rebalancer periodically distributes the pool's ENBs across its
+members in proportion to their MME relative capacity, and the primary of an
+ENB is the member it is currently assigned to. A member's health only
+changes once its checkin status has disagreed with it for the pool's
+rebalance hysteresis, and ENBs stay on their assigned member where possible,
+so failures and recoveries only move the ENBs needed to restore the
+distribution. The current assignment of each pool is exposed to the REST API
+through the service's protected GatewayPoolAssignmentLookup servicer.
 */
 package ha
@@ -19,8 +19,15 @@ import (
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/ha"
+	ha_protos "magma/lte/cloud/go/services/ha/protos"
+	"magma/lte/cloud/go/services/ha/rebalancer"
+	protected_servicers "magma/lte/cloud/go/services/ha/servicers/protected"
 	servicers "magma/lte/cloud/go/services/ha/servicers/southbound"
+	ha_storage "magma/lte/cloud/go/services/ha/storage"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/service/config"
 )
 
 func main() {
@@ -28,8 +35,28 @@ func main() {
 	if err != nil {
 		glog.Fatalf("Error creating had service: %s", err)
 	}
-	servicer := servicers.NewHAServicer()
+
+	var serviceConfig ha.Config
+	config.MustGetStructuredServiceConfig(lte.ModuleName, ha.ServiceName, &serviceConfig)
+	if err := serviceConfig.Validate(); err != nil {
+		glog.Fatalf("Invalid ha service configs: %v", err)
+	}
+
+	// Init storage
+	db, err := sqorc.Open(storage.GetSQLDriver(), storage.GetDatabaseSource())
+	if err != nil {
+		glog.Fatalf("Error opening db connection: %v", err)
+	}
+	store := ha_storage.NewAssignmentStore(db, sqorc.GetSqlBuilder())
+	if err := store.Initialize(); err != nil {
+		glog.Fatalf("Error initializing gateway pool assignment storage: %v", err)
+	}
+
+	servicer := servicers.NewHAServicer(store)
 	protos.RegisterHaServer(srv.GrpcServer, servicer)
+	ha_protos.RegisterGatewayPoolAssignmentLookupServer(srv.ProtectedGrpcServer, protected_servicers.NewLookupServicer(store))
+
+	go rebalancer.NewRebalancer(store).Run(serviceConfig)
 
 	err = srv.Run()
 	if err != nil {
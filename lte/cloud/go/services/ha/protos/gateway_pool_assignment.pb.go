@@ -0,0 +1,553 @@
+//
+//Copyright 2020 The Magma Authors.
+//
+//This source code is licensed under the BSD-style license found in the
+//LICENSE file in the root directory of this source tree.
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.10.0
+// source: lte/cloud/go/services/ha/protos/gateway_pool_assignment.proto
+
+package protos
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetGatewayPoolAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId     string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	GatewayPoolId string `protobuf:"bytes,2,opt,name=gateway_pool_id,json=gatewayPoolId,proto3" json:"gateway_pool_id,omitempty"`
+}
+
+func (x *GetGatewayPoolAssignmentRequest) Reset() {
+	*x = GetGatewayPoolAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGatewayPoolAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayPoolAssignmentRequest) ProtoMessage() {}
+
+func (x *GetGatewayPoolAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayPoolAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*GetGatewayPoolAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGatewayPoolAssignmentRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *GetGatewayPoolAssignmentRequest) GetGatewayPoolId() string {
+	if x != nil {
+		return x.GatewayPoolId
+	}
+	return ""
+}
+
+type GetGatewayPoolAssignmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignment *GatewayPoolAssignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+}
+
+func (x *GetGatewayPoolAssignmentResponse) Reset() {
+	*x = GetGatewayPoolAssignmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGatewayPoolAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayPoolAssignmentResponse) ProtoMessage() {}
+
+func (x *GetGatewayPoolAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayPoolAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*GetGatewayPoolAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGatewayPoolAssignmentResponse) GetAssignment() *GatewayPoolAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+// GatewayPoolAssignment is the distribution of an active-active gateway
+// pool's eNodeBs across its members.
+type GatewayPoolAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// members ordered by gateway ID
+	Members []*GatewayPoolAssignment_Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// eNodeBs which can't be assigned since no member is healthy
+	UnassignedEnodebSerials []string `protobuf:"bytes,2,rep,name=unassigned_enodeb_serials,json=unassignedEnodebSerials,proto3" json:"unassigned_enodeb_serials,omitempty"`
+	// unix time (ms) an eNodeB was last reassigned
+	LastRebalanceTime int64 `protobuf:"varint,3,opt,name=last_rebalance_time,json=lastRebalanceTime,proto3" json:"last_rebalance_time,omitempty"`
+}
+
+func (x *GatewayPoolAssignment) Reset() {
+	*x = GatewayPoolAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayPoolAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayPoolAssignment) ProtoMessage() {}
+
+func (x *GatewayPoolAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayPoolAssignment.ProtoReflect.Descriptor instead.
+func (*GatewayPoolAssignment) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GatewayPoolAssignment) GetMembers() []*GatewayPoolAssignment_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GatewayPoolAssignment) GetUnassignedEnodebSerials() []string {
+	if x != nil {
+		return x.UnassignedEnodebSerials
+	}
+	return nil
+}
+
+func (x *GatewayPoolAssignment) GetLastRebalanceTime() int64 {
+	if x != nil {
+		return x.LastRebalanceTime
+	}
+	return 0
+}
+
+type GatewayPoolAssignment_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	// eNodeBs are distributed across healthy members in proportion to their
+	// relative capacity
+	RelativeCapacity uint32 `protobuf:"varint,2,opt,name=relative_capacity,json=relativeCapacity,proto3" json:"relative_capacity,omitempty"`
+	// health of the member after hysteresis is applied
+	Healthy bool `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// unix time (ms) healthy last changed
+	HealthChangeTime int64 `protobuf:"varint,4,opt,name=health_change_time,json=healthChangeTime,proto3" json:"health_change_time,omitempty"`
+	// unix time (ms) the member's checkin status started to disagree with
+	// healthy, 0 while they agree
+	HealthPendingTime int64 `protobuf:"varint,5,opt,name=health_pending_time,json=healthPendingTime,proto3" json:"health_pending_time,omitempty"`
+	// serial numbers of the eNodeBs assigned to the member
+	EnodebSerials []string `protobuf:"bytes,6,rep,name=enodeb_serials,json=enodebSerials,proto3" json:"enodeb_serials,omitempty"`
+}
+
+func (x *GatewayPoolAssignment_Member) Reset() {
+	*x = GatewayPoolAssignment_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayPoolAssignment_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayPoolAssignment_Member) ProtoMessage() {}
+
+func (x *GatewayPoolAssignment_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayPoolAssignment_Member.ProtoReflect.Descriptor instead.
+func (*GatewayPoolAssignment_Member) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *GatewayPoolAssignment_Member) GetGatewayId() string {
+	if x != nil {
+		return x.GatewayId
+	}
+	return ""
+}
+
+func (x *GatewayPoolAssignment_Member) GetRelativeCapacity() uint32 {
+	if x != nil {
+		return x.RelativeCapacity
+	}
+	return 0
+}
+
+func (x *GatewayPoolAssignment_Member) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *GatewayPoolAssignment_Member) GetHealthChangeTime() int64 {
+	if x != nil {
+		return x.HealthChangeTime
+	}
+	return 0
+}
+
+func (x *GatewayPoolAssignment_Member) GetHealthPendingTime() int64 {
+	if x != nil {
+		return x.HealthPendingTime
+	}
+	return 0
+}
+
+func (x *GatewayPoolAssignment_Member) GetEnodebSerials() []string {
+	if x != nil {
+		return x.EnodebSerials
+	}
+	return nil
+}
+
+var File_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto protoreflect.FileDescriptor
+
+var file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDesc = []byte{
+	0x0a, 0x3d, 0x6c, 0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0c, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x68, 0x61, 0x22, 0x68, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x68, 0x61, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0xbf, 0x03, 0x0a, 0x15, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x68, 0x61, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x3a, 0x0a, 0x19, 0x75, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x6f, 0x64, 0x65, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x17, 0x75, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45,
+	0x6e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xf3, 0x01, 0x0a,
+	0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x2c, 0x0a,
+	0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x6e, 0x6f, 0x64, 0x65, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x73, 0x32, 0x9a, 0x01, 0x0a, 0x1b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f,
+	0x6f, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x12, 0x7b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x68, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x27, 0x5a, 0x25, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x6c, 0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x68,
+	0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescOnce sync.Once
+	file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescData = file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDesc
+)
+
+func file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescGZIP() []byte {
+	file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescOnce.Do(func() {
+		file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescData = protoimpl.X.CompressGZIP(file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescData)
+	})
+	return file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDescData
+}
+
+var file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_goTypes = []interface{}{
+	(*GetGatewayPoolAssignmentRequest)(nil),  // 0: magma.lte.ha.GetGatewayPoolAssignmentRequest
+	(*GetGatewayPoolAssignmentResponse)(nil), // 1: magma.lte.ha.GetGatewayPoolAssignmentResponse
+	(*GatewayPoolAssignment)(nil),            // 2: magma.lte.ha.GatewayPoolAssignment
+	(*GatewayPoolAssignment_Member)(nil),     // 3: magma.lte.ha.GatewayPoolAssignment.Member
+}
+var file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_depIdxs = []int32{
+	2, // 0: magma.lte.ha.GetGatewayPoolAssignmentResponse.assignment:type_name -> magma.lte.ha.GatewayPoolAssignment
+	3, // 1: magma.lte.ha.GatewayPoolAssignment.members:type_name -> magma.lte.ha.GatewayPoolAssignment.Member
+	0, // 2: magma.lte.ha.GatewayPoolAssignmentLookup.GetGatewayPoolAssignment:input_type -> magma.lte.ha.GetGatewayPoolAssignmentRequest
+	1, // 3: magma.lte.ha.GatewayPoolAssignmentLookup.GetGatewayPoolAssignment:output_type -> magma.lte.ha.GetGatewayPoolAssignmentResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_init() }
+func file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_init() {
+	if File_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGatewayPoolAssignmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGatewayPoolAssignmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayPoolAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayPoolAssignment_Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_goTypes,
+		DependencyIndexes: file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_depIdxs,
+		MessageInfos:      file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_msgTypes,
+	}.Build()
+	File_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto = out.File
+	file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_rawDesc = nil
+	file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_goTypes = nil
+	file_lte_cloud_go_services_ha_protos_gateway_pool_assignment_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// GatewayPoolAssignmentLookupClient is the client API for GatewayPoolAssignmentLookup service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GatewayPoolAssignmentLookupClient interface {
+	// GetGatewayPoolAssignment returns the assignment of a gateway pool.
+	GetGatewayPoolAssignment(ctx context.Context, in *GetGatewayPoolAssignmentRequest, opts ...grpc.CallOption) (*GetGatewayPoolAssignmentResponse, error)
+}
+
+type gatewayPoolAssignmentLookupClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGatewayPoolAssignmentLookupClient(cc grpc.ClientConnInterface) GatewayPoolAssignmentLookupClient {
+	return &gatewayPoolAssignmentLookupClient{cc}
+}
+
+func (c *gatewayPoolAssignmentLookupClient) GetGatewayPoolAssignment(ctx context.Context, in *GetGatewayPoolAssignmentRequest, opts ...grpc.CallOption) (*GetGatewayPoolAssignmentResponse, error) {
+	out := new(GetGatewayPoolAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.ha.GatewayPoolAssignmentLookup/GetGatewayPoolAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayPoolAssignmentLookupServer is the server API for GatewayPoolAssignmentLookup service.
+type GatewayPoolAssignmentLookupServer interface {
+	// GetGatewayPoolAssignment returns the assignment of a gateway pool.
+	GetGatewayPoolAssignment(context.Context, *GetGatewayPoolAssignmentRequest) (*GetGatewayPoolAssignmentResponse, error)
+}
+
+// UnimplementedGatewayPoolAssignmentLookupServer can be embedded to have forward compatible implementations.
+type UnimplementedGatewayPoolAssignmentLookupServer struct {
+}
+
+func (*UnimplementedGatewayPoolAssignmentLookupServer) GetGatewayPoolAssignment(context.Context, *GetGatewayPoolAssignmentRequest) (*GetGatewayPoolAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayPoolAssignment not implemented")
+}
+
+func RegisterGatewayPoolAssignmentLookupServer(s *grpc.Server, srv GatewayPoolAssignmentLookupServer) {
+	s.RegisterService(&_GatewayPoolAssignmentLookup_serviceDesc, srv)
+}
+
+func _GatewayPoolAssignmentLookup_GetGatewayPoolAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayPoolAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayPoolAssignmentLookupServer).GetGatewayPoolAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.ha.GatewayPoolAssignmentLookup/GetGatewayPoolAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayPoolAssignmentLookupServer).GetGatewayPoolAssignment(ctx, req.(*GetGatewayPoolAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GatewayPoolAssignmentLookup_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.lte.ha.GatewayPoolAssignmentLookup",
+	HandlerType: (*GatewayPoolAssignmentLookupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGatewayPoolAssignment",
+			Handler:    _GatewayPoolAssignmentLookup_GetGatewayPoolAssignment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lte/cloud/go/services/ha/protos/gateway_pool_assignment.proto",
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalancer
+
+import (
+	"sort"
+	"time"
+
+	"magma/lte/cloud/go/services/ha/protos"
+)
+
+// Member is the current state of a gateway in an active-active pool.
+type Member struct {
+	GatewayID        string
+	RelativeCapacity uint32
+	// CheckinValid is true if the gateway has recently checked in.
+	CheckinValid bool
+}
+
+// Assign distributes the eNodeBs of a gateway pool across its members, in
+// proportion to their relative capacity.
+//
+// Member health is taken from the previous assignment, and only changes once
+// a member's checkin status has disagreed with it for at least the
+// hysteresis duration.
+// eNodeBs stay on their previously assigned member where possible, so only
+// the eNodeBs needed to restore the capacity-weighted distribution are moved
+// when a member fails or recovers. If no member is healthy, eNodeBs keep
+// their previous assignment.
+func Assign(prev *protos.GatewayPoolAssignment, members []Member, enodebSerials []string, now time.Time, hysteresis time.Duration) *protos.GatewayPoolAssignment {
+	nowMs := now.UnixNano() / int64(time.Millisecond)
+	hysteresisMs := int64(hysteresis / time.Millisecond)
+
+	prevMembers := map[string]*protos.GatewayPoolAssignment_Member{}
+	prevOwners := map[string]string{}
+	for _, member := range prev.GetMembers() {
+		prevMembers[member.GatewayId] = member
+		for _, enb := range member.EnodebSerials {
+			prevOwners[enb] = member.GatewayId
+		}
+	}
+
+	ret := &protos.GatewayPoolAssignment{LastRebalanceTime: prev.GetLastRebalanceTime()}
+	assigned := map[string]*protos.GatewayPoolAssignment_Member{}
+	var healthy []*protos.GatewayPoolAssignment_Member
+	for _, m := range members {
+		member := updateHealth(prevMembers[m.GatewayID], m, nowMs, hysteresisMs)
+		ret.Members = append(ret.Members, member)
+		assigned[member.GatewayId] = member
+		if member.Healthy {
+			healthy = append(healthy, member)
+		}
+	}
+	sort.Slice(ret.Members, func(i, j int) bool { return ret.Members[i].GatewayId < ret.Members[j].GatewayId })
+	sort.Slice(healthy, func(i, j int) bool { return healthy[i].GatewayId < healthy[j].GatewayId })
+
+	enbs := append([]string{}, enodebSerials...)
+	sort.Strings(enbs)
+
+	var toAssign []string
+	if len(healthy) == 0 {
+		// Nowhere to move eNodeBs to, so leave them where they were
+		for _, enb := range enbs {
+			if member, ok := assigned[prevOwners[enb]]; ok {
+				member.EnodebSerials = append(member.EnodebSerials, enb)
+			} else {
+				ret.UnassignedEnodebSerials = append(ret.UnassignedEnodebSerials, enb)
+			}
+		}
+	} else {
+		quotas := getQuotas(healthy, len(enbs))
+		for _, enb := range enbs {
+			member, ok := assigned[prevOwners[enb]]
+			if ok && member.Healthy && len(member.EnodebSerials) < quotas[member.GatewayId] {
+				member.EnodebSerials = append(member.EnodebSerials, enb)
+			} else {
+				toAssign = append(toAssign, enb)
+			}
+		}
+		for _, enb := range toAssign {
+			target := healthy[0]
+			for _, member := range healthy[1:] {
+				if quotas[member.GatewayId]-len(member.EnodebSerials) > quotas[target.GatewayId]-len(target.EnodebSerials) {
+					target = member
+				}
+			}
+			target.EnodebSerials = append(target.EnodebSerials, enb)
+		}
+	}
+	for _, member := range ret.Members {
+		sort.Strings(member.EnodebSerials)
+	}
+
+	for _, member := range ret.Members {
+		for _, enb := range member.EnodebSerials {
+			if prevOwners[enb] != member.GatewayId {
+				ret.LastRebalanceTime = nowMs
+			}
+		}
+	}
+	return ret
+}
+
+func updateHealth(prev *protos.GatewayPoolAssignment_Member, m Member, nowMs int64, hysteresisMs int64) *protos.GatewayPoolAssignment_Member {
+	member := &protos.GatewayPoolAssignment_Member{GatewayId: m.GatewayID, RelativeCapacity: m.RelativeCapacity}
+	switch {
+	case prev == nil:
+		// New members start out with their current checkin status
+		member.Healthy, member.HealthChangeTime = m.CheckinValid, nowMs
+	case prev.Healthy == m.CheckinValid:
+		member.Healthy, member.HealthChangeTime = prev.Healthy, prev.HealthChangeTime
+	default:
+		member.Healthy, member.HealthChangeTime, member.HealthPendingTime = prev.Healthy, prev.HealthChangeTime, prev.HealthPendingTime
+		if member.HealthPendingTime == 0 {
+			member.HealthPendingTime = nowMs
+		}
+		if nowMs-member.HealthPendingTime >= hysteresisMs {
+			member.Healthy, member.HealthChangeTime, member.HealthPendingTime = m.CheckinValid, nowMs, 0
+		}
+	}
+	return member
+}
+
+// getQuotas returns the number of eNodeBs each member should serve, in
+// proportion to their relative capacity. Remainders are allocated by the
+// largest remainder method. If every member has zero capacity, they're
+// weighted equally.
+func getQuotas(members []*protos.GatewayPoolAssignment_Member, numEnbs int) map[string]int {
+	weights := make([]int, len(members))
+	total := 0
+	for i, member := range members {
+		weights[i] = int(member.RelativeCapacity)
+		total += weights[i]
+	}
+	if total == 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+		total = len(weights)
+	}
+
+	quotas := map[string]int{}
+	remaining := numEnbs
+	order := make([]int, len(members))
+	for i, member := range members {
+		quotas[member.GatewayId] = numEnbs * weights[i] / total
+		remaining -= quotas[member.GatewayId]
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		ri, rj := numEnbs*weights[order[i]]%total, numEnbs*weights[order[j]]%total
+		if ri != rj {
+			return ri > rj
+		}
+		return weights[order[i]] > weights[order[j]]
+	})
+	for _, i := range order[:remaining] {
+		quotas[members[i].GatewayId]++
+	}
+	return quotas
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalancer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/services/ha/protos"
+	"magma/lte/cloud/go/services/ha/rebalancer"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+func TestAssign_Distribution(t *testing.T) {
+	now := time.Unix(1000, 0)
+	nowMs := int64(1000000)
+	members := []rebalancer.Member{
+		{GatewayID: "g2", RelativeCapacity: 1, CheckinValid: true},
+		{GatewayID: "g1", RelativeCapacity: 2, CheckinValid: true},
+		{GatewayID: "g3", RelativeCapacity: 0, CheckinValid: true},
+	}
+	enbs := []string{"enb5", "enb4", "enb3", "enb2", "enb1"}
+
+	actual := rebalancer.Assign(nil, members, enbs, now, time.Minute)
+	expected := &protos.GatewayPoolAssignment{
+		Members: []*protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 2, Healthy: true, HealthChangeTime: nowMs, EnodebSerials: []string{"enb1", "enb2", "enb4"}},
+			{GatewayId: "g2", RelativeCapacity: 1, Healthy: true, HealthChangeTime: nowMs, EnodebSerials: []string{"enb3", "enb5"}},
+			{GatewayId: "g3", Healthy: true, HealthChangeTime: nowMs},
+		},
+		LastRebalanceTime: nowMs,
+	}
+	test_utils.AssertMessagesEqual(t, expected, actual)
+
+	// Unchanged inputs result in an unchanged assignment
+	later := now.Add(time.Hour)
+	actual = rebalancer.Assign(expected, members, enbs, later, time.Minute)
+	test_utils.AssertMessagesEqual(t, expected, actual)
+
+	// Zero capacities are weighted equally
+	members = []rebalancer.Member{
+		{GatewayID: "g1", CheckinValid: true},
+		{GatewayID: "g2", CheckinValid: true},
+	}
+	actual = rebalancer.Assign(nil, members, enbs, now, time.Minute)
+	assert.Len(t, actual.Members[0].EnodebSerials, 3)
+	assert.Len(t, actual.Members[1].EnodebSerials, 2)
+
+	// No members
+	actual = rebalancer.Assign(nil, nil, enbs, now, time.Minute)
+	expected = &protos.GatewayPoolAssignment{
+		UnassignedEnodebSerials: []string{"enb1", "enb2", "enb3", "enb4", "enb5"},
+	}
+	test_utils.AssertMessagesEqual(t, expected, actual)
+}
+
+func TestAssign_FailureAndRecovery(t *testing.T) {
+	start := time.Unix(1000, 0)
+	hysteresis := time.Minute
+	enbs := []string{"enb1", "enb2", "enb3", "enb4"}
+	healthy := []rebalancer.Member{
+		{GatewayID: "g1", RelativeCapacity: 1, CheckinValid: true},
+		{GatewayID: "g2", RelativeCapacity: 1, CheckinValid: true},
+	}
+	g2Down := []rebalancer.Member{
+		{GatewayID: "g1", RelativeCapacity: 1, CheckinValid: true},
+		{GatewayID: "g2", RelativeCapacity: 1, CheckinValid: false},
+	}
+
+	initial := rebalancer.Assign(nil, healthy, enbs, start, hysteresis)
+	assert.Equal(t, []string{"enb1", "enb3"}, initial.Members[0].EnodebSerials)
+	assert.Equal(t, []string{"enb2", "enb4"}, initial.Members[1].EnodebSerials)
+
+	// g2 missing checkins within the hysteresis doesn't move any eNodeBs
+	t1 := start.Add(10 * time.Second)
+	pending := rebalancer.Assign(initial, g2Down, enbs, t1, hysteresis)
+	assert.True(t, pending.Members[1].Healthy)
+	assert.Equal(t, t1.Unix()*1000, pending.Members[1].HealthPendingTime)
+	assert.Equal(t, []string{"enb2", "enb4"}, pending.Members[1].EnodebSerials)
+	assert.Equal(t, initial.LastRebalanceTime, pending.LastRebalanceTime)
+
+	// g2 checking in again within the hysteresis clears the pending change
+	recovered := rebalancer.Assign(pending, healthy, enbs, start.Add(20*time.Second), hysteresis)
+	test_utils.AssertMessagesEqual(t, initial, recovered)
+
+	// g2 is marked unhealthy once it has missed checkins for the hysteresis,
+	// and its eNodeBs are moved to g1
+	pending = rebalancer.Assign(recovered, g2Down, enbs, t1, hysteresis)
+	t2 := t1.Add(hysteresis)
+	failed := rebalancer.Assign(pending, g2Down, enbs, t2, hysteresis)
+	expected := &protos.GatewayPoolAssignment{
+		Members: []*protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 1, Healthy: true, HealthChangeTime: start.Unix() * 1000, EnodebSerials: enbs},
+			{GatewayId: "g2", RelativeCapacity: 1, Healthy: false, HealthChangeTime: t2.Unix() * 1000},
+		},
+		LastRebalanceTime: t2.Unix() * 1000,
+	}
+	test_utils.AssertMessagesEqual(t, expected, failed)
+
+	// After g2 recovers, only the eNodeBs needed to restore the distribution
+	// are moved back
+	t3 := t2.Add(time.Second)
+	pending = rebalancer.Assign(failed, healthy, enbs, t3, hysteresis)
+	assert.Equal(t, enbs, pending.Members[0].EnodebSerials)
+	t4 := t3.Add(hysteresis)
+	rebalanced := rebalancer.Assign(pending, healthy, enbs, t4, hysteresis)
+	assert.True(t, rebalanced.Members[1].Healthy)
+	assert.Len(t, rebalanced.Members[0].EnodebSerials, 2)
+	assert.Len(t, rebalanced.Members[1].EnodebSerials, 2)
+	assert.Equal(t, t4.Unix()*1000, rebalanced.LastRebalanceTime)
+
+	// A new eNodeB goes to the member with the most spare capacity, without
+	// moving existing eNodeBs
+	withNewEnb := rebalancer.Assign(failed, g2Down, append(enbs, "enb5"), t2.Add(time.Second), hysteresis)
+	assert.Equal(t, []string{"enb1", "enb2", "enb3", "enb4", "enb5"}, withNewEnb.Members[0].EnodebSerials)
+}
+
+func TestAssign_NoHealthyMembers(t *testing.T) {
+	now := time.Unix(1000, 0)
+	prev := &protos.GatewayPoolAssignment{
+		Members: []*protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 1, Healthy: false, EnodebSerials: []string{"enb1"}},
+			{GatewayId: "g2", RelativeCapacity: 1, Healthy: false, EnodebSerials: []string{"enb2"}},
+		},
+		LastRebalanceTime: 42,
+	}
+	members := []rebalancer.Member{
+		{GatewayID: "g1", RelativeCapacity: 1},
+		{GatewayID: "g2", RelativeCapacity: 1},
+	}
+
+	// eNodeBs stay put, new eNodeBs are left unassigned
+	actual := rebalancer.Assign(prev, members, []string{"enb1", "enb2", "enb3"}, now, time.Minute)
+	expected := &protos.GatewayPoolAssignment{
+		Members:                 prev.Members,
+		UnassignedEnodebSerials: []string{"enb3"},
+		LastRebalanceTime:       42,
+	}
+	test_utils.AssertMessagesEqual(t, expected, actual)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalancer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	"magma/lte/cloud/go/services/ha"
+	"magma/lte/cloud/go/services/ha/protos"
+	"magma/lte/cloud/go/services/ha/storage"
+	lte_models "magma/lte/cloud/go/services/lte/obsidian/models"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/state/wrappers"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
+)
+
+const (
+	// validSecsSinceCheckin matches the checkin validity used to determine
+	// primary gateway failure for offload.
+	validSecsSinceCheckin = 180
+)
+
+// Rebalancer keeps the eNodeB assignments of active-active gateway pools up
+// to date with pool membership and member health.
+type Rebalancer struct {
+	store storage.AssignmentStore
+}
+
+func NewRebalancer(store storage.AssignmentStore) *Rebalancer {
+	return &Rebalancer{store: store}
+}
+
+// Run rebalances all LTE networks forever, at the configured interval.
+func (r *Rebalancer) Run(config ha.Config) {
+	for {
+		err := r.RebalanceAllNetworks(context.Background())
+		if err != nil {
+			glog.Errorf("Error rebalancing gateway pools: %+v", err)
+		}
+		time.Sleep(config.GetRebalanceInterval())
+	}
+}
+
+// RebalanceAllNetworks rebalances the gateway pools of every LTE network.
+// Networks are rebalanced independently, a failure for one network doesn't
+// prevent progress on the others.
+func (r *Rebalancer) RebalanceAllNetworks(ctx context.Context) error {
+	networkIDs, err := configurator.ListNetworksOfType(ctx, lte.NetworkType)
+	if err != nil {
+		return fmt.Errorf("list LTE networks: %w", err)
+	}
+	errs := &multierror.Error{}
+	for _, networkID := range networkIDs {
+		if err := r.RebalanceNetwork(ctx, networkID); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("rebalance network %s: %w", networkID, err))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// RebalanceNetwork updates the assignments of every active-active gateway
+// pool in a network, and removes the assignments of pools which have been
+// deleted or are no longer active-active.
+func (r *Rebalancer) RebalanceNetwork(ctx context.Context, networkID string) error {
+	poolEnts, _, err := configurator.LoadAllEntitiesOfType(
+		ctx,
+		networkID, lte.CellularGatewayPoolEntityType,
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true},
+		serdes.Entity,
+	)
+	if err != nil {
+		return fmt.Errorf("load gateway pools: %w", err)
+	}
+	prevAssignments, err := r.store.GetAssignments(networkID)
+	if err != nil {
+		return fmt.Errorf("get gateway pool assignments: %w", err)
+	}
+
+	errs := &multierror.Error{}
+	activePools := map[string]bool{}
+	for _, poolEnt := range poolEnts {
+		poolCfg, ok := poolEnt.Config.(*lte_models.CellularGatewayPoolConfigs)
+		if !ok || poolCfg.Mode != lte_models.CellularGatewayPoolConfigsModeActiveActive {
+			continue
+		}
+		activePools[poolEnt.Key] = true
+		err := r.rebalancePool(ctx, networkID, poolEnt.Key, poolCfg, poolEnt.Associations.Filter(lte.CellularGatewayEntityType).Keys(), prevAssignments[poolEnt.Key])
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("rebalance gateway pool %s: %w", poolEnt.Key, err))
+		}
+	}
+
+	var stalePools []string
+	for poolID := range prevAssignments {
+		if !activePools[poolID] {
+			stalePools = append(stalePools, poolID)
+		}
+	}
+	if err := r.store.DeleteAssignments(networkID, stalePools); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	return errs.ErrorOrNil()
+}
+
+func (r *Rebalancer) rebalancePool(
+	ctx context.Context,
+	networkID string,
+	poolID string,
+	poolCfg *lte_models.CellularGatewayPoolConfigs,
+	gatewayIDs []string,
+	prev *protos.GatewayPoolAssignment,
+) error {
+	members, enbs, err := getMembersAndEnodebs(ctx, networkID, poolID, gatewayIDs)
+	if err != nil {
+		return err
+	}
+	hysteresis := time.Duration(poolCfg.RebalanceHysteresisSecs) * time.Second
+	assignment := Assign(prev, members, enbs, clock.Now(), hysteresis)
+	if proto.Equal(prev, assignment) {
+		return nil
+	}
+	if prev.GetLastRebalanceTime() != assignment.LastRebalanceTime {
+		glog.Infof("Rebalanced eNodeBs of gateway pool %s in network %s: %v", poolID, networkID, assignment.Members)
+	}
+	return r.store.SetAssignment(networkID, poolID, assignment)
+}
+
+// getMembersAndEnodebs returns the members of a gateway pool and the union of
+// the eNodeBs configured on them.
+func getMembersAndEnodebs(ctx context.Context, networkID string, poolID string, gatewayIDs []string) ([]Member, []string, error) {
+	if len(gatewayIDs) == 0 {
+		return nil, nil, nil
+	}
+	var tks orc8r_storage.TKs
+	for _, gatewayID := range gatewayIDs {
+		tks = append(tks,
+			orc8r_storage.TK{Type: lte.CellularGatewayEntityType, Key: gatewayID},
+			orc8r_storage.TK{Type: orc8r.MagmadGatewayType, Key: gatewayID},
+		)
+	}
+	ents, _, err := configurator.LoadEntities(
+		ctx, networkID, nil, nil, nil, tks,
+		configurator.EntityLoadCriteria{LoadMetadata: true, LoadConfig: true, LoadAssocsFromThis: true},
+		serdes.Entity,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load pool gateways: %w", err)
+	}
+	entsByTK := ents.MakeByTK()
+
+	var hwIDs []string
+	for _, gatewayID := range gatewayIDs {
+		if magmadEnt, ok := entsByTK[orc8r_storage.TK{Type: orc8r.MagmadGatewayType, Key: gatewayID}]; ok {
+			hwIDs = append(hwIDs, magmadEnt.PhysicalID)
+		}
+	}
+	statuses, err := wrappers.GetGatewayStatuses(ctx, networkID, hwIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get pool gateway statuses: %w", err)
+	}
+
+	var members []Member
+	enbSet := map[string]bool{}
+	for _, gatewayID := range gatewayIDs {
+		cellularEnt, ok := entsByTK[orc8r_storage.TK{Type: lte.CellularGatewayEntityType, Key: gatewayID}]
+		if !ok {
+			continue
+		}
+		member := Member{GatewayID: gatewayID}
+		if cellularCfg, ok := cellularEnt.Config.(*lte_models.GatewayCellularConfigs); ok {
+			for _, record := range cellularCfg.Pooling {
+				if string(record.GatewayPoolID) == poolID {
+					member.RelativeCapacity = record.MmeRelativeCapacity
+				}
+			}
+		}
+		if magmadEnt, ok := entsByTK[orc8r_storage.TK{Type: orc8r.MagmadGatewayType, Key: gatewayID}]; ok {
+			if status, ok := statuses[magmadEnt.PhysicalID]; ok {
+				timeSinceCheckin := clock.Now().Unix() - int64(status.CheckinTime)/1000
+				member.CheckinValid = timeSinceCheckin < validSecsSinceCheckin
+			}
+		}
+		members = append(members, member)
+		for _, enb := range cellularEnt.Associations.Filter(lte.CellularEnodebEntityType).Keys() {
+			enbSet[enb] = true
+		}
+	}
+	var enbs []string
+	for enb := range enbSet {
+		enbs = append(enbs, enb)
+	}
+	return members, enbs, nil
+}
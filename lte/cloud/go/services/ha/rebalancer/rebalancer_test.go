@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalancer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	"magma/lte/cloud/go/services/ha/protos"
+	"magma/lte/cloud/go/services/ha/rebalancer"
+	"magma/lte/cloud/go/services/ha/storage"
+	lte_models "magma/lte/cloud/go/services/lte/obsidian/models"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/configurator"
+	configurator_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	state_test_init "magma/orc8r/cloud/go/services/state/test_init"
+	state_test_utils "magma/orc8r/cloud/go/services/state/test_utils"
+	"magma/orc8r/cloud/go/sqorc"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/test_utils"
+	"magma/orc8r/lib/go/merrors"
+)
+
+func TestRebalancer_RebalanceAllNetworks(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	state_test_init.StartTestService(t)
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	store := storage.NewAssignmentStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+	r := rebalancer.NewRebalancer(store)
+
+	networkID := "n1"
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: networkID, Type: lte.NetworkType}, serdes.Network)
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(context.Background(), networkID, []configurator.NetworkEntity{
+		{Type: lte.CellularEnodebEntityType, Key: "enb1"},
+		{Type: lte.CellularEnodebEntityType, Key: "enb2"},
+		{Type: lte.CellularEnodebEntityType, Key: "enb3"},
+		{
+			Type: lte.CellularGatewayEntityType, Key: "g1",
+			Config: newGatewayConfig(2),
+			Associations: orc8r_storage.TKs{
+				{Type: lte.CellularEnodebEntityType, Key: "enb1"},
+				{Type: lte.CellularEnodebEntityType, Key: "enb2"},
+			},
+		},
+		{
+			Type: lte.CellularGatewayEntityType, Key: "g2",
+			Config:       newGatewayConfig(1),
+			Associations: orc8r_storage.TKs{{Type: lte.CellularEnodebEntityType, Key: "enb3"}},
+		},
+		{
+			Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1",
+			Associations: orc8r_storage.TKs{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
+		},
+		{
+			Type: orc8r.MagmadGatewayType, Key: "g2", PhysicalID: "hw2",
+			Associations: orc8r_storage.TKs{{Type: lte.CellularGatewayEntityType, Key: "g2"}},
+		},
+	}, serdes.Entity)
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(context.Background(), networkID, configurator.NetworkEntity{
+		Type: lte.CellularGatewayPoolEntityType, Key: "pool1",
+		Config: &lte_models.CellularGatewayPoolConfigs{
+			MmeGroupID:              1,
+			Mode:                    lte_models.CellularGatewayPoolConfigsModeActiveActive,
+			RebalanceHysteresisSecs: 60,
+		},
+		Associations: orc8r_storage.TKs{
+			{Type: lte.CellularGatewayEntityType, Key: "g1"},
+			{Type: lte.CellularGatewayEntityType, Key: "g2"},
+		},
+	}, serdes.Entity)
+	assert.NoError(t, err)
+
+	now := time.Unix(1000000, 0)
+	nowMs := now.Unix() * 1000
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+	reportCheckin(t, "hw1")
+	reportCheckin(t, "hw2")
+
+	err = r.RebalanceAllNetworks(context.Background())
+	assert.NoError(t, err)
+	assignment, err := store.GetAssignment(networkID, "pool1")
+	assert.NoError(t, err)
+	expected := &protos.GatewayPoolAssignment{
+		Members: []*protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 2, Healthy: true, HealthChangeTime: nowMs, EnodebSerials: []string{"enb1", "enb2"}},
+			{GatewayId: "g2", RelativeCapacity: 1, Healthy: true, HealthChangeTime: nowMs, EnodebSerials: []string{"enb3"}},
+		},
+		LastRebalanceTime: nowMs,
+	}
+	test_utils.AssertMessagesEqual(t, expected, assignment)
+
+	// g2 stops checking in, and is failed over to g1 after the hysteresis
+	later := now.Add(10 * time.Minute)
+	laterMs := later.Unix() * 1000
+	clock.SetAndFreezeClock(t, later)
+	reportCheckin(t, "hw1")
+	assert.NoError(t, r.RebalanceAllNetworks(context.Background()))
+	clock.SetAndFreezeClock(t, later.Add(time.Minute))
+	assert.NoError(t, r.RebalanceAllNetworks(context.Background()))
+	assignment, err = store.GetAssignment(networkID, "pool1")
+	assert.NoError(t, err)
+	failedOverMs := laterMs + 60000
+	expected = &protos.GatewayPoolAssignment{
+		Members: []*protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 2, Healthy: true, HealthChangeTime: nowMs, EnodebSerials: []string{"enb1", "enb2", "enb3"}},
+			{GatewayId: "g2", RelativeCapacity: 1, Healthy: false, HealthChangeTime: failedOverMs},
+		},
+		LastRebalanceTime: failedOverMs,
+	}
+	test_utils.AssertMessagesEqual(t, expected, assignment)
+
+	// Assignment is removed when the pool is no longer active-active
+	err = configurator.CreateOrUpdateEntityConfig(context.Background(), networkID, lte.CellularGatewayPoolEntityType, "pool1", &lte_models.CellularGatewayPoolConfigs{MmeGroupID: 1}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.NoError(t, r.RebalanceAllNetworks(context.Background()))
+	_, err = store.GetAssignment(networkID, "pool1")
+	assert.Equal(t, merrors.ErrNotFound, err)
+}
+
+func reportCheckin(t *testing.T, hwID string) {
+	ctx := state_test_utils.GetContextWithCertificate(t, hwID)
+	state_test_utils.ReportGatewayStatus(t, ctx, &models.GatewayStatus{HardwareID: hwID})
+}
+
+func newGatewayConfig(relativeCapacity uint32) *lte_models.GatewayCellularConfigs {
+	return &lte_models.GatewayCellularConfigs{
+		Pooling: lte_models.CellularGatewayPoolRecords{
+			{GatewayPoolID: "pool1", MmeCode: 1, MmeRelativeCapacity: relativeCapacity},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/lte/cloud/go/services/ha/protos"
+	"magma/lte/cloud/go/services/ha/storage"
+	"magma/orc8r/lib/go/merrors"
+)
+
+type lookupServicer struct {
+	store storage.AssignmentStore
+}
+
+// NewLookupServicer returns a new gateway pool assignment lookup servicer.
+// Stores should be initialized by the caller.
+func NewLookupServicer(store storage.AssignmentStore) protos.GatewayPoolAssignmentLookupServer {
+	return &lookupServicer{store: store}
+}
+
+func (l *lookupServicer) GetGatewayPoolAssignment(ctx context.Context, req *protos.GetGatewayPoolAssignmentRequest) (*protos.GetGatewayPoolAssignmentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	assignment, err := l.store.GetAssignment(req.NetworkId, req.GatewayPoolId)
+	if err != nil {
+		return nil, makeErr(err, "get gateway pool assignment from store")
+	}
+	return &protos.GetGatewayPoolAssignmentResponse{Assignment: assignment}, nil
+}
+
+func makeErr(err error, wrap string) error {
+	e := fmt.Errorf(wrap+": %w", err)
+	code := codes.Internal
+	if err == merrors.ErrNotFound {
+		code = codes.NotFound
+	}
+	return status.Error(code, e.Error())
+}
@@ -25,11 +25,13 @@ import (
 	"magma/lte/cloud/go/lte"
 	lte_protos "magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/serdes"
+	"magma/lte/cloud/go/services/ha/storage"
 	lte_service "magma/lte/cloud/go/services/lte"
 	lte_models "magma/lte/cloud/go/services/lte/obsidian/models"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/state/wrappers"
+	"magma/orc8r/lib/go/merrors"
 	"magma/orc8r/lib/go/protos"
 )
 
@@ -37,16 +39,20 @@ const (
 	validSecsSinceStateReported = 180
 )
 
-type HAServicer struct{}
+type HAServicer struct {
+	store storage.AssignmentStore
+}
 
 // NewHAServicer creates a new service implementing the HA proto file
-func NewHAServicer() lte_protos.HaServer {
-	return &HAServicer{}
+func NewHAServicer(store storage.AssignmentStore) lte_protos.HaServer {
+	return &HAServicer{store: store}
 }
 
 // GetEnodebOffloadState fetches all primary gateways that the calling gateway
 // is in a gateway pool with. For each of these gateways, it then fetches the
 // offload state for each of the gateway's ENBs.
+// In active-active pools, the primary of each ENB is the gateway it's
+// currently assigned to.
 func (s *HAServicer) GetEnodebOffloadState(ctx context.Context, req *lte_protos.GetEnodebOffloadStateRequest) (*lte_protos.GetEnodebOffloadStateResponse, error) {
 	ret := &lte_protos.GetEnodebOffloadStateResponse{}
 	secondaryGw := protos.GetClientGateway(ctx)
@@ -73,7 +79,7 @@ func (s *HAServicer) GetEnodebOffloadState(ctx context.Context, req *lte_protos.
 	callingRelativeCapacity := cellularCfg.Pooling[0].MmeRelativeCapacity
 	gwIDsToEnbs := map[string][]string{}
 	for _, record := range cellularCfg.Pooling {
-		gwIDsToEnbsInPool, err := s.getPrimaryGatewaysToEnodebs(ctx, secondaryGw.GetNetworkId(), string(record.GatewayPoolID), callingRelativeCapacity)
+		gwIDsToEnbsInPool, err := s.getPrimaryGatewaysToEnodebs(ctx, secondaryGw.GetNetworkId(), string(record.GatewayPoolID), secondaryGw.LogicalId, callingRelativeCapacity)
 		if err != nil {
 			return &lte_protos.GetEnodebOffloadStateResponse{}, err
 		}
@@ -120,16 +126,19 @@ func (s *HAServicer) GetEnodebOffloadState(ctx context.Context, req *lte_protos.
 	return &lte_protos.GetEnodebOffloadStateResponse{EnodebOffloadStates: enbSNsToOffloadState}, nil
 }
 
-func (s *HAServicer) getPrimaryGatewaysToEnodebs(ctx context.Context, networkID string, gatewayPoolID string, callingRelativeCapacity uint32) (map[string][]string, error) {
+func (s *HAServicer) getPrimaryGatewaysToEnodebs(ctx context.Context, networkID string, gatewayPoolID string, callingGatewayID string, callingRelativeCapacity uint32) (map[string][]string, error) {
 	poolEnt, err := configurator.LoadEntity(
 		ctx,
 		networkID, lte.CellularGatewayPoolEntityType, gatewayPoolID,
-		configurator.EntityLoadCriteria{LoadAssocsFromThis: true},
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true},
 		lte_models.EntitySerdes,
 	)
 	if err != nil {
 		return map[string][]string{}, err
 	}
+	if poolCfg, ok := poolEnt.Config.(*lte_models.CellularGatewayPoolConfigs); ok && poolCfg.Mode == lte_models.CellularGatewayPoolConfigsModeActiveActive {
+		return s.getAssignedGatewaysToEnodebs(networkID, gatewayPoolID, callingGatewayID)
+	}
 	primaryGatewaysToEnbs := map[string][]string{}
 	for _, gw := range poolEnt.Associations.Filter(lte.CellularGatewayEntityType) {
 		ent, err := configurator.LoadEntity(
@@ -159,6 +168,26 @@ func (s *HAServicer) getPrimaryGatewaysToEnodebs(ctx context.Context, networkID
 	return primaryGatewaysToEnbs, nil
 }
 
+// getAssignedGatewaysToEnodebs returns the ENBs of an active-active pool
+// which are assigned to gateways other than the calling gateway.
+func (s *HAServicer) getAssignedGatewaysToEnodebs(networkID string, gatewayPoolID string, callingGatewayID string) (map[string][]string, error) {
+	assignment, err := s.store.GetAssignment(networkID, gatewayPoolID)
+	if err == merrors.ErrNotFound {
+		// The pool hasn't been assigned yet, so there's nothing to offload
+		return map[string][]string{}, nil
+	}
+	if err != nil {
+		return map[string][]string{}, fmt.Errorf("get assignment for gateway pool %s: %w", gatewayPoolID, err)
+	}
+	assignedGatewaysToEnbs := map[string][]string{}
+	for _, member := range assignment.Members {
+		if member.GatewayId != callingGatewayID && member.Healthy && len(member.EnodebSerials) > 0 {
+			assignedGatewaysToEnbs[member.GatewayId] = member.EnodebSerials
+		}
+	}
+	return assignedGatewaysToEnbs, nil
+}
+
 func (s *HAServicer) isGatewayCheckinValid(ctx context.Context, networkID string, gatewayID string) (bool, error) {
 	hwID, err := s.getHardwareIDFromGatewayID(ctx, networkID, gatewayID)
 	if err != nil {
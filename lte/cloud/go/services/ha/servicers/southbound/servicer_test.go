@@ -25,7 +25,9 @@ import (
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/serdes"
+	ha_protos "magma/lte/cloud/go/services/ha/protos"
 	servicers "magma/lte/cloud/go/services/ha/servicers/southbound"
+	ha_storage "magma/lte/cloud/go/services/ha/storage"
 	lte_service "magma/lte/cloud/go/services/lte"
 	lte_models "magma/lte/cloud/go/services/lte/obsidian/models"
 	lte_test_init "magma/lte/cloud/go/services/lte/test_init"
@@ -37,6 +39,7 @@ import (
 	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
 	state_test_init "magma/orc8r/cloud/go/services/state/test_init"
 	"magma/orc8r/cloud/go/services/state/test_utils"
+	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/storage"
 	orc8r_protos "magma/orc8r/lib/go/protos"
 )
@@ -49,7 +52,7 @@ func TestHAServicer_GetEnodebOffloadState(t *testing.T) {
 	configurator_test_init.StartTestService(t)
 	state_test_init.StartTestService(t)
 	lte_test_init.StartTestService(t)
-	servicer := servicers.NewHAServicer()
+	servicer := servicers.NewHAServicer(newTestStore(t))
 
 	testNetworkId := "n1"
 	testGwHwId1 := "hw1"
@@ -210,6 +213,129 @@ func TestHAServicer_GetEnodebOffloadState(t *testing.T) {
 	assert.Equal(t, expectedRes, res)
 }
 
+func TestHAServicer_GetEnodebOffloadState_ActiveActive(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	state_test_init.StartTestService(t)
+	lte_test_init.StartTestService(t)
+	store := newTestStore(t)
+	servicer := servicers.NewHAServicer(store)
+
+	testNetworkId := "n1"
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: testNetworkId}, serdes.Network)
+	assert.NoError(t, err)
+
+	enb2Config := newDefaultUnmanagedEnodebConfig()
+	enb2Config.UnmanagedConfig.CellID = swag.Uint32(139)
+	_, err = configurator.CreateEntities(context.Background(), testNetworkId, []configurator.NetworkEntity{
+		{Type: lte.CellularEnodebEntityType, Key: "enb1", Config: newDefaultUnmanagedEnodebConfig()},
+		{Type: lte.CellularEnodebEntityType, Key: "enb2", Config: enb2Config},
+	}, serdes.Entity)
+	assert.NoError(t, err)
+
+	enbAssocs := storage.TKs{{Type: lte.CellularEnodebEntityType, Key: "enb1"}, {Type: lte.CellularEnodebEntityType, Key: "enb2"}}
+	var gwEnts []configurator.NetworkEntity
+	for i, gwID := range []string{"g1", "g2", "g3"} {
+		gwEnts = append(gwEnts,
+			configurator.NetworkEntity{
+				Type: lte.CellularGatewayEntityType, Key: gwID,
+				Config:       newDefaultGatewayConfig(uint32(i+1), 10),
+				Associations: enbAssocs,
+			},
+			configurator.NetworkEntity{
+				Type: orc8r.MagmadGatewayType, Key: gwID,
+				PhysicalID:   "hw" + gwID,
+				Associations: storage.TKs{{Type: lte.CellularGatewayEntityType, Key: gwID}},
+			},
+		)
+	}
+	_, err = configurator.CreateEntities(context.Background(), testNetworkId, gwEnts, serdes.Entity)
+	assert.NoError(t, err)
+
+	_, err = configurator.CreateEntity(context.Background(), testNetworkId, configurator.NetworkEntity{
+		Type: lte.CellularGatewayPoolEntityType, Key: "pool1",
+		Config: &lte_models.CellularGatewayPoolConfigs{
+			MmeGroupID: 1,
+			Mode:       lte_models.CellularGatewayPoolConfigsModeActiveActive,
+		},
+		Associations: storage.TKs{
+			{Type: lte.CellularGatewayEntityType, Key: "g1"},
+			{Type: lte.CellularGatewayEntityType, Key: "g2"},
+			{Type: lte.CellularGatewayEntityType, Key: "g3"},
+		},
+	}, serdes.Entity)
+	assert.NoError(t, err)
+
+	for _, gwID := range []string{"g1", "g2"} {
+		gwCtx := test_utils.GetContextWithCertificate(t, "hw"+gwID)
+		test_utils.ReportGatewayStatus(t, gwCtx, &models.GatewayStatus{CheckinTime: uint64(time.Now().Unix()), HardwareID: "hw" + gwID})
+		reportEnodebState(t, testNetworkId, gwID, "enb1", getDefaultEnodebState(gwID))
+		reportEnodebState(t, testNetworkId, gwID, "enb2", getDefaultEnodebState(gwID))
+	}
+	ctx1 := orc8r_protos.NewGatewayIdentity("hwg1", testNetworkId, "g1").NewContextWithIdentity(context.Background())
+	ctx2 := orc8r_protos.NewGatewayIdentity("hwg2", testNetworkId, "g2").NewContextWithIdentity(context.Background())
+
+	// Pool hasn't been assigned yet
+	res, err := servicer.GetEnodebOffloadState(ctx1, &protos.GetEnodebOffloadStateRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, res.EnodebOffloadStates)
+
+	// Each gateway offloads the ENBs assigned to the other, healthy gateway
+	err = store.SetAssignment(testNetworkId, "pool1", &ha_protos.GatewayPoolAssignment{
+		Members: []*ha_protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 10, Healthy: true, EnodebSerials: []string{"enb1"}},
+			{GatewayId: "g2", RelativeCapacity: 10, Healthy: true, EnodebSerials: []string{"enb2"}},
+			{GatewayId: "g3", RelativeCapacity: 10},
+		},
+	})
+	assert.NoError(t, err)
+	res, err = servicer.GetEnodebOffloadState(ctx1, &protos.GetEnodebOffloadStateRequest{})
+	assert.NoError(t, err)
+	expectedRes := &protos.GetEnodebOffloadStateResponse{
+		EnodebOffloadStates: map[uint32]protos.GetEnodebOffloadStateResponse_EnodebOffloadState{
+			139: protos.GetEnodebOffloadStateResponse_PRIMARY_CONNECTED_AND_SERVING_UES,
+		},
+	}
+	assert.Equal(t, expectedRes, res)
+	res, err = servicer.GetEnodebOffloadState(ctx2, &protos.GetEnodebOffloadStateRequest{})
+	assert.NoError(t, err)
+	expectedRes = &protos.GetEnodebOffloadStateResponse{
+		EnodebOffloadStates: map[uint32]protos.GetEnodebOffloadStateResponse_EnodebOffloadState{
+			138: protos.GetEnodebOffloadStateResponse_PRIMARY_CONNECTED_AND_SERVING_UES,
+		},
+	}
+	assert.Equal(t, expectedRes, res)
+
+	// g2 failed, so g1 now serves both ENBs
+	err = store.SetAssignment(testNetworkId, "pool1", &ha_protos.GatewayPoolAssignment{
+		Members: []*ha_protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 10, Healthy: true, EnodebSerials: []string{"enb1", "enb2"}},
+			{GatewayId: "g2", RelativeCapacity: 10},
+			{GatewayId: "g3", RelativeCapacity: 10},
+		},
+	})
+	assert.NoError(t, err)
+	res, err = servicer.GetEnodebOffloadState(ctx1, &protos.GetEnodebOffloadStateRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, res.EnodebOffloadStates)
+	res, err = servicer.GetEnodebOffloadState(ctx2, &protos.GetEnodebOffloadStateRequest{})
+	assert.NoError(t, err)
+	expectedRes = &protos.GetEnodebOffloadStateResponse{
+		EnodebOffloadStates: map[uint32]protos.GetEnodebOffloadStateResponse_EnodebOffloadState{
+			138: protos.GetEnodebOffloadStateResponse_PRIMARY_CONNECTED_AND_SERVING_UES,
+			139: protos.GetEnodebOffloadStateResponse_PRIMARY_CONNECTED_AND_SERVING_UES,
+		},
+	}
+	assert.Equal(t, expectedRes, res)
+}
+
+func newTestStore(t *testing.T) ha_storage.AssignmentStore {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	store := ha_storage.NewAssignmentStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+	return store
+}
+
 func reportEnodebState(t *testing.T, networkID string, gatewayID string, enodebSerial string, req *lte_models.EnodebState) {
 	req.TimeReported = uint64(clock.Now().UnixNano()) / uint64(time.Millisecond)
 	serializedEnodebState, err := serde.Serialize(req, lte.EnodebStateType, serdes.State)
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/golang/protobuf/proto"
+
+	"magma/lte/cloud/go/services/ha/protos"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/lib/go/merrors"
+)
+
+// AssignmentStore persists the eNodeB assignments of active-active gateway
+// pools.
+type AssignmentStore interface {
+	// Initialize the backing store.
+	Initialize() error
+
+	// GetAssignment returns the assignment of a gateway pool.
+	// Returns merrors.ErrNotFound if the pool hasn't been assigned yet.
+	GetAssignment(networkID string, gatewayPoolID string) (*protos.GatewayPoolAssignment, error)
+
+	// GetAssignments returns the assignments of all gateway pools in a
+	// network, keyed by gateway pool ID.
+	GetAssignments(networkID string) (map[string]*protos.GatewayPoolAssignment, error)
+
+	// SetAssignment creates or replaces the assignment of a gateway pool.
+	SetAssignment(networkID string, gatewayPoolID string, assignment *protos.GatewayPoolAssignment) error
+
+	// DeleteAssignments deletes the assignments of gateway pools.
+	DeleteAssignments(networkID string, gatewayPoolIDs []string) error
+}
+
+const (
+	tableName = "ha_gateway_pool_assignments"
+
+	nidCol        = "network_id"
+	poolIDCol     = "gateway_pool_id"
+	assignmentCol = "assignment"
+)
+
+type assignmentStore struct {
+	db      *sql.DB
+	builder sqorc.StatementBuilder
+}
+
+func NewAssignmentStore(db *sql.DB, builder sqorc.StatementBuilder) AssignmentStore {
+	return &assignmentStore{db: db, builder: builder}
+}
+
+func (s *assignmentStore) Initialize() error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.CreateTable(tableName).
+			IfNotExists().
+			Column(nidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(poolIDCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(assignmentCol).Type(sqorc.ColumnTypeBytes).NotNull().EndColumn().
+			PrimaryKey(nidCol, poolIDCol).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, fmt.Errorf("initialize gateway pool assignment table: %w", err)
+		}
+		return nil, nil
+	}
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *assignmentStore) GetAssignment(networkID string, gatewayPoolID string) (*protos.GatewayPoolAssignment, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		return s.getAssignments(tx, squirrel.Eq{nidCol: networkID, poolIDCol: gatewayPoolID})
+	}
+	txRet, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	assignment, ok := txRet.(map[string]*protos.GatewayPoolAssignment)[gatewayPoolID]
+	if !ok {
+		return nil, merrors.ErrNotFound
+	}
+	return assignment, nil
+}
+
+func (s *assignmentStore) GetAssignments(networkID string) (map[string]*protos.GatewayPoolAssignment, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		return s.getAssignments(tx, squirrel.Eq{nidCol: networkID})
+	}
+	txRet, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return txRet.(map[string]*protos.GatewayPoolAssignment), nil
+}
+
+func (s *assignmentStore) SetAssignment(networkID string, gatewayPoolID string, assignment *protos.GatewayPoolAssignment) error {
+	marshaled, err := proto.Marshal(assignment)
+	if err != nil {
+		return fmt.Errorf("marshal gateway pool assignment: %w", err)
+	}
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.
+			Insert(tableName).
+			Columns(nidCol, poolIDCol, assignmentCol).
+			Values(networkID, gatewayPoolID, marshaled).
+			OnConflict(
+				[]sqorc.UpsertValue{{Column: assignmentCol, Value: marshaled}},
+				nidCol, poolIDCol,
+			).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, fmt.Errorf("insert assignment for gateway pool %s: %w", gatewayPoolID, err)
+		}
+		return nil, nil
+	}
+	_, err = sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *assignmentStore) DeleteAssignments(networkID string, gatewayPoolIDs []string) error {
+	if len(gatewayPoolIDs) == 0 {
+		return nil
+	}
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.
+			Delete(tableName).
+			Where(squirrel.Eq{nidCol: networkID, poolIDCol: gatewayPoolIDs}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, fmt.Errorf("delete gateway pool assignments: %w", err)
+		}
+		return nil, nil
+	}
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *assignmentStore) getAssignments(tx *sql.Tx, where squirrel.Eq) (map[string]*protos.GatewayPoolAssignment, error) {
+	rows, err := s.builder.
+		Select(poolIDCol, assignmentCol).
+		From(tableName).
+		Where(where).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, fmt.Errorf("select gateway pool assignments: %w", err)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "getAssignments")
+
+	ret := map[string]*protos.GatewayPoolAssignment{}
+	for rows.Next() {
+		var poolID string
+		var marshaled []byte
+		err = rows.Scan(&poolID, &marshaled)
+		if err != nil {
+			return nil, fmt.Errorf("select gateway pool assignments, SQL row scan error: %w", err)
+		}
+		assignment := &protos.GatewayPoolAssignment{}
+		err = proto.Unmarshal(marshaled, assignment)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal assignment for gateway pool %s: %w", poolID, err)
+		}
+		ret[poolID] = assignment
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("select gateway pool assignments, SQL rows error: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/services/ha/protos"
+	"magma/lte/cloud/go/services/ha/storage"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/test_utils"
+	"magma/orc8r/lib/go/merrors"
+)
+
+func TestAssignmentStore(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	s := storage.NewAssignmentStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, s.Initialize())
+
+	_, err = s.GetAssignment("n0", "pool1")
+	assert.Equal(t, merrors.ErrNotFound, err)
+	assignments, err := s.GetAssignments("n0")
+	assert.NoError(t, err)
+	assert.Empty(t, assignments)
+
+	assignment1 := &protos.GatewayPoolAssignment{
+		Members: []*protos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 10, Healthy: true, HealthChangeTime: 1000, EnodebSerials: []string{"enb1", "enb2"}},
+			{GatewayId: "g2", RelativeCapacity: 5, HealthChangeTime: 1000, HealthPendingTime: 2000},
+		},
+		LastRebalanceTime: 1000,
+	}
+	assignment2 := &protos.GatewayPoolAssignment{UnassignedEnodebSerials: []string{"enb3"}}
+	assert.NoError(t, s.SetAssignment("n0", "pool1", assignment1))
+	assert.NoError(t, s.SetAssignment("n0", "pool2", assignment2))
+	assert.NoError(t, s.SetAssignment("n1", "pool1", assignment2))
+
+	actual, err := s.GetAssignment("n0", "pool1")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, assignment1, actual)
+	assignments, err = s.GetAssignments("n0")
+	assert.NoError(t, err)
+	assert.Len(t, assignments, 2)
+	test_utils.AssertMessagesEqual(t, assignment1, assignments["pool1"])
+	test_utils.AssertMessagesEqual(t, assignment2, assignments["pool2"])
+
+	// Overwrite
+	assert.NoError(t, s.SetAssignment("n0", "pool1", assignment2))
+	actual, err = s.GetAssignment("n0", "pool1")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, assignment2, actual)
+
+	// Deletes are scoped to the network
+	assert.NoError(t, s.DeleteAssignments("n0", []string{"pool1", "pool3"}))
+	assert.NoError(t, s.DeleteAssignments("n0", nil))
+	assignments, err = s.GetAssignments("n0")
+	assert.NoError(t, err)
+	assert.Len(t, assignments, 1)
+	assert.Contains(t, assignments, "pool2")
+	_, err = s.GetAssignment("n1", "pool1")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test_init
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/lte"
+	lte_protos "magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/ha"
+	"magma/lte/cloud/go/services/ha/protos"
+	protected_servicers "magma/lte/cloud/go/services/ha/servicers/protected"
+	servicers "magma/lte/cloud/go/services/ha/servicers/southbound"
+	"magma/lte/cloud/go/services/ha/storage"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+// StartTestService starts the ha service, returning its assignment store.
+func StartTestService(t *testing.T) storage.AssignmentStore {
+	srv, lis, plis := test_utils.NewTestOrchestratorService(t, lte.ModuleName, ha.ServiceName, nil, nil)
+
+	// Init storage
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	store := storage.NewAssignmentStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+
+	// Add servicers
+	lte_protos.RegisterHaServer(srv.GrpcServer, servicers.NewHAServicer(store))
+	protos.RegisterGatewayPoolAssignmentLookupServer(srv.ProtectedGrpcServer, protected_servicers.NewLookupServicer(store))
+
+	go srv.RunTest(lis, plis)
+	return store
+}
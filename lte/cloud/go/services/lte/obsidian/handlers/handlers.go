@@ -23,6 +23,7 @@ import (
 
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/serdes"
+	"magma/lte/cloud/go/services/ha"
 	lte_models "magma/lte/cloud/go/services/lte/obsidian/models"
 	policydb_models "magma/lte/cloud/go/services/policydb/obsidian/models"
 	"magma/orc8r/cloud/go/models"
@@ -60,8 +61,9 @@ const (
 	ManageNetworkApnPath              = ManageNetworkPath + obsidian.UrlSep + "apns"
 	ManageNetworkApnConfigurationPath = ManageNetworkApnPath + obsidian.UrlSep + ":apn_name"
 
-	ListGatewayPoolsPath   = ManageNetworkPath + obsidian.UrlSep + "gateway_pools"
-	ManageGatewayPoolsPath = ListGatewayPoolsPath + obsidian.UrlSep + ":gateway_pool_id"
+	ListGatewayPoolsPath            = ManageNetworkPath + obsidian.UrlSep + "gateway_pools"
+	ManageGatewayPoolsPath          = ListGatewayPoolsPath + obsidian.UrlSep + ":gateway_pool_id"
+	ManageGatewayPoolAssignmentPath = ManageGatewayPoolsPath + obsidian.UrlSep + "assignment"
 
 	Gateways                          = "gateways"
 	ListGatewaysPath                  = ManageNetworkPath + obsidian.UrlSep + Gateways
@@ -82,6 +84,7 @@ const (
 	ManageGatewayConnectedEnodebsPath = ManageGatewayPath + obsidian.UrlSep + "connected_enodeb_serials"
 	ManageGatewayCellularPoolingPath  = ManageGatewayCellularPath + obsidian.UrlSep + "pooling"
 	ManageGatewayVPNConfigPath        = ManageGatewayPath + obsidian.UrlSep + "vpn"
+	ManageGatewayMconfigDryRunPath    = ManageGatewayPath + obsidian.UrlSep + "mconfig" + obsidian.UrlSep + "dry_run"
 
 	Enodebs            = "enodebs"
 	ListEnodebsPath    = ManageNetworkPath + obsidian.UrlSep + Enodebs
@@ -103,6 +106,7 @@ func GetHandlers() []obsidian.Handler {
 		{Path: ManageGatewayPath, Methods: obsidian.DELETE, HandlerFunc: deleteGateway},
 
 		{Path: ManageGatewayStatePath, Methods: obsidian.GET, HandlerFunc: handlers.GetStateHandler},
+		handlers.GetMconfigDryRunHandler(ManageGatewayMconfigDryRunPath, serdes.Network, serdes.Entity),
 
 		{Path: ListEnodebsPath, Methods: obsidian.GET, HandlerFunc: listEnodebs},
 		{Path: ListEnodebsPath, Methods: obsidian.POST, HandlerFunc: createEnodeb},
@@ -118,6 +122,7 @@ func GetHandlers() []obsidian.Handler {
 		{Path: ManageGatewayPoolsPath, Methods: obsidian.GET, HandlerFunc: getGatewayPoolHandler},
 		{Path: ManageGatewayPoolsPath, Methods: obsidian.PUT, HandlerFunc: updateGatewayPoolHandler},
 		{Path: ManageGatewayPoolsPath, Methods: obsidian.DELETE, HandlerFunc: deleteGatewayPoolHandler},
+		{Path: ManageGatewayPoolAssignmentPath, Methods: obsidian.GET, HandlerFunc: getGatewayPoolAssignmentHandler},
 
 		{Path: ManageNetworkApnPath, Methods: obsidian.GET, HandlerFunc: listApns},
 		{Path: ManageNetworkApnPath, Methods: obsidian.POST, HandlerFunc: createApn},
@@ -929,6 +934,29 @@ func deleteGatewayPoolHandler(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+func getGatewayPoolAssignmentHandler(c echo.Context) error {
+	networkID, gatewayPoolID, nerr := getNetworkIDAndGatewayPoolID(c)
+	if nerr != nil {
+		return nerr
+	}
+	reqCtx := c.Request().Context()
+	cfg, err := configurator.LoadEntityConfig(reqCtx, networkID, lte.CellularGatewayPoolEntityType, gatewayPoolID, serdes.Entity)
+	if err != nil {
+		return makeErr(err)
+	}
+	poolCfg, ok := cfg.(*lte_models.CellularGatewayPoolConfigs)
+	if !ok || poolCfg.Mode != lte_models.CellularGatewayPoolConfigsModeActiveActive {
+		err := fmt.Errorf("gateway pool %s is not in %s mode", gatewayPoolID, lte_models.CellularGatewayPoolConfigsModeActiveActive)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	assignment, err := ha.GetGatewayPoolAssignment(reqCtx, networkID, gatewayPoolID)
+	if err != nil {
+		return makeErr(err)
+	}
+	ret := (&lte_models.GatewayPoolAssignment{}).FromBackendModels(gatewayPoolID, assignment)
+	return c.JSON(http.StatusOK, ret)
+}
+
 func getNetworkIDAndGatewayPoolID(c echo.Context) (string, string, *echo.HTTPError) {
 	vals, err := obsidian.GetParamValues(c, "network_id", "gateway_pool_id")
 	if err != nil {
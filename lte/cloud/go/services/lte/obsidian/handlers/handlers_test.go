@@ -14,9 +14,12 @@
 package handlers_test
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -27,8 +30,11 @@ import (
 
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/serdes"
+	haProtos "magma/lte/cloud/go/services/ha/protos"
+	haTestInit "magma/lte/cloud/go/services/ha/test_init"
 	"magma/lte/cloud/go/services/lte/obsidian/handlers"
 	lteModels "magma/lte/cloud/go/services/lte/obsidian/models"
+	lteTestInit "magma/lte/cloud/go/services/lte/test_init"
 	policyModels "magma/lte/cloud/go/services/policydb/obsidian/models"
 	"magma/orc8r/cloud/go/clock"
 	models2 "magma/orc8r/cloud/go/models"
@@ -4261,6 +4267,200 @@ func TestHAGatewayPools(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 }
 
+func TestGatewayPoolAssignment(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	store := haTestInit.StartTestService(t)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetHandlers()
+	getAssignment := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/lte/:network_id/gateway_pools/:gateway_pool_id/assignment", obsidian.GET).HandlerFunc
+
+	seedNetworks(t)
+	_, err := configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
+		{
+			Type: lte.CellularGatewayPoolEntityType, Key: "pool1",
+			Config: &lteModels.CellularGatewayPoolConfigs{MmeGroupID: 1},
+		},
+		{
+			Type: lte.CellularGatewayPoolEntityType, Key: "pool2",
+			Config: &lteModels.CellularGatewayPoolConfigs{
+				MmeGroupID: 2,
+				Mode:       lteModels.CellularGatewayPoolConfigsModeActiveActive,
+			},
+		},
+	}, serdes.Entity)
+	assert.NoError(t, err)
+
+	url := "/magma/v1/lte/:network_id/gateway_pools/:gateway_pool_id/assignment"
+	// 404 for unknown pool
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            url,
+		ParamNames:     []string{"network_id", "gateway_pool_id"},
+		ParamValues:    []string{"n1", "pool3"},
+		Handler:        getAssignment,
+		ExpectedStatus: 404,
+		ExpectedError:  "Not Found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// 400 for primary/secondary pool
+	tc.ParamValues = []string{"n1", "pool1"}
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "gateway pool pool1 is not in active_active mode"
+	tests.RunUnitTest(t, e, tc)
+
+	// 404 before the pool has been assigned
+	tc.ParamValues = []string{"n1", "pool2"}
+	tc.ExpectedStatus = 404
+	tc.ExpectedError = "Not Found"
+	tests.RunUnitTest(t, e, tc)
+
+	err = store.SetAssignment("n1", "pool2", &haProtos.GatewayPoolAssignment{
+		Members: []*haProtos.GatewayPoolAssignment_Member{
+			{GatewayId: "g1", RelativeCapacity: 2, Healthy: true, HealthChangeTime: 1000, EnodebSerials: []string{"enb1", "enb2"}},
+			{GatewayId: "g2", RelativeCapacity: 1, Healthy: false, HealthChangeTime: 2000, HealthPendingTime: 3000},
+		},
+		LastRebalanceTime: 2000,
+	})
+	assert.NoError(t, err)
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            url,
+		ParamNames:     []string{"network_id", "gateway_pool_id"},
+		ParamValues:    []string{"n1", "pool2"},
+		Handler:        getAssignment,
+		ExpectedStatus: 200,
+		ExpectedResult: &lteModels.GatewayPoolAssignment{
+			GatewayPoolID: "pool2",
+			Members: []*lteModels.GatewayPoolMemberAssignment{
+				{GatewayID: "g1", RelativeCapacity: 2, Healthy: true, HealthChangeTime: 1000, EnodebSerials: lteModels.EnodebSerials{"enb1", "enb2"}},
+				{GatewayID: "g2", RelativeCapacity: 1, Healthy: false, HealthChangeTime: 2000, EnodebSerials: lteModels.EnodebSerials{}},
+			},
+			UnassignedEnodebSerials: lteModels.EnodebSerials{},
+			LastRebalanceTime:       2000,
+		},
+	}
+	tests.RunUnitTest(t, e, tc)
+}
+
+func TestMconfigDryRun(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	lteTestInit.StartTestService(t)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetHandlers()
+	dryRun := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/lte/:network_id/gateways/:gateway_id/mconfig/dry_run", obsidian.POST).HandlerFunc
+
+	seedNetworks(t)
+	seedTier(t, "n1")
+	seedGateway(t, "n1", "g1")
+
+	testURL := "/magma/v1/lte/n1/gateways/g1/mconfig/dry_run"
+
+	// No proposed changes
+	tc := tests.Test{
+		Method:         "POST",
+		URL:            testURL,
+		Handler:        dryRun,
+		Payload:        &models.MconfigDryRunRequest{},
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 200,
+		ExpectedResult: &models.MconfigDryRunResult{Changes: map[string]models.MconfigChange{}, Errors: map[string]string{}},
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Changed gateway config
+	gwConfig := newDefaultGatewayConfig()
+	gwConfig.Ran.Pci = 300
+	result := runMconfigDryRun(t, e, dryRun, "n1", "g1", &models.MconfigDryRunRequest{
+		EntityConfigs: []*models.MconfigDryRunEntityConfig{
+			{Type: swag.String(lte.CellularGatewayEntityType), Key: swag.String("g1"), Config: gwConfig},
+		},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Contains(t, result.Changes, "enodebd")
+	assert.Equal(t, models.MconfigChangeStatusChanged, swag.StringValue(result.Changes["enodebd"].Status))
+	assert.Equal(t, float64(260), result.Changes["enodebd"].Current.(map[string]interface{})["pci"])
+	assert.Equal(t, float64(300), result.Changes["enodebd"].Proposed.(map[string]interface{})["pci"])
+
+	// Removed network config
+	result = runMconfigDryRun(t, e, dryRun, "n1", "g1", &models.MconfigDryRunRequest{
+		NetworkConfigs: map[string]interface{}{lte.CellularNetworkConfigType: nil},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Contains(t, result.Changes, "mme")
+	for key, change := range result.Changes {
+		assert.Equal(t, models.MconfigChangeStatusRemoved, swag.StringValue(change.Status), key)
+		assert.Nil(t, change.Proposed, key)
+	}
+
+	// Builder error: pooling records without a gateway pool
+	gwConfig = newDefaultGatewayConfig()
+	gwConfig.Pooling = lteModels.CellularGatewayPoolRecords{
+		{GatewayPoolID: "pool1", MmeCode: 1, MmeRelativeCapacity: 10},
+	}
+	result = runMconfigDryRun(t, e, dryRun, "n1", "g1", &models.MconfigDryRunRequest{
+		EntityConfigs: []*models.MconfigDryRunEntityConfig{
+			{Type: swag.String(lte.CellularGatewayEntityType), Key: swag.String("g1"), Config: gwConfig},
+		},
+	})
+	assert.Empty(t, result.Changes)
+	assert.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors["lte"], "Not found")
+
+	// Nothing was persisted
+	ent, err := configurator.LoadEntity(context.Background(), "n1", lte.CellularGatewayEntityType, "g1", configurator.EntityLoadCriteria{LoadConfig: true}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, newDefaultGatewayConfig(), ent.Config)
+	nwConfig, err := configurator.LoadNetworkConfig(context.Background(), "n1", lte.CellularNetworkConfigType, serdes.Network)
+	assert.NoError(t, err)
+	assert.Equal(t, lteModels.NewDefaultTDDNetworkConfig(), nwConfig)
+
+	// Invalid proposed config
+	gwConfig = newDefaultGatewayConfig()
+	gwConfig.Ran = nil
+	tc = tests.Test{
+		Method:  "POST",
+		URL:     testURL,
+		Handler: dryRun,
+		Payload: &models.MconfigDryRunRequest{
+			EntityConfigs: []*models.MconfigDryRunEntityConfig{
+				{Type: swag.String(lte.CellularGatewayEntityType), Key: swag.String("g1"), Config: gwConfig},
+			},
+		},
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "invalid config for entity cellular_gateway-g1: validation failure list:\nran in body is required",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Entity outside the gateway's graph
+	tc.Payload = &models.MconfigDryRunRequest{
+		EntityConfigs: []*models.MconfigDryRunEntityConfig{
+			{Type: swag.String(lte.CellularEnodebEntityType), Key: swag.String("enb1"), Config: map[string]interface{}{}},
+		},
+	}
+	tc.ExpectedError = "entity cellular_enodeb-enb1 is not in the gateway's entity graph"
+	tests.RunUnitTest(t, e, tc)
+
+	// Unknown gateway
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/lte/n1/gateways/g2/mconfig/dry_run",
+		Handler:        dryRun,
+		Payload:        &models.MconfigDryRunRequest{},
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g2"},
+		ExpectedStatus: 404,
+		ExpectedError:  "gateway g2 not found",
+	}
+	tests.RunUnitTest(t, e, tc)
+}
+
 func reportEnodebState(t *testing.T, ctx context.Context, enodebSerial string, req *lteModels.EnodebState) {
 	client, err := state.GetStateClient()
 	assert.NoError(t, err)
@@ -4282,6 +4482,23 @@ func reportEnodebState(t *testing.T, ctx context.Context, enodebSerial string, r
 }
 
 // n1, n3 are lte networks, n2 is not
+func runMconfigDryRun(t *testing.T, e *echo.Echo, handler echo.HandlerFunc, networkID string, gatewayID string, payload *models.MconfigDryRunRequest) *models.MconfigDryRunResult {
+	payloadBytes, err := payload.MarshalBinary()
+	assert.NoError(t, err)
+	req := httptest.NewRequest("POST", fmt.Sprintf("/magma/v1/lte/%s/gateways/%s/mconfig/dry_run", networkID, gatewayID), bytes.NewReader(payloadBytes))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames("network_id", "gateway_id")
+	c.SetParamValues(networkID, gatewayID)
+	assert.NoError(t, handler(c))
+	assert.Equal(t, 200, recorder.Code)
+
+	result := &models.MconfigDryRunResult{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), result))
+	return result
+}
+
 func seedNetworks(t *testing.T) {
 	_, err := configurator.CreateNetworks(context.Background(), []configurator.Network{
 		{
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -25,6 +26,17 @@ type CellularGatewayPoolConfigs struct {
 	// Maximum: 65535
 	// Minimum: 0
 	MmeGroupID uint32 `json:"mme_group_id"`
+
+	// How eNodeBs are served by the pool. In primary_secondary mode, the gateways with the highest MME relative capacity serve all eNodeBs and the others take over on failure. In active_active mode, eNodeBs are distributed across all healthy gateways in proportion to their MME relative capacity. Defaults to primary_secondary.
+	//
+	// Example: active_active
+	// Enum: [primary_secondary active_active]
+	Mode string `json:"mode,omitempty"`
+
+	// Time a gateway in an active_active pool must stay failed or recovered before eNodeBs are reassigned
+	//
+	// Example: 60
+	RebalanceHysteresisSecs uint32 `json:"rebalance_hysteresis_secs,omitempty"`
 }
 
 // Validate validates this cellular gateway pool configs
@@ -35,6 +47,10 @@ func (m *CellularGatewayPoolConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -58,6 +74,48 @@ func (m *CellularGatewayPoolConfigs) validateMmeGroupID(formats strfmt.Registry)
 	return nil
 }
 
+var cellularGatewayPoolConfigsTypeModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["primary_secondary","active_active"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		cellularGatewayPoolConfigsTypeModePropEnum = append(cellularGatewayPoolConfigsTypeModePropEnum, v)
+	}
+}
+
+const (
+
+	// CellularGatewayPoolConfigsModePrimarySecondary captures enum value "primary_secondary"
+	CellularGatewayPoolConfigsModePrimarySecondary string = "primary_secondary"
+
+	// CellularGatewayPoolConfigsModeActiveActive captures enum value "active_active"
+	CellularGatewayPoolConfigsModeActiveActive string = "active_active"
+)
+
+// prop value enum
+func (m *CellularGatewayPoolConfigs) validateModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, cellularGatewayPoolConfigsTypeModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CellularGatewayPoolConfigs) validateMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.Mode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateModeEnum("mode", "body", m.Mode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this cellular gateway pool configs based on context it is used
 func (m *CellularGatewayPoolConfigs) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
@@ -23,6 +23,7 @@ import (
 
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/protos"
+	haProtos "magma/lte/cloud/go/services/ha/protos"
 	policydbModels "magma/lte/cloud/go/services/policydb/obsidian/models"
 	"magma/orc8r/cloud/go/models"
 	commonModels "magma/orc8r/cloud/go/models"
@@ -746,6 +747,30 @@ func (m *MutableCellularGatewayPool) ToEntityUpdateCriteria() configurator.Entit
 	return update
 }
 
+func (m *GatewayPoolAssignment) FromBackendModels(gatewayPoolID string, assignment *haProtos.GatewayPoolAssignment) *GatewayPoolAssignment {
+	m.GatewayPoolID = GatewayPoolID(gatewayPoolID)
+	m.LastRebalanceTime = assignment.LastRebalanceTime
+	m.UnassignedEnodebSerials = EnodebSerials(assignment.UnassignedEnodebSerials)
+	if m.UnassignedEnodebSerials == nil {
+		m.UnassignedEnodebSerials = EnodebSerials{}
+	}
+	m.Members = []*GatewayPoolMemberAssignment{}
+	for _, member := range assignment.Members {
+		enbs := EnodebSerials(member.EnodebSerials)
+		if enbs == nil {
+			enbs = EnodebSerials{}
+		}
+		m.Members = append(m.Members, &GatewayPoolMemberAssignment{
+			GatewayID:        models.GatewayID(member.GatewayId),
+			RelativeCapacity: member.RelativeCapacity,
+			Healthy:          member.Healthy,
+			HealthChangeTime: member.HealthChangeTime,
+			EnodebSerials:    enbs,
+		})
+	}
+	return m
+}
+
 func (m *CellularGatewayPoolRecords) FromBackendModels(ctx context.Context, networkID string, gatewayID string) error {
 	cellularConfig := &GatewayCellularConfigs{}
 	err := cellularConfig.FromBackendModels(context.Background(), networkID, gatewayID)
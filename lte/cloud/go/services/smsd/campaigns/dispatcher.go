@@ -0,0 +1,135 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package campaigns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hashicorp/go-multierror"
+
+	"magma/lte/cloud/go/services/smsd"
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/orc8r/cloud/go/clock"
+)
+
+// Dispatcher creates the SMS messages of running campaigns, at each
+// campaign's configured rate.
+type Dispatcher struct {
+	store  storage.CampaignStorage
+	groups SubscriberGroupResolver
+}
+
+func NewDispatcher(store storage.CampaignStorage, groups SubscriberGroupResolver) *Dispatcher {
+	return &Dispatcher{store: store, groups: groups}
+}
+
+// Run dispatches campaign messages forever, at the configured interval.
+func (d *Dispatcher) Run(config smsd.Config) {
+	for {
+		err := d.DispatchCampaigns(context.Background())
+		if err != nil {
+			glog.Errorf("Error dispatching SMS campaigns: %+v", err)
+		}
+		time.Sleep(time.Duration(config.CampaignIntervalSecs) * time.Second)
+	}
+}
+
+// DispatchCampaigns creates the next batch of messages for every campaign
+// which has reached its start time.
+// Campaigns are dispatched independently, a failure for one campaign
+// doesn't prevent progress on the others.
+func (d *Dispatcher) DispatchCampaigns(ctx context.Context) error {
+	campaigns, err := d.store.GetCampaignsToDispatch()
+	if err != nil {
+		return fmt.Errorf("get campaigns to dispatch: %w", err)
+	}
+
+	errs := &multierror.Error{}
+	for _, campaign := range campaigns {
+		err := d.dispatchCampaign(ctx, campaign)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("dispatch campaign %s in network %s: %w", campaign.Pk, campaign.NetworkId, err))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func (d *Dispatcher) dispatchCampaign(ctx context.Context, campaign *storage.Campaign) error {
+	if campaign.Status == storage.CampaignStatus_SCHEDULED {
+		var imsis []string
+		if campaign.Spec.SubscriberGroup != "" {
+			members, err := d.groups.GetSubscribers(ctx, campaign.NetworkId, campaign.Spec.SubscriberGroup)
+			if err != nil {
+				return err
+			}
+			imsis = members
+		}
+		err := d.store.StartCampaign(campaign.NetworkId, campaign.Pk, imsis)
+		if err != nil {
+			return err
+		}
+	}
+
+	batchSize, ok := getBatchSize(campaign, clock.Now())
+	if !ok {
+		return nil
+	}
+	targets, err := d.store.GetPendingTargets(campaign.NetworkId, campaign.Pk, batchSize)
+	if err != nil {
+		return err
+	}
+
+	messages, failures := map[string]string{}, map[string]string{}
+	for _, imsi := range targets {
+		message, err := RenderMessage(campaign.Spec, imsi)
+		if err != nil {
+			failures[imsi] = err.Error()
+			continue
+		}
+		messages[imsi] = message
+	}
+	// Dispatch even if there are no targets left so the campaign gets
+	// marked as completed
+	return d.store.DispatchMessages(campaign.NetworkId, campaign.Pk, messages, failures)
+}
+
+// getBatchSize returns the number of messages which can be created for the
+// campaign without exceeding its rate, or false if no message can be sent
+// yet. A batch size of 0 means unlimited.
+//
+// Budget accumulated while the campaign was idle is capped to one minute's
+// worth of messages, so a campaign never bursts above its per-minute rate.
+func getBatchSize(campaign *storage.Campaign, now time.Time) (int, bool) {
+	rate := campaign.Spec.MessagesPerMinute
+	if rate == 0 {
+		return 0, true
+	}
+
+	elapsed := time.Minute
+	if campaign.LastDispatchTime != nil {
+		elapsed = now.Sub(campaign.LastDispatchTime.AsTime())
+	}
+	if elapsed > time.Minute {
+		elapsed = time.Minute
+	}
+	if elapsed < 0 {
+		return 0, false
+	}
+
+	batchSize := int(uint64(rate) * uint64(elapsed) / uint64(time.Minute))
+	return batchSize, batchSize > 0
+}
@@ -0,0 +1,151 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package campaigns_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/services/smsd/campaigns"
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+func TestDispatcher_DispatchCampaigns(t *testing.T) {
+	smsStore, store := newTestStores(t)
+	groups := &fakeGroupResolver{groups: map[string][]string{"group1": {"IMSI4", "IMSI5"}}}
+	dispatcher := campaigns.NewDispatcher(store, groups)
+
+	var frozenClock int64 = 1000
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+	defer clock.UnfreezeClock(t)
+
+	startTime, err := ptypes.TimestampProto(time.Unix(frozenClock+60, 0))
+	assert.NoError(t, err)
+	throttledPk, err := store.CreateCampaign("n1", &storage.MutableCampaign{
+		SourceMsisdn:        "123",
+		Template:            "hi {{name}}",
+		Imsis:               []string{"IMSI1", "IMSI2", "IMSI3"},
+		MessagesPerMinute:   2,
+		SubscriberVariables: map[string]*storage.TemplateVariables{"IMSI1": {Values: map[string]string{"name": "Alice"}}, "IMSI2": {Values: map[string]string{"name": "Bob"}}},
+	})
+	assert.NoError(t, err)
+	groupPk, err := store.CreateCampaign("n1", &storage.MutableCampaign{
+		SourceMsisdn:    "456",
+		Template:        "alert",
+		SubscriberGroup: "group1",
+		StartTime:       startTime,
+	})
+	assert.NoError(t, err)
+
+	// First run: the throttled campaign sends a minute's worth of messages,
+	// the group campaign hasn't started yet
+	assert.NoError(t, dispatcher.DispatchCampaigns(context.Background()))
+	assertMessages(t, smsStore, map[string]string{"IMSI1": "hi Alice", "IMSI2": "hi Bob"})
+	assertReport(t, store, throttledPk, &storage.CampaignReport{Total: 3, Pending: 1, Waiting: 2})
+	assertReport(t, store, groupPk, &storage.CampaignReport{})
+
+	// Not enough time has passed to send another message
+	frozenClock += 20
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+	assert.NoError(t, dispatcher.DispatchCampaigns(context.Background()))
+	assertReport(t, store, throttledPk, &storage.CampaignReport{Total: 3, Pending: 1, Waiting: 2})
+
+	// A minute after the first batch, the last target fails to render.
+	// The group campaign starts and sends all its messages at once.
+	frozenClock += 40
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+	assert.NoError(t, dispatcher.DispatchCampaigns(context.Background()))
+	assertReport(t, store, throttledPk, &storage.CampaignReport{
+		Total:    3,
+		Waiting:  2,
+		Failed:   1,
+		Failures: map[string]string{"IMSI3": "template variables not defined for subscriber: name"},
+	})
+	assertReport(t, store, groupPk, &storage.CampaignReport{Total: 2, Waiting: 2})
+	assertMessages(t, smsStore, map[string]string{"IMSI1": "hi Alice", "IMSI2": "hi Bob", "IMSI4": "alert", "IMSI5": "alert"})
+
+	// Both campaigns are done
+	toDispatch, err := store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	assert.Empty(t, toDispatch)
+
+	// Group resolution failures are reported, and retried on the next run
+	_, err = store.CreateCampaign("n1", &storage.MutableCampaign{SourceMsisdn: "456", Template: "alert", SubscriberGroup: "group2"})
+	assert.NoError(t, err)
+	err = dispatcher.DispatchCampaigns(context.Background())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "group group2 not found")
+	toDispatch, err = store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	assert.Len(t, toDispatch, 1)
+	assert.Equal(t, storage.CampaignStatus_SCHEDULED, toDispatch[0].Status)
+
+	// Empty groups complete immediately
+	groups.groups["group2"] = nil
+	assert.NoError(t, dispatcher.DispatchCampaigns(context.Background()))
+	toDispatch, err = store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	assert.Empty(t, toDispatch)
+}
+
+type fakeGroupResolver struct {
+	groups map[string][]string
+}
+
+func (f *fakeGroupResolver) GetSubscribers(_ context.Context, _ string, group string) ([]string, error) {
+	members, ok := f.groups[group]
+	if !ok {
+		return nil, errors.New("group " + group + " not found")
+	}
+	return members, nil
+}
+
+func newTestStores(t *testing.T) (storage.SMSStorage, storage.CampaignStorage) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign.keys=1")
+	assert.NoError(t, err)
+	idGenerator := &orc8r_storage.UUIDGenerator{}
+	smsStore := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &storage.DefaultSMSReferenceCounter{}, idGenerator)
+	assert.NoError(t, smsStore.Init())
+	store := storage.NewSQLCampaignStorage(db, sqorc.GetSqlBuilder(), idGenerator)
+	assert.NoError(t, store.Init())
+	return smsStore, store
+}
+
+func assertMessages(t *testing.T, smsStore storage.SMSStorage, expected map[string]string) {
+	messages, err := smsStore.GetSMSs("n1", nil, nil, false, nil, nil)
+	assert.NoError(t, err)
+	actual := map[string]string{}
+	for _, msg := range messages {
+		actual[msg.Imsi] = msg.Message
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func assertReport(t *testing.T, store storage.CampaignStorage, pk string, expected *storage.CampaignReport) {
+	actual, err := store.GetCampaignReport("n1", pk)
+	assert.NoError(t, err)
+	if expected.Failures == nil {
+		expected.Failures = map[string]string{}
+	}
+	test_utils.AssertMessagesEqual(t, expected, actual)
+}
@@ -0,0 +1,50 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package campaigns
+
+import (
+	"context"
+	"fmt"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+)
+
+// SubscriberGroupResolver resolves the subscriber group of a campaign to the
+// IMSIs it targets.
+type SubscriberGroupResolver interface {
+	GetSubscribers(ctx context.Context, networkID string, group string) ([]string, error)
+}
+
+// NewBaseNameGroupResolver returns a resolver which treats subscriber groups
+// as policy base names, targeting all subscribers assigned the base name.
+func NewBaseNameGroupResolver() SubscriberGroupResolver {
+	return &baseNameGroupResolver{}
+}
+
+type baseNameGroupResolver struct{}
+
+func (*baseNameGroupResolver) GetSubscribers(ctx context.Context, networkID string, group string) ([]string, error) {
+	ent, err := configurator.LoadEntity(
+		ctx,
+		networkID, lte.BaseNameEntityType, group,
+		configurator.EntityLoadCriteria{LoadAssocsToThis: true},
+		serdes.Entity,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("load subscriber group %s: %w", group, err)
+	}
+	return ent.ParentAssociations.Filter(lte.SubscriberEntityType).Keys(), nil
+}
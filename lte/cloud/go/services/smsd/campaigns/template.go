@@ -0,0 +1,69 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package campaigns
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"magma/lte/cloud/go/services/smsd/storage"
+)
+
+// ImsiVariable is the template variable which is always defined as the
+// destination IMSI of a message.
+const ImsiVariable = "imsi"
+
+var placeholderRegex = regexp.MustCompile(`{{\s*([A-Za-z0-9_]+)\s*}}`)
+
+// ValidateTemplate checks that every {{ in a message template opens a
+// well-formed variable placeholder.
+func ValidateTemplate(template string) error {
+	stripped := placeholderRegex.ReplaceAllString(template, "")
+	if strings.Contains(stripped, "{{") {
+		return fmt.Errorf("template contains a malformed placeholder; placeholders must be of the form {{variable}}")
+	}
+	return nil
+}
+
+// RenderMessage substitutes the variables of the campaign's template for
+// the given IMSI. Subscriber-specific variables take precedence over the
+// campaign's default variables.
+func RenderMessage(campaign *storage.MutableCampaign, imsi string) (string, error) {
+	vars := map[string]string{}
+	for k, v := range campaign.DefaultVariables {
+		vars[k] = v
+	}
+	for k, v := range campaign.SubscriberVariables[imsi].GetValues() {
+		vars[k] = v
+	}
+	vars[ImsiVariable] = imsi
+
+	var missing []string
+	rendered := placeholderRegex.ReplaceAllStringFunc(campaign.Template, func(placeholder string) string {
+		name := placeholderRegex.FindStringSubmatch(placeholder)[1]
+		val, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+		}
+		return val
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("template variables not defined for subscriber: %s", strings.Join(missing, ", "))
+	}
+	if rendered == "" {
+		return "", fmt.Errorf("rendered message is empty")
+	}
+	return rendered, nil
+}
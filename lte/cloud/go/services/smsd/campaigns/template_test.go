@@ -0,0 +1,66 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package campaigns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/services/smsd/campaigns"
+	"magma/lte/cloud/go/services/smsd/storage"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	assert.NoError(t, campaigns.ValidateTemplate("hello world"))
+	assert.NoError(t, campaigns.ValidateTemplate("hello {{name}}, {{ amount }} is due"))
+	assert.NoError(t, campaigns.ValidateTemplate("closing braces }} are fine"))
+	assert.EqualError(t, campaigns.ValidateTemplate("hello {{name"), "template contains a malformed placeholder; placeholders must be of the form {{variable}}")
+	assert.EqualError(t, campaigns.ValidateTemplate("hello {{first name}}"), "template contains a malformed placeholder; placeholders must be of the form {{variable}}")
+}
+
+func TestRenderMessage(t *testing.T) {
+	campaign := &storage.MutableCampaign{
+		Template:         "Hi {{name}}, {{ amount }} is due for {{imsi}}",
+		DefaultVariables: map[string]string{"name": "subscriber", "amount": "$10"},
+		SubscriberVariables: map[string]*storage.TemplateVariables{
+			"IMSI1": {Values: map[string]string{"name": "Alice"}},
+			"IMSI2": {Values: map[string]string{"amount": "$20", "imsi": "overridden"}},
+		},
+	}
+
+	msg, err := campaigns.RenderMessage(campaign, "IMSI1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi Alice, $10 is due for IMSI1", msg)
+
+	// imsi can't be overridden
+	msg, err = campaigns.RenderMessage(campaign, "IMSI2")
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi subscriber, $20 is due for IMSI2", msg)
+
+	msg, err = campaigns.RenderMessage(campaign, "IMSI3")
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi subscriber, $10 is due for IMSI3", msg)
+
+	// Missing variables
+	campaign.DefaultVariables = nil
+	_, err = campaigns.RenderMessage(campaign, "IMSI1")
+	assert.EqualError(t, err, "template variables not defined for subscriber: amount")
+	_, err = campaigns.RenderMessage(campaign, "IMSI3")
+	assert.EqualError(t, err, "template variables not defined for subscriber: name, amount")
+
+	// Empty message
+	_, err = campaigns.RenderMessage(&storage.MutableCampaign{Template: "{{empty}}", DefaultVariables: map[string]string{"empty": ""}}, "IMSI1")
+	assert.EqualError(t, err, "rendered message is empty")
+}
@@ -0,0 +1,104 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smsd
+
+import (
+	"fmt"
+	"time"
+
+	"magma/lte/cloud/go/sms_ll"
+)
+
+type Config struct {
+	// CampaignIntervalSecs is the time interval between each run of the
+	// campaign dispatcher.
+	CampaignIntervalSecs int `yaml:"campaignIntervalSecs"`
+
+	// NationalLanguages are the GSM 7 bit national language shift tables
+	// messages may be encoded with, e.g. "turkish". Messages which can't be
+	// encoded in GSM 7 bit are sent as UCS-2.
+	NationalLanguages []string `yaml:"nationalLanguages"`
+
+	// SMPP configures the relay of messages to and from an external SMSC.
+	SMPP SMPPConfig `yaml:"smpp"`
+}
+
+// SMPPConfig configures the SMPP session with the external SMSC.
+type SMPPConfig struct {
+	// Enabled turns on the SMSC relay. Mobile-originated messages are
+	// stored but not relayed anywhere while the relay is disabled.
+	Enabled bool `yaml:"enabled"`
+	// Address is the host:port of the SMSC.
+	Address    string `yaml:"address"`
+	SystemID   string `yaml:"systemId"`
+	Password   string `yaml:"password"`
+	SystemType string `yaml:"systemType"`
+
+	// RelayIntervalSecs is the time interval between each run of the MO
+	// message relay. Dropped sessions are re-established at this interval.
+	RelayIntervalSecs int `yaml:"relayIntervalSecs"`
+	// EnquireLinkIntervalSecs is the keepalive interval of the session.
+	EnquireLinkIntervalSecs int `yaml:"enquireLinkIntervalSecs"`
+}
+
+func (config Config) Validate() error {
+	if config.CampaignIntervalSecs <= 0 {
+		return fmt.Errorf("invalid campaign interval")
+	}
+	if _, err := config.GetNationalLanguages(); err != nil {
+		return err
+	}
+	if config.SMPP.Enabled {
+		if err := config.SMPP.Validate(); err != nil {
+			return fmt.Errorf("invalid smpp config: %w", err)
+		}
+	}
+	return nil
+}
+
+func (config Config) GetNationalLanguages() ([]sms_ll.NationalLanguage, error) {
+	var languages []sms_ll.NationalLanguage
+	for _, name := range config.NationalLanguages {
+		language, err := sms_ll.ParseNationalLanguage(name)
+		if err != nil {
+			return nil, err
+		}
+		languages = append(languages, language)
+	}
+	return languages, nil
+}
+
+func (config SMPPConfig) Validate() error {
+	if config.Address == "" {
+		return fmt.Errorf("address is required")
+	}
+	if config.SystemID == "" {
+		return fmt.Errorf("system ID is required")
+	}
+	if config.RelayIntervalSecs <= 0 {
+		return fmt.Errorf("invalid relay interval")
+	}
+	if config.EnquireLinkIntervalSecs < 0 {
+		return fmt.Errorf("invalid enquire link interval")
+	}
+	return nil
+}
+
+func (config SMPPConfig) GetRelayInterval() time.Duration {
+	return time.Duration(config.RelayIntervalSecs) * time.Second
+}
+
+func (config SMPPConfig) GetEnquireLinkInterval() time.Duration {
+	return time.Duration(config.EnquireLinkIntervalSecs) * time.Second
+}
@@ -2,12 +2,15 @@ package models
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
 
 	"magma/lte/cloud/go/services/policydb/obsidian/models"
+	"magma/lte/cloud/go/services/smsd/campaigns"
 	"magma/lte/cloud/go/services/smsd/storage"
 )
 
@@ -50,6 +53,102 @@ func (m *MutableSmsMessage) ToProto() *storage.MutableSMS {
 	}
 }
 
+func (m *SmsCampaign) FromProto(from *storage.Campaign, report *storage.CampaignReport) *SmsCampaign {
+	m.Pk = from.Pk
+	m.Campaign = (&MutableSmsCampaign{}).FromProto(from.Spec)
+	m.TimeCreated = tsToDT(from.CreatedTime)
+	lastDispatch := tsToDT(from.LastDispatchTime)
+	if lastDispatch != nil {
+		m.TimeLastDispatched = *lastDispatch
+	}
+	m.Report = (&SmsCampaignReport{}).FromProto(report)
+
+	switch from.Status {
+	case storage.CampaignStatus_SCHEDULED:
+		m.Status = strPtr(SmsCampaignStatusScheduled)
+	case storage.CampaignStatus_RUNNING:
+		m.Status = strPtr(SmsCampaignStatusRunning)
+	case storage.CampaignStatus_COMPLETED:
+		m.Status = strPtr(SmsCampaignStatusCompleted)
+	default:
+		m.Status = strPtr(SmsCampaignStatusScheduled)
+	}
+
+	return m
+}
+
+func (m *SmsCampaignReport) FromProto(from *storage.CampaignReport) *SmsCampaignReport {
+	m.Total = from.Total
+	m.Pending = from.Pending
+	m.Waiting = from.Waiting
+	m.Delivered = from.Delivered
+	m.Failed = from.Failed
+	m.Failures = from.Failures
+	return m
+}
+
+func (m *MutableSmsCampaign) ValidateModel(context.Context) error {
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	if len(m.Imsis) == 0 && m.SubscriberGroup == "" {
+		return errors.New("one of imsis or subscriber_group must be set")
+	}
+	if len(m.Imsis) != 0 && m.SubscriberGroup != "" {
+		return errors.New("only one of imsis or subscriber_group can be set")
+	}
+	return campaigns.ValidateTemplate(m.Template)
+}
+
+func (m *MutableSmsCampaign) ToProto() *storage.MutableCampaign {
+	ret := &storage.MutableCampaign{
+		Name:              m.Name,
+		SourceMsisdn:      m.SourceMsisdn,
+		Template:          m.Template,
+		SubscriberGroup:   m.SubscriberGroup,
+		MessagesPerMinute: m.MessagesPerMinute,
+		DefaultVariables:  m.DefaultVariables,
+	}
+	for _, imsi := range m.Imsis {
+		ret.Imsis = append(ret.Imsis, string(imsi))
+	}
+	if !time.Time(m.StartTime).IsZero() {
+		// Error can only happen for times outside the proto timestamp range,
+		// which the date-time format can't represent
+		ret.StartTime, _ = ptypes.TimestampProto(time.Time(m.StartTime))
+	}
+	if len(m.SubscriberVariables) != 0 {
+		ret.SubscriberVariables = map[string]*storage.TemplateVariables{}
+		for imsi, vars := range m.SubscriberVariables {
+			ret.SubscriberVariables[imsi] = &storage.TemplateVariables{Values: vars}
+		}
+	}
+	return ret
+}
+
+func (m *MutableSmsCampaign) FromProto(from *storage.MutableCampaign) *MutableSmsCampaign {
+	m.Name = from.Name
+	m.SourceMsisdn = from.SourceMsisdn
+	m.Template = from.Template
+	m.SubscriberGroup = from.SubscriberGroup
+	m.MessagesPerMinute = from.MessagesPerMinute
+	m.DefaultVariables = from.DefaultVariables
+	for _, imsi := range from.Imsis {
+		m.Imsis = append(m.Imsis, models.SubscriberID(imsi))
+	}
+	startTime := tsToDT(from.StartTime)
+	if startTime != nil {
+		m.StartTime = *startTime
+	}
+	if len(from.SubscriberVariables) != 0 {
+		m.SubscriberVariables = map[string]map[string]string{}
+		for imsi, vars := range from.SubscriberVariables {
+			m.SubscriberVariables[imsi] = vars.Values
+		}
+	}
+	return m
+}
+
 func tsToDT(ts *timestamp.Timestamp) *strfmt.DateTime {
 	if ts == nil {
 		return nil
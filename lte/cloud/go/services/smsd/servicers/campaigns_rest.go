@@ -0,0 +1,119 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package servicers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/thoas/go-funk"
+
+	"magma/lte/cloud/go/services/smsd/obsidian/models"
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/orc8r/cloud/go/services/obsidian"
+)
+
+func (s *SMSDRestServicer) listCampaigns(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	campaigns, err := s.campaignStore.GetCampaigns(networkID, nil)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	out := make([]*models.SmsCampaign, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		model, err := s.getCampaignModel(campaign)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		out = append(out, model)
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *SMSDRestServicer) getCampaign(c echo.Context) error {
+	networkID, pk, nerr := getNetworkAndCampaignID(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	campaigns, err := s.campaignStore.GetCampaigns(networkID, []string{pk})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if funk.IsEmpty(campaigns) {
+		return echo.ErrNotFound
+	}
+
+	model, err := s.getCampaignModel(campaigns[0])
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, model)
+}
+
+func (s *SMSDRestServicer) createCampaign(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	payload := &models.MutableSmsCampaign{}
+	if err := c.Bind(payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := payload.ValidateModel(context.Background()); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pk, err := s.campaignStore.CreateCampaign(networkID, payload.ToProto())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusCreated, pk)
+}
+
+func (s *SMSDRestServicer) deleteCampaign(c echo.Context) error {
+	networkID, pk, nerr := getNetworkAndCampaignID(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	err := s.campaignStore.DeleteCampaigns(networkID, []string{pk})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *SMSDRestServicer) getCampaignModel(campaign *storage.Campaign) (*models.SmsCampaign, error) {
+	report, err := s.campaignStore.GetCampaignReport(campaign.NetworkId, campaign.Pk)
+	if err != nil {
+		return nil, err
+	}
+	return (&models.SmsCampaign{}).FromProto(campaign, report), nil
+}
+
+func getNetworkAndCampaignID(c echo.Context) (string, string, *echo.HTTPError) {
+	vals, err := obsidian.GetParamValues(c, "network_id", "campaign_pk")
+	if err != nil {
+		return "", "", err
+	}
+	return vals[0], vals[1], nil
+}
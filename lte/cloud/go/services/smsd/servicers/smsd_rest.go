@@ -16,14 +16,18 @@ import (
 const (
 	SmsRootPath   = lteHandlers.ManageNetworkPath + obsidian.UrlSep + "sms"
 	SmsManagePath = SmsRootPath + obsidian.UrlSep + ":sms_pk"
+
+	CampaignRootPath   = lteHandlers.ManageNetworkPath + obsidian.UrlSep + "sms_campaigns"
+	CampaignManagePath = CampaignRootPath + obsidian.UrlSep + ":campaign_pk"
 )
 
-func NewRESTServicer(store storage.SMSStorage) *SMSDRestServicer {
-	return &SMSDRestServicer{store: store}
+func NewRESTServicer(store storage.SMSStorage, campaignStore storage.CampaignStorage) *SMSDRestServicer {
+	return &SMSDRestServicer{store: store, campaignStore: campaignStore}
 }
 
 type SMSDRestServicer struct {
-	store storage.SMSStorage
+	store         storage.SMSStorage
+	campaignStore storage.CampaignStorage
 }
 
 func (s *SMSDRestServicer) GetHandlers() []obsidian.Handler {
@@ -32,6 +36,11 @@ func (s *SMSDRestServicer) GetHandlers() []obsidian.Handler {
 		{Path: SmsRootPath, Methods: obsidian.POST, HandlerFunc: s.createMessage},
 		{Path: SmsManagePath, Methods: obsidian.GET, HandlerFunc: s.getMessage},
 		{Path: SmsManagePath, Methods: obsidian.DELETE, HandlerFunc: s.deleteMessage},
+
+		{Path: CampaignRootPath, Methods: obsidian.GET, HandlerFunc: s.listCampaigns},
+		{Path: CampaignRootPath, Methods: obsidian.POST, HandlerFunc: s.createCampaign},
+		{Path: CampaignManagePath, Methods: obsidian.GET, HandlerFunc: s.getCampaign},
+		{Path: CampaignManagePath, Methods: obsidian.DELETE, HandlerFunc: s.deleteCampaign},
 	}
 }
 
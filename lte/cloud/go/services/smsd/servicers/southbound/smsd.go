@@ -15,9 +15,11 @@ package servicers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/golang/glog"
 	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,12 +33,13 @@ import (
 const defaultTimeout = 6 * time.Minute
 
 type smsdServicer struct {
-	store storage.SMSStorage
-	serde sms_ll.SMSSerde
+	store   storage.SMSStorage
+	moStore storage.MOSMSStorage
+	serde   sms_ll.SMSSerde
 }
 
-func NewSMSDServicer(store storage.SMSStorage, serde sms_ll.SMSSerde) lteProtos.SmsDServer {
-	return &smsdServicer{store: store, serde: serde}
+func NewSMSDServicer(store storage.SMSStorage, moStore storage.MOSMSStorage, serde sms_ll.SMSSerde) lteProtos.SmsDServer {
+	return &smsdServicer{store: store, moStore: moStore, serde: serde}
 }
 
 func (s *smsdServicer) GetMessages(ctx context.Context, request *lteProtos.GetMessagesRequest) (*lteProtos.GetMessagesResponse, error) {
@@ -86,6 +89,9 @@ func (s *smsdServicer) ReportDelivery(ctx context.Context, request *lteProtos.Re
 	}
 
 	decoded, err := s.serde.DecodeDelivery(request.Report.NasMessageContainer)
+	if errors.Is(err, sms_ll.ErrRpData) {
+		return s.receiveMessage(networkID, request.Report)
+	}
 	if err != nil {
 		return ret, fmt.Errorf("failed to decode report: %w", err)
 	}
@@ -106,3 +112,41 @@ func (s *smsdServicer) ReportDelivery(ctx context.Context, request *lteProtos.Re
 	}
 	return ret, nil
 }
+
+// receiveMessage stores a mobile-originated message and returns its
+// acknowledgement. Storage failures are reported to the UE as temporary
+// failures so the message can be resent.
+func (s *smsdServicer) receiveMessage(networkID string, uplink *lteProtos.SMOUplinkUnitdata) (*lteProtos.ReportDeliveryResponse, error) {
+	ret := &lteProtos.ReportDeliveryResponse{}
+	submit, err := s.serde.DecodeSubmit(uplink.NasMessageContainer)
+	if err != nil {
+		return ret, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	var segment *storage.MOSegment
+	if submit.ConcatTotal > 1 {
+		segment = &storage.MOSegment{Reference: submit.ConcatReference, Total: submit.ConcatTotal, Sequence: submit.ConcatSequence}
+	}
+	var cause uint8
+	_, err = s.moStore.ReceiveMOSMS(
+		networkID,
+		&storage.MutableMOSMS{Imsi: uplink.Imsi, Destination: submit.Destination, Message: submit.Message},
+		segment,
+	)
+	if err != nil {
+		glog.Errorf("Failed to store MO SMS from %s: %v", uplink.Imsi, err)
+		cause = sms_ll.RpCauseTempFailure
+	}
+
+	encoded, err := s.serde.EncodeSubmitReport(submit, cause)
+	if err != nil {
+		return ret, fmt.Errorf("failed to encode message acknowledgement: %w", err)
+	}
+	for _, enc := range encoded {
+		ret.Responses = append(ret.Responses, &lteProtos.SMODownlinkUnitdata{
+			Imsi:                uplink.Imsi,
+			NasMessageContainer: enc,
+		})
+	}
+	return ret, nil
+}
@@ -35,7 +35,7 @@ import (
 func TestSMSDServicer_GetMessages(t *testing.T) {
 	store := new(mocks.SMSStorage)
 	serde := new(mocks2.SMSSerde)
-	srv := smsd_servicer.NewSMSDServicer(store, serde)
+	srv := smsd_servicer.NewSMSDServicer(store, new(mocks.MOSMSStorage), serde)
 	ctx := getTestContext(context.Background())
 
 	// 0 case
@@ -127,7 +127,7 @@ func TestSMSDServicer_GetMessages(t *testing.T) {
 func TestSMSDServicer_ReportDelivery(t *testing.T) {
 	store := new(mocks.SMSStorage)
 	serde := new(mocks2.SMSSerde)
-	srv := smsd_servicer.NewSMSDServicer(store, serde)
+	srv := smsd_servicer.NewSMSDServicer(store, new(mocks.MOSMSStorage), serde)
 	ctx := getTestContext(context.Background())
 
 	// 0 case
@@ -188,6 +188,56 @@ func TestSMSDServicer_ReportDelivery(t *testing.T) {
 	store.AssertExpectations(t)
 }
 
+func TestSMSDServicer_ReportDelivery_MobileOriginated(t *testing.T) {
+	store := new(mocks.SMSStorage)
+	moStore := new(mocks.MOSMSStorage)
+	serde := new(mocks2.SMSSerde)
+	srv := smsd_servicer.NewSMSDServicer(store, moStore, serde)
+	ctx := getTestContext(context.Background())
+
+	nas := []byte{0x1, 0x2}
+	uplink := &protos.ReportDeliveryRequest{Report: &protos.SMOUplinkUnitdata{Imsi: "IMSI1", NasMessageContainer: nas}}
+	serde.On("DecodeDelivery", nas).Return(sms_ll.SMSDeliveryReport{}, sms_ll.ErrRpData)
+	expectedResponse := &protos.ReportDeliveryResponse{
+		Responses: []*protos.SMODownlinkUnitdata{
+			{Imsi: "IMSI1", NasMessageContainer: []byte{0x3}},
+			{Imsi: "IMSI1", NasMessageContainer: []byte{0x4}},
+		},
+	}
+
+	// Single message
+	submit := sms_ll.SMSSubmit{TransactionID: 1, Reference: 2, Destination: "123", Message: "hello"}
+	serde.On("DecodeSubmit", nas).Return(submit, nil).Once()
+	moStore.On("ReceiveMOSMS", "n1", &storage.MutableMOSMS{Imsi: "IMSI1", Destination: "123", Message: "hello"}, (*storage.MOSegment)(nil)).
+		Return("1", nil).
+		Once()
+	serde.On("EncodeSubmitReport", submit, uint8(0)).Return([][]byte{{0x3}, {0x4}}, nil).Once()
+	actual, err := srv.ReportDelivery(ctx, uplink)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponse, actual)
+
+	// Segment of a concatenated message, storage failures are reported to
+	// the UE
+	submit = sms_ll.SMSSubmit{TransactionID: 1, Reference: 2, Destination: "123", Message: "hel", ConcatReference: 5, ConcatTotal: 2, ConcatSequence: 1}
+	serde.On("DecodeSubmit", nas).Return(submit, nil).Once()
+	moStore.On("ReceiveMOSMS", "n1", &storage.MutableMOSMS{Imsi: "IMSI1", Destination: "123", Message: "hel"}, &storage.MOSegment{Reference: 5, Total: 2, Sequence: 1}).
+		Return("", errors.New("store")).
+		Once()
+	serde.On("EncodeSubmitReport", submit, uint8(sms_ll.RpCauseTempFailure)).Return([][]byte{{0x3}, {0x4}}, nil).Once()
+	actual, err = srv.ReportDelivery(ctx, uplink)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponse, actual)
+
+	// Decoding failure
+	serde.On("DecodeSubmit", nas).Return(sms_ll.SMSSubmit{}, errors.New("serde")).Once()
+	_, err = srv.ReportDelivery(ctx, uplink)
+	assert.EqualError(t, err, "failed to decode message: serde")
+
+	serde.AssertExpectations(t)
+	moStore.AssertExpectations(t)
+	store.AssertExpectations(t)
+}
+
 func tsProto(t *testing.T, ti time.Time) *timestamp.Timestamp {
 	ret, err := ptypes.TimestampProto(ti)
 	assert.NoError(t, err)
@@ -0,0 +1,231 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package smsc relays SMS messages between smsd and an external SMSC over
+// SMPP.
+package smsc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hashicorp/go-multierror"
+
+	"magma/lte/cloud/go/services/smsd"
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/lte/cloud/go/smpp"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const (
+	// Maximum number of MO messages submitted per run of the relay
+	relayBatchSize = 100
+
+	// Addresses are exchanged as-is, without assuming a type of number
+	addrTON = 0x00 // unknown
+	addrNPI = 0x01 // ISDN (E.164)
+)
+
+var errNoMSISDN = errors.New("originating subscriber has no MSISDN")
+
+// Relay submits mobile-originated messages to the SMSC, and stores the
+// mobile-terminated messages and delivery receipts the SMSC delivers back.
+type Relay struct {
+	config      smsd.SMPPConfig
+	store       storage.SMSStorage
+	moStore     storage.MOSMSStorage
+	subscribers SubscriberDirectory
+
+	// client is the current SMSC session, only accessed from RelayMessages
+	client *smpp.Client
+}
+
+func NewRelay(config smsd.SMPPConfig, store storage.SMSStorage, moStore storage.MOSMSStorage, subscribers SubscriberDirectory) *Relay {
+	return &Relay{config: config, store: store, moStore: moStore, subscribers: subscribers}
+}
+
+// Run relays messages forever, at the configured interval.
+func (r *Relay) Run() {
+	for {
+		err := r.RelayMessages(context.Background())
+		if err != nil {
+			glog.Errorf("Error relaying MO SMS to SMSC: %+v", err)
+		}
+		time.Sleep(r.config.GetRelayInterval())
+	}
+}
+
+// RelayMessages submits the next batch of MO messages to the SMSC, binding a
+// new session first if there's none.
+// Submission failures reported by the SMSC count towards the message's
+// attempts, while session failures leave messages untouched until the
+// session is re-established.
+func (r *Relay) RelayMessages(ctx context.Context) error {
+	client, err := r.getClient()
+	if err != nil {
+		return err
+	}
+
+	messages, err := r.moStore.GetMOSMSsToRelay(relayBatchSize)
+	if err != nil {
+		return fmt.Errorf("get MO SMSs to relay: %w", err)
+	}
+
+	errs := &multierror.Error{}
+	msisdnsByNetwork := map[string]map[string]string{}
+	for _, message := range messages {
+		msisdns, ok := msisdnsByNetwork[message.NetworkId]
+		if !ok {
+			msisdns, err = r.subscribers.GetMSISDNs(ctx, message.NetworkId)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			msisdnsByNetwork[message.NetworkId] = msisdns
+		}
+
+		smscMessageID, submitErr := r.submit(client, message, msisdns[message.Imsi])
+		var status smpp.Status
+		if submitErr != nil && !errors.As(submitErr, &status) && submitErr != errNoMSISDN {
+			// The session is broken, retry with a new session
+			return multierror.Append(errs, fmt.Errorf("submit MO SMS %s: %w", message.Pk, submitErr)).ErrorOrNil()
+		}
+		errorMessage := ""
+		if submitErr != nil {
+			errorMessage = submitErr.Error()
+		}
+		err = r.moStore.ReportSubmission(message.NetworkId, message.Pk, smscMessageID, errorMessage)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("report submission of MO SMS %s: %w", message.Pk, err))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// Close ends the current SMSC session, if any.
+func (r *Relay) Close() error {
+	if r.client == nil {
+		return nil
+	}
+	err := r.client.Close()
+	r.client = nil
+	return err
+}
+
+func (r *Relay) getClient() (*smpp.Client, error) {
+	if r.client != nil {
+		select {
+		case <-r.client.Done():
+			glog.Warningf("SMSC session ended: %v", r.client.Err())
+			r.client = nil
+		default:
+			return r.client, nil
+		}
+	}
+
+	client, err := smpp.Dial(
+		smpp.ClientConfig{
+			Address:             r.config.Address,
+			SystemID:            r.config.SystemID,
+			Password:            r.config.Password,
+			SystemType:          r.config.SystemType,
+			EnquireLinkInterval: r.config.GetEnquireLinkInterval(),
+		},
+		r.HandleDeliver,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect to SMSC: %w", err)
+	}
+	r.client = client
+	return client, nil
+}
+
+func (r *Relay) submit(client *smpp.Client, message *storage.MOSMS, msisdn string) (string, error) {
+	if msisdn == "" {
+		return "", errNoMSISDN
+	}
+	dataCoding, content := smpp.EncodeText(message.Message)
+	return client.Submit(&smpp.ShortMessage{
+		SourceAddrTON:      addrTON,
+		SourceAddrNPI:      addrNPI,
+		SourceAddr:         msisdn,
+		DestAddrTON:        addrTON,
+		DestAddrNPI:        addrNPI,
+		DestinationAddr:    message.Destination,
+		RegisteredDelivery: smpp.RegisteredDeliveryFinal,
+		DataCoding:         dataCoding,
+		ShortMessage:       content,
+	})
+}
+
+// HandleDeliver handles a deliver_sm from the SMSC. Delivery receipts are
+// mapped back to the MO message they were submitted as, and other messages
+// are stored for delivery to the destination subscriber.
+func (r *Relay) HandleDeliver(sm *smpp.ShortMessage) smpp.Status {
+	if sm.IsDeliveryReceipt() {
+		return r.handleReceipt(sm)
+	}
+
+	message, err := smpp.DecodeText(sm.DataCoding, sm.ShortMessage)
+	if err != nil {
+		glog.Errorf("Failed to decode message from SMSC: %v", err)
+		return smpp.StatusInvalidMsgLen
+	}
+	networkID, imsi, err := r.subscribers.FindSubscriber(context.Background(), sm.DestinationAddr)
+	if err == merrors.ErrNotFound {
+		glog.Warningf("Rejecting message from SMSC to unknown MSISDN %s", sm.DestinationAddr)
+		return smpp.StatusInvalidDstAddr
+	}
+	if err != nil {
+		glog.Errorf("Failed to look up destination of message from SMSC: %v", err)
+		return smpp.StatusSystemError
+	}
+
+	_, err = r.store.CreateSMS(networkID, &storage.MutableSMS{Imsi: imsi, SourceMsisdn: sm.SourceAddr, Message: message})
+	if err != nil {
+		glog.Errorf("Failed to store message from SMSC: %v", err)
+		return smpp.StatusSystemError
+	}
+	return smpp.StatusOK
+}
+
+func (r *Relay) handleReceipt(sm *smpp.ShortMessage) smpp.Status {
+	receipt, err := smpp.ParseDeliveryReceipt(sm)
+	if err != nil {
+		glog.Errorf("Failed to parse delivery receipt from SMSC: %v", err)
+		return smpp.StatusInvalidMsgLen
+	}
+	if !receipt.IsFinal() {
+		return smpp.StatusOK
+	}
+
+	errorMessage := ""
+	if !receipt.IsDelivered() {
+		errorMessage = fmt.Sprintf("SMSC reported message as %s (error %s)", receipt.State, receipt.Error)
+	}
+	err = r.moStore.ReportReceipt(receipt.MessageID, receipt.IsDelivered(), errorMessage)
+	if err == merrors.ErrNotFound {
+		// Receipts for unknown or already-final messages are acknowledged so
+		// the SMSC doesn't redeliver them
+		glog.Warningf("Ignoring delivery receipt for unknown SMSC message ID %s", receipt.MessageID)
+		return smpp.StatusOK
+	}
+	if err != nil {
+		glog.Errorf("Failed to report delivery receipt from SMSC: %v", err)
+		return smpp.StatusSystemError
+	}
+	return smpp.StatusOK
+}
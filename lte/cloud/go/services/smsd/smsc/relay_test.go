@@ -0,0 +1,185 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smsc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/services/smsd"
+	"magma/lte/cloud/go/services/smsd/smsc"
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/lte/cloud/go/smpp"
+	"magma/lte/cloud/go/smpp/smpptest"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/lib/go/merrors"
+)
+
+func TestRelay(t *testing.T) {
+	server, err := smpptest.NewServer("magma", "secret")
+	assert.NoError(t, err)
+	defer server.Close()
+
+	smsStore, moStore := newTestStores(t)
+	subscribers := &fakeDirectory{msisdns: map[string]map[string]string{"n1": {"IMSI1": "15550001"}}}
+	config := smsd.SMPPConfig{Enabled: true, Address: server.Addr, SystemID: "magma", Password: "secret", RelayIntervalSecs: 1}
+	relay := smsc.NewRelay(config, smsStore, moStore, subscribers)
+	defer relay.Close()
+
+	// MO messages are submitted with the subscriber's MSISDN as source
+	_, err = moStore.ReceiveMOSMS("n1", &storage.MutableMOSMS{Imsi: "IMSI1", Destination: "15559999", Message: "héllo"}, nil)
+	assert.NoError(t, err)
+	_, err = moStore.ReceiveMOSMS("n1", &storage.MutableMOSMS{Imsi: "IMSI2", Destination: "15559999", Message: "hi"}, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, relay.RelayMessages(context.Background()))
+
+	assert.Equal(t, []*smpp.ShortMessage{
+		{
+			SourceAddrNPI:      1,
+			SourceAddr:         "15550001",
+			DestAddrNPI:        1,
+			DestinationAddr:    "15559999",
+			RegisteredDelivery: smpp.RegisteredDeliveryFinal,
+			DataCoding:         smpp.DataCodingUCS2,
+			ShortMessage:       []byte{0x00, 0x68, 0x00, 0xe9, 0x00, 0x6c, 0x00, 0x6c, 0x00, 0x6f},
+		},
+	}, server.Submitted())
+	assertMOStatus(t, moStore, map[string]storage.MOMessageStatus{"1": storage.MOMessageStatus_SUBMITTED, "2": storage.MOMessageStatus_RECEIVED})
+	actual, err := moStore.GetMOSMSs("n1", []string{"2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "originating subscriber has no MSISDN", actual[0].Error)
+
+	// Delivery receipts are mapped back to the submitted message
+	status, err := server.DeliverReceipt("1", smpp.StateDelivered)
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusOK, status)
+	status, err = server.DeliverReceipt("42", smpp.StateDelivered)
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusOK, status)
+	assertMOStatus(t, moStore, map[string]storage.MOMessageStatus{"1": storage.MOMessageStatus_SMSC_DELIVERED, "2": storage.MOMessageStatus_RECEIVED})
+
+	// Messages rejected by the SMSC count towards the attempts
+	server.SetSubmitStatus(smpp.StatusThrottled)
+	subscribers.msisdns["n1"]["IMSI2"] = "15550002"
+	assert.NoError(t, relay.RelayMessages(context.Background()))
+	actual, err = moStore.GetMOSMSs("n1", []string{"2"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), actual[0].AttemptCount)
+	assert.Equal(t, "smpp: command status 0x00000058", actual[0].Error)
+
+	server.SetSubmitStatus(smpp.StatusOK)
+	assert.NoError(t, relay.RelayMessages(context.Background()))
+	status, err = server.DeliverReceipt("2", smpp.StateUndeliverable)
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusOK, status)
+	actual, err = moStore.GetMOSMSs("n1", []string{"2"})
+	assert.NoError(t, err)
+	assert.Equal(t, storage.MOMessageStatus_UNDELIVERABLE, actual[0].Status)
+	assert.Equal(t, "SMSC reported message as UNDELIV (error 000)", actual[0].Error)
+
+	// MT messages from the SMSC are stored for delivery
+	dataCoding, content := smpp.EncodeText("hello back")
+	status, err = server.Deliver(&smpp.ShortMessage{SourceAddr: "15559999", DestinationAddr: "15550001", DataCoding: dataCoding, ShortMessage: content})
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusOK, status)
+	status, err = server.Deliver(&smpp.ShortMessage{SourceAddr: "15559999", DestinationAddr: "15550404", DataCoding: dataCoding, ShortMessage: content})
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusInvalidDstAddr, status)
+	status, err = server.Deliver(&smpp.ShortMessage{SourceAddr: "15559999", DestinationAddr: "15550001", DataCoding: 0x04, ShortMessage: content})
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusInvalidMsgLen, status)
+
+	messages, err := smsStore.GetSMSs("n1", nil, nil, false, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "IMSI1", messages[0].Imsi)
+	assert.Equal(t, "15559999", messages[0].SourceMsisdn)
+	assert.Equal(t, "hello back", messages[0].Message)
+
+	// Session failures don't count towards the attempts, and the session is
+	// re-established on the next run
+	_, err = moStore.ReceiveMOSMS("n1", &storage.MutableMOSMS{Imsi: "IMSI1", Destination: "15559999", Message: "again"}, nil)
+	assert.NoError(t, err)
+	server.Close()
+	assert.Error(t, relay.RelayMessages(context.Background()))
+	actual, err = moStore.GetMOSMSs("n1", []string{"3"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), actual[0].AttemptCount)
+
+	server, err = smpptest.NewServer("magma", "secret")
+	assert.NoError(t, err)
+	defer server.Close()
+	relay = smsc.NewRelay(smsd.SMPPConfig{Address: server.Addr, SystemID: "magma", Password: "secret"}, smsStore, moStore, subscribers)
+	defer relay.Close()
+	assert.NoError(t, relay.RelayMessages(context.Background()))
+	assertMOStatus(t, moStore, map[string]storage.MOMessageStatus{"1": storage.MOMessageStatus_SMSC_DELIVERED, "2": storage.MOMessageStatus_UNDELIVERABLE, "3": storage.MOMessageStatus_SUBMITTED})
+}
+
+func assertMOStatus(t *testing.T, store storage.MOSMSStorage, expected map[string]storage.MOMessageStatus) {
+	messages, err := store.GetMOSMSs("n1", nil)
+	assert.NoError(t, err)
+	actual := map[string]storage.MOMessageStatus{}
+	for _, message := range messages {
+		actual[message.Pk] = message.Status
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func newTestStores(t *testing.T) (storage.SMSStorage, storage.MOSMSStorage) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign.keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	smsStore := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &storage.DefaultSMSReferenceCounter{}, &mockIDGenerator{})
+	moStore := storage.NewSQLMOSMSStorage(db, sqorc.GetSqlBuilder(), &mockIDGenerator{})
+	assert.NoError(t, smsStore.Init())
+	assert.NoError(t, moStore.Init())
+	return smsStore, moStore
+}
+
+type fakeDirectory struct {
+	// MSISDNs by IMSI by network
+	msisdns map[string]map[string]string
+}
+
+func (f *fakeDirectory) GetMSISDNs(ctx context.Context, networkID string) (map[string]string, error) {
+	ret := map[string]string{}
+	for imsi, msisdn := range f.msisdns[networkID] {
+		ret[imsi] = msisdn
+	}
+	return ret, nil
+}
+
+func (f *fakeDirectory) FindSubscriber(ctx context.Context, msisdn string) (string, string, error) {
+	for networkID, msisdns := range f.msisdns {
+		for imsi, m := range msisdns {
+			if m == msisdn {
+				return networkID, imsi, nil
+			}
+		}
+	}
+	return "", "", merrors.ErrNotFound
+}
+
+type mockIDGenerator struct {
+	curID int
+}
+
+func (m *mockIDGenerator) New() string {
+	m.curID++
+	return fmt.Sprintf("%d", m.curID)
+}
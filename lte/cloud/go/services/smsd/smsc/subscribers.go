@@ -0,0 +1,74 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smsc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/lib/go/merrors"
+)
+
+// SubscriberDirectory maps subscribers to and from the MSISDNs the SMSC
+// addresses them with.
+type SubscriberDirectory interface {
+	// GetMSISDNs returns the MSISDNs of a network's subscribers, keyed by
+	// IMSI.
+	GetMSISDNs(ctx context.Context, networkID string) (map[string]string, error)
+
+	// FindSubscriber returns the network and IMSI of the subscriber with the
+	// MSISDN. Returns merrors.ErrNotFound if no subscriber has the MSISDN.
+	FindSubscriber(ctx context.Context, msisdn string) (string, string, error)
+}
+
+// NewSubscriberDirectory returns a directory backed by the MSISDN mappings of
+// subscriberdb.
+func NewSubscriberDirectory() SubscriberDirectory {
+	return &subscriberdbDirectory{}
+}
+
+type subscriberdbDirectory struct{}
+
+func (*subscriberdbDirectory) GetMSISDNs(ctx context.Context, networkID string) (map[string]string, error) {
+	imsisByMsisdn, err := subscriberdb.ListMSISDNs(ctx, networkID)
+	if err != nil {
+		return nil, fmt.Errorf("list MSISDNs of network %s: %w", networkID, err)
+	}
+	ret := map[string]string{}
+	for msisdn, imsi := range imsisByMsisdn {
+		ret[imsi] = msisdn
+	}
+	return ret, nil
+}
+
+func (*subscriberdbDirectory) FindSubscriber(ctx context.Context, msisdn string) (string, string, error) {
+	networkIDs, err := configurator.ListNetworkIDs(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("list networks: %w", err)
+	}
+	for _, networkID := range networkIDs {
+		imsi, err := subscriberdb.GetIMSIForMSISDN(ctx, networkID, msisdn)
+		if errors.Is(err, merrors.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("get IMSI for MSISDN in network %s: %w", networkID, err)
+		}
+		return networkID, imsi, nil
+	}
+	return "", "", merrors.ErrNotFound
+}
@@ -19,8 +19,10 @@ import (
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/smsd"
+	"magma/lte/cloud/go/services/smsd/campaigns"
 	"magma/lte/cloud/go/services/smsd/servicers"
 	smsd_servicer "magma/lte/cloud/go/services/smsd/servicers/southbound"
+	"magma/lte/cloud/go/services/smsd/smsc"
 	storage2 "magma/lte/cloud/go/services/smsd/storage"
 	"magma/lte/cloud/go/sms_ll"
 	"magma/orc8r/cloud/go/service"
@@ -29,6 +31,7 @@ import (
 	swagger_servicers "magma/orc8r/cloud/go/services/obsidian/swagger/servicers/protected"
 	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/service/config"
 )
 
 func main() {
@@ -37,23 +40,46 @@ func main() {
 		glog.Fatalf("error creating smsd service: %v", err)
 	}
 
+	var serviceConfig smsd.Config
+	config.MustGetStructuredServiceConfig(lte.ModuleName, smsd.ServiceName, &serviceConfig)
+	if err := serviceConfig.Validate(); err != nil {
+		glog.Fatalf("invalid smsd service configs: %v", err)
+	}
+	// Validated above
+	languages, _ := serviceConfig.GetNationalLanguages()
+
 	// Storage
 	db, err := sqorc.Open(storage.GetSQLDriver(), storage.GetDatabaseSource())
 	if err != nil {
 		glog.Fatalf("error opening db conn: %v", err)
 	}
-	store := storage2.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &storage2.DefaultSMSReferenceCounter{}, &storage.UUIDGenerator{})
+	store := storage2.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &storage2.DefaultSMSReferenceCounter{NationalLanguages: languages}, &storage.UUIDGenerator{})
 	err = store.Init()
 	if err != nil {
 		glog.Fatalf("error initializing smsd storage: %s", err)
 	}
+	campaignStore := storage2.NewSQLCampaignStorage(db, sqorc.GetSqlBuilder(), &storage.UUIDGenerator{})
+	err = campaignStore.Init()
+	if err != nil {
+		glog.Fatalf("error initializing smsd campaign storage: %s", err)
+	}
+	moStore := storage2.NewSQLMOSMSStorage(db, sqorc.GetSqlBuilder(), &storage.UUIDGenerator{})
+	err = moStore.Init()
+	if err != nil {
+		glog.Fatalf("error initializing smsd MO storage: %s", err)
+	}
 
-	restServicer := servicers.NewRESTServicer(store)
+	restServicer := servicers.NewRESTServicer(store, campaignStore)
 	obsidian.AttachHandlers(srv.EchoServer, restServicer.GetHandlers())
-	protos.RegisterSmsDServer(srv.GrpcServer, smsd_servicer.NewSMSDServicer(store, &sms_ll.DefaultSMSSerde{}))
+	protos.RegisterSmsDServer(srv.GrpcServer, smsd_servicer.NewSMSDServicer(store, moStore, &sms_ll.DefaultSMSSerde{NationalLanguages: languages}))
 
 	swagger_protos.RegisterSwaggerSpecServer(srv.ProtectedGrpcServer, swagger_servicers.NewSpecServicerFromFile(smsd.ServiceName))
 
+	go campaigns.NewDispatcher(campaignStore, campaigns.NewBaseNameGroupResolver()).Run(serviceConfig)
+	if serviceConfig.SMPP.Enabled {
+		go smsc.NewRelay(serviceConfig.SMPP, store, moStore, smsc.NewSubscriberDirectory()).Run()
+	}
+
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("error while running smsd service: %v", err)
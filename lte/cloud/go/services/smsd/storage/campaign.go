@@ -0,0 +1,65 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage
+
+// CampaignStorage is the storage interface for managing SMS campaigns.
+//
+// A campaign fans out into one SMS per targeted IMSI. Messages are created
+// in throttled batches through DispatchMessages, after which they are
+// delivered and reported like any other SMS. Campaign reports are then
+// aggregated from the delivery status of those messages.
+type CampaignStorage interface {
+	// Init performs on-start initialization work such as table creation.
+	// The SMS tables must already exist.
+	Init() error
+
+	// GetCampaigns returns all campaigns in a network.
+	// If pks is non-empty, this will fetch only the specified campaigns.
+	GetCampaigns(networkID string, pks []string) ([]*Campaign, error)
+
+	// CreateCampaign creates a new campaign. The auto-generated pk for the
+	// campaign is returned.
+	// Targets are recorded immediately for campaigns with an IMSI list.
+	CreateCampaign(networkID string, campaign *MutableCampaign) (string, error)
+
+	// DeleteCampaigns deletes campaigns by pk, stopping any further messages
+	// from being created for them. Messages which have already been created
+	// are left untouched. Semantics are all or nothing.
+	DeleteCampaigns(networkID string, pks []string) error
+
+	// GetCampaignsToDispatch returns the campaigns across all networks which
+	// have reached their start time but haven't completed yet.
+	GetCampaignsToDispatch() ([]*Campaign, error)
+
+	// StartCampaign marks a scheduled campaign as running.
+	// imsis are recorded as additional targets of the campaign, which is
+	// how subscriber groups get resolved at start time.
+	StartCampaign(networkID string, pk string, imsis []string) error
+
+	// GetPendingTargets returns up to limit IMSIs of the campaign for which
+	// no message has been created yet, in lexicographic order.
+	// A limit <= 0 returns all pending targets.
+	GetPendingTargets(networkID string, pk string, limit int) ([]string, error)
+
+	// DispatchMessages creates the rendered messages of a batch of campaign
+	// targets, and records the targets whose message couldn't be rendered.
+	// Map keys for both arguments are IMSIs. Targets which already have a
+	// message are skipped.
+	// The campaign is marked as completed once no target is pending.
+	DispatchMessages(networkID string, pk string, messages map[string]string, failures map[string]string) error
+
+	// GetCampaignReport aggregates the delivery status of a campaign's
+	// messages. Returns merrors.ErrNotFound if the campaign doesn't exist.
+	GetCampaignReport(networkID string, pk string) (*CampaignReport, error)
+}
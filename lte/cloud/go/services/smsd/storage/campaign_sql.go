@@ -0,0 +1,558 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/thoas/go-funk"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const (
+	campaignTable = "smsd_campaigns"
+
+	campaignPkCol       = "pk"
+	campaignNidCol      = "network_id"
+	campaignStatusCol   = "status"
+	campaignSpecCol     = "spec"
+	campaignStartCol    = "start_sec"
+	campaignCreatedCol  = "time_created_sec"
+	campaignDispatchCol = "last_dispatch_sec"
+
+	targetsTable = "smsd_campaign_targets"
+
+	targetCampaignCol = "campaign_pk"
+	targetImsiCol     = "imsi"
+	targetSmsCol      = "sms_pk"
+	targetErrorCol    = "error_message"
+)
+
+const deletedMessageError = "message was deleted"
+
+var allCampaignCols = []string{campaignPkCol, campaignNidCol, campaignStatusCol, campaignSpecCol, campaignCreatedCol, campaignDispatchCol}
+
+func NewSQLCampaignStorage(db *sql.DB, sqlBuilder sqorc.StatementBuilder, idGenerator storage.IDGenerator) CampaignStorage {
+	return &sqlCampaignStorage{
+		db:          db,
+		builder:     sqlBuilder,
+		idGenerator: idGenerator,
+	}
+}
+
+type sqlCampaignStorage struct {
+	db          *sql.DB
+	builder     sqorc.StatementBuilder
+	idGenerator storage.IDGenerator
+}
+
+func (s *sqlCampaignStorage) Init() (err error) {
+	tx, err := s.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return fmt.Errorf("table initialization failed: %w", err)
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				err = fmt.Errorf("%s; rollback error: %s", err, rollbackErr)
+			}
+		}
+	}()
+
+	_, err = s.builder.CreateTable(campaignTable).
+		IfNotExists().
+		Column(campaignPkCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(campaignNidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(campaignStatusCol).Type(sqorc.ColumnTypeInt).NotNull().Default(0).EndColumn().
+		Column(campaignSpecCol).Type(sqorc.ColumnTypeBytes).NotNull().EndColumn().
+		Column(campaignStartCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(campaignCreatedCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(campaignDispatchCol).Type(sqorc.ColumnTypeInt).EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = fmt.Errorf("failed to create campaign table: %w", err)
+		return
+	}
+
+	_, err = s.builder.CreateTable(targetsTable).
+		IfNotExists().
+		Column(targetCampaignCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(targetImsiCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(targetSmsCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(targetErrorCol).Type(sqorc.ColumnTypeText).EndColumn().
+		PrimaryKey(targetCampaignCol, targetImsiCol).
+		ForeignKey(campaignTable, map[string]string{targetCampaignCol: campaignPkCol}, sqorc.ColumnOnDeleteCascade).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = fmt.Errorf("failed to create campaign targets table: %w", err)
+		return
+	}
+
+	return
+}
+
+func (s *sqlCampaignStorage) GetCampaigns(networkID string, pks []string) ([]*Campaign, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		builder := s.builder.Select(allCampaignCols...).
+			From(campaignTable).
+			Where(sq.Eq{campaignNidCol: networkID}).
+			OrderBy(campaignPkCol).
+			RunWith(tx)
+		if !funk.IsEmpty(pks) {
+			builder = builder.Where(sq.Eq{campaignPkCol: pks})
+		}
+
+		rows, err := builder.Query()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load campaigns: %w", err)
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "GetCampaigns")
+
+		return scanCampaigns(rows)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.([]*Campaign), nil
+}
+
+func (s *sqlCampaignStorage) CreateCampaign(networkID string, campaign *MutableCampaign) (string, error) {
+	spec, err := proto.Marshal(campaign)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize campaign: %w", err)
+	}
+
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		pk := s.idGenerator.New()
+		timeCreated := clock.Now().Unix()
+		startTime := timeCreated
+		if campaign.StartTime != nil {
+			startTime = campaign.StartTime.Seconds
+		}
+
+		_, err := s.builder.Insert(campaignTable).
+			Columns(campaignPkCol, campaignNidCol, campaignStatusCol, campaignSpecCol, campaignStartCol, campaignCreatedCol).
+			Values(pk, networkID, CampaignStatus_SCHEDULED, spec, startTime, timeCreated).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return "", fmt.Errorf("failed to create campaign: %w", err)
+		}
+
+		err = insertTargets(tx, s.builder, pk, campaign.Imsis)
+		if err != nil {
+			return "", err
+		}
+		return pk, nil
+	}
+
+	iPK, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return "", err
+	}
+	return iPK.(string), nil
+}
+
+func (s *sqlCampaignStorage) DeleteCampaigns(networkID string, pks []string) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.Delete(campaignTable).
+			Where(sq.Eq{campaignNidCol: networkID, campaignPkCol: pks}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete campaigns: %w", err)
+		}
+		return nil, nil
+	}
+
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *sqlCampaignStorage) GetCampaignsToDispatch() ([]*Campaign, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		/*
+			SELECT * FROM smsd_campaigns
+			WHERE status != COMPLETED AND start_sec <= {now}
+			ORDER BY pk
+		*/
+		rows, err := s.builder.Select(allCampaignCols...).
+			From(campaignTable).
+			Where(sq.And{
+				sq.NotEq{campaignStatusCol: CampaignStatus_COMPLETED},
+				sq.LtOrEq{campaignStartCol: clock.Now().Unix()},
+			}).
+			OrderBy(campaignPkCol).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load campaigns to dispatch: %w", err)
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "GetCampaignsToDispatch")
+
+		return scanCampaigns(rows)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.([]*Campaign), nil
+}
+
+func (s *sqlCampaignStorage) StartCampaign(networkID string, pk string, imsis []string) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := loadCampaign(tx, s.builder, networkID, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.builder.Update(campaignTable).
+			Set(campaignStatusCol, CampaignStatus_RUNNING).
+			Where(sq.Eq{campaignNidCol: networkID, campaignPkCol: pk}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to start campaign: %w", err)
+		}
+
+		return nil, insertTargets(tx, s.builder, pk, imsis)
+	}
+
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *sqlCampaignStorage) GetPendingTargets(networkID string, pk string, limit int) ([]string, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := loadCampaign(tx, s.builder, networkID, pk)
+		if err != nil {
+			return nil, err
+		}
+		return loadPendingTargets(tx, s.builder, pk, limit)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.([]string), nil
+}
+
+func (s *sqlCampaignStorage) DispatchMessages(networkID string, pk string, messages map[string]string, failures map[string]string) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		campaign, err := loadCampaign(tx, s.builder, networkID, pk)
+		if err != nil {
+			return nil, err
+		}
+		pending, err := loadPendingTargets(tx, s.builder, pk, 0)
+		if err != nil {
+			return nil, err
+		}
+		isPending := map[string]bool{}
+		for _, imsi := range pending {
+			isPending[imsi] = true
+		}
+
+		sc := sq.NewStmtCache(tx)
+		defer sqorc.ClearStatementCacheLogOnError(sc, "DispatchMessages")
+
+		now := clock.Now().Unix()
+		for _, imsi := range sortedKeys(messages) {
+			if !isPending[imsi] {
+				continue
+			}
+			smsPk := s.idGenerator.New()
+			_, err := s.builder.Insert(smsTable).
+				Columns(pkCol, nidCol, imsiCol, sourceCol, messageCol, createdCol).
+				Values(smsPk, networkID, imsi, campaign.Spec.SourceMsisdn, messages[imsi], now).
+				RunWith(sc).
+				Exec()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create campaign SMS: %w", err)
+			}
+			err = updateTarget(sc, s.builder, pk, imsi, targetSmsCol, smsPk)
+			if err != nil {
+				return nil, err
+			}
+			delete(isPending, imsi)
+		}
+		for _, imsi := range sortedKeys(failures) {
+			if !isPending[imsi] {
+				continue
+			}
+			err := updateTarget(sc, s.builder, pk, imsi, targetErrorCol, failures[imsi])
+			if err != nil {
+				return nil, err
+			}
+			delete(isPending, imsi)
+		}
+
+		update := s.builder.Update(campaignTable).
+			Set(campaignDispatchCol, now).
+			Where(sq.Eq{campaignNidCol: networkID, campaignPkCol: pk})
+		if len(isPending) == 0 {
+			update = update.Set(campaignStatusCol, CampaignStatus_COMPLETED)
+		}
+		_, err = update.RunWith(sc).Exec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to update campaign: %w", err)
+		}
+		return nil, nil
+	}
+
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *sqlCampaignStorage) GetCampaignReport(networkID string, pk string) (*CampaignReport, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := loadCampaign(tx, s.builder, networkID, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		/*
+			SELECT smsd_campaign_targets.imsi, smsd_campaign_targets.sms_pk, smsd_campaign_targets.error_message,
+				smsd_messages.pk, smsd_messages.is_delivered, smsd_messages.num_attempts, smsd_messages.error_message
+			FROM smsd_campaign_targets
+			LEFT JOIN smsd_messages ON smsd_messages.pk = smsd_campaign_targets.sms_pk
+			WHERE smsd_campaign_targets.campaign_pk = {pk}
+		*/
+		rows, err := s.builder.Select(
+			getFQColName(targetsTable, targetImsiCol), getFQColName(targetsTable, targetSmsCol), getFQColName(targetsTable, targetErrorCol),
+			getFQColName(smsTable, pkCol), getFQColName(smsTable, deliveredCol), getFQColName(smsTable, attemptsCol), getFQColName(smsTable, errorCol),
+		).
+			From(targetsTable).
+			LeftJoin(fmt.Sprintf("%s ON %s=%s", smsTable, getFQColName(smsTable, pkCol), getFQColName(targetsTable, targetSmsCol))).
+			Where(sq.Eq{getFQColName(targetsTable, targetCampaignCol): pk}).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load campaign targets: %w", err)
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "GetCampaignReport")
+
+		return scanCampaignReport(rows)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.(*CampaignReport), nil
+}
+
+func loadCampaign(tx *sql.Tx, builder sqorc.StatementBuilder, networkID string, pk string) (*Campaign, error) {
+	rows, err := builder.Select(allCampaignCols...).
+		From(campaignTable).
+		Where(sq.Eq{campaignNidCol: networkID, campaignPkCol: pk}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load campaign: %w", err)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "loadCampaign")
+
+	campaigns, err := scanCampaigns(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(campaigns) == 0 {
+		return nil, merrors.ErrNotFound
+	}
+	return campaigns[0], nil
+}
+
+func insertTargets(tx *sql.Tx, builder sqorc.StatementBuilder, pk string, imsis []string) error {
+	if funk.IsEmpty(imsis) {
+		return nil
+	}
+
+	// INSERT INTO smsd_campaign_targets (campaign_pk, imsi) VALUES ($1, $2)
+	// ON CONFLICT (campaign_pk, imsi) DO NOTHING
+	sc := sq.NewStmtCache(tx)
+	defer sqorc.ClearStatementCacheLogOnError(sc, "insertTargets")
+	for _, imsi := range funk.UniqString(imsis) {
+		_, err := builder.Insert(targetsTable).
+			Columns(targetCampaignCol, targetImsiCol).
+			Values(pk, imsi).
+			OnConflict(nil, targetCampaignCol, targetImsiCol).
+			RunWith(sc).
+			Exec()
+		if err != nil {
+			return fmt.Errorf("failed to create campaign targets: %w", err)
+		}
+	}
+	return nil
+}
+
+func loadPendingTargets(tx *sql.Tx, builder sqorc.StatementBuilder, pk string, limit int) ([]string, error) {
+	/*
+		SELECT imsi FROM smsd_campaign_targets
+		WHERE campaign_pk = {pk} AND sms_pk IS NULL AND error_message IS NULL
+		ORDER BY imsi
+		[[ LIMIT {limit} ]]
+	*/
+	selectBuilder := builder.Select(targetImsiCol).
+		From(targetsTable).
+		Where(sq.Eq{targetCampaignCol: pk, targetSmsCol: nil, targetErrorCol: nil}).
+		OrderBy(targetImsiCol).
+		RunWith(tx)
+	if limit > 0 {
+		selectBuilder = selectBuilder.Limit(uint64(limit))
+	}
+
+	rows, err := selectBuilder.Query()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending campaign targets: %w", err)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "loadPendingTargets")
+
+	ret := []string{}
+	for rows.Next() {
+		var imsi string
+		err := rows.Scan(&imsi)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan campaign target: %w", err)
+		}
+		ret = append(ret, imsi)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("sql rows err: %w", err)
+	}
+	return ret, nil
+}
+
+func updateTarget(sc sq.BaseRunner, builder sqorc.StatementBuilder, pk string, imsi string, col string, value string) error {
+	_, err := builder.Update(targetsTable).
+		Set(col, value).
+		Where(sq.Eq{targetCampaignCol: pk, targetImsiCol: imsi}).
+		RunWith(sc).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("failed to update campaign target: %w", err)
+	}
+	return nil
+}
+
+func scanCampaigns(rows *sql.Rows) ([]*Campaign, error) {
+	ret := []*Campaign{}
+	for rows.Next() {
+		var pk, networkID string
+		var status, timeCreated int64
+		var spec []byte
+		var lastDispatch sql.NullInt64
+
+		err := rows.Scan(&pk, &networkID, &status, &spec, &timeCreated, &lastDispatch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan campaign row: %w", err)
+		}
+
+		campaign := &Campaign{
+			Pk:        pk,
+			NetworkId: networkID,
+			Status:    CampaignStatus(status),
+			Spec:      &MutableCampaign{},
+		}
+		err = proto.Unmarshal(spec, campaign.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to deserialize campaign %s: %w", pk, err)
+		}
+		campaign.CreatedTime, err = ptypes.TimestampProto(time.Unix(timeCreated, 0))
+		if err != nil {
+			return nil, fmt.Errorf("could not validate created time for campaign %s: %w", pk, err)
+		}
+		if lastDispatch.Valid {
+			campaign.LastDispatchTime, err = ptypes.TimestampProto(time.Unix(lastDispatch.Int64, 0))
+			if err != nil {
+				return nil, fmt.Errorf("could not validate dispatch time for campaign %s: %w", pk, err)
+			}
+		}
+		ret = append(ret, campaign)
+	}
+	err := rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("sql rows err: %w", err)
+	}
+	return ret, nil
+}
+
+func scanCampaignReport(rows *sql.Rows) (*CampaignReport, error) {
+	ret := &CampaignReport{Failures: map[string]string{}}
+	for rows.Next() {
+		var imsi string
+		var targetSms, targetError, smsPk, smsError sql.NullString
+		var delivered sql.NullBool
+		var numAttempts sql.NullInt64
+
+		err := rows.Scan(&imsi, &targetSms, &targetError, &smsPk, &delivered, &numAttempts, &smsError)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan campaign target row: %w", err)
+		}
+
+		ret.Total++
+		switch {
+		case targetError.Valid:
+			ret.Failed++
+			ret.Failures[imsi] = targetError.String
+		case !targetSms.Valid:
+			ret.Pending++
+		case !smsPk.Valid:
+			ret.Failed++
+			ret.Failures[imsi] = deletedMessageError
+		case delivered.Bool:
+			ret.Delivered++
+		case numAttempts.Int64 >= maxRetries:
+			ret.Failed++
+			ret.Failures[imsi] = smsError.String
+		default:
+			ret.Waiting++
+		}
+	}
+	err := rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("sql rows err: %w", err)
+	}
+	return ret, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,223 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/test_utils"
+	"magma/orc8r/lib/go/merrors"
+)
+
+func TestSQLCampaignStorage_Integration(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign.keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	idGenerator := &mockIDGenerator{}
+	smsStore := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &mockRefCounter{numRefs: 1}, idGenerator)
+	store := storage.NewSQLCampaignStorage(db, sqorc.GetSqlBuilder(), idGenerator)
+
+	err = smsStore.Init()
+	if err != nil {
+		t.Fatalf("Could not initialize smsd tables: %s", err)
+	}
+	err = store.Init()
+	if err != nil {
+		t.Fatalf("Could not initialize smsd campaign tables: %s", err)
+	}
+
+	var frozenClock int64 = 1000
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+	defer clock.UnfreezeClock(t)
+
+	// Empty-case tests
+	actualCampaigns, err := store.GetCampaigns("n1", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actualCampaigns)
+	actualCampaigns, err = store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	assert.Empty(t, actualCampaigns)
+	_, err = store.GetCampaignReport("n1", "1")
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Create an IMSI list campaign starting now, and a group campaign
+	// starting later
+	pk, err := store.CreateCampaign("n1", &storage.MutableCampaign{
+		Name:         "reminder",
+		SourceMsisdn: "123",
+		Template:     "hello {{imsi}}",
+		Imsis:        []string{"IMSI1", "IMSI2", "IMSI3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", pk)
+	pk, err = store.CreateCampaign("n1", &storage.MutableCampaign{
+		Name:            "alert",
+		SourceMsisdn:    "456",
+		Template:        "alert",
+		SubscriberGroup: "group1",
+		StartTime:       timestampProto(t, frozenClock+100),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", pk)
+
+	actualCampaigns, err = store.GetCampaigns("n1", nil)
+	assert.NoError(t, err)
+	expectedCampaigns := []*storage.Campaign{
+		{
+			Pk:        "1",
+			NetworkId: "n1",
+			Status:    storage.CampaignStatus_SCHEDULED,
+			Spec: &storage.MutableCampaign{
+				Name:         "reminder",
+				SourceMsisdn: "123",
+				Template:     "hello {{imsi}}",
+				Imsis:        []string{"IMSI1", "IMSI2", "IMSI3"},
+			},
+			CreatedTime: timestampProto(t, frozenClock),
+		},
+		{
+			Pk:        "2",
+			NetworkId: "n1",
+			Status:    storage.CampaignStatus_SCHEDULED,
+			Spec: &storage.MutableCampaign{
+				Name:            "alert",
+				SourceMsisdn:    "456",
+				Template:        "alert",
+				SubscriberGroup: "group1",
+				StartTime:       timestampProto(t, frozenClock+100),
+			},
+			CreatedTime: timestampProto(t, frozenClock),
+		},
+	}
+	test_utils.AssertListsEqual(t, expectedCampaigns, actualCampaigns)
+
+	// Wrong network
+	actualCampaigns, err = store.GetCampaigns("n2", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actualCampaigns)
+	_, err = store.GetPendingTargets("n2", "1", 0)
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Only the first campaign has started
+	actualCampaigns, err = store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	test_utils.AssertListsEqual(t, expectedCampaigns[:1], actualCampaigns)
+
+	report, err := store.GetCampaignReport("n1", "1")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, &storage.CampaignReport{Total: 3, Pending: 3, Failures: map[string]string{}}, report)
+	report, err = store.GetCampaignReport("n1", "2")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, &storage.CampaignReport{Failures: map[string]string{}}, report)
+
+	// Start the first campaign and dispatch a batch of 2 targets
+	err = store.StartCampaign("n1", "1", nil)
+	assert.NoError(t, err)
+	targets, err := store.GetPendingTargets("n1", "1", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI1", "IMSI2"}, targets)
+	err = store.DispatchMessages("n1", "1", map[string]string{"IMSI1": "hello IMSI1"}, map[string]string{"IMSI2": "missing variable"})
+	assert.NoError(t, err)
+
+	actualCampaigns, err = store.GetCampaigns("n1", []string{"1"})
+	assert.NoError(t, err)
+	expectedCampaigns[0].Status = storage.CampaignStatus_RUNNING
+	expectedCampaigns[0].LastDispatchTime = timestampProto(t, frozenClock)
+	test_utils.AssertListsEqual(t, expectedCampaigns[:1], actualCampaigns)
+
+	actualMessages, err := smsStore.GetSMSs("n1", nil, nil, false, nil, nil)
+	assert.NoError(t, err)
+	expectedMessages := []*storage.SMS{
+		{
+			Pk:           "3",
+			Status:       storage.MessageStatus_WAITING,
+			Imsi:         "IMSI1",
+			SourceMsisdn: "123",
+			Message:      "hello IMSI1",
+			CreatedTime:  timestampProto(t, frozenClock),
+		},
+	}
+	assert.Equal(t, expectedMessages, actualMessages)
+
+	report, err = store.GetCampaignReport("n1", "1")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, &storage.CampaignReport{Total: 3, Pending: 1, Waiting: 1, Failed: 1, Failures: map[string]string{"IMSI2": "missing variable"}}, report)
+
+	// Dispatching already-dispatched targets is a no-op, dispatching the
+	// last target completes the campaign
+	err = store.DispatchMessages("n1", "1", map[string]string{"IMSI1": "hello again", "IMSI3": "hello IMSI3"}, nil)
+	assert.NoError(t, err)
+	targets, err = store.GetPendingTargets("n1", "1", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, targets)
+	actualCampaigns, err = store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	assert.Empty(t, actualCampaigns)
+
+	// Deliver IMSI1's message, fail IMSI3's message until it runs out of
+	// retries
+	for i := 0; i < 3; i++ {
+		frozenClock += 1000
+		clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+		actualMessages, err = smsStore.GetSMSsToDeliver("n1", []string{"IMSI1", "IMSI3"}, 0)
+		assert.NoError(t, err)
+		delivered := map[string][]storage.SMSRef{}
+		if i == 0 {
+			delivered["IMSI1"] = []storage.SMSRef{0}
+		}
+		err = smsStore.ReportDelivery("n1", delivered, map[string][]storage.SMSFailureReport{"IMSI3": {{Ref: 0, ErrorMessage: "unreachable"}}})
+		assert.NoError(t, err)
+	}
+
+	report, err = store.GetCampaignReport("n1", "1")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, &storage.CampaignReport{Total: 3, Delivered: 1, Failed: 2, Failures: map[string]string{"IMSI2": "missing variable", "IMSI3": "unreachable"}}, report)
+
+	// Deleted messages are reported as failures
+	err = smsStore.DeleteSMSs("n1", []string{"3"})
+	assert.NoError(t, err)
+	report, err = store.GetCampaignReport("n1", "1")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, &storage.CampaignReport{Total: 3, Failed: 3, Failures: map[string]string{"IMSI1": "message was deleted", "IMSI2": "missing variable", "IMSI3": "unreachable"}}, report)
+
+	// Start the group campaign with the group's members
+	actualCampaigns, err = store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2"}, []string{actualCampaigns[0].Pk})
+	err = store.StartCampaign("n1", "2", []string{"IMSI4", "IMSI5"})
+	assert.NoError(t, err)
+	report, err = store.GetCampaignReport("n1", "2")
+	assert.NoError(t, err)
+	test_utils.AssertMessagesEqual(t, &storage.CampaignReport{Total: 2, Pending: 2, Failures: map[string]string{}}, report)
+
+	// Deleting the campaign stops it but keeps its messages
+	err = store.DeleteCampaigns("n1", []string{"1", "2"})
+	assert.NoError(t, err)
+	actualCampaigns, err = store.GetCampaigns("n1", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actualCampaigns)
+	actualCampaigns, err = store.GetCampaignsToDispatch()
+	assert.NoError(t, err)
+	assert.Empty(t, actualCampaigns)
+	actualMessages, err = smsStore.GetSMSs("n1", nil, nil, false, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, actualMessages, 1)
+}
@@ -0,0 +1,66 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage
+
+// MOSegment identifies a segment of a concatenated mobile-originated message.
+type MOSegment struct {
+	// Reference is the concatenation reference shared by all segments of a
+	// message
+	Reference int
+	// Total is the number of segments in the message
+	Total int
+	// Sequence is the 1-indexed position of this segment in the message
+	Sequence int
+}
+
+// MOSMSStorage is the storage interface for mobile-originated SMS messages,
+// which are received from subscribers and relayed to an external SMSC.
+//
+// A message is stored once it has been fully received, then submitted to
+// the SMSC through ReportSubmission. The SMSC's delivery receipt is finally
+// correlated back to the message with ReportReceipt.
+type MOSMSStorage interface {
+	// Init performs on-start initialization work such as table creation.
+	Init() error
+
+	// GetMOSMSs returns all MO messages received in a network.
+	// If pks is non-empty, this will fetch only the specified messages.
+	GetMOSMSs(networkID string, pks []string) ([]*MOSMS, error)
+
+	// ReceiveMOSMS stores a message received from a subscriber. The
+	// auto-generated pk for the message is returned.
+	//
+	// If segment is non-nil, the message is one segment of a concatenated
+	// message. Segments are buffered until all segments of the message have
+	// been received, at which point the reassembled message is stored and its
+	// pk returned. An empty pk is returned while segments are missing.
+	// Duplicate segments are ignored.
+	ReceiveMOSMS(networkID string, sms *MutableMOSMS, segment *MOSegment) (string, error)
+
+	// GetMOSMSsToRelay returns up to limit messages across all networks which
+	// have yet to be submitted to the SMSC, oldest first.
+	// A limit <= 0 returns all such messages.
+	GetMOSMSsToRelay(limit int) ([]*MOSMS, error)
+
+	// ReportSubmission reports the result of submitting a message to the SMSC.
+	// A non-empty smscMessageID marks the message as submitted, otherwise
+	// errorMessage is recorded and the message is retried until it runs out
+	// of attempts.
+	ReportSubmission(networkID string, pk string, smscMessageID string, errorMessage string) error
+
+	// ReportReceipt maps a delivery receipt from the SMSC back to the message
+	// it was submitted as. Returns merrors.ErrNotFound if no submitted message
+	// has the SMSC message ID.
+	ReportReceipt(smscMessageID string, delivered bool, errorMessage string) error
+}
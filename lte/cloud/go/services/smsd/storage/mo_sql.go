@@ -0,0 +1,443 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/thoas/go-funk"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const (
+	moTable    = "smsd_mo_messages"
+	moSmscIdx  = "smsd_mo_smsc_id_idx"
+	moQueueIdx = "smsd_mo_status_idx"
+
+	moPkCol          = "pk"
+	moNidCol         = "network_id"
+	moStatusCol      = "status"
+	moImsiCol        = "imsi"
+	moDestinationCol = "destination"
+	moMessageCol     = "message"
+	moReceivedCol    = "time_received_sec"
+	moAttemptCol     = "last_attempt_sec"
+	moAttemptsCol    = "num_attempts"
+	moErrorCol       = "error_message"
+	moSmscIDCol      = "smsc_message_id"
+
+	segmentsTable = "smsd_mo_segments"
+
+	segmentNidCol         = "network_id"
+	segmentImsiCol        = "imsi"
+	segmentRefCol         = "concat_ref"
+	segmentSeqCol         = "seq_num"
+	segmentTotalCol       = "num_segments"
+	segmentDestinationCol = "destination"
+	segmentMessageCol     = "message"
+	segmentReceivedCol    = "time_received_sec"
+)
+
+const (
+	// How long we'll buffer segments of a concatenated message before
+	// giving up on the missing segments
+	segmentTimeout = time.Hour
+)
+
+var allMOCols = []string{moPkCol, moNidCol, moStatusCol, moImsiCol, moDestinationCol, moMessageCol, moReceivedCol, moAttemptCol, moAttemptsCol, moErrorCol, moSmscIDCol}
+
+func NewSQLMOSMSStorage(db *sql.DB, sqlBuilder sqorc.StatementBuilder, idGenerator storage.IDGenerator) MOSMSStorage {
+	return &sqlMOSMSStorage{
+		db:          db,
+		builder:     sqlBuilder,
+		idGenerator: idGenerator,
+	}
+}
+
+type sqlMOSMSStorage struct {
+	db          *sql.DB
+	builder     sqorc.StatementBuilder
+	idGenerator storage.IDGenerator
+}
+
+func (s *sqlMOSMSStorage) Init() (err error) {
+	tx, err := s.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return fmt.Errorf("table initialization failed: %w", err)
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				err = fmt.Errorf("%s; rollback error: %s", err, rollbackErr)
+			}
+		}
+	}()
+
+	_, err = s.builder.CreateTable(moTable).
+		IfNotExists().
+		Column(moPkCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(moNidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(moStatusCol).Type(sqorc.ColumnTypeInt).NotNull().Default(0).EndColumn().
+		Column(moImsiCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(moDestinationCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(moMessageCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(moReceivedCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(moAttemptCol).Type(sqorc.ColumnTypeInt).EndColumn().
+		Column(moAttemptsCol).Type(sqorc.ColumnTypeInt).NotNull().Default(0).EndColumn().
+		Column(moErrorCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(moSmscIDCol).Type(sqorc.ColumnTypeText).EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = fmt.Errorf("failed to create MO SMS table: %w", err)
+		return
+	}
+
+	// Delivery receipts are looked up by SMSC message ID
+	_, err = s.builder.CreateIndex(moSmscIdx).
+		IfNotExists().
+		On(moTable).
+		Columns(moSmscIDCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = fmt.Errorf("failed to create MO SMS SMSC ID index: %w", err)
+		return
+	}
+
+	_, err = s.builder.CreateIndex(moQueueIdx).
+		IfNotExists().
+		On(moTable).
+		Columns(moStatusCol, moReceivedCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = fmt.Errorf("failed to create MO SMS status index: %w", err)
+		return
+	}
+
+	_, err = s.builder.CreateTable(segmentsTable).
+		IfNotExists().
+		Column(segmentNidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(segmentImsiCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(segmentRefCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(segmentSeqCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(segmentTotalCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(segmentDestinationCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(segmentMessageCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(segmentReceivedCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		PrimaryKey(segmentNidCol, segmentImsiCol, segmentRefCol, segmentSeqCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = fmt.Errorf("failed to create MO SMS segments table: %w", err)
+		return
+	}
+
+	return
+}
+
+func (s *sqlMOSMSStorage) GetMOSMSs(networkID string, pks []string) ([]*MOSMS, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		builder := s.builder.Select(allMOCols...).
+			From(moTable).
+			Where(sq.Eq{moNidCol: networkID}).
+			OrderBy(moPkCol).
+			RunWith(tx)
+		if !funk.IsEmpty(pks) {
+			builder = builder.Where(sq.Eq{moPkCol: pks})
+		}
+
+		rows, err := builder.Query()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load MO SMSs: %w", err)
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "GetMOSMSs")
+
+		return scanMOSMSs(rows)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.([]*MOSMS), nil
+}
+
+func (s *sqlMOSMSStorage) ReceiveMOSMS(networkID string, sms *MutableMOSMS, segment *MOSegment) (string, error) {
+	if segment != nil && segment.Total > 1 && (segment.Sequence < 1 || segment.Sequence > segment.Total) {
+		return "", fmt.Errorf("invalid segment %d of %d", segment.Sequence, segment.Total)
+	}
+
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		now := clock.Now().Unix()
+		message := sms
+		if segment != nil && segment.Total > 1 {
+			reassembled, err := s.receiveSegment(tx, networkID, sms, segment, now)
+			if err != nil || reassembled == nil {
+				return "", err
+			}
+			message = reassembled
+		}
+
+		pk := s.idGenerator.New()
+		_, err := s.builder.Insert(moTable).
+			Columns(moPkCol, moNidCol, moStatusCol, moImsiCol, moDestinationCol, moMessageCol, moReceivedCol).
+			Values(pk, networkID, MOMessageStatus_RECEIVED, message.Imsi, message.Destination, message.Message, now).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return "", fmt.Errorf("failed to create MO SMS: %w", err)
+		}
+		return pk, nil
+	}
+
+	iPK, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return "", err
+	}
+	return iPK.(string), nil
+}
+
+// receiveSegment buffers a segment of a concatenated message, returning the
+// reassembled message once all of its segments have been received.
+func (s *sqlMOSMSStorage) receiveSegment(tx *sql.Tx, networkID string, sms *MutableMOSMS, segment *MOSegment, now int64) (*MutableMOSMS, error) {
+	// Drop segments of messages which will never complete
+	_, err := s.builder.Delete(segmentsTable).
+		Where(sq.And{
+			sq.Eq{segmentNidCol: networkID, segmentImsiCol: sms.Imsi},
+			sq.Lt{segmentReceivedCol: now - int64(segmentTimeout.Seconds())},
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete expired MO SMS segments: %w", err)
+	}
+
+	// INSERT INTO smsd_mo_segments (...) VALUES (...)
+	// ON CONFLICT (network_id, imsi, concat_ref, seq_num) DO NOTHING
+	_, err = s.builder.Insert(segmentsTable).
+		Columns(segmentNidCol, segmentImsiCol, segmentRefCol, segmentSeqCol, segmentTotalCol, segmentDestinationCol, segmentMessageCol, segmentReceivedCol).
+		Values(networkID, sms.Imsi, segment.Reference, segment.Sequence, segment.Total, sms.Destination, sms.Message, now).
+		OnConflict(nil, segmentNidCol, segmentImsiCol, segmentRefCol, segmentSeqCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MO SMS segment: %w", err)
+	}
+
+	segmentKey := sq.Eq{segmentNidCol: networkID, segmentImsiCol: sms.Imsi, segmentRefCol: segment.Reference}
+	rows, err := s.builder.Select(segmentTotalCol, segmentDestinationCol, segmentMessageCol).
+		From(segmentsTable).
+		Where(segmentKey).
+		OrderBy(segmentSeqCol).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load MO SMS segments: %w", err)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "receiveSegment")
+
+	var parts []string
+	var destination string
+	for rows.Next() {
+		var total int
+		var dest, part string
+		err := rows.Scan(&total, &dest, &part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan MO SMS segment: %w", err)
+		}
+		// Segments of a previous message with the same reference can't be
+		// part of this message
+		if total != segment.Total {
+			continue
+		}
+		if destination == "" {
+			destination = dest
+		}
+		parts = append(parts, part)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("sql rows err: %w", err)
+	}
+	if len(parts) < segment.Total {
+		return nil, nil
+	}
+
+	_, err = s.builder.Delete(segmentsTable).
+		Where(segmentKey).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete MO SMS segments: %w", err)
+	}
+	return &MutableMOSMS{Imsi: sms.Imsi, Destination: destination, Message: strings.Join(parts, "")}, nil
+}
+
+func (s *sqlMOSMSStorage) GetMOSMSsToRelay(limit int) ([]*MOSMS, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		/*
+			SELECT * FROM smsd_mo_messages
+			WHERE status = RECEIVED
+			ORDER BY time_received_sec, pk
+			[[ LIMIT {limit} ]]
+		*/
+		builder := s.builder.Select(allMOCols...).
+			From(moTable).
+			Where(sq.Eq{moStatusCol: MOMessageStatus_RECEIVED}).
+			OrderBy(moReceivedCol, moPkCol).
+			RunWith(tx)
+		if limit > 0 {
+			builder = builder.Limit(uint64(limit))
+		}
+
+		rows, err := builder.Query()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load MO SMSs to relay: %w", err)
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "GetMOSMSsToRelay")
+
+		return scanMOSMSs(rows)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.([]*MOSMS), nil
+}
+
+func (s *sqlMOSMSStorage) ReportSubmission(networkID string, pk string, smscMessageID string, errorMessage string) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		update := s.builder.Update(moTable).
+			Set(moAttemptCol, clock.Now().Unix()).
+			Set(moAttemptsCol, sq.Expr(fmt.Sprintf("%s+1", moAttemptsCol))).
+			Where(sq.Eq{moNidCol: networkID, moPkCol: pk, moStatusCol: MOMessageStatus_RECEIVED})
+		if smscMessageID != "" {
+			update = update.
+				Set(moStatusCol, MOMessageStatus_SUBMITTED).
+				Set(moSmscIDCol, smscMessageID).
+				Set(moErrorCol, nil)
+		} else {
+			// Messages run out of attempts on their last failure
+			update = update.
+				Set(moStatusCol, sq.Expr(
+					fmt.Sprintf("CASE WHEN %s >= ? THEN ? ELSE ? END", moAttemptsCol),
+					maxRetries-1, MOMessageStatus_UNDELIVERABLE, MOMessageStatus_RECEIVED,
+				)).
+				Set(moErrorCol, errorMessage)
+		}
+
+		res, err := update.RunWith(tx).Exec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to update MO SMS: %w", err)
+		}
+		return nil, expectRowsAffected(res)
+	}
+
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *sqlMOSMSStorage) ReportReceipt(smscMessageID string, delivered bool, errorMessage string) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		update := s.builder.Update(moTable).
+			Where(sq.Eq{moSmscIDCol: smscMessageID, moStatusCol: MOMessageStatus_SUBMITTED})
+		if delivered {
+			update = update.Set(moStatusCol, MOMessageStatus_SMSC_DELIVERED)
+		} else {
+			update = update.
+				Set(moStatusCol, MOMessageStatus_UNDELIVERABLE).
+				Set(moErrorCol, errorMessage)
+		}
+
+		res, err := update.RunWith(tx).Exec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to update MO SMS: %w", err)
+		}
+		return nil, expectRowsAffected(res)
+	}
+
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func expectRowsAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if n == 0 {
+		return merrors.ErrNotFound
+	}
+	return nil
+}
+
+func scanMOSMSs(rows *sql.Rows) ([]*MOSMS, error) {
+	ret := []*MOSMS{}
+	for rows.Next() {
+		var pk, networkID, imsi, destination, message string
+		var status, timeReceived, attempts int64
+		var lastAttempt sql.NullInt64
+		var errorMessage, smscMessageID sql.NullString
+
+		err := rows.Scan(&pk, &networkID, &status, &imsi, &destination, &message, &timeReceived, &lastAttempt, &attempts, &errorMessage, &smscMessageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan MO SMS row: %w", err)
+		}
+
+		sms := &MOSMS{
+			Pk:            pk,
+			Status:        MOMessageStatus(status),
+			NetworkId:     networkID,
+			Imsi:          imsi,
+			Destination:   destination,
+			Message:       message,
+			AttemptCount:  uint32(attempts),
+			Error:         errorMessage.String,
+			SmscMessageId: smscMessageID.String,
+		}
+		sms.ReceivedTime, err = ptypes.TimestampProto(time.Unix(timeReceived, 0))
+		if err != nil {
+			return nil, fmt.Errorf("could not validate received time for MO SMS %s: %w", pk, err)
+		}
+		if lastAttempt.Valid {
+			sms.LastSubmitAttemptTime, err = ptypes.TimestampProto(time.Unix(lastAttempt.Int64, 0))
+			if err != nil {
+				return nil, fmt.Errorf("could not validate submission time for MO SMS %s: %w", pk, err)
+			}
+		}
+		ret = append(ret, sms)
+	}
+	err := rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("sql rows err: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,147 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/test_utils"
+	"magma/orc8r/lib/go/merrors"
+)
+
+func TestSQLMOSMSStorage_Integration(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign.keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	store := storage.NewSQLMOSMSStorage(db, sqorc.GetSqlBuilder(), &mockIDGenerator{})
+	err = store.Init()
+	if err != nil {
+		t.Fatalf("Could not initialize smsd MO tables: %s", err)
+	}
+
+	var frozenClock int64 = 1000
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+	defer clock.UnfreezeClock(t)
+
+	// Empty-case tests
+	actual, err := store.GetMOSMSs("n1", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+	actual, err = store.GetMOSMSsToRelay(0)
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+	err = store.ReportReceipt("smsc1", true, "")
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Single message
+	pk, err := store.ReceiveMOSMS("n1", &storage.MutableMOSMS{Imsi: "IMSI1", Destination: "123", Message: "hello"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", pk)
+
+	// Concatenated message, with a duplicated segment and segments received
+	// out of order
+	segments := []struct {
+		message string
+		seq     int
+	}{{"c", 3}, {"a", 1}, {"c", 3}, {"b", 2}}
+	for i, s := range segments {
+		pk, err = store.ReceiveMOSMS(
+			"n1",
+			&storage.MutableMOSMS{Imsi: "IMSI2", Destination: "456", Message: s.message},
+			&storage.MOSegment{Reference: 7, Total: 3, Sequence: s.seq},
+		)
+		assert.NoError(t, err)
+		if i < len(segments)-1 {
+			assert.Empty(t, pk)
+		} else {
+			assert.Equal(t, "2", pk)
+		}
+	}
+	_, err = store.ReceiveMOSMS("n1", &storage.MutableMOSMS{Imsi: "IMSI2"}, &storage.MOSegment{Reference: 7, Total: 3, Sequence: 4})
+	assert.EqualError(t, err, "invalid segment 4 of 3")
+
+	// Incomplete segments expire
+	pk, err = store.ReceiveMOSMS("n1", &storage.MutableMOSMS{Imsi: "IMSI3", Destination: "789", Message: "x"}, &storage.MOSegment{Reference: 1, Total: 2, Sequence: 1})
+	assert.NoError(t, err)
+	assert.Empty(t, pk)
+
+	expected := []*storage.MOSMS{
+		{Pk: "1", NetworkId: "n1", Imsi: "IMSI1", Destination: "123", Message: "hello", ReceivedTime: timestampProto(t, frozenClock)},
+		{Pk: "2", NetworkId: "n1", Imsi: "IMSI2", Destination: "456", Message: "abc", ReceivedTime: timestampProto(t, frozenClock)},
+	}
+	actual, err = store.GetMOSMSs("n1", nil)
+	assert.NoError(t, err)
+	test_utils.AssertListsEqual(t, expected, actual)
+	actual, err = store.GetMOSMSs("n2", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+
+	frozenClock += 7200
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+	pk, err = store.ReceiveMOSMS("n1", &storage.MutableMOSMS{Imsi: "IMSI3", Destination: "789", Message: "y"}, &storage.MOSegment{Reference: 1, Total: 2, Sequence: 2})
+	assert.NoError(t, err)
+	assert.Empty(t, pk)
+
+	actual, err = store.GetMOSMSsToRelay(1)
+	assert.NoError(t, err)
+	test_utils.AssertListsEqual(t, expected[:1], actual)
+
+	// Submit the first message, fail the second one until it runs out of
+	// attempts
+	err = store.ReportSubmission("n1", "1", "smsc1", "")
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		err = store.ReportSubmission("n1", "2", "", "bind failed")
+		assert.NoError(t, err)
+	}
+	err = store.ReportSubmission("n1", "2", "", "bind failed")
+	assert.Equal(t, merrors.ErrNotFound, err)
+	err = store.ReportSubmission("n2", "1", "smsc1", "")
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	actual, err = store.GetMOSMSsToRelay(0)
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+
+	expected[0].Status = storage.MOMessageStatus_SUBMITTED
+	expected[0].SmscMessageId = "smsc1"
+	expected[0].AttemptCount = 1
+	expected[0].LastSubmitAttemptTime = timestampProto(t, frozenClock)
+	expected[1].Status = storage.MOMessageStatus_UNDELIVERABLE
+	expected[1].AttemptCount = 3
+	expected[1].Error = "bind failed"
+	expected[1].LastSubmitAttemptTime = timestampProto(t, frozenClock)
+	actual, err = store.GetMOSMSs("n1", nil)
+	assert.NoError(t, err)
+	test_utils.AssertListsEqual(t, expected, actual)
+
+	// Delivery receipts
+	err = store.ReportReceipt("smsc1", true, "")
+	assert.NoError(t, err)
+	// Receipts are only applied once
+	err = store.ReportReceipt("smsc1", false, "expired")
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	expected[0].Status = storage.MOMessageStatus_SMSC_DELIVERED
+	actual, err = store.GetMOSMSs("n1", []string{"1"})
+	assert.NoError(t, err)
+	test_utils.AssertListsEqual(t, expected[:1], actual)
+}
@@ -80,8 +80,11 @@ type SMSReferenceCounter interface {
 	GetReferenceNumberCount(message string) uint16
 }
 
-type DefaultSMSReferenceCounter struct{}
+type DefaultSMSReferenceCounter struct {
+	// NationalLanguages must match those messages are encoded with.
+	NationalLanguages []sms_ll.NationalLanguage
+}
 
-func (*DefaultSMSReferenceCounter) GetReferenceNumberCount(message string) uint16 {
-	return uint16(sms_ll.GetMessageCount(message))
+func (c *DefaultSMSReferenceCounter) GetReferenceNumberCount(message string) uint16 {
+	return uint16(sms_ll.GetMessageCount(message, sms_ll.WithNationalLanguages(c.NationalLanguages...)))
 }
@@ -82,6 +82,115 @@ func (MessageStatus) EnumDescriptor() ([]byte, []int) {
 	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{0}
 }
 
+type CampaignStatus int32
+
+const (
+	// start time hasn't been reached yet
+	CampaignStatus_SCHEDULED CampaignStatus = 0
+	// messages are being created for the campaign's targets
+	CampaignStatus_RUNNING CampaignStatus = 1
+	// a message has been created (or failed to render) for every target
+	CampaignStatus_COMPLETED CampaignStatus = 2
+)
+
+// Enum value maps for CampaignStatus.
+var (
+	CampaignStatus_name = map[int32]string{
+		0: "SCHEDULED",
+		1: "RUNNING",
+		2: "COMPLETED",
+	}
+	CampaignStatus_value = map[string]int32{
+		"SCHEDULED": 0,
+		"RUNNING":   1,
+		"COMPLETED": 2,
+	}
+)
+
+func (x CampaignStatus) Enum() *CampaignStatus {
+	p := new(CampaignStatus)
+	*p = x
+	return p
+}
+
+func (x CampaignStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_enumTypes[1].Descriptor()
+}
+
+func (CampaignStatus) Type() protoreflect.EnumType {
+	return &file_lte_cloud_go_services_smsd_storage_storage_proto_enumTypes[1]
+}
+
+func (x CampaignStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignStatus.Descriptor instead.
+func (CampaignStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{1}
+}
+
+type MOMessageStatus int32
+
+const (
+	// received from the UE, waiting to be submitted to the SMSC
+	MOMessageStatus_RECEIVED MOMessageStatus = 0
+	// accepted by the SMSC, waiting for a delivery receipt
+	MOMessageStatus_SUBMITTED MOMessageStatus = 1
+	// the SMSC reported the message as delivered
+	MOMessageStatus_SMSC_DELIVERED MOMessageStatus = 2
+	// submission failed too many times, or the SMSC reported the message as
+	// undeliverable
+	MOMessageStatus_UNDELIVERABLE MOMessageStatus = 3
+)
+
+// Enum value maps for MOMessageStatus.
+var (
+	MOMessageStatus_name = map[int32]string{
+		0: "RECEIVED",
+		1: "SUBMITTED",
+		2: "SMSC_DELIVERED",
+		3: "UNDELIVERABLE",
+	}
+	MOMessageStatus_value = map[string]int32{
+		"RECEIVED":       0,
+		"SUBMITTED":      1,
+		"SMSC_DELIVERED": 2,
+		"UNDELIVERABLE":  3,
+	}
+)
+
+func (x MOMessageStatus) Enum() *MOMessageStatus {
+	p := new(MOMessageStatus)
+	*p = x
+	return p
+}
+
+func (x MOMessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MOMessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_enumTypes[2].Descriptor()
+}
+
+func (MOMessageStatus) Type() protoreflect.EnumType {
+	return &file_lte_cloud_go_services_smsd_storage_storage_proto_enumTypes[2]
+}
+
+func (x MOMessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MOMessageStatus.Descriptor instead.
+func (MOMessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{2}
+}
+
 // SMS represents a message tracked by the smsd service
 type SMS struct {
 	state         protoimpl.MessageState
@@ -282,6 +391,572 @@ func (x *MutableSMS) GetMessage() string {
 	return ""
 }
 
+// Campaign represents a templated SMS broadcast tracked by the smsd service
+type Campaign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pk uniquely identifies a campaign (generated unique key)
+	Pk string `protobuf:"bytes,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	// network that the campaign belongs to
+	NetworkId string         `protobuf:"bytes,2,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	Status    CampaignStatus `protobuf:"varint,3,opt,name=status,proto3,enum=magma.lte.smsd.storage.CampaignStatus" json:"status,omitempty"`
+	// user-provided definition of the campaign
+	Spec *MutableCampaign `protobuf:"bytes,10,opt,name=spec,proto3" json:"spec,omitempty"`
+	// time at which the campaign was created in the system
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,20,opt,name=createdTime,proto3" json:"createdTime,omitempty"`
+	// time of the most recent batch of messages created for this campaign.
+	// used to throttle delivery.
+	LastDispatchTime *timestamp.Timestamp `protobuf:"bytes,21,opt,name=lastDispatchTime,proto3" json:"lastDispatchTime,omitempty"`
+}
+
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Campaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campaign) ProtoMessage() {}
+
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Campaign) GetPk() string {
+	if x != nil {
+		return x.Pk
+	}
+	return ""
+}
+
+func (x *Campaign) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *Campaign) GetStatus() CampaignStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CampaignStatus_SCHEDULED
+}
+
+func (x *Campaign) GetSpec() *MutableCampaign {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Campaign) GetCreatedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *Campaign) GetLastDispatchTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastDispatchTime
+	}
+	return nil
+}
+
+// MutableCampaign encapsulates the campaign state that service clients are
+// allowed to set.
+type MutableCampaign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// source MSISDN for all messages of the campaign
+	SourceMsisdn string `protobuf:"bytes,2,opt,name=sourceMsisdn,proto3" json:"sourceMsisdn,omitempty"`
+	// message template. {{variable}} placeholders are substituted per
+	// subscriber. The imsi variable is always defined.
+	Template string `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	// Exactly one of imsis or subscriberGroup is set.
+	// explicit list of destination IMSIs
+	Imsis []string `protobuf:"bytes,4,rep,name=imsis,proto3" json:"imsis,omitempty"`
+	// policy base name whose assigned subscribers are the destinations.
+	// the group is resolved when the campaign starts.
+	SubscriberGroup string `protobuf:"bytes,5,opt,name=subscriberGroup,proto3" json:"subscriberGroup,omitempty"`
+	// time at which the campaign should start. starts immediately if unset.
+	StartTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// maximum number of messages to create per minute. 0 is unthrottled.
+	MessagesPerMinute uint32 `protobuf:"varint,7,opt,name=messagesPerMinute,proto3" json:"messagesPerMinute,omitempty"`
+	// template variables which apply to every subscriber
+	DefaultVariables map[string]string `protobuf:"bytes,8,rep,name=defaultVariables,proto3" json:"defaultVariables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// template variables by IMSI. these take precedence over the defaults.
+	SubscriberVariables map[string]*TemplateVariables `protobuf:"bytes,9,rep,name=subscriberVariables,proto3" json:"subscriberVariables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MutableCampaign) Reset() {
+	*x = MutableCampaign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutableCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutableCampaign) ProtoMessage() {}
+
+func (x *MutableCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutableCampaign.ProtoReflect.Descriptor instead.
+func (*MutableCampaign) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MutableCampaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MutableCampaign) GetSourceMsisdn() string {
+	if x != nil {
+		return x.SourceMsisdn
+	}
+	return ""
+}
+
+func (x *MutableCampaign) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *MutableCampaign) GetImsis() []string {
+	if x != nil {
+		return x.Imsis
+	}
+	return nil
+}
+
+func (x *MutableCampaign) GetSubscriberGroup() string {
+	if x != nil {
+		return x.SubscriberGroup
+	}
+	return ""
+}
+
+func (x *MutableCampaign) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MutableCampaign) GetMessagesPerMinute() uint32 {
+	if x != nil {
+		return x.MessagesPerMinute
+	}
+	return 0
+}
+
+func (x *MutableCampaign) GetDefaultVariables() map[string]string {
+	if x != nil {
+		return x.DefaultVariables
+	}
+	return nil
+}
+
+func (x *MutableCampaign) GetSubscriberVariables() map[string]*TemplateVariables {
+	if x != nil {
+		return x.SubscriberVariables
+	}
+	return nil
+}
+
+type TemplateVariables struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values map[string]string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TemplateVariables) Reset() {
+	*x = TemplateVariables{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateVariables) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVariables) ProtoMessage() {}
+
+func (x *TemplateVariables) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVariables.ProtoReflect.Descriptor instead.
+func (*TemplateVariables) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TemplateVariables) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// CampaignReport aggregates the delivery status of a campaign's messages
+type CampaignReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total number of targeted subscribers. 0 until a campaign targeting a
+	// subscriber group has started.
+	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// targets for which no message has been created yet
+	Pending uint32 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// messages created but not delivered yet
+	Waiting   uint32 `protobuf:"varint,3,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	Delivered uint32 `protobuf:"varint,4,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Failed    uint32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// error messages of failed targets, by IMSI
+	Failures map[string]string `protobuf:"bytes,10,rep,name=failures,proto3" json:"failures,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *CampaignReport) Reset() {
+	*x = CampaignReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CampaignReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignReport) ProtoMessage() {}
+
+func (x *CampaignReport) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignReport.ProtoReflect.Descriptor instead.
+func (*CampaignReport) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CampaignReport) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CampaignReport) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *CampaignReport) GetWaiting() uint32 {
+	if x != nil {
+		return x.Waiting
+	}
+	return 0
+}
+
+func (x *CampaignReport) GetDelivered() uint32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *CampaignReport) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CampaignReport) GetFailures() map[string]string {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// MOSMS represents a mobile-originated message received by the smsd service,
+// to be relayed to an external SMSC
+type MOSMS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pk uniquely identifies an MO SMS message (generated unique key)
+	Pk string `protobuf:"bytes,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	// relay status of the SMS
+	Status MOMessageStatus `protobuf:"varint,2,opt,name=status,proto3,enum=magma.lte.smsd.storage.MOMessageStatus" json:"status,omitempty"`
+	// network of the originating subscriber
+	NetworkId string `protobuf:"bytes,3,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	// originating subscriber
+	Imsi string `protobuf:"bytes,10,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// destination number, as dialed by the subscriber
+	Destination string `protobuf:"bytes,11,opt,name=destination,proto3" json:"destination,omitempty"`
+	// message content of the SMS. segments of concatenated messages are
+	// reassembled before the message is stored.
+	Message string `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+	// time at which the (last segment of the) message was received
+	ReceivedTime *timestamp.Timestamp `protobuf:"bytes,20,opt,name=receivedTime,proto3" json:"receivedTime,omitempty"`
+	// time that we last tried submitting this message to the SMSC. if status
+	// is SUBMITTED, this will be the submission time
+	LastSubmitAttemptTime *timestamp.Timestamp `protobuf:"bytes,21,opt,name=lastSubmitAttemptTime,proto3" json:"lastSubmitAttemptTime,omitempty"`
+	// number of times we've attempted to submit this SMS
+	AttemptCount uint32 `protobuf:"varint,22,opt,name=attemptCount,proto3" json:"attemptCount,omitempty"`
+	// error message from the most recent failed submission, or from the
+	// SMSC's delivery receipt
+	Error string `protobuf:"bytes,23,opt,name=error,proto3" json:"error,omitempty"`
+	// message ID assigned by the SMSC on submission. delivery receipts are
+	// correlated using this ID.
+	SmscMessageId string `protobuf:"bytes,24,opt,name=smscMessageId,proto3" json:"smscMessageId,omitempty"`
+}
+
+func (x *MOSMS) Reset() {
+	*x = MOSMS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MOSMS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MOSMS) ProtoMessage() {}
+
+func (x *MOSMS) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MOSMS.ProtoReflect.Descriptor instead.
+func (*MOSMS) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MOSMS) GetPk() string {
+	if x != nil {
+		return x.Pk
+	}
+	return ""
+}
+
+func (x *MOSMS) GetStatus() MOMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MOMessageStatus_RECEIVED
+}
+
+func (x *MOSMS) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *MOSMS) GetImsi() string {
+	if x != nil {
+		return x.Imsi
+	}
+	return ""
+}
+
+func (x *MOSMS) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *MOSMS) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MOSMS) GetReceivedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReceivedTime
+	}
+	return nil
+}
+
+func (x *MOSMS) GetLastSubmitAttemptTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSubmitAttemptTime
+	}
+	return nil
+}
+
+func (x *MOSMS) GetAttemptCount() uint32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *MOSMS) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MOSMS) GetSmscMessageId() string {
+	if x != nil {
+		return x.SmscMessageId
+	}
+	return ""
+}
+
+// MutableMOSMS encapsulates the state of a received MO message.
+type MutableMOSMS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imsi        string `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Message     string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MutableMOSMS) Reset() {
+	*x = MutableMOSMS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutableMOSMS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutableMOSMS) ProtoMessage() {}
+
+func (x *MutableMOSMS) ProtoReflect() protoreflect.Message {
+	mi := &file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutableMOSMS.ProtoReflect.Descriptor instead.
+func (*MutableMOSMS) Descriptor() ([]byte, []int) {
+	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MutableMOSMS) GetImsi() string {
+	if x != nil {
+		return x.Imsi
+	}
+	return ""
+}
+
+func (x *MutableMOSMS) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *MutableMOSMS) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_lte_cloud_go_services_smsd_storage_storage_proto protoreflect.FileDescriptor
 
 var file_lte_cloud_go_services_smsd_storage_storage_proto_rawDesc = []byte{
@@ -323,14 +998,147 @@ var file_lte_cloud_go_services_smsd_storage_storage_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x73, 0x69, 0x73,
 	0x64, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x37, 0x0a, 0x0d,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
-	0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45,
-	0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x02, 0x42, 0x2a, 0x5a, 0x28, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x6c,
-	0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x6d, 0x73, 0x64, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x02, 0x0a,
+	0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x73, 0x6d, 0x73, 0x64, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74,
+	0x65, 0x2e, 0x73, 0x6d, 0x73, 0x64, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa4, 0x05, 0x0a, 0x0f, 0x4d,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x73, 0x69, 0x73,
+	0x64, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x73, 0x69, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x73, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65,
+	0x2e, 0x73, 0x6d, 0x73, 0x64, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x75,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x2e, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x73,
+	0x6d, 0x73, 0x64, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x43, 0x0a, 0x15, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x71,
+	0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x73, 0x6d, 0x73, 0x64, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6c, 0x74, 0x65, 0x2e, 0x73, 0x6d, 0x73, 0x64, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c,
+	0x74, 0x65, 0x2e, 0x73, 0x6d, 0x73, 0x64, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb8, 0x03, 0x0a, 0x05, 0x4d, 0x4f, 0x53, 0x4d, 0x53, 0x12, 0x0e, 0x0a,
+	0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x3f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6c, 0x74, 0x65, 0x2e, 0x73, 0x6d, 0x73, 0x64, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x4f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x6d, 0x73, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0c,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x15,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6d, 0x73, 0x63,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x6d, 0x73, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x5e,
+	0x0a, 0x0c, 0x4d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x4f, 0x53, 0x4d, 0x53, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d,
+	0x73, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x37,
+	0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0b, 0x0a, 0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x3b, 0x0a, 0x0e, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48,
+	0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x55, 0x0a, 0x0f, 0x4d, 0x4f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x45, 0x49,
+	0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4d, 0x53, 0x43, 0x5f, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x44, 0x45,
+	0x4c, 0x49, 0x56, 0x45, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x42, 0x2a, 0x5a, 0x28, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2f, 0x6c, 0x74, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67,
+	0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x6d, 0x73, 0x64, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -345,23 +1153,48 @@ func file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescGZIP() []byte
 	return file_lte_cloud_go_services_smsd_storage_storage_proto_rawDescData
 }
 
-var file_lte_cloud_go_services_smsd_storage_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lte_cloud_go_services_smsd_storage_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_lte_cloud_go_services_smsd_storage_storage_proto_goTypes = []interface{}{
 	(MessageStatus)(0),          // 0: magma.lte.smsd.storage.MessageStatus
-	(*SMS)(nil),                 // 1: magma.lte.smsd.storage.SMS
-	(*MutableSMS)(nil),          // 2: magma.lte.smsd.storage.MutableSMS
-	(*timestamp.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(CampaignStatus)(0),         // 1: magma.lte.smsd.storage.CampaignStatus
+	(MOMessageStatus)(0),        // 2: magma.lte.smsd.storage.MOMessageStatus
+	(*SMS)(nil),                 // 3: magma.lte.smsd.storage.SMS
+	(*MutableSMS)(nil),          // 4: magma.lte.smsd.storage.MutableSMS
+	(*Campaign)(nil),            // 5: magma.lte.smsd.storage.Campaign
+	(*MutableCampaign)(nil),     // 6: magma.lte.smsd.storage.MutableCampaign
+	(*TemplateVariables)(nil),   // 7: magma.lte.smsd.storage.TemplateVariables
+	(*CampaignReport)(nil),      // 8: magma.lte.smsd.storage.CampaignReport
+	(*MOSMS)(nil),               // 9: magma.lte.smsd.storage.MOSMS
+	(*MutableMOSMS)(nil),        // 10: magma.lte.smsd.storage.MutableMOSMS
+	nil,                         // 11: magma.lte.smsd.storage.MutableCampaign.DefaultVariablesEntry
+	nil,                         // 12: magma.lte.smsd.storage.MutableCampaign.SubscriberVariablesEntry
+	nil,                         // 13: magma.lte.smsd.storage.TemplateVariables.ValuesEntry
+	nil,                         // 14: magma.lte.smsd.storage.CampaignReport.FailuresEntry
+	(*timestamp.Timestamp)(nil), // 15: google.protobuf.Timestamp
 }
 var file_lte_cloud_go_services_smsd_storage_storage_proto_depIdxs = []int32{
-	0, // 0: magma.lte.smsd.storage.SMS.status:type_name -> magma.lte.smsd.storage.MessageStatus
-	3, // 1: magma.lte.smsd.storage.SMS.createdTime:type_name -> google.protobuf.Timestamp
-	3, // 2: magma.lte.smsd.storage.SMS.lastDeliveryAttemptTime:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: magma.lte.smsd.storage.SMS.status:type_name -> magma.lte.smsd.storage.MessageStatus
+	15, // 1: magma.lte.smsd.storage.SMS.createdTime:type_name -> google.protobuf.Timestamp
+	15, // 2: magma.lte.smsd.storage.SMS.lastDeliveryAttemptTime:type_name -> google.protobuf.Timestamp
+	1,  // 3: magma.lte.smsd.storage.Campaign.status:type_name -> magma.lte.smsd.storage.CampaignStatus
+	6,  // 4: magma.lte.smsd.storage.Campaign.spec:type_name -> magma.lte.smsd.storage.MutableCampaign
+	15, // 5: magma.lte.smsd.storage.Campaign.createdTime:type_name -> google.protobuf.Timestamp
+	15, // 6: magma.lte.smsd.storage.Campaign.lastDispatchTime:type_name -> google.protobuf.Timestamp
+	15, // 7: magma.lte.smsd.storage.MutableCampaign.startTime:type_name -> google.protobuf.Timestamp
+	11, // 8: magma.lte.smsd.storage.MutableCampaign.defaultVariables:type_name -> magma.lte.smsd.storage.MutableCampaign.DefaultVariablesEntry
+	12, // 9: magma.lte.smsd.storage.MutableCampaign.subscriberVariables:type_name -> magma.lte.smsd.storage.MutableCampaign.SubscriberVariablesEntry
+	13, // 10: magma.lte.smsd.storage.TemplateVariables.values:type_name -> magma.lte.smsd.storage.TemplateVariables.ValuesEntry
+	14, // 11: magma.lte.smsd.storage.CampaignReport.failures:type_name -> magma.lte.smsd.storage.CampaignReport.FailuresEntry
+	2,  // 12: magma.lte.smsd.storage.MOSMS.status:type_name -> magma.lte.smsd.storage.MOMessageStatus
+	15, // 13: magma.lte.smsd.storage.MOSMS.receivedTime:type_name -> google.protobuf.Timestamp
+	15, // 14: magma.lte.smsd.storage.MOSMS.lastSubmitAttemptTime:type_name -> google.protobuf.Timestamp
+	7,  // 15: magma.lte.smsd.storage.MutableCampaign.SubscriberVariablesEntry.value:type_name -> magma.lte.smsd.storage.TemplateVariables
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_lte_cloud_go_services_smsd_storage_storage_proto_init() }
@@ -394,14 +1227,86 @@ func file_lte_cloud_go_services_smsd_storage_storage_proto_init() {
 				return nil
 			}
 		}
+		file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Campaign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutableCampaign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateVariables); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CampaignReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MOSMS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lte_cloud_go_services_smsd_storage_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutableMOSMS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lte_cloud_go_services_smsd_storage_storage_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
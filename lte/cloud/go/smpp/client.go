@@ -0,0 +1,291 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smpp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const defaultResponseTimeout = 10 * time.Second
+
+// ErrClosed is returned for requests on a session which has been closed,
+// either locally or by the SMSC.
+var ErrClosed = errors.New("smpp: session closed")
+
+// ClientConfig configures an ESME transceiver session.
+type ClientConfig struct {
+	// Address is the host:port of the SMSC
+	Address    string
+	SystemID   string
+	Password   string
+	SystemType string
+
+	// EnquireLinkInterval is the interval between keepalives. Keepalives are
+	// disabled if 0.
+	EnquireLinkInterval time.Duration
+	// ResponseTimeout bounds the time waited for each response from the
+	// SMSC. Defaults to 10 seconds.
+	ResponseTimeout time.Duration
+}
+
+// DeliverHandler handles the deliver_sm requests of the SMSC, which carry
+// either mobile-terminated messages or delivery receipts. The returned status
+// is sent back to the SMSC in the deliver_sm_resp.
+type DeliverHandler func(sm *ShortMessage) Status
+
+// Client is a bound transceiver session with an SMSC.
+// Requests can be issued concurrently.
+type Client struct {
+	conn    net.Conn
+	config  ClientConfig
+	handler DeliverHandler
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	seq     uint32
+	pending map[uint32]chan *PDU
+	closing bool
+	err     error
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Dial connects to the SMSC and binds as a transceiver.
+func Dial(config ClientConfig, handler DeliverHandler) (*Client, error) {
+	if config.ResponseTimeout == 0 {
+		config.ResponseTimeout = defaultResponseTimeout
+	}
+	conn, err := net.DialTimeout("tcp", config.Address, config.ResponseTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("dial SMSC: %w", err)
+	}
+
+	c := &Client{
+		conn:    conn,
+		config:  config,
+		handler: handler,
+		pending: map[uint32]chan *PDU{},
+		done:    make(chan struct{}),
+	}
+	go c.readLoop()
+
+	bind := &Bind{SystemID: config.SystemID, Password: config.Password, SystemType: config.SystemType}
+	body, _ := bind.MarshalBinary()
+	_, err = c.request(BindTransceiver, body)
+	if err != nil {
+		c.shutdown(err)
+		return nil, fmt.Errorf("bind transceiver: %w", err)
+	}
+
+	if config.EnquireLinkInterval > 0 {
+		go c.keepalive()
+	}
+	return c, nil
+}
+
+// Submit submits a short message to the SMSC, returning the message ID
+// assigned by the SMSC.
+func (c *Client) Submit(sm *ShortMessage) (string, error) {
+	body, err := sm.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.request(SubmitSM, body)
+	if err != nil {
+		return "", err
+	}
+	return DecodeMessageID(resp.Body)
+}
+
+// Close unbinds from the SMSC and closes the connection.
+func (c *Client) Close() error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+	}
+	// The SMSC may close the connection as soon as it has responded
+	c.mu.Lock()
+	c.closing = true
+	c.mu.Unlock()
+	_, err := c.request(Unbind, nil)
+	c.shutdown(ErrClosed)
+	if err == ErrClosed {
+		return nil
+	}
+	return err
+}
+
+// Done returns a channel which is closed once the session has ended.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the reason the session ended, or nil if it's still bound.
+func (c *Client) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *Client) request(commandID uint32, body []byte) (*PDU, error) {
+	respCh := make(chan *PDU, 1)
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return nil, ErrClosed
+	}
+	c.seq = c.seq%0x7fffffff + 1
+	seq := c.seq
+	c.pending[seq] = respCh
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, seq)
+		c.mu.Unlock()
+	}()
+
+	err := c.write(&PDU{CommandID: commandID, SequenceNumber: seq, Body: body})
+	if err != nil {
+		c.shutdown(err)
+		return nil, err
+	}
+
+	timer := time.NewTimer(c.config.ResponseTimeout)
+	defer timer.Stop()
+	select {
+	case resp := <-respCh:
+		if resp.CommandID == GenericNack {
+			return nil, fmt.Errorf("generic nack: %w", resp.CommandStatus)
+		}
+		if resp.CommandID != commandID|responseBit {
+			return nil, fmt.Errorf("smpp: unexpected response 0x%08x to command 0x%08x", resp.CommandID, commandID)
+		}
+		if resp.CommandStatus != StatusOK {
+			return nil, resp.CommandStatus
+		}
+		return resp, nil
+	case <-timer.C:
+		return nil, fmt.Errorf("smpp: timed out waiting for response to command 0x%08x", commandID)
+	case <-c.done:
+		return nil, ErrClosed
+	}
+}
+
+func (c *Client) write(p *PDU) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	err := c.conn.SetWriteDeadline(time.Now().Add(c.config.ResponseTimeout))
+	if err != nil {
+		return err
+	}
+	return WritePDU(c.conn, p)
+}
+
+func (c *Client) readLoop() {
+	for {
+		p, err := ReadPDU(c.conn)
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+
+		if p.IsResponse() {
+			c.mu.Lock()
+			respCh, ok := c.pending[p.SequenceNumber]
+			c.mu.Unlock()
+			if ok {
+				respCh <- p
+			} else {
+				glog.Warningf("Dropping unexpected SMPP response 0x%08x with sequence number %d", p.CommandID, p.SequenceNumber)
+			}
+			continue
+		}
+
+		var resp *PDU
+		switch p.CommandID {
+		case DeliverSM:
+			resp = p.Response(c.handleDeliver(p))
+			resp.Body = EncodeMessageID("")
+		case EnquireLink:
+			resp = p.Response(StatusOK)
+		case Unbind:
+			_ = c.write(p.Response(StatusOK))
+			c.shutdown(ErrClosed)
+			return
+		default:
+			resp = &PDU{CommandID: GenericNack, CommandStatus: StatusInvalidCmdID, SequenceNumber: p.SequenceNumber}
+		}
+		err = c.write(resp)
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+	}
+}
+
+func (c *Client) handleDeliver(p *PDU) Status {
+	sm := &ShortMessage{}
+	err := sm.UnmarshalBinary(p.Body)
+	if err != nil {
+		glog.Errorf("Failed to decode SMPP deliver_sm: %v", err)
+		return StatusInvalidMsgLen
+	}
+	if c.handler == nil {
+		return StatusOK
+	}
+	return c.handler(sm)
+}
+
+func (c *Client) keepalive() {
+	ticker := time.NewTicker(c.config.EnquireLinkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			_, err := c.request(EnquireLink, nil)
+			if err != nil {
+				c.shutdown(fmt.Errorf("enquire link: %w", err))
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *Client) shutdown(err error) {
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		c.err = err
+		if c.closing {
+			c.err = ErrClosed
+		}
+		c.mu.Unlock()
+		close(c.done)
+		closeErr := c.conn.Close()
+		if closeErr != nil {
+			glog.Warningf("Error closing SMPP connection: %v", closeErr)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smpp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/smpp"
+	"magma/lte/cloud/go/smpp/smpptest"
+)
+
+func TestClient(t *testing.T) {
+	server, err := smpptest.NewServer("magma", "secret")
+	assert.NoError(t, err)
+	defer server.Close()
+
+	config := smpp.ClientConfig{
+		Address:             server.Addr,
+		SystemID:            "magma",
+		Password:            "secret",
+		EnquireLinkInterval: 10 * time.Millisecond,
+		ResponseTimeout:     time.Second,
+	}
+
+	// Bad credentials
+	badConfig := config
+	badConfig.Password = "wrong"
+	_, err = smpp.Dial(badConfig, nil)
+	assert.EqualError(t, err, "bind transceiver: smpp: command status 0x0000000e")
+
+	delivered := make(chan *smpp.ShortMessage, 1)
+	client, err := smpp.Dial(config, func(sm *smpp.ShortMessage) smpp.Status {
+		delivered <- sm
+		if sm.DestinationAddr == "" {
+			return smpp.StatusInvalidDstAddr
+		}
+		return smpp.StatusOK
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.NumSessions())
+
+	// Submit
+	sm := &smpp.ShortMessage{SourceAddr: "123", DestinationAddr: "456", ShortMessage: []byte("hello")}
+	id, err := client.Submit(sm)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", id)
+	assert.Equal(t, []*smpp.ShortMessage{sm}, server.Submitted())
+
+	server.SetSubmitStatus(smpp.StatusThrottled)
+	_, err = client.Submit(sm)
+	assert.Equal(t, smpp.StatusThrottled, err)
+	server.SetSubmitStatus(smpp.StatusOK)
+
+	// Deliver, with the handler's status relayed back to the SMSC
+	mt := &smpp.ShortMessage{SourceAddr: "456", DestinationAddr: "123", ShortMessage: []byte("hi")}
+	status, err := server.Deliver(mt)
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusOK, status)
+	assert.Equal(t, mt, <-delivered)
+	status, err = server.Deliver(&smpp.ShortMessage{})
+	assert.NoError(t, err)
+	assert.Equal(t, smpp.StatusInvalidDstAddr, status)
+	<-delivered
+
+	// Keepalives keep the session up
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, client.Err())
+
+	// Unbind
+	assert.NoError(t, client.Close())
+	<-client.Done()
+	assert.Equal(t, smpp.ErrClosed, client.Err())
+	_, err = client.Submit(sm)
+	assert.Equal(t, smpp.ErrClosed, err)
+}
+
+func TestClient_ServerClosed(t *testing.T) {
+	server, err := smpptest.NewServer("magma", "secret")
+	assert.NoError(t, err)
+
+	client, err := smpp.Dial(smpp.ClientConfig{Address: server.Addr, SystemID: "magma", Password: "secret"}, nil)
+	assert.NoError(t, err)
+
+	server.Close()
+	select {
+	case <-client.Done():
+	case <-time.After(time.Second):
+		t.Fatal("session wasn't closed")
+	}
+	assert.Error(t, client.Err())
+	_, err = client.Submit(&smpp.ShortMessage{})
+	assert.Equal(t, smpp.ErrClosed, err)
+}
@@ -0,0 +1,71 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smpp
+
+import (
+	"fmt"
+
+	"github.com/warthog618/sms/encoding/gsm7"
+	"github.com/warthog618/sms/encoding/ucs2"
+)
+
+// Data coding schemes (SMPP 3.4 section 5.2.19)
+const (
+	DataCodingDefault = 0x00
+	DataCodingIA5     = 0x01
+	DataCodingLatin1  = 0x03
+	DataCodingUCS2    = 0x08
+)
+
+// EncodeText encodes a UTF-8 message for transmission to the SMSC, returning
+// the data coding scheme used. ASCII messages are sent as IA5, all others as
+// UCS-2.
+func EncodeText(message string) (byte, []byte) {
+	for i := 0; i < len(message); i++ {
+		if message[i] >= 0x80 {
+			return DataCodingUCS2, ucs2.Encode([]rune(message))
+		}
+	}
+	return DataCodingIA5, []byte(message)
+}
+
+// DecodeText decodes the content of a short message to UTF-8 according to
+// its data coding scheme. The SMSC default alphabet is assumed to be
+// unpacked GSM 7-bit.
+func DecodeText(dataCoding byte, data []byte) (string, error) {
+	switch dataCoding {
+	case DataCodingDefault:
+		decoded, err := gsm7.Decode(data)
+		if err != nil {
+			return "", err
+		}
+		return string(decoded), nil
+	case DataCodingIA5:
+		return string(data), nil
+	case DataCodingLatin1:
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return string(runes), nil
+	case DataCodingUCS2:
+		runes, err := ucs2.Decode(data)
+		if err != nil {
+			return "", err
+		}
+		return string(runes), nil
+	default:
+		return "", fmt.Errorf("smpp: unsupported data coding 0x%02x", dataCoding)
+	}
+}
@@ -0,0 +1,375 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package smpp implements the subset of SMPP 3.4 needed for an ESME to relay
+// short messages to and from an SMSC over a transceiver session.
+package smpp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Command IDs (SMPP 3.4 section 5.1.2.1)
+const (
+	GenericNack         uint32 = 0x80000000
+	BindTransceiver     uint32 = 0x00000009
+	BindTransceiverResp uint32 = 0x80000009
+	SubmitSM            uint32 = 0x00000004
+	SubmitSMResp        uint32 = 0x80000004
+	DeliverSM           uint32 = 0x00000005
+	DeliverSMResp       uint32 = 0x80000005
+	Unbind              uint32 = 0x00000006
+	UnbindResp          uint32 = 0x80000006
+	EnquireLink         uint32 = 0x00000015
+	EnquireLinkResp     uint32 = 0x80000015
+
+	// Response command IDs are the request ID with this bit set
+	responseBit uint32 = 0x80000000
+)
+
+// Command statuses (SMPP 3.4 section 5.1.3)
+const (
+	StatusOK             Status = 0x00000000
+	StatusInvalidMsgLen  Status = 0x00000001
+	StatusInvalidCmdLen  Status = 0x00000002
+	StatusInvalidCmdID   Status = 0x00000003
+	StatusInvalidBind    Status = 0x00000004
+	StatusAlreadyBound   Status = 0x00000005
+	StatusSystemError    Status = 0x00000008
+	StatusInvalidSrcAddr Status = 0x0000000A
+	StatusInvalidDstAddr Status = 0x0000000B
+	StatusBindFailed     Status = 0x0000000D
+	StatusInvalidPasswd  Status = 0x0000000E
+	StatusInvalidSysID   Status = 0x0000000F
+	StatusSubmitFailed   Status = 0x00000045
+	StatusThrottled      Status = 0x00000058
+)
+
+// Optional parameter tags (SMPP 3.4 section 5.3.2)
+const (
+	TagReceiptedMessageID uint16 = 0x001E
+	TagMessagePayload     uint16 = 0x0424
+	TagMessageState       uint16 = 0x0427
+)
+
+const (
+	// InterfaceVersion is the SMPP version negotiated on bind
+	InterfaceVersion = 0x34
+
+	headerLen = 16
+	// maxPDULen bounds the size of PDUs we're willing to read. SMPP doesn't
+	// define a maximum, but no PDU we handle comes anywhere close.
+	maxPDULen = 64 * 1024
+	// maxShortMessageLen is the maximum length of the short_message field,
+	// longer messages are carried in the message_payload parameter.
+	maxShortMessageLen = 254
+)
+
+// Status is an SMPP command status. Non-OK statuses returned by the peer are
+// surfaced as errors.
+type Status uint32
+
+func (s Status) Error() string {
+	return fmt.Sprintf("smpp: command status 0x%08x", uint32(s))
+}
+
+// PDU is an SMPP protocol data unit. Body holds the encoded mandatory and
+// optional parameters of the command.
+type PDU struct {
+	CommandID      uint32
+	CommandStatus  Status
+	SequenceNumber uint32
+	Body           []byte
+}
+
+// IsResponse returns true if the PDU is a response to a request.
+func (p *PDU) IsResponse() bool {
+	return p.CommandID&responseBit != 0
+}
+
+// Response returns an empty response PDU to this request with the given status.
+func (p *PDU) Response(status Status) *PDU {
+	if p.IsResponse() {
+		return nil
+	}
+	return &PDU{CommandID: p.CommandID | responseBit, CommandStatus: status, SequenceNumber: p.SequenceNumber}
+}
+
+// MarshalBinary encodes the PDU, including its header.
+func (p *PDU) MarshalBinary() ([]byte, error) {
+	b := make([]byte, headerLen, headerLen+len(p.Body))
+	binary.BigEndian.PutUint32(b[0:], uint32(headerLen+len(p.Body)))
+	binary.BigEndian.PutUint32(b[4:], p.CommandID)
+	binary.BigEndian.PutUint32(b[8:], uint32(p.CommandStatus))
+	binary.BigEndian.PutUint32(b[12:], p.SequenceNumber)
+	return append(b, p.Body...), nil
+}
+
+// ReadPDU reads the next PDU from r.
+func ReadPDU(r io.Reader) (*PDU, error) {
+	header := make([]byte, headerLen)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header[0:])
+	if length < headerLen || length > maxPDULen {
+		return nil, fmt.Errorf("smpp: invalid command length %d", length)
+	}
+
+	p := &PDU{
+		CommandID:      binary.BigEndian.Uint32(header[4:]),
+		CommandStatus:  Status(binary.BigEndian.Uint32(header[8:])),
+		SequenceNumber: binary.BigEndian.Uint32(header[12:]),
+		Body:           make([]byte, length-headerLen),
+	}
+	_, err = io.ReadFull(r, p.Body)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// WritePDU writes an encoded PDU to w.
+func WritePDU(w io.Writer, p *PDU) error {
+	b, err := p.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// Bind holds the parameters of a bind_transceiver request.
+type Bind struct {
+	SystemID   string
+	Password   string
+	SystemType string
+}
+
+func (b *Bind) MarshalBinary() ([]byte, error) {
+	w := &bytes.Buffer{}
+	writeCString(w, b.SystemID)
+	writeCString(w, b.Password)
+	writeCString(w, b.SystemType)
+	w.WriteByte(InterfaceVersion)
+	w.WriteByte(0) // addr_ton
+	w.WriteByte(0) // addr_npi
+	writeCString(w, "")
+	return w.Bytes(), nil
+}
+
+func (b *Bind) UnmarshalBinary(data []byte) error {
+	r := bytes.NewBuffer(data)
+	var err error
+	b.SystemID, err = readCString(r, "system_id")
+	if err != nil {
+		return err
+	}
+	b.Password, err = readCString(r, "password")
+	if err != nil {
+		return err
+	}
+	b.SystemType, err = readCString(r, "system_type")
+	if err != nil {
+		return err
+	}
+	// interface_version, addr_ton, addr_npi and address_range aren't used
+	return nil
+}
+
+// ShortMessage holds the parameters of a submit_sm or deliver_sm request,
+// which share the same layout.
+type ShortMessage struct {
+	ServiceType          string
+	SourceAddrTON        byte
+	SourceAddrNPI        byte
+	SourceAddr           string
+	DestAddrTON          byte
+	DestAddrNPI          byte
+	DestinationAddr      string
+	ESMClass             byte
+	ProtocolID           byte
+	PriorityFlag         byte
+	ScheduleDeliveryTime string
+	ValidityPeriod       string
+	RegisteredDelivery   byte
+	ReplaceIfPresentFlag byte
+	DataCoding           byte
+	SMDefaultMsgID       byte
+	// ShortMessage is the message content. Content which doesn't fit in the
+	// short_message field is carried in the message_payload parameter.
+	ShortMessage []byte
+
+	// Optional parameters by tag, excluding message_payload
+	Options map[uint16][]byte
+}
+
+func (sm *ShortMessage) MarshalBinary() ([]byte, error) {
+	w := &bytes.Buffer{}
+	writeCString(w, sm.ServiceType)
+	w.WriteByte(sm.SourceAddrTON)
+	w.WriteByte(sm.SourceAddrNPI)
+	writeCString(w, sm.SourceAddr)
+	w.WriteByte(sm.DestAddrTON)
+	w.WriteByte(sm.DestAddrNPI)
+	writeCString(w, sm.DestinationAddr)
+	w.WriteByte(sm.ESMClass)
+	w.WriteByte(sm.ProtocolID)
+	w.WriteByte(sm.PriorityFlag)
+	writeCString(w, sm.ScheduleDeliveryTime)
+	writeCString(w, sm.ValidityPeriod)
+	w.WriteByte(sm.RegisteredDelivery)
+	w.WriteByte(sm.ReplaceIfPresentFlag)
+	w.WriteByte(sm.DataCoding)
+	w.WriteByte(sm.SMDefaultMsgID)
+
+	payload := sm.ShortMessage
+	if len(payload) > maxShortMessageLen {
+		if len(payload) > 0xffff {
+			return nil, fmt.Errorf("smpp: message too long (%d octets)", len(payload))
+		}
+		w.WriteByte(0)
+		writeTLV(w, TagMessagePayload, payload)
+	} else {
+		w.WriteByte(byte(len(payload)))
+		w.Write(payload)
+	}
+	for _, tag := range sortedTags(sm.Options) {
+		if len(sm.Options[tag]) > 0xffff {
+			return nil, fmt.Errorf("smpp: optional parameter 0x%04x too long", tag)
+		}
+		writeTLV(w, tag, sm.Options[tag])
+	}
+	return w.Bytes(), nil
+}
+
+func (sm *ShortMessage) UnmarshalBinary(data []byte) error {
+	r := bytes.NewBuffer(data)
+	var err error
+	readString := func(dst *string, field string) {
+		if err == nil {
+			*dst, err = readCString(r, field)
+		}
+	}
+	readByte := func(dst *byte, field string) {
+		if err == nil {
+			*dst, err = r.ReadByte()
+			if err != nil {
+				err = fmt.Errorf("smpp: missing %s", field)
+			}
+		}
+	}
+
+	readString(&sm.ServiceType, "service_type")
+	readByte(&sm.SourceAddrTON, "source_addr_ton")
+	readByte(&sm.SourceAddrNPI, "source_addr_npi")
+	readString(&sm.SourceAddr, "source_addr")
+	readByte(&sm.DestAddrTON, "dest_addr_ton")
+	readByte(&sm.DestAddrNPI, "dest_addr_npi")
+	readString(&sm.DestinationAddr, "destination_addr")
+	readByte(&sm.ESMClass, "esm_class")
+	readByte(&sm.ProtocolID, "protocol_id")
+	readByte(&sm.PriorityFlag, "priority_flag")
+	readString(&sm.ScheduleDeliveryTime, "schedule_delivery_time")
+	readString(&sm.ValidityPeriod, "validity_period")
+	readByte(&sm.RegisteredDelivery, "registered_delivery")
+	readByte(&sm.ReplaceIfPresentFlag, "replace_if_present_flag")
+	readByte(&sm.DataCoding, "data_coding")
+	readByte(&sm.SMDefaultMsgID, "sm_default_msg_id")
+	var smLength byte
+	readByte(&smLength, "sm_length")
+	if err != nil {
+		return err
+	}
+	if r.Len() < int(smLength) {
+		return errors.New("smpp: short_message shorter than sm_length")
+	}
+	sm.ShortMessage = append([]byte{}, r.Next(int(smLength))...)
+
+	sm.Options = nil
+	for r.Len() > 0 {
+		if r.Len() < 4 {
+			return errors.New("smpp: truncated optional parameter")
+		}
+		tag := binary.BigEndian.Uint16(r.Next(2))
+		length := int(binary.BigEndian.Uint16(r.Next(2)))
+		if r.Len() < length {
+			return fmt.Errorf("smpp: truncated optional parameter 0x%04x", tag)
+		}
+		value := append([]byte{}, r.Next(length)...)
+		if tag == TagMessagePayload {
+			sm.ShortMessage = value
+			continue
+		}
+		if sm.Options == nil {
+			sm.Options = map[uint16][]byte{}
+		}
+		sm.Options[tag] = value
+	}
+	return nil
+}
+
+// EncodeMessageID encodes the body of submit_sm_resp and deliver_sm_resp
+// PDUs, which only hold a message ID.
+// The body of bind_transceiver_resp has the same layout, with the SMSC's
+// system ID in place of the message ID.
+func EncodeMessageID(id string) []byte {
+	w := &bytes.Buffer{}
+	writeCString(w, id)
+	return w.Bytes()
+}
+
+// DecodeMessageID decodes the body of a PDU encoded with EncodeMessageID.
+func DecodeMessageID(data []byte) (string, error) {
+	// The message ID may be omitted from error responses
+	if len(data) == 0 {
+		return "", nil
+	}
+	return readCString(bytes.NewBuffer(data), "message_id")
+}
+
+func writeCString(w *bytes.Buffer, s string) {
+	w.WriteString(s)
+	w.WriteByte(0)
+}
+
+func readCString(r *bytes.Buffer, field string) (string, error) {
+	s, err := r.ReadString(0)
+	if err != nil {
+		return "", fmt.Errorf("smpp: unterminated %s", field)
+	}
+	return s[:len(s)-1], nil
+}
+
+func writeTLV(w *bytes.Buffer, tag uint16, value []byte) {
+	var tl [4]byte
+	binary.BigEndian.PutUint16(tl[0:], tag)
+	binary.BigEndian.PutUint16(tl[2:], uint16(len(value)))
+	w.Write(tl[:])
+	w.Write(value)
+}
+
+func sortedTags(options map[uint16][]byte) []uint16 {
+	tags := make([]uint16, 0, len(options))
+	for tag := range options {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	return tags
+}
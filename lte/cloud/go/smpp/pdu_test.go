@@ -0,0 +1,154 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smpp_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/lte/cloud/go/smpp"
+)
+
+func TestPDU_RoundTrip(t *testing.T) {
+	bind := &smpp.Bind{SystemID: "magma", Password: "secret", SystemType: "smsd"}
+	body, err := bind.MarshalBinary()
+	assert.NoError(t, err)
+	p := &smpp.PDU{CommandID: smpp.BindTransceiver, SequenceNumber: 1, Body: body}
+	encoded, err := p.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, "00000026000000090000000000000001"+"6d61676d6100"+"73656372657400"+"736d736400"+"340000"+"00", hex.EncodeToString(encoded))
+
+	actual, err := smpp.ReadPDU(bytes.NewReader(encoded))
+	assert.NoError(t, err)
+	assert.Equal(t, p, actual)
+	actualBind := &smpp.Bind{}
+	assert.NoError(t, actualBind.UnmarshalBinary(actual.Body))
+	assert.Equal(t, bind, actualBind)
+
+	resp := p.Response(smpp.StatusInvalidPasswd)
+	assert.Equal(t, &smpp.PDU{CommandID: smpp.BindTransceiverResp, CommandStatus: smpp.StatusInvalidPasswd, SequenceNumber: 1}, resp)
+	assert.True(t, resp.IsResponse())
+	assert.Nil(t, resp.Response(smpp.StatusOK))
+
+	// Invalid lengths
+	_, err = smpp.ReadPDU(bytes.NewReader([]byte{0, 0, 0, 4, 0, 0, 0, 0x15, 0, 0, 0, 0, 0, 0, 0, 1}))
+	assert.EqualError(t, err, "smpp: invalid command length 4")
+	_, err = smpp.ReadPDU(bytes.NewReader(encoded[:20]))
+	assert.Error(t, err)
+}
+
+func TestShortMessage_RoundTrip(t *testing.T) {
+	sm := &smpp.ShortMessage{
+		SourceAddrTON:      1,
+		SourceAddrNPI:      1,
+		SourceAddr:         "15551230000",
+		DestAddrTON:        1,
+		DestAddrNPI:        1,
+		DestinationAddr:    "15551234567",
+		RegisteredDelivery: smpp.RegisteredDeliveryFinal,
+		DataCoding:         smpp.DataCodingIA5,
+		ShortMessage:       []byte("hello"),
+	}
+	body, err := sm.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, "00"+"0101"+"313535353132333030303000"+"0101"+"313535353132333435363700"+"000000"+"00"+"00"+"0100"+"0100"+"05"+"68656c6c6f", hex.EncodeToString(body))
+	actual := &smpp.ShortMessage{}
+	assert.NoError(t, actual.UnmarshalBinary(body))
+	assert.Equal(t, sm, actual)
+
+	// Long messages are carried in message_payload
+	sm.ShortMessage = []byte(strings.Repeat("x", 300))
+	sm.Options = map[uint16][]byte{smpp.TagMessageState: {2}, smpp.TagReceiptedMessageID: []byte("1\x00")}
+	body, err = sm.MarshalBinary()
+	assert.NoError(t, err)
+	actual = &smpp.ShortMessage{}
+	assert.NoError(t, actual.UnmarshalBinary(body))
+	assert.Equal(t, sm, actual)
+
+	// Truncated
+	assert.EqualError(t, actual.UnmarshalBinary(body[:10]), "smpp: unterminated source_addr")
+	assert.EqualError(t, actual.UnmarshalBinary(body[:len(body)-1]), "smpp: truncated optional parameter 0x0427")
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		message    string
+		dataCoding byte
+		encoded    string
+	}{
+		{"hello", smpp.DataCodingIA5, "68656c6c6f"},
+		{"héllo", smpp.DataCodingUCS2, "006800e9006c006c006f"},
+		{"hi 😀", smpp.DataCodingUCS2, "006800690020d83dde00"},
+	}
+	for _, tc := range tests {
+		dataCoding, encoded := smpp.EncodeText(tc.message)
+		assert.Equal(t, tc.dataCoding, dataCoding)
+		assert.Equal(t, tc.encoded, hex.EncodeToString(encoded))
+		decoded, err := smpp.DecodeText(dataCoding, encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.message, decoded)
+	}
+
+	// GSM 7-bit and Latin-1
+	decoded, err := smpp.DecodeText(smpp.DataCodingDefault, []byte{0x00, 0x48, 0x69})
+	assert.NoError(t, err)
+	assert.Equal(t, "@Hi", decoded)
+	decoded, err = smpp.DecodeText(smpp.DataCodingLatin1, []byte{0x68, 0xe9})
+	assert.NoError(t, err)
+	assert.Equal(t, "hé", decoded)
+	_, err = smpp.DecodeText(0x04, []byte{0x01})
+	assert.EqualError(t, err, "smpp: unsupported data coding 0x04")
+}
+
+func TestParseDeliveryReceipt(t *testing.T) {
+	// Receipt text
+	sm := &smpp.ShortMessage{
+		ESMClass:     smpp.ESMClassDeliveryReceipt,
+		ShortMessage: []byte("id:abc123 sub:001 dlvrd:000 submit date:2010101200 done date:2010101201 stat:UNDELIV err:042 text:hello"),
+	}
+	receipt, err := smpp.ParseDeliveryReceipt(sm)
+	assert.NoError(t, err)
+	assert.Equal(t, &smpp.DeliveryReceipt{MessageID: "abc123", State: smpp.StateUndeliverable, Error: "042"}, receipt)
+	assert.True(t, receipt.IsFinal())
+	assert.False(t, receipt.IsDelivered())
+
+	// Optional parameters take precedence
+	sm.Options = map[uint16][]byte{smpp.TagReceiptedMessageID: []byte("def456\x00"), smpp.TagMessageState: {2}}
+	receipt, err = smpp.ParseDeliveryReceipt(sm)
+	assert.NoError(t, err)
+	assert.Equal(t, &smpp.DeliveryReceipt{MessageID: "def456", State: smpp.StateDelivered, Error: "042"}, receipt)
+	assert.True(t, receipt.IsDelivered())
+
+	// Intermediate states aren't final
+	receipt, err = smpp.ParseDeliveryReceipt(&smpp.ShortMessage{ESMClass: smpp.ESMClassDeliveryReceipt, ShortMessage: []byte("id:1 stat:ENROUTE")})
+	assert.NoError(t, err)
+	assert.False(t, receipt.IsFinal())
+
+	// Round trip
+	receipt = &smpp.DeliveryReceipt{MessageID: "7", State: smpp.StateDelivered}
+	assert.Equal(t, "id:7 sub:001 dlvrd:001 stat:DELIVRD err:000", smpp.FormatDeliveryReceipt(receipt))
+	actual, err := smpp.ParseDeliveryReceipt(&smpp.ShortMessage{ESMClass: smpp.ESMClassDeliveryReceipt, ShortMessage: []byte(smpp.FormatDeliveryReceipt(receipt))})
+	assert.NoError(t, err)
+	assert.Equal(t, &smpp.DeliveryReceipt{MessageID: "7", State: smpp.StateDelivered, Error: "000"}, actual)
+
+	// Errors
+	_, err = smpp.ParseDeliveryReceipt(&smpp.ShortMessage{ShortMessage: []byte("id:1 stat:DELIVRD")})
+	assert.EqualError(t, err, "smpp: message is not a delivery receipt")
+	_, err = smpp.ParseDeliveryReceipt(&smpp.ShortMessage{ESMClass: smpp.ESMClassDeliveryReceipt, ShortMessage: []byte("hello")})
+	assert.EqualError(t, err, `smpp: malformed delivery receipt "hello"`)
+}
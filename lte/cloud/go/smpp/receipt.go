@@ -0,0 +1,145 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smpp
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+const (
+	// ESMClassDeliveryReceipt is the esm_class message type of a deliver_sm
+	// carrying an SMSC delivery receipt (SMPP 3.4 section 5.2.12)
+	ESMClassDeliveryReceipt = 0x04
+	esmClassTypeMask        = 0x3c
+
+	// RegisteredDeliveryFinal requests a delivery receipt once a submitted
+	// message reaches a final state (SMPP 3.4 section 5.2.17)
+	RegisteredDeliveryFinal = 0x01
+)
+
+// Message states, as reported in delivery receipts (SMPP 3.4 appendix B)
+const (
+	StateEnroute       = "ENROUTE"
+	StateDelivered     = "DELIVRD"
+	StateExpired       = "EXPIRED"
+	StateDeleted       = "DELETED"
+	StateUndeliverable = "UNDELIV"
+	StateAccepted      = "ACCEPTD"
+	StateUnknown       = "UNKNOWN"
+	StateRejected      = "REJECTD"
+)
+
+// message_state optional parameter values (SMPP 3.4 section 5.2.28)
+var messageStates = map[byte]string{
+	1: StateEnroute,
+	2: StateDelivered,
+	3: StateExpired,
+	4: StateDeleted,
+	5: StateUndeliverable,
+	6: StateAccepted,
+	7: StateUnknown,
+	8: StateRejected,
+}
+
+var (
+	receiptIDRegex    = regexp.MustCompile(`(?i)\bid:(\S+)`)
+	receiptStateRegex = regexp.MustCompile(`(?i)\bstat:(\S+)`)
+	receiptErrorRegex = regexp.MustCompile(`(?i)\berr:(\S+)`)
+)
+
+// DeliveryReceipt is the delivery status of a previously submitted message,
+// as reported by the SMSC.
+type DeliveryReceipt struct {
+	// MessageID is the ID assigned by the SMSC in the submit_sm_resp
+	MessageID string
+	// State is one of the State constants
+	State string
+	// Error is the network-specific error code, if any
+	Error string
+}
+
+// IsFinal returns true if the message won't change state anymore.
+func (r *DeliveryReceipt) IsFinal() bool {
+	switch r.State {
+	case StateEnroute, StateAccepted, StateUnknown:
+		return false
+	default:
+		return true
+	}
+}
+
+// IsDelivered returns true if the message was delivered to its destination.
+func (r *DeliveryReceipt) IsDelivered() bool {
+	return r.State == StateDelivered
+}
+
+// IsDeliveryReceipt returns true if the message is a delivery receipt rather
+// than a mobile-terminated message.
+func (sm *ShortMessage) IsDeliveryReceipt() bool {
+	return sm.ESMClass&esmClassTypeMask == ESMClassDeliveryReceipt
+}
+
+// ParseDeliveryReceipt extracts the delivery receipt from a deliver_sm.
+// The receipted_message_id and message_state parameters take precedence over
+// the receipt text, whose format is only recommended by the specification.
+func ParseDeliveryReceipt(sm *ShortMessage) (*DeliveryReceipt, error) {
+	if !sm.IsDeliveryReceipt() {
+		return nil, errors.New("smpp: message is not a delivery receipt")
+	}
+
+	ret := &DeliveryReceipt{}
+	text := string(sm.ShortMessage)
+	if id, ok := sm.Options[TagReceiptedMessageID]; ok {
+		ret.MessageID = string(trimNull(id))
+	} else if match := receiptIDRegex.FindStringSubmatch(text); match != nil {
+		ret.MessageID = match[1]
+	}
+	if state, ok := sm.Options[TagMessageState]; ok && len(state) == 1 {
+		ret.State = messageStates[state[0]]
+	} else if match := receiptStateRegex.FindStringSubmatch(text); match != nil {
+		ret.State = match[1]
+	}
+	if match := receiptErrorRegex.FindStringSubmatch(text); match != nil {
+		ret.Error = match[1]
+	}
+
+	if ret.MessageID == "" || ret.State == "" {
+		return nil, fmt.Errorf("smpp: malformed delivery receipt %q", text)
+	}
+	return ret, nil
+}
+
+// FormatDeliveryReceipt returns the receipt text recommended by the
+// specification for a delivery receipt.
+func FormatDeliveryReceipt(receipt *DeliveryReceipt) string {
+	delivered := 0
+	if receipt.IsDelivered() {
+		delivered = 1
+	}
+	errCode := receipt.Error
+	if errCode == "" {
+		errCode = "000"
+	}
+	return fmt.Sprintf("id:%s sub:001 dlvrd:%03d stat:%s err:%s", receipt.MessageID, delivered, receipt.State, errCode)
+}
+
+// C-octet string parameters are null terminated
+func trimNull(b []byte) []byte {
+	if len(b) > 0 && b[len(b)-1] == 0 {
+		return b[:len(b)-1]
+	}
+	return b
+}
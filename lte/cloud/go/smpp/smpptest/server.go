@@ -0,0 +1,285 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package smpptest provides a stub SMSC for testing SMPP clients.
+package smpptest
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"magma/lte/cloud/go/smpp"
+)
+
+const responseTimeout = 5 * time.Second
+
+// Server is a stub SMSC listening on a local port. It accepts transceiver
+// binds, records submitted messages and can deliver messages to bound
+// sessions.
+type Server struct {
+	// Addr is the host:port the server is listening on
+	Addr string
+
+	SystemID string
+	Password string
+
+	listener net.Listener
+
+	mu           sync.Mutex
+	submitStatus smpp.Status
+	nextID       int
+	submitted    []*smpp.ShortMessage
+	sessions     map[*session]bool
+	wg           sync.WaitGroup
+}
+
+// NewServer starts a stub SMSC which authenticates binds with the given
+// credentials.
+func NewServer(systemID string, password string) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		Addr:     listener.Addr().String(),
+		SystemID: systemID,
+		Password: password,
+		listener: listener,
+		sessions: map[*session]bool{},
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Close stops the server and closes all sessions.
+func (s *Server) Close() {
+	s.listener.Close()
+	s.mu.Lock()
+	for sess := range s.sessions {
+		sess.conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// Submitted returns the messages submitted to the server so far. Message IDs
+// are assigned in order, starting at "1".
+func (s *Server) Submitted() []*smpp.ShortMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*smpp.ShortMessage{}, s.submitted...)
+}
+
+// SetSubmitStatus sets the status returned for subsequent submit_sm requests.
+// Messages are only accepted while the status is OK.
+func (s *Server) SetSubmitStatus(status smpp.Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.submitStatus = status
+}
+
+// NumSessions returns the number of bound sessions.
+func (s *Server) NumSessions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for sess := range s.sessions {
+		if sess.isBound() {
+			n++
+		}
+	}
+	return n
+}
+
+// Deliver sends a deliver_sm to a bound session, returning the status of the
+// session's response.
+func (s *Server) Deliver(sm *smpp.ShortMessage) (smpp.Status, error) {
+	var target *session
+	s.mu.Lock()
+	for sess := range s.sessions {
+		if sess.isBound() {
+			target = sess
+			break
+		}
+	}
+	s.mu.Unlock()
+	if target == nil {
+		return 0, fmt.Errorf("no bound session")
+	}
+	return target.deliver(sm)
+}
+
+// DeliverReceipt sends a delivery receipt for a submitted message.
+func (s *Server) DeliverReceipt(messageID string, state string) (smpp.Status, error) {
+	receipt := &smpp.DeliveryReceipt{MessageID: messageID, State: state}
+	return s.Deliver(&smpp.ShortMessage{
+		ESMClass:     smpp.ESMClassDeliveryReceipt,
+		ShortMessage: []byte(smpp.FormatDeliveryReceipt(receipt)),
+	})
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		sess := &session{server: s, conn: conn, pending: map[uint32]chan *smpp.PDU{}}
+		s.mu.Lock()
+		s.sessions[sess] = true
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			sess.serve()
+			s.mu.Lock()
+			delete(s.sessions, sess)
+			s.mu.Unlock()
+		}()
+	}
+}
+
+func (s *Server) submit(sm *smpp.ShortMessage) (string, smpp.Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.submitStatus != smpp.StatusOK {
+		return "", s.submitStatus
+	}
+	s.nextID++
+	s.submitted = append(s.submitted, sm)
+	return strconv.Itoa(s.nextID), smpp.StatusOK
+}
+
+type session struct {
+	server *Server
+	conn   net.Conn
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	bound   bool
+	seq     uint32
+	pending map[uint32]chan *smpp.PDU
+}
+
+func (sess *session) isBound() bool {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	return sess.bound
+}
+
+func (sess *session) write(p *smpp.PDU) error {
+	sess.writeMu.Lock()
+	defer sess.writeMu.Unlock()
+	return smpp.WritePDU(sess.conn, p)
+}
+
+func (sess *session) deliver(sm *smpp.ShortMessage) (smpp.Status, error) {
+	body, err := sm.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	respCh := make(chan *smpp.PDU, 1)
+	sess.mu.Lock()
+	sess.seq++
+	seq := sess.seq
+	sess.pending[seq] = respCh
+	sess.mu.Unlock()
+
+	err = sess.write(&smpp.PDU{CommandID: smpp.DeliverSM, SequenceNumber: seq, Body: body})
+	if err != nil {
+		return 0, err
+	}
+	select {
+	case resp := <-respCh:
+		return resp.CommandStatus, nil
+	case <-time.After(responseTimeout):
+		return 0, fmt.Errorf("timed out waiting for deliver_sm_resp")
+	}
+}
+
+func (sess *session) serve() {
+	defer sess.conn.Close()
+	for {
+		p, err := smpp.ReadPDU(sess.conn)
+		if err != nil {
+			return
+		}
+		if p.IsResponse() {
+			sess.mu.Lock()
+			respCh, ok := sess.pending[p.SequenceNumber]
+			delete(sess.pending, p.SequenceNumber)
+			sess.mu.Unlock()
+			if ok {
+				respCh <- p
+			}
+			continue
+		}
+
+		var resp *smpp.PDU
+		switch {
+		case p.CommandID == smpp.BindTransceiver:
+			resp = p.Response(sess.bind(p))
+			resp.Body = smpp.EncodeMessageID(sess.server.SystemID)
+		case !sess.isBound():
+			resp = &smpp.PDU{CommandID: smpp.GenericNack, CommandStatus: smpp.StatusInvalidBind, SequenceNumber: p.SequenceNumber}
+		case p.CommandID == smpp.SubmitSM:
+			sm := &smpp.ShortMessage{}
+			status := smpp.StatusOK
+			id := ""
+			if sm.UnmarshalBinary(p.Body) != nil {
+				status = smpp.StatusInvalidMsgLen
+			} else {
+				id, status = sess.server.submit(sm)
+			}
+			resp = p.Response(status)
+			resp.Body = smpp.EncodeMessageID(id)
+		case p.CommandID == smpp.EnquireLink:
+			resp = p.Response(smpp.StatusOK)
+		case p.CommandID == smpp.Unbind:
+			_ = sess.write(p.Response(smpp.StatusOK))
+			return
+		default:
+			resp = &smpp.PDU{CommandID: smpp.GenericNack, CommandStatus: smpp.StatusInvalidCmdID, SequenceNumber: p.SequenceNumber}
+		}
+		if sess.write(resp) != nil {
+			return
+		}
+	}
+}
+
+func (sess *session) bind(p *smpp.PDU) smpp.Status {
+	bind := &smpp.Bind{}
+	if bind.UnmarshalBinary(p.Body) != nil {
+		return smpp.StatusInvalidCmdLen
+	}
+	if bind.SystemID != sess.server.SystemID {
+		return smpp.StatusInvalidSysID
+	}
+	if bind.Password != sess.server.Password {
+		return smpp.StatusInvalidPasswd
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.bound {
+		return smpp.StatusAlreadyBound
+	}
+	sess.bound = true
+	return smpp.StatusOK
+}
@@ -16,9 +16,9 @@ package sms_ll
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/warthog618/sms"
 	"github.com/warthog618/sms/encoding/tpdu"
 )
 
@@ -28,22 +28,37 @@ import (
 type SMSSerde interface {
 	EncodeMessage(message string, fromNum string, timestamp time.Time, references []uint8) ([][]byte, error)
 	DecodeDelivery(input []byte) (SMSDeliveryReport, error)
+	DecodeSubmit(input []byte) (SMSSubmit, error)
+	EncodeSubmitReport(submit SMSSubmit, cause uint8) ([][]byte, error)
 }
 
 // DefaultSMSSerde is the SMSSerde impl that's backed by the exported functions
 // in this package.
-type DefaultSMSSerde struct{}
+type DefaultSMSSerde struct {
+	// NationalLanguages are the national language shift tables messages may
+	// be encoded with.
+	NationalLanguages []NationalLanguage
+}
 
 func (d *DefaultSMSSerde) EncodeMessage(message string, fromNum string, timestamp time.Time, references []uint8) ([][]byte, error) {
-	return GenerateSmsDelivers(message, fromNum, timestamp, references)
+	return GenerateSmsDelivers(message, fromNum, timestamp, references, WithNationalLanguages(d.NationalLanguages...))
 }
 
 func (d *DefaultSMSSerde) DecodeDelivery(input []byte) (SMSDeliveryReport, error) {
 	return Decode(input)
 }
 
+func (d *DefaultSMSSerde) DecodeSubmit(input []byte) (SMSSubmit, error) {
+	return DecodeSubmit(input)
+}
+
+func (d *DefaultSMSSerde) EncodeSubmitReport(submit SMSSubmit, cause uint8) ([][]byte, error) {
+	return GenerateSubmitReport(submit, cause)
+}
+
 // Generate fully encoded SMS PDUs for delivery to a UE (MS). Will handle
-// encoding and chunking of messages as appropriate. We first generate TPDUs,
+// encoding and chunking of messages as appropriate, see GetMessageEncoding for
+// how the alphabet is chosen. We first generate TPDUs,
 // then RP-DATA headers, and finally CP-DATA headers, resulting in a set of
 // byte arrays that can be directly delivered to a UE (MS).
 // Inputs:
@@ -51,12 +66,18 @@ func (d *DefaultSMSSerde) DecodeDelivery(input []byte) (SMSDeliveryReport, error
 //   - from_num: A E.164 encoded source number.
 //   - timestamp: The sender timestamp for the SMS (generally, use current server time)
 //   - references: An array of references for the messages we'll generate. Must match number of PDUs generated.
+//     The first reference is also used as the concatenation reference of multi-part messages.
+//   - options: Encoding options, e.g. the national languages available
 //
 // Outputs:
 //   - Array of byte array representing the set of fully-encoded CP-DATA(RP-DATA(TPDU)) messages generated
 //   - Error	(if any)
-func GenerateSmsDelivers(message string, fromNum string, timestamp time.Time, references []uint8) ([][]byte, error) {
-	tpdus := createTpdus(message, fromNum, timestamp)
+func GenerateSmsDelivers(message string, fromNum string, timestamp time.Time, references []uint8, options ...EncodeOption) ([][]byte, error) {
+	var concatRef uint8
+	if len(references) > 0 {
+		concatRef = references[0]
+	}
+	tpdus := createTpdus(message, fromNum, timestamp, concatRef, options...)
 	if len(references) != len(tpdus) {
 		return nil, fmt.Errorf("insufficient references for generated TPDU (have %d, need %d)", len(references), len(tpdus))
 	}
@@ -92,16 +113,22 @@ func GenerateSmsDelivers(message string, fromNum string, timestamp time.Time, re
 // after taking TPDU encoding into account.
 // Inputs:
 // - message: A UTF-8 string representing the SMS
+// - options: Encoding options, must match those the message is generated with
 //
 // Outputs:
 //   - An integer representing the number of SMS messages the input will
 //     need to be split across after encoding.
-func GetMessageCount(message string) int {
+func GetMessageCount(message string, options ...EncodeOption) int {
 	// Number of SMS is determined only by the message content, not the
 	// timestamp or number.
-	return len(createTpdus(message, "123456", time.Now()))
+	return GetMessageEncoding(message, options...).Segments
 }
 
+// ErrRpData is returned by Decode when the input is an RP-DATA message rather
+// than a delivery report, i.e. a mobile-originated message which should be
+// decoded with DecodeSubmit.
+var ErrRpData = errors.New("RP-DATA message, ignoring")
+
 // SMSDeliveryReport is a struct that wraps the decoded result of a
 // SMS-DELIVERY-REPORT message.
 // ErrorMessage field will be non-empty if IsSuccessful is false and the input
@@ -157,12 +184,125 @@ func Decode(input []byte) (SMSDeliveryReport, error) {
 			ErrorMessage: rpm.cause.causeStr,
 		}, nil
 	default:
-		return ret, errors.New("RP-DATA message, ignoring")
+		return ret, ErrRpData
+	}
+}
+
+// SMSSubmit is a struct that wraps the decoded result of a mobile-originated
+// SMS-SUBMIT message.
+// Segments of a concatenated message are decoded individually, with
+// ConcatTotal set to the number of segments in the message. ConcatTotal is 0
+// for messages which aren't concatenated.
+type SMSSubmit struct {
+	// TransactionID is the CP transaction ID allocated by the UE (MS)
+	TransactionID uint8
+	// Reference is the RP message reference, which must be acknowledged
+	Reference uint8
+	// MessageReference is the TP-MR assigned by the UE (MS)
+	MessageReference uint8
+	// Destination is the TP-DA of the message
+	Destination string
+	// Message is the UTF-8 text of the message (or segment)
+	Message string
+
+	ConcatReference int
+	ConcatTotal     int
+	ConcatSequence  int
+}
+
+// Decodes a mobile-originated SMS-SUBMIT message.
+// Inputs:
+//   - input: A byte array representing a fully encoded SMS sent by a UE
+//
+// Outputs:
+//   - SMSSubmit: the decoded message
+//   - error: if the message received is not a CP-DATA(RP-DATA(SMS-SUBMIT)).
+func DecodeSubmit(input []byte) (SMSSubmit, error) {
+	ret := SMSSubmit{}
+	cpm := new(cpMessage)
+	err := cpm.unmarshalBinary(input)
+	if err != nil {
+		return ret, err
+	}
+	if cpm.messageType != CpData {
+		return ret, fmt.Errorf("not a CP-DATA message: %x", cpm.messageType)
+	}
+
+	rpm := new(rpMessage)
+	err = rpm.unmarshalBinary(cpm.rpdu)
+	if err != nil {
+		return ret, err
+	}
+	if rpm.mti != RpMtiMoData {
+		return ret, smsRpError(fmt.Sprintf("not an MO RP-DATA message: 0x%x", rpm.mti))
+	}
+
+	tp := &tpdu.TPDU{Direction: tpdu.MO}
+	err = tp.UnmarshalBinary(rpm.userData.tpdu)
+	if err != nil {
+		return ret, err
+	}
+	if tp.SmsType() != tpdu.SmsSubmit {
+		return ret, fmt.Errorf("not an SMS-SUBMIT TPDU: %s", tp.SmsType())
+	}
+	message, err := decodeUserData(tp)
+	if err != nil {
+		return ret, err
+	}
+
+	ret = SMSSubmit{
+		TransactionID:    cpm.GetTransactionId(),
+		Reference:        rpm.reference,
+		MessageReference: tp.MR,
+		Destination:      strings.TrimPrefix(tp.DA.Number(), "+"),
+		Message:          message,
+	}
+	if segments, seqno, concatRef, ok := tp.ConcatInfo(); ok {
+		ret.ConcatReference, ret.ConcatTotal, ret.ConcatSequence = concatRef, segments, seqno
+	}
+	return ret, nil
+}
+
+// Generate the fully encoded response to an SMS-SUBMIT message. The network
+// acknowledges the CP-DATA with a CP-ACK, then responds to the RP-DATA with an
+// RP-ACK, or an RP-ERROR if cause is non-zero.
+// Inputs:
+//   - submit: The decoded SMS-SUBMIT being responded to
+//   - cause: An RP cause (TS24.011 Table 8.4), or 0 for success
+//
+// Outputs:
+//   - Array of byte array representing the CP-ACK and CP-DATA(RP-ACK/RP-ERROR) messages, in order
+//   - Error	(if any)
+func GenerateSubmitReport(submit SMSSubmit, cause uint8) ([][]byte, error) {
+	// The network responds within the UE's transaction, so the TI flag
+	// (high order bit of the transaction ID) must be set.
+	txID := submit.TransactionID | 0x8
+
+	cpAck, err := createCpMessage(txID, CpAck, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rpm := rpMessage{mti: RpMtiMtAck, reference: submit.Reference}
+	if cause != 0 {
+		if _, ok := RpCauseStr[cause]; !ok {
+			return nil, smsRpError(fmt.Sprintf("Invalid cause: %x", cause))
+		}
+		rpm.mti = RpMtiMtErr
+		rpm.cause = rpCauseElement{iei: RpCauseIei, length: 1, cause: cause}
 	}
+	cpData, err := createCpDataMessage(rpm.marshalBinary(), txID)
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{cpAck.marshalBinary(), cpData.marshalBinary()}, nil
 }
 
-func createTpdus(message string, from_num string, timestamp time.Time) []tpdu.TPDU {
-	tpdus, _ := sms.Encode([]byte(message), sms.AsDeliver, sms.From(from_num))
+func createTpdus(message string, from_num string, timestamp time.Time, concatRef uint8, options ...EncodeOption) []tpdu.TPDU {
+	enc := encodeUserData(message, options...)
+	enc.template.OA = tpdu.NewAddress(tpdu.FromNumber(from_num))
+	tpdus := enc.template.Segment(enc.ud, tpdu.WithConcatRef(concatReference(concatRef)))
 	for i := range tpdus {
 		tpdus[i].FirstOctet |= tpdu.FoMMS // Android won't accept if this bit isn't set.
 		tpdus[i].FirstOctet |= tpdu.FoSRI // Request a delivery report.
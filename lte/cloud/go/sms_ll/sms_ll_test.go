@@ -3,10 +3,14 @@ package sms_ll
 import (
 	"encoding/hex"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/warthog618/sms/encoding/gsm7"
+	"github.com/warthog618/sms/encoding/tpdu"
+	"github.com/warthog618/sms/encoding/ucs2"
 )
 
 // Test cases:
@@ -57,6 +61,206 @@ func TestEncodingMultipleSMS(t *testing.T) {
 	}
 }
 
+func TestEncodingRoundTrip(t *testing.T) {
+	ts := time.Date(2020, 9, 14, 16, 30, 50, 12345, time.UTC)
+	num := "18658675309"
+
+	tests := []struct {
+		name      string
+		message   string
+		languages []NationalLanguage
+		want      MessageEncoding
+	}{
+		{name: "gsm7", message: "Here's a test.", want: MessageEncoding{Alphabet: AlphabetGSM7, Segments: 1}},
+		{name: "gsm7-max", message: strings.Repeat("a", 160), want: MessageEncoding{Alphabet: AlphabetGSM7, Segments: 1}},
+		{name: "gsm7-concat", message: strings.Repeat("a", 161), want: MessageEncoding{Alphabet: AlphabetGSM7, Segments: 2}},
+		// The escape of the last character would be split across segments
+		{name: "gsm7-escape-boundary", message: strings.Repeat("a", 152) + "€ and {braces}", want: MessageEncoding{Alphabet: AlphabetGSM7, Segments: 2}},
+		{name: "ucs2", message: "Привет, как дела?", want: MessageEncoding{Alphabet: AlphabetUCS2, Segments: 1}},
+		{name: "ucs2-max", message: strings.Repeat("Ж", 70), want: MessageEncoding{Alphabet: AlphabetUCS2, Segments: 1}},
+		{name: "ucs2-concat", message: strings.Repeat("Ж", 71), want: MessageEncoding{Alphabet: AlphabetUCS2, Segments: 2}},
+		{name: "ucs2-mixed", message: "Hello 世界", want: MessageEncoding{Alphabet: AlphabetUCS2, Segments: 1}},
+		// Surrogate pairs aren't split across segments, so the 34th emoji
+		// starts the second segment
+		{name: "emoji", message: strings.Repeat("😀", 40), want: MessageEncoding{Alphabet: AlphabetUCS2, Segments: 2}},
+		{name: "spanish-unavailable", message: "¿Dónde está?", want: MessageEncoding{Alphabet: AlphabetUCS2, Segments: 1}},
+		{
+			name:      "spanish-single-shift",
+			message:   "¿Dónde está?",
+			languages: []NationalLanguage{LanguageSpanish},
+			want:      MessageEncoding{Alphabet: AlphabetGSM7, SingleShift: LanguageSpanish, Segments: 1},
+		},
+		{
+			name:      "spanish-single-shift-concat",
+			message:   strings.Repeat("¿Dónde está? ", 19),
+			languages: []NationalLanguage{LanguageSpanish},
+			want:      MessageEncoding{Alphabet: AlphabetGSM7, SingleShift: LanguageSpanish, Segments: 2},
+		},
+		{
+			name:      "turkish-single-shift",
+			message:   "Şişli'de buluşalım mı?",
+			languages: []NationalLanguage{LanguageSpanish, LanguageTurkish},
+			want:      MessageEncoding{Alphabet: AlphabetGSM7, SingleShift: LanguageTurkish, Segments: 1},
+		},
+		// Escaping every character would take 3 segments
+		{
+			name:      "turkish-locking-shift",
+			message:   strings.Repeat("ş", 150),
+			languages: []NationalLanguage{LanguageTurkish},
+			want:      MessageEncoding{Alphabet: AlphabetGSM7, LockingShift: LanguageTurkish, Segments: 1},
+		},
+		{
+			name:      "turkish-locking-shift-concat",
+			message:   strings.Repeat("ş", 298),
+			languages: []NationalLanguage{LanguageTurkish},
+			want:      MessageEncoding{Alphabet: AlphabetGSM7, LockingShift: LanguageTurkish, Segments: 2},
+		},
+		// Ê is only in the locking table, Φ only in the single shift table
+		{
+			name:      "portuguese-locking-and-single-shift",
+			message:   "ÊXITO Φ",
+			languages: []NationalLanguage{LanguagePortuguese},
+			want:      MessageEncoding{Alphabet: AlphabetGSM7, LockingShift: LanguagePortuguese, SingleShift: LanguagePortuguese, Segments: 1},
+		},
+		{name: "empty", message: "", want: MessageEncoding{Alphabet: AlphabetGSM7}},
+	}
+
+	for _, tc := range tests {
+		option := WithNationalLanguages(tc.languages...)
+		assert.Equal(t, tc.want, GetMessageEncoding(tc.message, option), tc.name)
+		assert.Equal(t, tc.want.Segments, GetMessageCount(tc.message, option), tc.name)
+
+		references := make([]uint8, tc.want.Segments)
+		for i := range references {
+			references[i] = uint8(0x40 + i)
+		}
+		b, err := GenerateSmsDelivers(tc.message, num, ts, references, option)
+		assert.NoError(t, err, tc.name)
+		assert.Len(t, b, tc.want.Segments, tc.name)
+
+		actual := ""
+		for i := range b {
+			tp := decodeDeliver(t, b[i], references[i])
+			assert.Equal(t, "+"+num, tp.OA.Number(), tc.name)
+
+			alphabet, err := tp.Alphabet()
+			assert.NoError(t, err, tc.name)
+			if tc.want.Alphabet == AlphabetUCS2 {
+				assert.Equal(t, tpdu.AlphaUCS2, alphabet, tc.name)
+			} else {
+				assert.Equal(t, tpdu.Alpha7Bit, alphabet, tc.name)
+			}
+			assertShiftIE(t, tp, lockingShiftIei, tc.want.LockingShift, tc.name)
+			assertShiftIE(t, tp, singleShiftIei, tc.want.SingleShift, tc.name)
+
+			segments, seqno, concatRef, ok := tp.ConcatInfo()
+			if tc.want.Segments > 1 {
+				assert.True(t, ok, tc.name)
+				assert.Equal(t, []int{tc.want.Segments, i + 1, int(references[0])}, []int{segments, seqno, concatRef}, tc.name)
+			} else {
+				assert.False(t, ok, tc.name)
+			}
+
+			// Each segment must decode on its own
+			segment, err := decodeUserData(tp)
+			assert.NoError(t, err, tc.name)
+			actual += segment
+		}
+		assert.Equal(t, tc.message, actual, tc.name)
+	}
+
+	_, err := GenerateSmsDelivers(strings.Repeat("Ж", 71), num, ts, []uint8{1})
+	assert.EqualError(t, err, "insufficient references for generated TPDU (have 1, need 2)")
+}
+
+func TestDecodeSubmitRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		dcs     tpdu.DCS
+		udh     tpdu.UserDataHeader
+	}{
+		{name: "ucs2", message: "Привет 😀", dcs: tpdu.DcsUCS2Data},
+		{
+			name:    "turkish-single-shift",
+			message: "Şişli'de buluşalım mı?",
+			udh:     tpdu.UserDataHeader{{ID: singleShiftIei, Data: []byte{byte(LanguageTurkish)}}},
+		},
+		{
+			name:    "turkish-locking-shift",
+			message: "Şişli'de buluşalım mı?",
+			udh:     tpdu.UserDataHeader{{ID: lockingShiftIei, Data: []byte{byte(LanguageTurkish)}}},
+		},
+	}
+
+	for _, tc := range tests {
+		tp := tpdu.TPDU{}
+		assert.NoError(t, tp.SetSmsType(tpdu.SmsSubmit))
+		tp.MR = 0x20
+		tp.DA = tpdu.NewAddress(tpdu.FromNumber("15551234567"))
+		if tc.dcs == tpdu.DcsUCS2Data {
+			tp.SetDCS(byte(tc.dcs))
+			tp.UD = ucs2.Encode([]rune(tc.message))
+		} else {
+			var options []gsm7.EncoderOption
+			if ie, ok := tc.udh.IE(lockingShiftIei); ok {
+				options = append(options, gsm7.WithCharset(int(ie.Data[0])))
+			}
+			if ie, ok := tc.udh.IE(singleShiftIei); ok {
+				options = append(options, gsm7.WithExtCharset(int(ie.Data[0])))
+			}
+			ud, err := gsm7.Encode([]byte(tc.message), options...)
+			assert.NoError(t, err, tc.name)
+			tp.UD = ud
+			tp.SetUDH(tc.udh)
+		}
+		tpBytes, err := tp.MarshalBinary()
+		assert.NoError(t, err, tc.name)
+		rpm, err := createRpDataMessage(RpMtiMoData, 0x30, tpBytes)
+		assert.NoError(t, err, tc.name)
+		cpm, err := createCpDataMessage(rpm.marshalBinary(), 1)
+		assert.NoError(t, err, tc.name)
+
+		actual, err := DecodeSubmit(cpm.marshalBinary())
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, SMSSubmit{TransactionID: 1, Reference: 0x30, MessageReference: 0x20, Destination: "15551234567", Message: tc.message}, actual, tc.name)
+	}
+}
+
+// decodeDeliver unwraps the SMS-DELIVER TPDU from an encoded CP-DATA(RP-DATA)
+func decodeDeliver(t *testing.T, input []byte, reference uint8) *tpdu.TPDU {
+	cpm := new(cpMessage)
+	assert.NoError(t, cpm.unmarshalBinary(input))
+	assert.EqualValues(t, CpData, cpm.messageType)
+	rpm := new(rpMessage)
+	assert.NoError(t, rpm.unmarshalBinary(cpm.rpdu))
+	assert.Equal(t, reference, rpm.reference)
+	tp := &tpdu.TPDU{Direction: tpdu.MT}
+	assert.NoError(t, tp.UnmarshalBinary(rpm.userData.tpdu))
+	assert.Equal(t, tpdu.SmsDeliver, tp.SmsType())
+	return tp
+}
+
+func assertShiftIE(t *testing.T, tp *tpdu.TPDU, iei byte, language NationalLanguage, name string) {
+	ie, ok := tp.UDH.IE(iei)
+	if language == LanguageDefault {
+		assert.False(t, ok, name)
+		return
+	}
+	assert.True(t, ok, name)
+	assert.Equal(t, []byte{byte(language)}, ie.Data, name)
+}
+
+func TestParseNationalLanguage(t *testing.T) {
+	l, err := ParseNationalLanguage("Turkish")
+	assert.NoError(t, err)
+	assert.Equal(t, LanguageTurkish, l)
+	assert.Equal(t, "turkish", l.String())
+
+	_, err = ParseNationalLanguage("klingon")
+	assert.EqualError(t, err, "unknown national language: klingon")
+}
+
 func TestDecode(t *testing.T) {
 	type decodeResult struct {
 		ref   uint8
@@ -84,6 +288,69 @@ func TestDecode(t *testing.T) {
 	}
 }
 
+func TestDecodeSubmit(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  SMSSubmit
+		err   bool
+	}{
+		{
+			name:  "single",
+			input: "19011e00300002b9111701200b915155214365f700000bc8329bfd06bde5639c1c",
+			want:  SMSSubmit{TransactionID: 1, Reference: 0x30, MessageReference: 0x20, Destination: "15551234567", Message: "Hello orc8r"},
+		},
+		{
+			name:  "concatenated-segment",
+			input: "29012d00310002b9112641210b915155214365f700001c050003010202e8a739685e8797e5a0791d5e9683d86ff7d905",
+			want: SMSSubmit{
+				TransactionID:    2,
+				Reference:        0x31,
+				MessageReference: 0x21,
+				Destination:      "15551234567",
+				Message:          "t's super super long.",
+				ConcatReference:  1,
+				ConcatTotal:      2,
+				ConcatSequence:   2,
+			},
+		},
+		{name: "delivery-report", input: "d90106020141020000", err: true},
+		{name: "mt-data", input: "790127010702b9110020240b918156685703f90000029041610305000ec8b2bc7c9a83c2207a794e7701", err: true},
+		{name: "giberish", input: "209491c192c912ca1010", err: true},
+	}
+
+	for _, tc := range tests {
+		msg, _ := hex.DecodeString(tc.input)
+		actual, err := DecodeSubmit(msg)
+		if tc.err {
+			assert.Error(t, err, tc.name)
+			continue
+		}
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.want, actual, tc.name)
+	}
+
+	// Decode reports MO messages with a sentinel error
+	msg, _ := hex.DecodeString(tests[0].input)
+	_, err := Decode(msg)
+	assert.Equal(t, ErrRpData, err)
+}
+
+func TestGenerateSubmitReport(t *testing.T) {
+	submit := SMSSubmit{TransactionID: 1, Reference: 0x30}
+
+	b, err := GenerateSubmitReport(submit, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"9904", "9901020330"}, []string{hex.EncodeToString(b[0]), hex.EncodeToString(b[1])})
+
+	b, err = GenerateSubmitReport(submit, RpCauseCongestion)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"9904", "9901040530012a"}, []string{hex.EncodeToString(b[0]), hex.EncodeToString(b[1])})
+
+	_, err = GenerateSubmitReport(submit, 0xff)
+	assert.Error(t, err)
+}
+
 func TestPiecewiseDecodeDeliveryFailure(t *testing.T) {
 	input := "d9010404010160"
 	cp_hex, _ := hex.DecodeString(input)
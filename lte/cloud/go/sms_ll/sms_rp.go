@@ -55,13 +55,11 @@ func (rpadde rpAddressElement) marshalBinary() []byte {
 // Decode an address element. Returns the length of the address element if present.
 func (rpadde *rpAddressElement) unmarshalBinary(input []byte) (int, error) {
 	// Empty addresses will be one byte long with a zero value length
-	if len(input) == 1 {
-		if input[0] != 0x0 {
-			return -1, smsRpError("Invalid RP Address of length 1")
-		} else {
-			rpadde.length = input[0]
-			return 1, nil
-		}
+	if len(input) == 0 {
+		return -1, smsRpError("Missing RP Address")
+	} else if input[0] == 0x0 {
+		rpadde.length = input[0]
+		return 1, nil
 	} else if len(input) < 3 { // if it's not zero length, we must have at least 3 octets
 		return -1, smsRpError("Invalid RP Address")
 	}
@@ -0,0 +1,260 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package sms_ll
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/warthog618/sms/encoding/gsm7"
+	"github.com/warthog618/sms/encoding/gsm7/charset"
+	"github.com/warthog618/sms/encoding/tpdu"
+	"github.com/warthog618/sms/encoding/ucs2"
+)
+
+// Alphabet is the character set the user data of an SMS is encoded with.
+// See TS23.038 Section 4.
+type Alphabet string
+
+const (
+	AlphabetGSM7 Alphabet = "GSM-7"
+	AlphabetUCS2 Alphabet = "UCS-2"
+)
+
+// NationalLanguage identifies a set of GSM 7 bit national language shift
+// tables. See TS23.038 Section 6.2.1.2.4.
+type NationalLanguage int
+
+const (
+	LanguageDefault    = NationalLanguage(charset.Default)
+	LanguageTurkish    = NationalLanguage(charset.Turkish)
+	LanguageSpanish    = NationalLanguage(charset.Spanish)
+	LanguagePortuguese = NationalLanguage(charset.Portuguese)
+	LanguageBengali    = NationalLanguage(charset.Bengali)
+	LanguageGujarati   = NationalLanguage(charset.Gujaranti)
+	LanguageHindi      = NationalLanguage(charset.Hindi)
+	LanguageKannada    = NationalLanguage(charset.Kannada)
+	LanguageMalayalam  = NationalLanguage(charset.Malayalam)
+	LanguageOriya      = NationalLanguage(charset.Oriya)
+	LanguagePunjabi    = NationalLanguage(charset.Punjabi)
+	LanguageTamil      = NationalLanguage(charset.Tamil)
+	LanguageTelugu     = NationalLanguage(charset.Telugu)
+	LanguageUrdu       = NationalLanguage(charset.Urdu)
+)
+
+var nationalLanguageNames = map[NationalLanguage]string{
+	LanguageDefault:    "default",
+	LanguageTurkish:    "turkish",
+	LanguageSpanish:    "spanish",
+	LanguagePortuguese: "portuguese",
+	LanguageBengali:    "bengali",
+	LanguageGujarati:   "gujarati",
+	LanguageHindi:      "hindi",
+	LanguageKannada:    "kannada",
+	LanguageMalayalam:  "malayalam",
+	LanguageOriya:      "oriya",
+	LanguagePunjabi:    "punjabi",
+	LanguageTamil:      "tamil",
+	LanguageTelugu:     "telugu",
+	LanguageUrdu:       "urdu",
+}
+
+func (l NationalLanguage) String() string {
+	if name, ok := nationalLanguageNames[l]; ok {
+		return name
+	}
+	return fmt.Sprintf("NationalLanguage(%d)", int(l))
+}
+
+// ParseNationalLanguage returns the national language with the given name,
+// e.g. "turkish". Names are case-insensitive.
+func ParseNationalLanguage(name string) (NationalLanguage, error) {
+	for l, lName := range nationalLanguageNames {
+		if strings.EqualFold(name, lName) {
+			return l, nil
+		}
+	}
+	return LanguageDefault, fmt.Errorf("unknown national language: %s", name)
+}
+
+// hasLockingShift returns true if the language defines a locking shift table.
+// Spanish only defines a single shift table.
+func (l NationalLanguage) hasLockingShift() bool {
+	return l != LanguageDefault && l != LanguageSpanish
+}
+
+// National language shift IEIs, see TS23.040 Section 9.2.3.24.
+const (
+	singleShiftIei  byte = 0x24
+	lockingShiftIei byte = 0x25
+)
+
+// EncodeOption modifies how messages are encoded into TPDUs.
+type EncodeOption func(*encodeConfig)
+
+type encodeConfig struct {
+	languages []NationalLanguage
+}
+
+// WithNationalLanguages allows messages to be encoded with the shift tables
+// of the given languages, in addition to the GSM 7 bit default alphabet.
+func WithNationalLanguages(languages ...NationalLanguage) EncodeOption {
+	return func(cfg *encodeConfig) {
+		cfg.languages = append(cfg.languages, languages...)
+	}
+}
+
+// MessageEncoding describes how a message is encoded for delivery.
+type MessageEncoding struct {
+	Alphabet Alphabet
+	// LockingShift and SingleShift are the national language tables the
+	// message is encoded with. Both are LanguageDefault for UCS-2 messages.
+	LockingShift NationalLanguage
+	SingleShift  NationalLanguage
+	// Segments is the number of SMS the message is split across.
+	Segments int
+}
+
+// GetMessageEncoding returns how a message will be encoded.
+//
+// Messages are encoded in the GSM 7 bit alphabet if possible, using whichever
+// of the available national language tables results in the fewest segments.
+// Messages that can't be represented in GSM 7 bit are encoded in UCS-2.
+func GetMessageEncoding(message string, options ...EncodeOption) MessageEncoding {
+	return encodeUserData(message, options...).MessageEncoding
+}
+
+// encodedMessage is a message encoded into user data, along with the TPDU
+// template (DCS and UDH) to segment it with.
+type encodedMessage struct {
+	MessageEncoding
+	template tpdu.TPDU
+	ud       []byte
+}
+
+func encodeUserData(message string, options ...EncodeOption) encodedMessage {
+	cfg := &encodeConfig{}
+	for _, option := range options {
+		option(cfg)
+	}
+
+	var best *encodedMessage
+	for _, enc := range getShiftCandidates(cfg.languages) {
+		ud, err := gsm7.Encode([]byte(message), gsm7.WithCharset(int(enc.LockingShift)), gsm7.WithExtCharset(int(enc.SingleShift)))
+		if err != nil {
+			continue
+		}
+		candidate := encodedMessage{MessageEncoding: enc, template: newDeliverTemplate(), ud: ud}
+		var udh tpdu.UserDataHeader
+		if enc.LockingShift != LanguageDefault {
+			udh = append(udh, tpdu.InformationElement{ID: lockingShiftIei, Data: []byte{byte(enc.LockingShift)}})
+		}
+		if enc.SingleShift != LanguageDefault {
+			udh = append(udh, tpdu.InformationElement{ID: singleShiftIei, Data: []byte{byte(enc.SingleShift)}})
+		}
+		if udh != nil {
+			candidate.template.SetUDH(udh)
+		}
+		candidate.Segments = len(candidate.template.Segment(ud))
+		// Candidates are in order of preference, so only replace on strictly
+		// fewer segments
+		if best == nil || candidate.Segments < best.Segments {
+			best = &candidate
+		}
+	}
+	if best != nil {
+		return *best
+	}
+
+	// GSM 7 bit never needs more segments than UCS-2, so UCS-2 is only used
+	// when the message can't be encoded otherwise.
+	ret := encodedMessage{
+		MessageEncoding: MessageEncoding{Alphabet: AlphabetUCS2},
+		template:        newDeliverTemplate(),
+		ud:              ucs2.Encode([]rune(message)),
+	}
+	ret.template.SetDCS(byte(tpdu.DcsUCS2Data))
+	ret.Segments = len(ret.template.Segment(ret.ud))
+	return ret
+}
+
+// getShiftCandidates returns the GSM 7 bit table combinations available for
+// the given languages, in order of preference. The default alphabet is
+// preferred as it needs no UDH, then single shift tables as they leave the
+// rest of the default alphabet intact.
+func getShiftCandidates(languages []NationalLanguage) []MessageEncoding {
+	candidates := []MessageEncoding{{Alphabet: AlphabetGSM7}}
+	for _, l := range languages {
+		if l != LanguageDefault {
+			candidates = append(candidates, MessageEncoding{Alphabet: AlphabetGSM7, SingleShift: l})
+		}
+	}
+	for _, l := range languages {
+		if l.hasLockingShift() {
+			candidates = append(candidates, MessageEncoding{Alphabet: AlphabetGSM7, LockingShift: l})
+		}
+	}
+	for _, l := range languages {
+		if l.hasLockingShift() {
+			candidates = append(candidates, MessageEncoding{Alphabet: AlphabetGSM7, LockingShift: l, SingleShift: l})
+		}
+	}
+	return candidates
+}
+
+func newDeliverTemplate() tpdu.TPDU {
+	t := tpdu.TPDU{}
+	_ = t.SetSmsType(tpdu.SmsDeliver)
+	return t
+}
+
+// concatReference is a tpdu.Counter which always returns the same
+// concatenation reference.
+type concatReference uint8
+
+func (c concatReference) Count() int {
+	return int(c)
+}
+
+// decodeUserData decodes the user data of a single TPDU, applying any
+// national language tables indicated by its UDH.
+func decodeUserData(tp *tpdu.TPDU) (string, error) {
+	alphabet, err := tp.Alphabet()
+	if err != nil {
+		return "", err
+	}
+	switch alphabet {
+	case tpdu.AlphaUCS2:
+		runes, err := ucs2.Decode(tp.UD)
+		if _, ok := err.(ucs2.ErrDanglingSurrogate); ok {
+			// The UE split a surrogate pair across segments, which we
+			// can't reassemble since segments are decoded individually
+			return string(append(runes, utf8.RuneError)), nil
+		}
+		return string(runes), err
+	case tpdu.Alpha8Bit:
+		return "", fmt.Errorf("unsupported alphabet: 8 bit data")
+	default:
+		var options []gsm7.DecoderOption
+		if ie, ok := tp.UDH.IE(lockingShiftIei); ok && len(ie.Data) == 1 {
+			options = append(options, gsm7.WithCharset(int(ie.Data[0])))
+		}
+		if ie, ok := tp.UDH.IE(singleShiftIei); ok && len(ie.Data) == 1 {
+			options = append(options, gsm7.WithExtCharset(int(ie.Data[0])))
+		}
+		message, err := gsm7.Decode(tp.UD, options...)
+		return string(message), err
+	}
+}
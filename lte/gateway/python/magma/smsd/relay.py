@@ -111,7 +111,7 @@ class SmsRelay(Job):
             return
 
         try:
-            self._smsd.ReportDelivery(
+            smsd_resp = self._smsd.ReportDelivery(
                 sms_orc8r_pb2.ReportDeliveryRequest(
                     report=sms_orc8r_pb2.SMOUplinkUnitdata(
                         imsi="IMSI" + request.imsi,
@@ -124,6 +124,15 @@ class SmsRelay(Job):
             context.set_code(grpc.StatusCode.INTERNAL)
             return
 
+        # Mobile-originated messages are acknowledged by smsd, relay the
+        # acknowledgements back to the UE
+        for dl in smsd_resp.responses:
+            try:
+                self._mme_sms.SMODownlink(dl, SMS_TIMEOUT_SECS)
+            except grpc.RpcError as err:
+                logging.error("RPC call to MME failed: %s", err)
+                return
+
     def _is_enabled(self) -> bool:
         """Return whether SMS should act as a relay
 
@@ -16,6 +16,7 @@ package configurator
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -230,6 +231,27 @@ func LoadNetwork(ctx context.Context, networkID string, loadMetadata bool, loadC
 	return networks[0], nil
 }
 
+// LoadSerializedNetwork is same as LoadNetwork, but returns the network's
+// storage representation, leaving its configs serialized.
+// If not found, returns ErrNotFound from magma/orc8r/lib/go/merrors.
+func LoadSerializedNetwork(ctx context.Context, networkID string) (*storage.Network, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.LoadNetworks(ctx, &protos.LoadNetworksRequest{
+		Filter:   &storage.NetworkLoadFilter{Ids: []string{networkID}},
+		Criteria: &storage.FullNetworkLoadCriteria,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Networks) == 0 {
+		return nil, merrors.ErrNotFound
+	}
+	return res.Networks[0], nil
+}
+
 // LoadNetworkConfig loads network config of type configType registered under the network ID.
 // If not found, returns ErrNotFound from magma/orc8r/lib/go/merrors.
 func LoadNetworkConfig(ctx context.Context, networkID, configType string, serdes serde.Registry) (interface{}, error) {
@@ -570,6 +592,77 @@ func LoadSerializedEntities(
 	return ents, entIDsToTKs(notFound), nil
 }
 
+// LoadSerializedGraphForEntity loads the entity graph containing the passed
+// entity, leaving entity configs serialized.
+// The graph is assembled by walking associations outward from the entity,
+// so it contains exactly the connected component the southbound mconfig
+// build would load for the same entity.
+func LoadSerializedGraphForEntity(ctx context.Context, networkID string, id storage2.TK) (*storage.EntityGraph, error) {
+	loaded := map[storage2.TK]*storage.NetworkEntity{}
+	missing := map[storage2.TK]bool{}
+	frontier := storage2.TKs{id}
+	for len(frontier) != 0 {
+		ents, notFound, err := loadEntities(ctx, networkID, nil, nil, nil, frontier, FullEntityLoadCriteria())
+		if err != nil {
+			return nil, err
+		}
+		if len(ents) == 0 && len(notFound) == 0 {
+			return nil, fmt.Errorf("failed to load graph for %s: no progress loading %v", id, frontier)
+		}
+		for _, tk := range entIDsToTKs(notFound) {
+			missing[tk] = true
+		}
+		if missing[id] {
+			return nil, merrors.ErrNotFound
+		}
+		for _, ent := range ents {
+			loaded[ent.GetTK()] = ent
+		}
+
+		// Entities omitted from a page-limited load are retried on the next
+		// pass, along with all newly discovered neighbors
+		next := map[storage2.TK]bool{}
+		for _, tk := range frontier {
+			next[tk] = true
+		}
+		for _, ent := range ents {
+			for _, neighbor := range append(ent.Associations, ent.ParentAssociations...) {
+				next[neighbor.ToTK()] = true
+			}
+		}
+		frontier = nil
+		for tk := range next {
+			if _, ok := loaded[tk]; !ok && !missing[tk] {
+				frontier = append(frontier, tk)
+			}
+		}
+	}
+
+	graph := &storage.EntityGraph{}
+	for _, ent := range loaded {
+		graph.Entities = append(graph.Entities, ent)
+		if len(ent.ParentAssociations) == 0 {
+			graph.RootEntities = append(graph.RootEntities, ent.GetID())
+		}
+		for _, child := range ent.Associations {
+			graph.Edges = append(graph.Edges, &storage.GraphEdge{From: ent.GetID(), To: child})
+		}
+	}
+	if len(graph.RootEntities) == 0 {
+		return nil, fmt.Errorf("graph for %s does not have root nodes", id)
+	}
+
+	storage.SortEntities(graph.Entities)
+	storage.SortIDs(graph.RootEntities)
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From.ToTK() != graph.Edges[j].From.ToTK() {
+			return graph.Edges[i].From.ToTK().IsLessThan(graph.Edges[j].From.ToTK())
+		}
+		return graph.Edges[i].To.ToTK().IsLessThan(graph.Edges[j].To.ToTK())
+	})
+	return graph, nil
+}
+
 func loadEntities(
 	ctx context.Context,
 	networkID string,
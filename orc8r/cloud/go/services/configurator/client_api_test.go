@@ -19,11 +19,14 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/stretchr/testify/assert"
+	"github.com/thoas/go-funk"
 
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
+	cfg_storage "magma/orc8r/cloud/go/services/configurator/storage"
 	"magma/orc8r/cloud/go/services/configurator/test_init"
 	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/merrors"
 )
 
 const (
@@ -265,6 +268,58 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, "foobar", entities[0].Name)
 }
 
+func TestLoadSerializedGraphForEntity(t *testing.T) {
+	test_init.StartTestService(t)
+	ctx := context.Background()
+
+	entitySerdes := serde.NewRegistry(
+		&mockSerde{domain: configurator.NetworkEntitySerdeDomain, serdeType: "foo"},
+	)
+	err := configurator.CreateNetwork(ctx, configurator.Network{ID: networkID1}, nil)
+	assert.NoError(t, err)
+
+	// Graph 1: foo:a -> foo:b -> foo:c, foo:d -> foo:b
+	// Graph 2: foo:e
+	_, err = configurator.CreateEntities(
+		ctx,
+		networkID1,
+		[]configurator.NetworkEntity{
+			{Type: "foo", Key: "c", Config: "cfg_c"},
+			{Type: "foo", Key: "b", Associations: storage.TKs{{Type: "foo", Key: "c"}}},
+			{Type: "foo", Key: "a", Associations: storage.TKs{{Type: "foo", Key: "b"}}},
+			{Type: "foo", Key: "d", Associations: storage.TKs{{Type: "foo", Key: "b"}}},
+			{Type: "foo", Key: "e"},
+		},
+		entitySerdes,
+	)
+	assert.NoError(t, err)
+
+	graph, err := configurator.LoadSerializedGraphForEntity(ctx, networkID1, storage.TK{Type: "foo", Key: "c"})
+	assert.NoError(t, err)
+	keys := funk.Map(graph.Entities, func(e *cfg_storage.NetworkEntity) string { return e.Key })
+	assert.Equal(t, []string{"a", "b", "c", "d"}, keys)
+	assert.Equal(t, []byte("cfg_c"), graph.Entities[2].Config)
+	assert.Equal(t, []*cfg_storage.EntityID{{Type: "foo", Key: "a"}, {Type: "foo", Key: "d"}}, graph.RootEntities)
+	assert.Equal(
+		t,
+		[]*cfg_storage.GraphEdge{
+			{From: &cfg_storage.EntityID{Type: "foo", Key: "a"}, To: &cfg_storage.EntityID{Type: "foo", Key: "b"}},
+			{From: &cfg_storage.EntityID{Type: "foo", Key: "b"}, To: &cfg_storage.EntityID{Type: "foo", Key: "c"}},
+			{From: &cfg_storage.EntityID{Type: "foo", Key: "d"}, To: &cfg_storage.EntityID{Type: "foo", Key: "b"}},
+		},
+		graph.Edges,
+	)
+
+	graph, err = configurator.LoadSerializedGraphForEntity(ctx, networkID1, storage.TK{Type: "foo", Key: "e"})
+	assert.NoError(t, err)
+	assert.Len(t, graph.Entities, 1)
+	assert.Equal(t, []*cfg_storage.EntityID{{Type: "foo", Key: "e"}}, graph.RootEntities)
+	assert.Empty(t, graph.Edges)
+
+	_, err = configurator.LoadSerializedGraphForEntity(ctx, networkID1, storage.TK{Type: "foo", Key: "nope"})
+	assert.Equal(t, merrors.ErrNotFound, err)
+}
+
 func strPointer(str string) *string {
 	return &str
 }
@@ -0,0 +1,109 @@
+/*
+ Copyright 2020 The Magma Authors.
+
+ This source code is licensed under the BSD-style license found in the
+ LICENSE file in the root directory of this source tree.
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package mconfig
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"magma/orc8r/cloud/go/services/configurator/storage"
+)
+
+// BuildResults are the per-service outcomes of running every registered
+// mconfig builder.
+type BuildResults struct {
+	// Configs is the merged mconfig across all builders which succeeded.
+	Configs ConfigsByKey
+	// Errors holds the build error of each failing builder, keyed by the
+	// builder's service name.
+	Errors map[string]error
+}
+
+// BuildAll runs all registered mconfig builders against the passed network
+// and graph.
+// Unlike CreateMconfigJSON, a failing builder doesn't abort the build: its
+// error is recorded and the remaining builders still run. This allows
+// callers to evaluate a hypothetical network or graph without a gateway
+// ever seeing the result.
+func BuildAll(network *storage.Network, graph *storage.EntityGraph, gatewayID string) (BuildResults, error) {
+	builders, err := GetBuildersByService()
+	if err != nil {
+		return BuildResults{}, err
+	}
+
+	ret := BuildResults{Configs: ConfigsByKey{}, Errors: map[string]error{}}
+	owners := map[string]string{}
+	for _, service := range sortedKeys(builders) {
+		partialConfig, err := builders[service].Build(network, graph, gatewayID)
+		if err != nil {
+			ret.Errors[service] = err
+			continue
+		}
+		for key, config := range partialConfig {
+			if owner, ok := owners[key]; ok {
+				ret.Errors[service] = fmt.Errorf("received partial config for key %v from multiple mconfig builders (%s, %s)", key, owner, service)
+				continue
+			}
+			owners[key] = service
+			ret.Configs[key] = config
+		}
+	}
+	return ret, nil
+}
+
+// ConfigsDiff describes the changes between two mconfigs, by config key.
+type ConfigsDiff struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+// IsEmpty returns true iff the diffed mconfigs are identical.
+func (d ConfigsDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffConfigs returns the sorted keys which were added, removed, or changed
+// going from the old to the new mconfig.
+func DiffConfigs(oldConfigs, newConfigs ConfigsByKey) ConfigsDiff {
+	diff := ConfigsDiff{}
+	for key, newConfig := range newConfigs {
+		oldConfig, ok := oldConfigs[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, key)
+		case !bytes.Equal(oldConfig, newConfig):
+			diff.Changed = append(diff.Changed, key)
+		}
+	}
+	for key := range oldConfigs {
+		if _, ok := newConfigs[key]; !ok {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+	return diff
+}
+
+func sortedKeys(builders map[string]Builder) []string {
+	keys := make([]string, 0, len(builders))
+	for k := range builders {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
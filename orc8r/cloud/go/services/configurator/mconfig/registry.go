@@ -17,6 +17,8 @@
 package mconfig
 
 import (
+	"strings"
+
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/lib/go/registry"
 )
@@ -34,3 +36,18 @@ func GetBuilders() ([]Builder, error) {
 
 	return builders, nil
 }
+
+// GetBuildersByService returns all registered mconfig builders, keyed by the
+// name of the service which provides them.
+func GetBuildersByService() (map[string]Builder, error) {
+	services, err := registry.FindServices(orc8r.MconfigBuilderLabel)
+	if err != nil {
+		return map[string]Builder{}, err
+	}
+	builders := map[string]Builder{}
+	for _, s := range services {
+		builders[strings.ToLower(s)] = NewRemoteBuilder(s)
+	}
+
+	return builders, nil
+}
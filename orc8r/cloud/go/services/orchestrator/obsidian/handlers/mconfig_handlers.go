@@ -0,0 +1,213 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/protobuf/proto"
+	"github.com/labstack/echo/v4"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/mconfig"
+	cfg_storage "magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/merrors"
+)
+
+// GetMconfigDryRunHandler returns a POST handler which builds a gateway's
+// mconfig against a proposed set of network and entity configs, without
+// persisting anything.
+// The handler responds with the mconfig keys which would change for the
+// gateway, or with the errors of any mconfig builders which would fail.
+//   - path: the url at which the handler will be registered. Must contain
+//     the network_id and gateway_id path params.
+//   - networkSerdes, entitySerdes: serdes used to validate the proposed
+//     network and entity configs.
+func GetMconfigDryRunHandler(path string, networkSerdes, entitySerdes serde.Registry) obsidian.Handler {
+	return obsidian.Handler{
+		Path:    path,
+		Methods: obsidian.POST,
+		HandlerFunc: func(c echo.Context) error {
+			return mconfigDryRun(c, networkSerdes, entitySerdes)
+		},
+	}
+}
+
+func mconfigDryRun(c echo.Context, networkSerdes, entitySerdes serde.Registry) error {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	payload, nerr := GetAndValidatePayload(c, &models.MconfigDryRunRequest{})
+	if nerr != nil {
+		return nerr
+	}
+	request := payload.(*models.MconfigDryRunRequest)
+	reqCtx := c.Request().Context()
+
+	network, err := configurator.LoadSerializedNetwork(reqCtx, networkID)
+	if err == merrors.ErrNotFound {
+		return obsidian.MakeHTTPError(fmt.Errorf("network %s not found", networkID), http.StatusNotFound)
+	}
+	if err != nil {
+		return obsidian.MakeHTTPError(fmt.Errorf("failed to load network: %w", err), http.StatusInternalServerError)
+	}
+	graph, err := configurator.LoadSerializedGraphForEntity(reqCtx, networkID, storage.TK{Type: orc8r.MagmadGatewayType, Key: gatewayID})
+	if err == merrors.ErrNotFound {
+		return obsidian.MakeHTTPError(fmt.Errorf("gateway %s not found", gatewayID), http.StatusNotFound)
+	}
+	if err != nil {
+		return obsidian.MakeHTTPError(fmt.Errorf("failed to load entity graph: %w", err), http.StatusInternalServerError)
+	}
+
+	proposedNetwork, err := applyProposedNetworkConfigs(reqCtx, network, request.NetworkConfigs, networkSerdes)
+	if err != nil {
+		return obsidian.MakeHTTPError(err, http.StatusBadRequest)
+	}
+	proposedGraph, err := applyProposedEntityConfigs(reqCtx, graph, request.EntityConfigs, entitySerdes)
+	if err != nil {
+		return obsidian.MakeHTTPError(err, http.StatusBadRequest)
+	}
+
+	current, err := mconfig.BuildAll(network, graph, gatewayID)
+	if err != nil {
+		return obsidian.MakeHTTPError(fmt.Errorf("failed to build current mconfig: %w", err), http.StatusInternalServerError)
+	}
+	proposed, err := mconfig.BuildAll(proposedNetwork, proposedGraph, gatewayID)
+	if err != nil {
+		return obsidian.MakeHTTPError(fmt.Errorf("failed to build proposed mconfig: %w", err), http.StatusInternalServerError)
+	}
+
+	ret, err := makeMconfigDryRunResult(current, proposed)
+	if err != nil {
+		return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func applyProposedNetworkConfigs(ctx context.Context, network *cfg_storage.Network, configs map[string]interface{}, serdes serde.Registry) (*cfg_storage.Network, error) {
+	ret := proto.Clone(network).(*cfg_storage.Network)
+	if ret.Configs == nil {
+		ret.Configs = map[string][]byte{}
+	}
+	for configType, config := range configs {
+		if config == nil {
+			delete(ret.Configs, configType)
+			continue
+		}
+		sConfig, err := reserializeProposedConfig(ctx, config, configType, serdes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network config %s: %w", configType, err)
+		}
+		ret.Configs[configType] = sConfig
+	}
+	return ret, nil
+}
+
+func applyProposedEntityConfigs(ctx context.Context, graph *cfg_storage.EntityGraph, configs []*models.MconfigDryRunEntityConfig, serdes serde.Registry) (*cfg_storage.EntityGraph, error) {
+	ret := proto.Clone(graph).(*cfg_storage.EntityGraph)
+	entsByTK := map[storage.TK]*cfg_storage.NetworkEntity{}
+	for _, ent := range ret.Entities {
+		entsByTK[ent.GetTK()] = ent
+	}
+	for _, config := range configs {
+		tk := storage.TK{Type: swag.StringValue(config.Type), Key: swag.StringValue(config.Key)}
+		ent, ok := entsByTK[tk]
+		if !ok {
+			return nil, fmt.Errorf("entity %s is not in the gateway's entity graph", tk)
+		}
+		if config.Config == nil {
+			ent.Config = nil
+			continue
+		}
+		sConfig, err := reserializeProposedConfig(ctx, config.Config, tk.Type, serdes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config for entity %s: %w", tk, err)
+		}
+		ent.Config = sConfig
+	}
+	return ret, nil
+}
+
+// reserializeProposedConfig converts a config from its generic JSON
+// representation to the serialized form the serde registry would persist,
+// running the model's validations along the way.
+func reserializeProposedConfig(ctx context.Context, config interface{}, configType string, serdes serde.Registry) ([]byte, error) {
+	marshaled, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	iConfig, err := serde.Deserialize(marshaled, configType, serdes)
+	if err != nil {
+		return nil, err
+	}
+	if model, ok := iConfig.(serde.ValidatableModel); ok {
+		if err := model.ValidateModel(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return serde.Serialize(iConfig, configType, serdes)
+}
+
+func makeMconfigDryRunResult(current, proposed mconfig.BuildResults) (*models.MconfigDryRunResult, error) {
+	ret := &models.MconfigDryRunResult{
+		Changes: map[string]models.MconfigChange{},
+		Errors:  map[string]string{},
+	}
+
+	// The gateway receives no mconfig at all when any builder fails, so
+	// there's no meaningful diff to report
+	if len(proposed.Errors) != 0 {
+		for service, err := range proposed.Errors {
+			ret.Errors[service] = err.Error()
+		}
+		return ret, nil
+	}
+
+	diff := mconfig.DiffConfigs(current.Configs, proposed.Configs)
+	statuses := map[string][]string{
+		models.MconfigChangeStatusAdded:   diff.Added,
+		models.MconfigChangeStatusRemoved: diff.Removed,
+		models.MconfigChangeStatusChanged: diff.Changed,
+	}
+	for status, keys := range statuses {
+		for _, key := range keys {
+			change := models.MconfigChange{Status: swag.String(status)}
+			if err := unmarshalMconfig(current.Configs[key], &change.Current); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal current mconfig %s: %w", key, err)
+			}
+			if err := unmarshalMconfig(proposed.Configs[key], &change.Proposed); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal proposed mconfig %s: %w", key, err)
+			}
+			ret.Changes[key] = change
+		}
+	}
+	return ret, nil
+}
+
+func unmarshalMconfig(config []byte, out *interface{}) error {
+	if len(config) == 0 {
+		return nil
+	}
+	return json.Unmarshal(config, out)
+}
@@ -131,3 +131,7 @@ func (m *GatewayStatus) ValidateModel(context.Context) error {
 func (m *GatewayVpnConfigs) ValidateModel(context.Context) error {
 	return m.Validate(strfmt.Default)
 }
+
+func (m *MconfigDryRunRequest) ValidateModel(context.Context) error {
+	return m.Validate(strfmt.Default)
+}